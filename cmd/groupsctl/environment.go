@@ -0,0 +1,190 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	core "groups/core"
+	"groups/core/model"
+	"groups/driven/authman"
+	"groups/driven/corebb"
+	"groups/driven/notifications"
+	"groups/driven/objectstorage"
+	storage "groups/driven/storage"
+	"groups/driven/webhooks"
+	"groups/utils"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/rokwire/core-auth-library-go/v2/authservice"
+	"github.com/rokwire/core-auth-library-go/v2/sigauth"
+	"github.com/rokwire/logging-library-go/v2/logs"
+)
+
+// environment reads the same environment variables as the service, so groupsctl runs against the environment the service is configured for
+type environment struct {
+	missing []string
+}
+
+func (e *environment) get(key string, required bool) string {
+	value, exist := os.LookupEnv(key)
+	if !exist && required {
+		e.missing = append(e.missing, key)
+	}
+	return value
+}
+
+func (e *environment) err() error {
+	if len(e.missing) > 0 {
+		return fmt.Errorf("missing environment variables: %s", strings.Join(e.missing, ", "))
+	}
+	return nil
+}
+
+// newStorageAdapter connects to the database. Starting the adapter applies the index checks and the data migrations.
+func newStorageAdapter() (*storage.Adapter, error) {
+	env := environment{}
+	mongoDBAuth := env.get("GR_MONGO_AUTH", true)
+	mongoDBName := env.get("GR_MONGO_DATABASE", true)
+	mongoTimeout := env.get("GR_MONGO_TIMEOUT", false)
+	mongoSlowQueryThreshold := env.get("GR_MONGO_SLOW_QUERY_THRESHOLD", false)
+	answersEncryptionKeys := env.get("GR_ANSWERS_ENCRYPTION_KEYS", false)
+	answersEncryptionKeyID := env.get("GR_ANSWERS_ENCRYPTION_KEY_ID", false)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+
+	storageAdapter := storage.NewStorageAdapter(mongoDBAuth, mongoDBName, mongoTimeout, mongoSlowQueryThreshold, answersEncryptionKeys, answersEncryptionKeyID)
+	err := storageAdapter.Start()
+	if err != nil {
+		return nil, fmt.Errorf("cannot start the mongoDB adapter - %s", err)
+	}
+	// the application is not started in groupsctl, so the configs are cached here instead of by the warmup
+	err = storageAdapter.CacheConfigs()
+	if err != nil {
+		return nil, fmt.Errorf("cannot cache the configs - %s", err)
+	}
+	return storageAdapter, nil
+}
+
+// newObjectStorageAdapter creates the adapter of the backup object storage. It gives nil if the object storage is not configured.
+func newObjectStorageAdapter() (core.ObjectStorage, error) {
+	env := environment{}
+	endpoint := env.get("GR_BACKUP_S3_ENDPOINT", false)
+	if endpoint == "" {
+		return nil, nil
+	}
+	region := env.get("GR_BACKUP_S3_REGION", false)
+	bucket := env.get("GR_BACKUP_S3_BUCKET", true)
+	accessKey := env.get("GR_BACKUP_S3_ACCESS_KEY", true)
+	secretKey := env.get("GR_BACKUP_S3_SECRET_KEY", true)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+	return objectstorage.NewObjectStorageAdapter(endpoint, region, bucket, accessKey, secretKey, 5*time.Minute)
+}
+
+// newApplication creates the application with the adapters needed by the maintenance tasks. The application is not started,
+// so no scheduled tasks and storage listeners run in groupsctl.
+// The Rewards, Calendar and Social adapters are not configured.
+func newApplication(storageAdapter *storage.Adapter, withExternalAdapters bool) (*core.Application, error) {
+	env := environment{}
+	appID := env.get("GROUPS_APP_ID", true)
+	orgID := env.get("GROUPS_ORG_ID", true)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+	config := &model.ApplicationConfig{
+		SupportedClientIDs:     []string{"edu.illinois.rokwire", "edu.illinois.covid"},
+		AppID:                  appID,
+		OrgID:                  orgID,
+		UnsubscribeTokenSecret: env.get("GR_UNSUBSCRIBE_TOKEN_SECRET", false),
+		BackupPrefix:           env.get("GR_BACKUP_PREFIX", false),
+	}
+	logger := logs.NewLogger("groupsctl", &logs.LoggerOpts{})
+	objectStorageAdapter, err := newObjectStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+
+	if !withExternalAdapters {
+		return core.NewApplication(Version, "", storageAdapter, nil, nil, nil, nil, nil, nil, nil, nil, objectStorageAdapter, nil, nil, nil, "gr", logger, config), nil
+	}
+
+	coreBBHost := env.get("CORE_BB_HOST", true)
+	groupServiceURL := env.get("GROUP_SERVICE_URL", false)
+	serviceAccountID := env.get("GR_SERVICE_ACCOUNT_ID", false)
+	privKeyRaw := env.get("GR_PRIV_KEY", true)
+	notificationsBaseURL := env.get("NOTIFICATIONS_BASE_URL", true)
+	authmanBaseURL := env.get("AUTHMAN_BASE_URL", true)
+	authmanUsername := env.get("AUTHMAN_USERNAME", true)
+	authmanPassword := env.get("AUTHMAN_PASSWORD", true)
+	if err := env.err(); err != nil {
+		return nil, err
+	}
+
+	authService := authservice.AuthService{
+		ServiceID:   "groups",
+		ServiceHost: groupServiceURL,
+		FirstParty:  true,
+		AuthBaseURL: coreBBHost,
+	}
+	serviceRegLoader, err := authservice.NewRemoteServiceRegLoader(&authService, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing remote service registration loader: %v", err)
+	}
+	serviceRegManager, err := authservice.NewServiceRegManager(&authService, serviceRegLoader)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing service registration manager: %v", err)
+	}
+	privKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(strings.ReplaceAll(privKeyRaw, "\\n", "\n")))
+	if err != nil {
+		return nil, fmt.Errorf("error parsing priv key: %v", err)
+	}
+	signatureAuth, err := sigauth.NewSignatureAuth(privKey, serviceRegManager, false)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing signature auth: %v", err)
+	}
+	serviceAccountLoader, err := authservice.NewRemoteServiceAccountLoader(&authService, serviceAccountID, signatureAuth)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing remote service account loader: %v", err)
+	}
+	serviceAccountManager, err := authservice.NewServiceAccountManager(&authService, serviceAccountLoader)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing service account manager: %v", err)
+	}
+
+	clientConfig := utils.ResilientClientConfig{
+		Timeout:          15 * time.Second,
+		MaxRetries:       2,
+		RetryBackoff:     500 * time.Millisecond,
+		FailureThreshold: 5,
+		OpenDuration:     30 * time.Second,
+	}
+	notificationsAdapter, err := notifications.NewNotificationsAdapter(notificationsBaseURL, serviceAccountManager, clientConfig)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing notification adapter: %v", err)
+	}
+	authmanClientConfig := clientConfig
+	authmanClientConfig.Timeout = 60 * time.Second
+	authmanAdapter := authman.NewAuthmanAdapter(authmanBaseURL, authmanUsername, authmanPassword, authmanClientConfig)
+	coreAdapter := corebb.NewCoreAdapter(coreBBHost, serviceAccountManager, clientConfig)
+	webhooksAdapter := webhooks.NewWebhooksAdapter(10 * time.Second)
+
+	return core.NewApplication(Version, "", storageAdapter, notificationsAdapter, authmanAdapter, coreAdapter, nil, nil,
+		webhooksAdapter, nil, nil, objectStorageAdapter, nil, nil, nil, "gr", logger, config), nil
+}
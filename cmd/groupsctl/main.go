@@ -0,0 +1,314 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// groupsctl runs the maintenance tasks of the Groups Building Block against the environment configured with the service environment variables.
+//
+//	groupsctl <command> [flags]
+//
+// The results are written to the standard output as JSON, the logs to the standard error.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"groups/core/model"
+	"log"
+	"os"
+	"strings"
+	"time"
+)
+
+// Version : version of this executable
+var Version string
+
+type command struct {
+	name        string
+	description string
+	run         func(args []string) (interface{}, error)
+}
+
+var commands = []command{
+	{name: "migrate", description: "apply the index checks and the data migrations", run: runMigrate},
+	{name: "recalculate-stats", description: "recalculate the membership stats of the groups", run: runRecalculateStats},
+	{name: "dedup-memberships", description: "merge the duplicate memberships of the same user within a group", run: runDedupMemberships},
+	{name: "sync-group", description: "force the Authman synchronization of a group", run: runSyncGroup},
+	{name: "backup", description: "export the group documents to the backup object storage", run: runBackup},
+	{name: "restore-backup", description: "restore a collection from the backup object storage", run: runRestoreBackup},
+	{name: "reencrypt-answers", description: "encrypt the member answers with the current encryption key after the key rotation", run: runReencryptAnswers},
+}
+
+func main() {
+	if len(Version) == 0 {
+		Version = "dev"
+	}
+	log.SetOutput(os.Stderr)
+
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	for _, cmd := range commands {
+		if cmd.name == os.Args[1] {
+			result, err := cmd.run(os.Args[2:])
+			if err != nil {
+				writeJSON(map[string]string{"error": err.Error()})
+				os.Exit(1)
+			}
+			writeJSON(result)
+			return
+		}
+	}
+
+	usage()
+	os.Exit(2)
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: groupsctl <command> [flags]")
+	fmt.Fprintln(os.Stderr, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintf(os.Stderr, "  %-18s %s\n", cmd.name, cmd.description)
+	}
+	fmt.Fprintln(os.Stderr, "run 'groupsctl <command> -h' for the flags of a command")
+}
+
+func writeJSON(value interface{}) {
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	err := encoder.Encode(value)
+	if err != nil {
+		log.Printf("error encoding the result: %s", err)
+		os.Exit(1)
+	}
+}
+
+// migrateResult is the output of the migrate command
+type migrateResult struct {
+	DryRun  bool `json:"dry_run"`
+	Applied bool `json:"applied"`
+}
+
+// runMigrate connects to the database. The index checks and the data migrations are applied when the storage adapter starts,
+// so the dry run only validates the configuration without connecting.
+func runMigrate(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("migrate", flag.ExitOnError)
+	dryRun := flags.Bool("dry-run", false, "validate the configuration without connecting to the database")
+	flags.Parse(args)
+
+	if *dryRun {
+		env := environment{}
+		env.get("GR_MONGO_AUTH", true)
+		env.get("GR_MONGO_DATABASE", true)
+		if err := env.err(); err != nil {
+			return nil, err
+		}
+		return migrateResult{DryRun: true}, nil
+	}
+
+	_, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	return migrateResult{Applied: true}, nil
+}
+
+// recalculateStatsResult is the output of the recalculate-stats command
+type recalculateStatsResult struct {
+	DryRun  bool                            `json:"dry_run"`
+	Changed int                             `json:"changed"`
+	Groups  []model.GroupStatsRecalculation `json:"groups"`
+}
+
+func runRecalculateStats(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("recalculate-stats", flag.ExitOnError)
+	clientID := flags.String("client-id", "edu.illinois.rokwire", "client id")
+	groupIDs := flags.String("group-ids", "", "comma separated group ids, all groups of the client if empty")
+	dryRun := flags.Bool("dry-run", false, "calculate the stats without storing them")
+	flags.Parse(args)
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	application, err := newApplication(storageAdapter, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var ids []string
+	if len(*groupIDs) > 0 {
+		ids = strings.Split(*groupIDs, ",")
+	}
+	groups, err := application.Admin.RecalculateGroupStats(*clientID, ids, *dryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	result := recalculateStatsResult{DryRun: *dryRun, Groups: groups}
+	for _, group := range groups {
+		if group.Changed {
+			result.Changed++
+		}
+	}
+	return result, nil
+}
+
+func runDedupMemberships(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("dedup-memberships", flag.ExitOnError)
+	clientID := flags.String("client-id", "edu.illinois.rokwire", "client id")
+	dryRun := flags.Bool("dry-run", false, "count the duplicate memberships without merging them")
+	flags.Parse(args)
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	application, err := newApplication(storageAdapter, false)
+	if err != nil {
+		return nil, err
+	}
+	return application.Admin.DeduplicateMemberships(*clientID, *dryRun)
+}
+
+// reencryptAnswersResult is the output of the reencrypt-answers command
+type reencryptAnswersResult struct {
+	Reencrypted int64 `json:"reencrypted"`
+}
+
+// runReencryptAnswers encrypts the member answers stored in plaintext or with a retired key, so the retired key could be removed from
+// the config afterwards
+func runReencryptAnswers(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("reencrypt-answers", flag.ExitOnError)
+	flags.Parse(args)
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	count, err := storageAdapter.ReencryptMemberAnswers(nil)
+	if err != nil {
+		return nil, fmt.Errorf("error re-encrypting the member answers after %d memberships: %s", count, err)
+	}
+	return reencryptAnswersResult{Reencrypted: count}, nil
+}
+
+// syncGroupResult is the output of the sync-group command
+type syncGroupResult struct {
+	DryRun      bool                  `json:"dry_run"`
+	GroupID     string                `json:"group_id"`
+	Title       string                `json:"title"`
+	AuthmanKeys []string              `json:"authman_keys"`
+	Eligible    bool                  `json:"eligible"`
+	Run         *model.AuthmanSyncRun `json:"run,omitempty"`
+}
+
+func runSyncGroup(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("sync-group", flag.ExitOnError)
+	clientID := flags.String("client-id", "edu.illinois.rokwire", "client id")
+	groupID := flags.String("group-id", "", "group id")
+	dryRun := flags.Bool("dry-run", false, "check the group without synchronizing it")
+	flags.Parse(args)
+
+	if len(*groupID) == 0 {
+		return nil, errors.New("the group-id flag is required")
+	}
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	group, err := storageAdapter.FindGroup(nil, *clientID, *groupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("group %s not found", *groupID)
+	}
+
+	result := syncGroupResult{DryRun: *dryRun, GroupID: group.ID, Title: group.Title,
+		AuthmanKeys: group.GetAuthmanGroupKeys(), Eligible: group.IsAuthmanSyncEligible()}
+	if *dryRun {
+		return result, nil
+	}
+	if !result.Eligible {
+		return nil, fmt.Errorf("group %s is not eligible for Authman synchronization", *groupID)
+	}
+
+	application, err := newApplication(storageAdapter, true)
+	if err != nil {
+		return nil, err
+	}
+	err = application.Services.SynchronizeAuthmanGroup(*clientID, *groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int64(1)
+	runs, err := application.Services.GetAuthmanSyncRuns(*clientID, model.AuthmanSyncRunFilter{GroupID: groupID, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	if len(runs) > 0 {
+		result.Run = &runs[0]
+	}
+	return result, nil
+}
+
+func runBackup(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("backup", flag.ExitOnError)
+	flags.Parse(args)
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	application, err := newApplication(storageAdapter, false)
+	if err != nil {
+		return nil, err
+	}
+	return application.Admin.ProcessBackups()
+}
+
+func runRestoreBackup(args []string) (interface{}, error) {
+	flags := flag.NewFlagSet("restore-backup", flag.ExitOnError)
+	collection := flags.String("collection", "", "collection to restore - "+strings.Join(model.BackupCollections, ", "))
+	until := flags.String("until", "", "restore the backups made up to the RFC3339 time, the latest backups if empty")
+	dryRun := flags.Bool("dry-run", false, "list the backups and count the documents without restoring them")
+	flags.Parse(args)
+
+	if len(*collection) == 0 {
+		return nil, errors.New("the collection flag is required")
+	}
+	var untilTime *time.Time
+	if len(*until) > 0 {
+		value, err := time.Parse(time.RFC3339, *until)
+		if err != nil {
+			return nil, fmt.Errorf("invalid until flag - %s", err)
+		}
+		untilTime = &value
+	}
+
+	storageAdapter, err := newStorageAdapter()
+	if err != nil {
+		return nil, err
+	}
+	application, err := newApplication(storageAdapter, false)
+	if err != nil {
+		return nil, err
+	}
+	return application.Admin.RestoreBackup(*collection, untilTime, *dryRun)
+}
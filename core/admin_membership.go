@@ -20,6 +20,9 @@ import (
 )
 
 func (app *Application) adminAddGroupMemberships(clientID string, current *model.User, groupID string, membershipStatuses model.MembershipStatuses) error {
+	var addedNetIDs []string
+	var addedGroup *model.Group
+	var addedMemberships []model.GroupMembership
 	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
 		membership, _ := app.storage.FindGroupMembershipWithContext(context, clientID, groupID, current.ID)
 
@@ -61,6 +64,23 @@ func (app *Application) adminAddGroupMemberships(clientID string, current *model
 					if err != nil {
 						return err
 					}
+					for _, item := range memberships {
+						err = app.recordMembershipChanged(context, clientID, item, model.MembershipChangeCreated)
+						if err != nil {
+							return err
+						}
+					}
+					for _, item := range memberships {
+						addedNetIDs = append(addedNetIDs, item.NetID)
+					}
+					addedGroup = group
+					addedMemberships = memberships
+
+					err = app.recordAuditLog(context, clientID, current, groupID, model.AuditActionMembershipsAdded, "membership", "",
+						map[string]model.AuditChange{"net_ids": {New: addedNetIDs}})
+					if err != nil {
+						return err
+					}
 				}
 			}
 
@@ -69,18 +89,39 @@ func (app *Application) adminAddGroupMemberships(clientID string, current *model
 
 		return nil
 	})
+	if err == nil && len(addedNetIDs) > 0 {
+		go app.welcomeMembers(clientID, addedGroup, addedMemberships)
+	}
 
 	return err
 }
 
 func (app *Application) adminDeleteMembershipsByID(clientID string, current *model.User, groupID string, accountIDs []string) error {
-
 	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
 		membership, _ := app.storage.FindGroupMembershipWithContext(context, clientID, groupID, current.ID)
 
 		if membership != nil && membership.IsAdmin() {
+			err := app.checkGroupKeepsAdmin(context, clientID, groupID, accountIDs, nil)
+			if err != nil {
+				return err
+			}
+
+			err = app.storage.DeleteGroupMembershipsByAccountsIDs(app.logger, context, accountIDs)
+			if err != nil {
+				return err
+			}
+
+			err = app.recordDomainEvent(context, clientID, model.DomainEventMembershipChanged, groupID, map[string]interface{}{
+				"group_id": groupID,
+				"user_ids": accountIDs,
+				"change":   model.MembershipChangeDeleted,
+			})
+			if err != nil {
+				return err
+			}
 
-			err := app.storage.DeleteGroupMembershipsByAccountsIDs(app.logger, context, accountIDs)
+			err = app.recordAuditLog(context, clientID, current, groupID, model.AuditActionMembershipsDeleted, "membership", "",
+				map[string]model.AuditChange{"account_ids": {New: accountIDs}})
 			if err != nil {
 				return err
 			}
@@ -16,12 +16,12 @@ package core
 
 import (
 	"groups/core/model"
-	"groups/driven/calendar"
-	"groups/driven/corebb"
-	"groups/driven/rewards"
 	"log"
+	"sync"
+	"sync/atomic"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/robfig/cron/v3"
 	"github.com/rokwire/logging-library-go/v2/logs"
 )
@@ -47,9 +47,33 @@ type Application struct {
 	corebb        Core
 	rewards       Rewards
 	calendar      Calendar
+	webhooks      Webhooks
+	social        Social        // optional, nil if the Social BB is not configured
+	polls         Polls         // optional, nil if the Polls BB is not configured
+	objectStorage ObjectStorage // optional, nil if the backups are not configured
+	moderation    Moderation    // optional, nil if the external moderation API is not configured
+	orgHierarchy  OrgHierarchy  // optional, nil if the org hierarchy service is not configured
+	messageBus    MessageBus    // optional, nil if the domain events are not published
 
 	authmanSyncInProgress bool
 
+	// instanceID identifies the instance as owner of the distributed locks
+	instanceID string
+
+	// ready is set once the warmup completes
+	ready atomic.Bool
+
+	webhookDeliveryLock sync.Mutex
+
+	// groupMessageSubscribers are the open chat streams of this instance
+	groupMessageSubscribers *groupMessageSubscribers
+
+	// pollResultsCache keeps the poll results fetched from the Polls BB for a short time
+	pollResultsCache *pollResultsCache
+
+	// publicFeedCache keeps the public feeds of the groups for a short time
+	publicFeedCache *publicFeedCache
+
 	//synchronize managed groups timer
 	scheduler *cron.Cron
 	logger    *logs.Logger
@@ -61,7 +85,7 @@ func (app *Application) Start() {
 	storageListener := storageListenerImpl{app: app}
 	app.storage.RegisterStorageListener(&storageListener)
 
-	app.setupCronTimer()
+	go app.warmup()
 }
 
 func (app *Application) setupCronTimer() {
@@ -95,6 +119,34 @@ func (app *Application) setupCronTimer() {
 
 	app.startCoreCleanupTask()
 
+	app.startWebhookDeliveryTask()
+
+	app.startMembershipPruningTask()
+
+	app.startSmartGroupSyncTask()
+
+	app.startBackupTask()
+
+	app.startMembershipDedupTask()
+
+	app.startUsageMeteringTask()
+
+	app.startDigestTask()
+
+	app.startOrgUnitSyncTask()
+
+	app.startPendingMembershipTask()
+
+	app.startEventReminderTask()
+
+	app.startGroupDeletionTask()
+
+	app.startPostNotificationBurstTask()
+
+	app.startMembershipTransitionTask()
+
+	app.startOutboxDispatchTask()
+
 	app.scheduler.Start()
 }
 
@@ -126,8 +178,8 @@ func (app *Application) startScheduledPostTask() {
 }
 
 // NewApplication creates new Application
-func NewApplication(version string, build string, storage Storage, notifications Notifications, authman Authman, core *corebb.Adapter,
-	rewards *rewards.Adapter, calendar *calendar.Adapter, serviceID string, logger *logs.Logger, config *model.ApplicationConfig) *Application {
+func NewApplication(version string, build string, storage Storage, notifications Notifications, authman Authman, core Core,
+	rewards Rewards, calendar Calendar, webhooks Webhooks, social Social, polls Polls, objectStorage ObjectStorage, moderation Moderation, orgHierarchy OrgHierarchy, messageBus MessageBus, serviceID string, logger *logs.Logger, config *model.ApplicationConfig) *Application {
 
 	scheduler := cron.New(cron.WithLocation(time.UTC))
 	application := Application{version: version,
@@ -138,9 +190,21 @@ func NewApplication(version string, build string, storage Storage, notifications
 		corebb:        core,
 		rewards:       rewards,
 		calendar:      calendar,
+		webhooks:      webhooks,
+		social:        social,
+		polls:         polls,
+		objectStorage: objectStorage,
+		moderation:    moderation,
+		orgHierarchy:  orgHierarchy,
+		messageBus:    messageBus,
 		config:        config,
 		scheduler:     scheduler,
 		logger:        logger,
+		instanceID:    uuid.NewString(),
+
+		groupMessageSubscribers: newGroupMessageSubscribers(),
+		pollResultsCache:        newPollResultsCache(),
+		publicFeedCache:         newPublicFeedCache(),
 	}
 
 	//add the drivers ports/interfaces
@@ -27,6 +27,8 @@ import (
 // Services exposes APIs for the driver adapters
 type Services interface {
 	GetVersion() string
+	IsReady() bool
+	GetExternalServicesMetrics() []utils.ResilientClientMetrics
 
 	// TODO: Deprecate this method due to missed CurrentMember!
 	GetGroupEntity(clientID string, id string) (*model.Group, error)
@@ -35,44 +37,126 @@ type Services interface {
 
 	CreateGroup(clientID string, current *model.User, group *model.Group, membersConfig *model.DefaultMembershipConfig) (*string, *utils.GroupError)
 	UpdateGroup(clientID string, current *model.User, group *model.Group) *utils.GroupError
+	PatchGroup(clientID string, current *model.User, groupID string, patch model.GroupPatch) (*model.Group, *utils.GroupError)
 	UpdateGroupDateUpdated(clientID string, groupID string) error
-	DeleteGroup(clientID string, current *model.User, id string) error
+	DeleteGroup(clientID string, current *model.User, id string, cancelEvents bool) error
 	GetAllGroups(clientID string) ([]model.Group, error)
 	GetGroups(clientID string, current *model.User, filter model.GroupsFilter) ([]model.Group, error)
+	CountGroups(clientID string, current *model.User, filter model.GroupsFilter) (int64, error)
 	GetUserGroups(clientID string, current *model.User, filter model.GroupsFilter) ([]model.Group, error)
 	DeleteUser(clientID string, current *model.User) error
 	ReportGroupAsAbuse(clientID string, current *model.User, group *model.Group, comment string) error
 
 	GetGroup(clientID string, current *model.User, id string) (*model.Group, error)
+	GetGroupDetails(clientID string, current *model.User, id string) (*model.Group, error)
 	GetGroupsByGroupIDs(groupIDs []string) ([]model.Group, error)
+	MergeAccounts(clientID string, merge model.AccountMerge) (*model.AccountMergeResult, error)
 
 	GetGroupStats(clientID string, id string) (*model.GroupStats, error)
 
-	ApplyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReason string) error
-	UpdateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences) error
+	ApplyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReasonCode string, rejectReasonNote string) error
+	UpdateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences, showAsLeader *bool, mentionable *bool, searchable *bool) error
 	UpdateMemberships(clientID string, user *model.User, group *model.Group, operation model.MembershipMultiUpdate) error
-
-	GetEvents(clientID string, current *model.User, groupID string, filterByToMembers bool) ([]model.Event, error)
+	MuteMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error)
+	UnmuteMembership(clientID string, membershipID string) error
+	SnoozeMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error)
+	UnsnoozeMembership(clientID string, membershipID string) error
+	UpdateMembershipDigest(clientID string, membershipID string, frequency string, email bool) error
+	ScheduleMembershipTransition(clientID string, current *model.User, membershipID string, status string, dateEffective time.Time) (*model.GroupMembership, error)
+	CancelMembershipTransition(clientID string, current *model.User, membershipID string) error
+
+	GetEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) ([]model.Event, error)
+	CountEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) (int64, error)
 	CreateEvent(clientID string, current *model.User, eventID string, group *model.Group, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error)
+	CreateInternalGroupEvent(clientID string, service string, eventID string, group *model.Group, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error)
 	UpdateEvent(clientID string, current *model.User, eventID string, groupID string, toMemberList []model.ToMember) error
 	DeleteEvent(clientID string, current *model.User, eventID string, groupID string) error
 	GetEventUserIDs(eventID string) ([]string, error)
+	GetGroupEvent(clientID string, groupID string, eventID string) (*model.Event, error)
+	UpdateEventRSVP(clientID string, current *model.User, groupID string, eventID string, status string) (*model.EventRSVPSummary, error)
+	UpdateEventReminder(clientID string, current *model.User, groupID string, eventID string, reminder bool) (*model.EventRSVPSummary, error)
+	SendEventRSVPReminder(clientID string, current *model.User, group *model.Group, event *model.Event, message string) (int, error)
+	CreateCalendarEventSeriesSingleGroup(clientID string, current *model.User, event map[string]interface{}, groupID string, members []model.ToMember, recurrence model.EventRecurrence) ([]map[string]interface{}, error)
+	UpdateCalendarEventSeries(clientID string, current *model.User, groupID string, eventID string, scope string, event map[string]interface{}, members []model.ToMember) ([]map[string]interface{}, error)
+	DeleteEventSeries(clientID string, groupID string, eventID string, scope string) (int64, error)
+	CreateEventCheckInCode(clientID string, current *model.User, groupID string, eventID string, ttl time.Duration) (*model.EventCheckInCode, error)
+	CheckInToEvent(clientID string, current *model.User, membership *model.GroupMembership, eventID string, code *string, token *string) (*model.EventAttendance, error)
+	GetGroupAttendanceReport(clientID string, groupID string, startDate *time.Time, endDate *time.Time) (*model.GroupAttendanceReport, error)
+
+	GetGroupMessages(clientID string, groupID string, cursor *model.GroupMessagesCursor, limit int64) (*model.GroupMessagesPage, error)
+	CreateGroupMessage(clientID string, current *model.User, groupID string, body string) (*model.GroupMessage, error)
+	DeleteGroupMessage(clientID string, current *model.User, group *model.Group, messageID string) (bool, error)
+	SubscribeGroupMessages(clientID string, groupID string) (<-chan model.GroupMessage, func())
+
+	Unsubscribe(token string) (*model.UnsubscribeResult, error)
+
+	CreateCalendarFeedLink(clientID string, current *model.User, groupID string) (*model.CalendarFeedLink, error)
+	RotateCalendarFeedLink(clientID string, current *model.User, group *model.Group, overlap time.Duration) (*model.CalendarFeedLink, error)
+	GetGroupCalendarFeed(groupID string, token string) (*model.CalendarFeed, error)
+
+	GetGroupPublicFeed(clientID string, groupID string) (*model.GroupPublicFeed, error)
+
+	UpdateGroupRules(clientID string, current *model.User, group *model.Group, text string) (*model.GroupRules, error)
+	AcknowledgeGroupRules(clientID string, current *model.User, groupID string, version int) error
 	GetGroupMembershipsStatusAndGroupTitle(userID string) ([]model.GetGroupMembershipsResponse, error)
+	GetUsersGroupMemberships(userIDs []string) ([]model.UserGroupMemberships, error)
 	GetGroupMembershipsByGroupID(groupID string) ([]string, error)
 
 	GetGroupsEvents(eventIDs []string) ([]model.GetGroupsEvents, error)
 
 	GetPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) ([]model.Post, error)
+	CountPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) (int64, error)
 	GetPost(clientID string, userID *string, groupID string, postID string, skipMembershipCheck bool, filterByToMembers bool) (*model.Post, error)
 	GetUserPostCount(clientID string, userID string) (*int64, error)
 	CreatePost(clientID string, current *model.User, post *model.Post, group *model.Group) (*model.Post, error)
 	UpdatePost(clientID string, current *model.User, group *model.Group, post *model.Post) (*model.Post, error)
 	ReactToPost(clientID string, current *model.User, groupID string, postID string, reaction string) error
+	GetPostReactors(clientID string, current *model.User, group *model.Group, postID string, reaction *string) ([]model.PostReactor, error)
 	ReportPostAsAbuse(clientID string, current *model.User, group *model.Group, post *model.Post, comment string, sendToDean bool, sendToGroupAdmins bool) error
 	DeletePost(clientID string, current *model.User, groupID string, postID string, force bool) error
+	PinPost(clientID string, current *model.User, groupID string, postID string, pinned bool) (*model.Post, error)
+	GetPostRevisions(clientID string, current *model.User, groupID string, postID string) ([]model.PostRevision, error)
+	GetDraftPosts(clientID string, current *model.User, groupID string) ([]model.Post, error)
+	PublishDraftPost(clientID string, current *model.User, group *model.Group, postID string) (*model.Post, error)
+	GetPendingApprovalPosts(clientID string, groupID string) ([]model.Post, error)
+	ModeratePendingPost(clientID string, current *model.User, group *model.Group, postID string, approve bool, rejectionReason string) (*model.Post, error)
+	GetUsageRecords(clientID string, fromPeriod *string, toPeriod *string) ([]model.UsageRecord, error)
+	GetAbuseReports(clientID string, filter model.AbuseReportsFilter) ([]model.AbuseReport, error)
+	ModerateAbuseReport(clientID string, current *model.User, groupID *string, id string, action string, comment string) (*model.AbuseReport, error)
+
+	MarkGroupPostsRead(clientID string, current *model.User, groupID string) error
+	GetUnreadCounts(clientID string, current *model.User) ([]model.GroupUnreadCount, error)
+	BookmarkPost(clientID string, current *model.User, groupID string, postID string) (*model.PostBookmark, error)
+	UnbookmarkPost(clientID string, current *model.User, postID string) error
+	AddPostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error)
+	RemovePostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error)
+	GetBookmarkedPosts(clientID string, current *model.User, offset *int64, limit *int64) ([]model.Post, error)
+	GetAdminFeed(clientID string, current *model.User) (*model.AdminFeed, error)
+	LoginUser(clientID string, current *model.User) (*model.AccountLinkResult, error)
+	TransferGroupOwnership(clientID string, current *model.User, group *model.Group, membershipID string, keepAdmin bool) (*model.GroupMembership, error)
+	GetGroupsNeedingAdmin(clientID string) ([]model.Group, error)
+	BanGroupMember(clientID string, current *model.User, group *model.Group, userID string, externalID string, reason string) (*model.GroupBan, error)
+	GetGroupBans(clientID string, groupID string) ([]model.GroupBan, error)
+	UnbanGroupMember(clientID string, current *model.User, groupID string, banID string) (bool, error)
+	ApplyMembershipDecisions(clientID string, current *model.User, group *model.Group, decisions []model.MembershipDecision) ([]model.GroupMembership, error)
+	RepairAccountLinks(clientID string, current *model.User) (*model.AccountLinkRepairResult, error)
+	RestorePost(clientID string, current *model.User, groupID string, postID string) (*model.Post, error)
+
+	GetGroupFeed(clientID string, current *model.User, groupID string, filter model.GroupFeedFilter) ([]model.GroupFeedItem, error)
+	GetGroupActivity(clientID string, current *model.User, group *model.Group, since time.Time) (*model.GroupActivity, error)
+	UpdateGroupJoinRules(clientID string, current *model.User, group *model.Group, rules *model.GroupJoinRules) error
+	UpdateGroupOrgUnit(clientID string, current *model.User, group *model.Group, code *string) (*model.GroupOrgUnit, error)
+	FeatureEvent(clientID string, current *model.User, groupID string, eventID string, featuredUntil *time.Time) (*model.Event, error)
+	GetGroupPollResults(clientID string, current *model.User, group *model.Group, pollID string) (*model.PollResults, error)
+	ApplyGroupAction(clientID string, current *model.User, group *model.Group, action model.GroupAction) model.GroupActionResult
 
 	SynchronizeAuthman(clientID string) error
 	SynchronizeAuthmanGroup(clientID string, groupID string) error
+	GetAuthmanSyncRuns(clientID string, filter model.AuthmanSyncRunFilter) ([]model.AuthmanSyncRun, error)
+	ValidateAuthmanGroups(authmanGroups []string) []model.AuthmanSyncKeyResult
+
+	UpdateSmartGroupRules(clientID string, current *model.User, groupID string, rules *model.SmartGroupRules) error
+	SynchronizeSmartGroup(clientID string, groupID string) error
 
 	GetManagedGroupConfigs(clientID string) ([]model.ManagedGroupConfig, error)
 	CreateManagedGroupConfig(config model.ManagedGroupConfig) (*model.ManagedGroupConfig, error)
@@ -82,10 +166,47 @@ type Services interface {
 	GetSyncConfig(clientID string) (*model.SyncConfig, error)
 	UpdateSyncConfig(config model.SyncConfig) error
 
+	GetPrivacyConfig(clientID string) (*model.PrivacyConfig, error)
+	UpdatePrivacyConfig(config model.PrivacyConfig) error
+	GetLicenseConfig(clientID string) (*model.LicenseConfig, error)
+	UpdateLicenseConfig(config model.LicenseConfig) error
+	GetMembershipPruningConfig(clientID string) (*model.MembershipPruningConfig, error)
+	UpdateMembershipPruningConfig(config model.MembershipPruningConfig) error
+	GetPendingMembershipConfig(clientID string) (*model.PendingMembershipConfig, error)
+	UpdatePendingMembershipConfig(config model.PendingMembershipConfig) error
+	GetPostLimitsConfig(clientID string) (*model.PostLimitsConfig, error)
+	UpdatePostLimitsConfig(config model.PostLimitsConfig) error
+	GetGroupDeletionPolicyConfig(clientID string) (*model.GroupDeletionPolicyConfig, error)
+	UpdateGroupDeletionPolicyConfig(config model.GroupDeletionPolicyConfig) error
+	GetCategoryVisibilityConfig(clientID string) (*model.CategoryVisibilityConfig, error)
+	UpdateCategoryVisibilityConfig(config model.CategoryVisibilityConfig) error
+	GetInternalEventServicesConfig(clientID string) (*model.InternalEventServicesConfig, error)
+	UpdateInternalEventServicesConfig(config model.InternalEventServicesConfig) error
+	GetArchivedGroups(clientID string) ([]model.GroupArchiveItem, error)
+	GetGroupArchiveItems(clientID string, groupID string, entityType *string, offset *int64, limit *int64) ([]model.GroupArchiveItem, error)
+
+	// Group deletions
+	GetGroupDeletionJobs(clientID string, filter model.GroupDeletionJobFilter) ([]model.GroupDeletionJob, error)
+	GetGroupDeletionJob(clientID string, id string) (*model.GroupDeletionJob, error)
+	GetDeletedGroups(clientID string) ([]model.Group, error)
+	RestoreGroup(clientID string, current *model.User, groupID string) (bool, error)
+	CleanupCreatorGroups(clientID string, current *model.User, creatorID string, action string, dryRun bool, excludeGroupIDs []string, cancelEvents bool) (*model.CreatorGroupsCleanupResult, error)
+	GetContentFilterConfig(clientID string) (*model.ContentFilterConfig, error)
+	UpdateContentFilterConfig(config model.ContentFilterConfig) error
+	GetContentRateLimitsConfig(clientID string) (*model.ContentRateLimitsConfig, error)
+	UpdateContentRateLimitsConfig(config model.ContentRateLimitsConfig) error
+	GetRejectionReasonsConfig(clientID string) (*model.RejectionReasonsConfig, error)
+	UpdateRejectionReasonsConfig(config model.RejectionReasonsConfig) error
+	GetPostNotificationBatchingConfig(clientID string) (*model.PostNotificationBatchingConfig, error)
+	UpdatePostNotificationBatchingConfig(config model.PostNotificationBatchingConfig) error
+
 	// V3
 	CheckUserGroupMembershipPermission(clientID string, current *model.User, groupID string) (*model.Group, bool)
+	GetGroupForSupportRead(clientID string, current *model.User, groupID string, resource string) (*model.Group, error)
 	FindGroupsV3(clientID string, filter model.GroupsFilter) ([]model.Group, error)
 	FindGroupMemberships(clientID string, filter model.MembershipFilter) (model.MembershipCollection, error)
+	CountGroupMemberships(clientID string, filter model.MembershipFilter) (int64, error)
+	GetPickerMembers(clientID string, current *model.User, groupID string, name *string, limit *int64) ([]model.PickerMember, error)
 	FindGroupMembership(clientID string, groupID string, userID string) (*model.GroupMembership, error)
 	FindGroupMembershipByID(clientID string, id string) (*model.GroupMembership, error)
 	FindUserGroupMemberships(clientID string, userID string) (model.MembershipCollection, error)
@@ -97,6 +218,7 @@ type Services interface {
 
 	// Group Notifications
 	SendGroupNotification(clientID string, notification model.GroupNotification, predicate model.MutePreferencePredicate) error
+	ResolveGroupRecipients(clientID string, groupID string, audience model.RecipientAudience) ([]notifications.Recipient, error)
 	GetResearchProfileUserCount(clientID string, current *model.User, researchProfile map[string]map[string][]string) (int64, error)
 
 	// Group Events
@@ -104,15 +226,38 @@ type Services interface {
 	UpdateGroupMappingsForEvent(clientID string, current *model.User, eventID string, groupIDs []string) ([]string, error)
 
 	// Analytics
-	AnalyticsFindGroups(startDate *time.Time, endDate *time.Time) ([]model.Group, error)
-	AnalyticsFindPosts(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error)
-	AnalyticsFindMembers(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error)
+	AnalyticsFindGroups(clientID string, startDate *time.Time, endDate *time.Time) ([]model.Group, error)
+	AnalyticsFindPosts(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error)
+	AnalyticsFindMembers(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error)
+	GetGroupAnalytics(clientID string, groupID string, weeks int) (*model.GroupAnalytics, error)
 
 	// Calendar BB
 	CreateCalendarEventForGroups(clientID string, adminIdentifier []model.AccountIdentifiers, current *model.User, event map[string]interface{}, groupIDs []string) (map[string]interface{}, []string, error)
 	CreateCalendarEventSingleGroup(clientID string, current *model.User, event map[string]interface{}, groupID string, members []model.ToMember) (map[string]interface{}, []model.ToMember, error)
 	UpdateCalendarEventSingleGroup(clientID string, current *model.User, event map[string]interface{}, groupID string, members []model.ToMember) (map[string]interface{}, []model.ToMember, error)
 	GetGroupCalendarEvents(clientID string, current *model.User, groupID string, published *bool, filter model.GroupEventFilter) (map[string]interface{}, error)
+
+	// Schedule
+	GetGroupSchedule(clientID string, current *model.User, groupID string) (model.GroupSchedule, error)
+
+	// User Notifications Inbox
+	GetUserNotifications(current *model.User, filter model.UserNotificationsFilter) ([]model.UserNotification, int64, error)
+	MarkUserNotificationsRead(current *model.User, ids []string, read bool) (int64, error)
+
+	// Audit
+	GetGroupAuditLogs(clientID string, groupID string, filter model.AuditLogFilter) ([]model.AuditLog, error)
+
+	// Webhooks
+	CreateWebhookSubscription(clientID string, url string, eventTypes []string, secret string) (*model.WebhookSubscription, string, error)
+	GetWebhookSubscriptions(clientID string) ([]model.WebhookSubscription, error)
+	UpdateWebhookSubscriptionActive(clientID string, id string, active bool) (bool, error)
+	GetWebhookDeliveries(clientID string, subscriptionID string, offset *int64, limit *int64) ([]model.WebhookDelivery, error)
+	RotateWebhookSecret(clientID string, current *model.User, id string, secret string, overlap time.Duration) (*model.WebhookSubscription, string, error)
+
+	// Reactions migration to the Social BB
+	StartReactionMigration(clientID string, groupIDs []string) (*model.ReactionMigration, error)
+	GetReactionMigrations(clientID string, limit int64) ([]model.ReactionMigration, error)
+	GetReactionMigration(clientID string, id string) (*model.ReactionMigration, error)
 }
 
 type servicesImpl struct {
@@ -123,6 +268,14 @@ func (s *servicesImpl) GetVersion() string {
 	return s.app.getVersion()
 }
 
+func (s *servicesImpl) IsReady() bool {
+	return s.app.isReady()
+}
+
+func (s *servicesImpl) GetExternalServicesMetrics() []utils.ResilientClientMetrics {
+	return s.app.getExternalServicesMetrics()
+}
+
 // TODO: Deprecate this method due to missed CurrentMember!
 func (s *servicesImpl) GetGroupEntity(clientID string, id string) (*model.Group, error) {
 	return s.app.getGroupEntity(clientID, id)
@@ -144,18 +297,26 @@ func (s *servicesImpl) UpdateGroup(clientID string, current *model.User, group *
 	return s.app.updateGroup(clientID, current, group)
 }
 
+func (s *servicesImpl) PatchGroup(clientID string, current *model.User, groupID string, patch model.GroupPatch) (*model.Group, *utils.GroupError) {
+	return s.app.patchGroup(clientID, current, groupID, patch)
+}
+
 func (s *servicesImpl) UpdateGroupDateUpdated(clientID string, groupID string) error {
 	return s.app.updateGroupDateUpdated(clientID, groupID)
 }
 
-func (s *servicesImpl) DeleteGroup(clientID string, current *model.User, id string) error {
-	return s.app.deleteGroup(clientID, current, id)
+func (s *servicesImpl) DeleteGroup(clientID string, current *model.User, id string, cancelEvents bool) error {
+	return s.app.deleteGroup(clientID, current, id, cancelEvents)
 }
 
 func (s *servicesImpl) GetGroups(clientID string, current *model.User, filter model.GroupsFilter) ([]model.Group, error) {
 	return s.app.getGroups(clientID, current, filter)
 }
 
+func (s *servicesImpl) CountGroups(clientID string, current *model.User, filter model.GroupsFilter) (int64, error) {
+	return s.app.countGroups(clientID, current, filter)
+}
+
 func (s *servicesImpl) GetAllGroups(clientID string) ([]model.Group, error) {
 	return s.app.getAllGroups(clientID)
 }
@@ -176,30 +337,70 @@ func (s *servicesImpl) GetGroup(clientID string, current *model.User, id string)
 	return s.app.getGroup(clientID, current, id)
 }
 
+func (s *servicesImpl) GetGroupDetails(clientID string, current *model.User, id string) (*model.Group, error) {
+	return s.app.getGroupDetails(clientID, current, id)
+}
+
 func (s *servicesImpl) GetGroupStats(clientID string, id string) (*model.GroupStats, error) {
 	return s.app.storage.GetGroupMembershipStats(nil, clientID, id)
 }
 
-func (s *servicesImpl) ApplyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReason string) error {
-	return s.app.applyMembershipApproval(clientID, current, membershipID, approve, rejectReason)
+func (s *servicesImpl) ApplyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReasonCode string, rejectReasonNote string) error {
+	return s.app.applyMembershipApproval(clientID, current, membershipID, approve, rejectReasonCode, rejectReasonNote)
+}
+
+func (s *servicesImpl) UpdateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences, showAsLeader *bool, mentionable *bool, searchable *bool) error {
+	return s.app.updateMembership(clientID, current, membershipID, status, dateAttended, notificationsPreferences, showAsLeader, mentionable, searchable)
+}
+
+func (s *servicesImpl) MuteMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error) {
+	return s.app.muteMembership(clientID, membershipID, duration)
+}
+
+func (s *servicesImpl) UnmuteMembership(clientID string, membershipID string) error {
+	return s.app.unmuteMembership(clientID, membershipID)
+}
+
+func (s *servicesImpl) SnoozeMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error) {
+	return s.app.snoozeMembership(clientID, membershipID, duration)
+}
+
+func (s *servicesImpl) UnsnoozeMembership(clientID string, membershipID string) error {
+	return s.app.unsnoozeMembership(clientID, membershipID)
+}
+
+func (s *servicesImpl) UpdateMembershipDigest(clientID string, membershipID string, frequency string, email bool) error {
+	return s.app.updateMembershipDigest(clientID, membershipID, frequency, email)
 }
 
-func (s *servicesImpl) UpdateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences) error {
-	return s.app.updateMembership(clientID, current, membershipID, status, dateAttended, notificationsPreferences)
+func (s *servicesImpl) ScheduleMembershipTransition(clientID string, current *model.User, membershipID string, status string, dateEffective time.Time) (*model.GroupMembership, error) {
+	return s.app.scheduleMembershipTransition(clientID, current, membershipID, status, dateEffective)
+}
+
+func (s *servicesImpl) CancelMembershipTransition(clientID string, current *model.User, membershipID string) error {
+	return s.app.cancelMembershipTransition(clientID, current, membershipID)
 }
 
 func (s *servicesImpl) UpdateMemberships(clientID string, user *model.User, group *model.Group, operation model.MembershipMultiUpdate) error {
 	return s.app.updateMemberships(clientID, user, group, operation)
 }
 
-func (s *servicesImpl) GetEvents(clientID string, current *model.User, groupID string, filterByToMembers bool) ([]model.Event, error) {
-	return s.app.getEvents(clientID, current, groupID, filterByToMembers)
+func (s *servicesImpl) GetEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) ([]model.Event, error) {
+	return s.app.getEvents(clientID, current, groupID, filterByToMembers, filter)
+}
+
+func (s *servicesImpl) CountEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) (int64, error) {
+	return s.app.countEvents(clientID, current, groupID, filterByToMembers, filter)
 }
 
 func (s *servicesImpl) CreateEvent(clientID string, current *model.User, eventID string, group *model.Group, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error) {
 	return s.app.createEvent(clientID, current, eventID, group, toMemberList, creator)
 }
 
+func (s *servicesImpl) CreateInternalGroupEvent(clientID string, service string, eventID string, group *model.Group, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error) {
+	return s.app.createInternalGroupEvent(clientID, service, eventID, group, toMemberList, creator)
+}
+
 func (s *servicesImpl) UpdateEvent(clientID string, current *model.User, eventID string, groupID string, toMemberList []model.ToMember) error {
 	return s.app.updateEvent(clientID, current, eventID, groupID, toMemberList)
 }
@@ -212,9 +413,98 @@ func (s *servicesImpl) GetEventUserIDs(eventID string) ([]string, error) {
 	return s.app.findEventUserIDs(eventID)
 }
 
+func (s *servicesImpl) GetGroupEvent(clientID string, groupID string, eventID string) (*model.Event, error) {
+	return s.app.getGroupEvent(clientID, groupID, eventID)
+}
+
+func (s *servicesImpl) UpdateEventRSVP(clientID string, current *model.User, groupID string, eventID string, status string) (*model.EventRSVPSummary, error) {
+	return s.app.updateEventRSVP(clientID, current, groupID, eventID, status)
+}
+
+func (s *servicesImpl) UpdateEventReminder(clientID string, current *model.User, groupID string, eventID string, reminder bool) (*model.EventRSVPSummary, error) {
+	return s.app.updateEventReminder(clientID, current, groupID, eventID, reminder)
+}
+
+func (s *servicesImpl) SendEventRSVPReminder(clientID string, current *model.User, group *model.Group, event *model.Event, message string) (int, error) {
+	return s.app.sendEventRSVPReminder(clientID, current, group, event, message)
+}
+
+func (s *servicesImpl) CreateCalendarEventSeriesSingleGroup(clientID string, current *model.User, event map[string]interface{}, groupID string, members []model.ToMember, recurrence model.EventRecurrence) ([]map[string]interface{}, error) {
+	return s.app.createCalendarEventSeriesSingleGroup(clientID, current, event, groupID, members, recurrence)
+}
+
+func (s *servicesImpl) UpdateCalendarEventSeries(clientID string, current *model.User, groupID string, eventID string, scope string, event map[string]interface{}, members []model.ToMember) ([]map[string]interface{}, error) {
+	return s.app.updateCalendarEventSeries(clientID, current, groupID, eventID, scope, event, members)
+}
+
+func (s *servicesImpl) DeleteEventSeries(clientID string, groupID string, eventID string, scope string) (int64, error) {
+	return s.app.deleteEventSeries(clientID, groupID, eventID, scope)
+}
+
+func (s *servicesImpl) CreateEventCheckInCode(clientID string, current *model.User, groupID string, eventID string, ttl time.Duration) (*model.EventCheckInCode, error) {
+	return s.app.createEventCheckInCode(clientID, current, groupID, eventID, ttl)
+}
+
+func (s *servicesImpl) CheckInToEvent(clientID string, current *model.User, membership *model.GroupMembership, eventID string, code *string, token *string) (*model.EventAttendance, error) {
+	return s.app.checkInToEvent(clientID, current, membership, eventID, code, token)
+}
+
+func (s *servicesImpl) GetGroupAttendanceReport(clientID string, groupID string, startDate *time.Time, endDate *time.Time) (*model.GroupAttendanceReport, error) {
+	return s.app.getGroupAttendanceReport(clientID, groupID, startDate, endDate)
+}
+
+func (s *servicesImpl) GetGroupMessages(clientID string, groupID string, cursor *model.GroupMessagesCursor, limit int64) (*model.GroupMessagesPage, error) {
+	return s.app.getGroupMessages(clientID, groupID, cursor, limit)
+}
+
+func (s *servicesImpl) CreateGroupMessage(clientID string, current *model.User, groupID string, body string) (*model.GroupMessage, error) {
+	return s.app.createGroupMessage(clientID, current, groupID, body)
+}
+
+func (s *servicesImpl) DeleteGroupMessage(clientID string, current *model.User, group *model.Group, messageID string) (bool, error) {
+	return s.app.deleteGroupMessage(clientID, current, group, messageID)
+}
+
+func (s *servicesImpl) SubscribeGroupMessages(clientID string, groupID string) (<-chan model.GroupMessage, func()) {
+	return s.app.groupMessageSubscribers.subscribe(clientID, groupID)
+}
+
+func (s *servicesImpl) Unsubscribe(token string) (*model.UnsubscribeResult, error) {
+	return s.app.unsubscribe(token)
+}
+
+func (s *servicesImpl) CreateCalendarFeedLink(clientID string, current *model.User, groupID string) (*model.CalendarFeedLink, error) {
+	return s.app.createCalendarFeedLink(clientID, current, groupID)
+}
+
+func (s *servicesImpl) RotateCalendarFeedLink(clientID string, current *model.User, group *model.Group, overlap time.Duration) (*model.CalendarFeedLink, error) {
+	return s.app.rotateCalendarFeedLink(clientID, current, group, overlap)
+}
+
+func (s *servicesImpl) GetGroupCalendarFeed(groupID string, token string) (*model.CalendarFeed, error) {
+	return s.app.getGroupCalendarFeed(groupID, token)
+}
+
+func (s *servicesImpl) GetGroupPublicFeed(clientID string, groupID string) (*model.GroupPublicFeed, error) {
+	return s.app.getGroupPublicFeed(clientID, groupID)
+}
+
+func (s *servicesImpl) UpdateGroupRules(clientID string, current *model.User, group *model.Group, text string) (*model.GroupRules, error) {
+	return s.app.updateGroupRules(clientID, current, group, text)
+}
+
+func (s *servicesImpl) AcknowledgeGroupRules(clientID string, current *model.User, groupID string, version int) error {
+	return s.app.acknowledgeGroupRules(clientID, current, groupID, version)
+}
+
 func (s *servicesImpl) GetGroupMembershipsStatusAndGroupTitle(userID string) ([]model.GetGroupMembershipsResponse, error) {
 	return s.app.findGroupMembershipsStatusAndGroupsTitle(userID)
 }
+
+func (s *servicesImpl) GetUsersGroupMemberships(userIDs []string) ([]model.UserGroupMemberships, error) {
+	return s.app.findUsersGroupMemberships(userIDs)
+}
+
 func (s *servicesImpl) GetGroupMembershipsByGroupID(groupID string) ([]string, error) {
 	return s.app.findGroupMembershipsByGroupID(groupID)
 }
@@ -222,6 +512,10 @@ func (s *servicesImpl) GetGroupMembershipsByGroupID(groupID string) ([]string, e
 func (s *servicesImpl) GetGroupsEvents(eventIDs []string) ([]model.GetGroupsEvents, error) {
 	return s.app.findGroupsEvents(eventIDs)
 }
+func (s *servicesImpl) MergeAccounts(clientID string, merge model.AccountMerge) (*model.AccountMergeResult, error) {
+	return s.app.mergeAccounts(clientID, merge)
+}
+
 func (s *servicesImpl) GetGroupsByGroupIDs(groupIDs []string) ([]model.Group, error) {
 	return s.app.findGroupsByGroupIDs(groupIDs)
 }
@@ -230,6 +524,10 @@ func (s *servicesImpl) GetPosts(clientID string, current *model.User, filter mod
 	return s.app.getPosts(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
 }
 
+func (s *servicesImpl) CountPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) (int64, error) {
+	return s.app.countPosts(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
+}
+
 func (s *servicesImpl) GetPost(clientID string, userID *string, groupID string, postID string, skipMembershipCheck bool, filterByToMembers bool) (*model.Post, error) {
 	return s.app.getPost(clientID, userID, groupID, postID, skipMembershipCheck, filterByToMembers)
 }
@@ -250,12 +548,148 @@ func (s *servicesImpl) ReactToPost(clientID string, current *model.User, groupID
 	return s.app.reactToPost(clientID, current, groupID, postID, reaction)
 }
 
+func (s *servicesImpl) GetPostReactors(clientID string, current *model.User, group *model.Group, postID string, reaction *string) ([]model.PostReactor, error) {
+	return s.app.getPostReactors(clientID, current, group, postID, reaction)
+}
+
 func (s *servicesImpl) ReportPostAsAbuse(clientID string, current *model.User, group *model.Group, post *model.Post, comment string, sendToDean bool, sendToGroupAdmins bool) error {
 	return s.app.reportPostAsAbuse(clientID, current, group, post, comment, sendToDean, sendToGroupAdmins)
 }
 
 func (s *servicesImpl) DeletePost(clientID string, current *model.User, groupID string, postID string, force bool) error {
-	return s.app.deletePost(clientID, current.ID, groupID, postID, force)
+	return s.app.deletePost(clientID, current, groupID, postID, force)
+}
+
+func (s *servicesImpl) PinPost(clientID string, current *model.User, groupID string, postID string, pinned bool) (*model.Post, error) {
+	return s.app.pinPost(clientID, current, groupID, postID, pinned)
+}
+
+func (s *servicesImpl) GetPostRevisions(clientID string, current *model.User, groupID string, postID string) ([]model.PostRevision, error) {
+	return s.app.getPostRevisions(clientID, current, groupID, postID)
+}
+
+func (s *servicesImpl) GetDraftPosts(clientID string, current *model.User, groupID string) ([]model.Post, error) {
+	return s.app.getDraftPosts(clientID, current, groupID)
+}
+
+func (s *servicesImpl) PublishDraftPost(clientID string, current *model.User, group *model.Group, postID string) (*model.Post, error) {
+	return s.app.publishDraftPost(clientID, current, group, postID)
+}
+
+func (s *servicesImpl) GetPendingApprovalPosts(clientID string, groupID string) ([]model.Post, error) {
+	return s.app.getPendingApprovalPosts(clientID, groupID)
+}
+
+func (s *servicesImpl) ModeratePendingPost(clientID string, current *model.User, group *model.Group, postID string, approve bool, rejectionReason string) (*model.Post, error) {
+	return s.app.moderatePendingPost(clientID, current, group, postID, approve, rejectionReason)
+}
+
+func (s *servicesImpl) GetAbuseReports(clientID string, filter model.AbuseReportsFilter) ([]model.AbuseReport, error) {
+	return s.app.getAbuseReports(clientID, filter)
+}
+
+func (s *servicesImpl) ModerateAbuseReport(clientID string, current *model.User, groupID *string, id string, action string, comment string) (*model.AbuseReport, error) {
+	return s.app.moderateAbuseReport(clientID, current, groupID, id, action, comment)
+}
+
+func (s *servicesImpl) GetUsageRecords(clientID string, fromPeriod *string, toPeriod *string) ([]model.UsageRecord, error) {
+	return s.app.getUsageRecords(clientID, fromPeriod, toPeriod)
+}
+
+func (s *servicesImpl) MarkGroupPostsRead(clientID string, current *model.User, groupID string) error {
+	return s.app.markGroupPostsRead(clientID, current, groupID)
+}
+
+func (s *servicesImpl) GetUnreadCounts(clientID string, current *model.User) ([]model.GroupUnreadCount, error) {
+	return s.app.getUnreadCounts(clientID, current)
+}
+
+func (s *servicesImpl) BookmarkPost(clientID string, current *model.User, groupID string, postID string) (*model.PostBookmark, error) {
+	return s.app.bookmarkPost(clientID, current, groupID, postID)
+}
+
+func (s *servicesImpl) UnbookmarkPost(clientID string, current *model.User, postID string) error {
+	return s.app.unbookmarkPost(clientID, current, postID)
+}
+
+func (s *servicesImpl) AddPostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error) {
+	return s.app.addPostRecipients(clientID, current, group, postID, userIDs)
+}
+
+func (s *servicesImpl) RemovePostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error) {
+	return s.app.removePostRecipients(clientID, current, group, postID, userIDs)
+}
+
+func (s *servicesImpl) GetBookmarkedPosts(clientID string, current *model.User, offset *int64, limit *int64) ([]model.Post, error) {
+	return s.app.getBookmarkedPosts(clientID, current, offset, limit)
+}
+
+func (s *servicesImpl) GetAdminFeed(clientID string, current *model.User) (*model.AdminFeed, error) {
+	return s.app.getAdminFeed(clientID, current)
+}
+
+func (s *servicesImpl) TransferGroupOwnership(clientID string, current *model.User, group *model.Group, membershipID string, keepAdmin bool) (*model.GroupMembership, error) {
+	return s.app.transferGroupOwnership(clientID, current, group, membershipID, keepAdmin)
+}
+
+func (s *servicesImpl) GetGroupsNeedingAdmin(clientID string) ([]model.Group, error) {
+	return s.app.getGroupsNeedingAdmin(clientID)
+}
+
+func (s *servicesImpl) BanGroupMember(clientID string, current *model.User, group *model.Group, userID string, externalID string, reason string) (*model.GroupBan, error) {
+	return s.app.banGroupMember(clientID, current, group, userID, externalID, reason)
+}
+
+func (s *servicesImpl) GetGroupBans(clientID string, groupID string) ([]model.GroupBan, error) {
+	return s.app.getGroupBans(clientID, groupID)
+}
+
+func (s *servicesImpl) UnbanGroupMember(clientID string, current *model.User, groupID string, banID string) (bool, error) {
+	return s.app.unbanGroupMember(clientID, current, groupID, banID)
+}
+
+func (s *servicesImpl) ApplyMembershipDecisions(clientID string, current *model.User, group *model.Group, decisions []model.MembershipDecision) ([]model.GroupMembership, error) {
+	return s.app.applyMembershipDecisions(clientID, current, group, decisions)
+}
+
+func (s *servicesImpl) GetGroupActivity(clientID string, current *model.User, group *model.Group, since time.Time) (*model.GroupActivity, error) {
+	return s.app.getGroupActivity(clientID, current, group, since)
+}
+
+func (s *servicesImpl) UpdateGroupJoinRules(clientID string, current *model.User, group *model.Group, rules *model.GroupJoinRules) error {
+	return s.app.updateGroupJoinRules(clientID, current, group, rules)
+}
+
+func (s *servicesImpl) UpdateGroupOrgUnit(clientID string, current *model.User, group *model.Group, code *string) (*model.GroupOrgUnit, error) {
+	return s.app.updateGroupOrgUnit(clientID, current, group, code)
+}
+
+func (s *servicesImpl) FeatureEvent(clientID string, current *model.User, groupID string, eventID string, featuredUntil *time.Time) (*model.Event, error) {
+	return s.app.featureEvent(clientID, current, groupID, eventID, featuredUntil)
+}
+
+func (s *servicesImpl) LoginUser(clientID string, current *model.User) (*model.AccountLinkResult, error) {
+	return s.app.loginUser(clientID, current)
+}
+
+func (s *servicesImpl) RepairAccountLinks(clientID string, current *model.User) (*model.AccountLinkRepairResult, error) {
+	return s.app.repairAccountLinks(clientID, current)
+}
+
+func (s *servicesImpl) GetGroupPollResults(clientID string, current *model.User, group *model.Group, pollID string) (*model.PollResults, error) {
+	return s.app.getGroupPollResults(clientID, current, group, pollID)
+}
+
+func (s *servicesImpl) RestorePost(clientID string, current *model.User, groupID string, postID string) (*model.Post, error) {
+	return s.app.restorePost(clientID, current, groupID, postID)
+}
+
+func (s *servicesImpl) GetGroupFeed(clientID string, current *model.User, groupID string, filter model.GroupFeedFilter) ([]model.GroupFeedItem, error) {
+	return s.app.getGroupFeed(clientID, current, groupID, filter)
+}
+
+func (s *servicesImpl) ApplyGroupAction(clientID string, current *model.User, group *model.Group, action model.GroupAction) model.GroupActionResult {
+	return s.app.applyGroupAction(clientID, current, group, action)
 }
 
 func (s *servicesImpl) SynchronizeAuthman(clientID string) error {
@@ -266,6 +700,22 @@ func (s *servicesImpl) SynchronizeAuthmanGroup(clientID string, groupID string)
 	return s.app.synchronizeAuthmanGroup(clientID, groupID)
 }
 
+func (s *servicesImpl) GetAuthmanSyncRuns(clientID string, filter model.AuthmanSyncRunFilter) ([]model.AuthmanSyncRun, error) {
+	return s.app.getAuthmanSyncRuns(clientID, filter)
+}
+
+func (s *servicesImpl) ValidateAuthmanGroups(authmanGroups []string) []model.AuthmanSyncKeyResult {
+	return s.app.validateAuthmanGroups(authmanGroups)
+}
+
+func (s *servicesImpl) UpdateSmartGroupRules(clientID string, current *model.User, groupID string, rules *model.SmartGroupRules) error {
+	return s.app.updateSmartGroupRules(clientID, current, groupID, rules)
+}
+
+func (s *servicesImpl) SynchronizeSmartGroup(clientID string, groupID string) error {
+	return s.app.synchronizeSmartGroup(clientID, groupID)
+}
+
 func (s *servicesImpl) GetManagedGroupConfigs(clientID string) ([]model.ManagedGroupConfig, error) {
 	return s.app.getManagedGroupConfigs(clientID)
 }
@@ -290,12 +740,142 @@ func (s *servicesImpl) UpdateSyncConfig(config model.SyncConfig) error {
 	return s.app.updateSyncConfig(config)
 }
 
+func (s *servicesImpl) GetPrivacyConfig(clientID string) (*model.PrivacyConfig, error) {
+	return s.app.getPrivacyConfig(clientID)
+}
+
+func (s *servicesImpl) UpdatePrivacyConfig(config model.PrivacyConfig) error {
+	return s.app.updatePrivacyConfig(config)
+}
+
+func (s *servicesImpl) GetLicenseConfig(clientID string) (*model.LicenseConfig, error) {
+	return s.app.getLicenseConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateLicenseConfig(config model.LicenseConfig) error {
+	return s.app.updateLicenseConfig(config)
+}
+
+func (s *servicesImpl) GetMembershipPruningConfig(clientID string) (*model.MembershipPruningConfig, error) {
+	return s.app.getMembershipPruningConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateMembershipPruningConfig(config model.MembershipPruningConfig) error {
+	return s.app.updateMembershipPruningConfig(config)
+}
+
+func (s *servicesImpl) GetPendingMembershipConfig(clientID string) (*model.PendingMembershipConfig, error) {
+	return s.app.getPendingMembershipConfig(clientID)
+}
+
+func (s *servicesImpl) UpdatePendingMembershipConfig(config model.PendingMembershipConfig) error {
+	return s.app.updatePendingMembershipConfig(config)
+}
+
+func (s *servicesImpl) GetPostLimitsConfig(clientID string) (*model.PostLimitsConfig, error) {
+	return s.app.getPostLimitsConfig(clientID)
+}
+
+func (s *servicesImpl) UpdatePostLimitsConfig(config model.PostLimitsConfig) error {
+	return s.app.updatePostLimitsConfig(config)
+}
+
+func (s *servicesImpl) GetGroupDeletionPolicyConfig(clientID string) (*model.GroupDeletionPolicyConfig, error) {
+	return s.app.getGroupDeletionPolicyConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateGroupDeletionPolicyConfig(config model.GroupDeletionPolicyConfig) error {
+	return s.app.updateGroupDeletionPolicyConfig(config)
+}
+
+func (s *servicesImpl) GetCategoryVisibilityConfig(clientID string) (*model.CategoryVisibilityConfig, error) {
+	return s.app.getCategoryVisibilityConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateCategoryVisibilityConfig(config model.CategoryVisibilityConfig) error {
+	return s.app.updateCategoryVisibilityConfig(config)
+}
+
+func (s *servicesImpl) GetInternalEventServicesConfig(clientID string) (*model.InternalEventServicesConfig, error) {
+	return s.app.getInternalEventServicesConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateInternalEventServicesConfig(config model.InternalEventServicesConfig) error {
+	return s.app.updateInternalEventServicesConfig(config)
+}
+
+func (s *servicesImpl) GetArchivedGroups(clientID string) ([]model.GroupArchiveItem, error) {
+	return s.app.getArchivedGroups(clientID)
+}
+
+func (s *servicesImpl) GetGroupArchiveItems(clientID string, groupID string, entityType *string, offset *int64, limit *int64) ([]model.GroupArchiveItem, error) {
+	return s.app.getGroupArchiveItems(clientID, groupID, entityType, offset, limit)
+}
+
+// Group deletions
+
+func (s *servicesImpl) GetGroupDeletionJobs(clientID string, filter model.GroupDeletionJobFilter) ([]model.GroupDeletionJob, error) {
+	return s.app.getGroupDeletionJobs(clientID, filter)
+}
+
+func (s *servicesImpl) GetGroupDeletionJob(clientID string, id string) (*model.GroupDeletionJob, error) {
+	return s.app.getGroupDeletionJob(clientID, id)
+}
+
+func (s *servicesImpl) GetDeletedGroups(clientID string) ([]model.Group, error) {
+	return s.app.getDeletedGroups(clientID)
+}
+
+func (s *servicesImpl) RestoreGroup(clientID string, current *model.User, groupID string) (bool, error) {
+	return s.app.restoreGroup(clientID, current, groupID)
+}
+
+func (s *servicesImpl) CleanupCreatorGroups(clientID string, current *model.User, creatorID string, action string, dryRun bool, excludeGroupIDs []string, cancelEvents bool) (*model.CreatorGroupsCleanupResult, error) {
+	return s.app.cleanupCreatorGroups(clientID, current, creatorID, action, dryRun, excludeGroupIDs, cancelEvents)
+}
+
+func (s *servicesImpl) GetContentFilterConfig(clientID string) (*model.ContentFilterConfig, error) {
+	return s.app.getContentFilterConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateContentFilterConfig(config model.ContentFilterConfig) error {
+	return s.app.updateContentFilterConfig(config)
+}
+
+func (s *servicesImpl) GetContentRateLimitsConfig(clientID string) (*model.ContentRateLimitsConfig, error) {
+	return s.app.getContentRateLimitsConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateContentRateLimitsConfig(config model.ContentRateLimitsConfig) error {
+	return s.app.updateContentRateLimitsConfig(config)
+}
+
+func (s *servicesImpl) GetRejectionReasonsConfig(clientID string) (*model.RejectionReasonsConfig, error) {
+	return s.app.getRejectionReasonsConfig(clientID)
+}
+
+func (s *servicesImpl) UpdateRejectionReasonsConfig(config model.RejectionReasonsConfig) error {
+	return s.app.updateRejectionReasonsConfig(config)
+}
+
+func (s *servicesImpl) GetPostNotificationBatchingConfig(clientID string) (*model.PostNotificationBatchingConfig, error) {
+	return s.app.getPostNotificationBatchingConfig(clientID)
+}
+
+func (s *servicesImpl) UpdatePostNotificationBatchingConfig(config model.PostNotificationBatchingConfig) error {
+	return s.app.updatePostNotificationBatchingConfig(config)
+}
+
 // V3
 
 func (s *servicesImpl) CheckUserGroupMembershipPermission(clientID string, current *model.User, groupID string) (*model.Group, bool) {
 	return s.app.checkUserGroupMembershipPermission(clientID, current, groupID)
 }
 
+func (s *servicesImpl) GetGroupForSupportRead(clientID string, current *model.User, groupID string, resource string) (*model.Group, error) {
+	return s.app.getGroupForSupportRead(clientID, current, groupID, resource)
+}
+
 func (s *servicesImpl) FindGroupsV3(clientID string, filter model.GroupsFilter) ([]model.Group, error) {
 	return s.app.findGroupsV3(clientID, filter)
 }
@@ -304,6 +884,14 @@ func (s *servicesImpl) FindGroupMemberships(clientID string, filter model.Member
 	return s.app.findGroupMemberships(nil, clientID, filter)
 }
 
+func (s *servicesImpl) CountGroupMemberships(clientID string, filter model.MembershipFilter) (int64, error) {
+	return s.app.countGroupMemberships(clientID, filter)
+}
+
+func (s *servicesImpl) GetPickerMembers(clientID string, current *model.User, groupID string, name *string, limit *int64) ([]model.PickerMember, error) {
+	return s.app.getPickerMembers(clientID, current, groupID, name, limit)
+}
+
 func (s *servicesImpl) FindGroupMembership(clientID string, groupID string, userID string) (*model.GroupMembership, error) {
 	return s.app.findGroupMembership(clientID, groupID, userID)
 }
@@ -329,7 +917,7 @@ func (s *servicesImpl) DeletePendingMembership(clientID string, current *model.U
 }
 
 func (s *servicesImpl) DeleteMembershipByID(clientID string, current *model.User, membershipID string) error {
-	return s.app.deleteMembershipByID(clientID, current, membershipID)
+	return s.app.deleteMembershipByID(clientID, current, membershipID, nil)
 }
 
 func (s *servicesImpl) DeleteMembership(clientID string, current *model.User, groupID string) error {
@@ -340,6 +928,10 @@ func (s *servicesImpl) SendGroupNotification(clientID string, notification model
 	return s.app.sendGroupNotification(clientID, notification, predicate)
 }
 
+func (s *servicesImpl) ResolveGroupRecipients(clientID string, groupID string, audience model.RecipientAudience) ([]notifications.Recipient, error) {
+	return s.app.resolveGroupRecipients(clientID, groupID, audience)
+}
+
 func (s *servicesImpl) GetResearchProfileUserCount(clientID string, current *model.User, researchProfile map[string]map[string][]string) (int64, error) {
 	return s.app.getResearchProfileUserCount(clientID, current, researchProfile)
 }
@@ -356,16 +948,20 @@ func (s *servicesImpl) UpdateGroupMappingsForEvent(clientID string, current *mod
 
 // Analytics
 
-func (s *servicesImpl) AnalyticsFindGroups(startDate *time.Time, endDate *time.Time) ([]model.Group, error) {
-	return s.app.analyticsFindGroups(startDate, endDate)
+func (s *servicesImpl) AnalyticsFindGroups(clientID string, startDate *time.Time, endDate *time.Time) ([]model.Group, error) {
+	return s.app.analyticsFindGroups(clientID, startDate, endDate)
 }
 
-func (s *servicesImpl) AnalyticsFindPosts(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error) {
-	return s.app.analyticsFindPosts(groupID, startDate, endDate)
+func (s *servicesImpl) AnalyticsFindPosts(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error) {
+	return s.app.analyticsFindPosts(clientID, groupID, startDate, endDate)
 }
 
-func (s *servicesImpl) AnalyticsFindMembers(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error) {
-	return s.app.analyticsFindMembers(groupID, startDate, endDate)
+func (s *servicesImpl) AnalyticsFindMembers(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error) {
+	return s.app.analyticsFindMembers(clientID, groupID, startDate, endDate)
+}
+
+func (s *servicesImpl) GetGroupAnalytics(clientID string, groupID string, weeks int) (*model.GroupAnalytics, error) {
+	return s.app.getGroupAnalytics(clientID, groupID, weeks)
 }
 
 func (s *servicesImpl) CreateCalendarEventForGroups(clientID string, adminIdentifier []model.AccountIdentifiers, current *model.User, event map[string]interface{}, groupIDs []string) (map[string]interface{}, []string, error) {
@@ -384,10 +980,72 @@ func (s *servicesImpl) GetGroupCalendarEvents(clientID string, current *model.Us
 	return s.app.getGroupCalendarEvents(clientID, current, groupID, published, filter)
 }
 
+// Schedule
+
+func (s *servicesImpl) GetGroupSchedule(clientID string, current *model.User, groupID string) (model.GroupSchedule, error) {
+	return s.app.getGroupSchedule(clientID, current, groupID)
+}
+
+// User Notifications Inbox
+
+func (s *servicesImpl) GetUserNotifications(current *model.User, filter model.UserNotificationsFilter) ([]model.UserNotification, int64, error) {
+	return s.app.getUserNotifications(current, filter)
+}
+
+func (s *servicesImpl) MarkUserNotificationsRead(current *model.User, ids []string, read bool) (int64, error) {
+	return s.app.markUserNotificationsRead(current, ids, read)
+}
+
+// Audit
+
+func (s *servicesImpl) GetGroupAuditLogs(clientID string, groupID string, filter model.AuditLogFilter) ([]model.AuditLog, error) {
+	return s.app.getGroupAuditLogs(clientID, groupID, filter)
+}
+
+// Webhooks
+
+func (s *servicesImpl) CreateWebhookSubscription(clientID string, url string, eventTypes []string, secret string) (*model.WebhookSubscription, string, error) {
+	return s.app.createWebhookSubscription(clientID, url, eventTypes, secret)
+}
+
+func (s *servicesImpl) GetWebhookSubscriptions(clientID string) ([]model.WebhookSubscription, error) {
+	return s.app.getWebhookSubscriptions(clientID)
+}
+
+func (s *servicesImpl) UpdateWebhookSubscriptionActive(clientID string, id string, active bool) (bool, error) {
+	return s.app.updateWebhookSubscriptionActive(clientID, id, active)
+}
+
+func (s *servicesImpl) GetWebhookDeliveries(clientID string, subscriptionID string, offset *int64, limit *int64) ([]model.WebhookDelivery, error) {
+	return s.app.getWebhookDeliveries(clientID, subscriptionID, offset, limit)
+}
+
+func (s *servicesImpl) RotateWebhookSecret(clientID string, current *model.User, id string, secret string, overlap time.Duration) (*model.WebhookSubscription, string, error) {
+	return s.app.rotateWebhookSecret(clientID, current, id, secret, overlap)
+}
+
+func (s *servicesImpl) StartReactionMigration(clientID string, groupIDs []string) (*model.ReactionMigration, error) {
+	return s.app.startReactionMigration(clientID, groupIDs)
+}
+
+func (s *servicesImpl) GetReactionMigrations(clientID string, limit int64) ([]model.ReactionMigration, error) {
+	return s.app.getReactionMigrations(clientID, limit)
+}
+
+func (s *servicesImpl) GetReactionMigration(clientID string, id string) (*model.ReactionMigration, error) {
+	return s.app.getReactionMigration(clientID, id)
+}
+
 // Administration exposes administration APIs for the driver adapters
 type Administration interface {
 	AdminAddGroupMemberships(clientID string, current *model.User, groupID string, membershipStatuses model.MembershipStatuses) error
 	AdminDeleteMembershipsByID(clientID string, current *model.User, groupID string, accountIDs []string) error
+
+	RecalculateGroupStats(clientID string, groupIDs []string, dryRun bool) ([]model.GroupStatsRecalculation, error)
+	DeduplicateMemberships(clientID string, dryRun bool) (*model.MembershipDedupResult, error)
+
+	ProcessBackups() ([]model.BackupResult, error)
+	RestoreBackup(collection string, until *time.Time, dryRun bool) (*model.BackupRestoreResult, error)
 }
 
 type administrationImpl struct {
@@ -402,9 +1060,26 @@ func (s *administrationImpl) AdminDeleteMembershipsByID(clientID string, current
 	return s.app.adminDeleteMembershipsByID(clientID, current, groupID, accountIDs)
 }
 
+func (s *administrationImpl) RecalculateGroupStats(clientID string, groupIDs []string, dryRun bool) ([]model.GroupStatsRecalculation, error) {
+	return s.app.recalculateGroupStats(clientID, groupIDs, dryRun)
+}
+
+func (s *administrationImpl) DeduplicateMemberships(clientID string, dryRun bool) (*model.MembershipDedupResult, error) {
+	return s.app.deduplicateMemberships(clientID, dryRun)
+}
+
+func (s *administrationImpl) ProcessBackups() ([]model.BackupResult, error) {
+	return s.app.processBackups()
+}
+
+func (s *administrationImpl) RestoreBackup(collection string, until *time.Time, dryRun bool) (*model.BackupRestoreResult, error) {
+	return s.app.restoreBackup(collection, until, dryRun)
+}
+
 // Storage is used by corebb to storage data - DB storage adapter, file storage adapter etc
 type Storage interface {
 	RegisterStorageListener(listener storage.Listener)
+	CacheConfigs() error
 
 	PerformTransaction(transaction func(context storage.TransactionContext) error) error
 
@@ -413,14 +1088,43 @@ type Storage interface {
 	FindSyncConfig(context storage.TransactionContext, clientID string) (*model.SyncConfig, error)
 	SaveSyncConfig(context storage.TransactionContext, config model.SyncConfig) error
 
+	FindPrivacyConfig(context storage.TransactionContext, clientID string) (*model.PrivacyConfig, error)
+	SavePrivacyConfig(context storage.TransactionContext, config model.PrivacyConfig) error
+	FindLicenseConfig(context storage.TransactionContext, clientID string) (*model.LicenseConfig, error)
+	SaveLicenseConfig(context storage.TransactionContext, config model.LicenseConfig) error
+	FindMembershipPruningConfig(context storage.TransactionContext, clientID string) (*model.MembershipPruningConfig, error)
+	SaveMembershipPruningConfig(context storage.TransactionContext, config model.MembershipPruningConfig) error
+	FindPendingMembershipConfig(context storage.TransactionContext, clientID string) (*model.PendingMembershipConfig, error)
+	SavePendingMembershipConfig(context storage.TransactionContext, config model.PendingMembershipConfig) error
+	FindPostLimitsConfig(context storage.TransactionContext, clientID string) (*model.PostLimitsConfig, error)
+	SavePostLimitsConfig(context storage.TransactionContext, config model.PostLimitsConfig) error
+	FindGroupDeletionPolicyConfig(context storage.TransactionContext, clientID string) (*model.GroupDeletionPolicyConfig, error)
+	SaveGroupDeletionPolicyConfig(context storage.TransactionContext, config model.GroupDeletionPolicyConfig) error
+	FindCategoryVisibilityConfig(context storage.TransactionContext, clientID string) (*model.CategoryVisibilityConfig, error)
+	SaveCategoryVisibilityConfig(context storage.TransactionContext, config model.CategoryVisibilityConfig) error
+	FindInternalEventServicesConfig(context storage.TransactionContext, clientID string) (*model.InternalEventServicesConfig, error)
+	SaveInternalEventServicesConfig(context storage.TransactionContext, config model.InternalEventServicesConfig) error
+	FindContentFilterConfig(context storage.TransactionContext, clientID string) (*model.ContentFilterConfig, error)
+	SaveContentFilterConfig(context storage.TransactionContext, config model.ContentFilterConfig) error
+	FindContentRateLimitsConfig(context storage.TransactionContext, clientID string) (*model.ContentRateLimitsConfig, error)
+	SaveContentRateLimitsConfig(context storage.TransactionContext, config model.ContentRateLimitsConfig) error
+	FindRejectionReasonsConfig(context storage.TransactionContext, clientID string) (*model.RejectionReasonsConfig, error)
+	SaveRejectionReasonsConfig(context storage.TransactionContext, config model.RejectionReasonsConfig) error
+	FindPostNotificationBatchingConfig(context storage.TransactionContext, clientID string) (*model.PostNotificationBatchingConfig, error)
+	SavePostNotificationBatchingConfig(context storage.TransactionContext, config model.PostNotificationBatchingConfig) error
+
 	FindSyncTimes(context storage.TransactionContext, clientID string, key string, legacy bool) (*model.SyncTimes, error)
+	AcquireLock(context storage.TransactionContext, name string, owner string, lease time.Duration) (bool, error)
+	ReleaseLock(context storage.TransactionContext, name string, owner string) error
 	SaveSyncTimes(context storage.TransactionContext, times model.SyncTimes) error
 
 	GetUserPostCount(clientID string, userID string) (*int64, error)
 	DeleteUser(clientID string, userID string) error
+	MergeUserAccounts(clientID string, appID string, orgID string, oldUserID string, newUserID string) (*model.AccountMergeResult, error)
 
 	CreateGroup(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, memberships []model.GroupMembership) (*string, *utils.GroupError)
 	UpdateGroup(context storage.TransactionContext, clientID string, current *model.User, group *model.Group) *utils.GroupError
+	PatchGroup(context storage.TransactionContext, clientID string, groupID string, patch model.GroupPatch) *utils.GroupError
 	UpdateGroupWithMembership(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, memberships []model.GroupMembership) *utils.GroupError
 	UpdateGroupSyncTimes(context storage.TransactionContext, clientID string, group *model.Group) error
 	UpdateGroupStats(context storage.TransactionContext, clientID string, id string, resetUpdateDate bool, resetMembershipUpdateDate bool, resetManagedMembershipUpdateDate bool, resetStats bool) error
@@ -429,19 +1133,119 @@ type Storage interface {
 	FindGroup(context storage.TransactionContext, clientID string, groupID string, userID *string) (*model.Group, error)
 	FindGroupByTitle(clientID string, title string) (*model.Group, error)
 	FindGroups(clientID string, userID *string, filter model.GroupsFilter) ([]model.Group, error)
+	CountGroups(clientID string, userID *string, filter model.GroupsFilter) (int64, error)
 	FindGroupsByGroupIDs(groupIDs []string) ([]model.Group, error)
 	FindUserGroups(clientID string, userID string, filter model.GroupsFilter) ([]model.Group, error)
 	FindUserGroupsCount(clientID string, userID string) (*int64, error)
 	DeleteUsersByAccountsIDs(log *logs.Logger, context storage.TransactionContext, accountsIDs []string) error
 
-	FindEvents(clientID string, current *model.User, groupID string, filterByToMembers bool) ([]model.Event, error)
+	FindEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) ([]model.Event, error)
+	CountEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) (int64, error)
+	UpdateEventDates(context storage.TransactionContext, clientID string, groupID string, eventID string, dateStart *time.Time, dateEnd *time.Time) error
 	CreateEvent(context storage.TransactionContext, clientID string, eventID string, groupID string, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error)
 	UpdateEvent(clientID string, eventID string, groupID string, toMemberList []model.ToMember) error
 	DeleteEvent(clientID string, eventID string, groupID string) error
 	PullMembersFromEventsByUserIDs(log *logs.Logger, context storage.TransactionContext, accountsIDs []string) error
+	FindEvent(context storage.TransactionContext, clientID string, groupID string, eventID string) (*model.Event, error)
+	SaveEventRSVP(clientID string, groupID string, eventID string, rsvp model.EventRSVP) error
+	SaveEventRSVPReminder(clientID string, groupID string, eventID string, rsvp model.EventRSVP) error
+	FindEventsDueReminder(context storage.TransactionContext, clientID string, startAfter time.Time, startBefore time.Time) ([]model.Event, error)
+	ClaimEventReminder(context storage.TransactionContext, clientID string, groupID string, eventID string, date time.Time) (bool, error)
+	PullRSVPsFromEventsByUserIDs(context storage.TransactionContext, accountIDs []string) error
+	InsertSeriesEvent(context storage.TransactionContext, event model.Event) error
+	FindEventSeries(context storage.TransactionContext, clientID string, groupID string, seriesID string, from *time.Time) ([]model.Event, error)
+	UpdateSeriesEvent(context storage.TransactionContext, clientID string, groupID string, eventID string, toMemberList []model.ToMember, occurrenceStart *time.Time) error
+	DeleteEventsByIDs(context storage.TransactionContext, clientID string, groupID string, eventIDs []string) (int64, error)
+
+	InsertEventCheckInCode(context storage.TransactionContext, code model.EventCheckInCode) error
+	FindEventCheckInCode(context storage.TransactionContext, clientID string, groupID string, eventID string, code *string, token *string) (*model.EventCheckInCode, error)
+	InsertEventAttendance(attendance model.EventAttendance) (bool, error)
+	FindEventAttendances(context storage.TransactionContext, clientID string, groupID string, eventID string) ([]model.EventAttendance, error)
+	DeleteEventAttendancesByUserIDs(context storage.TransactionContext, accountIDs []string) error
+	FindGroupMemberAttendances(context storage.TransactionContext, clientID string, groupID string, startDate *time.Time, endDate *time.Time) ([]model.MemberAttendance, error)
+	CountGroupAttendedEvents(context storage.TransactionContext, clientID string, groupID string, startDate *time.Time, endDate *time.Time) (int, error)
+
+	InsertGroupMessage(context storage.TransactionContext, message model.GroupMessage) error
+	FindGroupMessages(context storage.TransactionContext, clientID string, groupID string, cursor *model.GroupMessagesCursor, limit int64) ([]model.GroupMessage, error)
+	DeleteGroupMessage(context storage.TransactionContext, clientID string, groupID string, messageID string, senderID *string) (bool, error)
+	DeleteGroupMessagesByUserIDs(context storage.TransactionContext, accountIDs []string) error
+
+	UpdateMembershipNotificationsPreferences(context storage.TransactionContext, clientID string, membershipID string, preferences model.NotificationsPreferences) error
+	UpdateMembershipMutedUntil(context storage.TransactionContext, clientID string, membershipID string, mutedUntil *time.Time) error
+	UpdateMembershipSnoozedUntil(context storage.TransactionContext, clientID string, membershipID string, snoozedUntil *time.Time) error
+	FindMutedMemberUserIDs(context storage.TransactionContext, groupID string, userIDs []string, includeDigest bool) ([]string, error)
+
+	// Group admins
+	CountGroupAdmins(context storage.TransactionContext, clientID string, groupID string, exceptUserIDs []string, exceptMembershipIDs []string) (int64, error)
+	FindAdminMembershipsByUserIDs(context storage.TransactionContext, userIDs []string) ([]model.GroupMembership, error)
+	FindLongestStandingMember(context storage.TransactionContext, clientID string, groupID string, exceptUserIDs []string) (*model.GroupMembership, error)
+	TransferGroupAdmin(context storage.TransactionContext, clientID string, groupID string, fromMembershipID string, toMembershipID string) error
+	UpdateMembershipStatus(context storage.TransactionContext, clientID string, groupID string, membershipID string, status string) error
+	UpdateGroupAdminNeeded(context storage.TransactionContext, clientID string, groupID string, date *time.Time) error
+	FindGroupsNeedingAdmin(context storage.TransactionContext, clientID string) ([]model.Group, error)
+
+	// Group archives
+	FindArchivedGroups(context storage.TransactionContext, clientID string) ([]model.GroupArchiveItem, error)
+	FindGroupArchiveItems(context storage.TransactionContext, clientID string, groupID string, entityType *string, offset *int64, limit *int64) ([]model.GroupArchiveItem, error)
+
+	// Group deletions
+	InsertGroupDeletionJob(context storage.TransactionContext, job model.GroupDeletionJob) error
+	UpdateGroupDeletionJob(context storage.TransactionContext, job model.GroupDeletionJob) error
+	FindGroupDeletionJob(context storage.TransactionContext, clientID string, id string) (*model.GroupDeletionJob, error)
+	FindGroupDeletionJobs(context storage.TransactionContext, clientID string, filter model.GroupDeletionJobFilter) ([]model.GroupDeletionJob, error)
+	FindUnfinishedGroupDeletionJobs(context storage.TransactionContext, updatedBefore time.Time) ([]model.GroupDeletionJob, error)
+	MarkGroupDeleted(context storage.TransactionContext, clientID string, groupID string, date time.Time, datePurge time.Time) (bool, error)
+	RestoreGroup(context storage.TransactionContext, clientID string, groupID string, now time.Time) (bool, error)
+	ArchiveGroup(context storage.TransactionContext, clientID string, groupID string) (bool, error)
+	FindDeletedGroups(context storage.TransactionContext, clientID string) ([]model.Group, error)
+	FindGroupsToPurge(context storage.TransactionContext, now time.Time) ([]model.Group, error)
+	ClaimGroupPurge(context storage.TransactionContext, clientID string, groupID string, now time.Time) (bool, error)
+	ArchiveGroupEventMappings(context storage.TransactionContext, clientID string, groupID string) (int64, error)
+	DeleteGroupContentBatch(context storage.TransactionContext, clientID string, groupID string, step string, dateArchived time.Time, datePurge *time.Time, limit int64) (int64, error)
+
+	// Group bans
+	SaveGroupBan(context storage.TransactionContext, ban model.GroupBan) (*model.GroupBan, error)
+	FindGroupBans(context storage.TransactionContext, clientID string, groupID string) ([]model.GroupBan, error)
+	FindGroupBan(context storage.TransactionContext, clientID string, groupID string, userID string, externalID string) (*model.GroupBan, error)
+	UpdateMembershipPostingMutedUntil(context storage.TransactionContext, clientID string, membershipID string, mutedUntil *time.Time) error
+	FindGroupBannedExternalIDs(context storage.TransactionContext, clientID string, groupID string) ([]string, error)
+	DeleteGroupBan(context storage.TransactionContext, clientID string, groupID string, id string) (bool, error)
+
+	// Membership decisions
+	ApplyPendingMembershipDecision(context storage.TransactionContext, clientID string, groupID string, membershipID string, approve bool, rejection *model.MembershipRejection) (*model.GroupMembership, error)
+
+	UpdateGroupJoinRules(context storage.TransactionContext, clientID string, groupID string, rules *model.GroupJoinRules) error
+
+	UpdateGroupOrgUnit(context storage.TransactionContext, clientID string, groupID string, orgUnit *model.GroupOrgUnit) error
+	FindGroupOrgUnitCodes(context storage.TransactionContext, clientID string) ([]string, error)
+	UpdateGroupsOrgUnit(context storage.TransactionContext, clientID string, orgUnit model.GroupOrgUnit) (int64, error)
+
+	CountFeaturedEvents(context storage.TransactionContext, clientID string, groupID string, now time.Time) (int64, error)
+	UpdateEventFeaturedUntil(context storage.TransactionContext, clientID string, groupID string, eventID string, featuredUntil *time.Time) (bool, error)
+
+	// Account links
+	LinkAccountData(context storage.TransactionContext, clientID string, userID string, externalID string, netID string) (*model.AccountLinkResult, error)
+	FindUnlinkedMembershipExternalIDs(context storage.TransactionContext, clientID string, afterExternalID string, limit int64) ([]string, error)
+
+	// Digests
+	UpdateMembershipDigest(context storage.TransactionContext, clientID string, membershipID string, frequency string, email bool) error
+	UpdateMembershipCalendarFeedVersion(context storage.TransactionContext, clientID string, membershipID string, version int, newVersion int, previousVersionEnds *time.Time) (bool, error)
+	UpdateMembershipTransition(context storage.TransactionContext, clientID string, groupID string, membershipID string, transition *model.MembershipTransition) (bool, error)
+	FindDueMembershipTransitions(context storage.TransactionContext, clientID string, until time.Time, limit int64) ([]model.GroupMembership, error)
+	ClaimMembershipTransition(context storage.TransactionContext, clientID string, membershipID string, dateEffective time.Time) (bool, error)
+	FindDigestMemberships(context storage.TransactionContext, clientID string) ([]model.GroupMembership, error)
+	UpdateMembershipsLastDigest(context storage.TransactionContext, membershipIDs []string, date time.Time) error
+	FindDigestPosts(context storage.TransactionContext, clientID string, groupID string, userID string, since time.Time, until time.Time) ([]model.Post, error)
+	CountDigestEvents(context storage.TransactionContext, clientID string, groupID string, userID string, since time.Time, until time.Time) (int64, error)
+
+	FindPublicFeedPosts(context storage.TransactionContext, clientID string, groupID string, limit int64) ([]model.Post, error)
+
+	UpdateGroupRules(context storage.TransactionContext, clientID string, groupID string, rules *model.GroupRules) error
+	UpdateMembershipRulesAcknowledgement(context storage.TransactionContext, clientID string, groupID string, userID string, version int, dateAcknowledged time.Time) error
 
 	FindEventUserIDs(context storage.TransactionContext, eventID string) ([]string, error)
 	FindGroupMembershipStatusAndGroupTitle(context storage.TransactionContext, userID string) ([]model.GetGroupMembershipsResponse, error)
+	FindUsersGroupMemberships(context storage.TransactionContext, userIDs []string) ([]model.UserGroupMembership, error)
 	FindGroupMembershipByGroupID(context storage.TransactionContext, groupID string) ([]string, error)
 
 	FindGroupsEvents(context storage.TransactionContext, eventIDs []string) ([]model.GetGroupsEvents, error)
@@ -450,20 +1254,61 @@ type Storage interface {
 	ReportPostAsAbuse(clientID string, userID string, group *model.Group, post *model.Post) error
 
 	FindPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) ([]model.Post, error)
+	CountPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) (int64, error)
 	FindPost(context storage.TransactionContext, clientID string, userID *string, groupID string, postID string, skipMembershipCheck bool, filterByToMembers bool) (*model.Post, error)
 	FindPostsByParentID(context storage.TransactionContext, clientID string, userID *string, groupID string, parentID string, skipMembershipCheck bool, filterByToMembers bool, recursive bool, order *string) ([]model.Post, error)
 
-	CreatePost(clientID string, current *model.User, post *model.Post) (*model.Post, error)
+	CreatePost(context storage.TransactionContext, clientID string, current *model.User, post *model.Post) (*model.Post, error)
 	UpdatePost(clientID string, userID string, post *model.Post) (*model.Post, error)
 	ReactToPost(context storage.TransactionContext, userID string, postID string, reaction string, on bool) error
 	DeletePost(ctx storage.TransactionContext, clientID string, userID string, groupID string, postID string, force bool) error
+	CountPinnedPosts(context storage.TransactionContext, clientID string, groupID string) (int64, error)
+	UpdatePostPinned(context storage.TransactionContext, clientID string, groupID string, postID string, pinned bool) (bool, error)
+	UpdatePostToMembers(context storage.TransactionContext, clientID string, groupID string, postID string, toMembers []model.ToMember) (bool, error)
+	FindPostRevisions(context storage.TransactionContext, clientID string, postID string) ([]model.PostRevision, error)
+	FindDraftPosts(context storage.TransactionContext, clientID string, groupID string, userID string) ([]model.Post, error)
+	PublishDraftPost(context storage.TransactionContext, clientID string, userID string, post *model.Post) (bool, error)
+
+	FindPendingApprovalPosts(context storage.TransactionContext, clientID string, groupID string) ([]model.Post, error)
+	ApprovePendingPost(context storage.TransactionContext, clientID string, post *model.Post) (bool, error)
+	RejectPendingPost(context storage.TransactionContext, clientID string, groupID string, postID string, reason string) (bool, error)
+
+	CreateAbuseReport(context storage.TransactionContext, report model.AbuseReport) error
+	FindAbuseReports(context storage.TransactionContext, clientID string, filter model.AbuseReportsFilter) ([]model.AbuseReport, error)
+	FindAbuseReport(context storage.TransactionContext, clientID string, id string) (*model.AbuseReport, error)
+	UpdateAbuseReportStatus(context storage.TransactionContext, clientID string, id string, status string, action string, moderatorID string, moderatorComment string) (bool, error)
+
+	CountUsage(context storage.TransactionContext, clientID string, from time.Time, to time.Time) (*model.UsageRecord, error)
+	SaveUsageRecord(context storage.TransactionContext, record model.UsageRecord) error
+	FindUsageRecord(context storage.TransactionContext, clientID string, period string) (*model.UsageRecord, error)
+	FindUsageRecords(context storage.TransactionContext, clientID string, fromPeriod *string, toPeriod *string) ([]model.UsageRecord, error)
+
+	SaveGroupReadCursor(context storage.TransactionContext, clientID string, groupID string, userID string, dateRead time.Time) error
+	FindGroupReadCursors(context storage.TransactionContext, clientID string, userID string) ([]model.GroupReadCursor, error)
+	CountUnreadPosts(context storage.TransactionContext, clientID string, userID string, since map[string]time.Time) ([]model.GroupUnreadCount, error)
+
+	SavePostBookmark(context storage.TransactionContext, bookmark model.PostBookmark) (*model.PostBookmark, error)
+	DeletePostBookmark(context storage.TransactionContext, clientID string, userID string, postID string) error
+	FindPostBookmarks(context storage.TransactionContext, clientID string, userID string, offset *int64, limit *int64) ([]model.PostBookmark, error)
+	FindVisiblePosts(context storage.TransactionContext, clientID string, userID string, groupIDs []string, postIDs []string) ([]model.Post, error)
+
+	RecordPostNotificationBurst(context storage.TransactionContext, clientID string, groupID string, creatorID string, now time.Time, window time.Duration) (bool, *model.PostNotificationBurst, error)
+	ClaimEndedPostNotificationBurst(context storage.TransactionContext, now time.Time) (*model.PostNotificationBurst, error)
+
+	ExportDocuments(collection string, updatedSince *time.Time, updatedBefore time.Time, afterID string, limit int64) ([][]byte, string, error)
+	ImportDocuments(collection string, documents [][]byte) (int64, error)
+	RestorePost(ctx storage.TransactionContext, clientID string, groupID string, postID string) (int64, error)
 	DeletePostsByAccountsIDs(log *logs.Logger, context storage.TransactionContext, accountsIDs []string) error
 	PullMembersFromPostsByUserIDs(log *logs.Logger, context storage.TransactionContext, accountsIDs []string) error
 
-	FindScheduledPosts(context storage.TransactionContext) ([]model.Post, error)
-	UpdateDateNotifiedForPostIDs(context storage.TransactionContext, ids []string, dateNotified time.Time) error
+	ClaimScheduledPost(context storage.TransactionContext, claimToken string, leaseExpiry time.Time) (*model.Post, error)
+	UpdateClaimedPostNotified(context storage.TransactionContext, id string, claimToken string, dateNotified time.Time) (bool, error)
+	UpdatePostNotificationDeferred(context storage.TransactionContext, id string, claimToken string, deferUntil time.Time) error
+	UpdateClaimedPostNotificationFailed(context storage.TransactionContext, id string, claimToken string, dateFailed time.Time) error
 
 	FindAuthmanGroups(clientID string) ([]model.Group, error)
+	FindSmartGroups(context storage.TransactionContext, clientID string) ([]model.Group, error)
+	UpdateGroupSmartRules(context storage.TransactionContext, clientID string, groupID string, rules *model.SmartGroupRules) error
 	FindAuthmanGroupByKey(clientID string, authmanGroupKey string) (*model.Group, error)
 
 	LoadManagedGroupConfigs() ([]model.ManagedGroupConfig, error)
@@ -477,25 +1322,27 @@ type Storage interface {
 	FindGroupsV3(context storage.TransactionContext, clientID string, filter model.GroupsFilter) ([]model.Group, error)
 	FindGroupMemberships(clientID string, filter model.MembershipFilter) (model.MembershipCollection, error)
 	FindGroupMembershipsWithContext(context storage.TransactionContext, clientID string, filter model.MembershipFilter) (model.MembershipCollection, error)
+	CountGroupMemberships(context storage.TransactionContext, clientID string, filter model.MembershipFilter) (int64, error)
 
 	FindGroupMembership(clientID string, groupID string, userID string) (*model.GroupMembership, error)
 	FindGroupMembershipWithContext(context storage.TransactionContext, clientID string, groupID string, userID string) (*model.GroupMembership, error)
 	FindGroupMembershipByID(clientID string, id string) (*model.GroupMembership, error)
 	FindUserGroupMemberships(clientID string, userID string) (model.MembershipCollection, error)
 	FindUserGroupMembershipsWithContext(ctx storage.TransactionContext, clientID string, userID string) (model.MembershipCollection, error)
-	BulkUpdateGroupMembershipsByExternalID(clientID string, groupID string, saveOperations []storage.SingleMembershipOperation, updateGroupStats bool) error
+	BulkUpdateGroupMembershipsByExternalID(clientID string, groupID string, saveOperations []storage.SingleMembershipOperation, updateGroupStats bool) ([]string, error)
 	SaveGroupMembershipByExternalID(clientID string, groupID string, externalID string, userID *string, status *string,
 		email *string, name *string, memberAnswers []model.MemberAnswer, syncID *string, updateGroupStats bool) (*model.GroupMembership, error)
 
-	CreateMembership(clientID string, current *model.User, group *model.Group, member *model.GroupMembership) error
+	CreateMembership(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, member *model.GroupMembership) error
 	CreateMemberships(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, memberships []model.GroupMembership) error
-	CreatePendingMembership(clientID string, current *model.User, group *model.Group, member *model.GroupMembership) error
-	ApplyMembershipApproval(clientID string, membershipID string, approve bool, rejectReason string) (*model.GroupMembership, error)
-	UpdateMembership(clientID string, _ *model.User, membershipID string, membership *model.GroupMembership) error
-	UpdateMemberships(clientID string, user *model.User, groupID string, operation model.MembershipMultiUpdate) error
-	DeleteMembership(clientID string, groupID string, userID string) error
-	DeleteMembershipByID(clientID string, current *model.User, membershipID string) error
-	DeleteUnsyncedGroupMemberships(clientID string, groupID string, syncID string) (int64, error)
+	CreatePendingMembership(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, member *model.GroupMembership) error
+	ApplyMembershipApproval(context storage.TransactionContext, clientID string, membershipID string, approve bool, rejection *model.MembershipRejection) (*model.GroupMembership, error)
+	UpdateMembership(context storage.TransactionContext, clientID string, _ *model.User, membershipID string, membership *model.GroupMembership) error
+	UpdateMemberships(context storage.TransactionContext, clientID string, user *model.User, groupID string, operation model.MembershipMultiUpdate) error
+	DeleteMembership(context storage.TransactionContext, clientID string, groupID string, userID string) error
+	DeleteMembershipByID(context storage.TransactionContext, clientID string, current *model.User, membershipID string) error
+	DeleteUnsyncedGroupMemberships(context storage.TransactionContext, clientID string, groupID string, syncID string) (int64, error)
+	DeleteGroupMembershipsByExternalIDs(context storage.TransactionContext, clientID string, groupID string, externalIDs []string) (int64, error)
 	DeleteGroupMembershipsByAccountsIDs(log *logs.Logger, context storage.TransactionContext, accountsIDs []string) error
 
 	GetGroupMembershipStats(context storage.TransactionContext, clientID string, groupID string) (*model.GroupStats, error)
@@ -508,6 +1355,80 @@ type Storage interface {
 	AnalyticsFindGroups(startDate *time.Time, endDate *time.Time) ([]model.Group, error)
 	AnalyticsFindPosts(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error)
 	AnalyticsFindMembers(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error)
+	FindGroupWeeklyPostCounts(context storage.TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error)
+	FindGroupWeeklyNewMemberCounts(context storage.TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error)
+	FindGroupWeeklyEventCounts(context storage.TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error)
+	CountGroupEvents(context storage.TransactionContext, clientID string, groupID string) (int64, error)
+	CountGroupActiveMembers(context storage.TransactionContext, clientID string, groupID string, since time.Time) (int64, error)
+	CountGroupRejectionsByReason(context storage.TransactionContext, clientID string, groupID string) (map[string]int64, error)
+
+	// Admin feed
+	CountPendingMembershipsByGroup(context storage.TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error)
+	CountPendingPostsByGroup(context storage.TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error)
+	CountOpenAbuseReportsByGroup(context storage.TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error)
+	FindFailedGroupSyncs(context storage.TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error)
+
+	// User Notifications Inbox
+	InsertUserNotifications(context storage.TransactionContext, items []model.UserNotification) error
+	FindUserNotifications(context storage.TransactionContext, appID string, orgID string, userID string, filter model.UserNotificationsFilter) ([]model.UserNotification, error)
+	CountUnreadUserNotifications(context storage.TransactionContext, appID string, orgID string, userID string) (int64, error)
+	UpdateUserNotificationsRead(context storage.TransactionContext, appID string, orgID string, userID string, ids []string, read bool) (int64, error)
+
+	// Audit
+	InsertAuditLog(context storage.TransactionContext, item model.AuditLog) error
+	FindAuditLogs(context storage.TransactionContext, clientID string, groupID string, filter model.AuditLogFilter) ([]model.AuditLog, error)
+
+	FindPostsWithReactions(context storage.TransactionContext, clientID string, groupID string, afterID *string, limit int64) ([]model.Post, error)
+	FindGroupIDsWithPostReactions(context storage.TransactionContext, clientID string) ([]string, error)
+	UpdateGroupReactionsMigrated(context storage.TransactionContext, clientID string, groupID string, migrated bool) error
+	InsertReactionMigration(context storage.TransactionContext, migration model.ReactionMigration) error
+	UpdateReactionMigration(context storage.TransactionContext, migration model.ReactionMigration) error
+	FindReactionMigrations(context storage.TransactionContext, clientID string, limit int64) ([]model.ReactionMigration, error)
+	FindReactionMigration(context storage.TransactionContext, clientID string, id string) (*model.ReactionMigration, error)
+
+	UpdateGroupAuthmanSyncWatermark(context storage.TransactionContext, clientID string, groupID string, watermark *time.Time) error
+
+	FindMembershipPruningCandidates(context storage.TransactionContext, clientID string, afterID string, limit int64) ([]model.GroupMembership, error)
+	FindExpiredPendingMemberships(context storage.TransactionContext, clientID string, createdBefore time.Time, afterID string, limit int64) ([]model.GroupMembership, error)
+	FindPendingMembershipsSummaries(context storage.TransactionContext, clientID string, createdBefore time.Time) ([]model.PendingMembershipsSummary, error)
+	UpdateGroupPendingReminderSent(context storage.TransactionContext, clientID string, groupID string, date time.Time) error
+	UpdateMembershipsStaleFlag(context storage.TransactionContext, clientID string, ids []string, dateFlagged *time.Time) error
+	DeleteStaleGroupMemberships(context storage.TransactionContext, clientID string, groupID string, ids []string) (int64, error)
+
+	FindDuplicateMemberships(context storage.TransactionContext, clientID string) ([]model.DuplicateMemberships, error)
+	MergeDuplicateMemberships(context storage.TransactionContext, clientID string, groupID string, userID string) (int64, error)
+	ApplyUniqueMembershipIndex() error
+
+	InsertAuthmanSyncRun(context storage.TransactionContext, run model.AuthmanSyncRun) error
+	UpdateAuthmanSyncRun(context storage.TransactionContext, run model.AuthmanSyncRun) error
+	FindAuthmanSyncRuns(context storage.TransactionContext, clientID string, filter model.AuthmanSyncRunFilter) ([]model.AuthmanSyncRun, error)
+
+	// Webhooks
+	InsertWebhookSubscription(context storage.TransactionContext, subscription model.WebhookSubscription) error
+	FindWebhookSubscriptions(context storage.TransactionContext, clientID string, activeOnly bool) ([]model.WebhookSubscription, error)
+	FindWebhookSubscription(context storage.TransactionContext, clientID string, id string) (*model.WebhookSubscription, error)
+	UpdateWebhookSubscriptionActive(context storage.TransactionContext, clientID string, id string, active bool) (int64, error)
+	UpdateWebhookSubscriptionSecret(context storage.TransactionContext, clientID string, id string, secret string, previousSecret string, previousSecretExpires *time.Time) (int64, error)
+	InsertWebhookDeliveries(context storage.TransactionContext, deliveries []model.WebhookDelivery) error
+	ClaimWebhookDelivery(context storage.TransactionContext, claimToken string, leaseExpiry time.Time) (*model.WebhookDelivery, error)
+	FindWebhookDeliveries(context storage.TransactionContext, clientID string, subscriptionID string, offset *int64, limit *int64) ([]model.WebhookDelivery, error)
+	UpdateWebhookDelivery(context storage.TransactionContext, delivery model.WebhookDelivery, claimToken string) (bool, error)
+
+	// Domain events outbox
+	InsertOutboxEvent(context storage.TransactionContext, event model.OutboxEvent) error
+	FindPendingOutboxEvents(context storage.TransactionContext, limit int64) ([]model.OutboxEvent, error)
+	UpdateOutboxEvent(context storage.TransactionContext, event model.OutboxEvent) error
+
+	// Reactions rate limiting
+	InsertReactionEvent(context storage.TransactionContext, event model.ReactionEvent) error
+	CountReactionEvents(context storage.TransactionContext, clientID string, userID string, postID *string, reaction *string, since time.Time) (int64, error)
+	InsertReactionSuspension(context storage.TransactionContext, suspension model.ReactionSuspension) error
+	FindActiveReactionSuspension(context storage.TransactionContext, clientID string, userID string) (*model.ReactionSuspension, error)
+
+	// Content rate limiting
+	InsertContentEvent(context storage.TransactionContext, event model.ContentEvent) error
+	CountContentEvents(context storage.TransactionContext, clientID string, userID string, groupID string, action string, since time.Time) (int64, error)
+	FindOldestContentEvent(context storage.TransactionContext, clientID string, userID string, groupID string, action string, since time.Time) (*model.ContentEvent, error)
 }
 
 type storageListenerImpl struct {
@@ -519,6 +1440,10 @@ func (a *storageListenerImpl) OnConfigsChanged() {
 	a.app.setupCronTimer()
 }
 
+func (a *storageListenerImpl) OnGroupMessageCreated(message model.GroupMessage) {
+	a.app.groupMessageSubscribers.publish(message)
+}
+
 // Notifications exposes Notifications BB APIs for the driver adapters
 type Notifications interface {
 	SendNotification(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string, dateScheduled *time.Time) error
@@ -531,6 +1456,7 @@ type Notifications interface {
 // Authman exposes Authman APIs for the driver adapters
 type Authman interface {
 	RetrieveAuthmanGroupMembers(groupName string) ([]string, error)
+	RetrieveAuthmanGroupMemberChanges(groupName string, since time.Time) (*model.AuthmanMemberChanges, error)
 	RetrieveAuthmanUsers(externalIDs []string) (map[string]model.AuthmanSubject, error)
 	RetrieveAuthmanStemGroups(stemName string) (*model.АuthmanGroupsResponse, error)
 	AddAuthmanMemberToGroup(groupName string, uin string) error
@@ -550,16 +1476,56 @@ type Core interface {
 	RetrieveFerpaAccounts(ids []string) ([]string, error)
 }
 
+// Moderation is used by core to check the content of the posts with the external moderation API
+type Moderation interface {
+	CheckContent(text string) ([]string, error)
+}
+
+// MessageBus is used by core to publish the domain events to the message bus of the campus integrations
+type MessageBus interface {
+	Publish(event model.DomainEvent, payload []byte) error
+}
+
+// OrgHierarchy is used by core to validate and synchronize the org units of the groups with the campus organizational hierarchy
+type OrgHierarchy interface {
+	GetOrgUnit(code string) (*model.OrgUnit, error)
+}
+
+// ObjectStorage is used by core to store the backups in S3-compatible object storage
+type ObjectStorage interface {
+	PutObject(key string, data []byte, contentType string) error
+	GetObject(key string) ([]byte, error)
+	ListObjects(prefix string) ([]string, error)
+}
+
 // Rewards exposes Rewards internal APIs for giving rewards to the users
 type Rewards interface {
 	CreateUserReward(userID string, rewardType string, description string) error
 }
 
+// Webhooks exposes the webhook delivery for the driver adapters
+type Webhooks interface {
+	ValidateURL(url string) error
+	Deliver(url string, secrets []string, eventType string, payload []byte) (*int, error)
+}
+
 // Calendar exposes Calendar BB APIs for the driver adapters
 type Calendar interface {
 	CreateCalendarEvent(adminIdentifier []model.AccountIdentifiers, currentAccountIdentifier model.AccountIdentifiers, event map[string]interface{}, orgID string, appID string, groupIDs []string) (map[string]interface{}, error)
 	UpdateCalendarEvent(currentAccountIdentifier model.AccountIdentifiers, eventID string, event map[string]interface{}, orgID string, appID string) (map[string]interface{}, error)
+	DeleteCalendarEvent(currentAccountIdentifier model.AccountIdentifiers, eventID string, orgID string, appID string) error
 	GetGroupCalendarEvents(currentAccountIdentifier model.AccountIdentifiers, eventIDs []string, appID string, orgID string, published *bool, filter model.GroupEventFilter) (map[string]interface{}, error)
 	AddPeopleToCalendarEvent(people []string, eventID string, orgID string, appID string) error
 	RemovePeopleFromCalendarEvent(people []string, eventID string, orgID string, appID string) error
 }
+
+// Social exposes Social BB APIs for the driver adapters
+type Social interface {
+	ImportReactions(reactions []model.SocialReaction, appID string, orgID string) (int64, error)
+	CountGroupReactions(groupID string, appID string, orgID string) (int64, error)
+}
+
+// Polls exposes Polls BB APIs for the driver adapters
+type Polls interface {
+	GetPollResults(pollID string, appID string, orgID string) (*model.PollResults, error)
+}
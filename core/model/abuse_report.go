@@ -0,0 +1,99 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// AbuseReportStatusOpen the report waits for a review
+	AbuseReportStatusOpen = "open"
+	// AbuseReportStatusReviewed the report is seen by a moderator who has not taken an action yet
+	AbuseReportStatusReviewed = "reviewed"
+	// AbuseReportStatusResolved an action is taken on the report
+	AbuseReportStatusResolved = "resolved"
+
+	// AbuseReportTargetPost reported post
+	AbuseReportTargetPost = "post"
+	// AbuseReportTargetGroup reported group
+	AbuseReportTargetGroup = "group"
+
+	// AbuseReportActionReview marks the report as reviewed
+	AbuseReportActionReview = "review"
+	// AbuseReportActionDismiss resolves the report without any action
+	AbuseReportActionDismiss = "dismiss"
+	// AbuseReportActionRemovePost resolves the report by removing the reported post
+	AbuseReportActionRemovePost = "remove_post"
+	// AbuseReportActionWarnUser resolves the report by warning the author of the reported post
+	AbuseReportActionWarnUser = "warn_user"
+)
+
+// AbuseReport represents a report of an abusive post or group
+type AbuseReport struct {
+	ID         string `json:"id" bson:"_id"`
+	ClientID   string `json:"client_id" bson:"client_id"`
+	TargetType string `json:"target_type" bson:"target_type"` // post or group
+	GroupID    string `json:"group_id" bson:"group_id"`
+	GroupTitle string `json:"group_title" bson:"group_title"`
+	PostID     string `json:"post_id,omitempty" bson:"post_id,omitempty"`
+
+	ReportedUserID   string `json:"reported_user_id,omitempty" bson:"reported_user_id,omitempty"` // the author of the reported post
+	ReportedUserName string `json:"reported_user_name,omitempty" bson:"reported_user_name,omitempty"`
+
+	ReporterID        string `json:"reporter_id" bson:"reporter_id"`
+	ReporterName      string `json:"reporter_name" bson:"reporter_name"`
+	Comment           string `json:"comment" bson:"comment"`
+	SendToDean        bool   `json:"send_to_dean" bson:"send_to_dean"`
+	SendToGroupAdmins bool   `json:"send_to_group_admins" bson:"send_to_group_admins"`
+
+	Status           string  `json:"status" bson:"status"`
+	Action           *string `json:"action,omitempty" bson:"action,omitempty"` // the last moderation action
+	ModeratorID      *string `json:"moderator_id,omitempty" bson:"moderator_id,omitempty"`
+	ModeratorComment *string `json:"moderator_comment,omitempty" bson:"moderator_comment,omitempty"`
+
+	DateCreated  time.Time  `json:"date_created" bson:"date_created"`
+	DateUpdated  *time.Time `json:"date_updated" bson:"date_updated"`
+	DateResolved *time.Time `json:"date_resolved,omitempty" bson:"date_resolved,omitempty"`
+} // @name AbuseReport
+
+// IsResolved checks if an action is taken on the report
+func (r *AbuseReport) IsResolved() bool {
+	return r.Status == AbuseReportStatusResolved
+}
+
+// IsVisibleToGroupAdmins checks if the group admins could see and moderate the report. The reports of groups and the reports sent only
+// to the Dean of Students are moderated by the org moderators.
+func (r *AbuseReport) IsVisibleToGroupAdmins() bool {
+	return r.TargetType == AbuseReportTargetPost && r.SendToGroupAdmins
+}
+
+// AbuseReportsFilter Wraps all possible filters for getting abuse reports
+type AbuseReportsFilter struct {
+	GroupIDs          []string `json:"group_ids"`            // list of group ids
+	PostID            *string  `json:"post_id"`              // reported post id
+	TargetType        *string  `json:"target_type"`          // post or group
+	Statuses          []string `json:"statuses"`             // list of report statuses
+	SendToGroupAdmins *bool    `json:"send_to_group_admins"` // only the reports sent to the group admins
+	Offset            *int64   `json:"offset"`               // result offset
+	Limit             *int64   `json:"limit"`                // result limit
+} // @name AbuseReportsFilter
+
+// IsAbuseReportAction checks if the value is a valid moderation action
+func IsAbuseReportAction(action string) bool {
+	switch action {
+	case AbuseReportActionReview, AbuseReportActionDismiss, AbuseReportActionRemovePost, AbuseReportActionWarnUser:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// AccountLinkResult represents the data added by the external ID or the NetID before the first login, which has been linked to the Core BB account
+type AccountLinkResult struct {
+	Memberships int64 `json:"memberships"` // the memberships claimed by the account
+	Posts       int64 `json:"posts"`       // the posts addressed to the account
+	Events      int64 `json:"events"`      // the events addressed to the account
+} // @name AccountLinkResult
+
+// IsEmpty checks if nothing has been linked
+func (r AccountLinkResult) IsEmpty() bool {
+	return r.Memberships == 0 && r.Posts == 0 && r.Events == 0
+}
+
+// Add adds the counts of the other result
+func (r *AccountLinkResult) Add(other AccountLinkResult) {
+	r.Memberships += other.Memberships
+	r.Posts += other.Posts
+	r.Events += other.Events
+}
+
+// AccountLinkRepairResult represents the outcome of the repair of the missed account links
+type AccountLinkRepairResult struct {
+	ExternalIDs  int `json:"external_ids"`  // the unlinked external IDs checked
+	LinkedUsers  int `json:"linked_users"`  // the Core BB accounts which got linked data
+	MissingUsers int `json:"missing_users"` // the external IDs without a Core BB account yet
+	Failed       int `json:"failed"`        // the accounts whose linking failed
+
+	AccountLinkResult
+} // @name AccountLinkRepairResult
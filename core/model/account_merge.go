@@ -0,0 +1,54 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// AccountMerge represents a merge of two Core BB accounts. The data of the old account is moved to the surviving one.
+type AccountMerge struct {
+	OldUserID string `json:"old_user_id" validate:"required"`
+	NewUserID string `json:"new_user_id" validate:"required,nefield=OldUserID"`
+} // @name AccountMerge
+
+// AccountMergeResult represents the counts of the entities moved by an account merge
+type AccountMergeResult struct {
+	MembershipsMoved  int64 `json:"memberships_moved"`
+	MembershipsMerged int64 `json:"memberships_merged"` // the surviving account was already a member, the highest status is kept
+	Posts             int64 `json:"posts"`
+	PostsToMembers    int64 `json:"posts_to_members"`
+	PostsReactions    int64 `json:"posts_reactions"`
+	Events            int64 `json:"events"`
+	EventsToMembers   int64 `json:"events_to_members"`
+	GroupsCreated     int64 `json:"groups_created"`
+	UserNotifications int64 `json:"user_notifications"`
+	Bans              int64 `json:"bans"`              // the bans of the old account apply to the surviving account
+	ReadCursors       int64 `json:"read_cursors"`      // the later read date is kept when both accounts have read the group
+	PostBookmarks     int64 `json:"post_bookmarks"`    // the bookmarks of the surviving account are kept
+	EventRSVPs        int64 `json:"event_rsvps"`       // the response of the surviving account is kept
+	EventAttendances  int64 `json:"event_attendances"` // the attendance of the surviving account is kept
+} // @name AccountMergeResult
+
+// MembershipStatusRank gives the rank of the membership status. The higher rank wins when two memberships are merged.
+func MembershipStatusRank(status string) int {
+	switch status {
+	case "admin":
+		return 4
+	case "member":
+		return 3
+	case "pending":
+		return 2
+	case "rejected":
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,77 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// AdminFeedItemTypePendingMemberships membership requests waiting for an approval
+	AdminFeedItemTypePendingMemberships = "pending_memberships"
+	// AdminFeedItemTypePendingPosts posts held for an approval
+	AdminFeedItemTypePendingPosts = "pending_posts"
+	// AdminFeedItemTypeAbuseReports unresolved abuse reports sent to the group admins
+	AdminFeedItemTypeAbuseReports = "abuse_reports"
+	// AdminFeedItemTypeFailedSync the last Authman sync of the group has failed
+	AdminFeedItemTypeFailedSync = "failed_sync"
+)
+
+// AdminFeed represents the things needing attention across all the groups the user administers
+type AdminFeed struct {
+	Counts AdminFeedCounts `json:"counts"`
+	Items  []AdminFeedItem `json:"items"` // newest first
+} // @name AdminFeed
+
+// AdminFeedCounts represents the totals of the admin feed by type
+type AdminFeedCounts struct {
+	PendingMemberships int64 `json:"pending_memberships"`
+	PendingPosts       int64 `json:"pending_posts"`
+	AbuseReports       int64 `json:"abuse_reports"`
+	FailedSyncs        int64 `json:"failed_syncs"`
+} // @name AdminFeedCounts
+
+// AdminFeedItem represents the things of a single type needing attention within a group. DeepLink is the path of the API which lists them.
+type AdminFeedItem struct {
+	Type       string    `json:"type"`
+	GroupID    string    `json:"group_id"`
+	GroupTitle string    `json:"group_title"`
+	Count      int64     `json:"count"`
+	DateLatest time.Time `json:"date_latest"`
+	DeepLink   string    `json:"deep_link"`
+} // @name AdminFeedItem
+
+// AdminFeedGroupCount represents the count and the latest date of the things needing attention within a group
+type AdminFeedGroupCount struct {
+	GroupID    string    `json:"group_id" bson:"_id"`
+	Count      int64     `json:"count" bson:"count"`
+	DateLatest time.Time `json:"date_latest" bson:"date_latest"`
+} // @name AdminFeedGroupCount
+
+// AdminFeedDeepLink gives the path of the API which lists the things of the item type within the group
+func AdminFeedDeepLink(itemType string, groupID string) string {
+	switch itemType {
+	case AdminFeedItemTypePendingMemberships:
+		return fmt.Sprintf("/api/group/%s/members", groupID)
+	case AdminFeedItemTypePendingPosts:
+		return fmt.Sprintf("/api/group/%s/posts/pending", groupID)
+	case AdminFeedItemTypeAbuseReports:
+		return fmt.Sprintf("/api/group/%s/abuse-reports?status=open,reviewed", groupID)
+	case AdminFeedItemTypeFailedSync:
+		return fmt.Sprintf("/api/group/%s/authman/sync-status", groupID)
+	}
+	return ""
+}
@@ -0,0 +1,181 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/json"
+	"reflect"
+	"time"
+)
+
+const (
+	// AuditActionGroupUpdated group update
+	AuditActionGroupUpdated = "group.updated"
+	// AuditActionGroupDeleted group deletion
+	AuditActionGroupDeleted = "group.deleted"
+	// AuditActionGroupRestored deleted group restored by an admin within the restore period
+	AuditActionGroupRestored = "group.restored"
+	// AuditActionMembershipApproved membership approval
+	AuditActionMembershipApproved = "membership.approved"
+	// AuditActionMembershipRejected membership rejection
+	AuditActionMembershipRejected = "membership.rejected"
+	// AuditActionMembershipExpired pending membership rejected by the expiration policy
+	AuditActionMembershipExpired = "membership.expired"
+	// AuditActionMembershipUpdated single membership update
+	AuditActionMembershipUpdated = "membership.updated"
+	// AuditActionMembershipsUpdated multi membership update
+	AuditActionMembershipsUpdated = "memberships.updated"
+	// AuditActionMembershipsAdded memberships added by an admin
+	AuditActionMembershipsAdded = "memberships.added"
+	// AuditActionMembershipsDeleted memberships deleted by an admin
+	AuditActionMembershipsDeleted = "memberships.deleted"
+	// AuditActionPostDeleted post deleted by an admin, the creators deleting their own posts are not audited
+	AuditActionPostDeleted = "post.deleted"
+	// AuditActionPostRestored post restoration from soft delete
+	AuditActionPostRestored = "post.restored"
+	// AuditActionPostPinned post pinned by an admin
+	AuditActionPostPinned = "post.pinned"
+	// AuditActionPostUnpinned post unpinned by an admin
+	AuditActionPostUnpinned = "post.unpinned"
+	// AuditActionPostApproved post of a pre-moderated group approved by an admin
+	AuditActionPostApproved = "post.approved"
+	// AuditActionPostRejected post of a pre-moderated group rejected by an admin
+	AuditActionPostRejected = "post.rejected"
+	// AuditActionAbuseReportModerated abuse report reviewed or resolved by a moderator
+	AuditActionAbuseReportModerated = "abuse_report.moderated"
+	// AuditActionAuthmanSync Authman synchronization of the group memberships
+	AuditActionAuthmanSync = "authman.synchronized"
+	// AuditActionSmartGroupRulesUpdated smart group rules updated by an admin
+	AuditActionSmartGroupRulesUpdated = "smart_group.rules_updated"
+	// AuditActionSmartGroupSync smart group synchronization of the group memberships
+	AuditActionSmartGroupSync = "smart_group.synchronized"
+	// AuditActionMembershipsPruned stale memberships removed by the pruning policy
+	AuditActionMembershipsPruned = "memberships.pruned"
+	// AuditActionMembershipsMerged duplicate memberships of a user merged by the dedup job
+	AuditActionMembershipsMerged = "memberships.merged"
+	// AuditActionNotificationsUnsubscribed notifications muted through an unsubscribe link
+	AuditActionNotificationsUnsubscribed = "notifications.unsubscribed"
+	// AuditActionSupportRead group content viewed by the support staff without membership
+	AuditActionSupportRead = "support.read"
+	// AuditActionAccountLinked data added by the external ID linked to the Core BB account of the user
+	AuditActionAccountLinked = "account.linked"
+	// AuditActionGroupOwnershipTransferred group admin role handed over to a member
+	AuditActionGroupOwnershipTransferred = "group.ownership_transferred"
+	// AuditActionMemberBanned user banned from the group by an admin
+	AuditActionMemberBanned = "member.banned"
+	// AuditActionMemberUnbanned ban of the user lifted by an admin
+	AuditActionMemberUnbanned = "member.unbanned"
+	// AuditActionMemberMuted member muted by an admin, they cannot post nor reply until the mute ends
+	AuditActionMemberMuted = "member.muted"
+	// AuditActionEventFeatured event featured by an admin
+	AuditActionEventFeatured = "event.featured"
+	// AuditActionEventUnfeatured event no longer featured
+	AuditActionEventUnfeatured = "event.unfeatured"
+	// AuditActionWebhookSecretRotated webhook subscription secret rotated by an admin
+	AuditActionWebhookSecretRotated = "webhook.secret_rotated"
+	// AuditActionCalendarFeedRotated calendar feed token of a member rotated
+	AuditActionCalendarFeedRotated = "calendar_feed.rotated"
+
+	// AuditActorTypeUser action performed by a user
+	AuditActorTypeUser = "user"
+	// AuditActorTypeSystem action performed by the system (eg. scheduled task)
+	AuditActorTypeSystem = "system"
+)
+
+// AuditLog represents a record of a single privileged mutation
+type AuditLog struct {
+	ID          string                 `json:"id" bson:"_id"`
+	ClientID    string                 `json:"client_id" bson:"client_id"`
+	GroupID     string                 `json:"group_id" bson:"group_id"`
+	Action      string                 `json:"action" bson:"action"`
+	TargetType  string                 `json:"target_type" bson:"target_type"` // group, membership or post
+	TargetID    string                 `json:"target_id" bson:"target_id"`
+	Actor       AuditActor             `json:"actor" bson:"actor"`
+	Diff        map[string]AuditChange `json:"diff,omitempty" bson:"diff,omitempty"`
+	DateCreated time.Time              `json:"date_created" bson:"date_created"`
+} // @name AuditLog
+
+// AuditActor represents the initiator of the audited action
+type AuditActor struct {
+	Type   string `json:"type" bson:"type"` // user or system
+	UserID string `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	Name   string `json:"name,omitempty" bson:"name,omitempty"`
+	Email  string `json:"email,omitempty" bson:"email,omitempty"`
+} // @name AuditActor
+
+// AuditChange represents a single field change
+type AuditChange struct {
+	Old interface{} `json:"old" bson:"old"`
+	New interface{} `json:"new" bson:"new"`
+} // @name AuditChange
+
+// AuditLogFilter Wraps all possible filters for getting the audit logs
+type AuditLogFilter struct {
+	Actions []string `json:"actions"`
+	Offset  *int64   `json:"offset"`
+	Limit   *int64   `json:"limit"`
+} // @name AuditLogFilter
+
+// NewAuditActor constructs the actor from the current user. nil user means system actor.
+func NewAuditActor(current *User) AuditActor {
+	if current == nil {
+		return AuditActor{Type: AuditActorTypeSystem}
+	}
+	return AuditActor{
+		Type:   AuditActorTypeUser,
+		UserID: current.ID,
+		Name:   current.Name,
+		Email:  current.Email,
+	}
+}
+
+// NewAuditDiff Builds field level diff between two entities based on their json representation. The ignored fields are skipped.
+func NewAuditDiff(before interface{}, after interface{}, ignoredFields ...string) map[string]AuditChange {
+	beforeMap := toAuditMap(before)
+	afterMap := toAuditMap(after)
+	for _, field := range ignoredFields {
+		delete(beforeMap, field)
+		delete(afterMap, field)
+	}
+
+	diff := map[string]AuditChange{}
+	for key, oldValue := range beforeMap {
+		newValue := afterMap[key]
+		if !reflect.DeepEqual(oldValue, newValue) {
+			diff[key] = AuditChange{Old: oldValue, New: newValue}
+		}
+	}
+	for key, newValue := range afterMap {
+		if _, ok := beforeMap[key]; !ok && newValue != nil {
+			diff[key] = AuditChange{Old: nil, New: newValue}
+		}
+	}
+
+	return diff
+}
+
+func toAuditMap(entity interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	if entity == nil {
+		return result
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return result
+	}
+	json.Unmarshal(data, &result)
+	return result
+}
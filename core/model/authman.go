@@ -14,7 +14,10 @@
 
 package model
 
-import "strings"
+import (
+	"strings"
+	"time"
+)
 
 // AuthmanSubject contains user name and user email
 type AuthmanSubject struct {
@@ -59,6 +62,47 @@ type AuthmanGroupResponse struct {
 	} `json:"WsGetMembersLiteResult"`
 }
 
+// AuthmanMemberChangesResponse Authman group member changes response wrapper
+type AuthmanMemberChangesResponse struct {
+	WsGetMemberChangesResult struct {
+		ResultMetadata struct {
+			Success       string `json:"success"`
+			ResultCode    string `json:"resultCode"`
+			ResultMessage string `json:"resultMessage"`
+		} `json:"resultMetadata"`
+		AsOf            string                 `json:"asOf"` // RFC 3339 time the changes are complete up to
+		AddedSubjects   []AuthmanChangeSubject `json:"addedSubjects"`
+		RemovedSubjects []AuthmanChangeSubject `json:"removedSubjects"`
+	} `json:"WsGetMemberChangesResult"`
+}
+
+// AuthmanChangeSubject represents a subject added to or removed from an Authman group
+type AuthmanChangeSubject struct {
+	SourceID string `json:"sourceId"`
+	ID       string `json:"id"`
+}
+
+// AuthmanMemberChanges represents the members added to and removed from an Authman group since a sync watermark
+type AuthmanMemberChanges struct {
+	Added   []string
+	Removed []string
+	AsOf    time.Time // the next sync watermark
+}
+
+// HasConflicts checks if a subject is both added and removed. The order of the changes is unknown, so the final membership cannot be determined.
+func (c *AuthmanMemberChanges) HasConflicts() bool {
+	added := map[string]bool{}
+	for _, externalID := range c.Added {
+		added[externalID] = true
+	}
+	for _, externalID := range c.Removed {
+		if added[externalID] {
+			return true
+		}
+	}
+	return false
+}
+
 // АuthmanUserRequest Authman user request wrapper
 type АuthmanUserRequest struct {
 	WsRestGetSubjectsRequest АuthmanUserData `json:"WsRestGetSubjectsRequest"`
@@ -0,0 +1,90 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// AuthmanSyncRunTypeGlobal run of the global Authman sync of a client
+	AuthmanSyncRunTypeGlobal = "global"
+	// AuthmanSyncRunTypeGroup run of the Authman sync of a single group
+	AuthmanSyncRunTypeGroup = "group"
+
+	// AuthmanSyncModeFull all members of the Authman group are retrieved
+	AuthmanSyncModeFull = "full"
+	// AuthmanSyncModeDelta only the member changes since the group watermark are retrieved
+	AuthmanSyncModeDelta = "delta"
+
+	// AuthmanSyncRunStatusRunning the run is in progress
+	AuthmanSyncRunStatusRunning = "running"
+	// AuthmanSyncRunStatusSucceeded the run finished without errors
+	AuthmanSyncRunStatusSucceeded = "succeeded"
+	// AuthmanSyncRunStatusFailed the run finished with errors
+	AuthmanSyncRunStatusFailed = "failed"
+
+	// maxAuthmanSyncRunErrors limits the error details stored per run
+	maxAuthmanSyncRunErrors = 50
+)
+
+// AuthmanSyncRun represents the record of a single Authman sync run
+type AuthmanSyncRun struct {
+	ID           string                 `json:"id" bson:"_id"`
+	ClientID     string                 `json:"client_id" bson:"client_id"`
+	Type         string                 `json:"type" bson:"type"` // global or group
+	GroupID      string                 `json:"group_id,omitempty" bson:"group_id,omitempty"`
+	AuthmanGroup string                 `json:"authman_group,omitempty" bson:"authman_group,omitempty"`
+	Keys         []AuthmanSyncKeyResult `json:"keys,omitempty" bson:"keys,omitempty"` // per Authman group key results of the full group runs
+	Mode         string                 `json:"mode,omitempty" bson:"mode,omitempty"` // full or delta, for the group runs
+	Status       string                 `json:"status" bson:"status"`                 // running, succeeded or failed
+	Added        int64                  `json:"added" bson:"added"`
+	Removed      int64                  `json:"removed" bson:"removed"`
+	Failed       int64                  `json:"failed" bson:"failed"`
+	Errors       []string               `json:"errors,omitempty" bson:"errors,omitempty"`
+	DateStarted  time.Time              `json:"date_started" bson:"date_started"`
+	DateEnded    *time.Time             `json:"date_ended" bson:"date_ended"`
+} // @name AuthmanSyncRun
+
+// AuthmanSyncKeyResult represents the result of retrieving the members of a single Authman group key
+type AuthmanSyncKeyResult struct {
+	Key     string  `json:"key" bson:"key"`
+	Members int     `json:"members" bson:"members"`
+	Error   *string `json:"error,omitempty" bson:"error,omitempty"`
+} // @name AuthmanSyncKeyResult
+
+// AddError records an error detail of the run
+func (r *AuthmanSyncRun) AddError(err error) {
+	if err == nil || len(r.Errors) >= maxAuthmanSyncRunErrors {
+		return
+	}
+	r.Errors = append(r.Errors, err.Error())
+}
+
+// Finish sets the end date and the final status of the run
+func (r *AuthmanSyncRun) Finish() {
+	now := time.Now()
+	r.DateEnded = &now
+	if len(r.Errors) > 0 || r.Failed > 0 {
+		r.Status = AuthmanSyncRunStatusFailed
+	} else {
+		r.Status = AuthmanSyncRunStatusSucceeded
+	}
+}
+
+// AuthmanSyncRunFilter Wraps all possible filters for getting the Authman sync runs
+type AuthmanSyncRunFilter struct {
+	GroupID *string `json:"group_id"`
+	Type    *string `json:"type"`
+	Limit   *int64  `json:"limit"`
+} // @name AuthmanSyncRunFilter
@@ -0,0 +1,46 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// BackupModeFull the backup contains all documents of the collection
+	BackupModeFull = "full"
+	// BackupModeIncremental the backup contains the documents created or updated since the previous backup
+	BackupModeIncremental = "incremental"
+)
+
+// BackupCollections are the collections exported to the object storage
+var BackupCollections = []string{"groups", "group_memberships", "posts", "events"}
+
+// BackupResult is the result of the backup of a collection
+type BackupResult struct {
+	Collection string     `json:"collection"`
+	Mode       string     `json:"mode"`
+	Key        string     `json:"key"` // the object key prefix of the backup parts
+	Parts      int        `json:"parts"`
+	Documents  int64      `json:"documents"`
+	DateFrom   *time.Time `json:"date_from,omitempty"` // nil for the full backups
+	DateTo     time.Time  `json:"date_to"`
+	Error      *string    `json:"error,omitempty"`
+} // @name BackupResult
+
+// BackupRestoreResult is the result of the restore of a collection
+type BackupRestoreResult struct {
+	Collection string   `json:"collection"`
+	Backups    []string `json:"backups"` // the restored backups in the order of the restore
+	Documents  int64    `json:"documents"`
+} // @name BackupRestoreResult
@@ -0,0 +1,116 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"crypto/hmac"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+// CalendarFeedToken represents the payload of the signed token of the calendar feed URL of a member. The calendar clients cannot log in,
+// so the token does not expire. The membership and the token version are checked on every request instead.
+type CalendarFeedToken struct {
+	ClientID string `json:"c"`
+	UserID   string `json:"u"`
+	GroupID  string `json:"g"`
+	Version  int    `json:"v,omitempty"` // the calendar feed version of the membership, the tokens issued before the rotations have none
+}
+
+// Sign encodes the token and appends its HMAC-SHA256 signature
+func (t CalendarFeedToken) Sign(secret string) string {
+	data, _ := json.Marshal(t)
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + signTokenPayload(payload, secret)
+}
+
+// ParseCalendarFeedToken verifies the signature of a signed calendar feed token
+func ParseCalendarFeedToken(token string, secret string) (*CalendarFeedToken, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, errors.New("malformed calendar feed token")
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(signTokenPayload(parts[0], secret))) {
+		return nil, errors.New("invalid calendar feed token signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed calendar feed token")
+	}
+	var result CalendarFeedToken
+	err = json.Unmarshal(data, &result)
+	if err != nil || len(result.ClientID) == 0 || len(result.UserID) == 0 || len(result.GroupID) == 0 {
+		return nil, errors.New("malformed calendar feed token")
+	}
+	return &result, nil
+}
+
+// CalendarFeedLink represents the calendar feed URL of a member
+type CalendarFeedLink struct {
+	Token string `json:"token"`
+	Path  string `json:"path"` // relative to the service host
+} // @name CalendarFeedLink
+
+// CalendarFeed represents the events of a group rendered as a calendar feed
+type CalendarFeed struct {
+	GroupID string
+	Title   string
+	Events  []CalendarFeedEvent
+}
+
+// CalendarFeedEvent represents a Calendar BB event in the calendar feed
+type CalendarFeedEvent struct {
+	ID          string
+	Title       string
+	Description string
+	Location    string
+	URL         string
+	Start       time.Time
+	End         *time.Time
+	AllDay      bool
+}
+
+// NewCalendarFeedEvent constructs the feed entry of a Calendar BB event. It gives nil if the event has no id or start.
+func NewCalendarFeedEvent(event map[string]interface{}) *CalendarFeedEvent {
+	id, _ := event["id"].(string)
+	start := GetCalendarEventStart(event)
+	if len(id) == 0 || start == nil {
+		return nil
+	}
+
+	result := CalendarFeedEvent{ID: id, Start: *start, End: GetCalendarEventEnd(event)}
+	result.Title, _ = event["name"].(string)
+	if len(result.Title) == 0 {
+		result.Title, _ = event["title"].(string)
+	}
+	result.Description, _ = event["description"].(string)
+	result.AllDay, _ = event["all_day"].(bool)
+	if location, ok := event["location"].(map[string]interface{}); ok {
+		for _, key := range []string{"description", "name", "address"} {
+			if value, ok := location[key].(string); ok && len(value) > 0 {
+				result.Location = value
+				break
+			}
+		}
+	}
+	if onlineDetails, ok := event["online_details"].(map[string]interface{}); ok {
+		result.URL, _ = onlineDetails["url"].(string)
+	}
+	return &result
+}
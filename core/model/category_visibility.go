@@ -0,0 +1,51 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "errors"
+
+// maxCategoryVisibilityCategories limits the categories of the allow and the deny lists
+const maxCategoryVisibilityCategories = 200
+
+// CategoryVisibilityConfig defines the per client group categories the group lists may contain. If the allowed categories are set only
+// the groups of these categories are listed, the groups of the denied categories are never listed.
+type CategoryVisibilityConfig struct {
+	Type              string   `json:"type" bson:"type"`
+	ClientID          string   `json:"client_id" bson:"client_id"`
+	AllowedCategories []string `json:"allowed_categories" bson:"allowed_categories"`
+	DeniedCategories  []string `json:"denied_categories" bson:"denied_categories"`
+} //@name CategoryVisibilityConfig
+
+// Validate validates the config
+func (c CategoryVisibilityConfig) Validate() error {
+	if len(c.AllowedCategories) > maxCategoryVisibilityCategories || len(c.DeniedCategories) > maxCategoryVisibilityCategories {
+		return errors.New("the allowed and the denied categories could contain up to 200 categories")
+	}
+	for _, category := range append(append([]string{}, c.AllowedCategories...), c.DeniedCategories...) {
+		if len(category) == 0 {
+			return errors.New("the categories must not be empty")
+		}
+	}
+	return nil
+}
+
+// ApplyToFilter restricts the categories of the groups filter according to the config
+func (c *CategoryVisibilityConfig) ApplyToFilter(filter *GroupsFilter) {
+	if c == nil || filter == nil {
+		return
+	}
+	filter.AllowedCategories = c.AllowedCategories
+	filter.DeniedCategories = c.DeniedCategories
+}
@@ -23,6 +23,11 @@ type ApplicationConfig struct {
 	SupportedClientIDs        []string
 	AppID                     string
 	OrgID                     string
+	AnalyticsHashSalt         string
+	WarmupPrimeGroups         bool
+	UnsubscribeTokenSecret    string
+	CalendarFeedTokenSecret   string
+	BackupPrefix              string // the object key prefix of the backups
 }
 
 // SyncConfig defines system configs for managed group sync
@@ -35,6 +40,47 @@ type SyncConfig struct {
 	GroupTimeout  int    `json:"group_timeout" bson:"group_timeout"`   // Time from sync_start_time to be considered a failed run for a single group in minutes
 }
 
+// PrivacyConfig defines the per client anonymization settings applied to the analytics and stats exports
+type PrivacyConfig struct {
+	Type                string `json:"type" bson:"type"`
+	ClientID            string `json:"client_id" bson:"client_id"`
+	PrivacyMode         bool   `json:"privacy_mode" bson:"privacy_mode"`
+	KAnonymityThreshold int    `json:"k_anonymity_threshold" bson:"k_anonymity_threshold"` // Cells (groups) with fewer individuals are suppressed
+} //@name PrivacyConfig
+
+// LicenseConfig defines the per client licensing metadata attached to the exports and the public feeds
+type LicenseConfig struct {
+	Type        string `json:"type" bson:"type"`
+	ClientID    string `json:"client_id" bson:"client_id"`
+	License     string `json:"license" bson:"license"`         // License name or SPDX identifier, e.g. CC-BY-4.0
+	LicenseURL  string `json:"license_url" bson:"license_url"` // Link to the license text
+	Attribution string `json:"attribution" bson:"attribution"` // Attribution statement, e.g. the name of the organization
+	TermsURL    string `json:"terms_url" bson:"terms_url"`     // Link to the terms of use
+} //@name LicenseConfig
+
+// IsEmpty checks if the config does not define any licensing metadata
+func (c *LicenseConfig) IsEmpty() bool {
+	return c == nil || (len(c.License) == 0 && len(c.LicenseURL) == 0 && len(c.Attribution) == 0 && len(c.TermsURL) == 0)
+}
+
+// MembershipPruningConfig defines the per client policy for pruning the memberships of long-inactive users
+type MembershipPruningConfig struct {
+	Type            string `json:"type" bson:"type"`
+	ClientID        string `json:"client_id" bson:"client_id"`
+	Enabled         bool   `json:"enabled" bson:"enabled"`
+	InactivityDays  int    `json:"inactivity_days" bson:"inactivity_days"`     // Members who have not logged in for longer are flagged as stale and notified
+	AutoRemove      bool   `json:"auto_remove" bson:"auto_remove"`             // Removes the stale memberships once the grace period passes
+	GracePeriodDays int    `json:"grace_period_days" bson:"grace_period_days"` // Days between flagging and removing a stale membership
+} //@name MembershipPruningConfig
+
+// PendingMembershipConfig defines the per client policy for the pending membership requests which wait for the group admins
+type PendingMembershipConfig struct {
+	Type           string `json:"type" bson:"type"`
+	ClientID       string `json:"client_id" bson:"client_id"`
+	ExpirationDays int    `json:"expiration_days" bson:"expiration_days"` // Requests pending for longer are rejected automatically, 0 keeps them pending
+	ReminderDays   int    `json:"reminder_days" bson:"reminder_days"`     // Admins are reminded about the requests pending for longer at most once per period, 0 disables the reminders
+} //@name PendingMembershipConfig
+
 // SyncTimes defines the times used to prevent concurrent syncs
 type SyncTimes struct {
 	Key       string     `json:"key" bson:"key"`
@@ -52,3 +98,38 @@ type ManagedGroupConfig struct {
 	DateCreated  time.Time  `json:"date_created" bson:"date_created"`
 	DateUpdated  *time.Time `json:"date_updated" bson:"date_updated"`
 } //@name ManagedGroupConfig
+
+// PostLimitsConfig defines the per client size rules of the posts. The missing limits are not applied.
+type PostLimitsConfig struct {
+	Type           string `json:"type" bson:"type"`
+	ClientID       string `json:"client_id" bson:"client_id"`
+	MaxBodyLength  *int   `json:"max_body_length,omitempty" bson:"max_body_length,omitempty"` // Max number of characters of the post body
+	MaxAttachments *int   `json:"max_attachments,omitempty" bson:"max_attachments,omitempty"` // Max number of the attached media, currently the image
+	MaxReplyDepth  *int   `json:"max_reply_depth,omitempty" bson:"max_reply_depth,omitempty"` // Max nesting level of the replies, 1 allows replies only to the top level posts
+} //@name PostLimitsConfig
+
+// ContentFilterConfig defines the per client content policy applied on the created and the updated posts
+type ContentFilterConfig struct {
+	Type               string   `json:"type" bson:"type"`
+	ClientID           string   `json:"client_id" bson:"client_id"`
+	Enabled            bool     `json:"enabled" bson:"enabled"`
+	Words              []string `json:"words" bson:"words"`                             // Blocked words and phrases, matched case insensitively as whole words
+	Action             string   `json:"action" bson:"action"`                           // reject, flag or annotate
+	ExternalModeration bool     `json:"external_moderation" bson:"external_moderation"` // Checks the content with the external moderation API too, if it is configured
+} //@name ContentFilterConfig
+
+// ContentRateLimitsConfig defines the per client anti-spam limits of the content created by a user within a group. The missing limits are not applied.
+type ContentRateLimitsConfig struct {
+	Type               string     `json:"type" bson:"type"`
+	ClientID           string     `json:"client_id" bson:"client_id"`
+	Posts              *RateLimit `json:"posts,omitempty" bson:"posts,omitempty"`
+	Replies            *RateLimit `json:"replies,omitempty" bson:"replies,omitempty"`
+	Reactions          *RateLimit `json:"reactions,omitempty" bson:"reactions,omitempty"`
+	MembershipRequests *RateLimit `json:"membership_requests,omitempty" bson:"membership_requests,omitempty"`
+} //@name ContentRateLimitsConfig
+
+// RateLimit defines the max number of the actions allowed within a sliding window
+type RateLimit struct {
+	Max           int `json:"max" bson:"max"`
+	WindowSeconds int `json:"window_seconds" bson:"window_seconds"`
+} //@name RateLimit
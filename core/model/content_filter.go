@@ -0,0 +1,76 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ContentFilterActionReject the matching posts are rejected
+	ContentFilterActionReject = "reject"
+	// ContentFilterActionFlag the matching posts are published and reported to the moderators for a review
+	ContentFilterActionFlag = "flag"
+	// ContentFilterActionAnnotate the matching posts are published with the content flags only
+	ContentFilterActionAnnotate = "annotate"
+
+	// MaxContentFilterWords is the maximum number of the blocked words of a client
+	MaxContentFilterWords = 1000
+)
+
+// ContentFilterError is returned when a post is rejected by the content filter of the client
+type ContentFilterError struct {
+	Flags []string
+}
+
+func (e *ContentFilterError) Error() string {
+	return fmt.Sprintf("the post violates the content policy: %s", strings.Join(e.Flags, ", "))
+}
+
+// Validate validates the content filter config
+func (c ContentFilterConfig) Validate() error {
+	if c.Action != ContentFilterActionReject && c.Action != ContentFilterActionFlag && c.Action != ContentFilterActionAnnotate {
+		return fmt.Errorf("invalid content filter action %s", c.Action)
+	}
+	if len(c.Words) > MaxContentFilterWords {
+		return fmt.Errorf("at most %d words could be blocked", MaxContentFilterWords)
+	}
+	for _, word := range c.Words {
+		if len(strings.TrimSpace(word)) == 0 {
+			return errors.New("the blocked words must not be empty")
+		}
+	}
+	return nil
+}
+
+// MatchWords gives the blocked words contained in the subject or the body of the post
+func (c *ContentFilterConfig) MatchWords(post *Post) []string {
+	if c == nil || post == nil || len(c.Words) == 0 {
+		return nil
+	}
+
+	text := post.Subject + "\n" + post.Body
+	var matches []string
+	for _, word := range c.Words {
+		pattern := `(?i)\b` + regexp.QuoteMeta(strings.TrimSpace(word)) + `\b`
+		if regexp.MustCompile(pattern).MatchString(text) {
+			matches = append(matches, strings.ToLower(strings.TrimSpace(word)))
+		}
+	}
+	return matches
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// ContentActionPost creating a top level post
+	ContentActionPost = "post"
+	// ContentActionReply creating a reply
+	ContentActionReply = "reply"
+	// ContentActionReaction adding a reaction to a post
+	ContentActionReaction = "reaction"
+	// ContentActionMembershipRequest requesting a group membership
+	ContentActionMembershipRequest = "membership_request"
+
+	// MaxRateLimitWindowSeconds is the longest window of a limit as the content events are not kept longer
+	MaxRateLimitWindowSeconds = 30 * 24 * 60 * 60
+)
+
+// ContentEvent represents a single content creation of a user within a group. The events are kept for a short period and used for the rate limiting.
+type ContentEvent struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	UserID      string    `json:"user_id" bson:"user_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	Action      string    `json:"action" bson:"action"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name ContentEvent
+
+// ContentRateLimitError is returned when the user creates the content within a group too often
+type ContentRateLimitError struct {
+	Action     string
+	Limit      int
+	RetryAfter time.Duration
+}
+
+func (e *ContentRateLimitError) Error() string {
+	return fmt.Sprintf("%s limit of %d exceeded, retry after %d seconds", e.Action, e.Limit, int64(e.RetryAfter.Seconds()))
+}
+
+// Window gives the sliding window of the limit
+func (l RateLimit) Window() time.Duration {
+	return time.Duration(l.WindowSeconds) * time.Second
+}
+
+// Validate validates the limits
+func (c ContentRateLimitsConfig) Validate() error {
+	for _, limit := range []*RateLimit{c.Posts, c.Replies, c.Reactions, c.MembershipRequests} {
+		if limit == nil {
+			continue
+		}
+		if limit.Max < 0 {
+			return errors.New("the limits must not be negative")
+		}
+		if limit.WindowSeconds <= 0 || limit.WindowSeconds > MaxRateLimitWindowSeconds {
+			return fmt.Errorf("the window must be between 1 and %d seconds", MaxRateLimitWindowSeconds)
+		}
+	}
+	return nil
+}
+
+// GetLimit gives the limit of the action or nil if the action is not limited
+func (c *ContentRateLimitsConfig) GetLimit(action string) *RateLimit {
+	if c == nil {
+		return nil
+	}
+	switch action {
+	case ContentActionPost:
+		return c.Posts
+	case ContentActionReply:
+		return c.Replies
+	case ContentActionReaction:
+		return c.Reactions
+	case ContentActionMembershipRequest:
+		return c.MembershipRequests
+	}
+	return nil
+}
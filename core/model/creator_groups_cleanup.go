@@ -0,0 +1,52 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+const (
+	// CreatorGroupsCleanupActionArchive hides the groups from the search and blocks the new membership requests, the groups are kept
+	CreatorGroupsCleanupActionArchive = "archive"
+	// CreatorGroupsCleanupActionDelete deletes the groups, the deletion policy of the client applies
+	CreatorGroupsCleanupActionDelete = "delete"
+
+	// CreatorGroupsCleanupStatusMatched the group would be cleaned up (dry run)
+	CreatorGroupsCleanupStatusMatched = "matched"
+	// CreatorGroupsCleanupStatusArchived the group has been archived
+	CreatorGroupsCleanupStatusArchived = "archived"
+	// CreatorGroupsCleanupStatusDeleted the group has been deleted
+	CreatorGroupsCleanupStatusDeleted = "deleted"
+	// CreatorGroupsCleanupStatusSkipped the group has been archived already or it is a managed group the admin is not allowed to clean up
+	CreatorGroupsCleanupStatusSkipped = "skipped"
+	// CreatorGroupsCleanupStatusFailed the cleanup of the group has failed
+	CreatorGroupsCleanupStatusFailed = "failed"
+
+	// MaxCreatorGroupsCleanup the maximum number of the groups cleaned up at once
+	MaxCreatorGroupsCleanup = 500
+)
+
+// CreatorGroupsCleanupResult represents the outcome of the cleanup of the groups created by an account
+type CreatorGroupsCleanupResult struct {
+	CreatorID string                     `json:"creator_id"`
+	Action    string                     `json:"action"`
+	DryRun    bool                       `json:"dry_run"`
+	Groups    []CreatorGroupsCleanupItem `json:"groups"`
+} // @name CreatorGroupsCleanupResult
+
+// CreatorGroupsCleanupItem represents the outcome of the cleanup of a group
+type CreatorGroupsCleanupItem struct {
+	GroupID string `json:"group_id"`
+	Title   string `json:"title"`
+	Status  string `json:"status"` // matched, archived, deleted, skipped or failed
+	Error   string `json:"error,omitempty"`
+} // @name CreatorGroupsCleanupItem
@@ -0,0 +1,100 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	// DigestFrequencyDaily the new posts and events of the group are summarized once a day
+	DigestFrequencyDaily = "daily"
+	// DigestFrequencyWeekly the new posts and events of the group are summarized once a week
+	DigestFrequencyWeekly = "weekly"
+
+	// digestDueMargin lets the digest be sent by the daily job run even if the previous one finished a bit later
+	digestDueMargin = time.Hour
+	// maxDigestPostSubjects the number of the post subjects listed per group within a digest
+	maxDigestPostSubjects = 3
+)
+
+// digestOperations the notifications which the members in the digest mode get within the digest instead
+var digestOperations = map[string]bool{"post_created": true, "posts_created": true, "event_created": true}
+
+// IsDigestFrequency checks if the value is a valid digest frequency
+func IsDigestFrequency(value string) bool {
+	return value == DigestFrequencyDaily || value == DigestFrequencyWeekly
+}
+
+// IsDigestOperation checks if the notification of the operation is summarized within the digests
+func IsDigestOperation(operation string) bool {
+	return digestOperations[operation]
+}
+
+// IsDigestDue checks if the digest of the membership should be sent at the provided time
+func (m *GroupMembership) IsDigestDue(now time.Time) bool {
+	if m.DateLastDigest == nil {
+		return true
+	}
+	period := 24 * time.Hour
+	if m.DigestFrequency == DigestFrequencyWeekly {
+		period = 7 * 24 * time.Hour
+	}
+	return now.Sub(*m.DateLastDigest) >= period-digestDueMargin
+}
+
+// GroupDigest represents the new content of a group since the last digest of a member
+type GroupDigest struct {
+	GroupID      string
+	GroupTitle   string
+	PostsCount   int
+	PostSubjects []string
+	EventsCount  int64
+}
+
+// IsEmpty checks if there is no new content within the group
+func (d GroupDigest) IsEmpty() bool {
+	return d.PostsCount == 0 && d.EventsCount == 0
+}
+
+// Summary gives the text of the group within the digest notification
+func (d GroupDigest) Summary() string {
+	parts := []string{}
+	if d.PostsCount > 0 {
+		parts = append(parts, pluralize(d.PostsCount, "new post"))
+	}
+	if d.EventsCount > 0 {
+		parts = append(parts, pluralize(int(d.EventsCount), "new event"))
+	}
+	summary := fmt.Sprintf("%s: %s", d.GroupTitle, strings.Join(parts, ", "))
+
+	subjects := d.PostSubjects
+	if len(subjects) > maxDigestPostSubjects {
+		subjects = subjects[:maxDigestPostSubjects]
+	}
+	if len(subjects) > 0 {
+		summary = fmt.Sprintf("%s (%s)", summary, strings.Join(subjects, "; "))
+	}
+	return summary
+}
+
+func pluralize(count int, noun string) string {
+	if count == 1 {
+		return fmt.Sprintf("1 %s", noun)
+	}
+	return fmt.Sprintf("%d %ss", count, noun)
+}
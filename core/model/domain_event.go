@@ -0,0 +1,65 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// DomainEventGroupCreated group created event
+	DomainEventGroupCreated = "group.created"
+	// DomainEventMembershipChanged a membership has been created, its status has changed or it has been deleted
+	DomainEventMembershipChanged = "membership.changed"
+	// DomainEventPostCreated post published event
+	DomainEventPostCreated = "post.created"
+	// DomainEventAuthmanSyncCompleted a global or a group Authman sync run has finished
+	DomainEventAuthmanSyncCompleted = "authman.sync.completed"
+
+	// MembershipChangeCreated the membership has been created
+	MembershipChangeCreated = "created"
+	// MembershipChangeUpdated the status of the membership has changed
+	MembershipChangeUpdated = "updated"
+	// MembershipChangeDeleted the membership has been deleted
+	MembershipChangeDeleted = "deleted"
+
+	// OutboxEventStatusPending the event is waiting to be published
+	OutboxEventStatusPending = "pending"
+	// OutboxEventStatusPublished the event has been accepted by the message bus
+	OutboxEventStatusPublished = "published"
+	// OutboxEventStatusFailed the event has exhausted all publish attempts
+	OutboxEventStatusFailed = "failed"
+)
+
+// DomainEvent represents the message published to the message bus
+type DomainEvent struct {
+	ID        string                 `json:"id" bson:"id"`
+	Type      string                 `json:"type" bson:"type"`
+	ClientID  string                 `json:"client_id" bson:"client_id"`
+	GroupID   string                 `json:"group_id,omitempty" bson:"group_id,omitempty"`
+	Data      map[string]interface{} `json:"data" bson:"data"`
+	Timestamp time.Time              `json:"timestamp" bson:"timestamp"`
+} // @name DomainEvent
+
+// OutboxEvent represents a domain event stored within the transaction of the operation which emits it, until it is published
+type OutboxEvent struct {
+	ID          string      `json:"id" bson:"_id"`
+	Event       DomainEvent `json:"event" bson:"event"`
+	Status      string      `json:"status" bson:"status"` // pending, published or failed
+	Attempts    int         `json:"attempts" bson:"attempts"`
+	LastError   *string     `json:"last_error" bson:"last_error"`
+	NextAttempt *time.Time  `json:"next_attempt" bson:"next_attempt"`
+
+	DateCreated time.Time  `json:"date_created" bson:"date_created"`
+	DateUpdated *time.Time `json:"date_updated" bson:"date_updated"`
+} // @name OutboxEvent
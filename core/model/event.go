@@ -16,19 +16,190 @@ package model
 
 import (
 	"groups/driven/notifications"
+	"sort"
 	"time"
 )
 
 // Event represents event entity
 type Event struct {
-	ClientID      string     `json:"client_id" bson:"client_id"`
-	EventID       string     `json:"event_id" bson:"event_id"`
-	GroupID       string     `json:"group_id" bson:"group_id"`
-	DateCreated   time.Time  `json:"date_created" bson:"date_created"`
-	Creator       *Creator   `json:"creator" bson:"creator"`
-	ToMembersList []ToMember `json:"to_members" bson:"to_members"` // nil or empty means everyone; non-empty means visible to those user ids and admins
+	ClientID      string      `json:"client_id" bson:"client_id"`
+	EventID       string      `json:"event_id" bson:"event_id"`
+	GroupID       string      `json:"group_id" bson:"group_id"`
+	DateCreated   time.Time   `json:"date_created" bson:"date_created"`
+	Creator       *Creator    `json:"creator" bson:"creator"`
+	ToMembersList []ToMember  `json:"to_members" bson:"to_members"` // nil or empty means everyone; non-empty means visible to those user ids and admins
+	RSVPs         []EventRSVP `json:"-" bson:"rsvps,omitempty"`     // exposed through the RSVP summary only
+
+	DateStart       *time.Time `json:"date_start,omitempty" bson:"date_start,omitempty"`             // the start of the calendar event when known
+	DateEnd         *time.Time `json:"date_end,omitempty" bson:"date_end,omitempty"`                 // the end of the calendar event when known
+	SeriesID        *string    `json:"series_id,omitempty" bson:"series_id,omitempty"`               // the recurring series the event belongs to
+	OccurrenceStart *time.Time `json:"occurrence_start,omitempty" bson:"occurrence_start,omitempty"` // the start of the event within its series
+
+	DateArchived  *time.Time `json:"date_archived,omitempty" bson:"date_archived,omitempty"`   // set when the group is deleted, the mapping is kept instead of being dropped
+	FeaturedUntil *time.Time `json:"featured_until,omitempty" bson:"featured_until,omitempty"` // the event is listed first until the time, set by the group admins
+
+	DateReminderSent *time.Time `json:"-" bson:"date_reminder_sent,omitempty"` // the scheduled reminder is sent, unset when the event is moved to another start
 } // @name Event
 
+// GetStart gives the start of the event within its series, or the start of the calendar event. It gives nil if the start is not known.
+func (e Event) GetStart() *time.Time {
+	if e.OccurrenceStart != nil {
+		return e.OccurrenceStart
+	}
+	return e.DateStart
+}
+
+const (
+	// MaxFeaturedEvents is the maximum number of the events featured in a group at the same time
+	MaxFeaturedEvents = 3
+	// MaxEventFeatureDuration is the longest time an event could be featured for
+	MaxEventFeatureDuration = 30 * 24 * time.Hour
+)
+
+// IsFeatured checks if the event is featured at the moment
+func (e Event) IsFeatured(now time.Time) bool {
+	return e.FeaturedUntil != nil && e.FeaturedUntil.After(now)
+}
+
+// SortEventsFeaturedFirst moves the events featured at the moment to the beginning keeping the order otherwise
+func SortEventsFeaturedFirst(events []Event, now time.Time) {
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].IsFeatured(now) && !events[j].IsFeatured(now)
+	})
+}
+
+const (
+	// EventRSVPStatusGoing the member is going to the event
+	EventRSVPStatusGoing = "going"
+	// EventRSVPStatusMaybe the member may go to the event
+	EventRSVPStatusMaybe = "maybe"
+	// EventRSVPStatusNotGoing the member is not going to the event
+	EventRSVPStatusNotGoing = "not_going"
+)
+
+// EventRSVP represents the response of a member to a group event
+type EventRSVP struct {
+	UserID      string    `json:"user_id" bson:"user_id"`
+	Name        string    `json:"name" bson:"name"`
+	Email       string    `json:"email" bson:"email"`
+	Status      string    `json:"status" bson:"status"`                         // going, maybe or not_going. Empty if the member has set the reminder preference only
+	Reminder    *bool     `json:"reminder,omitempty" bson:"reminder,omitempty"` // the reminder opt-in of the member. The group notifications preferences apply if not set
+	DateUpdated time.Time `json:"date_updated" bson:"date_updated"`
+} // @name EventRSVP
+
+// EventRSVPSummary represents the RSVP counts of a group event. The list of responses is given to the group admins only.
+type EventRSVPSummary struct {
+	EventID      string         `json:"event_id"`
+	Counts       map[string]int `json:"counts"`
+	UserStatus   *string        `json:"user_status"`   // the response of the current user
+	UserReminder *bool          `json:"user_reminder"` // the reminder opt-in of the current user
+	RSVPs        []EventRSVP    `json:"rsvps,omitempty"`
+} // @name EventRSVPSummary
+
+// GetAttendingRSVPs gives the RSVPs of the members who are going or may go to the event
+func (e Event) GetAttendingRSVPs() []EventRSVP {
+	var result []EventRSVP
+	for _, rsvp := range e.RSVPs {
+		if rsvp.Status == EventRSVPStatusGoing || rsvp.Status == EventRSVPStatusMaybe {
+			result = append(result, rsvp)
+		}
+	}
+	return result
+}
+
+// IsValidEventRSVPStatus checks if the status is a supported RSVP status
+func IsValidEventRSVPStatus(status string) bool {
+	return status == EventRSVPStatusGoing || status == EventRSVPStatusMaybe || status == EventRSVPStatusNotGoing
+}
+
+// GetRSVPSummary constructs the RSVP summary of the event for the user
+func (e Event) GetRSVPSummary(userID string, includeRSVPs bool) EventRSVPSummary {
+	summary := EventRSVPSummary{
+		EventID: e.EventID,
+		Counts: map[string]int{
+			EventRSVPStatusGoing:    0,
+			EventRSVPStatusMaybe:    0,
+			EventRSVPStatusNotGoing: 0,
+		},
+	}
+	for _, rsvp := range e.RSVPs {
+		if rsvp.UserID == userID {
+			summary.UserReminder = rsvp.Reminder
+		}
+		if len(rsvp.Status) == 0 {
+			continue
+		}
+		summary.Counts[rsvp.Status]++
+		if rsvp.UserID == userID {
+			status := rsvp.Status
+			summary.UserStatus = &status
+		}
+		if includeRSVPs {
+			summary.RSVPs = append(summary.RSVPs, rsvp)
+		}
+	}
+	return summary
+}
+
+// GetRSVP gives the record of the user, nil if the user has not responded
+func (e Event) GetRSVP(userID string) *EventRSVP {
+	for _, rsvp := range e.RSVPs {
+		if rsvp.UserID == userID {
+			return &rsvp
+		}
+	}
+	return nil
+}
+
+// GetReminderUserIDs gives the IDs of the users who should get the event reminders - the members going to the event and the members who opted in,
+// without the members who opted out. The opted in users are marked in the map, the reminders are sent to them even if they muted the group events.
+func (e Event) GetReminderUserIDs() ([]string, map[string]bool) {
+	var userIDs []string
+	optedIn := map[string]bool{}
+	for _, rsvp := range e.RSVPs {
+		if rsvp.Reminder != nil {
+			if *rsvp.Reminder {
+				userIDs = append(userIDs, rsvp.UserID)
+				optedIn[rsvp.UserID] = true
+			}
+			continue
+		}
+		if rsvp.Status == EventRSVPStatusGoing {
+			userIDs = append(userIDs, rsvp.UserID)
+		}
+	}
+	return userIDs, optedIn
+}
+
+// GetScheduledReminderUserIDs gives the IDs of the users who should get the scheduled reminder of the event. When the reminders are on by default
+// for the group they are the same as GetReminderUserIDs, otherwise only the members who opted in get them.
+func (e Event) GetScheduledReminderUserIDs(groupDefault bool) ([]string, map[string]bool) {
+	if groupDefault {
+		return e.GetReminderUserIDs()
+	}
+
+	var userIDs []string
+	optedIn := map[string]bool{}
+	for _, rsvp := range e.RSVPs {
+		if rsvp.Reminder != nil && *rsvp.Reminder {
+			userIDs = append(userIDs, rsvp.UserID)
+			optedIn[rsvp.UserID] = true
+		}
+	}
+	return userIDs, optedIn
+}
+
+// GetRSVPUserIDs gives the IDs of the users who responded with the status
+func (e Event) GetRSVPUserIDs(status string) []string {
+	var userIDs []string
+	for _, rsvp := range e.RSVPs {
+		if rsvp.Status == status {
+			userIDs = append(userIDs, rsvp.UserID)
+		}
+	}
+	return userIDs
+}
+
 // AccountIdentifiers represents extended identfier which handles external id in addtion of the account id.
 type AccountIdentifiers struct {
 	AccountID  *string `json:"account_id"`
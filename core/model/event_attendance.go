@@ -0,0 +1,77 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// EventCheckInMethodCode the member checked in by entering the short code
+	EventCheckInMethodCode = "code"
+	// EventCheckInMethodQR the member checked in by scanning the QR token
+	EventCheckInMethodQR = "qr"
+)
+
+// EventCheckInCode represents a short-lived check-in code of a group event. The code is entered by hand, the token is encoded in the QR code.
+type EventCheckInCode struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	EventID     string    `json:"event_id" bson:"event_id"`
+	Code        string    `json:"code" bson:"code"`
+	Token       string    `json:"token" bson:"token"`
+	CreatorID   string    `json:"creator_id" bson:"creator_id"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+	DateExpires time.Time `json:"date_expires" bson:"date_expires"`
+} // @name EventCheckInCode
+
+// IsExpired checks if the code can not be used any more
+func (c EventCheckInCode) IsExpired() bool {
+	return time.Now().After(c.DateExpires)
+}
+
+// EventAttendance represents the check-in of a member to a group event
+type EventAttendance struct {
+	ID            string    `json:"id" bson:"_id"`
+	ClientID      string    `json:"client_id" bson:"client_id"`
+	GroupID       string    `json:"group_id" bson:"group_id"`
+	EventID       string    `json:"event_id" bson:"event_id"`
+	UserID        string    `json:"user_id" bson:"user_id"`
+	MembershipID  string    `json:"membership_id" bson:"membership_id"`
+	Name          string    `json:"name" bson:"name"`
+	Email         string    `json:"email" bson:"email"`
+	Method        string    `json:"method" bson:"method"` // code or qr
+	DateCheckedIn time.Time `json:"date_checked_in" bson:"date_checked_in"`
+} // @name EventAttendance
+
+// MemberAttendance represents the attendance count of a member within a group
+type MemberAttendance struct {
+	UserID           string     `json:"user_id"`
+	Name             string     `json:"name"`
+	Email            string     `json:"email"`
+	NetID            string     `json:"net_id"`
+	Status           string     `json:"status"` // empty if the user is not a member any more
+	AttendedCount    int        `json:"attended_count"`
+	DateLastAttended *time.Time `json:"date_last_attended"`
+} // @name MemberAttendance
+
+// GroupAttendanceReport represents the participation of the members in the events of a group within a date range
+type GroupAttendanceReport struct {
+	GroupID       string             `json:"group_id"`
+	StartDate     *time.Time         `json:"start_date"`
+	EndDate       *time.Time         `json:"end_date"`
+	EventsCount   int                `json:"events_count"` // events with at least one check-in
+	Members       []MemberAttendance `json:"members"`
+	DateGenerated time.Time          `json:"date_generated"`
+} // @name GroupAttendanceReport
@@ -0,0 +1,156 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// EventRecurrenceDaily repeats the event every interval days
+	EventRecurrenceDaily = "daily"
+	// EventRecurrenceWeekly repeats the event every interval weeks
+	EventRecurrenceWeekly = "weekly"
+	// EventRecurrenceMonthly repeats the event every interval months
+	EventRecurrenceMonthly = "monthly"
+
+	// EventRecurrenceMaxOccurrences is the max number of the events within a series
+	EventRecurrenceMaxOccurrences = 52
+
+	// EventSeriesScopeThis applies the change to the selected event only
+	EventSeriesScopeThis = "this"
+	// EventSeriesScopeFuture applies the change to the selected event and all later events of the series
+	EventSeriesScopeFuture = "future"
+)
+
+// calendarEventDateFields are the date fields of the Calendar BB event which are shifted for every occurrence of a series
+var calendarEventDateFields = []string{"start_date", "end_date", "start_time", "end_time"}
+
+// EventRecurrence represents the recurrence rule of a group event series. The series ends after Count events or at Until, whichever comes first.
+type EventRecurrence struct {
+	Frequency string     `json:"frequency"` // daily, weekly or monthly
+	Interval  int        `json:"interval"`  // 1 by default
+	Count     int        `json:"count"`
+	Until     *time.Time `json:"until"`
+} // @name EventRecurrence
+
+// Validate validates the recurrence rule
+func (r EventRecurrence) Validate() error {
+	if r.Frequency != EventRecurrenceDaily && r.Frequency != EventRecurrenceWeekly && r.Frequency != EventRecurrenceMonthly {
+		return errors.New("frequency must be one of daily, weekly or monthly")
+	}
+	if r.Interval < 0 {
+		return errors.New("interval must be positive")
+	}
+	if r.Count <= 0 && r.Until == nil {
+		return errors.New("count or until is required")
+	}
+	if r.Count > EventRecurrenceMaxOccurrences {
+		return fmt.Errorf("count must not exceed %d", EventRecurrenceMaxOccurrences)
+	}
+	return nil
+}
+
+// Occurrences gives the start times of the series starting with the provided one
+func (r EventRecurrence) Occurrences(start time.Time) []time.Time {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = 1
+	}
+	count := r.Count
+	if count <= 0 || count > EventRecurrenceMaxOccurrences {
+		count = EventRecurrenceMaxOccurrences
+	}
+
+	occurrences := make([]time.Time, 0, count)
+	for i := 0; i < count; i++ {
+		var occurrence time.Time
+		switch r.Frequency {
+		case EventRecurrenceDaily:
+			occurrence = start.AddDate(0, 0, i*interval)
+		case EventRecurrenceWeekly:
+			occurrence = start.AddDate(0, 0, 7*i*interval)
+		default:
+			occurrence = start.AddDate(0, i*interval, 0)
+		}
+		if r.Until != nil && occurrence.After(*r.Until) {
+			break
+		}
+		occurrences = append(occurrences, occurrence)
+	}
+	return occurrences
+}
+
+// GetCalendarEventStart gives the start of the Calendar BB event. It gives nil if the event has no start date.
+func GetCalendarEventStart(event map[string]interface{}) *time.Time {
+	for _, field := range []string{"start_date", "start_time"} {
+		if value, ok := parseCalendarEventDate(event[field]); ok {
+			return &value
+		}
+	}
+	return nil
+}
+
+// GetCalendarEventEnd gives the end of the Calendar BB event. It gives nil if the event has no end date.
+func GetCalendarEventEnd(event map[string]interface{}) *time.Time {
+	for _, field := range []string{"end_date", "end_time"} {
+		if value, ok := parseCalendarEventDate(event[field]); ok {
+			return &value
+		}
+	}
+	return nil
+}
+
+// ShiftCalendarEventDates copies the Calendar BB event with its dates moved by the offset. The event id is removed from the copy.
+func ShiftCalendarEventDates(event map[string]interface{}, offset time.Duration) map[string]interface{} {
+	result := make(map[string]interface{}, len(event))
+	for key, value := range event {
+		result[key] = value
+	}
+	delete(result, "id")
+
+	for _, field := range calendarEventDateFields {
+		switch value := event[field].(type) {
+		case string:
+			if date, ok := parseCalendarEventDate(value); ok {
+				result[field] = date.Add(offset).Format(time.RFC3339)
+			}
+		case float64:
+			result[field] = int64(value) + int64(offset.Seconds())
+		case int64:
+			result[field] = value + int64(offset.Seconds())
+		}
+	}
+	return result
+}
+
+// parseCalendarEventDate parses a RFC3339 string or a unix time in seconds
+func parseCalendarEventDate(value interface{}) (time.Time, bool) {
+	switch value := value.(type) {
+	case string:
+		date, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return date, true
+	case float64:
+		return time.Unix(int64(value), 0).UTC(), true
+	case int64:
+		return time.Unix(value, 0).UTC(), true
+	}
+	return time.Time{}, false
+}
@@ -1,8 +1,14 @@
 package model
 
+import (
+	"fmt"
+	"time"
+)
+
 // MembershipFilter Wraps all possible filters for getting group members call
 type MembershipFilter struct {
 	ID         *string  `json:"id"`          // membership id
+	IDs        []string `json:"ids"`         // membership ids
 	GroupIDs   []string `json:"group_ids"`   // list of group ids
 	UserID     *string  `json:"user_id"`     // core user id
 	UserIDs    []string `json:"user_ids"`    // core user ids
@@ -10,11 +16,44 @@ type MembershipFilter struct {
 	NetID      *string  `json:"net_id"`      // core user net id
 	NetIDs     []string `json:"net_ids"`     // core user net ids
 	Name       *string  `json:"name"`        // member's name
+	Search     *string  `json:"search"`      // case-insensitive partial match of the member's name, email or net id
+	SearchName bool     `json:"-"`           // the search matches the name only, set for the non-admin APIs
 	Statuses   []string `json:"statuses"`    // lest of membership statuses
+	Leaders    *bool    `json:"leaders"`     // only the memberships shown as group leaders
+	Searchable *bool    `json:"searchable"`  // only the memberships listed in the member pickers
+	SortBy     *string  `json:"sort_by"`     // name, date_created (join date) or status. Default: status & name
+	Order      *string  `json:"order"`       // asc (default) or desc
+	Light      *bool    `json:"light"`       // omit the member answers and the notification preferences, i.e. for the member lists
 	Offset     *int64   `json:"offset"`      // result offset
 	Limit      *int64   `json:"limit"`       // result limit
 } // @name MembershipFilter
 
+const (
+	// MembershipSortByName sorts the memberships by the member name
+	MembershipSortByName = "name"
+	// MembershipSortByDateCreated sorts the memberships by the join date
+	MembershipSortByDateCreated = "date_created"
+	// MembershipSortByStatus sorts the memberships by the status and the member name
+	MembershipSortByStatus = "status"
+
+	// MaxMembershipSearchLength the maximum length of the member search text
+	MaxMembershipSearchLength = 100
+)
+
+// Validate checks the sorting and the search of the memberships filter
+func (f MembershipFilter) Validate() error {
+	if f.SortBy != nil && *f.SortBy != MembershipSortByName && *f.SortBy != MembershipSortByDateCreated && *f.SortBy != MembershipSortByStatus {
+		return fmt.Errorf("invalid sort_by %s", *f.SortBy)
+	}
+	if f.Order != nil && *f.Order != "asc" && *f.Order != "desc" {
+		return fmt.Errorf("invalid order %s", *f.Order)
+	}
+	if f.Search != nil && len(*f.Search) > MaxMembershipSearchLength {
+		return fmt.Errorf("search must be at most %d characters long", MaxMembershipSearchLength)
+	}
+	return nil
+}
+
 // GroupsFilter Wraps all possible filters for getting a group
 type GroupsFilter struct {
 	GroupIDs         []string                       `json:"ids"`                // membership id
@@ -23,6 +62,7 @@ type GroupsFilter struct {
 	MemberExternalID *string                        `json:"member_external_id"` // member user external id
 	Title            *string                        `json:"title"`              // group title
 	Category         *string                        `json:"category"`           // group category
+	Categories       []string                       `json:"categories"`         // groups having any of the categories
 	Privacy          *string                        `json:"privacy"`            // group privacy
 	Tags             []string                       `json:"tags"`               // group tags
 	IncludeHidden    *bool                          `json:"include_hidden"`     // Include hidden groups
@@ -33,17 +73,35 @@ type GroupsFilter struct {
 	ResearchGroup    *bool                          `json:"research_group"`
 	ResearchAnswers  map[string]map[string][]string `json:"research_answers"`
 	Attributes       map[string]interface{}         `json:"attributes"`
-	Order            *string                        `json:"order"`  // order by category & name (asc desc)
-	Offset           *int64                         `json:"offset"` // result offset
-	Limit            *int64                         `json:"limit"`  // result limit
+	Near             *GeoNearFilter                 `json:"near"`          // groups located within the radius around the center
+	OrgUnitCode      *string                        `json:"org_unit_code"` // groups of the org unit and of all the units under it
+	CreatorID        *string                        `json:"creator_id"`    // groups created by the account
+	Order            *string                        `json:"order"`         // order by category & name (asc desc)
+	Offset           *int64                         `json:"offset"`        // result offset
+	Limit            *int64                         `json:"limit"`         // result limit
+	Cursor           *string                        `json:"cursor"`        // opaque cursor (next_cursor) of the previous page, empty for the first page. Orders by the creation date and takes precedence over the offset.
+
+	AllowedCategories []string `json:"-"` // set from the category visibility config of the client
+	DeniedCategories  []string `json:"-"` // set from the category visibility config of the client
 } // @name GroupsFilter
 
 // PostsFilter Wraps all possible filters for getting group post call
 type PostsFilter struct {
-	GroupID       string  `json:"group_id"`
-	PostType      *string `json:"type"`
-	ScheduledOnly *bool   `json:"scheduled_only"`
-	Offset        *int64  `json:"offset"`
-	Limit         *int64  `json:"limit"`
-	Order         *string `json:"order"`
+	GroupID       string     `json:"group_id"`
+	PostType      *string    `json:"type"`
+	ScheduledOnly *bool      `json:"scheduled_only"`
+	PinnedOnly    *bool      `json:"pinned_only"`
+	Since         *time.Time `json:"since"` // posts created at or after
+	Offset        *int64     `json:"offset"`
+	Limit         *int64     `json:"limit"`
+	Order         *string    `json:"order"`
 } // @name PostsFilter
+
+// EventsFilter Wraps the filters of the group events. The dates are matched against the event start, the mappings without a known start fall back to their creation date.
+type EventsFilter struct {
+	StartDate    *time.Time `json:"start_date"`    // events starting at or after
+	EndDate      *time.Time `json:"end_date"`      // events starting at or before
+	UpcomingOnly bool       `json:"upcoming_only"` // events which have not ended yet
+	Offset       *int64     `json:"offset"`
+	Limit        *int64     `json:"limit"`
+} // @name EventsFilter
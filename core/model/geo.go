@@ -0,0 +1,60 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "errors"
+
+// EarthRadiusMeters is the equatorial radius used to convert distances to radians for the spherical geo queries
+const EarthRadiusMeters = 6378100.0
+
+// GeoPoint represents a GeoJSON point. The coordinates are longitude first, then latitude.
+type GeoPoint struct {
+	Type        string    `json:"type" bson:"type"`
+	Coordinates []float64 `json:"coordinates" bson:"coordinates"`
+} // @name GeoPoint
+
+// NewGeoPoint creates a GeoJSON point
+func NewGeoPoint(latitude float64, longitude float64) *GeoPoint {
+	return &GeoPoint{Type: "Point", Coordinates: []float64{longitude, latitude}}
+}
+
+// Validate checks if the point is a valid GeoJSON point
+func (p *GeoPoint) Validate() error {
+	if p.Type != "Point" {
+		return errors.New("location type must be Point")
+	}
+	if len(p.Coordinates) != 2 {
+		return errors.New("location coordinates must be [longitude, latitude]")
+	}
+	if p.Coordinates[0] < -180 || p.Coordinates[0] > 180 {
+		return errors.New("location longitude must be between -180 and 180")
+	}
+	if p.Coordinates[1] < -90 || p.Coordinates[1] > 90 {
+		return errors.New("location latitude must be between -90 and 90")
+	}
+	return nil
+}
+
+// GeoNearFilter matches the locations within the radius around the center
+type GeoNearFilter struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	Radius    float64 `json:"radius"` // in meters
+} // @name GeoNearFilter
+
+// IsValid checks if the center and the radius are valid
+func (f *GeoNearFilter) IsValid() bool {
+	return f != nil && f.Radius > 0 && f.Latitude >= -90 && f.Latitude <= 90 && f.Longitude >= -180 && f.Longitude <= 180
+}
@@ -15,7 +15,11 @@
 package model
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"sort"
+	"strings"
 	"time"
 )
 
@@ -23,7 +27,8 @@ import (
 type Group struct {
 	ID                  string   `json:"id" bson:"_id"`
 	ClientID            string   `json:"client_id" bson:"client_id"`
-	Category            string   `json:"category" bson:"category"` //one of the enums categories list
+	Category            string   `json:"category" bson:"category"`               //one of the enums categories list
+	Categories          []string `json:"categories" bson:"categories,omitempty"` // all the categories of the group, the first one is kept in the legacy category field
 	Title               string   `json:"title" bson:"title"`
 	Privacy             string   `json:"privacy" bson:"privacy"` //public or private
 	HiddenForSearch     bool     `json:"hidden_for_search" bson:"hidden_for_search"`
@@ -34,24 +39,36 @@ type Group struct {
 	MembershipQuestions []string `json:"membership_questions" bson:"membership_questions"`
 	IsAbuse             *bool    `json:"is_abuse,omitempty" bson:"is_abuse,omitempty"`
 
-	Settings   *GroupSettings         `json:"settings" bson:"settings"` // TODO: Remove the pointer once the backward support is not needed any more!
+	MembershipQuestionsSchema []MembershipQuestion `json:"membership_questions_schema,omitempty" bson:"membership_questions_schema,omitempty"` // the typed membership questions, MembershipQuestions keeps their texts for the legacy clients
+
+	Localizations GroupLocalizations `json:"localizations,omitempty" bson:"localizations,omitempty"` // per locale title and description, the primary title stays unique
+	Locale        string             `json:"locale,omitempty" bson:"-"`                              // the locale applied to the title and the description, empty for the primary ones
+
+	Settings   *GroupSettings         `json:"settings" bson:"settings"`               // TODO: Remove the pointer once the backward support is not needed any more!
+	Rules      *GroupRules            `json:"rules,omitempty" bson:"rules,omitempty"` // managed through the rules APIs
 	Attributes map[string]interface{} `json:"attributes" bson:"attributes"`
+	Location   *GeoPoint              `json:"location,omitempty" bson:"location,omitempty"` // optional, for the location based groups
 
 	CurrentMember *GroupMembership `json:"current_member"` // this is indicative and it's not required for update APIs
 	Members       []Member         `json:"members,omitempty" bson:"members,omitempty"`
+	Leaders       []GroupLeader    `json:"leaders,omitempty" bson:"-"` // public regardless of the member list visibility
 	Stats         GroupStats       `json:"stats" bson:"stats"`
 
+	CreatorID string `json:"creator_id,omitempty" bson:"creator_id,omitempty"` // the account which created the group, not tracked for the groups created before
+
 	DateCreated                  time.Time  `json:"date_created" bson:"date_created"`
 	DateUpdated                  *time.Time `json:"date_updated" bson:"date_updated"`
 	DateMembershipUpdated        *time.Time `json:"date_membership_updated" bson:"date_membership_updated"`
 	DateManagedMembershipUpdated *time.Time `json:"date_managed_membership_updated" bson:"date_managed_membership_updated"`
 
-	AuthmanEnabled             bool    `json:"authman_enabled" bson:"authman_enabled"`
-	AuthmanGroup               *string `json:"authman_group" bson:"authman_group"`
-	OnlyAdminsCanCreatePolls   bool    `json:"only_admins_can_create_polls" bson:"only_admins_can_create_polls"`
-	CanJoinAutomatically       bool    `json:"can_join_automatically" bson:"can_join_automatically"`
-	BlockNewMembershipRequests bool    `json:"block_new_membership_requests" bson:"block_new_membership_requests"`
-	AttendanceGroup            bool    `json:"attendance_group" bson:"attendance_group"`
+	AuthmanEnabled             bool     `json:"authman_enabled" bson:"authman_enabled"`
+	AuthmanGroup               *string  `json:"authman_group" bson:"authman_group"`
+	AuthmanGroups              []string `json:"authman_groups,omitempty" bson:"authman_groups,omitempty"` // additional Authman group keys, the membership is the union of all keys
+	OnlyAdminsCanCreatePolls   bool     `json:"only_admins_can_create_polls" bson:"only_admins_can_create_polls"`
+	CanJoinAutomatically       bool     `json:"can_join_automatically" bson:"can_join_automatically"`
+	BlockNewMembershipRequests bool     `json:"block_new_membership_requests" bson:"block_new_membership_requests"`
+	AttendanceGroup            bool     `json:"attendance_group" bson:"attendance_group"`
+	ReactionsMigrated          bool     `json:"reactions_migrated" bson:"reactions_migrated"` // the post reactions are managed by the Social BB, the local reaction writes are disabled
 
 	ResearchOpen             bool                           `json:"research_open" bson:"research_open"`
 	ResearchGroup            bool                           `json:"research_group" bson:"research_group"`
@@ -62,6 +79,20 @@ type Group struct {
 
 	SyncStartTime *time.Time `json:"sync_start_time" bson:"sync_start_time"`
 	SyncEndTime   *time.Time `json:"sync_end_time" bson:"sync_end_time"`
+
+	AuthmanSyncWatermark *time.Time `json:"authman_sync_watermark" bson:"authman_sync_watermark"` // the time the memberships are synchronized up to, used by the incremental sync
+
+	SmartGroupRules *SmartGroupRules `json:"smart_group_rules,omitempty" bson:"smart_group_rules,omitempty"` // the membership is derived from the Core BB account attributes
+	JoinRules       *GroupJoinRules  `json:"join_rules,omitempty" bson:"join_rules,omitempty"`               // the matching membership requests are approved automatically
+
+	OrgUnit *GroupOrgUnit `json:"org_unit,omitempty" bson:"org_unit,omitempty"` // the campus org unit of the group, the name is synchronized from the hierarchy
+
+	DateAdminNeeded *time.Time `json:"date_admin_needed,omitempty" bson:"date_admin_needed,omitempty"` // the last admin has left and there has been no member to promote, the org admins have to appoint one
+
+	DatePendingReminderSent *time.Time `json:"-" bson:"date_pending_reminder_sent,omitempty"` // the last reminder of the admins about the outstanding membership requests
+
+	DateDeleted *time.Time `json:"date_deleted,omitempty" bson:"date_deleted,omitempty"` // the group is excluded from all the queries, it could be restored until the purge date
+	DatePurge   *time.Time `json:"date_purge,omitempty" bson:"date_purge,omitempty"`     // the content of the deleted group is deleted then, unset once the purge starts
 } // @name Group
 
 // GetGroupMembershipsResponse response
@@ -71,6 +102,30 @@ type GetGroupMembershipsResponse struct {
 	Status  string `json:"status"`
 } // @name GetGroupMembershipsResponse
 
+// UserGroupMemberships represents the group memberships of a user given by the bulk lookup
+type UserGroupMemberships struct {
+	UserID      string                `json:"user_id"`
+	Memberships []UserGroupMembership `json:"memberships"`
+} // @name UserGroupMemberships
+
+// UserGroupMembership represents a group membership of a user given by the bulk lookup
+type UserGroupMembership struct {
+	UserID  string `json:"-" bson:"user_id"`
+	GroupID string `json:"group_id" bson:"group_id"`
+	Title   string `json:"group_title" bson:"title"`
+	Status  string `json:"status" bson:"status"`
+	IsAdmin bool   `json:"is_admin" bson:"-"`
+} // @name UserGroupMembership
+
+// GroupLeader represents the public information of a member marked to be shown as a group leader
+type GroupLeader struct {
+	ID       string `json:"id"` // membership id
+	UserID   string `json:"user_id"`
+	Name     string `json:"name"`
+	PhotoURL string `json:"photo_url"`
+	Status   string `json:"status"`
+} // @name GroupLeader
+
 // ApplyLegacyMembership applies legacy membership to the group for backward compatibility
 func (gr *Group) ApplyLegacyMembership(membershipCollection MembershipCollection) {
 	var list []Member
@@ -111,7 +166,92 @@ func (gr *Group) CreateMembershipEmptyAnswers() []MemberAnswer {
 
 // IsAuthmanSyncEligible Checks if the group has all required artefacts for an Authman Synchronization
 func (gr *Group) IsAuthmanSyncEligible() bool {
-	return gr.AuthmanEnabled && gr.AuthmanGroup != nil && *gr.AuthmanGroup != ""
+	return gr.AuthmanEnabled && len(gr.GetAuthmanGroupKeys()) > 0
+}
+
+// IsSmartGroupSyncEligible Checks if the group membership is derived from the smart group rules. The Authman groups are not smart groups.
+func (gr *Group) IsSmartGroupSyncEligible() bool {
+	return !gr.AuthmanEnabled && gr.SmartGroupRules != nil && gr.SmartGroupRules.Enabled && len(gr.SmartGroupRules.Match) > 0
+}
+
+// GetAuthmanGroupKeys gives all Authman group keys mapped to the group. The authman_group key is the first one if set.
+func (gr *Group) GetAuthmanGroupKeys() []string {
+	var keys []string
+	exists := map[string]bool{}
+	add := func(key string) {
+		if key != "" && !exists[key] {
+			exists[key] = true
+			keys = append(keys, key)
+		}
+	}
+	if gr.AuthmanGroup != nil {
+		add(*gr.AuthmanGroup)
+	}
+	for _, key := range gr.AuthmanGroups {
+		add(key)
+	}
+	return keys
+}
+
+// GetPrimaryAuthmanGroupKey gives the Authman group key used for the member writes to Authman
+func (gr *Group) GetPrimaryAuthmanGroupKey() *string {
+	keys := gr.GetAuthmanGroupKeys()
+	if len(keys) == 0 {
+		return nil
+	}
+	return &keys[0]
+}
+
+// maxAuthmanGroupKeys limits the Authman groups mapped to a single group
+const maxAuthmanGroupKeys = 50
+
+// ValidateAuthmanGroupKeys validates the Authman group keys mapped to a group
+func ValidateAuthmanGroupKeys(keys []string) error {
+	if len(keys) > maxAuthmanGroupKeys {
+		return fmt.Errorf("too many Authman groups - max %d", maxAuthmanGroupKeys)
+	}
+	exists := map[string]bool{}
+	for _, key := range keys {
+		if strings.TrimSpace(key) == "" {
+			return fmt.Errorf("empty Authman group key")
+		}
+		if exists[key] {
+			return fmt.Errorf("duplicated Authman group key %s", key)
+		}
+		exists[key] = true
+	}
+	return nil
+}
+
+// QuietHoursEnd gives the end of the group quiet hours if the provided time falls within them, otherwise nil
+func (gr *Group) QuietHoursEnd(t time.Time) *time.Time {
+	if gr.Settings == nil || gr.Settings.QuietHours == nil {
+		return nil
+	}
+	return gr.Settings.QuietHours.DeferUntil(t)
+}
+
+// RequiresPostApproval checks if the posts of the member have to be approved by an admin before they become visible. The posts of the admins are never moderated.
+func (gr *Group) RequiresPostApproval(membership *GroupMembership) bool {
+	if gr.Settings == nil || !gr.Settings.PostPreferences.PostsRequireApproval {
+		return false
+	}
+	return membership == nil || !membership.IsAdmin()
+}
+
+// IsPublicFeedEnabled checks if the public posts of the group are given in the public feed. Only the public groups have the feed unless
+// the admins disable it.
+func (gr *Group) IsPublicFeedEnabled() bool {
+	if gr.Privacy != "public" {
+		return false
+	}
+	return gr.Settings == nil || !gr.Settings.PublicFeedDisabled
+}
+
+// ResearchConsentVersion gives a version of the research consent which changes whenever the consent statement or details change
+func (gr *Group) ResearchConsentVersion() string {
+	hash := sha256.Sum256([]byte(gr.ResearchConsentStatement + "\n" + gr.ResearchConsentDetails))
+	return hex.EncodeToString(hash[:8])
 }
 
 // GetNewCategory gets new category attribute
@@ -133,6 +273,40 @@ func (gr *Group) SetNewCategory(category string) {
 	gr.Attributes["category"] = category
 }
 
+// SyncCategories keeps the categories and the legacy category, which is the first of the categories, in sync. The legacy clients set the
+// category only, so the other current categories of the group are kept then.
+func (gr *Group) SyncCategories(currentCategories []string) {
+	if gr.Categories == nil {
+		if gr.Category == "" {
+			if category := gr.GetNewCategory(); category != nil {
+				gr.Category = *category
+			}
+		}
+		gr.Categories = []string{}
+		if gr.Category != "" {
+			gr.Categories = append(gr.Categories, gr.Category)
+			if len(currentCategories) > 1 {
+				gr.Categories = append(gr.Categories, currentCategories[1:]...)
+			}
+		}
+	}
+
+	categories := []string{}
+	added := map[string]bool{}
+	for _, category := range gr.Categories {
+		if category != "" && !added[category] {
+			categories = append(categories, category)
+			added[category] = true
+		}
+	}
+	gr.Categories = categories
+	if len(categories) > 0 {
+		gr.Category = categories[0]
+	} else {
+		gr.Category = ""
+	}
+}
+
 // GetNewTags Gets new tags attribute
 func (gr *Group) GetNewTags() []string {
 	if gr.Attributes != nil {
@@ -0,0 +1,88 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// GroupActionMembershipApprove approves a pending membership
+	GroupActionMembershipApprove = "membership.approve"
+	// GroupActionMembershipReject rejects a pending membership
+	GroupActionMembershipReject = "membership.reject"
+	// GroupActionMembershipRemove removes a membership from the group
+	GroupActionMembershipRemove = "membership.remove"
+	// GroupActionMembershipMute mutes a member for the duration of the action, the member cannot post nor reply within the group meanwhile
+	GroupActionMembershipMute = "membership.mute"
+	// GroupActionPostDelete deletes a post of the group
+	GroupActionPostDelete = "post.delete"
+	// GroupActionPostPin pins a top level post of the group
+	GroupActionPostPin = "post.pin"
+
+	// MinGroupActionMuteDuration is the shortest mute of the membership.mute action
+	MinGroupActionMuteDuration = time.Minute
+	// MaxGroupActionMuteDuration is the longest mute of the membership.mute action
+	MaxGroupActionMuteDuration = 365 * 24 * time.Hour
+)
+
+// GroupActionTypes lists all supported group admin quick actions
+var GroupActionTypes = []string{GroupActionMembershipApprove, GroupActionMembershipReject, GroupActionMembershipRemove, GroupActionMembershipMute,
+	GroupActionPostDelete, GroupActionPostPin}
+
+// GroupAction represents a single group admin quick action. Only the target field which corresponds to the type is required.
+type GroupAction struct {
+	Type         string  `json:"type" validate:"required"`
+	MembershipID *string `json:"membership_id"`
+	PostID       *string `json:"post_id"`
+	Reason       *string `json:"reason"`   // reject reason
+	Duration     *int64  `json:"duration"` // mute duration in seconds
+} // @name GroupAction
+
+// TargetID gives the id of the entity the action is applied to
+func (a GroupAction) TargetID() *string {
+	switch a.Type {
+	case GroupActionMembershipApprove, GroupActionMembershipReject, GroupActionMembershipRemove, GroupActionMembershipMute:
+		return a.MembershipID
+	case GroupActionPostDelete, GroupActionPostPin:
+		return a.PostID
+	}
+	return nil
+}
+
+// MuteDuration gives the duration of the membership.mute action. It gives nil if the duration is missing or out of the allowed range.
+func (a GroupAction) MuteDuration() *time.Duration {
+	if a.Duration == nil || *a.Duration < int64(MinGroupActionMuteDuration/time.Second) || *a.Duration > int64(MaxGroupActionMuteDuration/time.Second) {
+		return nil
+	}
+	duration := time.Duration(*a.Duration) * time.Second
+	return &duration
+}
+
+// IsSupported checks if the action type is supported
+func (a GroupAction) IsSupported() bool {
+	for _, actionType := range GroupActionTypes {
+		if actionType == a.Type {
+			return true
+		}
+	}
+	return false
+}
+
+// GroupActionResult represents the result of a group admin quick action
+type GroupActionResult struct {
+	Type     string  `json:"type"`
+	TargetID string  `json:"target_id"`
+	Success  bool    `json:"success"`
+	Error    *string `json:"error,omitempty"`
+} // @name GroupActionResult
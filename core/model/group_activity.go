@@ -0,0 +1,44 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// MaxGroupActivityItems is the maximum number of the posts, the events and the joins listed within the group activity
+const MaxGroupActivityItems = 50
+
+// GroupActivity summarizes what happened in the group since a moment. The lists are limited to the newest MaxGroupActivityItems items,
+// the counts cover all the items.
+type GroupActivity struct {
+	Since time.Time `json:"since"`
+
+	NewPosts      []Post `json:"new_posts"`
+	NewPostsCount int    `json:"new_posts_count"`
+
+	NewEvents      []Event `json:"new_events"`
+	NewEventsCount int     `json:"new_events_count"`
+
+	Joins      []GroupActivityMember `json:"joins"` // empty when the members cannot see the member info of the group
+	JoinsCount int                   `json:"joins_count"`
+
+	PinnedPosts []Post `json:"pinned_posts"` // posts pinned since the moment
+} // @name GroupActivity
+
+// GroupActivityMember represents a user who joined the group
+type GroupActivityMember struct {
+	UserID     string    `json:"user_id"`
+	Name       string    `json:"name,omitempty"`
+	DateJoined time.Time `json:"date_joined"`
+} // @name GroupActivityMember
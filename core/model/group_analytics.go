@@ -0,0 +1,43 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupAnalytics represents the time-series stats of a single group
+type GroupAnalytics struct {
+	GroupID            string           `json:"group_id"`
+	Weeks              int              `json:"weeks"`
+	PostsPerWeek       []WeeklyCount    `json:"posts_per_week"`
+	NewMembersPerWeek  []WeeklyCount    `json:"new_members_per_week"`
+	EventsPerWeek      []WeeklyCount    `json:"events_per_week"`
+	EventsCount        int64            `json:"events_count"`
+	ActiveMembersCount int64            `json:"active_members_count"` // posted or reacted within the last 30 days
+	RejectionsByReason map[string]int64 `json:"rejections_by_reason"` // rejected membership requests by reason code, "other" for the free text reasons
+	DateGenerated      time.Time        `json:"date_generated"`
+} // @name GroupAnalytics
+
+// WeeklyCount represents a count within an ISO week which starts on the WeekStart Monday
+type WeeklyCount struct {
+	WeekStart time.Time `json:"week_start"`
+	Count     int64     `json:"count"`
+} // @name WeeklyCount
+
+// ISOWeekStart gives the Monday of the ISO week
+func ISOWeekStart(year int, week int) time.Time {
+	jan4 := time.Date(year, time.January, 4, 0, 0, 0, 0, time.UTC)
+	firstMonday := jan4.AddDate(0, 0, -((int(jan4.Weekday()) + 6) % 7))
+	return firstMonday.AddDate(0, 0, (week-1)*7)
+}
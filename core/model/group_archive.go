@@ -0,0 +1,84 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"time"
+)
+
+const (
+	// GroupArchiveEntityGroup archived group
+	GroupArchiveEntityGroup = "group"
+	// GroupArchiveEntityPost archived post of the group
+	GroupArchiveEntityPost = "post"
+	// GroupArchiveEntityEvent archived event mapping of the group
+	GroupArchiveEntityEvent = "event"
+
+	// maxGroupArchiveRetentionDays the longest retention of the archived content, 100 years
+	maxGroupArchiveRetentionDays = 36500
+	// maxGroupRestoreDays the longest restore period of the deleted groups
+	maxGroupRestoreDays = 365
+)
+
+// GroupDeletionPolicyConfig defines the per client handling of the content of the deleted groups. The content is deleted by default.
+// With archive_content the group, its posts and its event mappings are moved to the archive and purged after the retention period.
+// With restore_days the deleted groups could be restored by the admins within the period before their content is deleted.
+type GroupDeletionPolicyConfig struct {
+	Type           string `json:"type" bson:"type"`
+	ClientID       string `json:"client_id" bson:"client_id"`
+	ArchiveContent bool   `json:"archive_content" bson:"archive_content"`
+	RetentionDays  int    `json:"retention_days" bson:"retention_days"`
+	RestoreDays    int    `json:"restore_days" bson:"restore_days"`
+} //@name GroupDeletionPolicyConfig
+
+// Validate validates the policy
+func (c GroupDeletionPolicyConfig) Validate() error {
+	if c.RetentionDays < 0 || c.RetentionDays > maxGroupArchiveRetentionDays {
+		return errors.New("retention_days must be between 0 and 36500")
+	}
+	if c.ArchiveContent && c.RetentionDays == 0 {
+		return errors.New("retention_days is required to archive the content")
+	}
+	if c.RestoreDays < 0 || c.RestoreDays > maxGroupRestoreDays {
+		return errors.New("restore_days must be between 0 and 365")
+	}
+	return nil
+}
+
+// DatePurge gives the date the content of a group deleted at the provided time is deleted
+func (c *GroupDeletionPolicyConfig) DatePurge(dateDeleted time.Time) time.Time {
+	if c == nil {
+		return dateDeleted
+	}
+	return dateDeleted.AddDate(0, 0, c.RestoreDays)
+}
+
+// ArchivesContent tells if the content of the deleted groups is archived
+func (c *GroupDeletionPolicyConfig) ArchivesContent() bool {
+	return c != nil && c.ArchiveContent && c.RetentionDays > 0
+}
+
+// GroupArchiveItem represents an entity of a deleted group kept in the archive until the purge date
+type GroupArchiveItem struct {
+	ID           string                 `json:"id" bson:"_id"`
+	ClientID     string                 `json:"client_id" bson:"client_id"`
+	GroupID      string                 `json:"group_id" bson:"group_id"`
+	EntityType   string                 `json:"entity_type" bson:"entity_type"` // group, post or event
+	EntityID     string                 `json:"entity_id" bson:"entity_id"`
+	Data         map[string]interface{} `json:"data" bson:"data"` // the stored document as it was on the deletion
+	DateArchived time.Time              `json:"date_archived" bson:"date_archived"`
+	DatePurge    time.Time              `json:"date_purge" bson:"date_purge"`
+} // @name GroupArchiveItem
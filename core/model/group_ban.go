@@ -0,0 +1,54 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// GroupBan represents a user banned from the group. The ban is kept when the membership is deleted, so the user cannot join
+// the group again and is not added back by the Authman and the smart group syncs.
+type GroupBan struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	UserID      string    `json:"user_id,omitempty" bson:"user_id,omitempty"`
+	ExternalID  string    `json:"external_id,omitempty" bson:"external_id,omitempty"`
+	Name        string    `json:"name,omitempty" bson:"name,omitempty"`
+	NetID       string    `json:"net_id,omitempty" bson:"net_id,omitempty"`
+	Reason      string    `json:"reason,omitempty" bson:"reason,omitempty"`
+	BannedBy    string    `json:"banned_by" bson:"banned_by"` // user id of the admin
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name GroupBan
+
+// GroupBanError is returned when a banned user tries to join the group or to be added to it
+type GroupBanError struct {
+	GroupID string
+}
+
+func (e *GroupBanError) Error() string {
+	return fmt.Sprintf("the user is banned from group %s", e.GroupID)
+}
+
+// PostingMutedError is returned when a member muted by an admin tries to post or reply within the group
+type PostingMutedError struct {
+	GroupID    string
+	MutedUntil time.Time
+}
+
+func (e *PostingMutedError) Error() string {
+	return fmt.Sprintf("the member is muted in group %s until %s", e.GroupID, e.MutedUntil.Format(time.RFC3339))
+}
@@ -0,0 +1,113 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// GroupDeletionJobStatusRunning the content of the group is being deleted
+	GroupDeletionJobStatusRunning = "running"
+	// GroupDeletionJobStatusSucceeded the group and all its content are deleted
+	GroupDeletionJobStatusSucceeded = "succeeded"
+	// GroupDeletionJobStatusFailed the deletion stopped on an error, it is resumed by the next run of the deletion task
+	GroupDeletionJobStatusFailed = "failed"
+
+	// GroupDeletionStepMemberships deletion of the memberships
+	GroupDeletionStepMemberships = "memberships"
+	// GroupDeletionStepPosts deletion (or archival) of the posts
+	GroupDeletionStepPosts = "posts"
+	// GroupDeletionStepPostRevisions deletion of the post revisions
+	GroupDeletionStepPostRevisions = "post_revisions"
+	// GroupDeletionStepReadCursors deletion of the read cursors
+	GroupDeletionStepReadCursors = "read_cursors"
+	// GroupDeletionStepPostBookmarks deletion of the post bookmarks
+	GroupDeletionStepPostBookmarks = "post_bookmarks"
+	// GroupDeletionStepBans deletion of the bans
+	GroupDeletionStepBans = "bans"
+	// GroupDeletionStepMessages deletion of the chat messages
+	GroupDeletionStepMessages = "messages"
+	// GroupDeletionStepEvents archival of the event mappings
+	GroupDeletionStepEvents = "events"
+	// GroupDeletionStepEventCheckInCodes deletion of the event check-in codes
+	GroupDeletionStepEventCheckInCodes = "event_checkin_codes"
+	// GroupDeletionStepEventAttendances deletion of the event attendance records
+	GroupDeletionStepEventAttendances = "event_attendances"
+	// GroupDeletionStepWebhookDeliveries deletion of the webhook deliveries of the group events. The subscriptions are per client, so
+	// they are kept.
+	GroupDeletionStepWebhookDeliveries = "webhook_deliveries"
+	// GroupDeletionStepNotificationBursts deletion of the post notification bursts
+	GroupDeletionStepNotificationBursts = "post_notification_bursts"
+	// GroupDeletionStepAbuseReports deletion of the abuse reports
+	GroupDeletionStepAbuseReports = "abuse_reports"
+	// GroupDeletionStepGroup final removal of the group
+	GroupDeletionStepGroup = "group"
+
+	// maxGroupDeletionJobErrors limits the error details stored per job
+	maxGroupDeletionJobErrors = 20
+)
+
+// GroupDeletionSteps lists the steps of a group deletion in the order of processing
+var GroupDeletionSteps = []string{GroupDeletionStepMemberships, GroupDeletionStepPosts, GroupDeletionStepPostRevisions,
+	GroupDeletionStepReadCursors, GroupDeletionStepPostBookmarks, GroupDeletionStepBans, GroupDeletionStepMessages, GroupDeletionStepEvents,
+	GroupDeletionStepEventCheckInCodes, GroupDeletionStepEventAttendances, GroupDeletionStepWebhookDeliveries,
+	GroupDeletionStepNotificationBursts, GroupDeletionStepAbuseReports, GroupDeletionStepGroup}
+
+// GroupDeletionJob represents the progress of the purge of a deleted group. The content of the group is deleted in batches outside of
+// a transaction, so the purge of a large group does not exceed the transaction limits and could be resumed after a failure. The group
+// itself is removed last.
+type GroupDeletionJob struct {
+	ID         string           `json:"id" bson:"_id"`
+	ClientID   string           `json:"client_id" bson:"client_id"`
+	GroupID    string           `json:"group_id" bson:"group_id"`
+	GroupTitle string           `json:"group_title" bson:"group_title"`
+	DatePurge  *time.Time       `json:"date_purge,omitempty" bson:"date_purge,omitempty"` // the content is archived until then by the deletion policy
+	Status     string           `json:"status" bson:"status"`                             // running, succeeded or failed
+	Step       string           `json:"step" bson:"step"`                                 // the step in progress
+	Processed  map[string]int64 `json:"processed" bson:"processed"`                       // the number of the deleted or archived items per step
+	Errors     []string         `json:"errors,omitempty" bson:"errors,omitempty"`
+
+	DateStarted time.Time  `json:"date_started" bson:"date_started"`
+	DateUpdated *time.Time `json:"date_updated" bson:"date_updated"`
+	DateEnded   *time.Time `json:"date_ended" bson:"date_ended"`
+} // @name GroupDeletionJob
+
+// NewGroupDeletionJob creates a job for the deletion of the group
+func NewGroupDeletionJob(id string, group Group, datePurge *time.Time) GroupDeletionJob {
+	return GroupDeletionJob{ID: id, ClientID: group.ClientID, GroupID: group.ID, GroupTitle: group.Title, DatePurge: datePurge,
+		Status: GroupDeletionJobStatusRunning, Step: GroupDeletionSteps[0], Processed: map[string]int64{}, DateStarted: time.Now().UTC()}
+}
+
+// Archives tells if the content of the group is moved to the archive instead of deletion
+func (j *GroupDeletionJob) Archives() bool {
+	return j.DatePurge != nil
+}
+
+// AddError records an error detail of the job
+func (j *GroupDeletionJob) AddError(err error) {
+	if err == nil {
+		return
+	}
+	if len(j.Errors) >= maxGroupDeletionJobErrors {
+		j.Errors = j.Errors[1:]
+	}
+	j.Errors = append(j.Errors, err.Error())
+}
+
+// GroupDeletionJobFilter Wraps all possible filters for getting the group deletion jobs
+type GroupDeletionJobFilter struct {
+	GroupID *string `json:"group_id"`
+	Status  *string `json:"status"`
+	Limit   *int64  `json:"limit"`
+} // @name GroupDeletionJobFilter
@@ -0,0 +1,49 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// GroupFeedItemTypePost post feed item
+	GroupFeedItemTypePost = "post"
+	// GroupFeedItemTypeEvent event feed item
+	GroupFeedItemTypeEvent = "event"
+	// GroupFeedItemTypeMembershipMilestone membership milestone feed item
+	GroupFeedItemTypeMembershipMilestone = "membership_milestone"
+)
+
+// GroupMembershipMilestones are the member counts which are reported as milestones within the group feed
+var GroupMembershipMilestones = []int{10, 25, 50, 100, 250, 500, 1000, 2500, 5000, 10000}
+
+// GroupFeedItem represents a single entry of the group activity feed. Only the field which corresponds to the type is set.
+type GroupFeedItem struct {
+	Type      string               `json:"type"`
+	Date      time.Time            `json:"date"`
+	Post      *Post                `json:"post,omitempty"`
+	Event     *Event               `json:"event,omitempty"`
+	Milestone *MembershipMilestone `json:"milestone,omitempty"`
+} // @name GroupFeedItem
+
+// MembershipMilestone represents the moment when the group has reached a members count
+type MembershipMilestone struct {
+	MembersCount int `json:"members_count"`
+} // @name MembershipMilestone
+
+// GroupFeedFilter Wraps all possible filters for getting the group feed
+type GroupFeedFilter struct {
+	Offset *int64 `json:"offset"`
+	Limit  *int64 `json:"limit"`
+} // @name GroupFeedFilter
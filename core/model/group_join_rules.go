@@ -0,0 +1,92 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// maxJoinRuleEmailDomains limits the email domains of the join rules
+	maxJoinRuleEmailDomains = 20
+	// maxJoinRuleAttributes limits the account attributes the join rules could match
+	maxJoinRuleAttributes = 20
+)
+
+// GroupJoinRules defines which membership requests are approved automatically. A request is approved if the user matches any of the criteria:
+// the email domain, the NetID pattern or the Core BB account attributes. The attributes are passed as search params to the Core BB accounts API
+// like the smart group rules, i.e. {"profile.department": ["CS", "ECE"]}.
+type GroupJoinRules struct {
+	Enabled      bool                   `json:"enabled" bson:"enabled"`
+	EmailDomains []string               `json:"email_domains,omitempty" bson:"email_domains,omitempty"`   // i.e. illinois.edu
+	NetIDPattern string                 `json:"net_id_pattern,omitempty" bson:"net_id_pattern,omitempty"` // regular expression matched against the whole NetID
+	AccountMatch map[string]interface{} `json:"account_match,omitempty" bson:"account_match,omitempty"`
+} // @name GroupJoinRules
+
+// Validate validates the join rules
+func (r *GroupJoinRules) Validate() error {
+	if len(r.EmailDomains) == 0 && len(r.NetIDPattern) == 0 && len(r.AccountMatch) == 0 {
+		return errors.New("missing email domains, NetID pattern or account match attributes")
+	}
+	if len(r.EmailDomains) > maxJoinRuleEmailDomains {
+		return fmt.Errorf("too many email domains - max %d", maxJoinRuleEmailDomains)
+	}
+	for _, domain := range r.EmailDomains {
+		domain = strings.TrimPrefix(domain, "@")
+		if len(domain) == 0 || strings.Contains(domain, "@") {
+			return fmt.Errorf("invalid email domain %s", domain)
+		}
+	}
+	if len(r.NetIDPattern) > 0 {
+		_, err := regexp.Compile(r.NetIDPattern)
+		if err != nil {
+			return fmt.Errorf("invalid NetID pattern: %s", err)
+		}
+	}
+	if len(r.AccountMatch) > maxJoinRuleAttributes {
+		return fmt.Errorf("too many account match attributes - max %d", maxJoinRuleAttributes)
+	}
+	for attribute, value := range r.AccountMatch {
+		if len(attribute) == 0 {
+			return errors.New("empty account match attribute")
+		}
+		if value == nil {
+			return fmt.Errorf("missing value for account match attribute %s", attribute)
+		}
+	}
+	return nil
+}
+
+// MatchesEmailOrNetID checks if the email domain or the NetID of the user match the rules
+func (r *GroupJoinRules) MatchesEmailOrNetID(email string, netID string) bool {
+	if at := strings.LastIndex(email, "@"); at >= 0 {
+		emailDomain := email[at+1:]
+		for _, domain := range r.EmailDomains {
+			if strings.EqualFold(strings.TrimPrefix(domain, "@"), emailDomain) {
+				return true
+			}
+		}
+	}
+	if len(r.NetIDPattern) > 0 && len(netID) > 0 {
+		matched, err := regexp.MatchString(fmt.Sprintf("^(?:%s)$", r.NetIDPattern), netID)
+		if err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxGroupLocalizations the maximum number of the locales a group could be localized in
+const MaxGroupLocalizations = 50
+
+// localePattern matches the BCP 47 like locales - en, es-MX, zh-Hant-TW
+var localePattern = regexp.MustCompile(`^[a-zA-Z]{2,3}(-[a-zA-Z0-9]{2,8})*$`)
+
+// GroupLocalization represents the title and the description of a group in a given locale. The empty values fall back to the primary ones.
+type GroupLocalization struct {
+	Title       string  `json:"title" bson:"title"`
+	Description *string `json:"description,omitempty" bson:"description,omitempty"`
+} // @name GroupLocalization
+
+// GroupLocalizations represents the localized group metadata by locale
+type GroupLocalizations map[string]GroupLocalization
+
+// Validate validates the locales of the localized group metadata. The localized titles are not required to be unique,
+// the uniqueness is checked only for the primary title.
+func (l GroupLocalizations) Validate() error {
+	if len(l) > MaxGroupLocalizations {
+		return fmt.Errorf("a group could be localized in up to %d locales", MaxGroupLocalizations)
+	}
+	locales := map[string]bool{}
+	for locale := range l {
+		if !localePattern.MatchString(locale) {
+			return fmt.Errorf("invalid locale %s", locale)
+		}
+		key := strings.ToLower(locale)
+		if locales[key] {
+			return fmt.Errorf("duplicate locale %s", locale)
+		}
+		locales[key] = true
+	}
+	return nil
+}
+
+// ParseLocales parses the locale negotiation value - a comma separated list of locales ordered by preference, e.g. "es-MX,es".
+// The Accept-Language quality values are ignored.
+func ParseLocales(value string) []string {
+	var locales []string
+	for _, item := range strings.Split(value, ",") {
+		locale := strings.TrimSpace(strings.Split(item, ";")[0])
+		if len(locale) > 0 {
+			locales = append(locales, locale)
+		}
+	}
+	return locales
+}
+
+// Localize applies the title and the description of the best matching locale. Every requested locale is tried as it is and then by its
+// language (es-MX falls back to es). The primary title and description are kept if no locale matches.
+func (gr *Group) Localize(locales []string) {
+	if len(gr.Localizations) == 0 || len(locales) == 0 {
+		return
+	}
+
+	for _, locale := range locales {
+		candidates := []string{locale}
+		if language := strings.Split(locale, "-")[0]; language != locale {
+			candidates = append(candidates, language)
+		}
+		for _, candidate := range candidates {
+			for key, localization := range gr.Localizations {
+				if !strings.EqualFold(key, candidate) {
+					continue
+				}
+				if len(localization.Title) > 0 {
+					gr.Title = localization.Title
+				}
+				if localization.Description != nil && len(*localization.Description) > 0 {
+					gr.Description = localization.Description
+				}
+				gr.Locale = key
+				return
+			}
+		}
+	}
+}
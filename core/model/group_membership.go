@@ -17,6 +17,16 @@ func (c *MembershipCollection) ApplyGroupSettings(settings *GroupSettings) {
 	}
 }
 
+// ApplyMemberView applies the group settings for the member lists of the group members. The fields visible only to the group admins
+// are removed from the memberships of the others than the user.
+func (c *MembershipCollection) ApplyMemberView(settings *GroupSettings, userID string) {
+	for index := range c.Items {
+		if c.Items[index].UserID != userID {
+			c.Items[index].ApplyMemberView(settings)
+		}
+	}
+}
+
 // GetMembershipByAccountID Finds a membership by account ID
 func (c *MembershipCollection) GetMembershipByAccountID(accountID string) *GroupMembership {
 	if len(c.Items) > 0 {
@@ -112,15 +122,64 @@ type GroupMembership struct {
 
 	RejectReason  string         `json:"reject_reason" bson:"reject_reason"`
 	MemberAnswers []MemberAnswer `json:"member_answers" bson:"member_answers"`
-	SyncID        string         `json:"sync_id" bson:"sync_id"` //ID of sync that last updated this membership
+	SyncID        string         `json:"sync_id" bson:"sync_id"`               //ID of sync that last updated this membership
+	ShowAsLeader  bool           `json:"show_as_leader" bson:"show_as_leader"` // listed publicly within the group leaders regardless of the member list visibility
+
+	RejectReasonCode string `json:"reject_reason_code,omitempty" bson:"reject_reason_code,omitempty"` // code of the rejection reasons catalog
+	RejectReasonNote string `json:"reject_reason_note,omitempty" bson:"reject_reason_note,omitempty"`
+
+	Mentionable *bool `json:"mentionable,omitempty" bson:"mentionable,omitempty"` // nil means the member could be mentioned
+	Searchable  *bool `json:"searchable,omitempty" bson:"searchable,omitempty"`   // nil means the member is listed in the member pickers
 
 	NotificationsPreferences NotificationsPreferences `json:"notifications_preferences" bson:"notifications_preferences"`
+	MutedUntil               *time.Time               `json:"muted_until,omitempty" bson:"muted_until,omitempty"`     // all the notifications of the group are muted until then
+	SnoozedUntil             *time.Time               `json:"snoozed_until,omitempty" bson:"snoozed_until,omitempty"` // the immediate notifications of the group are muted until then, the digests still accumulate
+
+	PostingMutedUntil *time.Time `json:"posting_muted_until,omitempty" bson:"posting_muted_until,omitempty"` // muted by an admin, the member cannot post nor reply until then
+
+	DigestFrequency string     `json:"digest_frequency,omitempty" bson:"digest_frequency,omitempty"` // daily or weekly, the new posts and events are notified within the digests only
+	DigestEmail     bool       `json:"digest_email,omitempty" bson:"digest_email,omitempty"`         // the digests are emailed too
+	DateLastDigest  *time.Time `json:"date_last_digest,omitempty" bson:"date_last_digest,omitempty"`
 
 	DateCreated  time.Time  `json:"date_created" bson:"date_created"`
 	DateUpdated  *time.Time `json:"date_updated" bson:"date_updated"`
 	DateAttended *time.Time `json:"date_attended" bson:"date_attended"`
+
+	DateStaleFlagged *time.Time `json:"date_stale_flagged,omitempty" bson:"date_stale_flagged,omitempty"` // set when the member is flagged as inactive by the pruning policy
+
+	ScheduledTransition *MembershipTransition `json:"scheduled_transition,omitempty" bson:"scheduled_transition,omitempty"` // the status change scheduled by an admin, i.e. at the end of an officer term
+
+	RulesAcknowledgedVersion int        `json:"rules_acknowledged_version" bson:"rules_acknowledged_version,omitempty"`
+	DateRulesAcknowledged    *time.Time `json:"date_rules_acknowledged,omitempty" bson:"date_rules_acknowledged,omitempty"`
+
+	CalendarFeedVersion                 int        `json:"-" bson:"calendar_feed_version,omitempty"`                    // increased by every rotation of the calendar feed token
+	DatePreviousCalendarFeedVersionEnds *time.Time `json:"-" bson:"date_previous_calendar_feed_version_ends,omitempty"` // end of the overlap of the previous token
 } //@name GroupMembership
 
+// AcceptsCalendarFeedVersion checks if the calendar feed token of the version is valid at the provided time. The previous token is
+// still valid until the overlap after a rotation ends.
+func (m *GroupMembership) AcceptsCalendarFeedVersion(version int, now time.Time) bool {
+	if version == m.CalendarFeedVersion {
+		return true
+	}
+	return version == m.CalendarFeedVersion-1 && m.DatePreviousCalendarFeedVersionEnds != nil && m.DatePreviousCalendarFeedVersionEnds.After(now)
+}
+
+// IsMuted checks if the notifications of the group are temporarily muted at the provided time
+func (m *GroupMembership) IsMuted(now time.Time) bool {
+	return m.MutedUntil != nil && m.MutedUntil.After(now)
+}
+
+// IsPostingMuted checks if the member is muted by an admin at the provided time, so they cannot post nor reply within the group
+func (m *GroupMembership) IsPostingMuted(now time.Time) bool {
+	return m.PostingMutedUntil != nil && m.PostingMutedUntil.After(now)
+}
+
+// IsSnoozed checks if the immediate notifications of the group are snoozed at the provided time
+func (m *GroupMembership) IsSnoozed(now time.Time) bool {
+	return m.SnoozedUntil != nil && m.SnoozedUntil.After(now)
+}
+
 // GetDisplayName Constructs a display name based on the current data state
 func (m *GroupMembership) GetDisplayName() string {
 	if len(m.Name) > 0 {
@@ -216,6 +275,30 @@ func (m *GroupMembership) ApplyGroupSettings(settings *GroupSettings) {
 
 }
 
+// ApplyMemberView applies the group settings and removes the fields visible only to the group admins, i.e. the member answers, the
+// notification preferences and the rejection reasons
+func (m *GroupMembership) ApplyMemberView(settings *GroupSettings) {
+	m.ApplyGroupSettings(settings)
+	if settings != nil && !settings.MemberInfoPreferences.AllowMemberInfo {
+		m.Name = ""
+		m.NetID = ""
+		m.Email = ""
+	}
+
+	m.MemberAnswers = nil
+	m.NotificationsPreferences = NotificationsPreferences{}
+	m.MutedUntil = nil
+	m.SnoozedUntil = nil
+	m.PostingMutedUntil = nil
+	m.DigestFrequency = ""
+	m.DigestEmail = false
+	m.DateLastDigest = nil
+	m.RejectReason = ""
+	m.RejectReasonCode = ""
+	m.RejectReasonNote = ""
+	m.ScheduledTransition = nil
+}
+
 // ToShortMemberRecord converts to ShortMemberRecord
 func (m *GroupMembership) ToShortMemberRecord() ShortMemberRecord {
 	return ShortMemberRecord{
@@ -251,12 +334,34 @@ func (m *GroupMembership) ToMember() Member {
 		Status:        m.Status,
 		RejectReason:  m.RejectReason,
 		MemberAnswers: m.MemberAnswers,
+		ShowAsLeader:  m.ShowAsLeader,
 		DateCreated:   m.DateCreated,
 		DateUpdated:   m.DateUpdated,
 		DateAttended:  m.DateAttended,
 	}
 }
 
+// IsMentionable checks if the member could be mentioned within the group posts
+func (m *GroupMembership) IsMentionable() bool {
+	return m.Mentionable == nil || *m.Mentionable
+}
+
+// IsSearchable checks if the member is listed in the member pickers
+func (m *GroupMembership) IsSearchable() bool {
+	return m.Searchable == nil || *m.Searchable
+}
+
+// ToGroupLeader converts the membership to its public leader representation
+func (m *GroupMembership) ToGroupLeader() GroupLeader {
+	return GroupLeader{
+		ID:       m.ID,
+		UserID:   m.UserID,
+		Name:     m.Name,
+		PhotoURL: m.PhotoURL,
+		Status:   m.Status,
+	}
+}
+
 // NotificationsPreferences overrides default notification preferences on group level
 type NotificationsPreferences struct {
 	OverridePreferences bool `json:"override_preferences" bson:"override_preferences"`
@@ -267,6 +372,24 @@ type NotificationsPreferences struct {
 	PollsMuted          bool `json:"polls_mute" bson:"polls_mute"`
 } // @name NotificationsPreferences
 
+// Mute mutes the notifications of the unsubscribe scope. It gives false if they are already muted.
+func (p *NotificationsPreferences) Mute(scope string) bool {
+	if p.OverridePreferences && (p.AllMute || (scope == UnsubscribeScopePosts && p.PostsMuted) || (scope == UnsubscribeScopeEvents && p.EventsMuted)) {
+		return false
+	}
+
+	p.OverridePreferences = true
+	switch scope {
+	case UnsubscribeScopePosts:
+		p.PostsMuted = true
+	case UnsubscribeScopeEvents:
+		p.EventsMuted = true
+	default:
+		p.AllMute = true
+	}
+	return true
+}
+
 // MembershipStatuses list of membership statuses
 type MembershipStatuses []MembershipStatus
 
@@ -0,0 +1,80 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GroupMessageMaxLength is the max length of a chat message body
+const GroupMessageMaxLength = 2000
+
+// GroupMessage represents a lightweight chat message within a group. Unlike the posts the messages have no subject, no replies and expire after the retention period.
+type GroupMessage struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	Sender      Creator   `json:"sender" bson:"sender"`
+	Body        string    `json:"body" bson:"body"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name GroupMessage
+
+// GroupMessagesPage represents a page of group messages ordered from the newest to the oldest one
+type GroupMessagesPage struct {
+	Messages   []GroupMessage `json:"messages"`
+	NextCursor *string        `json:"next_cursor"` // nil when there are no older messages
+} // @name GroupMessagesPage
+
+// GroupMessagesCursor represents the decoded opaque cursor of the group messages pagination. It points to the oldest message of the previous page
+// within the (date_created, id) sort order so the next page is stable while new messages arrive.
+type GroupMessagesCursor struct {
+	DateCreated time.Time `json:"d"`
+	ID          string    `json:"i"`
+}
+
+// Encode encodes the cursor as an opaque string
+func (c GroupMessagesCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseGroupMessagesCursor decodes an opaque group messages cursor
+func ParseGroupMessagesCursor(cursor string) (*GroupMessagesCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid messages cursor: %s", err)
+	}
+
+	var result GroupMessagesCursor
+	err = json.Unmarshal(data, &result)
+	if err != nil || result.ID == "" {
+		return nil, fmt.Errorf("invalid messages cursor")
+	}
+	return &result, nil
+}
+
+// NextGroupMessagesCursor gives the cursor of the next page or nil when the provided page is the last one
+func NextGroupMessagesCursor(messages []GroupMessage, limit int64) *string {
+	if limit <= 0 || int64(len(messages)) < limit {
+		return nil
+	}
+
+	last := messages[len(messages)-1]
+	cursor := GroupMessagesCursor{DateCreated: last.DateCreated, ID: last.ID}.Encode()
+	return &cursor
+}
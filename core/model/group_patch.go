@@ -0,0 +1,158 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "errors"
+
+// GroupPatch holds the group fields modified by a partial update. The fields missing from the patch are left unchanged. The Authman
+// and the membership question fields are updated by the full group update only.
+type GroupPatch struct {
+	Title                      *string                        `json:"title"`
+	Description                *string                        `json:"description"`
+	Category                   *string                        `json:"category"`
+	Categories                 []string                       `json:"categories"`
+	Tags                       []string                       `json:"tags"`
+	Privacy                    *string                        `json:"privacy"`
+	HiddenForSearch            *bool                          `json:"hidden_for_search"`
+	ImageURL                   *string                        `json:"image_url"`
+	WebURL                     *string                        `json:"web_url"`
+	OnlyAdminsCanCreatePolls   *bool                          `json:"only_admins_can_create_polls"`
+	CanJoinAutomatically       *bool                          `json:"can_join_automatically"`
+	BlockNewMembershipRequests *bool                          `json:"block_new_membership_requests"`
+	AttendanceGroup            *bool                          `json:"attendance_group"`
+	ResearchOpen               *bool                          `json:"research_open"`
+	ResearchConsentStatement   *string                        `json:"research_consent_statement"`
+	ResearchConsentDetails     *string                        `json:"research_consent_details"`
+	ResearchDescription        *string                        `json:"research_description"`
+	ResearchProfile            map[string]map[string][]string `json:"research_profile"`
+	Settings                   *GroupSettings                 `json:"settings"`
+	Attributes                 map[string]interface{}         `json:"attributes"`
+	Location                   *GeoPoint                      `json:"location"`
+	Localizations              GroupLocalizations             `json:"localizations"`
+} // @name GroupPatch
+
+// Validate validates the provided fields of the patch
+func (p GroupPatch) Validate() error {
+	if p.Title != nil && len(*p.Title) == 0 {
+		return errors.New("title must not be empty")
+	}
+	if p.Privacy != nil && *p.Privacy != "public" && *p.Privacy != "private" {
+		return errors.New("privacy must be public or private")
+	}
+	if p.Settings != nil {
+		if err := p.Settings.Validate(); err != nil {
+			return err
+		}
+	}
+	if p.Location != nil {
+		if err := p.Location.Validate(); err != nil {
+			return err
+		}
+	}
+	return p.Localizations.Validate()
+}
+
+// IsEmpty checks if the patch modifies no field
+func (p GroupPatch) IsEmpty() bool {
+	return p.Title == nil && p.Description == nil && p.Category == nil && p.Categories == nil && p.Tags == nil && p.Privacy == nil &&
+		p.HiddenForSearch == nil && p.ImageURL == nil && p.WebURL == nil && p.OnlyAdminsCanCreatePolls == nil && p.CanJoinAutomatically == nil &&
+		p.BlockNewMembershipRequests == nil && p.AttendanceGroup == nil && p.ResearchOpen == nil && p.ResearchConsentStatement == nil &&
+		p.ResearchConsentDetails == nil && p.ResearchDescription == nil && p.ResearchProfile == nil && p.Settings == nil &&
+		p.Attributes == nil && p.Location == nil && p.Localizations == nil
+}
+
+// ModifiesResearch checks if the patch modifies any of the research project fields
+func (p GroupPatch) ModifiesResearch() bool {
+	return p.ResearchOpen != nil || p.ResearchConsentStatement != nil || p.ResearchConsentDetails != nil ||
+		p.ResearchDescription != nil || p.ResearchProfile != nil
+}
+
+// Apply sets the provided fields of the patch to the group. The categories and the legacy category are kept in sync, so the patch is
+// updated with both of them once either is provided.
+func (p *GroupPatch) Apply(group *Group) {
+	if p.Title != nil {
+		group.Title = *p.Title
+	}
+	if p.Description != nil {
+		group.Description = p.Description
+	}
+	if p.Categories != nil {
+		group.Categories = p.Categories
+		group.SyncCategories(nil)
+	} else if p.Category != nil {
+		currentCategories := group.Categories
+		group.Category = *p.Category
+		group.Categories = nil
+		group.SyncCategories(currentCategories)
+	}
+	if p.Categories != nil || p.Category != nil {
+		p.Categories = group.Categories
+		p.Category = &group.Category
+	}
+	if p.Tags != nil {
+		group.Tags = p.Tags
+	}
+	if p.Privacy != nil {
+		group.Privacy = *p.Privacy
+	}
+	if p.HiddenForSearch != nil {
+		group.HiddenForSearch = *p.HiddenForSearch
+	}
+	if p.ImageURL != nil {
+		group.ImageURL = p.ImageURL
+	}
+	if p.WebURL != nil {
+		group.WebURL = p.WebURL
+	}
+	if p.OnlyAdminsCanCreatePolls != nil {
+		group.OnlyAdminsCanCreatePolls = *p.OnlyAdminsCanCreatePolls
+	}
+	if p.CanJoinAutomatically != nil {
+		group.CanJoinAutomatically = *p.CanJoinAutomatically
+	}
+	if p.BlockNewMembershipRequests != nil {
+		group.BlockNewMembershipRequests = *p.BlockNewMembershipRequests
+	}
+	if p.AttendanceGroup != nil {
+		group.AttendanceGroup = *p.AttendanceGroup
+	}
+	if p.ResearchOpen != nil {
+		group.ResearchOpen = *p.ResearchOpen
+	}
+	if p.ResearchConsentStatement != nil {
+		group.ResearchConsentStatement = *p.ResearchConsentStatement
+	}
+	if p.ResearchConsentDetails != nil {
+		group.ResearchConsentDetails = *p.ResearchConsentDetails
+	}
+	if p.ResearchDescription != nil {
+		group.ResearchDescription = *p.ResearchDescription
+	}
+	if p.ResearchProfile != nil {
+		group.ResearchProfile = p.ResearchProfile
+	}
+	if p.Settings != nil {
+		group.Settings = p.Settings
+	}
+	if p.Attributes != nil {
+		group.Attributes = p.Attributes
+	}
+	if p.Location != nil {
+		group.Location = p.Location
+	}
+	if p.Localizations != nil {
+		group.Localizations = p.Localizations
+	}
+}
@@ -0,0 +1,53 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupPublicFeed represents the public posts of a public group rendered as a feed for the websites
+type GroupPublicFeed struct {
+	GroupID     string
+	Title       string
+	Description string
+	Updated     time.Time // the latest change of the entries, the group creation if there are no entries
+	Entries     []GroupPublicFeedEntry
+}
+
+// GroupPublicFeedEntry represents a post in the public feed. The creator is not given as the feed is read without login.
+type GroupPublicFeedEntry struct {
+	ID        string
+	Title     string
+	Content   string
+	ImageURL  string
+	Published time.Time
+	Updated   time.Time
+}
+
+// NewGroupPublicFeedEntry constructs the feed entry of a post
+func NewGroupPublicFeedEntry(post Post) GroupPublicFeedEntry {
+	entry := GroupPublicFeedEntry{ID: post.ID, Title: post.Subject, Content: post.Body, Published: post.DateCreated}
+	// the scheduled posts are published once they are sent
+	if post.DateScheduled != nil && post.DateScheduled.After(entry.Published) {
+		entry.Published = *post.DateScheduled
+	}
+	entry.Updated = entry.Published
+	if post.DateEdited != nil && post.DateEdited.After(entry.Updated) {
+		entry.Updated = *post.DateEdited
+	}
+	if post.ImageURL != nil {
+		entry.ImageURL = *post.ImageURL
+	}
+	return entry
+}
@@ -0,0 +1,51 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupRules represents the versioned rules (code of conduct) of a group
+type GroupRules struct {
+	Text        string    `json:"text" bson:"text"`
+	Version     int       `json:"version" bson:"version"` // increases whenever the text changes
+	DateUpdated time.Time `json:"date_updated" bson:"date_updated"`
+} // @name GroupRules
+
+// GroupRulesStatus represents the rules of a group and their acknowledgement by the current user
+type GroupRulesStatus struct {
+	Rules                   *GroupRules `json:"rules"`
+	AcknowledgedVersion     int         `json:"acknowledged_version"`
+	DateAcknowledged        *time.Time  `json:"date_acknowledged"`
+	AcknowledgementRequired bool        `json:"acknowledgement_required"`
+} // @name GroupRulesStatus
+
+// RequiresRulesAcknowledgement checks if the member has to acknowledge the current rules of the group before posting. The admins are not required to.
+func (gr *Group) RequiresRulesAcknowledgement(membership *GroupMembership) bool {
+	if gr.Rules == nil || gr.Rules.Version == 0 || membership == nil || membership.IsAdmin() {
+		return false
+	}
+	return membership.RulesAcknowledgedVersion < gr.Rules.Version
+}
+
+// GetRulesStatus constructs the rules status of the group for the membership
+func (gr *Group) GetRulesStatus(membership *GroupMembership) GroupRulesStatus {
+	status := GroupRulesStatus{Rules: gr.Rules}
+	if membership != nil {
+		status.AcknowledgedVersion = membership.RulesAcknowledgedVersion
+		status.DateAcknowledged = membership.DateRulesAcknowledged
+	}
+	status.AcknowledgementRequired = gr.RequiresRulesAcknowledgement(membership)
+	return status
+}
@@ -1,11 +1,47 @@
 package model
 
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
 // GroupSettings wraps group settings and flags as a separate unit
 type GroupSettings struct {
 	MemberInfoPreferences MemberInfoPreferences `json:"member_info_preferences" bson:"member_info_preferences"`
 	PostPreferences       PostPreferences       `json:"post_preferences" bson:"post_preferences"`
+	QuietHours            *QuietHours           `json:"quiet_hours,omitempty" bson:"quiet_hours,omitempty"`
+	WelcomeMessage        *WelcomeMessage       `json:"welcome_message,omitempty" bson:"welcome_message,omitempty"`
+	PollPreferences       *PollPreferences      `json:"poll_preferences,omitempty" bson:"poll_preferences,omitempty"`
+	PublicFeedDisabled    bool                  `json:"public_feed_disabled" bson:"public_feed_disabled"` // the public posts of a public group are not given in the Atom feed for the websites
+	EventReminders        bool                  `json:"event_reminders" bson:"event_reminders"`           // the members going to the events get the scheduled reminders unless they opted out, otherwise only the opted in members get them
 } // @name GroupSettings
 
+// Validate validates the settings
+func (s GroupSettings) Validate() error {
+	err := s.PostPreferences.Validate()
+	if err != nil {
+		return err
+	}
+	if s.QuietHours != nil {
+		err = s.QuietHours.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if s.WelcomeMessage != nil {
+		err = s.WelcomeMessage.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	if s.PollPreferences != nil {
+		return s.PollPreferences.Validate()
+	}
+	return nil
+}
+
 // DefaultGroupSettings Returns default settings
 func DefaultGroupSettings() GroupSettings {
 	return GroupSettings{
@@ -44,4 +80,138 @@ type PostPreferences struct {
 	CanSendPostToAll             bool `json:"can_send_post_to_all" bson:"can_send_post_to_all"`
 	CanSendPostReplies           bool `json:"can_send_post_replies" bson:"can_send_post_replies"`
 	CanSendPostReactions         bool `json:"can_send_post_reactions" bson:"can_send_post_reactions"`
+	PostsByAdminsOnly            bool `json:"posts_by_admins_only" bson:"posts_by_admins_only"`     // announcement mode - only the admins create top level posts, the members may still reply
+	PostsRequireApproval         bool `json:"posts_require_approval" bson:"posts_require_approval"` // pre-moderation - the posts of the members are visible once an admin approves them
+
+	AllowedReactions []string `json:"allowed_reactions,omitempty" bson:"allowed_reactions,omitempty"` // the emoji the members may react with, any if empty
 } // @name PostPreferences
+
+// MaxAllowedReactions is the maximum size of the configurable reaction set of a group
+const MaxAllowedReactions = 20
+
+// Validate validates the post preferences
+func (p PostPreferences) Validate() error {
+	if len(p.AllowedReactions) > MaxAllowedReactions {
+		return fmt.Errorf("at most %d allowed reactions can be set", MaxAllowedReactions)
+	}
+	exists := map[string]bool{}
+	for _, reaction := range p.AllowedReactions {
+		if len(reaction) == 0 {
+			return errors.New("the allowed reactions must not be empty")
+		}
+		if exists[reaction] {
+			return fmt.Errorf("duplicate allowed reaction %s", reaction)
+		}
+		exists[reaction] = true
+	}
+	return nil
+}
+
+// IsReactionAllowed checks if the members may react with the reaction
+func (p PostPreferences) IsReactionAllowed(reaction string) bool {
+	if len(reaction) == 0 {
+		return false
+	}
+	if len(p.AllowedReactions) == 0 {
+		return true
+	}
+	for _, allowed := range p.AllowedReactions {
+		if allowed == reaction {
+			return true
+		}
+	}
+	return false
+}
+
+// MaxWelcomeMessageLength is the maximum length of the welcome message of a group
+const MaxWelcomeMessageLength = 2000
+
+// WelcomeMessage wraps the message sent to the users once they become members or admins of the group
+type WelcomeMessage struct {
+	Enabled  bool   `json:"enabled" bson:"enabled"`
+	Text     string `json:"text" bson:"text"`
+	AutoPost bool   `json:"auto_post" bson:"auto_post"` // the message is posted within the group to the new members too
+} // @name WelcomeMessage
+
+// Validate validates the welcome message
+func (w WelcomeMessage) Validate() error {
+	if !w.Enabled {
+		return nil
+	}
+	if len(strings.TrimSpace(w.Text)) == 0 {
+		return errors.New("the welcome message text is required")
+	}
+	if len(w.Text) > MaxWelcomeMessageLength {
+		return fmt.Errorf("the welcome message must be at most %d characters", MaxWelcomeMessageLength)
+	}
+	return nil
+}
+
+// QuietHours wraps the daily time range in which the post notifications of the group are deferred
+type QuietHours struct {
+	Start    string `json:"start" bson:"start"`       // HH:MM
+	End      string `json:"end" bson:"end"`           // HH:MM, before the start for a range over midnight
+	Timezone string `json:"timezone" bson:"timezone"` // IANA time zone, e.g. America/Chicago
+} // @name QuietHours
+
+// Validate validates the quiet hours
+func (q QuietHours) Validate() error {
+	start, err := parseClockMinutes(q.Start)
+	if err != nil {
+		return fmt.Errorf("invalid quiet hours start: %s", err)
+	}
+	end, err := parseClockMinutes(q.End)
+	if err != nil {
+		return fmt.Errorf("invalid quiet hours end: %s", err)
+	}
+	if start == end {
+		return fmt.Errorf("quiet hours start and end must differ")
+	}
+	if _, err := time.LoadLocation(q.Timezone); err != nil || len(q.Timezone) == 0 {
+		return fmt.Errorf("invalid quiet hours timezone %s", q.Timezone)
+	}
+	return nil
+}
+
+// DeferUntil gives the end of the quiet hours if the provided time falls within them, otherwise nil
+func (q QuietHours) DeferUntil(t time.Time) *time.Time {
+	start, err := parseClockMinutes(q.Start)
+	if err != nil {
+		return nil
+	}
+	end, err := parseClockMinutes(q.End)
+	if err != nil || start == end {
+		return nil
+	}
+	location, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return nil
+	}
+
+	local := t.In(location)
+	minutes := local.Hour()*60 + local.Minute()
+	dayOffset := -1
+	if start < end {
+		if minutes >= start && minutes < end {
+			dayOffset = 0
+		}
+	} else if minutes >= start {
+		dayOffset = 1 // the range ends tomorrow
+	} else if minutes < end {
+		dayOffset = 0 // the range started yesterday
+	}
+	if dayOffset < 0 {
+		return nil
+	}
+
+	until := time.Date(local.Year(), local.Month(), local.Day()+dayOffset, end/60, end%60, 0, 0, location)
+	return &until
+}
+
+func parseClockMinutes(value string) (int, error) {
+	clock, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, fmt.Errorf("%s is not in HH:MM format", value)
+	}
+	return clock.Hour()*60 + clock.Minute(), nil
+}
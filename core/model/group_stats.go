@@ -9,3 +9,13 @@ type GroupStats struct {
 	RejectedCount   int `json:"rejected_count" bson:"rejected_count"`
 	AttendanceCount int `json:"attendance_count" bson:"attendance_count"`
 } //@name GroupStats
+
+// GroupStatsRecalculation is the result of the recalculation of the stats of one group
+type GroupStatsRecalculation struct {
+	GroupID  string     `json:"group_id"`
+	Title    string     `json:"title"`
+	Previous GroupStats `json:"previous"`
+	Current  GroupStats `json:"current"`
+	Changed  bool       `json:"changed"`
+	Updated  bool       `json:"updated"` // false on dry run
+} //@name GroupStatsRecalculation
@@ -0,0 +1,61 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GroupsCursor represents the decoded opaque cursor of the groups pagination. It points to the last group of the previous page
+// within the (date_created, id) sort order. Neither of them changes, so the next page is stable while groups are being created or renamed.
+type GroupsCursor struct {
+	DateCreated time.Time `json:"d"`
+	ID          string    `json:"i"`
+}
+
+// Encode encodes the cursor as an opaque string
+func (c GroupsCursor) Encode() string {
+	data, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// ParseGroupsCursor decodes an opaque groups cursor
+func ParseGroupsCursor(cursor string) (*GroupsCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return nil, fmt.Errorf("invalid groups cursor: %s", err)
+	}
+
+	var result GroupsCursor
+	err = json.Unmarshal(data, &result)
+	if err != nil || result.ID == "" {
+		return nil, fmt.Errorf("invalid groups cursor")
+	}
+	return &result, nil
+}
+
+// NextGroupsCursor gives the cursor of the next page or nil when the provided page is the last one
+func NextGroupsCursor(groups []Group, limit *int64) *string {
+	if limit == nil || *limit <= 0 || int64(len(groups)) < *limit {
+		return nil
+	}
+
+	last := groups[len(groups)-1]
+	cursor := GroupsCursor{DateCreated: last.DateCreated, ID: last.ID}.Encode()
+	return &cursor
+}
@@ -0,0 +1,141 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// maxInternalEventServices limits the services of the internal event services config
+const maxInternalEventServices = 50
+
+// InternalEventServicesConfig defines per client which services may link the events to which groups through the internal API. A service
+// may link an event to a group if the group has any of the categories or matches all the attributes of the service. Without the config
+// any service may link the events to any group.
+type InternalEventServicesConfig struct {
+	Type     string                 `json:"type" bson:"type"`
+	ClientID string                 `json:"client_id" bson:"client_id"`
+	Services []InternalEventService `json:"services" bson:"services"`
+} //@name InternalEventServicesConfig
+
+// InternalEventService defines the groups a service may link the events to
+type InternalEventService struct {
+	Name       string                 `json:"name" bson:"name"` // the service query param of the internal API
+	Categories []string               `json:"categories,omitempty" bson:"categories,omitempty"`
+	Attributes map[string]interface{} `json:"attributes,omitempty" bson:"attributes,omitempty"` // i.e. {"college": "Engineering"}, a list attribute matches if it contains the value
+} //@name InternalEventService
+
+// Validate validates the config
+func (c InternalEventServicesConfig) Validate() error {
+	if len(c.Services) > maxInternalEventServices {
+		return fmt.Errorf("up to %d services could be configured", maxInternalEventServices)
+	}
+	names := map[string]bool{}
+	for _, service := range c.Services {
+		if len(service.Name) == 0 {
+			return errors.New("the service name must not be empty")
+		}
+		if names[service.Name] {
+			return fmt.Errorf("duplicated service %s", service.Name)
+		}
+		names[service.Name] = true
+		if len(service.Categories) == 0 && len(service.Attributes) == 0 {
+			return fmt.Errorf("missing categories or attributes for service %s", service.Name)
+		}
+	}
+	return nil
+}
+
+// Allows checks if the service may link the events to the group
+func (c *InternalEventServicesConfig) Allows(service string, group *Group) bool {
+	if c == nil {
+		return true
+	}
+	if group == nil {
+		return false
+	}
+	for _, item := range c.Services {
+		if item.Name == service {
+			return item.matches(group)
+		}
+	}
+	return false
+}
+
+func (s InternalEventService) matches(group *Group) bool {
+	for _, category := range s.Categories {
+		for _, groupCategory := range append([]string{group.Category}, group.Categories...) {
+			if groupCategory != "" && groupCategory == category {
+				return true
+			}
+		}
+	}
+	if len(s.Attributes) == 0 {
+		return false
+	}
+	for key, value := range s.Attributes {
+		if !attributeMatches(group.Attributes[key], value) {
+			return false
+		}
+	}
+	return true
+}
+
+// attributeMatches compares the values by their string representation, so the numbers decoded from JSON and from the database match
+func attributeMatches(groupValue interface{}, value interface{}) bool {
+	if groupValue == nil {
+		return false
+	}
+	list := reflect.ValueOf(groupValue)
+	if list.Kind() == reflect.Slice {
+		for i := 0; i < list.Len(); i++ {
+			if fmt.Sprint(list.Index(i).Interface()) == fmt.Sprint(value) {
+				return true
+			}
+		}
+		return false
+	}
+	return fmt.Sprint(groupValue) == fmt.Sprint(value)
+}
+
+// EventServiceNotAllowedError is returned when the service may not link the events to the group
+type EventServiceNotAllowedError struct {
+	Service string
+	GroupID string
+}
+
+func (e *EventServiceNotAllowedError) Error() string {
+	return fmt.Sprintf("service '%s' is not allowed to link events to group %s", e.Service, e.GroupID)
+}
+
+// CalendarEventNotFoundError is returned when the event is not known to the Calendar BB
+type CalendarEventNotFoundError struct {
+	EventID string
+}
+
+func (e *CalendarEventNotFoundError) Error() string {
+	return fmt.Sprintf("event %s not found in the calendar", e.EventID)
+}
+
+// EventMappingExistsError is returned when the event is already linked to the group
+type EventMappingExistsError struct {
+	Mapping Event
+}
+
+func (e *EventMappingExistsError) Error() string {
+	return fmt.Sprintf("event %s is already linked to group %s", e.Mapping.EventID, e.Mapping.GroupID)
+}
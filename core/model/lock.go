@@ -0,0 +1,25 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// DistributedLock represents a lease based lock shared by all service instances. The lock is taken over once the lease of the owner expires.
+type DistributedLock struct {
+	Name         string    `json:"name" bson:"_id"`
+	Owner        string    `json:"owner" bson:"owner"` // instance id
+	DateAcquired time.Time `json:"date_acquired" bson:"date_acquired"`
+	DateExpires  time.Time `json:"date_expires" bson:"date_expires"`
+} // @name DistributedLock
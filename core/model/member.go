@@ -33,6 +33,7 @@ type Member struct {
 	Status        string         `json:"status" bson:"status"` //pending, member, admin, rejected
 	RejectReason  string         `json:"reject_reason" bson:"reject_reason"`
 	MemberAnswers []MemberAnswer `json:"member_answers" bson:"member_answers"`
+	ShowAsLeader  bool           `json:"show_as_leader" bson:"show_as_leader"`
 
 	DateCreated  time.Time  `json:"date_created" bson:"date_created"`
 	DateUpdated  *time.Time `json:"date_updated" bson:"date_updated"`
@@ -116,8 +117,9 @@ type ToMember struct {
 
 // MemberAnswer represents member answer entity
 type MemberAnswer struct {
-	Question string `json:"question" bson:"question"`
-	Answer   string `json:"answer" bson:"answer"`
+	Question string   `json:"question" bson:"question"`
+	Answer   string   `json:"answer" bson:"answer"`
+	Values   []string `json:"values,omitempty" bson:"values,omitempty"` // the selected options of a multi_select question
 } //@name MemberAnswer
 
 // IsAdmin says if the user is admin of the group
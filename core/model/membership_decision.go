@@ -0,0 +1,34 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "fmt"
+
+// MembershipDecision represents the approval or the rejection of a pending membership
+type MembershipDecision struct {
+	MembershipID     string
+	Approve          bool
+	RejectReasonCode string // code of the rejection reasons catalog
+	RejectReasonNote string // optional note of the rejection
+}
+
+// PendingMembershipError is returned when a decided membership is not a pending membership of the group
+type PendingMembershipError struct {
+	MembershipID string
+}
+
+func (e *PendingMembershipError) Error() string {
+	return fmt.Sprintf("membership %s is not a pending membership of the group", e.MembershipID)
+}
@@ -0,0 +1,76 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+// DuplicateMemberships represents the memberships of the same user within the same group
+type DuplicateMemberships struct {
+	GroupID       string   `json:"group_id" bson:"group_id"`
+	UserID        string   `json:"user_id" bson:"user_id"`
+	MembershipIDs []string `json:"membership_ids" bson:"membership_ids"`
+} // @name DuplicateMemberships
+
+// MembershipDedupResult represents the outcome of merging the duplicate memberships of a client
+type MembershipDedupResult struct {
+	ClientID          string `json:"client_id"`
+	DryRun            bool   `json:"dry_run"`
+	DuplicateSets     int    `json:"duplicate_sets"`     // the number of the (group, user) pairs with more than one membership
+	MembershipsMerged int64  `json:"memberships_merged"` // the number of the removed redundant memberships
+	GroupsRepaired    int    `json:"groups_repaired"`    // the number of the groups whose stats have been recalculated
+	Failed            int    `json:"failed"`
+} // @name MembershipDedupResult
+
+// MergeDuplicateMemberships gives the membership which survives the merge of the duplicates and the IDs of the redundant ones.
+// The survivor keeps the highest status, the earliest join date and the data missing within it is taken from the duplicates.
+func MergeDuplicateMemberships(memberships []GroupMembership) (*GroupMembership, []string) {
+	if len(memberships) == 0 {
+		return nil, nil
+	}
+
+	survivorIndex := 0
+	for i, membership := range memberships {
+		survivor := memberships[survivorIndex]
+		rank, survivorRank := MembershipStatusRank(membership.Status), MembershipStatusRank(survivor.Status)
+		if rank > survivorRank || (rank == survivorRank && membership.DateCreated.Before(survivor.DateCreated)) {
+			survivorIndex = i
+		}
+	}
+
+	merged := memberships[survivorIndex]
+	var redundantIDs []string
+	for i, membership := range memberships {
+		if i == survivorIndex {
+			continue
+		}
+		redundantIDs = append(redundantIDs, membership.ID)
+
+		if membership.DateCreated.Before(merged.DateCreated) {
+			merged.DateCreated = membership.DateCreated
+		}
+		if len(merged.ExternalID) == 0 {
+			merged.ExternalID = membership.ExternalID
+		}
+		if len(merged.MemberAnswers) == 0 {
+			merged.MemberAnswers = membership.MemberAnswers
+		}
+		if membership.ShowAsLeader {
+			merged.ShowAsLeader = true
+		}
+		if membership.RulesAcknowledgedVersion > merged.RulesAcknowledgedVersion {
+			merged.RulesAcknowledgedVersion = membership.RulesAcknowledgedVersion
+			merged.DateRulesAcknowledged = membership.DateRulesAcknowledged
+		}
+	}
+	return &merged, redundantIDs
+}
@@ -0,0 +1,201 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+const (
+	// MembershipQuestionTypeText free text answer
+	MembershipQuestionTypeText = "text"
+	// MembershipQuestionTypeSingleSelect one of the question options
+	MembershipQuestionTypeSingleSelect = "single_select"
+	// MembershipQuestionTypeMultiSelect any of the question options
+	MembershipQuestionTypeMultiSelect = "multi_select"
+	// MembershipQuestionTypeBoolean "true" or "false"
+	MembershipQuestionTypeBoolean = "boolean"
+
+	// maxMembershipQuestions limits the membership questions of a group
+	maxMembershipQuestions = 20
+	// maxMembershipQuestionOptions limits the options of a select question
+	maxMembershipQuestionOptions = 50
+	// maxMemberAnswerLength limits the length of a text answer
+	maxMemberAnswerLength = 2000
+)
+
+// MembershipQuestion represents a typed membership question of a group
+type MembershipQuestion struct {
+	Question string   `json:"question" bson:"question"`
+	Type     string   `json:"type" bson:"type"`                           // text, single_select, multi_select or boolean
+	Options  []string `json:"options,omitempty" bson:"options,omitempty"` // for the select questions only
+	Required bool     `json:"required" bson:"required"`
+} // @name MembershipQuestion
+
+// Validate validates the membership question
+func (q *MembershipQuestion) Validate() error {
+	if len(strings.TrimSpace(q.Question)) == 0 {
+		return errors.New("missing question")
+	}
+
+	switch q.Type {
+	case MembershipQuestionTypeText, MembershipQuestionTypeBoolean:
+		if len(q.Options) > 0 {
+			return fmt.Errorf("options are not allowed for %s question '%s'", q.Type, q.Question)
+		}
+	case MembershipQuestionTypeSingleSelect, MembershipQuestionTypeMultiSelect:
+		if len(q.Options) == 0 {
+			return fmt.Errorf("missing options for %s question '%s'", q.Type, q.Question)
+		}
+		if len(q.Options) > maxMembershipQuestionOptions {
+			return fmt.Errorf("too many options for question '%s' - max %d", q.Question, maxMembershipQuestionOptions)
+		}
+		options := map[string]bool{}
+		for _, option := range q.Options {
+			if len(strings.TrimSpace(option)) == 0 {
+				return fmt.Errorf("empty option for question '%s'", q.Question)
+			}
+			if options[option] {
+				return fmt.Errorf("duplicate option '%s' for question '%s'", option, q.Question)
+			}
+			options[option] = true
+		}
+	default:
+		return fmt.Errorf("invalid type '%s' for question '%s'", q.Type, q.Question)
+	}
+	return nil
+}
+
+func (q *MembershipQuestion) hasOption(value string) bool {
+	for _, option := range q.Options {
+		if option == value {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateMembershipQuestions validates the typed membership questions of a group
+func ValidateMembershipQuestions(questions []MembershipQuestion) error {
+	if len(questions) > maxMembershipQuestions {
+		return fmt.Errorf("too many membership questions - max %d", maxMembershipQuestions)
+	}
+	for i := range questions {
+		err := questions[i].Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MemberAnswersError is returned when the member answers do not match the membership questions of the group
+type MemberAnswersError struct {
+	Reason string
+}
+
+func (e *MemberAnswersError) Error() string {
+	return fmt.Sprintf("invalid member answers: %s", e.Reason)
+}
+
+// SyncMembershipQuestions keeps the legacy question texts in sync with the typed membership questions.
+// Legacy clients send the question texts only, so the current schema is kept while they do not change the texts.
+func (gr *Group) SyncMembershipQuestions(currentSchema []MembershipQuestion) {
+	if gr.MembershipQuestionsSchema == nil && len(currentSchema) > 0 && sameMembershipQuestionTexts(gr.MembershipQuestions, currentSchema) {
+		gr.MembershipQuestionsSchema = currentSchema
+	}
+	if len(gr.MembershipQuestionsSchema) == 0 {
+		gr.MembershipQuestionsSchema = nil
+		return
+	}
+
+	gr.MembershipQuestions = make([]string, len(gr.MembershipQuestionsSchema))
+	for i, question := range gr.MembershipQuestionsSchema {
+		gr.MembershipQuestions[i] = question.Question
+	}
+}
+
+func sameMembershipQuestionTexts(questions []string, schema []MembershipQuestion) bool {
+	if len(questions) != len(schema) {
+		return false
+	}
+	for i, question := range schema {
+		if questions[i] != question.Question {
+			return false
+		}
+	}
+	return true
+}
+
+// ValidateMemberAnswers validates the member answers against the typed membership questions by position and normalizes them.
+// The legacy string questions are optional text questions, so the answers of the groups without a schema are not validated.
+func (gr *Group) ValidateMemberAnswers(answers []MemberAnswer) error {
+	if len(gr.MembershipQuestionsSchema) == 0 {
+		return nil
+	}
+	if len(answers) != len(gr.MembershipQuestionsSchema) {
+		return &MemberAnswersError{Reason: fmt.Sprintf("expected %d answers, got %d", len(gr.MembershipQuestionsSchema), len(answers))}
+	}
+
+	for i, question := range gr.MembershipQuestionsSchema {
+		answer := &answers[i]
+		if len(answer.Question) > 0 && answer.Question != question.Question {
+			return &MemberAnswersError{Reason: fmt.Sprintf("answer %d is not for question '%s'", i, question.Question)}
+		}
+		answer.Question = question.Question
+		answer.Answer = strings.TrimSpace(answer.Answer)
+
+		switch question.Type {
+		case MembershipQuestionTypeText:
+			if len(answer.Answer) > maxMemberAnswerLength {
+				return &MemberAnswersError{Reason: fmt.Sprintf("the answer of question '%s' is too long - max %d", question.Question, maxMemberAnswerLength)}
+			}
+			answer.Values = nil
+		case MembershipQuestionTypeBoolean:
+			if len(answer.Answer) > 0 && answer.Answer != "true" && answer.Answer != "false" {
+				return &MemberAnswersError{Reason: fmt.Sprintf("the answer of question '%s' must be true or false", question.Question)}
+			}
+			answer.Values = nil
+		case MembershipQuestionTypeSingleSelect:
+			if len(answer.Answer) > 0 && !question.hasOption(answer.Answer) {
+				return &MemberAnswersError{Reason: fmt.Sprintf("'%s' is not an option of question '%s'", answer.Answer, question.Question)}
+			}
+			answer.Values = nil
+		case MembershipQuestionTypeMultiSelect:
+			if len(answer.Values) == 0 && len(answer.Answer) > 0 {
+				answer.Values = []string{answer.Answer}
+			}
+			selected := map[string]bool{}
+			for _, value := range answer.Values {
+				if !question.hasOption(value) {
+					return &MemberAnswersError{Reason: fmt.Sprintf("'%s' is not an option of question '%s'", value, question.Question)}
+				}
+				if selected[value] {
+					return &MemberAnswersError{Reason: fmt.Sprintf("duplicate answer '%s' for question '%s'", value, question.Question)}
+				}
+				selected[value] = true
+			}
+			// the legacy clients display the answer only
+			answer.Answer = strings.Join(answer.Values, ", ")
+		}
+
+		if question.Required && len(answer.Answer) == 0 {
+			return &MemberAnswersError{Reason: fmt.Sprintf("missing answer for required question '%s'", question.Question)}
+		}
+	}
+	return nil
+}
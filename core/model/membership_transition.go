@@ -0,0 +1,28 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// MembershipTransitionRemoved the membership is deleted when the transition takes effect
+const MembershipTransitionRemoved = "removed"
+
+// MembershipTransition represents a future status change of a membership scheduled by an admin
+type MembershipTransition struct {
+	Status        string    `json:"status" bson:"status"` // admin, member or removed
+	DateEffective time.Time `json:"date_effective" bson:"date_effective"`
+	ScheduledBy   string    `json:"scheduled_by" bson:"scheduled_by"` // user id of the admin
+	DateScheduled time.Time `json:"date_scheduled" bson:"date_scheduled"`
+} // @name MembershipTransition
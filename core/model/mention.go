@@ -0,0 +1,63 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "regexp"
+
+// mentionPattern matches the mention markup @[Display Name](user_id) within the post texts
+var mentionPattern = regexp.MustCompile(`@\[([^\[\]]+)\]\(([^()\s]+)\)`)
+
+// PickerMember represents a member listed in the member pickers
+type PickerMember struct {
+	UserID      string `json:"user_id"`
+	Name        string `json:"name"`
+	PhotoURL    string `json:"photo_url"`
+	Mentionable bool   `json:"mentionable"`
+} // @name PickerMember
+
+// ParseMentions gives the distinct user IDs mentioned within the text
+func ParseMentions(text string) []string {
+	var userIDs []string
+	found := map[string]bool{}
+	for _, match := range mentionPattern.FindAllStringSubmatch(text, -1) {
+		userID := match[2]
+		if !found[userID] {
+			found[userID] = true
+			userIDs = append(userIDs, userID)
+		}
+	}
+	return userIDs
+}
+
+// DegradeMentions replaces the mentions of the users who could not be mentioned with the plain text @Display Name
+func DegradeMentions(text string, mentionable func(userID string) bool) string {
+	return mentionPattern.ReplaceAllStringFunc(text, func(mention string) string {
+		match := mentionPattern.FindStringSubmatch(mention)
+		if mentionable(match[2]) {
+			return mention
+		}
+		return "@" + match[1]
+	})
+}
+
+// ToPickerMember converts the membership to its member picker representation
+func (m *GroupMembership) ToPickerMember() PickerMember {
+	return PickerMember{
+		UserID:      m.UserID,
+		Name:        m.Name,
+		PhotoURL:    m.PhotoURL,
+		Mentionable: m.IsMentionable(),
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// OrgUnit represents a unit of the campus organizational hierarchy, i.e. a college or a department
+type OrgUnit struct {
+	Code       string   `json:"code"`
+	Name       string   `json:"name"`
+	ParentCode string   `json:"parent_code"`
+	Ancestors  []string `json:"ancestors"` // the codes of the parent units, the root first
+}
+
+// GroupOrgUnit represents the org unit a group belongs to
+type GroupOrgUnit struct {
+	Code       string     `json:"code" bson:"code"` // the canonical org unit code
+	Name       string     `json:"name" bson:"name"` // kept in sync with the hierarchy
+	Path       []string   `json:"path" bson:"path"` // the codes of the parent units and the unit itself, the root first
+	DateSynced *time.Time `json:"date_synced" bson:"date_synced"`
+} // @name GroupOrgUnit
+
+// NewGroupOrgUnit creates the org unit of a group from the hierarchy unit
+func NewGroupOrgUnit(unit OrgUnit) *GroupOrgUnit {
+	now := time.Now().UTC()
+	path := append(append([]string{}, unit.Ancestors...), unit.Code)
+	return &GroupOrgUnit{Code: unit.Code, Name: unit.Name, Path: path, DateSynced: &now}
+}
+
+// OrgUnitNotFoundError is returned when the org unit code is not known to the hierarchy
+type OrgUnitNotFoundError struct {
+	Code string
+}
+
+func (e *OrgUnitNotFoundError) Error() string {
+	return fmt.Sprintf("unknown org unit %s", e.Code)
+}
@@ -0,0 +1,27 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// ExpiredMembershipRejectReason is the reject reason of the pending memberships rejected by the expiration policy
+const ExpiredMembershipRejectReason = "The request expired without a decision"
+
+// PendingMembershipsSummary represents the outstanding membership requests of a group
+type PendingMembershipsSummary struct {
+	GroupID    string    `json:"group_id" bson:"_id"`
+	Count      int       `json:"count" bson:"count"`
+	DateOldest time.Time `json:"date_oldest" bson:"date_oldest"`
+}
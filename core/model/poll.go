@@ -0,0 +1,93 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "fmt"
+
+const (
+	// PollResultsVisibilityAll the members see the poll results any time
+	PollResultsVisibilityAll = "all"
+	// PollResultsVisibilityAfterClose the members see the poll results once the poll is closed
+	PollResultsVisibilityAfterClose = "after_close"
+	// PollResultsVisibilityAdmins only the group admins see the poll results
+	PollResultsVisibilityAdmins = "admins"
+
+	// PollStatusTerminated the poll is closed for voting
+	PollStatusTerminated = "terminated"
+)
+
+// PollPreferences wraps the rules of the poll results within the group. The group admins always see the full results.
+type PollPreferences struct {
+	ResultsVisibility string `json:"results_visibility,omitempty" bson:"results_visibility,omitempty"` // all (default), after_close or admins
+	HideVoters        bool   `json:"hide_voters" bson:"hide_voters"`                                   // the members see the vote counts only
+} // @name PollPreferences
+
+// Validate validates the poll preferences
+func (p PollPreferences) Validate() error {
+	switch p.ResultsVisibility {
+	case "", PollResultsVisibilityAll, PollResultsVisibilityAfterClose, PollResultsVisibilityAdmins:
+		return nil
+	}
+	return fmt.Errorf("invalid poll results visibility %s", p.ResultsVisibility)
+}
+
+// CanMembersSeeResults checks if the members may see the results of the poll
+func (p PollPreferences) CanMembersSeeResults(results PollResults) bool {
+	switch p.ResultsVisibility {
+	case PollResultsVisibilityAdmins:
+		return false
+	case PollResultsVisibilityAfterClose:
+		return results.IsClosed()
+	}
+	return true
+}
+
+// PollResults represents the results of a group poll given by the Polls BB
+type PollResults struct {
+	PollID     string             `json:"poll_id"`
+	GroupID    string             `json:"group_id"`
+	Question   string             `json:"question"`
+	Status     string             `json:"status"` // created, started or terminated
+	TotalVotes int                `json:"total_votes"`
+	Options    []PollOptionResult `json:"options"`
+} // @name PollResults
+
+// PollOptionResult represents the votes of a poll option
+type PollOptionResult struct {
+	Option string      `json:"option"`
+	Votes  int         `json:"votes"`
+	Voters []PollVoter `json:"voters,omitempty"` // empty for the anonymous polls
+} // @name PollOptionResult
+
+// PollVoter represents a user who voted for a poll option
+type PollVoter struct {
+	UserID string `json:"user_id"`
+	Name   string `json:"name"`
+} // @name PollVoter
+
+// IsClosed checks if the poll is closed for voting
+func (r PollResults) IsClosed() bool {
+	return r.Status == PollStatusTerminated
+}
+
+// WithoutVoters gives a copy of the results without the voter identities
+func (r PollResults) WithoutVoters() PollResults {
+	options := make([]PollOptionResult, len(r.Options))
+	for i, option := range r.Options {
+		options[i] = PollOptionResult{Option: option.Option, Votes: option.Votes}
+	}
+	r.Options = options
+	return r
+}
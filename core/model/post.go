@@ -16,6 +16,7 @@ package model
 
 import (
 	"groups/driven/notifications"
+	"sort"
 	"time"
 )
 
@@ -36,12 +37,126 @@ type Post struct {
 	Reactions         map[string][]string `json:"reactions,omitempty" bson:"reactions,omitempty"`
 	ImageURL          *string             `json:"image_url" bson:"image_url"`
 
+	ReactionCounts map[string]int `json:"reaction_counts,omitempty" bson:"-"` // the number of the users per reaction, constructed for the client APIs instead of the reactions
+	UserReactions  []string       `json:"user_reactions,omitempty" bson:"-"`  // the reactions of the current user, constructed for the client APIs
+
 	ToMembersList []ToMember `json:"to_members" bson:"to_members"` // nil or empty means everyone; non-empty means visible to those user ids and admins
 
 	DateCreated   time.Time  `json:"date_created" bson:"date_created"`
 	DateUpdated   *time.Time `json:"date_updated" bson:"date_updated"`
 	DateScheduled *time.Time `json:"date_scheduled" bson:"date_scheduled"`
 	DateNotified  *time.Time `json:"date_notified" bson:"date_notified"`
+
+	DateNotificationDeferred *time.Time `json:"date_notification_deferred,omitempty" bson:"date_notification_deferred,omitempty"` // set when the notification is deferred by the group quiet hours
+
+	NotificationClaim            string     `json:"-" bson:"notification_claim,omitempty"`                                        // the token of the instance sending the scheduled notification
+	DateNotificationClaimExpires *time.Time `json:"-" bson:"date_notification_claim_expires,omitempty"`                           // another instance could take over the claim afterwards, i.e. when the claiming instance crashed
+	NotificationAttempts         int        `json:"-" bson:"notification_attempts,omitempty"`                                     // the number of the claims of the scheduled notification
+	DateNotificationFailed       *time.Time `json:"date_notification_failed,omitempty" bson:"date_notification_failed,omitempty"` // set when the scheduled notification is given up after too many attempts
+
+	Status string `json:"status,omitempty" bson:"status,omitempty"` // the unpublished posts (drafts, pending approval, rejected) are visible only to the creator, empty for the published posts
+
+	DateModerated   *time.Time `json:"date_moderated,omitempty" bson:"date_moderated,omitempty"`     // the time of the approval or the rejection of the post by an admin
+	RejectionReason string     `json:"rejection_reason,omitempty" bson:"rejection_reason,omitempty"` // the reason of the rejection given by the admin
+
+	ContentFlags []string `json:"content_flags,omitempty" bson:"content_flags,omitempty"` // the blocked words and the moderation categories matched by the content filter of the client
+
+	Edited     bool       `json:"edited" bson:"edited,omitempty"`                     // the subject, the body or the image has been changed after the creation
+	DateEdited *time.Time `json:"date_edited,omitempty" bson:"date_edited,omitempty"` // the time of the last content change, the previous content is kept as a PostRevision
+	Pinned     bool       `json:"pinned" bson:"pinned,omitempty"`                     // pinned posts are returned first
+	DatePinned *time.Time `json:"date_pinned,omitempty" bson:"date_pinned,omitempty"` // the latest pinned post is the first one
+
+	DateDeleted *time.Time `json:"date_deleted,omitempty" bson:"date_deleted,omitempty"`
+	DeletedBy   *string    `json:"deleted_by,omitempty" bson:"deleted_by,omitempty"`
+	DeletionID  *string    `json:"deletion_id,omitempty" bson:"deletion_id,omitempty"` // id of the root post of the deletion
+}
+
+// MaxPinnedPosts is the maximum number of the pinned posts of a group
+const MaxPinnedPosts = 3
+
+const (
+	// PostStatusDraft the post is a draft visible only to the creator
+	PostStatusDraft = "draft"
+	// PostStatusPendingApproval the post waits for an admin approval as the group requires it
+	PostStatusPendingApproval = "pending_approval"
+	// PostStatusRejected the post has been rejected by an admin
+	PostStatusRejected = "rejected"
+)
+
+// UnpublishedPostStatuses the statuses of the posts which are visible only to their creators
+var UnpublishedPostStatuses = []string{PostStatusDraft, PostStatusPendingApproval, PostStatusRejected}
+
+// IsDraft checks if the post is a draft
+func (p *Post) IsDraft() bool {
+	return p.Status == PostStatusDraft
+}
+
+// IsPendingApproval checks if the post waits for an admin approval
+func (p *Post) IsPendingApproval() bool {
+	return p.Status == PostStatusPendingApproval
+}
+
+// IsPublished checks if the post is visible to the group
+func (p *Post) IsPublished() bool {
+	return len(p.Status) == 0
+}
+
+// SummarizeReactions replaces the user lists of the reactions of the post and its replies with the counts and the reactions of the user
+func (p *Post) SummarizeReactions(userID string) {
+	if len(p.Reactions) > 0 {
+		p.ReactionCounts = map[string]int{}
+		p.UserReactions = nil
+		for reaction, userIDs := range p.Reactions {
+			if len(userIDs) == 0 {
+				continue
+			}
+			p.ReactionCounts[reaction] = len(userIDs)
+			for _, reactedUserID := range userIDs {
+				if reactedUserID == userID {
+					p.UserReactions = append(p.UserReactions, reaction)
+					break
+				}
+			}
+		}
+		sort.Strings(p.UserReactions)
+		p.Reactions = nil
+	}
+
+	for i := range p.Replies {
+		p.Replies[i].SummarizeReactions(userID)
+	}
+}
+
+// PostReactor represents a user who reacted to a post
+type PostReactor struct {
+	UserID   string `json:"user_id"`
+	Name     string `json:"name,omitempty"` // hidden if the group does not allow viewing the member names
+	Reaction string `json:"reaction"`
+} // @name PostReactor
+
+// PostRevision is the content of a post before an edit
+type PostRevision struct {
+	ID       string  `json:"id" bson:"_id"`
+	ClientID string  `json:"client_id" bson:"client_id"`
+	GroupID  string  `json:"group_id" bson:"group_id"`
+	PostID   string  `json:"post_id" bson:"post_id"`
+	UserID   string  `json:"user_id" bson:"user_id"` // the user who made the edit
+	Subject  string  `json:"subject" bson:"subject"`
+	Body     string  `json:"body" bson:"body"`
+	ImageURL *string `json:"image_url" bson:"image_url"`
+
+	DateCreated time.Time `json:"date_created" bson:"date_created"` // the time of the edit which replaced the content
+} // @name PostRevision
+
+// ContentDiffers checks if the subject, the body or the image of the posts differ
+func (p *Post) ContentDiffers(other *Post) bool {
+	if p.Subject != other.Subject || p.Body != other.Body {
+		return true
+	}
+	if (p.ImageURL == nil) != (other.ImageURL == nil) {
+		return true
+	}
+	return p.ImageURL != nil && *p.ImageURL != *other.ImageURL
 }
 
 // UserCanSeePost checks if the user can see the current post or not
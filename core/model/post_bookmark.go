@@ -0,0 +1,27 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// PostBookmark represents a post saved by the user for later
+type PostBookmark struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	UserID      string    `json:"user_id" bson:"user_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	PostID      string    `json:"post_id" bson:"post_id"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name PostBookmark
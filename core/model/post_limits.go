@@ -0,0 +1,72 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"unicode/utf8"
+)
+
+const (
+	// PostLimitFieldBody the body length limit
+	PostLimitFieldBody = "body"
+	// PostLimitFieldAttachments the attachments count limit
+	PostLimitFieldAttachments = "attachments"
+	// PostLimitFieldReplyDepth the reply depth limit
+	PostLimitFieldReplyDepth = "reply_depth"
+)
+
+// PostLimitError is returned when a post exceeds the limits of the client
+type PostLimitError struct {
+	Field string
+	Limit int
+}
+
+func (e *PostLimitError) Error() string {
+	return fmt.Sprintf("%s exceeds the limit of %d", e.Field, e.Limit)
+}
+
+// Validate validates the limits
+func (c PostLimitsConfig) Validate() error {
+	for _, limit := range []*int{c.MaxBodyLength, c.MaxAttachments, c.MaxReplyDepth} {
+		if limit != nil && *limit < 0 {
+			return errors.New("the limits must not be negative")
+		}
+	}
+	return nil
+}
+
+// CheckContent gives PostLimitError when the body or the attachments of the post exceed the limits
+func (c *PostLimitsConfig) CheckContent(post *Post) error {
+	if c == nil || post == nil {
+		return nil
+	}
+	if c.MaxBodyLength != nil && utf8.RuneCountInString(post.Body) > *c.MaxBodyLength {
+		return &PostLimitError{Field: PostLimitFieldBody, Limit: *c.MaxBodyLength}
+	}
+	if c.MaxAttachments != nil && post.AttachmentsCount() > *c.MaxAttachments {
+		return &PostLimitError{Field: PostLimitFieldAttachments, Limit: *c.MaxAttachments}
+	}
+	return nil
+}
+
+// AttachmentsCount gives the number of the media attached to the post
+func (p *Post) AttachmentsCount() int {
+	if p.ImageURL != nil && len(*p.ImageURL) > 0 {
+		return 1
+	}
+	return 0
+}
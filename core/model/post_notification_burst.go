@@ -0,0 +1,42 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// PostNotificationBatchingConfig defines the per client batching of the post notifications of the groups with bursty activity
+type PostNotificationBatchingConfig struct {
+	Type          string `json:"type" bson:"type"`
+	ClientID      string `json:"client_id" bson:"client_id"`
+	WindowMinutes int    `json:"window_minutes" bson:"window_minutes"` // The posts following a notified post of the group within the window are notified together once the window ends, 0 disables the batching
+} //@name PostNotificationBatchingConfig
+
+// PostNotificationBurst tracks the posts of a group within the batching window. The first post is notified immediately, the following
+// ones are summarized within a single notification once the window ends.
+type PostNotificationBurst struct {
+	ID         string   `bson:"_id"`
+	ClientID   string   `bson:"client_id"`
+	GroupID    string   `bson:"group_id"`
+	Count      int      `bson:"count"`       // the posts within the window including the notified one
+	CreatorIDs []string `bson:"creator_ids"` // the creators of the batched posts
+
+	DateStarted time.Time `bson:"date_started"`
+	DateEnds    time.Time `bson:"date_ends"`
+}
+
+// BatchedCount gives the number of the posts waiting for the summary notification
+func (b *PostNotificationBurst) BatchedCount() int {
+	return b.Count - 1
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// ReactionEvent represents a single reaction toggle of a user. The events are kept for a short period and used for the rate limiting.
+type ReactionEvent struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	UserID      string    `json:"user_id" bson:"user_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	PostID      string    `json:"post_id" bson:"post_id"`
+	Reaction    string    `json:"reaction" bson:"reaction"`
+	On          bool      `json:"on" bson:"on"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name ReactionEvent
+
+// ReactionSuspension represents a temporary suspension of the reactions of a user who has been detected as toggle spammer
+type ReactionSuspension struct {
+	ID          string    `json:"id" bson:"_id"`
+	ClientID    string    `json:"client_id" bson:"client_id"`
+	UserID      string    `json:"user_id" bson:"user_id"`
+	GroupID     string    `json:"group_id" bson:"group_id"`
+	PostID      string    `json:"post_id" bson:"post_id"`
+	Reason      string    `json:"reason" bson:"reason"`
+	DateExpires time.Time `json:"date_expires" bson:"date_expires"`
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name ReactionSuspension
+
+// ReactionLimitError is returned when the user is not allowed to react at the moment
+type ReactionLimitError struct {
+	Reason     string
+	RetryAfter time.Duration
+}
+
+func (e *ReactionLimitError) Error() string {
+	return fmt.Sprintf("%s, retry after %d seconds", e.Reason, int64(e.RetryAfter.Seconds()))
+}
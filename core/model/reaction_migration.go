@@ -0,0 +1,90 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// ReactionMigrationStatusRunning the migration is in progress
+	ReactionMigrationStatusRunning = "running"
+	// ReactionMigrationStatusCompleted all groups of the migration have been migrated
+	ReactionMigrationStatusCompleted = "completed"
+	// ReactionMigrationStatusFailed at least one group of the migration has not been migrated
+	ReactionMigrationStatusFailed = "failed"
+)
+
+// ReactionMigration represents a job which moves the post reactions of groups to the Social BB
+type ReactionMigration struct {
+	ID          string                   `json:"id" bson:"_id"`
+	ClientID    string                   `json:"client_id" bson:"client_id"`
+	Status      string                   `json:"status" bson:"status"` // running, completed or failed
+	Groups      []ReactionMigrationGroup `json:"groups" bson:"groups"`
+	Error       *string                  `json:"error,omitempty" bson:"error,omitempty"`
+	DateStarted time.Time                `json:"date_started" bson:"date_started"`
+	DateEnded   *time.Time               `json:"date_ended" bson:"date_ended"`
+} // @name ReactionMigration
+
+// ReactionMigrationGroup represents the migration result of a single group
+type ReactionMigrationGroup struct {
+	GroupID     string  `json:"group_id" bson:"group_id"`
+	Status      string  `json:"status" bson:"status"` // running, completed or failed
+	Posts       int64   `json:"posts" bson:"posts"`
+	LocalCount  int64   `json:"local_count" bson:"local_count"`         // reactions found in the group posts
+	PushedCount int64   `json:"pushed_count" bson:"pushed_count"`       // reactions accepted by the Social BB
+	SocialCount int64   `json:"social_count" bson:"social_count"`       // reactions of the group reported by the Social BB after the push
+	Error       *string `json:"error,omitempty" bson:"error,omitempty"` // the reason the group has not been migrated
+} // @name ReactionMigrationGroup
+
+// SocialReaction represents a post reaction pushed to the Social BB
+type SocialReaction struct {
+	IdempotencyKey string    `json:"idempotency_key"`
+	GroupID        string    `json:"group_id"`
+	PostID         string    `json:"post_id"`
+	UserID         string    `json:"user_id"`
+	Reaction       string    `json:"reaction"`
+	DateCreated    time.Time `json:"date_created"`
+} // @name SocialReaction
+
+// NewSocialReaction creates a Social BB reaction with a stable idempotency key, so pushing the same reaction again does not duplicate it
+func NewSocialReaction(clientID string, post Post, userID string, reaction string) SocialReaction {
+	return SocialReaction{
+		IdempotencyKey: fmt.Sprintf("groups:%s:%s:%s:%s", clientID, post.ID, reaction, userID),
+		GroupID:        post.GroupID,
+		PostID:         post.ID,
+		UserID:         userID,
+		Reaction:       reaction,
+		DateCreated:    post.DateCreated,
+	}
+}
+
+// Finish sets the end date and the final status of the migration
+func (m *ReactionMigration) Finish() {
+	now := time.Now()
+	m.DateEnded = &now
+	m.Status = ReactionMigrationStatusCompleted
+	if m.Error != nil {
+		m.Status = ReactionMigrationStatusFailed
+		return
+	}
+	for _, group := range m.Groups {
+		if group.Status != ReactionMigrationStatusCompleted {
+			m.Status = ReactionMigrationStatusFailed
+			return
+		}
+	}
+}
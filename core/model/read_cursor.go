@@ -0,0 +1,33 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// GroupReadCursor represents the time up to which the user has read the posts of the group
+type GroupReadCursor struct {
+	ID       string    `json:"id" bson:"_id"`
+	ClientID string    `json:"client_id" bson:"client_id"`
+	GroupID  string    `json:"group_id" bson:"group_id"`
+	UserID   string    `json:"user_id" bson:"user_id"`
+	DateRead time.Time `json:"date_read" bson:"date_read"`
+} // @name GroupReadCursor
+
+// GroupUnreadCount represents the number of the posts of the group created after the read cursor of the user
+type GroupUnreadCount struct {
+	GroupID     string     `json:"group_id" bson:"_id"`
+	UnreadCount int64      `json:"unread_count" bson:"count"`
+	DateRead    *time.Time `json:"date_read" bson:"-"` // nil if the user has never marked the group as read, the join date is used instead
+} // @name GroupUnreadCount
@@ -0,0 +1,95 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+const (
+	// RecipientAudienceAll all the members and the admins of the group
+	RecipientAudienceAll = "all"
+	// RecipientAudienceAdmins the admins of the group
+	RecipientAudienceAdmins = "admins"
+	// RecipientAudienceAttended the members and the admins who attended the group since the date
+	RecipientAudienceAttended = "attended"
+	// RecipientAudienceToMembers the listed members and admins of the group
+	RecipientAudienceToMembers = "to_members"
+
+	// NotificationCategoryPosts the post notifications, the members who muted the posts are muted
+	NotificationCategoryPosts = "posts"
+	// NotificationCategoryEvents the event notifications, the members who muted the events are muted
+	NotificationCategoryEvents = "events"
+	// NotificationCategoryInvitations the membership notifications, the members who muted the invitations are muted
+	NotificationCategoryInvitations = "invitations"
+	// NotificationCategoryPolls the poll notifications, the members who muted the polls are muted
+	NotificationCategoryPolls = "polls"
+)
+
+// RecipientAudience selects the members of a group who receive a notification
+type RecipientAudience struct {
+	Audience      string     `json:"audience"`       // all (default), admins, attended or to_members
+	AttendedSince *time.Time `json:"attended_since"` // required by the attended audience
+	ToMembers     []string   `json:"to_members"`     // user ids, required by the to_members audience
+	Category      string     `json:"category"`       // posts, events, invitations or polls - the muted category of the preferences. Only all_mute applies if empty
+	Operation     string     `json:"operation"`      // the notification operation, the mutes of the memberships apply to it
+	SkipUserIDs   []string   `json:"skip_user_ids"`  // i.e. the sender
+	ExcludeMuted  bool       `json:"exclude_muted"`  // the muted recipients are omitted instead of being flagged
+} // @name RecipientAudience
+
+// Validate checks the audience selector
+func (a RecipientAudience) Validate() error {
+	switch a.Audience {
+	case "", RecipientAudienceAll, RecipientAudienceAdmins:
+	case RecipientAudienceAttended:
+		if a.AttendedSince == nil {
+			return errors.New("attended_since is required by the attended audience")
+		}
+	case RecipientAudienceToMembers:
+		if len(a.ToMembers) == 0 {
+			return errors.New("to_members is required by the to_members audience")
+		}
+	default:
+		return fmt.Errorf("invalid audience %s", a.Audience)
+	}
+
+	switch a.Category {
+	case "", NotificationCategoryPosts, NotificationCategoryEvents, NotificationCategoryInvitations, NotificationCategoryPolls:
+		return nil
+	default:
+		return fmt.Errorf("invalid category %s", a.Category)
+	}
+}
+
+// IsMuted says if the notifications of the category are muted by the preferences
+func (p NotificationsPreferences) IsMuted(category string) bool {
+	if !p.OverridePreferences {
+		return false
+	}
+	switch category {
+	case NotificationCategoryPosts:
+		return p.AllMute || p.PostsMuted
+	case NotificationCategoryEvents:
+		return p.AllMute || p.EventsMuted
+	case NotificationCategoryInvitations:
+		return p.AllMute || p.InvitationsMuted
+	case NotificationCategoryPolls:
+		return p.AllMute || p.PollsMuted
+	default:
+		return p.AllMute
+	}
+}
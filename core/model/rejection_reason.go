@@ -0,0 +1,131 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// MaxRejectionReasons the maximum number of the rejection reasons of a client
+const MaxRejectionReasons = 100
+
+// rejectionReasonCodePattern matches the rejection reason codes - not_eligible, duplicate_request
+var rejectionReasonCodePattern = regexp.MustCompile(`^[a-z0-9_]{1,64}$`)
+
+// RejectionReason represents a membership rejection reason of the catalog with its messages by locale
+type RejectionReason struct {
+	Code          string            `json:"code" bson:"code"`
+	Message       string            `json:"message" bson:"message"`
+	Localizations map[string]string `json:"localizations,omitempty" bson:"localizations,omitempty"` // message by locale
+} // @name RejectionReason
+
+// RejectionReasonsConfig defines the per client catalog of the membership rejection reasons
+type RejectionReasonsConfig struct {
+	Type     string            `json:"type" bson:"type"`
+	ClientID string            `json:"client_id" bson:"client_id"`
+	Reasons  []RejectionReason `json:"reasons" bson:"reasons"`
+} //@name RejectionReasonsConfig
+
+// RejectReasonError is returned when the membership rejection does not carry a reason code of the catalog
+type RejectReasonError struct {
+	Code string
+}
+
+func (e *RejectReasonError) Error() string {
+	if len(e.Code) == 0 {
+		return "reject_reason_code is required"
+	}
+	return fmt.Sprintf("unknown reject_reason_code %s", e.Code)
+}
+
+// Validate validates the catalog
+func (c RejectionReasonsConfig) Validate() error {
+	if len(c.Reasons) > MaxRejectionReasons {
+		return fmt.Errorf("the catalog could contain up to %d reasons", MaxRejectionReasons)
+	}
+	codes := map[string]bool{}
+	for _, reason := range c.Reasons {
+		if !rejectionReasonCodePattern.MatchString(reason.Code) {
+			return fmt.Errorf("invalid reason code %s", reason.Code)
+		}
+		if codes[reason.Code] {
+			return fmt.Errorf("duplicate reason code %s", reason.Code)
+		}
+		codes[reason.Code] = true
+		if len(strings.TrimSpace(reason.Message)) == 0 {
+			return fmt.Errorf("missing message of reason %s", reason.Code)
+		}
+		for locale := range reason.Localizations {
+			if !localePattern.MatchString(locale) {
+				return fmt.Errorf("invalid locale %s of reason %s", locale, reason.Code)
+			}
+		}
+	}
+	return nil
+}
+
+// FindReason finds the reason with the provided code. It gives nil if the catalog does not contain it.
+func (c *RejectionReasonsConfig) FindReason(code string) *RejectionReason {
+	if c == nil {
+		return nil
+	}
+	for i := range c.Reasons {
+		if c.Reasons[i].Code == code {
+			return &c.Reasons[i]
+		}
+	}
+	return nil
+}
+
+// Localize gives the reasons with the messages of the best matching locale, the same way as the group localization
+func (c *RejectionReasonsConfig) Localize(locales []string) []RejectionReason {
+	if c == nil {
+		return []RejectionReason{}
+	}
+	reasons := make([]RejectionReason, len(c.Reasons))
+	for i, reason := range c.Reasons {
+		reasons[i] = RejectionReason{Code: reason.Code, Message: reason.LocalizedMessage(locales)}
+	}
+	return reasons
+}
+
+// LocalizedMessage gives the message of the best matching locale. Every requested locale is tried as it is and then by its
+// language (es-MX falls back to es). The primary message is given if no locale matches.
+func (r RejectionReason) LocalizedMessage(locales []string) string {
+	for _, locale := range locales {
+		candidates := []string{locale}
+		if language := strings.Split(locale, "-")[0]; language != locale {
+			candidates = append(candidates, language)
+		}
+		for _, candidate := range candidates {
+			for key, message := range r.Localizations {
+				if strings.EqualFold(key, candidate) && len(message) > 0 {
+					return message
+				}
+			}
+		}
+	}
+	return r.Message
+}
+
+// MembershipRejection represents the reason of a membership rejection. Reason is the human readable text stored for the
+// clients which do not know the catalog.
+type MembershipRejection struct {
+	Code   string
+	Note   string
+	Reason string
+}
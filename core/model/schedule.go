@@ -0,0 +1,62 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	// ScheduleItemTypePost scheduled post entry
+	ScheduleItemTypePost = "post"
+	// ScheduleItemTypeEvent upcoming event entry
+	ScheduleItemTypeEvent = "event"
+	// ScheduleItemTypeEventReminder due reminder of an upcoming event entry
+	ScheduleItemTypeEventReminder = "event_reminder"
+)
+
+// GroupScheduleItem represents a single upcoming entry within the group schedule timeline
+type GroupScheduleItem struct {
+	Type               string                 `json:"type"` // post, event or event_reminder
+	ID                 string                 `json:"id"`
+	Title              string                 `json:"title"`
+	Date               time.Time              `json:"date"`
+	Conflicting        bool                   `json:"conflicting"`                   // true if another entry is scheduled too close to this one
+	SeriesID           *string                `json:"series_id,omitempty"`           // the recurring series of the event
+	ReminderRecipients int                    `json:"reminder_recipients,omitempty"` // the recipients of the scheduled reminder by the event_reminders group setting
+	Post               *Post                  `json:"post,omitempty"`
+	Event              map[string]interface{} `json:"event,omitempty"`
+} // @name GroupScheduleItem
+
+// GroupSchedule wraps the timeline of upcoming group entries
+type GroupSchedule []GroupScheduleItem // @name GroupSchedule
+
+// SortByDate sorts the timeline in ascending order
+func (s GroupSchedule) SortByDate() {
+	sort.SliceStable(s, func(i, j int) bool {
+		return s[i].Date.Before(s[j].Date)
+	})
+}
+
+// MarkConflicts flags all entries which are scheduled within the window of another entry. The timeline must be sorted.
+func (s GroupSchedule) MarkConflicts(window time.Duration) {
+	for i := 1; i < len(s); i++ {
+		if s[i].Date.Sub(s[i-1].Date) < window {
+			s[i-1].Conflicting = true
+			s[i].Conflicting = true
+		}
+	}
+}
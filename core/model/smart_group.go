@@ -0,0 +1,51 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"errors"
+	"fmt"
+)
+
+// maxSmartGroupRuleAttributes limits the account attributes a smart group rule could match
+const maxSmartGroupRuleAttributes = 20
+
+// SmartGroupRules defines the Core BB account attributes the membership of a smart group is derived from.
+// The match is passed as search params to the Core BB accounts API, i.e. {"profile.department": ["CS", "ECE"]}.
+type SmartGroupRules struct {
+	Enabled bool                   `json:"enabled" bson:"enabled"`
+	Match   map[string]interface{} `json:"match" bson:"match"`
+	AppID   *string                `json:"app_id,omitempty" bson:"app_id,omitempty"`
+	OrgID   *string                `json:"org_id,omitempty" bson:"org_id,omitempty"`
+} // @name SmartGroupRules
+
+// Validate validates the smart group rules
+func (r *SmartGroupRules) Validate() error {
+	if len(r.Match) == 0 {
+		return errors.New("missing match attributes")
+	}
+	if len(r.Match) > maxSmartGroupRuleAttributes {
+		return fmt.Errorf("too many match attributes - max %d", maxSmartGroupRuleAttributes)
+	}
+	for attribute, value := range r.Match {
+		if len(attribute) == 0 {
+			return errors.New("empty match attribute")
+		}
+		if value == nil {
+			return fmt.Errorf("missing value for match attribute %s", attribute)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+)
+
+const (
+	// UnsubscribeScopePosts mutes the post notifications
+	UnsubscribeScopePosts = "posts"
+	// UnsubscribeScopeEvents mutes the event notifications
+	UnsubscribeScopeEvents = "events"
+	// UnsubscribeScopeAll mutes all notifications
+	UnsubscribeScopeAll = "all"
+)
+
+// UnsubscribeToken represents the payload of the signed token included in the notifications. An empty group id applies the unsubscribe to all groups of the user.
+type UnsubscribeToken struct {
+	ClientID    string `json:"c"`
+	UserID      string `json:"u"`
+	GroupID     string `json:"g,omitempty"`
+	Scope       string `json:"s"`
+	DateExpires int64  `json:"e"` // unix seconds
+}
+
+// Sign encodes the token and appends its HMAC-SHA256 signature
+func (t UnsubscribeToken) Sign(secret string) string {
+	data, _ := json.Marshal(t)
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + signTokenPayload(payload, secret)
+}
+
+// ParseUnsubscribeToken verifies the signature and the expiration of a signed unsubscribe token
+func ParseUnsubscribeToken(token string, secret string) (*UnsubscribeToken, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return nil, errors.New("malformed unsubscribe token")
+	}
+	if !hmac.Equal([]byte(parts[1]), []byte(signTokenPayload(parts[0], secret))) {
+		return nil, errors.New("invalid unsubscribe token signature")
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return nil, errors.New("malformed unsubscribe token")
+	}
+	var result UnsubscribeToken
+	err = json.Unmarshal(data, &result)
+	if err != nil || len(result.ClientID) == 0 || len(result.UserID) == 0 || !IsValidUnsubscribeScope(result.Scope) {
+		return nil, errors.New("malformed unsubscribe token")
+	}
+	if time.Now().Unix() > result.DateExpires {
+		return nil, errors.New("expired unsubscribe token")
+	}
+	return &result, nil
+}
+
+// IsValidUnsubscribeScope checks if the scope is one of the supported ones
+func IsValidUnsubscribeScope(scope string) bool {
+	return scope == UnsubscribeScopePosts || scope == UnsubscribeScopeEvents || scope == UnsubscribeScopeAll
+}
+
+// UnsubscribeResult represents the outcome of an unsubscribe link
+type UnsubscribeResult struct {
+	Scope    string   `json:"scope"`
+	GroupIDs []string `json:"group_ids"` // the groups whose notifications have been muted by the link
+} // @name UnsubscribeResult
+
+// signTokenPayload gives the HMAC-SHA256 signature of the encoded payload of a signed token
+func signTokenPayload(payload string, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,57 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+// UsagePeriodLayout the layout of the metering periods - one calendar month in UTC, e.g. 2026-09
+const UsagePeriodLayout = "2006-01"
+
+// UsageRecord represents the metered usage of a client within a month. The counts of the groups, the members and the posts and the storage
+// footprint are snapshots taken at the last metering of the period. The created posts and the notification sends are counted within the period.
+type UsageRecord struct {
+	ID       string `json:"id" bson:"_id"`
+	ClientID string `json:"client_id" bson:"client_id"`
+	Period   string `json:"period" bson:"period"` // 2006-01
+
+	GroupsCount       int64 `json:"groups_count" bson:"groups_count"`
+	MembersCount      int64 `json:"members_count" bson:"members_count"` // members and admins
+	PostsCount        int64 `json:"posts_count" bson:"posts_count"`
+	PostsCreated      int64 `json:"posts_created" bson:"posts_created"`
+	NotificationsSent int64 `json:"notifications_sent" bson:"notifications_sent"` // one per recipient of the group notifications
+	StorageBytes      int64 `json:"storage_bytes" bson:"storage_bytes"`           // the BSON size of the groups, the memberships and the posts
+
+	Final bool `json:"final" bson:"final"` // the period is over and the record is not updated anymore
+
+	DateCreated time.Time  `json:"date_created" bson:"date_created"`
+	DateUpdated *time.Time `json:"date_updated" bson:"date_updated"`
+} // @name UsageRecord
+
+// UsagePeriodRange gives the start (inclusive) and the end (exclusive) of the metering period
+func UsagePeriodRange(period string) (time.Time, time.Time, error) {
+	start, err := time.ParseInLocation(UsagePeriodLayout, period, time.UTC)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("invalid usage period %s - the expected format is %s", period, UsagePeriodLayout)
+	}
+	return start, start.AddDate(0, 1, 0), nil
+}
+
+// UsagePeriod gives the metering period of the time
+func UsagePeriod(t time.Time) string {
+	return t.UTC().Format(UsagePeriodLayout)
+}
@@ -48,6 +48,9 @@ func (u *User) ToCreator() *Creator {
 	}
 }
 
+// PermissionGroupsSupportRead allows the support staff to read the content and the members of any group without joining it
+const PermissionGroupsSupportRead = "groups_support_read"
+
 // HasPermission Checks if the user has desired permission
 func (u *User) HasPermission(name string) bool {
 	for _, permission := range u.Permissions {
@@ -95,7 +98,8 @@ type CoreAccount struct {
 		State     string `json:"state"`
 		ZipCode   string `json:"zip_code"`
 	} `json:"profile"`
-	ID string `json:"id"`
+	ID            string     `json:"id"`
+	LastLoginDate *time.Time `json:"last_login_date"`
 }
 
 // GetExternalID Gets the external id
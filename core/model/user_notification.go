@@ -0,0 +1,41 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+// UserNotification represents a summary of a sent notification mirrored within the user inbox
+type UserNotification struct {
+	ID       string            `json:"id" bson:"_id"`
+	AppID    string            `json:"app_id" bson:"app_id"`
+	OrgID    string            `json:"org_id" bson:"org_id"`
+	UserID   string            `json:"user_id" bson:"user_id"`
+	GroupID  *string           `json:"group_id" bson:"group_id"`
+	Topic    *string           `json:"topic" bson:"topic"`
+	Subject  string            `json:"subject" bson:"subject"`
+	Body     string            `json:"body" bson:"body"`
+	Data     map[string]string `json:"data" bson:"data"`
+	Read     bool              `json:"read" bson:"read"`
+	DateRead *time.Time        `json:"date_read" bson:"date_read"`
+
+	DateCreated time.Time `json:"date_created" bson:"date_created"`
+} // @name UserNotification
+
+// UserNotificationsFilter Wraps all possible filters for getting the user inbox
+type UserNotificationsFilter struct {
+	Read   *bool  `json:"read"`
+	Offset *int64 `json:"offset"`
+	Limit  *int64 `json:"limit"`
+} // @name UserNotificationsFilter
@@ -0,0 +1,103 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package model
+
+import "time"
+
+const (
+	// WebhookEventGroupCreated group created event
+	WebhookEventGroupCreated = "group.created"
+	// WebhookEventMembershipApproved membership approved event
+	WebhookEventMembershipApproved = "membership.approved"
+	// WebhookEventPostCreated post created event
+	WebhookEventPostCreated = "post.created"
+	// WebhookEventResearchParticipantEnrolled a user has become a participant of a research group
+	WebhookEventResearchParticipantEnrolled = "research.participant_enrolled"
+
+	// WebhookDeliveryStatusPending the delivery is waiting for a (re)try
+	WebhookDeliveryStatusPending = "pending"
+	// WebhookDeliveryStatusDelivered the delivery has been accepted by the subscriber
+	WebhookDeliveryStatusDelivered = "delivered"
+	// WebhookDeliveryStatusFailed the delivery has exhausted all retries
+	WebhookDeliveryStatusFailed = "failed"
+)
+
+// WebhookEventTypes lists all supported webhook event types
+var WebhookEventTypes = []string{WebhookEventGroupCreated, WebhookEventMembershipApproved, WebhookEventPostCreated,
+	WebhookEventResearchParticipantEnrolled}
+
+// WebhookSubscription represents a callback URL registered for group events
+type WebhookSubscription struct {
+	ID         string   `json:"id" bson:"_id"`
+	ClientID   string   `json:"client_id" bson:"client_id"`
+	URL        string   `json:"url" bson:"url"`
+	Secret     string   `json:"-" bson:"secret"` // used for HMAC signing of the payload
+	EventTypes []string `json:"event_types" bson:"event_types"`
+	Active     bool     `json:"active" bson:"active"`
+
+	PreviousSecret            string     `json:"-" bson:"previous_secret,omitempty"`                                                   // still signs the payloads until the overlap after a rotation ends
+	DatePreviousSecretExpires *time.Time `json:"date_previous_secret_expires,omitempty" bson:"date_previous_secret_expires,omitempty"` // end of the overlap of the previous secret
+
+	DateCreated time.Time  `json:"date_created" bson:"date_created"`
+	DateUpdated *time.Time `json:"date_updated" bson:"date_updated"`
+} // @name WebhookSubscription
+
+// IsSubscribedFor checks if the subscription accepts the event type
+func (s WebhookSubscription) IsSubscribedFor(eventType string) bool {
+	for _, item := range s.EventTypes {
+		if item == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// SigningSecrets gives the secrets which sign the payloads at the provided time, the current secret first
+func (s WebhookSubscription) SigningSecrets(now time.Time) []string {
+	secrets := []string{s.Secret}
+	if s.PreviousSecret != "" && s.DatePreviousSecretExpires != nil && s.DatePreviousSecretExpires.After(now) {
+		secrets = append(secrets, s.PreviousSecret)
+	}
+	return secrets
+}
+
+// WebhookEvent represents the payload sent to the subscribers
+type WebhookEvent struct {
+	ID        string                 `json:"id" bson:"id"`
+	Type      string                 `json:"type" bson:"type"`
+	ClientID  string                 `json:"client_id" bson:"client_id"`
+	GroupID   string                 `json:"group_id" bson:"group_id"`
+	Data      map[string]interface{} `json:"data" bson:"data"`
+	Timestamp time.Time              `json:"timestamp" bson:"timestamp"`
+} // @name WebhookEvent
+
+// WebhookDelivery represents a single delivery of an event to a subscription
+type WebhookDelivery struct {
+	ID             string       `json:"id" bson:"_id"`
+	ClientID       string       `json:"client_id" bson:"client_id"`
+	SubscriptionID string       `json:"subscription_id" bson:"subscription_id"`
+	Event          WebhookEvent `json:"event" bson:"event"`
+	Status         string       `json:"status" bson:"status"` // pending, delivered or failed
+	Attempts       int          `json:"attempts" bson:"attempts"`
+	ResponseStatus *int         `json:"response_status" bson:"response_status"`
+	LastError      *string      `json:"last_error" bson:"last_error"`
+	NextAttempt    *time.Time   `json:"next_attempt" bson:"next_attempt"`
+
+	DeliveryClaim            string     `json:"-" bson:"delivery_claim,omitempty"`              // the token of the instance attempting the delivery
+	DateDeliveryClaimExpires *time.Time `json:"-" bson:"date_delivery_claim_expires,omitempty"` // another instance could take over the claim afterwards, i.e. when the claiming instance crashed
+
+	DateCreated time.Time  `json:"date_created" bson:"date_created"`
+	DateUpdated *time.Time `json:"date_updated" bson:"date_updated"`
+} // @name WebhookDelivery
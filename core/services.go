@@ -15,6 +15,7 @@
 package core
 
 import (
+	"errors"
 	"fmt"
 	"groups/driven/rewards"
 	"groups/driven/storage"
@@ -85,6 +86,9 @@ func (app *Application) isGroupAdmin(clientID string, groupID string, userID str
 
 func (app *Application) createGroup(clientID string, current *model.User, group *model.Group, membersConfig *model.DefaultMembershipConfig) (*string, *utils.GroupError) {
 
+	group.SyncMembershipQuestions(nil)
+	group.SyncCategories(nil)
+
 	var groupError *utils.GroupError
 	var groupID *string
 	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
@@ -124,6 +128,17 @@ func (app *Application) createGroup(clientID string, current *model.User, group
 			return err
 		}
 
+		err = app.recordDomainEvent(context, clientID, model.DomainEventGroupCreated, group.ID, map[string]interface{}{
+			"group_id":   group.ID,
+			"title":      group.Title,
+			"privacy":    group.Privacy,
+			"category":   group.Category,
+			"creator_id": group.CreatorID,
+		})
+		if err != nil {
+			return err
+		}
+
 		if group.ResearchGroup {
 			searchParams := app.formatCoreAccountSearchParams(group.ResearchProfile)
 
@@ -139,7 +154,7 @@ func (app *Application) createGroup(clientID string, current *model.User, group
 				list = append(list, ne)
 			}
 
-			app.notifications.SendNotification(list, nil, "A new research project is available", fmt.Sprintf("%s by %s", group.Title, current.Name),
+			app.sendNotification(list, nil, "A new research project is available", fmt.Sprintf("%s by %s", group.Title, current.Name),
 				map[string]string{
 					"type":        "group",
 					"operation":   "research_group",
@@ -177,18 +192,96 @@ func (app *Application) createGroup(clientID string, current *model.User, group
 		return nil, utils.NewServerError()
 	}
 
+	if groupID != nil {
+		go app.publishWebhookEvent(clientID, model.WebhookEventGroupCreated, *groupID, map[string]interface{}{
+			"group_id":   *groupID,
+			"title":      group.Title,
+			"privacy":    group.Privacy,
+			"category":   group.Category,
+			"creator_id": current.ID,
+		})
+	}
+
 	return groupID, nil
 }
 
 func (app *Application) updateGroup(clientID string, current *model.User, group *model.Group) *utils.GroupError {
+	oldGroup, findErr := app.storage.FindGroup(nil, clientID, group.ID, nil)
+	if findErr != nil {
+		log.Printf("app.updateGroup() error loading the group %s for the audit log: %s", group.ID, findErr)
+	}
+	if oldGroup != nil {
+		group.SyncMembershipQuestions(oldGroup.MembershipQuestionsSchema)
+		group.SyncCategories(oldGroup.Categories)
+	} else {
+		group.SyncMembershipQuestions(nil)
+		group.SyncCategories(nil)
+	}
 
-	err := app.storage.UpdateGroup(nil, clientID, current, group)
+	var groupErr *utils.GroupError
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		groupErr = app.storage.UpdateGroup(context, clientID, current, group)
+		if groupErr != nil {
+			return groupErr
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupUpdated, "group", group.ID,
+			model.NewAuditDiff(oldGroup, group, groupAuditIgnoredFields...))
+	})
+	if groupErr != nil {
+		return groupErr
+	}
 	if err != nil {
-		return err
+		log.Printf("app.updateGroup() error updating the group %s: %s", group.ID, err)
+		return utils.NewServerError()
 	}
+
+	// the incremental Authman sync is not aware of the removed keys, so the next sync must be a full one
+	if oldGroup != nil && strings.Join(oldGroup.GetAuthmanGroupKeys(), ",") != strings.Join(group.GetAuthmanGroupKeys(), ",") {
+		watermarkErr := app.storage.UpdateGroupAuthmanSyncWatermark(nil, clientID, group.ID, nil)
+		if watermarkErr != nil {
+			log.Printf("app.updateGroup() error resetting the Authman sync watermark of group %s: %s", group.ID, watermarkErr)
+		}
+	}
+
 	return nil
 }
 
+// patchGroup modifies only the fields provided by the patch, the rest of the group is left unchanged
+func (app *Application) patchGroup(clientID string, current *model.User, groupID string, patch model.GroupPatch) (*model.Group, *utils.GroupError) {
+	oldGroup, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+	if err != nil {
+		log.Printf("app.patchGroup() error loading the group %s: %s", groupID, err)
+		return nil, utils.NewServerError()
+	}
+	if oldGroup == nil {
+		return nil, utils.NewNotFoundError()
+	}
+
+	group := *oldGroup
+	patch.Apply(&group)
+
+	var groupErr *utils.GroupError
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		groupErr = app.storage.PatchGroup(context, clientID, groupID, patch)
+		if groupErr != nil {
+			return groupErr
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionGroupUpdated, "group", groupID,
+			model.NewAuditDiff(oldGroup, &group, groupAuditIgnoredFields...))
+	})
+	if groupErr != nil {
+		return nil, groupErr
+	}
+	if err != nil {
+		log.Printf("app.patchGroup() error patching the group %s: %s", groupID, err)
+		return nil, utils.NewServerError()
+	}
+
+	return &group, nil
+}
+
 func (app *Application) updateGroupDateUpdated(clientID string, groupID string) error {
 	err := app.storage.UpdateGroupDateUpdated(clientID, groupID)
 	if err != nil {
@@ -197,11 +290,33 @@ func (app *Application) updateGroupDateUpdated(clientID string, groupID string)
 	return nil
 }
 
-func (app *Application) deleteGroup(clientID string, current *model.User, id string) error {
-	err := app.storage.DeleteGroup(nil, clientID, id)
+func (app *Application) deleteGroup(clientID string, current *model.User, id string, cancelEvents bool) error {
+	// the upcoming events are loaded before the deletion, so their RSVP'd members could be notified
+	group, err := app.storage.FindGroup(nil, clientID, id, nil)
 	if err != nil {
 		return err
 	}
+	if group == nil {
+		return fmt.Errorf("group %s not found", id)
+	}
+	upcomingEvents, err := app.storage.FindEvents(clientID, nil, id, false, &model.EventsFilter{UpcomingOnly: true})
+	if err != nil {
+		return err
+	}
+
+	// the content is deleted in the background once the restore period passes, the progress is given by the group deletion jobs
+	marked, err := app.markGroupDeleted(clientID, current, group)
+	if err != nil {
+		return err
+	}
+	if !marked {
+		// already deleted
+		return nil
+	}
+
+	if len(upcomingEvents) > 0 {
+		go app.handleGroupEventsArchived(clientID, current, group, upcomingEvents, cancelEvents)
+	}
 	return nil
 }
 
@@ -210,6 +325,10 @@ func (app *Application) getGroups(clientID string, current *model.User, filter m
 	if current != nil {
 		userID = &current.ID
 	}
+	err := app.applyCategoryVisibility(clientID, &filter)
+	if err != nil {
+		return nil, err
+	}
 	// find the groups objects
 	groups, err := app.storage.FindGroups(clientID, userID, filter)
 	if err != nil {
@@ -219,6 +338,19 @@ func (app *Application) getGroups(clientID string, current *model.User, filter m
 	return groups, nil
 }
 
+// countGroups counts the groups matching the filter with the same category visibility as getGroups
+func (app *Application) countGroups(clientID string, current *model.User, filter model.GroupsFilter) (int64, error) {
+	var userID *string
+	if current != nil {
+		userID = &current.ID
+	}
+	err := app.applyCategoryVisibility(clientID, &filter)
+	if err != nil {
+		return 0, err
+	}
+	return app.storage.CountGroups(clientID, userID, filter)
+}
+
 func (app *Application) getAllGroups(clientID string) ([]model.Group, error) {
 	// find the groups objects
 	groups, err := app.storage.FindGroups(clientID, nil, model.GroupsFilter{})
@@ -230,6 +362,10 @@ func (app *Application) getAllGroups(clientID string) ([]model.Group, error) {
 }
 
 func (app *Application) getUserGroups(clientID string, current *model.User, filter model.GroupsFilter) ([]model.Group, error) {
+	err := app.applyCategoryVisibility(clientID, &filter)
+	if err != nil {
+		return nil, err
+	}
 	// find the user groups
 	groups, err := app.storage.FindUserGroups(clientID, current.ID, filter)
 	if err != nil {
@@ -240,6 +376,12 @@ func (app *Application) getUserGroups(clientID string, current *model.User, filt
 }
 
 func (app *Application) deleteUser(clientID string, current *model.User) error {
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		return app.ensureGroupAdmins(context, []string{current.ID})
+	})
+	if err != nil {
+		return err
+	}
 	return app.storage.DeleteUser(clientID, current.ID)
 }
 
@@ -258,20 +400,97 @@ func (app *Application) getGroup(clientID string, current *model.User, id string
 	return group, nil
 }
 
-func (app *Application) applyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReason string) error {
-	membership, err := app.storage.ApplyMembershipApproval(clientID, membershipID, approve, rejectReason)
+// getGroupDetails gives the group with the public leaders list for the group detail responses
+func (app *Application) getGroupDetails(clientID string, current *model.User, id string) (*model.Group, error) {
+	group, err := app.getGroup(clientID, current, id)
+	if err != nil || group == nil {
+		return group, err
+	}
+
+	group.Leaders = app.getGroupLeaders(clientID, group.ID)
+	return group, nil
+}
+
+// getGroupLeaders gives the public leaders list of the group. Failures are only logged as the leaders are a secondary part of the group details.
+func (app *Application) getGroupLeaders(clientID string, groupID string) []model.GroupLeader {
+	leadersOnly := true
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{groupID},
+		Statuses: []string{"admin", "member"},
+		Leaders:  &leadersOnly,
+	})
+	if err != nil {
+		log.Printf("app.getGroupLeaders() error loading the leaders of group %s: %s", groupID, err)
+		return nil
+	}
+
+	var leaders []model.GroupLeader
+	for _, membership := range memberships.Items {
+		leaders = append(leaders, membership.ToGroupLeader())
+	}
+	return leaders
+}
+
+func (app *Application) applyMembershipApproval(clientID string, current *model.User, membershipID string, approve bool, rejectReasonCode string, rejectReasonNote string) error {
+	var rejection *model.MembershipRejection
+	if !approve {
+		var err error
+		rejection, err = app.resolveMembershipRejection(clientID, rejectReasonCode, rejectReasonNote)
+		if err != nil {
+			return err
+		}
+	}
+
+	var membership *model.GroupMembership
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		membership, err = app.storage.ApplyMembershipApproval(context, clientID, membershipID, approve, rejection)
+		if err != nil {
+			return err
+		}
+
+		action := model.AuditActionMembershipRejected
+		if approve {
+			action = model.AuditActionMembershipApproved
+		}
+		err = app.recordAuditLog(context, clientID, current, membership.GroupID, action, "membership", membership.ID,
+			map[string]model.AuditChange{
+				"status":             {New: membership.Status},
+				"reject_reason":      {New: membership.RejectReason},
+				"reject_reason_code": {New: membership.RejectReasonCode},
+			})
+		if err != nil {
+			return err
+		}
+
+		return app.recordMembershipChanged(context, clientID, *membership, model.MembershipChangeUpdated)
+	})
 	if err != nil {
 		return fmt.Errorf("error applying membership approval: %s", err)
 	}
-	if err == nil && membership != nil {
+	if membership != nil {
+		if approve {
+			go app.publishWebhookEvent(clientID, model.WebhookEventMembershipApproved, membership.GroupID, map[string]interface{}{
+				"group_id":      membership.GroupID,
+				"membership_id": membership.ID,
+				"user_id":       membership.UserID,
+				"status":        membership.Status,
+			})
+		}
+
 		group, _ := app.storage.FindGroup(nil, clientID, membership.GroupID, nil)
+		if approve {
+			go app.publishResearchParticipantEnrolled(clientID, group, membership)
+			go app.welcomeMembers(clientID, group, []model.GroupMembership{*membership})
+		}
+
 		topic := "group.invitations"
 		groupStr := "Group"
 		if group.ResearchGroup {
 			groupStr = "Research Project"
 		}
 		if approve {
-			app.notifications.SendNotification(
+			app.sendNotification(
 				[]notifications.Recipient{
 					membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
 						(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute)),
@@ -291,20 +510,23 @@ func (app *Application) applyMembershipApproval(clientID string, current *model.
 				nil,
 			)
 		} else {
-			app.notifications.SendNotification(
+			app.sendNotification(
 				[]notifications.Recipient{
 					membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
 						(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute)),
 				},
 				&topic,
 				fmt.Sprintf("%s - %s", groupStr, group.Title),
-				fmt.Sprintf("Your membership in '%s' %s has been rejected with a reason: %s", group.Title, strings.ToLower(groupStr), rejectReason),
+				fmt.Sprintf("Your membership in '%s' %s has been rejected with a reason: %s", group.Title, strings.ToLower(groupStr), rejection.Reason),
 				map[string]string{
-					"type":        "group",
-					"operation":   "membership_reject",
-					"entity_type": "group",
-					"entity_id":   group.ID,
-					"entity_name": group.Title,
+					"type":               "group",
+					"operation":          "membership_reject",
+					"entity_type":        "group",
+					"entity_id":          group.ID,
+					"entity_name":        group.Title,
+					"reject_reason":      rejection.Reason,
+					"reject_reason_code": rejection.Code,
+					"reject_reason_note": rejection.Note,
 				},
 				current.AppID,
 				current.OrgID,
@@ -313,7 +535,7 @@ func (app *Application) applyMembershipApproval(clientID string, current *model.
 		}
 
 		if approve && group.CanJoinAutomatically && group.AuthmanEnabled && membership.ExternalID != "" {
-			err := app.authman.AddAuthmanMemberToGroup(*group.AuthmanGroup, membership.ExternalID)
+			err := app.addAuthmanGroupMember(group, membership.ExternalID)
 			if err != nil {
 				log.Printf("err app.applyMembershipApproval() - error storing member in Authman: %s", err)
 			}
@@ -326,10 +548,17 @@ func (app *Application) applyMembershipApproval(clientID string, current *model.
 	return nil
 }
 
-func (app *Application) updateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences) error {
+func (app *Application) updateMembership(clientID string, current *model.User, membershipID string, status *string, dateAttended *time.Time, notificationsPreferences *model.NotificationsPreferences, showAsLeader *bool, mentionable *bool, searchable *bool) error {
 	membership, _ := app.storage.FindGroupMembershipByID(clientID, membershipID)
 	if membership != nil {
+		oldMembership := *membership
 		if status != nil && membership.Status != *status {
+			if membership.IsAdmin() {
+				err := app.checkGroupKeepsAdmin(nil, clientID, membership.GroupID, nil, []string{membership.ID})
+				if err != nil {
+					return err
+				}
+			}
 			membership.Status = *status
 		}
 		if dateAttended != nil && membership.DateAttended == nil {
@@ -338,11 +567,46 @@ func (app *Application) updateMembership(clientID string, current *model.User, m
 		if notificationsPreferences != nil {
 			membership.NotificationsPreferences = *notificationsPreferences
 		}
+		if showAsLeader != nil {
+			membership.ShowAsLeader = *showAsLeader
+		}
+		if mentionable != nil {
+			membership.Mentionable = mentionable
+		}
+		if searchable != nil {
+			membership.Searchable = searchable
+		}
 
-		err := app.storage.UpdateMembership(clientID, current, membershipID, membership)
+		err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+			err := app.storage.UpdateMembership(context, clientID, current, membershipID, membership)
+			if err != nil {
+				return err
+			}
+
+			err = app.recordAuditLog(context, clientID, current, membership.GroupID, model.AuditActionMembershipUpdated, "membership", membershipID,
+				model.NewAuditDiff(oldMembership, membership, membershipAuditIgnoredFields...))
+			if err != nil || oldMembership.Status == membership.Status {
+				return err
+			}
+
+			return app.recordMembershipChanged(context, clientID, *membership, model.MembershipChangeUpdated)
+		})
 		if err != nil {
 			return err
 		}
+
+		if !oldMembership.IsAdmin() && membership.IsAdmin() {
+			app.clearGroupAdminNeeded(clientID, membership.GroupID)
+		}
+
+		if !oldMembership.IsAdminOrMember() && membership.IsAdminOrMember() {
+			group, err := app.storage.FindGroup(nil, clientID, membership.GroupID, nil)
+			if err != nil {
+				log.Printf("error loading group %s to welcome membership %s: %s", membership.GroupID, membershipID, err)
+			} else {
+				go app.welcomeMembers(clientID, group, []model.GroupMembership{*membership})
+			}
+		}
 	}
 
 	return nil
@@ -350,10 +614,51 @@ func (app *Application) updateMembership(clientID string, current *model.User, m
 
 func (app *Application) updateMemberships(clientID string, user *model.User, group *model.Group, operation model.MembershipMultiUpdate) error {
 	if group != nil && group.CurrentMember != nil && group.CurrentMember.IsAdmin() {
-		err := app.storage.UpdateMemberships(clientID, user, group.ID, operation)
+		var joining []model.GroupMembership
+		if operation.Status != nil && (*operation.Status == "member" || *operation.Status == "admin") && len(operation.UserIDs) > 0 {
+			memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{GroupIDs: []string{group.ID},
+				UserIDs: operation.UserIDs, Statuses: []string{"pending", "rejected"}})
+			if err != nil {
+				log.Printf("error loading the memberships of group %s to welcome: %s", group.ID, err)
+			} else {
+				joining = memberships.Items
+			}
+		}
+
+		if operation.Status != nil && *operation.Status != "admin" && len(operation.UserIDs) > 0 {
+			err := app.checkGroupKeepsAdmin(nil, clientID, group.ID, operation.UserIDs, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+			err := app.storage.UpdateMemberships(context, clientID, user, group.ID, operation)
+			if err != nil {
+				return err
+			}
+
+			err = app.recordAuditLog(context, clientID, user, group.ID, model.AuditActionMembershipsUpdated, "membership", "",
+				model.NewAuditDiff(nil, operation))
+			if err != nil || operation.Status == nil {
+				return err
+			}
+
+			return app.recordDomainEvent(context, clientID, model.DomainEventMembershipChanged, group.ID, map[string]interface{}{
+				"group_id": group.ID,
+				"user_ids": operation.UserIDs,
+				"status":   *operation.Status,
+				"change":   model.MembershipChangeUpdated,
+			})
+		})
 		if err != nil {
 			return err
 		}
+
+		for i := range joining {
+			joining[i].Status = *operation.Status
+		}
+		go app.welcomeMembers(clientID, group, joining)
 	}
 	return nil
 }
@@ -366,6 +671,8 @@ func (app *Application) reportGroupAsAbuse(clientID string, current *model.User,
 		return fmt.Errorf("error while reporting an abuse group: %s", err)
 	}
 
+	app.createAbuseReport(clientID, current, group, nil, comment, true, false)
+
 	subject := fmt.Sprintf("Report violation of Student Code to Dean of Students for group: %s", group.Title)
 
 	body := fmt.Sprintf(`
@@ -381,6 +688,10 @@ func (app *Application) getPosts(clientID string, current *model.User, filter mo
 	return app.storage.FindPosts(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
 }
 
+func (app *Application) countPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) (int64, error) {
+	return app.storage.CountPosts(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
+}
+
 func (app *Application) getPost(clientID string, userID *string, groupID string, postID string, skipMembershipCheck bool, filterByToMembers bool) (*model.Post, error) {
 	return app.storage.FindPost(nil, clientID, userID, groupID, postID, skipMembershipCheck, filterByToMembers)
 }
@@ -390,12 +701,102 @@ func (app *Application) getUserPostCount(clientID string, userID string) (*int64
 }
 
 func (app *Application) createPost(clientID string, current *model.User, post *model.Post, group *model.Group) (*model.Post, error) {
+	if group.CurrentMember != nil && group.CurrentMember.IsPostingMuted(time.Now()) {
+		return nil, &model.PostingMutedError{GroupID: group.ID, MutedUntil: *group.CurrentMember.PostingMutedUntil}
+	}
+	if post.Status != "" && !post.IsDraft() {
+		return nil, fmt.Errorf("invalid post status %s", post.Status)
+	}
+	if post.IsDraft() && post.ParentID != nil {
+		return nil, errors.New("replies could not be drafts")
+	}
+	var parentPost *model.Post
+	if post.ParentID != nil {
+		var err error
+		parentPost, err = app.storage.FindPost(nil, clientID, &current.ID, group.ID, *post.ParentID, true, false)
+		if err != nil {
+			return nil, err
+		}
+		if parentPost != nil && !parentPost.IsPublished() {
+			return nil, errors.New("only the published posts could be replied")
+		}
+	}
 
-	post, err := app.storage.CreatePost(clientID, current, post)
+	rateLimitAction := model.ContentActionPost
+	if post.ParentID != nil {
+		rateLimitAction = model.ContentActionReply
+	}
+	err := app.checkContentRateLimit(clientID, current, group.ID, rateLimitAction)
 	if err != nil {
 		return nil, err
 	}
 
+	err = app.checkPostLimits(clientID, current, group.ID, post, parentPost)
+	if err != nil {
+		return nil, err
+	}
+
+	flagged, err := app.filterPostContent(clientID, post)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.resolvePostMentions(clientID, group.ID, post)
+	if err != nil {
+		return nil, err
+	}
+
+	// the posts of the members of the pre-moderated groups are published once an admin approves them
+	if !post.IsDraft() && group.RequiresPostApproval(group.CurrentMember) {
+		post.Status = model.PostStatusPendingApproval
+	}
+
+	// Scheduled posts are checked against the quiet hours by the scheduler once they are due
+	if post.DateScheduled == nil && post.IsPublished() {
+		post.DateNotificationDeferred = group.QuietHoursEnd(time.Now())
+	}
+
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		post, err = app.storage.CreatePost(context, clientID, current, post)
+		if err != nil || !post.IsPublished() {
+			return err
+		}
+		return app.recordPostCreated(context, clientID, current, group, post)
+	})
+	if err != nil {
+		return nil, err
+	}
+	app.recordContentEvent(clientID, current, group.ID, rateLimitAction)
+	if flagged {
+		go app.flagPostContent(clientID, group, post)
+	}
+
+	// the drafts and the pending posts are announced once they are published
+	if !post.IsPublished() {
+		if post.IsPendingApproval() {
+			go app.notifyAdminsPostPending(clientID, current, group, post)
+		}
+		return post, nil
+	}
+
+	app.handlePostPublished(clientID, current, group, post)
+	return post, nil
+}
+
+// recordPostCreated records the domain event of a published post within the publishing transaction
+func (app *Application) recordPostCreated(context storage.TransactionContext, clientID string, current *model.User, group *model.Group, post *model.Post) error {
+	return app.recordDomainEvent(context, clientID, model.DomainEventPostCreated, group.ID, map[string]interface{}{
+		"group_id":       group.ID,
+		"post_id":        post.ID,
+		"parent_id":      post.ParentID,
+		"creator_id":     current.ID,
+		"private":        post.Private,
+		"date_scheduled": post.DateScheduled,
+	})
+}
+
+// handlePostPublished gives the rewards, sends the notifications and publishes the webhook event of a new post
+func (app *Application) handlePostPublished(clientID string, current *model.User, group *model.Group, post *model.Post) {
 	handleRewardsAsync := func(clientID, userID string) {
 		count, grErr := app.storage.GetUserPostCount(clientID, current.ID)
 		if grErr != nil {
@@ -412,12 +813,25 @@ func (app *Application) createPost(clientID string, current *model.User, post *m
 
 	go app.sendGroupNotificationForNewPost(clientID, &current.ID, &current.Name, group, post)
 
-	return post, nil
+	go app.publishWebhookEvent(clientID, model.WebhookEventPostCreated, group.ID, map[string]interface{}{
+		"group_id":       group.ID,
+		"post_id":        post.ID,
+		"creator_id":     current.ID,
+		"private":        post.Private,
+		"date_scheduled": post.DateScheduled,
+	})
 }
 
 func (app *Application) sendGroupNotificationForNewPost(clientID string, currentUserID *string, currentUserName *string, group *model.Group, post *model.Post) error {
 	now := time.Now()
+	if post.DateNotificationDeferred != nil && now.Before(*post.DateNotificationDeferred) {
+		return nil // the scheduler sends it at the end of the group quiet hours
+	}
 	if post.DateScheduled == nil || now.After(*post.DateScheduled) {
+		// the bursts of the posts to the whole group are notified within a summary
+		if post.ParentID == nil && len(post.ToMembersList) == 0 && !post.UseAsNotification && app.batchPostNotification(clientID, group, post) {
+			return nil
+		}
 
 		recipientsUserIDs, _ := app.getPostNotificationRecipientsAsUserIDs(clientID, post, currentUserID)
 
@@ -486,25 +900,22 @@ func (app *Application) sendGroupNotificationForNewPost(clientID string, current
 			}
 
 			topic := "group.posts"
-			return app.notifications.SendNotification(
-				recipients,
-				&topic,
-				title,
-				body,
-				map[string]string{
-					"type":         "group",
-					"operation":    "post_created",
-					"entity_type":  "group",
-					"entity_id":    group.ID,
-					"entity_name":  group.Title,
-					"post_id":      post.ID,
-					"post_subject": post.Subject,
-					"post_body":    post.Body,
-				},
-				app.config.AppID,
-				app.config.OrgID,
-				nil,
-			)
+			data := map[string]string{
+				"type":         "group",
+				"operation":    "post_created",
+				"entity_type":  "group",
+				"entity_id":    group.ID,
+				"entity_name":  group.Title,
+				"post_id":      post.ID,
+				"post_subject": post.Subject,
+				"post_body":    post.Body,
+			}
+			if post.ParentID == nil && len(post.ToMembersList) == 0 {
+				// the announcements to the whole group carry an unsubscribe link
+				return app.sendUnsubscribableNotification(clientID, group.ID, model.UnsubscribeScopePosts, recipients, &topic, title, body, data,
+					app.config.AppID, app.config.OrgID)
+			}
+			return app.sendNotification(recipients, &topic, title, body, data, app.config.AppID, app.config.OrgID, nil)
 		}
 	}
 	return nil
@@ -540,10 +951,42 @@ func (app *Application) getPostNotificationRecipientsAsUserIDs(clientID string,
 }
 
 func (app *Application) updatePost(clientID string, current *model.User, group *model.Group, post *model.Post) (*model.Post, error) {
-	return app.storage.UpdatePost(clientID, current.ID, post)
+	err := app.checkPostLimits(clientID, current, group.ID, post, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	flagged, err := app.filterPostContent(clientID, post)
+	if err != nil {
+		return nil, err
+	}
+
+	err = app.resolvePostMentions(clientID, group.ID, post)
+	if err != nil {
+		return nil, err
+	}
+
+	post, err = app.storage.UpdatePost(clientID, current.ID, post)
+	if err != nil {
+		return nil, err
+	}
+	if flagged {
+		go app.flagPostContent(clientID, group, post)
+	}
+	return post, nil
 }
 
 func (app *Application) reactToPost(clientID string, current *model.User, groupID string, postID string, reaction string) error {
+	err := app.checkReactionLimits(clientID, current, groupID, postID, reaction)
+	if err != nil {
+		return err
+	}
+	err = app.checkContentRateLimit(clientID, current, groupID, model.ContentActionReaction)
+	if err != nil {
+		return err
+	}
+
+	on := true
 	transaction := func(context storage.TransactionContext) error {
 		post, err := app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
 		if err != nil {
@@ -555,6 +998,7 @@ func (app *Application) reactToPost(clientID string, current *model.User, groupI
 
 		for _, userID := range post.Reactions[reaction] {
 			if current.ID == userID {
+				on = false
 				err = app.storage.ReactToPost(context, current.ID, postID, reaction, false)
 				if err != nil {
 					return fmt.Errorf("error removing reaction: %v", err)
@@ -572,7 +1016,16 @@ func (app *Application) reactToPost(clientID string, current *model.User, groupI
 		return nil
 	}
 
-	return app.storage.PerformTransaction(transaction)
+	err = app.storage.PerformTransaction(transaction)
+	if err != nil {
+		return err
+	}
+
+	app.recordReactionEvent(clientID, current, groupID, postID, reaction, on)
+	if on {
+		app.recordContentEvent(clientID, current, groupID, model.ContentActionReaction)
+	}
+	return nil
 }
 
 func (app *Application) reportPostAsAbuse(clientID string, current *model.User, group *model.Group, post *model.Post, comment string, sendToDean bool, sendToGroupAdmins bool) error {
@@ -587,6 +1040,8 @@ func (app *Application) reportPostAsAbuse(clientID string, current *model.User,
 		return fmt.Errorf("error while reporting an abuse post: %s", err)
 	}
 
+	app.createAbuseReport(clientID, current, group, post, comment, sendToDean, sendToGroupAdmins)
+
 	subject := ""
 	if sendToDean && !sendToGroupAdmins {
 		subject = "Report violation of Student Code to Dean of Students"
@@ -630,7 +1085,7 @@ Reported comment: %s
 	`, current.ExternalID, post.Creator.Name, group.Title, post.Subject, post.Body,
 			current.ExternalID, current.Name, comment)
 
-		return app.notifications.SendNotification(toMembers, nil, subject, body, map[string]string{
+		return app.sendNotification(toMembers, nil, subject, body, map[string]string{
 			"type":         "group",
 			"operation":    "report_abuse_post",
 			"entity_type":  "group",
@@ -649,8 +1104,43 @@ Reported comment: %s
 	return nil
 }
 
-func (app *Application) deletePost(clientID string, userID string, groupID string, postID string, force bool) error {
-	return app.storage.DeletePost(nil, clientID, userID, groupID, postID, force)
+// deletePost soft deletes the post with its replies. Only the moderation is audited - the creators deleting their own posts are not.
+func (app *Application) deletePost(clientID string, current *model.User, groupID string, postID string, force bool) error {
+	return app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		post, err := app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
+		if err != nil {
+			return err
+		}
+
+		err = app.storage.DeletePost(context, clientID, current.ID, groupID, postID, force)
+		if err != nil {
+			return err
+		}
+
+		// the deletion of someone else's post passed the admin check of the storage
+		if post != nil && post.Creator.UserID != current.ID {
+			return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionPostDeleted, "post", postID,
+				map[string]model.AuditChange{"creator_id": {Old: post.Creator.UserID}})
+		}
+		return nil
+	})
+}
+
+func (app *Application) restorePost(clientID string, current *model.User, groupID string, postID string) (*model.Post, error) {
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		restored, err := app.storage.RestorePost(context, clientID, groupID, postID)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionPostRestored, "post", postID,
+			map[string]model.AuditChange{"restored_count": {New: restored}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return app.storage.FindPost(nil, clientID, &current.ID, groupID, postID, true, false)
 }
 
 func (app *Application) sendGroupNotification(clientID string, notification model.GroupNotification, predicate model.MutePreferencePredicate) error {
@@ -670,13 +1160,22 @@ func (app *Application) sendGroupNotification(clientID string, notification mode
 	}
 
 	recipients := members.GetMembersAsNotificationRecipients(predicate)
-	app.sendNotification(recipients, notification.Topic, notification.Subject, notification.Body, notification.Data, app.config.AppID, app.config.OrgID)
+	app.sendNotification(recipients, notification.Topic, notification.Subject, notification.Body, notification.Data, app.config.AppID, app.config.OrgID, nil)
 
 	return nil
 }
 
-func (app *Application) sendNotification(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string) {
-	app.notifications.SendNotification(recipients, topic, title, text, data, appID, orgID, nil)
+func (app *Application) sendNotification(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string, dateScheduled *time.Time) error {
+	recipients = app.applyMembershipMutes(recipients, data)
+
+	err := app.notifications.SendNotification(recipients, topic, title, text, data, appID, orgID, dateScheduled)
+	if err != nil {
+		return err
+	}
+
+	app.mirrorNotificationToInbox(recipients, topic, title, text, data, appID, orgID, dateScheduled)
+
+	return nil
 }
 
 func (app *Application) getManagedGroupConfigs(clientID string) ([]model.ManagedGroupConfig, error) {
@@ -707,6 +1206,22 @@ func (app *Application) updateSyncConfig(config model.SyncConfig) error {
 	return app.storage.SaveSyncConfig(nil, config)
 }
 
+func (app *Application) getPrivacyConfig(clientID string) (*model.PrivacyConfig, error) {
+	return app.storage.FindPrivacyConfig(nil, clientID)
+}
+
+func (app *Application) updatePrivacyConfig(config model.PrivacyConfig) error {
+	return app.storage.SavePrivacyConfig(nil, config)
+}
+
+func (app *Application) getLicenseConfig(clientID string) (*model.LicenseConfig, error) {
+	return app.storage.FindLicenseConfig(nil, clientID)
+}
+
+func (app *Application) updateLicenseConfig(config model.LicenseConfig) error {
+	return app.storage.SaveLicenseConfig(nil, config)
+}
+
 func (app *Application) findGroupMembership(clientID string, groupID string, userID string) (*model.GroupMembership, error) {
 	return app.storage.FindGroupMembership(clientID, groupID, userID)
 }
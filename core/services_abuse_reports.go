@@ -0,0 +1,157 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// createAbuseReport records the report, so it could be moderated. The report emails and notifications are sent regardless of the result.
+func (app *Application) createAbuseReport(clientID string, current *model.User, group *model.Group, post *model.Post, comment string, sendToDean bool, sendToGroupAdmins bool) {
+	report := model.AbuseReport{
+		ID:                uuid.NewString(),
+		ClientID:          clientID,
+		TargetType:        model.AbuseReportTargetGroup,
+		GroupID:           group.ID,
+		GroupTitle:        group.Title,
+		ReporterID:        current.ID,
+		ReporterName:      current.Name,
+		Comment:           comment,
+		SendToDean:        sendToDean,
+		SendToGroupAdmins: sendToGroupAdmins,
+		Status:            model.AbuseReportStatusOpen,
+		DateCreated:       time.Now(),
+	}
+	if post != nil {
+		report.TargetType = model.AbuseReportTargetPost
+		report.PostID = post.ID
+		report.ReportedUserID = post.Creator.UserID
+		report.ReportedUserName = post.Creator.Name
+	}
+
+	err := app.storage.CreateAbuseReport(nil, report)
+	if err != nil {
+		log.Printf("error app.createAbuseReport() - unable to record the report of %s %s: %s", report.TargetType, report.GroupID, err)
+	}
+}
+
+func (app *Application) getAbuseReports(clientID string, filter model.AbuseReportsFilter) ([]model.AbuseReport, error) {
+	return app.storage.FindAbuseReports(nil, clientID, filter)
+}
+
+// moderateAbuseReport applies the moderation action on the report. The group admins pass the group ID and could moderate only the reports of
+// the group visible to them. It gives nil if there is no such report.
+func (app *Application) moderateAbuseReport(clientID string, current *model.User, groupID *string, id string, action string, comment string) (*model.AbuseReport, error) {
+	if !model.IsAbuseReportAction(action) {
+		return nil, fmt.Errorf("invalid abuse report action %s", action)
+	}
+
+	report, err := app.storage.FindAbuseReport(nil, clientID, id)
+	if err != nil || report == nil {
+		return nil, err
+	}
+	if groupID != nil && (report.GroupID != *groupID || !report.IsVisibleToGroupAdmins()) {
+		return nil, nil
+	}
+	if report.IsResolved() {
+		return nil, errors.New("the abuse report is already resolved")
+	}
+	if (action == model.AbuseReportActionRemovePost || action == model.AbuseReportActionWarnUser) && report.TargetType != model.AbuseReportTargetPost {
+		return nil, fmt.Errorf("the %s action is allowed only for the reports of posts", action)
+	}
+
+	switch action {
+	case model.AbuseReportActionRemovePost:
+		err = app.deletePost(clientID, current, report.GroupID, report.PostID, true)
+		if err != nil {
+			return nil, fmt.Errorf("error removing the reported post %s: %s", report.PostID, err)
+		}
+	case model.AbuseReportActionWarnUser:
+		err = app.warnReportedUser(clientID, current, report, comment)
+		if err != nil {
+			return nil, fmt.Errorf("error warning the author of the reported post %s: %s", report.PostID, err)
+		}
+	}
+
+	status := model.AbuseReportStatusResolved
+	if action == model.AbuseReportActionReview {
+		status = model.AbuseReportStatusReviewed
+	}
+	updated := false
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		updated, err = app.storage.UpdateAbuseReportStatus(context, clientID, report.ID, status, action, current.ID, comment)
+		if err != nil || !updated {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, report.GroupID, model.AuditActionAbuseReportModerated, "abuse_report", report.ID,
+			map[string]model.AuditChange{
+				"status": {Old: report.Status, New: status},
+				"action": {New: action},
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !updated {
+		return nil, errors.New("the abuse report is already resolved")
+	}
+
+	now := time.Now()
+	report.Status = status
+	report.Action = &action
+	report.ModeratorID = &current.ID
+	report.ModeratorComment = &comment
+	report.DateUpdated = &now
+	if status == model.AbuseReportStatusResolved {
+		report.DateResolved = &now
+	}
+	return report, nil
+}
+
+// warnReportedUser notifies the author of the reported post about the violation
+func (app *Application) warnReportedUser(clientID string, current *model.User, report *model.AbuseReport, comment string) error {
+	text := fmt.Sprintf("Your post in '%s' was reported as a violation of the community guidelines", report.GroupTitle)
+	if len(comment) > 0 {
+		text = fmt.Sprintf("%s: %s", text, comment)
+	}
+
+	return app.sendNotification(
+		[]notifications.Recipient{{UserID: report.ReportedUserID, Name: report.ReportedUserName}},
+		nil,
+		fmt.Sprintf("Group - %s", report.GroupTitle),
+		text,
+		map[string]string{
+			"type":        "group",
+			"operation":   "abuse_warning",
+			"entity_type": "group",
+			"entity_id":   report.GroupID,
+			"entity_name": report.GroupTitle,
+			"post_id":     report.PostID,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+}
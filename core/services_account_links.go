@@ -0,0 +1,111 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+)
+
+// accountLinkRepairBatchSize is the number of the external IDs resolved per Core BB request by the account link repair
+const accountLinkRepairBatchSize = 200
+
+// loginUser links the data added by the external ID or the NetID of the user before the first login to the Core BB account
+func (app *Application) loginUser(clientID string, current *model.User) (*model.AccountLinkResult, error) {
+	if current.IsAnonymous {
+		return &model.AccountLinkResult{}, nil
+	}
+	return app.linkAccountData(clientID, current, current.ID, current.ExternalID, current.NetID)
+}
+
+// linkAccountData claims the memberships, the posts and the events of the identifiers by the account in one transaction
+func (app *Application) linkAccountData(clientID string, current *model.User, userID string, externalID string, netID string) (*model.AccountLinkResult, error) {
+	if len(userID) == 0 || (len(externalID) == 0 && len(netID) == 0) {
+		return &model.AccountLinkResult{}, nil
+	}
+
+	var result *model.AccountLinkResult
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		result, err = app.storage.LinkAccountData(context, clientID, userID, externalID, netID)
+		if err != nil || result.IsEmpty() {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, "", model.AuditActionAccountLinked, "user", userID,
+			map[string]model.AuditChange{
+				"memberships": {New: result.Memberships},
+				"posts":       {New: result.Posts},
+				"events":      {New: result.Events},
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error linking the data of account %s: %s", userID, err)
+	}
+
+	if !result.IsEmpty() {
+		log.Printf("linked %d memberships, %d posts and %d events to account %s", result.Memberships, result.Posts, result.Events, userID)
+	}
+	return result, nil
+}
+
+// repairAccountLinks links the data of the unlinked memberships to the Core BB accounts of their external IDs. It is meant for the
+// users whose data has been added after they logged in, or whose link has been missed at the login.
+func (app *Application) repairAccountLinks(clientID string, current *model.User) (*model.AccountLinkRepairResult, error) {
+	result := model.AccountLinkRepairResult{}
+
+	afterExternalID := ""
+	for {
+		externalIDs, err := app.storage.FindUnlinkedMembershipExternalIDs(nil, clientID, afterExternalID, accountLinkRepairBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the unlinked memberships: %s", err)
+		}
+		if len(externalIDs) == 0 {
+			break
+		}
+		afterExternalID = externalIDs[len(externalIDs)-1]
+		result.ExternalIDs += len(externalIDs)
+
+		accounts, err := app.corebb.GetAllCoreAccountsWithExternalIDs(externalIDs, &current.AppID, &current.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the core accounts: %s", err)
+		}
+
+		found := map[string]bool{}
+		for _, account := range accounts {
+			externalID := account.GetExternalID()
+			if len(externalID) == 0 || found[externalID] {
+				continue
+			}
+			found[externalID] = true
+
+			linked, err := app.linkAccountData(clientID, current, account.ID, externalID, account.GetNetID())
+			if err != nil {
+				log.Printf("repairAccountLinks: %s", err)
+				result.Failed++
+				continue
+			}
+			if !linked.IsEmpty() {
+				result.LinkedUsers++
+				result.Add(*linked)
+			}
+		}
+		result.MissingUsers += len(externalIDs) - len(found)
+	}
+
+	return &result, nil
+}
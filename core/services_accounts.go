@@ -0,0 +1,33 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+)
+
+// mergeAccounts moves the data of the old account within the client to the surviving account once Core BB merges them
+func (app *Application) mergeAccounts(clientID string, merge model.AccountMerge) (*model.AccountMergeResult, error) {
+	result, err := app.storage.MergeUserAccounts(clientID, app.config.AppID, app.config.OrgID, merge.OldUserID, merge.NewUserID)
+	if err != nil {
+		log.Printf("app.mergeAccounts() error merging account %s into %s: %s", merge.OldUserID, merge.NewUserID, err)
+		return nil, err
+	}
+
+	log.Printf("app.mergeAccounts() merged account %s into %s: %d memberships moved, %d memberships merged, %d posts, %d events",
+		merge.OldUserID, merge.NewUserID, result.MembershipsMoved, result.MembershipsMerged, result.Posts, result.Events)
+	return result, nil
+}
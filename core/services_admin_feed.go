@@ -0,0 +1,97 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"sort"
+)
+
+// getAdminFeed gives the pending memberships, the held posts, the unresolved abuse reports and the failed syncs across all the groups
+// the current user administers
+func (app *Application) getAdminFeed(clientID string, current *model.User) (*model.AdminFeed, error) {
+	memberships, err := app.storage.FindUserGroupMemberships(clientID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := []string{}
+	for _, membership := range memberships.Items {
+		if membership.IsAdmin() {
+			groupIDs = append(groupIDs, membership.GroupID)
+		}
+	}
+
+	feed := model.AdminFeed{Items: []model.AdminFeedItem{}}
+	if len(groupIDs) == 0 {
+		return &feed, nil
+	}
+
+	sources := []struct {
+		itemType string
+		find     func() ([]model.AdminFeedGroupCount, error)
+		total    *int64
+	}{
+		{model.AdminFeedItemTypePendingMemberships, func() ([]model.AdminFeedGroupCount, error) {
+			return app.storage.CountPendingMembershipsByGroup(nil, clientID, groupIDs)
+		}, &feed.Counts.PendingMemberships},
+		{model.AdminFeedItemTypePendingPosts, func() ([]model.AdminFeedGroupCount, error) {
+			return app.storage.CountPendingPostsByGroup(nil, clientID, groupIDs)
+		}, &feed.Counts.PendingPosts},
+		{model.AdminFeedItemTypeAbuseReports, func() ([]model.AdminFeedGroupCount, error) {
+			return app.storage.CountOpenAbuseReportsByGroup(nil, clientID, groupIDs)
+		}, &feed.Counts.AbuseReports},
+		{model.AdminFeedItemTypeFailedSync, func() ([]model.AdminFeedGroupCount, error) {
+			return app.storage.FindFailedGroupSyncs(nil, clientID, groupIDs)
+		}, &feed.Counts.FailedSyncs},
+	}
+	for _, source := range sources {
+		counts, err := source.find()
+		if err != nil {
+			return nil, fmt.Errorf("error counting %s: %s", source.itemType, err)
+		}
+		for _, count := range counts {
+			*source.total += count.Count
+			feed.Items = append(feed.Items, model.AdminFeedItem{
+				Type:       source.itemType,
+				GroupID:    count.GroupID,
+				Count:      count.Count,
+				DateLatest: count.DateLatest,
+				DeepLink:   model.AdminFeedDeepLink(source.itemType, count.GroupID),
+			})
+		}
+	}
+	if len(feed.Items) == 0 {
+		return &feed, nil
+	}
+
+	groups, err := app.storage.FindGroups(clientID, nil, model.GroupsFilter{GroupIDs: groupIDs})
+	if err != nil {
+		return nil, err
+	}
+	titles := map[string]string{}
+	for _, group := range groups {
+		titles[group.ID] = group.Title
+	}
+	for i := range feed.Items {
+		feed.Items[i].GroupTitle = titles[feed.Items[i].GroupID]
+	}
+
+	sort.SliceStable(feed.Items, func(i, j int) bool {
+		return feed.Items[i].DateLatest.After(feed.Items[j].DateLatest)
+	})
+	return &feed, nil
+}
@@ -15,18 +15,211 @@
 package core
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"groups/core/model"
+	"log"
 	"time"
 )
 
-func (app *Application) analyticsFindGroups(startDate *time.Time, endDate *time.Time) ([]model.Group, error) {
-	return app.storage.AnalyticsFindGroups(startDate, endDate)
+// defaultKAnonymityThreshold is used when the privacy mode is enabled without an explicit threshold
+const defaultKAnonymityThreshold = 5
+
+const (
+	// defaultGroupAnalyticsWeeks is the number of weeks of the group analytics when the client does not provide it
+	defaultGroupAnalyticsWeeks = 12
+	// maxGroupAnalyticsWeeks is the max number of weeks of the group analytics
+	maxGroupAnalyticsWeeks = 52
+	// groupActiveMembersPeriod is the period within which a member must have posted or reacted to be counted as active
+	groupActiveMembersPeriod = 30 * 24 * time.Hour
+)
+
+func (app *Application) analyticsFindGroups(clientID string, startDate *time.Time, endDate *time.Time) ([]model.Group, error) {
+	groups, err := app.storage.AnalyticsFindGroups(startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	privacyConfig := app.getAnalyticsPrivacyConfig(clientID)
+	if privacyConfig == nil {
+		return groups, nil
+	}
+
+	k := privacyConfig.KAnonymityThreshold
+	result := []model.Group{}
+	for _, group := range groups {
+		if group.Stats.TotalCount < k {
+			continue
+		}
+		group.Stats.AdminsCount = suppressSmallCell(group.Stats.AdminsCount, k)
+		group.Stats.MemberCount = suppressSmallCell(group.Stats.MemberCount, k)
+		group.Stats.PendingCount = suppressSmallCell(group.Stats.PendingCount, k)
+		group.Stats.RejectedCount = suppressSmallCell(group.Stats.RejectedCount, k)
+		group.Stats.AttendanceCount = suppressSmallCell(group.Stats.AttendanceCount, k)
+		result = append(result, group)
+	}
+	return result, nil
 }
 
-func (app *Application) analyticsFindPosts(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error) {
-	return app.storage.AnalyticsFindPosts(groupID, startDate, endDate)
+func (app *Application) analyticsFindPosts(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error) {
+	posts, err := app.storage.AnalyticsFindPosts(groupID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	privacyConfig := app.getAnalyticsPrivacyConfig(clientID)
+	if privacyConfig == nil {
+		return posts, nil
+	}
+
+	authorsMapping := map[string]map[string]bool{}
+	for _, post := range posts {
+		if authorsMapping[post.GroupID] == nil {
+			authorsMapping[post.GroupID] = map[string]bool{}
+		}
+		authorsMapping[post.GroupID][post.Creator.UserID] = true
+	}
+
+	result := []model.Post{}
+	for _, post := range posts {
+		if len(authorsMapping[post.GroupID]) < privacyConfig.KAnonymityThreshold {
+			continue
+		}
+		post.Creator = model.Creator{UserID: app.hashAnalyticsIdentifier(post.Creator.UserID)}
+		result = append(result, post)
+	}
+	return result, nil
+}
+
+func (app *Application) analyticsFindMembers(clientID string, groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error) {
+	members, err := app.storage.AnalyticsFindMembers(groupID, startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+
+	privacyConfig := app.getAnalyticsPrivacyConfig(clientID)
+	if privacyConfig == nil {
+		return members, nil
+	}
+
+	groupCounts := map[string]int{}
+	for _, member := range members {
+		groupCounts[member.GroupID]++
+	}
+
+	result := []model.GroupMembership{}
+	for _, member := range members {
+		if groupCounts[member.GroupID] < privacyConfig.KAnonymityThreshold {
+			continue
+		}
+		result = append(result, model.GroupMembership{
+			ID:          app.hashAnalyticsIdentifier(member.ID),
+			ClientID:    member.ClientID,
+			GroupID:     member.GroupID,
+			UserID:      app.hashAnalyticsIdentifier(member.UserID),
+			Status:      member.Status,
+			DateCreated: member.DateCreated,
+			DateUpdated: member.DateUpdated,
+		})
+	}
+	return result, nil
 }
 
-func (app *Application) analyticsFindMembers(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.GroupMembership, error) {
-	return app.storage.AnalyticsFindMembers(groupID, startDate, endDate)
+// getAnalyticsPrivacyConfig returns the privacy config of the client only if the privacy mode is enabled
+func (app *Application) getAnalyticsPrivacyConfig(clientID string) *model.PrivacyConfig {
+	config, err := app.storage.FindPrivacyConfig(nil, clientID)
+	if err != nil {
+		log.Printf("app.getAnalyticsPrivacyConfig() error loading the privacy config for %s: %s", clientID, err)
+		return nil
+	}
+	if config == nil || !config.PrivacyMode {
+		return nil
+	}
+	if config.KAnonymityThreshold <= 0 {
+		config.KAnonymityThreshold = defaultKAnonymityThreshold
+	}
+	return config
+}
+
+// hashAnalyticsIdentifier hashes the identifier with the configured salt so that the same user is still traceable across the exports
+func (app *Application) hashAnalyticsIdentifier(identifier string) string {
+	if identifier == "" {
+		return ""
+	}
+	hash := sha256.Sum256([]byte(app.config.AnalyticsHashSalt + identifier))
+	return hex.EncodeToString(hash[:])
+}
+
+// suppressSmallCell reports the counts below the k-anonymity threshold as zero
+func suppressSmallCell(count int, k int) int {
+	if count < k {
+		return 0
+	}
+	return count
+}
+
+func (app *Application) getGroupAnalytics(clientID string, groupID string, weeks int) (*model.GroupAnalytics, error) {
+	if weeks <= 0 {
+		weeks = defaultGroupAnalyticsWeeks
+	}
+	if weeks > maxGroupAnalyticsWeeks {
+		weeks = maxGroupAnalyticsWeeks
+	}
+
+	now := time.Now().UTC()
+	year, week := now.ISOWeek()
+	since := model.ISOWeekStart(year, week).AddDate(0, 0, -7*(weeks-1))
+
+	posts, err := app.storage.FindGroupWeeklyPostCounts(nil, clientID, groupID, since)
+	if err != nil {
+		return nil, fmt.Errorf("error counting posts per week for group %s: %s", groupID, err)
+	}
+	members, err := app.storage.FindGroupWeeklyNewMemberCounts(nil, clientID, groupID, since)
+	if err != nil {
+		return nil, fmt.Errorf("error counting new members per week for group %s: %s", groupID, err)
+	}
+	events, err := app.storage.FindGroupWeeklyEventCounts(nil, clientID, groupID, since)
+	if err != nil {
+		return nil, fmt.Errorf("error counting events per week for group %s: %s", groupID, err)
+	}
+	eventsCount, err := app.storage.CountGroupEvents(nil, clientID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error counting events for group %s: %s", groupID, err)
+	}
+	activeMembersCount, err := app.storage.CountGroupActiveMembers(nil, clientID, groupID, now.Add(-groupActiveMembersPeriod))
+	if err != nil {
+		return nil, fmt.Errorf("error counting active members for group %s: %s", groupID, err)
+	}
+	rejectionsByReason, err := app.storage.CountGroupRejectionsByReason(nil, clientID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error counting rejections for group %s: %s", groupID, err)
+	}
+
+	return &model.GroupAnalytics{
+		GroupID:            groupID,
+		Weeks:              weeks,
+		PostsPerWeek:       fillWeeklyCounts(posts, since, weeks),
+		NewMembersPerWeek:  fillWeeklyCounts(members, since, weeks),
+		EventsPerWeek:      fillWeeklyCounts(events, since, weeks),
+		EventsCount:        eventsCount,
+		ActiveMembersCount: activeMembersCount,
+		RejectionsByReason: rejectionsByReason,
+		DateGenerated:      now,
+	}, nil
+}
+
+// fillWeeklyCounts gives a continuous series of weeks starting from since, where the weeks without data have zero count
+func fillWeeklyCounts(counts []model.WeeklyCount, since time.Time, weeks int) []model.WeeklyCount {
+	countsMapping := map[time.Time]int64{}
+	for _, item := range counts {
+		countsMapping[item.WeekStart] = item.Count
+	}
+
+	result := make([]model.WeeklyCount, weeks)
+	for i := 0; i < weeks; i++ {
+		weekStart := since.AddDate(0, 0, 7*i)
+		result[i] = model.WeeklyCount{WeekStart: weekStart, Count: countsMapping[weekStart]}
+	}
+	return result
 }
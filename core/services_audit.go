@@ -0,0 +1,58 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// groupAuditIgnoredFields are the group fields which are either computed or maintained by the service and must not be part of the audit diff
+var groupAuditIgnoredFields = []string{"current_member", "members", "leaders", "stats", "date_created", "date_updated",
+	"date_membership_updated", "date_managed_membership_updated", "sync_start_time", "sync_end_time", "org_unit"}
+
+// membershipAuditIgnoredFields are the membership fields which must not be part of the audit diff
+var membershipAuditIgnoredFields = []string{"date_created", "date_updated"}
+
+// recordAuditLog stores an audit record for a privileged mutation. It must be called within the transaction of the audited mutation, so the record
+// is stored only if the mutation is committed and the mutation is rolled back if the record cannot be stored. nil current user means the action is
+// performed by the system.
+func (app *Application) recordAuditLog(context storage.TransactionContext, clientID string, current *model.User, groupID string, action string, targetType string, targetID string, diff map[string]model.AuditChange) error {
+	item := model.AuditLog{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		GroupID:     groupID,
+		Action:      action,
+		TargetType:  targetType,
+		TargetID:    targetID,
+		Actor:       model.NewAuditActor(current),
+		Diff:        diff,
+		DateCreated: time.Now().UTC(),
+	}
+
+	err := app.storage.InsertAuditLog(context, item)
+	if err != nil {
+		return fmt.Errorf("app.recordAuditLog() error storing %s audit record for group %s: %s", action, groupID, err)
+	}
+	return nil
+}
+
+func (app *Application) getGroupAuditLogs(clientID string, groupID string, filter model.AuditLogFilter) ([]model.AuditLog, error) {
+	return app.storage.FindAuditLogs(nil, clientID, groupID, filter)
+}
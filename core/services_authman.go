@@ -20,12 +20,27 @@ import (
 	"groups/core/model"
 	"groups/driven/storage"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// authmanFullSyncInterval is the max age of the group sync watermark for the incremental sync. A full sync is done once the watermark gets older, so any drift is repaired.
+const authmanFullSyncInterval = 24 * time.Hour
+
 func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool) error {
+	// only one instance runs the sync, the lock is taken over if the running instance fails
+	acquired, releaseLock, err := app.acquireLock("authman_sync_"+clientID, authmanSyncLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the Authman sync lock for clientID %s: %s", clientID, err)
+	}
+	if !acquired {
+		log.Println("Another instance is running the Authman sync for clientID " + clientID)
+		return fmt.Errorf("another instance is running the Authman sync for clientID %s", clientID)
+	}
+	defer releaseLock()
+
 	startTime := time.Now()
 	syncKey := "authman"
 	transaction := func(context storage.TransactionContext) error {
@@ -40,17 +55,10 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 			if err != nil {
 				log.Printf("error finding sync configs for clientID %s: %v", clientID, err)
 			}
-			timeout := defaultConfigSyncTimeout
-			if config != nil && config.Timeout > 0 {
-				timeout = config.Timeout
-			}
 
 			if times.EndTime == nil {
-				if !startTime.After(times.StartTime.Add(time.Minute * time.Duration(timeout))) {
-					log.Println("Another Authman sync process is running for clientID " + clientID)
-					return fmt.Errorf("another Authman sync process is running" + clientID)
-				}
-				log.Printf("Authman sync past timeout threshold %d mins for client ID %s\n", timeout, clientID)
+				// the lock is held, so the unfinished sync belongs to a failed instance and is taken over
+				log.Printf("Taking over unfinished Authman sync started at %s for client ID %s\n", times.StartTime, clientID)
 			}
 			if checkThreshold {
 				if config == nil {
@@ -67,15 +75,17 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 		return app.storage.SaveSyncTimes(context, model.SyncTimes{StartTime: &startTime, EndTime: nil, Key: syncKey})
 	}
 
-	err := app.storage.PerformTransaction(transaction)
+	err = app.storage.PerformTransaction(transaction)
 	if err != nil {
 		return err
 	}
 
 	log.Printf("Global Authman synchronization started for clientID: %s\n", clientID)
 
+	run := app.startAuthmanSyncRun(clientID, model.AuthmanSyncRunTypeGlobal, nil)
 	app.authmanSyncInProgress = true
 	finishAuthmanSync := func() {
+		app.finishAuthmanSyncRun(run)
 		endTime := time.Now()
 		err := app.storage.SaveSyncTimes(nil, model.SyncTimes{StartTime: &startTime, EndTime: &endTime, Key: syncKey})
 		if err != nil {
@@ -86,6 +96,38 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 	}
 	defer finishAuthmanSync()
 
+	err = app.syncAuthmanStemGroups(clientID, run)
+	if err != nil {
+		run.AddError(err)
+		return err
+	}
+
+	authmanGroups, err := app.storage.FindAuthmanGroups(clientID)
+	if err != nil {
+		run.AddError(err)
+		return err
+	}
+
+	if len(authmanGroups) > 0 {
+		for _, authmanGroup := range authmanGroups {
+			groupRun, err := app.runAuthmanGroupSync(clientID, authmanGroup.ID)
+			if groupRun != nil {
+				run.Added += groupRun.Added
+				run.Removed += groupRun.Removed
+				run.Failed += groupRun.Failed
+			}
+			if err != nil {
+				log.Printf("error app.synchronizeAuthmanGroup() '%s' - %s", authmanGroup.Title, err)
+				run.AddError(fmt.Errorf("group '%s': %s", authmanGroup.Title, err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// syncAuthmanStemGroups creates or updates the groups of the Authman stems of the managed group configs
+func (app *Application) syncAuthmanStemGroups(clientID string, run *model.AuthmanSyncRun) error {
 	configs, err := app.storage.FindManagedGroupConfigs(clientID)
 	if err != nil {
 		return fmt.Errorf("error finding managed group configs for clientID %s", clientID)
@@ -146,6 +188,7 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 							return fmt.Errorf("error on create Authman stem group: '%s' - %s", stemGroup.Name, err)
 						}
 
+						run.Added += int64(len(memberships))
 						log.Printf("Created new `%s` group", title)
 					} else {
 						missedUINs := []string{}
@@ -196,6 +239,7 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 
 						if storedStemGroup.Category == "" {
 							storedStemGroup.Category = "Academic" // Hardcoded.
+							storedStemGroup.Categories = []string{storedStemGroup.Category}
 							groupUpdated = true
 						}
 
@@ -203,6 +247,8 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 							err := app.storage.UpdateGroupWithMembership(nil, clientID, nil, storedStemGroup, membershipsForUpdate)
 							if err != nil {
 								log.Printf("error app.synchronizeAuthmanGroup() - unable to update group admins of '%s' - %s", storedStemGroup.Title, err)
+								run.Failed += int64(len(membershipsForUpdate))
+								run.AddError(fmt.Errorf("unable to update group admins of '%s': %s", storedStemGroup.Title, err))
 							}
 						}
 					}
@@ -211,20 +257,6 @@ func (app *Application) synchronizeAuthman(clientID string, checkThreshold bool)
 		}
 	}
 
-	authmanGroups, err := app.storage.FindAuthmanGroups(clientID)
-	if err != nil {
-		return err
-	}
-
-	if len(authmanGroups) > 0 {
-		for _, authmanGroup := range authmanGroups {
-			err := app.synchronizeAuthmanGroup(clientID, authmanGroup.ID)
-			if err != nil {
-				log.Printf("error app.synchronizeAuthmanGroup() '%s' - %s", authmanGroup.Title, err)
-			}
-		}
-	}
-
 	return nil
 }
 
@@ -268,41 +300,154 @@ func (app *Application) buildMembersByExternalIDs(clientID string, externalIDs [
 }
 
 func (app *Application) synchronizeAuthmanGroup(clientID string, groupID string) error {
+	_, err := app.runAuthmanGroupSync(clientID, groupID)
+	return err
+}
+
+// runAuthmanGroupSync synchronizes the Authman group and gives the record of the run. The run is nil if the sync has not been started.
+func (app *Application) runAuthmanGroupSync(clientID string, groupID string) (*model.AuthmanSyncRun, error) {
 	if groupID == "" {
-		return errors.New("Missing group ID")
+		return nil, errors.New("Missing group ID")
 	}
 	var group *model.Group
 	var err error
 	group, err = app.checkGroupSyncTimes(clientID, groupID)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	log.Printf("Authman synchronization for group %s started", *group.AuthmanGroup)
+	authmanKeys := group.GetAuthmanGroupKeys()
+	authmanLabel := strings.Join(authmanKeys, ",")
+	log.Printf("Authman synchronization for group %s started", authmanLabel)
+
+	run := app.startAuthmanSyncRun(clientID, model.AuthmanSyncRunTypeGroup, group)
+
+	// the incremental sync is used while the watermark is recent, the full sync is the fallback.
+	// The groups mapped to several Authman groups are always fully synchronized as the membership is the union of all keys.
+	var changes *model.AuthmanMemberChanges
+	if len(authmanKeys) == 1 && group.AuthmanSyncWatermark != nil && time.Since(*group.AuthmanSyncWatermark) < authmanFullSyncInterval {
+		var authmanErr error
+		changes, authmanErr = app.authman.RetrieveAuthmanGroupMemberChanges(authmanKeys[0], *group.AuthmanSyncWatermark)
+		if authmanErr != nil {
+			log.Printf("Falling back to full Authman synchronization for group %s: %s", authmanLabel, authmanErr)
+			changes = nil
+		} else if changes.HasConflicts() {
+			log.Printf("Falling back to full Authman synchronization for group %s due to conflicting member changes", authmanLabel)
+			changes = nil
+		}
+	}
 
-	authmanExternalIDs, authmanErr := app.authman.RetrieveAuthmanGroupMembers(*group.AuthmanGroup)
-	if authmanErr != nil {
-		return fmt.Errorf("error on requesting Authman for %s: %s", *group.AuthmanGroup, authmanErr)
+	watermark := time.Now()
+	var authmanExternalIDs []string
+	if changes == nil {
+		authmanExternalIDs, err = app.retrieveAuthmanGroupKeysMembers(authmanKeys, run)
+		if err != nil {
+			run.AddError(err)
+			app.finishAuthmanSyncRun(run)
+			return run, err
+		}
 	}
 
 	app.authmanSyncInProgress = true
 	finishAuthmanSync := func() {
+		app.finishAuthmanSyncRun(run)
 		endTime := time.Now()
 		group.SyncEndTime = &endTime
 		err = app.storage.UpdateGroupSyncTimes(nil, clientID, group)
 		if err != nil {
-			log.Printf("Error saving group to end sync for Authman %s: %s\n", *group.AuthmanGroup, err)
+			log.Printf("Error saving group to end sync for Authman %s: %s\n", authmanLabel, err)
 			return
 		}
-		log.Printf("Authman synchronization for group %s finished", *group.AuthmanGroup)
+		log.Printf("Authman synchronization for group %s finished", authmanLabel)
 	}
 	defer finishAuthmanSync()
 
-	err = app.syncAuthmanGroupMemberships(clientID, group, authmanExternalIDs)
+	if changes != nil {
+		run.Mode = model.AuthmanSyncModeDelta
+		watermark = changes.AsOf
+		err = app.syncAuthmanGroupMemberChanges(clientID, group, changes, run)
+	} else {
+		run.Mode = model.AuthmanSyncModeFull
+		err = app.syncAuthmanGroupMemberships(clientID, group, authmanExternalIDs, run)
+	}
 	if err != nil {
-		return fmt.Errorf("error updating group memberships for Authman %s: %s", *group.AuthmanGroup, err)
+		err = fmt.Errorf("error updating group memberships for Authman %s: %s", authmanLabel, err)
+		run.AddError(err)
+	}
+
+	// the watermark moves forward only if all changes are stored, otherwise the next sync is a full one
+	var nextWatermark *time.Time
+	if err == nil && run.Failed == 0 && len(run.Errors) == 0 {
+		nextWatermark = &watermark
+	}
+	watermarkErr := app.storage.UpdateGroupAuthmanSyncWatermark(nil, clientID, group.ID, nextWatermark)
+	if watermarkErr != nil {
+		log.Printf("Error saving the Authman sync watermark for group %s: %s\n", authmanLabel, watermarkErr)
+	}
+
+	return run, err
+}
+
+// retrieveAuthmanGroupKeysMembers gives the union of the members of all Authman group keys and records the per key results in the run.
+// It fails if the members of any key could not be retrieved, so that the members of that key are not removed.
+func (app *Application) retrieveAuthmanGroupKeysMembers(authmanKeys []string, run *model.AuthmanSyncRun) ([]string, error) {
+	var externalIDs []string
+	exists := map[string]bool{}
+	var failedKeys []string
+	run.Keys = nil
+	for _, key := range authmanKeys {
+		keyResult := model.AuthmanSyncKeyResult{Key: key}
+		members, err := app.authman.RetrieveAuthmanGroupMembers(key)
+		if err != nil {
+			errStr := err.Error()
+			keyResult.Error = &errStr
+			failedKeys = append(failedKeys, key)
+		} else {
+			keyResult.Members = len(members)
+			for _, externalID := range members {
+				if !exists[externalID] {
+					exists[externalID] = true
+					externalIDs = append(externalIDs, externalID)
+				}
+			}
+		}
+		run.Keys = append(run.Keys, keyResult)
+	}
+
+	if len(failedKeys) > 0 {
+		return nil, fmt.Errorf("error on requesting Authman for %s", strings.Join(failedKeys, ","))
+	}
+	return externalIDs, nil
+}
+
+// validateAuthmanGroups checks that the members of each Authman group key could be retrieved
+func (app *Application) validateAuthmanGroups(authmanGroups []string) []model.AuthmanSyncKeyResult {
+	run := &model.AuthmanSyncRun{}
+	app.retrieveAuthmanGroupKeysMembers(authmanGroups, run)
+	return run.Keys
+}
+
+// addAuthmanGroupMember adds the member to the primary Authman group of the group
+func (app *Application) addAuthmanGroupMember(group *model.Group, externalID string) error {
+	authmanGroup := group.GetPrimaryAuthmanGroupKey()
+	if authmanGroup == nil {
+		return nil
 	}
+	return app.authman.AddAuthmanMemberToGroup(*authmanGroup, externalID)
+}
 
+// removeAuthmanGroupMember removes the member from all Authman groups of the group, otherwise the next sync would add the member back
+func (app *Application) removeAuthmanGroupMember(group *model.Group, externalID string) error {
+	var errs []string
+	for _, authmanGroup := range group.GetAuthmanGroupKeys() {
+		err := app.authman.RemoveAuthmanMemberFromGroup(authmanGroup, externalID)
+		if err != nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", authmanGroup, err))
+		}
+	}
+	if len(errs) > 0 {
+		return errors.New(strings.Join(errs, "; "))
+	}
 	return nil
 }
 
@@ -318,8 +463,8 @@ func (app *Application) checkGroupSyncTimes(clientID string, groupID string) (*m
 		if group == nil {
 			return fmt.Errorf("missing group for ID %s", groupID)
 		}
-		if !group.IsAuthmanSyncEligible() {
-			return fmt.Errorf("Authman synchronization failed for group '%s' due to bad settings", group.Title)
+		if !group.IsAuthmanSyncEligible() && !group.IsSmartGroupSyncEligible() {
+			return fmt.Errorf("synchronization failed for group '%s' due to bad settings", group.Title)
 		}
 
 		if group.SyncStartTime != nil {
@@ -344,7 +489,7 @@ func (app *Application) checkGroupSyncTimes(clientID string, groupID string) (*m
 		group.SyncEndTime = nil
 		err = app.storage.UpdateGroupSyncTimes(context, clientID, group)
 		if err != nil {
-			return fmt.Errorf("error switching to group memberships for Authman %s: %s", *group.AuthmanGroup, err)
+			return fmt.Errorf("error switching to group memberships for Authman %s: %s", strings.Join(group.GetAuthmanGroupKeys(), ","), err)
 		}
 		return nil
 	}
@@ -357,30 +502,129 @@ func (app *Application) checkGroupSyncTimes(clientID string, groupID string) (*m
 	return group, nil
 }
 
-func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGroup *model.Group, authmanExternalIDs []string) error {
+func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGroup *model.Group, authmanExternalIDs []string, run *model.AuthmanSyncRun) error {
+	authmanKeys := authmanGroup.GetAuthmanGroupKeys()
+	authmanLabel := strings.Join(authmanKeys, ",")
 	syncID := uuid.NewString()
-	log.Printf("Sync ID %s for Authman %s...\n", syncID, *authmanGroup.AuthmanGroup)
+	log.Printf("Sync ID %s for Authman %s...\n", syncID, authmanLabel)
 
-	// Get list of all member external IDs (Authman members + admins)
-	allExternalIDs := append([]string{}, authmanExternalIDs...)
+	adminExternalIDsMap, err := app.findAuthmanGroupAdminExternalIDs(clientID, authmanGroup)
+	if err != nil {
+		return err
+	}
 
-	// Load existing admins
+	// the banned users are not synced, so they are removed as the unsynced memberships
+	authmanExternalIDs, err = app.filterBannedExternalIDs(clientID, authmanGroup.ID, authmanExternalIDs)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("Processing %d current members for Authman %s...\n", len(authmanExternalIDs), authmanLabel)
+	app.saveAuthmanGroupMemberships(clientID, authmanGroup, authmanExternalIDs, adminExternalIDsMap, &syncID, run)
+
+	// Delete removed non-admin members. The sync is audited within the same transaction.
+	log.Printf("Deleting removed members for Authman %s...\n", authmanLabel)
+	var deleteCount int64
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		deleteCount, err = app.storage.DeleteUnsyncedGroupMemberships(context, clientID, authmanGroup.ID, syncID)
+		if err != nil {
+			return fmt.Errorf("deleting removed memberships: %s", err)
+		}
+
+		err = app.storage.UpdateGroupStats(context, clientID, authmanGroup.ID, false, false, true, true)
+		if err != nil {
+			return fmt.Errorf("updating group stats: %s", err)
+		}
+
+		return app.recordAuditLog(context, clientID, nil, authmanGroup.ID, model.AuditActionAuthmanSync, "group", authmanGroup.ID,
+			map[string]model.AuditChange{
+				"sync_id":        {New: syncID},
+				"authman_groups": {New: authmanKeys},
+				"synced_count":   {New: len(authmanExternalIDs)},
+				"removed_count":  {New: deleteCount},
+			})
+	})
+	if err != nil {
+		log.Printf("Error deleting removed memberships in Authman %s - %s\n", authmanLabel, err)
+		run.AddError(err)
+	} else {
+		log.Printf("%d memberships removed from Authman %s\n", deleteCount, authmanLabel)
+		run.Removed += deleteCount
+	}
+
+	return nil
+}
+
+// syncAuthmanGroupMemberChanges applies the incremental Authman member changes to the group memberships
+func (app *Application) syncAuthmanGroupMemberChanges(clientID string, authmanGroup *model.Group, changes *model.AuthmanMemberChanges, run *model.AuthmanSyncRun) error {
+	authmanKeys := authmanGroup.GetAuthmanGroupKeys()
+	authmanLabel := strings.Join(authmanKeys, ",")
+	log.Printf("Processing %d added and %d removed members for Authman %s...\n", len(changes.Added), len(changes.Removed), authmanLabel)
+
+	adminExternalIDsMap, err := app.findAuthmanGroupAdminExternalIDs(clientID, authmanGroup)
+	if err != nil {
+		return err
+	}
+
+	added, err := app.filterBannedExternalIDs(clientID, authmanGroup.ID, changes.Added)
+	if err != nil {
+		return err
+	}
+
+	app.saveAuthmanGroupMemberships(clientID, authmanGroup, added, adminExternalIDsMap, nil, run)
+
+	var deleteCount int64
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		deleteCount, err = app.storage.DeleteGroupMembershipsByExternalIDs(context, clientID, authmanGroup.ID, changes.Removed)
+		if err != nil {
+			return fmt.Errorf("error deleting removed memberships: %s", err)
+		}
+
+		err = app.storage.UpdateGroupStats(context, clientID, authmanGroup.ID, false, false, true, true)
+		if err != nil {
+			return fmt.Errorf("error updating group stats: %s", err)
+		}
+
+		return app.recordAuditLog(context, clientID, nil, authmanGroup.ID, model.AuditActionAuthmanSync, "group", authmanGroup.ID,
+			map[string]model.AuditChange{
+				"mode":           {New: model.AuthmanSyncModeDelta},
+				"authman_groups": {New: authmanKeys},
+				"added_count":    {New: len(added)},
+				"removed_count":  {New: deleteCount},
+			})
+	})
+	if err != nil {
+		return err
+	}
+	run.Removed += deleteCount
+
+	return nil
+}
+
+// findAuthmanGroupAdminExternalIDs gives the external IDs of the group admins. The admins keep their status when they are synchronized as Authman members.
+func (app *Application) findAuthmanGroupAdminExternalIDs(clientID string, authmanGroup *model.Group) (map[string]bool, error) {
 	adminExternalIDsMap := map[string]bool{}
 	adminMembers, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
 		GroupIDs: []string{authmanGroup.ID},
 		Statuses: []string{"admin"},
 	})
 	if err != nil {
-		return fmt.Errorf("error finding admin memberships in authman %s: %s", *authmanGroup.AuthmanGroup, err)
+		return nil, fmt.Errorf("error finding admin memberships in authman %s: %s", strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","), err)
 	}
 
 	for _, adminMember := range adminMembers.Items {
 		if len(adminMember.ExternalID) > 0 {
-			allExternalIDs = append(allExternalIDs, adminMember.ExternalID)
 			adminExternalIDsMap[adminMember.ExternalID] = true
 		}
 	}
+	return adminExternalIDsMap, nil
+}
 
+// saveAuthmanGroupMemberships creates or updates the memberships of the Authman members in batches. The syncID marks the memberships of a full sync, it is nil for the incremental sync.
+func (app *Application) saveAuthmanGroupMemberships(clientID string, authmanGroup *model.Group, authmanExternalIDs []string, adminExternalIDsMap map[string]bool,
+	syncID *string, run *model.AuthmanSyncRun) {
 	step := 0
 	updateExternalIDs := []string{}
 	updateOperations := []storage.SingleMembershipOperation{}
@@ -389,7 +633,7 @@ func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGrou
 		authmanUsersMapping := map[string]model.AuthmanSubject{}
 		authmanMembers, err := app.authman.RetrieveAuthmanUsers(externalIDs)
 		if err != nil {
-			log.Printf("Error on bulk loading %d Authman users %s: %s\n", len(externalIDs), *authmanGroup.AuthmanGroup, err)
+			log.Printf("Error on bulk loading %d Authman users %s: %s\n", len(externalIDs), strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","), err)
 		}
 		for _, authmanauthmanMember := range authmanMembers {
 			authmanUsersMapping[authmanauthmanMember.ID] = authmanauthmanMember
@@ -398,9 +642,9 @@ func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGrou
 		localUsersMapping := map[string]model.CoreAccount{}
 		localUsers, err := app.corebb.GetAllCoreAccountsWithExternalIDs(externalIDs, nil, nil)
 		if err != nil {
-			log.Printf("Error on bulk loading %d core accounts in Authman %s: %s\n", len(externalIDs), *authmanGroup.AuthmanGroup, err)
+			log.Printf("Error on bulk loading %d core accounts in Authman %s: %s\n", len(externalIDs), strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","), err)
 		} else {
-			log.Printf("Bulk load %d external IDs -> Loaded %d accounts in Authman %s: %s\n", len(externalIDs), len(localUsers), *authmanGroup.AuthmanGroup, err)
+			log.Printf("Bulk load %d external IDs -> Loaded %d accounts in Authman %s: %s\n", len(externalIDs), len(localUsers), strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","), err)
 		}
 
 		if len(localUsers) > 0 {
@@ -434,16 +678,19 @@ func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGrou
 			}
 		}
 
-		err = app.storage.BulkUpdateGroupMembershipsByExternalID(clientID, authmanGroup.ID, updateOperations, false)
+		added, err := app.storage.BulkUpdateGroupMembershipsByExternalID(clientID, authmanGroup.ID, updateOperations, false)
 		if err != nil {
-			log.Printf("Error on bulk saving step: %d, items: %d memberships, core accounts: %d in Authman %s: %s\n", step, len(updateOperations), len(localUsers), *authmanGroup.AuthmanGroup, err)
+			log.Printf("Error on bulk saving step: %d, items: %d memberships, core accounts: %d in Authman %s: %s\n", step, len(updateOperations), len(localUsers), strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","), err)
+			run.Failed += int64(len(updateOperations))
+			run.AddError(fmt.Errorf("bulk saving step %d: %s", step, err))
 		} else {
-			log.Printf("Successful bulk saving step: %d, items: %d memberships, core accounts: %d in Authman '%s'", step, len(updateOperations), len(localUsers), *authmanGroup.AuthmanGroup)
+			run.Added += int64(len(added))
+			app.welcomeMembershipIDs(clientID, authmanGroup, added)
+			log.Printf("Successful bulk saving step: %d, items: %d memberships, core accounts: %d in Authman '%s'", step, len(updateOperations), len(localUsers), strings.Join(authmanGroup.GetAuthmanGroupKeys(), ","))
 		}
 		step++
 	}
 
-	log.Printf("Processing %d current members for Authman %s...\n", len(authmanExternalIDs), *authmanGroup.AuthmanGroup)
 	for _, externalID := range authmanExternalIDs {
 
 		status := "member"
@@ -462,7 +709,7 @@ func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGrou
 			Status:     &status,
 			Email:      email,
 			Name:       name,
-			SyncID:     &syncID,
+			SyncID:     syncID,
 			Answers:    authmanGroup.CreateMembershipEmptyAnswers(),
 		})
 
@@ -476,20 +723,49 @@ func (app *Application) syncAuthmanGroupMemberships(clientID string, authmanGrou
 	if len(updateOperations) > 0 {
 		batchUpdate(updateExternalIDs, updateOperations)
 	}
+}
 
-	// Delete removed non-admin members
-	log.Printf("Deleting removed members for Authman %s...\n", *authmanGroup.AuthmanGroup)
-	deleteCount, err := app.storage.DeleteUnsyncedGroupMemberships(clientID, authmanGroup.ID, syncID)
+// startAuthmanSyncRun stores the record of a new Authman sync run. The group is nil for the global sync.
+func (app *Application) startAuthmanSyncRun(clientID string, runType string, group *model.Group) *model.AuthmanSyncRun {
+	run := &model.AuthmanSyncRun{ID: uuid.NewString(), ClientID: clientID, Type: runType,
+		Status: model.AuthmanSyncRunStatusRunning, DateStarted: time.Now()}
+	if group != nil {
+		run.GroupID = group.ID
+		run.AuthmanGroup = strings.Join(group.GetAuthmanGroupKeys(), ",")
+	}
+
+	err := app.storage.InsertAuthmanSyncRun(nil, *run)
 	if err != nil {
-		log.Printf("Error deleting removed memberships in Authman %s\n", *authmanGroup.AuthmanGroup)
-	} else {
-		log.Printf("%d memberships removed from Authman %s\n", deleteCount, *authmanGroup.AuthmanGroup)
+		log.Printf("error storing Authman sync run %s for clientID %s - %s", run.ID, clientID, err)
 	}
+	return run
+}
 
-	err = app.storage.UpdateGroupStats(nil, clientID, authmanGroup.ID, false, false, true, true)
+// finishAuthmanSyncRun stores the counts, the errors and the final status of the run
+func (app *Application) finishAuthmanSyncRun(run *model.AuthmanSyncRun) {
+	run.Finish()
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.UpdateAuthmanSyncRun(context, *run)
+		if err != nil {
+			return err
+		}
+
+		return app.recordDomainEvent(context, run.ClientID, model.DomainEventAuthmanSyncCompleted, run.GroupID, map[string]interface{}{
+			"run_id":   run.ID,
+			"type":     run.Type,
+			"group_id": run.GroupID,
+			"mode":     run.Mode,
+			"status":   run.Status,
+			"added":    run.Added,
+			"removed":  run.Removed,
+			"failed":   run.Failed,
+		})
+	})
 	if err != nil {
-		log.Printf("Error updating group stats for '%s' - %s", *authmanGroup.AuthmanGroup, err)
+		log.Printf("error updating Authman sync run %s for clientID %s - %s", run.ID, run.ClientID, err)
 	}
+}
 
-	return nil
+func (app *Application) getAuthmanSyncRuns(clientID string, filter model.AuthmanSyncRunFilter) ([]model.AuthmanSyncRun, error) {
+	return app.storage.FindAuthmanSyncRuns(nil, clientID, filter)
 }
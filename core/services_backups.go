@@ -0,0 +1,266 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"log"
+	"strings"
+	"time"
+)
+
+// backupFullInterval is the max age of the last full backup of a collection, the incremental backups are made in between
+const backupFullInterval = 7 * 24 * time.Hour
+
+// backupPageSize is the number of documents loaded per storage request
+const backupPageSize = 1000
+
+// backupPartSize is the number of documents stored per backup object
+const backupPartSize = 10000
+
+// backupKeyTimeFormat is sortable, so the backups of a collection are listed in the order they were made
+const backupKeyTimeFormat = "20060102T150405Z"
+
+func (app *Application) startBackupTask() {
+	if app.objectStorage == nil {
+		log.Printf("object storage is not configured, the backup task is not scheduled")
+		return
+	}
+
+	_, err := app.scheduler.AddFunc("0 4 * * *", func() {
+		_, err := app.processBackups()
+		if err != nil {
+			log.Printf("error processing backups: %s", err)
+		}
+	})
+	if err != nil {
+		log.Printf("error on running backup task: %s", err)
+	}
+	log.Printf("successful running of backup task")
+}
+
+// processBackups exports the backup collections to the object storage. A collection gets a full backup if it has none or the last one is
+// older than backupFullInterval, otherwise it gets an incremental backup of the documents created or updated since its previous backup.
+// The deleted documents are not tracked by the incremental backups, they are dropped from the restored data on the next full backup only.
+func (app *Application) processBackups() ([]model.BackupResult, error) {
+	if app.objectStorage == nil {
+		return nil, errors.New("object storage is not configured")
+	}
+
+	acquired, releaseLock, err := app.acquireLock("backup", backupLockLease)
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring the backup lock: %s", err)
+	}
+	if !acquired {
+		log.Println("the backup is running on another instance")
+		return nil, nil
+	}
+	defer releaseLock()
+
+	results := make([]model.BackupResult, 0, len(model.BackupCollections))
+	for _, collection := range model.BackupCollections {
+		result := app.backupCollection(collection, time.Now().UTC())
+		if result.Error != nil {
+			log.Printf("error backing up %s: %s", collection, *result.Error)
+		} else {
+			log.Printf("%s backup of %s stored to %s - %d documents in %d parts", result.Mode, collection, result.Key, result.Documents, result.Parts)
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// backupCollection exports the collection documents up to the provided time. The backup times move forward only if all parts are stored,
+// so a failed backup is repeated by the next one.
+func (app *Application) backupCollection(collection string, now time.Time) model.BackupResult {
+	result := model.BackupResult{Collection: collection, Mode: model.BackupModeFull, DateTo: now}
+	fail := func(err error) model.BackupResult {
+		errStr := err.Error()
+		result.Error = &errStr
+		return result
+	}
+
+	times, err := app.storage.FindSyncTimes(nil, "", "backup_"+collection, false)
+	if err != nil {
+		return fail(fmt.Errorf("error loading the backup times: %s", err))
+	}
+	fullTimes, err := app.storage.FindSyncTimes(nil, "", "backup_full_"+collection, false)
+	if err != nil {
+		return fail(fmt.Errorf("error loading the full backup times: %s", err))
+	}
+	if times != nil && times.EndTime != nil && fullTimes != nil && fullTimes.EndTime != nil && now.Sub(*fullTimes.EndTime) < backupFullInterval {
+		result.Mode = model.BackupModeIncremental
+		result.DateFrom = times.EndTime
+	}
+	result.Key = fmt.Sprintf("%s/%s/%s-%s", app.backupPrefix(), collection, now.Format(backupKeyTimeFormat), result.Mode)
+
+	var part bytes.Buffer
+	partDocuments := 0
+	storePart := func() error {
+		key := fmt.Sprintf("%s/part-%05d.jsonl", result.Key, result.Parts+1)
+		err := app.objectStorage.PutObject(key, part.Bytes(), "application/x-ndjson")
+		if err != nil {
+			return err
+		}
+		result.Parts++
+		part.Reset()
+		partDocuments = 0
+		return nil
+	}
+
+	afterID := ""
+	for {
+		documents, lastID, err := app.storage.ExportDocuments(collection, result.DateFrom, now, afterID, backupPageSize)
+		if err != nil {
+			return fail(fmt.Errorf("error exporting documents: %s", err))
+		}
+		for _, document := range documents {
+			part.Write(document)
+			part.WriteByte('\n')
+			partDocuments++
+			result.Documents++
+			if partDocuments >= backupPartSize {
+				err = storePart()
+				if err != nil {
+					return fail(fmt.Errorf("error storing backup part: %s", err))
+				}
+			}
+		}
+		if len(documents) < backupPageSize {
+			break
+		}
+		afterID = lastID
+	}
+	// the empty backups are stored too, so every backup has at least one part
+	if partDocuments > 0 || result.Parts == 0 {
+		err = storePart()
+		if err != nil {
+			return fail(fmt.Errorf("error storing backup part: %s", err))
+		}
+	}
+
+	err = app.storage.SaveSyncTimes(nil, model.SyncTimes{Key: "backup_" + collection, StartTime: result.DateFrom, EndTime: &now})
+	if err != nil {
+		return fail(fmt.Errorf("error saving the backup times: %s", err))
+	}
+	if result.Mode == model.BackupModeFull {
+		err = app.storage.SaveSyncTimes(nil, model.SyncTimes{Key: "backup_full_" + collection, EndTime: &now})
+		if err != nil {
+			return fail(fmt.Errorf("error saving the full backup times: %s", err))
+		}
+	}
+	return result
+}
+
+// restoreBackup restores the collection from the last full backup made up to the provided time and the incremental backups made after it.
+// The documents are created or replaced by id, the documents missing in the backups are kept. The latest backups are restored if until is nil.
+func (app *Application) restoreBackup(collection string, until *time.Time, dryRun bool) (*model.BackupRestoreResult, error) {
+	if app.objectStorage == nil {
+		return nil, errors.New("object storage is not configured")
+	}
+	if !isBackupCollection(collection) {
+		return nil, fmt.Errorf("collection %s is not backed up", collection)
+	}
+
+	prefix := fmt.Sprintf("%s/%s/", app.backupPrefix(), collection)
+	keys, err := app.objectStorage.ListObjects(prefix)
+	if err != nil {
+		return nil, fmt.Errorf("error listing the backups: %s", err)
+	}
+
+	// the keys are <prefix>/<time>-<mode>/part-<n>.jsonl and they are sorted, so are the backups
+	var backups []string
+	parts := map[string][]string{}
+	for _, key := range keys {
+		index := strings.LastIndex(key, "/")
+		if index <= len(prefix) {
+			continue
+		}
+		backup := key[len(prefix):index]
+		if len(backup) < len(backupKeyTimeFormat) {
+			continue
+		}
+		if until != nil && backup[:len(backupKeyTimeFormat)] > until.UTC().Format(backupKeyTimeFormat) {
+			continue
+		}
+		if len(parts[backup]) == 0 {
+			backups = append(backups, backup)
+		}
+		parts[backup] = append(parts[backup], key)
+	}
+
+	start := -1
+	for i, backup := range backups {
+		if strings.HasSuffix(backup, "-"+model.BackupModeFull) {
+			start = i
+		}
+	}
+	if start < 0 {
+		return nil, fmt.Errorf("no full backup of %s found", collection)
+	}
+
+	result := &model.BackupRestoreResult{Collection: collection, Backups: backups[start:]}
+	for _, backup := range result.Backups {
+		for _, key := range parts[backup] {
+			data, err := app.objectStorage.GetObject(key)
+			if err != nil {
+				return result, fmt.Errorf("error loading %s: %s", key, err)
+			}
+
+			var documents [][]byte
+			for _, line := range bytes.Split(data, []byte{'\n'}) {
+				if len(bytes.TrimSpace(line)) > 0 {
+					documents = append(documents, line)
+				}
+			}
+			if dryRun {
+				result.Documents += int64(len(documents))
+				continue
+			}
+
+			for i := 0; i < len(documents); i += backupPageSize {
+				end := i + backupPageSize
+				if end > len(documents) {
+					end = len(documents)
+				}
+				count, err := app.storage.ImportDocuments(collection, documents[i:end])
+				if err != nil {
+					return result, fmt.Errorf("error restoring %s: %s", key, err)
+				}
+				result.Documents += count
+			}
+		}
+	}
+	return result, nil
+}
+
+func (app *Application) backupPrefix() string {
+	if app.config != nil && app.config.BackupPrefix != "" {
+		return app.config.BackupPrefix
+	}
+	return "groups-backups"
+}
+
+func isBackupCollection(collection string) bool {
+	for _, item := range model.BackupCollections {
+		if item == collection {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+const (
+	// calendarFeedHistory is how long the past events stay in the calendar feed
+	calendarFeedHistory = 90 * 24 * time.Hour
+	// calendarFeedMaxOverlap is the max period the previous calendar feed token is still valid after a rotation
+	calendarFeedMaxOverlap = 7 * 24 * time.Hour
+)
+
+// createCalendarFeedLink signs the calendar feed token of the current member of the group
+func (app *Application) createCalendarFeedLink(clientID string, current *model.User, groupID string) (*model.CalendarFeedLink, error) {
+	if len(app.config.CalendarFeedTokenSecret) == 0 {
+		return nil, errors.New("the calendar feeds are not configured")
+	}
+	membership, err := app.storage.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, errors.New("the user is not a member of the group")
+	}
+	return app.calendarFeedLink(clientID, current.ID, groupID, membership.CalendarFeedVersion), nil
+}
+
+// rotateCalendarFeedLink issues a new calendar feed token of the current member of the group. The previous token is still valid until
+// the overlap ends, so the subscribed calendars could be switched. Zero overlap revokes the previous token at once.
+func (app *Application) rotateCalendarFeedLink(clientID string, current *model.User, group *model.Group, overlap time.Duration) (*model.CalendarFeedLink, error) {
+	if len(app.config.CalendarFeedTokenSecret) == 0 {
+		return nil, errors.New("the calendar feeds are not configured")
+	}
+	if overlap < 0 || overlap > calendarFeedMaxOverlap {
+		return nil, fmt.Errorf("the overlap must be between 0 and %s", calendarFeedMaxOverlap)
+	}
+
+	membership, err := app.storage.FindGroupMembership(clientID, group.ID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil {
+		return nil, errors.New("the user is not a member of the group")
+	}
+
+	version := membership.CalendarFeedVersion + 1
+	var previousVersionEnds *time.Time
+	if overlap > 0 {
+		ends := time.Now().UTC().Add(overlap)
+		previousVersionEnds = &ends
+	}
+
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		updated, err := app.storage.UpdateMembershipCalendarFeedVersion(context, clientID, membership.ID, membership.CalendarFeedVersion, version, previousVersionEnds)
+		if err != nil {
+			return err
+		}
+		if !updated {
+			return errors.New("the calendar feed token has been rotated concurrently")
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionCalendarFeedRotated, "membership", membership.ID,
+			map[string]model.AuditChange{
+				"calendar_feed_version":                    {Old: membership.CalendarFeedVersion, New: version},
+				"date_previous_calendar_feed_version_ends": {Old: membership.DatePreviousCalendarFeedVersionEnds, New: previousVersionEnds},
+			})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	go app.notifyAdminsCalendarFeedRotated(clientID, current, group)
+
+	return app.calendarFeedLink(clientID, current.ID, group.ID, version), nil
+}
+
+// calendarFeedLink signs the calendar feed token of the version
+func (app *Application) calendarFeedLink(clientID string, userID string, groupID string, version int) *model.CalendarFeedLink {
+	token := model.CalendarFeedToken{ClientID: clientID, UserID: userID, GroupID: groupID, Version: version}.Sign(app.config.CalendarFeedTokenSecret)
+	return &model.CalendarFeedLink{Token: token, Path: fmt.Sprintf("/api/group/%s/events/ical?token=%s", groupID, token)}
+}
+
+// notifyAdminsCalendarFeedRotated notifies the group admins about the rotated calendar feed token of the member, so a leaked token
+// could be followed up
+func (app *Application) notifyAdminsCalendarFeedRotated(clientID string, current *model.User, group *model.Group) {
+	result, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		Statuses: []string{"admin"},
+	})
+	if err != nil {
+		log.Printf("error app.notifyAdminsCalendarFeedRotated() - unable to find the admins of group %s: %s", group.ID, err)
+		return
+	}
+	recipients := result.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		return member.UserID != current.ID,
+			member.NotificationsPreferences.OverridePreferences &&
+				(member.NotificationsPreferences.EventsMuted || member.NotificationsPreferences.AllMute)
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	topic := "group.events"
+	err = app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		fmt.Sprintf("%s rotated the calendar feed link of the group events", current.Name),
+		map[string]string{
+			"type":        "group",
+			"operation":   "calendar_feed_rotated",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error app.notifyAdminsCalendarFeedRotated() - unable to notify the admins of group %s: %s", group.ID, err)
+	}
+}
+
+// getGroupCalendarFeed gives the events of the group visible to the member of the token. It gives nil if the token is not valid for the group
+// or the user is no longer a member of the group.
+func (app *Application) getGroupCalendarFeed(groupID string, token string) (*model.CalendarFeed, error) {
+	if len(app.config.CalendarFeedTokenSecret) == 0 {
+		log.Println("app.getGroupCalendarFeed() - the calendar feeds are not configured")
+		return nil, nil
+	}
+	payload, err := model.ParseCalendarFeedToken(token, app.config.CalendarFeedTokenSecret)
+	if err != nil {
+		log.Printf("app.getGroupCalendarFeed() - %s", err)
+		return nil, nil
+	}
+	if payload.GroupID != groupID {
+		return nil, nil
+	}
+
+	membership, err := app.storage.FindGroupMembership(payload.ClientID, groupID, payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil || !membership.IsAdminOrMember() || !membership.AcceptsCalendarFeedVersion(payload.Version, time.Now()) {
+		return nil, nil
+	}
+	group, err := app.storage.FindGroup(nil, payload.ClientID, groupID, &payload.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, nil
+	}
+
+	// the feed is requested without login, so the member is identified by the token only
+	current := &model.User{ID: payload.UserID, AppID: app.config.AppID, OrgID: app.config.OrgID}
+	published := true
+	startTimeAfter := time.Now().Add(-calendarFeedHistory).Unix()
+	eventsResponse, err := app.getGroupCalendarEvents(payload.ClientID, current, groupID, &published, model.GroupEventFilter{StartTimeAfter: &startTimeAfter})
+	if err != nil {
+		return nil, err
+	}
+
+	feed := model.CalendarFeed{GroupID: group.ID, Title: group.Title, Events: []model.CalendarFeedEvent{}}
+	if events, ok := eventsResponse["events"].([]interface{}); ok {
+		for _, entry := range events {
+			event, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			if feedEvent := model.NewCalendarFeedEvent(event); feedEvent != nil {
+				feed.Events = append(feed.Events, *feedEvent)
+			}
+		}
+	}
+	return &feed, nil
+}
@@ -0,0 +1,39 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+)
+
+func (app *Application) getCategoryVisibilityConfig(clientID string) (*model.CategoryVisibilityConfig, error) {
+	return app.storage.FindCategoryVisibilityConfig(nil, clientID)
+}
+
+func (app *Application) updateCategoryVisibilityConfig(config model.CategoryVisibilityConfig) error {
+	return app.storage.SaveCategoryVisibilityConfig(nil, config)
+}
+
+// applyCategoryVisibility restricts the categories of the groups filter by the category visibility config of the client.
+// The error is returned instead of listing the groups unrestricted.
+func (app *Application) applyCategoryVisibility(clientID string, filter *model.GroupsFilter) error {
+	config, err := app.storage.FindCategoryVisibilityConfig(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the category visibility config for %s: %s", clientID, err)
+	}
+	config.ApplyToFilter(filter)
+	return nil
+}
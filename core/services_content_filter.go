@@ -0,0 +1,72 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+	"strings"
+)
+
+// contentFilterReporter is the reporter of the abuse reports created for the posts flagged by the content filter
+var contentFilterReporter = &model.User{ID: "content_filter", Name: "Content filter"}
+
+func (app *Application) getContentFilterConfig(clientID string) (*model.ContentFilterConfig, error) {
+	return app.storage.FindContentFilterConfig(nil, clientID)
+}
+
+func (app *Application) updateContentFilterConfig(config model.ContentFilterConfig) error {
+	return app.storage.SaveContentFilterConfig(nil, config)
+}
+
+// filterPostContent checks the post against the content filter of the client and sets the content flags of the post. It gives
+// model.ContentFilterError when the post is rejected and true when the post has to be flagged for a review once it is saved.
+func (app *Application) filterPostContent(clientID string, post *model.Post) (bool, error) {
+	post.ContentFlags = nil
+
+	config, err := app.storage.FindContentFilterConfig(nil, clientID)
+	if err != nil {
+		log.Printf("app.filterPostContent() error loading the content filter config for %s: %s", clientID, err)
+		return false, nil
+	}
+	if config == nil || !config.Enabled {
+		return false, nil
+	}
+
+	flags := config.MatchWords(post)
+	// the posts are still checked against the blocked words when the external moderation API fails
+	if config.ExternalModeration && app.moderation != nil {
+		categories, err := app.moderation.CheckContent(post.Subject + "\n" + post.Body)
+		if err != nil {
+			log.Printf("app.filterPostContent() error checking the content of post %s with the moderation API: %s", post.ID, err)
+		} else {
+			flags = append(flags, categories...)
+		}
+	}
+	if len(flags) == 0 {
+		return false, nil
+	}
+
+	if config.Action == model.ContentFilterActionReject {
+		return false, &model.ContentFilterError{Flags: flags}
+	}
+	post.ContentFlags = flags
+	return config.Action == model.ContentFilterActionFlag, nil
+}
+
+// flagPostContent reports the post flagged by the content filter to the group admins and the moderators
+func (app *Application) flagPostContent(clientID string, group *model.Group, post *model.Post) {
+	app.createAbuseReport(clientID, contentFilterReporter, group, post, "content filter: "+strings.Join(post.ContentFlags, ", "), false, true)
+}
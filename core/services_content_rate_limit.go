@@ -0,0 +1,82 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+func (app *Application) getContentRateLimitsConfig(clientID string) (*model.ContentRateLimitsConfig, error) {
+	return app.storage.FindContentRateLimitsConfig(nil, clientID)
+}
+
+func (app *Application) updateContentRateLimitsConfig(config model.ContentRateLimitsConfig) error {
+	return app.storage.SaveContentRateLimitsConfig(nil, config)
+}
+
+// checkContentRateLimit gives model.ContentRateLimitError when the user exceeds the limit of the action within the group.
+// The storage errors are only logged as the limiting must never prevent the legitimate content.
+func (app *Application) checkContentRateLimit(clientID string, current *model.User, groupID string, action string) error {
+	config, err := app.storage.FindContentRateLimitsConfig(nil, clientID)
+	if err != nil {
+		log.Printf("app.checkContentRateLimit() error loading the content rate limits config for %s: %s", clientID, err)
+		return nil
+	}
+	limit := config.GetLimit(action)
+	if limit == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	since := now.Add(-limit.Window())
+	count, err := app.storage.CountContentEvents(nil, clientID, current.ID, groupID, action, since)
+	if err != nil {
+		log.Printf("app.checkContentRateLimit() error counting %s events for %s: %s", action, current.ID, err)
+		return nil
+	}
+	if count < int64(limit.Max) {
+		return nil
+	}
+
+	retryAfter := limit.Window()
+	oldest, err := app.storage.FindOldestContentEvent(nil, clientID, current.ID, groupID, action, since)
+	if err != nil {
+		log.Printf("app.checkContentRateLimit() error finding the oldest %s event for %s: %s", action, current.ID, err)
+	} else if oldest != nil {
+		retryAfter = oldest.DateCreated.Add(limit.Window()).Sub(now)
+	}
+	return &model.ContentRateLimitError{Action: action, Limit: limit.Max, RetryAfter: retryAfter}
+}
+
+// recordContentEvent stores the content creation for the rate limiting. Failures are only logged.
+func (app *Application) recordContentEvent(clientID string, current *model.User, groupID string, action string) {
+	event := model.ContentEvent{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		UserID:      current.ID,
+		GroupID:     groupID,
+		Action:      action,
+		DateCreated: time.Now().UTC(),
+	}
+
+	err := app.storage.InsertContentEvent(nil, event)
+	if err != nil {
+		log.Printf("app.recordContentEvent() error storing %s event for %s: %s", action, current.ID, err)
+	}
+}
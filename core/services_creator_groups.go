@@ -0,0 +1,98 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"groups/utils"
+)
+
+// cleanupCreatorGroups archives or deletes the groups created by the account, i.e. when the account is found to be abusive. The dry run
+// gives the matched groups without any change. The excluded groups are kept as they are.
+func (app *Application) cleanupCreatorGroups(clientID string, current *model.User, creatorID string, action string, dryRun bool,
+	excludeGroupIDs []string, cancelEvents bool) (*model.CreatorGroupsCleanupResult, error) {
+	limit := int64(model.MaxCreatorGroupsCleanup + 1)
+	groups, err := app.storage.FindGroups(clientID, nil, model.GroupsFilter{CreatorID: &creatorID, Limit: &limit})
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) > model.MaxCreatorGroupsCleanup {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("the account has created more than %d groups, exclude some of them", model.MaxCreatorGroupsCleanup))
+	}
+
+	excluded := make(map[string]bool, len(excludeGroupIDs))
+	for _, groupID := range excludeGroupIDs {
+		excluded[groupID] = true
+	}
+
+	result := model.CreatorGroupsCleanupResult{CreatorID: creatorID, Action: action, DryRun: dryRun, Groups: []model.CreatorGroupsCleanupItem{}}
+	for _, group := range groups {
+		if excluded[group.ID] {
+			continue
+		}
+
+		item := model.CreatorGroupsCleanupItem{GroupID: group.ID, Title: group.Title, Status: model.CreatorGroupsCleanupStatusMatched}
+		if group.AuthmanEnabled && !current.HasPermission("managed_group_admin") {
+			// same rule as for the single group deletion
+			item.Status = model.CreatorGroupsCleanupStatusSkipped
+			item.Error = "managed group, the managed_group_admin permission is required"
+		} else if !dryRun {
+			app.cleanupCreatorGroup(clientID, current, group, action, cancelEvents, &item)
+		}
+		result.Groups = append(result.Groups, item)
+	}
+	return &result, nil
+}
+
+func (app *Application) cleanupCreatorGroup(clientID string, current *model.User, group model.Group, action string, cancelEvents bool,
+	item *model.CreatorGroupsCleanupItem) {
+	if action == model.CreatorGroupsCleanupActionDelete {
+		err := app.deleteGroup(clientID, current, group.ID, cancelEvents)
+		if err != nil {
+			item.Status = model.CreatorGroupsCleanupStatusFailed
+			item.Error = err.Error()
+			return
+		}
+		item.Status = model.CreatorGroupsCleanupStatusDeleted
+		return
+	}
+
+	archived := false
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		archived, err = app.storage.ArchiveGroup(context, clientID, group.ID)
+		if err != nil || !archived {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupUpdated, "group", group.ID,
+			map[string]model.AuditChange{
+				"hidden_for_search":             {Old: group.HiddenForSearch, New: true},
+				"block_new_membership_requests": {Old: group.BlockNewMembershipRequests, New: true},
+			})
+	})
+	if err != nil {
+		item.Status = model.CreatorGroupsCleanupStatusFailed
+		item.Error = err.Error()
+		return
+	}
+	if !archived {
+		item.Status = model.CreatorGroupsCleanupStatusSkipped
+		return
+	}
+	item.Status = model.CreatorGroupsCleanupStatusArchived
+}
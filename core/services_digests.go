@@ -0,0 +1,202 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+	"strings"
+	"time"
+)
+
+func (app *Application) startDigestTask() {
+	_, err := app.scheduler.AddFunc("0 16 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.processDigests(clientID)
+			if err != nil {
+				log.Printf("error processing digests for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running digest task: %s", err)
+	}
+	log.Printf("successful running of digest task")
+}
+
+func (app *Application) updateMembershipDigest(clientID string, membershipID string, frequency string, email bool) error {
+	if len(frequency) > 0 && !model.IsDigestFrequency(frequency) {
+		return fmt.Errorf("invalid digest frequency %s", frequency)
+	}
+	return app.storage.UpdateMembershipDigest(nil, clientID, membershipID, frequency, email)
+}
+
+// processDigests sends a single summary of the new posts and events of all the groups in the digest mode to every member whose
+// digest is due. The last digest date moves even if there is nothing new, so the next digest covers the next period only.
+func (app *Application) processDigests(clientID string) error {
+	acquired, releaseLock, err := app.acquireLock("digests_"+clientID, digestLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the digest lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("digests for clientID %s are sent by another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	memberships, err := app.storage.FindDigestMemberships(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the digest memberships: %s", err)
+	}
+
+	now := time.Now().UTC()
+	dueMemberships := map[string][]model.GroupMembership{}
+	groupIDs := []string{}
+	seenGroupIDs := map[string]bool{}
+	for _, membership := range memberships {
+		if !membership.IsDigestDue(now) {
+			continue
+		}
+		dueMemberships[membership.UserID] = append(dueMemberships[membership.UserID], membership)
+		if !seenGroupIDs[membership.GroupID] {
+			seenGroupIDs[membership.GroupID] = true
+			groupIDs = append(groupIDs, membership.GroupID)
+		}
+	}
+	if len(dueMemberships) == 0 {
+		return nil
+	}
+
+	log.Printf("processDigests: BEGIN for clientID %s - %d users", clientID, len(dueMemberships))
+
+	groups, err := app.storage.FindGroupsByGroupIDs(groupIDs)
+	if err != nil {
+		return fmt.Errorf("error loading the digest groups: %s", err)
+	}
+	groupTitles := make(map[string]string, len(groups))
+	for _, group := range groups {
+		groupTitles[group.ID] = group.Title
+	}
+
+	var sentCount int
+	for userID, userMemberships := range dueMemberships {
+		digests := []model.GroupDigest{}
+		email := ""
+		membershipIDs := []string{}
+		for _, membership := range userMemberships {
+			title, ok := groupTitles[membership.GroupID]
+			if !ok {
+				continue // the group has been deleted
+			}
+			digest, err := app.buildGroupDigest(clientID, membership, title, now)
+			if err != nil {
+				log.Printf("processDigests: error building the digest of group %s for user %s: %s", membership.GroupID, userID, err)
+				continue
+			}
+			membershipIDs = append(membershipIDs, membership.ID)
+			if !digest.IsEmpty() {
+				digests = append(digests, *digest)
+			}
+			if membership.DigestEmail && len(membership.Email) > 0 {
+				email = membership.Email
+			}
+		}
+
+		if len(digests) > 0 {
+			if !app.sendDigest(userID, email, digests) {
+				continue // retried by the next run
+			}
+			sentCount++
+		}
+
+		if len(membershipIDs) > 0 {
+			err = app.storage.UpdateMembershipsLastDigest(nil, membershipIDs, now)
+			if err != nil {
+				log.Printf("processDigests: error updating the last digest date for user %s: %s", userID, err)
+			}
+		}
+	}
+
+	log.Printf("processDigests: END for clientID %s - %d digests sent", clientID, sentCount)
+	return nil
+}
+
+// buildGroupDigest gives the new content of the group since the last digest of the membership
+func (app *Application) buildGroupDigest(clientID string, membership model.GroupMembership, groupTitle string, now time.Time) (*model.GroupDigest, error) {
+	since := membership.DateCreated
+	if membership.DateLastDigest != nil {
+		since = *membership.DateLastDigest
+	}
+
+	posts, err := app.storage.FindDigestPosts(nil, clientID, membership.GroupID, membership.UserID, since, now)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the posts: %s", err)
+	}
+	eventsCount, err := app.storage.CountDigestEvents(nil, clientID, membership.GroupID, membership.UserID, since, now)
+	if err != nil {
+		return nil, fmt.Errorf("error counting the events: %s", err)
+	}
+
+	subjects := []string{}
+	for _, post := range posts {
+		if len(post.Subject) > 0 {
+			subjects = append(subjects, post.Subject)
+		}
+	}
+	return &model.GroupDigest{GroupID: membership.GroupID, GroupTitle: groupTitle, PostsCount: len(posts),
+		PostSubjects: subjects, EventsCount: eventsCount}, nil
+}
+
+// sendDigest sends the digest notification to the user and emails it if requested. It gives false if the notification failed.
+func (app *Application) sendDigest(userID string, email string, digests []model.GroupDigest) bool {
+	summaries := make([]string, len(digests))
+	groupIDs := make([]string, len(digests))
+	for i, digest := range digests {
+		summaries[i] = digest.Summary()
+		groupIDs[i] = digest.GroupID
+	}
+	title := "Your groups digest"
+	text := strings.Join(summaries, "\n")
+
+	topic := "group.digest"
+	err := app.sendNotification(
+		[]notifications.Recipient{{UserID: userID}},
+		&topic,
+		title,
+		text,
+		map[string]string{
+			"type":      "group",
+			"operation": "digest",
+			"group_ids": strings.Join(groupIDs, ","),
+		},
+		app.config.AppID,
+		app.config.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error sending the digest to user %s: %s", userID, err)
+		return false
+	}
+
+	if len(email) > 0 {
+		err = app.notifications.SendMail(email, title, text)
+		if err != nil {
+			log.Printf("error emailing the digest to user %s: %s", userID, err)
+		}
+	}
+	return true
+}
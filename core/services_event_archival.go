@@ -0,0 +1,101 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+)
+
+// handleGroupEventsArchived handles the upcoming events of a deleted group. The events linked only to the group are cancelled in the
+// Calendar BB if requested. The members who are going or may go to the events are notified.
+func (app *Application) handleGroupEventsArchived(clientID string, current *model.User, group *model.Group, events []model.Event, cancelEvents bool) {
+	cancelled := map[string]bool{}
+	if cancelEvents {
+		var eventIDs []string
+		for _, event := range events {
+			eventIDs = append(eventIDs, event.EventID)
+		}
+		// the events linked to other groups are kept for them
+		linked := map[string]bool{}
+		mappings, err := app.storage.FindGroupsEvents(nil, eventIDs)
+		if err != nil {
+			log.Printf("error app.handleGroupEventsArchived() - unable to find the other groups of the events of group %s: %s", group.ID, err)
+			return
+		}
+		for _, mapping := range mappings {
+			linked[mapping.EventID] = true
+		}
+
+		currentAccount := model.AccountIdentifiers{AccountID: &current.ID, ExternalID: &current.NetID}
+		for _, eventID := range eventIDs {
+			if linked[eventID] {
+				continue
+			}
+			err = app.calendar.DeleteCalendarEvent(currentAccount, eventID, current.OrgID, current.AppID)
+			if err != nil {
+				log.Printf("error app.handleGroupEventsArchived() - unable to cancel event %s of group %s: %s", eventID, group.ID, err)
+				continue
+			}
+			cancelled[eventID] = true
+		}
+	}
+
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	topic := "group.events"
+	for _, event := range events {
+		rsvps := event.GetAttendingRSVPs()
+		if len(rsvps) == 0 {
+			continue
+		}
+		recipients := make([]notifications.Recipient, len(rsvps))
+		for i, rsvp := range rsvps {
+			recipients[i] = notifications.Recipient{UserID: rsvp.UserID, Name: rsvp.Name}
+		}
+
+		operation := "event_archived"
+		text := fmt.Sprintf("'%s' has been deleted. An event you responded to is no longer listed in it", group.Title)
+		if cancelled[event.EventID] {
+			operation = "event_cancelled"
+			text = fmt.Sprintf("'%s' has been deleted and an event you responded to has been cancelled", group.Title)
+		}
+
+		err := app.sendNotification(
+			recipients,
+			&topic,
+			fmt.Sprintf("%s - %s", groupStr, group.Title),
+			text,
+			map[string]string{
+				"type":        "group",
+				"operation":   operation,
+				"entity_type": "group",
+				"entity_id":   group.ID,
+				"entity_name": group.Title,
+				"event_id":    event.EventID,
+			},
+			current.AppID,
+			current.OrgID,
+			nil,
+		)
+		if err != nil {
+			log.Printf("error app.handleGroupEventsArchived() - unable to notify the members of event %s of group %s: %s", event.EventID, group.ID, err)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"groups/core/model"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	eventCheckInCodeDefaultTTL = 15 * time.Minute
+	eventCheckInCodeMaxTTL     = 24 * time.Hour
+	eventCheckInCodeLength     = 6
+	// no 0/O and 1/I so that the code can be read out loud
+	eventCheckInCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+// createEventCheckInCode generates a short-lived check-in code for the event. The ttl falls back to the default one and is capped to one day.
+func (app *Application) createEventCheckInCode(clientID string, current *model.User, groupID string, eventID string, ttl time.Duration) (*model.EventCheckInCode, error) {
+	if ttl <= 0 {
+		ttl = eventCheckInCodeDefaultTTL
+	}
+	if ttl > eventCheckInCodeMaxTTL {
+		ttl = eventCheckInCodeMaxTTL
+	}
+
+	code, err := generateEventCheckInCode()
+	if err != nil {
+		return nil, err
+	}
+	tokenBytes := make([]byte, 32)
+	if _, err := rand.Read(tokenBytes); err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	checkInCode := model.EventCheckInCode{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		GroupID:     groupID,
+		EventID:     eventID,
+		Code:        code,
+		Token:       hex.EncodeToString(tokenBytes),
+		CreatorID:   current.ID,
+		DateCreated: now,
+		DateExpires: now.Add(ttl),
+	}
+	err = app.storage.InsertEventCheckInCode(nil, checkInCode)
+	if err != nil {
+		return nil, err
+	}
+	return &checkInCode, nil
+}
+
+// checkInToEvent records the attendance of the member with a check-in code or a QR token. It gives nil if the code is not valid or has expired.
+// Checking in more than once is allowed and keeps the first check-in.
+func (app *Application) checkInToEvent(clientID string, current *model.User, membership *model.GroupMembership, eventID string, code *string, token *string) (*model.EventAttendance, error) {
+	checkInCode, err := app.storage.FindEventCheckInCode(nil, clientID, membership.GroupID, eventID, code, token)
+	if err != nil {
+		return nil, err
+	}
+	if checkInCode == nil || checkInCode.IsExpired() {
+		return nil, nil
+	}
+
+	method := model.EventCheckInMethodCode
+	if code == nil {
+		method = model.EventCheckInMethodQR
+	}
+	attendance := model.EventAttendance{
+		ID:            uuid.NewString(),
+		ClientID:      clientID,
+		GroupID:       membership.GroupID,
+		EventID:       eventID,
+		UserID:        current.ID,
+		MembershipID:  membership.ID,
+		Name:          membership.Name,
+		Email:         membership.Email,
+		Method:        method,
+		DateCheckedIn: time.Now().UTC(),
+	}
+	_, err = app.storage.InsertEventAttendance(attendance)
+	if err != nil {
+		return nil, err
+	}
+	return &attendance, nil
+}
+
+// getGroupAttendanceReport gives the attendance counts of the current members of the group and of the former members who have attended within the date range
+func (app *Application) getGroupAttendanceReport(clientID string, groupID string, startDate *time.Time, endDate *time.Time) (*model.GroupAttendanceReport, error) {
+	attendances, err := app.storage.FindGroupMemberAttendances(nil, clientID, groupID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("error counting attendances for group %s: %s", groupID, err)
+	}
+	eventsCount, err := app.storage.CountGroupAttendedEvents(nil, clientID, groupID, startDate, endDate)
+	if err != nil {
+		return nil, fmt.Errorf("error counting attended events for group %s: %s", groupID, err)
+	}
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{groupID},
+		Statuses: []string{"admin", "member"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error loading members for group %s: %s", groupID, err)
+	}
+
+	attendancesMap := make(map[string]model.MemberAttendance, len(attendances))
+	for _, attendance := range attendances {
+		attendancesMap[attendance.UserID] = attendance
+	}
+
+	members := make([]model.MemberAttendance, 0, len(memberships.Items)+len(attendances))
+	for _, membership := range memberships.Items {
+		member := attendancesMap[membership.UserID]
+		member.UserID = membership.UserID
+		member.Name = membership.Name
+		member.Email = membership.Email
+		member.NetID = membership.NetID
+		member.Status = membership.Status
+		members = append(members, member)
+		delete(attendancesMap, membership.UserID)
+	}
+	for _, attendance := range attendancesMap {
+		members = append(members, attendance)
+	}
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].AttendedCount != members[j].AttendedCount {
+			return members[i].AttendedCount > members[j].AttendedCount
+		}
+		return strings.ToLower(members[i].Name) < strings.ToLower(members[j].Name)
+	})
+
+	return &model.GroupAttendanceReport{
+		GroupID:       groupID,
+		StartDate:     startDate,
+		EndDate:       endDate,
+		EventsCount:   eventsCount,
+		Members:       members,
+		DateGenerated: time.Now().UTC(),
+	}, nil
+}
+
+func generateEventCheckInCode() (string, error) {
+	alphabetSize := big.NewInt(int64(len(eventCheckInCodeAlphabet)))
+	code := make([]byte, eventCheckInCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", err
+		}
+		code[i] = eventCheckInCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
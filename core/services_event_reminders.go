@@ -0,0 +1,91 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+)
+
+// eventReminderLead is how long before the event its scheduled reminder is sent
+const eventReminderLead = 24 * time.Hour
+
+func (app *Application) startEventReminderTask() {
+	_, err := app.scheduler.AddFunc("*/15 * * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.processEventReminders(clientID, time.Now().UTC())
+			if err != nil {
+				log.Printf("error processing event reminders for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running event reminder task: %s", err)
+	}
+	log.Printf("successful running of event reminder task")
+}
+
+// processEventReminders sends the scheduled reminders of the events starting within the reminder lead. The members going to the event get them
+// unless they opted out if the reminders are on for the group, otherwise only the members who opted in get them. Every reminder is claimed before
+// it is sent, so it is sent once even if multiple instances process the reminders.
+func (app *Application) processEventReminders(clientID string, now time.Time) error {
+	events, err := app.storage.FindEventsDueReminder(nil, clientID, now, now.Add(eventReminderLead))
+	if err != nil {
+		return fmt.Errorf("error finding the events due the reminders: %s", err)
+	}
+
+	var remindedCount int
+	groups := map[string]*model.Group{}
+	for i := range events {
+		event := events[i]
+		group, ok := groups[event.GroupID]
+		if !ok {
+			group, err = app.storage.FindGroup(nil, clientID, event.GroupID, nil)
+			if err != nil {
+				log.Printf("processEventReminders: unable to load group %s: %s", event.GroupID, err)
+				continue
+			}
+			groups[event.GroupID] = group
+		}
+		if group == nil {
+			continue
+		}
+
+		claimed, err := app.storage.ClaimEventReminder(nil, clientID, event.GroupID, event.EventID, now)
+		if err != nil {
+			log.Printf("processEventReminders: unable to claim the reminder of event %s: %s", event.EventID, err)
+			continue
+		}
+		if !claimed {
+			continue
+		}
+
+		userIDs, optedIn := event.GetScheduledReminderUserIDs(group.Settings != nil && group.Settings.EventReminders)
+		count, err := app.notifyEventReminder(clientID, group, &event, userIDs, optedIn,
+			fmt.Sprintf("Reminder: an event of '%s' starts within a day", group.Title), app.config.AppID, app.config.OrgID)
+		if err != nil {
+			log.Printf("processEventReminders: error reminding the members of event %s: %s", event.EventID, err)
+			continue
+		}
+		if count > 0 {
+			remindedCount++
+		}
+	}
+
+	log.Printf("processEventReminders: members of %d events reminded for clientID %s", remindedCount, clientID)
+	return nil
+}
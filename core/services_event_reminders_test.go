@@ -0,0 +1,159 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"sort"
+	"testing"
+	"time"
+)
+
+// fakeRemindersStorage is the storage of the event reminder tests. The events are due the reminders until they are claimed.
+type fakeRemindersStorage struct {
+	Storage
+
+	group       model.Group
+	events      []model.Event
+	memberships []model.GroupMembership
+}
+
+func (s *fakeRemindersStorage) FindEventsDueReminder(context storage.TransactionContext, clientID string, startAfter time.Time, startBefore time.Time) ([]model.Event, error) {
+	var result []model.Event
+	for _, event := range s.events {
+		start := event.GetStart()
+		if event.DateReminderSent == nil && start != nil && start.After(startAfter) && !start.After(startBefore) {
+			result = append(result, event)
+		}
+	}
+	return result, nil
+}
+
+func (s *fakeRemindersStorage) ClaimEventReminder(context storage.TransactionContext, clientID string, groupID string, eventID string, date time.Time) (bool, error) {
+	for i, event := range s.events {
+		if event.EventID == eventID && event.DateReminderSent == nil {
+			s.events[i].DateReminderSent = &date
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *fakeRemindersStorage) FindGroup(context storage.TransactionContext, clientID string, groupID string, userID *string) (*model.Group, error) {
+	group := s.group
+	return &group, nil
+}
+
+func (s *fakeRemindersStorage) FindGroupMemberships(clientID string, filter model.MembershipFilter) (model.MembershipCollection, error) {
+	var items []model.GroupMembership
+	for _, membership := range s.memberships {
+		if contains(filter.UserIDs, membership.UserID) && contains(filter.Statuses, membership.Status) {
+			items = append(items, membership)
+		}
+	}
+	return model.MembershipCollection{Items: items}, nil
+}
+
+func (s *fakeRemindersStorage) FindMutedMemberUserIDs(context storage.TransactionContext, groupID string, userIDs []string, includeDigest bool) ([]string, error) {
+	return nil, nil
+}
+
+func (s *fakeRemindersStorage) InsertUserNotifications(context storage.TransactionContext, items []model.UserNotification) error {
+	return nil
+}
+
+// fakeNotifications records the recipients of the sent notifications
+type fakeNotifications struct {
+	Notifications
+
+	recipients [][]notifications.Recipient
+}
+
+func (n *fakeNotifications) SendNotification(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string, dateScheduled *time.Time) error {
+	n.recipients = append(n.recipients, recipients)
+	return nil
+}
+
+func TestProcessEventReminders(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	soon := now.Add(2 * time.Hour)
+	later := now.Add(3 * 24 * time.Hour)
+	optIn := true
+	optOut := false
+	rsvps := []model.EventRSVP{
+		{UserID: "user-going", Status: model.EventRSVPStatusGoing},
+		{UserID: "user-opted-in", Reminder: &optIn},
+		{UserID: "user-opted-out", Status: model.EventRSVPStatusGoing, Reminder: &optOut},
+		{UserID: "user-maybe", Status: model.EventRSVPStatusMaybe},
+	}
+	memberships := []model.GroupMembership{
+		{UserID: "user-going", Status: "member"},
+		{UserID: "user-opted-in", Status: "member"},
+		{UserID: "user-opted-out", Status: "member"},
+		{UserID: "user-maybe", Status: "member"},
+	}
+
+	cases := []struct {
+		name       string
+		reminders  bool
+		recipients []string
+	}{
+		{name: "going and opted in members get the reminder when the group default is on", reminders: true, recipients: []string{"user-going", "user-opted-in"}},
+		{name: "only opted in members get the reminder when the group default is off", recipients: []string{"user-opted-in"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeStorage := &fakeRemindersStorage{
+				group: model.Group{ID: "group-1", Title: "Chess Club", Settings: &model.GroupSettings{EventReminders: tc.reminders}},
+				events: []model.Event{
+					{EventID: "event-soon", GroupID: "group-1", DateStart: &soon, RSVPs: rsvps},
+					{EventID: "event-later", GroupID: "group-1", DateStart: &later, RSVPs: rsvps},
+				},
+				memberships: memberships,
+			}
+			fakeNotifications := &fakeNotifications{}
+			app := &Application{storage: fakeStorage, notifications: fakeNotifications, config: &model.ApplicationConfig{SupportedClientIDs: []string{testClientID}}}
+
+			for i := 0; i < 2; i++ {
+				err := app.processEventReminders(testClientID, now)
+				if err != nil {
+					t.Fatalf("error processing the reminders - %s", err)
+				}
+			}
+
+			if len(fakeNotifications.recipients) != 1 {
+				t.Fatalf("sent reminders = %d, want 1", len(fakeNotifications.recipients))
+			}
+			var userIDs []string
+			for _, recipient := range fakeNotifications.recipients[0] {
+				userIDs = append(userIDs, recipient.UserID)
+			}
+			sort.Strings(userIDs)
+			if len(userIDs) != len(tc.recipients) {
+				t.Fatalf("recipients = %v, want %v", userIDs, tc.recipients)
+			}
+			for i := range userIDs {
+				if userIDs[i] != tc.recipients[i] {
+					t.Fatalf("recipients = %v, want %v", userIDs, tc.recipients)
+				}
+			}
+			if fakeStorage.events[1].DateReminderSent != nil {
+				t.Errorf("the reminder of the later event is sent")
+			}
+		})
+	}
+}
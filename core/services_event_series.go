@@ -0,0 +1,167 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// createCalendarEventSeriesSingleGroup creates a Calendar BB event for every occurrence of the recurrence and links them to the group as one series.
+// The members are notified about the first event of the series only.
+func (app *Application) createCalendarEventSeriesSingleGroup(clientID string, current *model.User, event map[string]interface{}, groupID string, members []model.ToMember, recurrence model.EventRecurrence) ([]map[string]interface{}, error) {
+	start := model.GetCalendarEventStart(event)
+	if start == nil {
+		return nil, errors.New("the event start is required for a recurring event")
+	}
+
+	group, err := app.storage.FindGroup(nil, clientID, groupID, &current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, fmt.Errorf("missing group %s", groupID)
+	}
+
+	seriesID := uuid.NewString()
+	creator := &model.Creator{UserID: current.ID, Name: current.Name, Email: current.Email}
+	currentAccount := model.AccountIdentifiers{AccountID: &current.ID, ExternalID: &current.ExternalID}
+
+	var createdEvents []map[string]interface{}
+	for i, occurrence := range recurrence.Occurrences(*start) {
+		occurrenceEvent := model.ShiftCalendarEventDates(event, occurrence.Sub(*start))
+		createdEvent, err := app.calendar.CreateCalendarEvent([]model.AccountIdentifiers{}, currentAccount, occurrenceEvent, current.OrgID, current.AppID, []string{groupID})
+		if err != nil {
+			// the created events stay linked as a shorter series
+			return createdEvents, fmt.Errorf("error creating occurrence %d of series %s: %s", i+1, seriesID, err)
+		}
+		eventID, _ := createdEvent["id"].(string)
+		if len(eventID) == 0 {
+			return createdEvents, fmt.Errorf("missing id of occurrence %d of series %s", i+1, seriesID)
+		}
+
+		occurrenceStart := occurrence.UTC()
+		mapping := model.Event{
+			ClientID:        clientID,
+			EventID:         eventID,
+			GroupID:         groupID,
+			DateCreated:     time.Now().UTC(),
+			Creator:         creator,
+			ToMembersList:   members,
+			DateStart:       model.GetCalendarEventStart(occurrenceEvent),
+			DateEnd:         model.GetCalendarEventEnd(occurrenceEvent),
+			SeriesID:        &seriesID,
+			OccurrenceStart: &occurrenceStart,
+		}
+		err = app.storage.InsertSeriesEvent(nil, mapping)
+		if err != nil {
+			return createdEvents, fmt.Errorf("error linking occurrence %d of series %s: %s", i+1, seriesID, err)
+		}
+		createdEvents = append(createdEvents, createdEvent)
+
+		if i == 0 {
+			app.notifyGroupMembersForNewEvent(nil, clientID, current, group, &mapping, &current.ID)
+		}
+	}
+	return createdEvents, nil
+}
+
+// updateCalendarEventSeries updates the selected event or the selected and all later events of its series. The later events keep their distance
+// from the selected one, so moving the selected event moves them too.
+func (app *Application) updateCalendarEventSeries(clientID string, current *model.User, groupID string, eventID string, scope string, event map[string]interface{}, members []model.ToMember) ([]map[string]interface{}, error) {
+	selected, targets, err := app.findEventSeriesTargets(clientID, groupID, eventID, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	newStart := model.GetCalendarEventStart(event)
+	if newStart == nil {
+		newStart = selected.OccurrenceStart
+	}
+
+	currentAccount := model.AccountIdentifiers{AccountID: &current.ID, ExternalID: &current.NetID}
+	var updatedEvents []map[string]interface{}
+	for _, target := range targets {
+		offset := target.OccurrenceStart.Sub(*selected.OccurrenceStart)
+		targetEvent := model.ShiftCalendarEventDates(event, offset)
+		targetEvent["id"] = target.EventID
+
+		updatedEvent, err := app.calendar.UpdateCalendarEvent(currentAccount, target.EventID, targetEvent, current.OrgID, current.AppID)
+		if err != nil {
+			return updatedEvents, fmt.Errorf("error updating event %s of series %s: %s", target.EventID, *selected.SeriesID, err)
+		}
+
+		targetStart := newStart.Add(offset).UTC()
+		err = app.storage.UpdateSeriesEvent(nil, clientID, groupID, target.EventID, members, &targetStart)
+		if err != nil {
+			return updatedEvents, err
+		}
+		if dateStart := model.GetCalendarEventStart(targetEvent); dateStart != nil {
+			err = app.storage.UpdateEventDates(nil, clientID, groupID, target.EventID, dateStart, model.GetCalendarEventEnd(targetEvent))
+			if err != nil {
+				return updatedEvents, err
+			}
+		}
+		updatedEvents = append(updatedEvents, updatedEvent)
+	}
+	return updatedEvents, nil
+}
+
+// deleteEventSeries unlinks the selected event or the selected and all later events of its series from the group
+func (app *Application) deleteEventSeries(clientID string, groupID string, eventID string, scope string) (int64, error) {
+	_, targets, err := app.findEventSeriesTargets(clientID, groupID, eventID, scope)
+	if err != nil {
+		return 0, err
+	}
+
+	eventIDs := make([]string, len(targets))
+	for i, target := range targets {
+		eventIDs[i] = target.EventID
+	}
+	deleted, err := app.storage.DeleteEventsByIDs(nil, clientID, groupID, eventIDs)
+	if err != nil {
+		return 0, err
+	}
+	log.Printf("app.deleteEventSeries() - %d events of series %s unlinked from group %s", deleted, *targets[0].SeriesID, groupID)
+	return deleted, nil
+}
+
+// findEventSeriesTargets gives the selected event of a series and the events the scope applies to
+func (app *Application) findEventSeriesTargets(clientID string, groupID string, eventID string, scope string) (*model.Event, []model.Event, error) {
+	selected, err := app.storage.FindEvent(nil, clientID, groupID, eventID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if selected == nil {
+		return nil, nil, fmt.Errorf("missing event %s for group %s", eventID, groupID)
+	}
+	if selected.SeriesID == nil || selected.OccurrenceStart == nil {
+		return nil, nil, fmt.Errorf("event %s is not part of a series", eventID)
+	}
+
+	if scope != model.EventSeriesScopeFuture {
+		return selected, []model.Event{*selected}, nil
+	}
+	targets, err := app.storage.FindEventSeries(nil, clientID, groupID, *selected.SeriesID, selected.OccurrenceStart)
+	if err != nil {
+		return nil, nil, err
+	}
+	return selected, targets, nil
+}
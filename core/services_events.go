@@ -21,6 +21,7 @@ import (
 	"groups/driven/storage"
 	"log"
 	"strings"
+	"time"
 )
 
 func (app *Application) findAdminGroupsForEvent(clientID string, current *model.User, eventID string) ([]string, error) {
@@ -39,6 +40,36 @@ func (app *Application) findGroupMembershipsStatusAndGroupsTitle(userID string)
 	return app.storage.FindGroupMembershipStatusAndGroupTitle(nil, userID)
 }
 
+// findUsersGroupMemberships gives the group memberships of the users in the order of the user IDs, the users without memberships included
+func (app *Application) findUsersGroupMemberships(userIDs []string) ([]model.UserGroupMemberships, error) {
+	memberships, err := app.storage.FindUsersGroupMemberships(nil, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	byUser := map[string][]model.UserGroupMembership{}
+	for _, membership := range memberships {
+		membership.IsAdmin = membership.Status == "admin"
+		byUser[membership.UserID] = append(byUser[membership.UserID], membership)
+	}
+
+	result := make([]model.UserGroupMemberships, 0, len(userIDs))
+	added := map[string]bool{}
+	for _, userID := range userIDs {
+		if added[userID] {
+			continue
+		}
+		added[userID] = true
+
+		userMemberships := byUser[userID]
+		if userMemberships == nil {
+			userMemberships = []model.UserGroupMembership{}
+		}
+		result = append(result, model.UserGroupMemberships{UserID: userID, Memberships: userMemberships})
+	}
+	return result, nil
+}
+
 func (app *Application) findGroupMembershipsByGroupID(groupID string) ([]string, error) {
 	return app.storage.FindGroupMembershipByGroupID(nil, groupID)
 }
@@ -50,16 +81,20 @@ func (app *Application) findGroupsByGroupIDs(groupIDs []string) ([]model.Group,
 	return app.storage.FindGroupsByGroupIDs(groupIDs)
 }
 
-func (app *Application) getEvents(clientID string, current *model.User, groupID string, filterByToMembers bool) ([]model.Event, error) {
-	events, err := app.storage.FindEvents(clientID, current, groupID, filterByToMembers)
+func (app *Application) getEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) ([]model.Event, error) {
+	events, err := app.storage.FindEvents(clientID, current, groupID, filterByToMembers, filter)
 	if err != nil {
 		return nil, err
 	}
 	return events, nil
 }
 
+func (app *Application) countEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) (int64, error) {
+	return app.storage.CountEvents(clientID, current, groupID, filterByToMembers, filter)
+}
+
 func (app *Application) getGroupCalendarEvents(clientID string, current *model.User, groupID string, published *bool, filter model.GroupEventFilter) (map[string]interface{}, error) {
-	mappings, err := app.storage.FindEvents(clientID, current, groupID, true)
+	mappings, err := app.storage.FindEvents(clientID, current, groupID, true, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -92,7 +127,8 @@ func (app *Application) createEvent(clientID string, current *model.User, eventI
 
 	var event *model.Event
 	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
-		_, err := app.storage.CreateEvent(context, clientID, eventID, group.ID, toMemberList, creator)
+		var err error
+		event, err = app.storage.CreateEvent(context, clientID, eventID, group.ID, toMemberList, creator)
 		if err != nil {
 			return err
 		}
@@ -232,6 +268,14 @@ func (app *Application) createCalendarEventSingleGroup(clientID string, current
 				if err != nil {
 					log.Printf("Error create goup mapping: %s", err)
 				}
+				if mapping != nil {
+					mapping.DateStart = model.GetCalendarEventStart(createdEvent)
+					mapping.DateEnd = model.GetCalendarEventEnd(createdEvent)
+					err = app.storage.UpdateEventDates(context, clientID, groupID, eventID, mapping.DateStart, mapping.DateEnd)
+					if err != nil {
+						log.Printf("Error storing the event dates: %s", err)
+					}
+				}
 
 				group, grErr := app.storage.FindGroup(context, clientID, groupID, &current.ID)
 				if grErr != nil {
@@ -282,6 +326,13 @@ func (app *Application) updateCalendarEventSingleGroup(clientID string, current
 				return nil, nil, err
 			}
 
+			if dateStart := model.GetCalendarEventStart(createdEvent); dateStart != nil {
+				err = app.storage.UpdateEventDates(nil, clientID, groupID, eventID, dateStart, model.GetCalendarEventEnd(createdEvent))
+				if err != nil {
+					log.Printf("app.updateCalendarEventSingleGroup() Error storing the event dates: %s", err)
+				}
+			}
+
 			for _, groupID := range groupIDs {
 				mapping, err := app.storage.CreateEvent(nil, clientID, eventID, groupID, members, &model.Creator{
 					UserID: current.ID,
@@ -349,7 +400,7 @@ func (app *Application) notifyGroupMembersForNewEvent(context storage.Transactio
 			groupStr = "Research Project"
 		}
 
-		err = app.notifications.SendNotification(
+		err = app.sendNotification(
 			recipients,
 			&topic,
 			fmt.Sprintf("%s - %s", groupStr, group.Title),
@@ -370,3 +421,117 @@ func (app *Application) notifyGroupMembersForNewEvent(context storage.Transactio
 		}
 	}
 }
+
+func (app *Application) getGroupEvent(clientID string, groupID string, eventID string) (*model.Event, error) {
+	return app.storage.FindEvent(nil, clientID, groupID, eventID)
+}
+
+func (app *Application) updateEventRSVP(clientID string, current *model.User, groupID string, eventID string, status string) (*model.EventRSVPSummary, error) {
+	rsvp := model.EventRSVP{
+		UserID:      current.ID,
+		Name:        current.Name,
+		Email:       current.Email,
+		Status:      status,
+		DateUpdated: time.Now().UTC(),
+	}
+	err := app.storage.SaveEventRSVP(clientID, groupID, eventID, rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := app.storage.FindEvent(nil, clientID, groupID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, fmt.Errorf("missing event %s for group %s", eventID, groupID)
+	}
+	summary := event.GetRSVPSummary(current.ID, false)
+	return &summary, nil
+}
+
+// updateEventReminder sets the reminder opt-in of the current user for the event
+func (app *Application) updateEventReminder(clientID string, current *model.User, groupID string, eventID string, reminder bool) (*model.EventRSVPSummary, error) {
+	rsvp := model.EventRSVP{
+		UserID:      current.ID,
+		Name:        current.Name,
+		Email:       current.Email,
+		Reminder:    &reminder,
+		DateUpdated: time.Now().UTC(),
+	}
+	err := app.storage.SaveEventRSVPReminder(clientID, groupID, eventID, rsvp)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := app.storage.FindEvent(nil, clientID, groupID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if event == nil {
+		return nil, fmt.Errorf("missing event %s for group %s", eventID, groupID)
+	}
+	summary := event.GetRSVPSummary(current.ID, false)
+	return &summary, nil
+}
+
+// sendEventRSVPReminder notifies the current members who are going to the event or opted in for its reminders. The members who muted the group events
+// are notified only if they opted in. It gives the number of the notified members.
+func (app *Application) sendEventRSVPReminder(clientID string, current *model.User, group *model.Group, event *model.Event, message string) (int, error) {
+	userIDs, optedIn := event.GetReminderUserIDs()
+	if len(message) == 0 {
+		message = fmt.Sprintf("Reminder: you are going to an upcoming event of '%s'", group.Title)
+	}
+	return app.notifyEventReminder(clientID, group, event, userIDs, optedIn, message, current.AppID, current.OrgID)
+}
+
+// notifyEventReminder notifies the current members of the group from the users. The members who muted the group events are notified only if they opted in.
+// It gives the number of the notified members.
+func (app *Application) notifyEventReminder(clientID string, group *model.Group, event *model.Event, userIDs []string, optedIn map[string]bool, message string, appID string, orgID string) (int, error) {
+	if len(userIDs) == 0 {
+		return 0, nil
+	}
+
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		UserIDs:  userIDs,
+		Statuses: []string{"member", "admin"},
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	recipients := memberships.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		if optedIn[member.UserID] {
+			return true, false
+		}
+		return true, member.NotificationsPreferences.OverridePreferences &&
+			(member.NotificationsPreferences.EventsMuted || member.NotificationsPreferences.AllMute)
+	})
+	if len(recipients) == 0 {
+		return 0, nil
+	}
+
+	topic := "group.events"
+	err = app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("Group - %s", group.Title),
+		message,
+		map[string]string{
+			"type":        "group",
+			"operation":   "event_reminder",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"event_id":    event.EventID,
+		},
+		appID,
+		orgID,
+		nil,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return len(recipients), nil
+}
@@ -0,0 +1,64 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/storage"
+	"groups/utils"
+	"time"
+)
+
+// featureEvent features the event of the group until the time, nil stops featuring it. It gives utils.GroupError when the group already has
+// the maximum number of featured events and nil if the event does not belong to the group.
+func (app *Application) featureEvent(clientID string, current *model.User, groupID string, eventID string, featuredUntil *time.Time) (*model.Event, error) {
+	var event *model.Event
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		existing, err := app.storage.FindEvent(context, clientID, groupID, eventID)
+		if err != nil || existing == nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if featuredUntil != nil && !existing.IsFeatured(now) {
+			count, err := app.storage.CountFeaturedEvents(context, clientID, groupID, now)
+			if err != nil {
+				return err
+			}
+			if count >= model.MaxFeaturedEvents {
+				return utils.NewFeaturedEventsLimitError(model.MaxFeaturedEvents)
+			}
+		}
+
+		updated, err := app.storage.UpdateEventFeaturedUntil(context, clientID, groupID, eventID, featuredUntil)
+		if err != nil || !updated {
+			return err
+		}
+		existing.FeaturedUntil = featuredUntil
+		event = existing
+
+		action := model.AuditActionEventUnfeatured
+		if featuredUntil != nil {
+			action = model.AuditActionEventFeatured
+		}
+		return app.recordAuditLog(context, clientID, current, groupID, action, "event", eventID,
+			map[string]model.AuditChange{"featured_until": {New: featuredUntil}})
+	})
+	if err != nil || event == nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,108 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"sort"
+	"time"
+)
+
+// defaultGroupFeedLimit is the page size of the group feed when the client does not provide a limit
+const defaultGroupFeedLimit int64 = 20
+
+// getGroupFeed assembles the posts, the events and the membership milestones of the group in one chronologically sorted (newest first) page.
+// Every source is loaded up to offset+limit items as this is the max number of items from a single source which could fall within the page.
+func (app *Application) getGroupFeed(clientID string, current *model.User, groupID string, filter model.GroupFeedFilter) ([]model.GroupFeedItem, error) {
+	offset := int64(0)
+	if filter.Offset != nil && *filter.Offset > 0 {
+		offset = *filter.Offset
+	}
+	limit := defaultGroupFeedLimit
+	if filter.Limit != nil && *filter.Limit > 0 {
+		limit = *filter.Limit
+	}
+	window := offset + limit
+
+	items := []model.GroupFeedItem{}
+
+	order := "desc"
+	zero := int64(0)
+	posts, err := app.storage.FindPosts(clientID, current, model.PostsFilter{GroupID: groupID, Order: &order, Offset: &zero, Limit: &window}, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("error finding posts for the feed of group %s: %s", groupID, err)
+	}
+	for i := range posts {
+		items = append(items, model.GroupFeedItem{Type: model.GroupFeedItemTypePost, Date: posts[i].DateCreated, Post: &posts[i]})
+	}
+
+	events, err := app.storage.FindEvents(clientID, current, groupID, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding events for the feed of group %s: %s", groupID, err)
+	}
+	for i := range events {
+		items = append(items, model.GroupFeedItem{Type: model.GroupFeedItemTypeEvent, Date: events[i].DateCreated, Event: &events[i]})
+	}
+
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{groupID},
+		Statuses: []string{"admin", "member"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error finding memberships for the feed of group %s: %s", groupID, err)
+	}
+	items = append(items, getGroupMembershipMilestones(memberships.Items)...)
+
+	// the featured events are first until they expire
+	now := time.Now().UTC()
+	sort.SliceStable(items, func(i, j int) bool {
+		iFeatured := items[i].Event != nil && items[i].Event.IsFeatured(now)
+		jFeatured := items[j].Event != nil && items[j].Event.IsFeatured(now)
+		if iFeatured != jFeatured {
+			return iFeatured
+		}
+		return items[i].Date.After(items[j].Date)
+	})
+
+	if offset >= int64(len(items)) {
+		return []model.GroupFeedItem{}, nil
+	}
+	end := window
+	if end > int64(len(items)) {
+		end = int64(len(items))
+	}
+	return items[offset:end], nil
+}
+
+// getGroupMembershipMilestones gives a feed item for every milestone members count the group has reached. The milestone date is the join date of the member who reached it.
+func getGroupMembershipMilestones(memberships []model.GroupMembership) []model.GroupFeedItem {
+	sort.SliceStable(memberships, func(i, j int) bool {
+		return memberships[i].DateCreated.Before(memberships[j].DateCreated)
+	})
+
+	items := []model.GroupFeedItem{}
+	for _, count := range model.GroupMembershipMilestones {
+		if count > len(memberships) {
+			break
+		}
+		items = append(items, model.GroupFeedItem{
+			Type:      model.GroupFeedItemTypeMembershipMilestone,
+			Date:      memberships[count-1].DateCreated,
+			Milestone: &model.MembershipMilestone{MembersCount: count},
+		})
+	}
+	return items
+}
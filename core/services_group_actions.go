@@ -0,0 +1,97 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+)
+
+// applyGroupAction dispatches a group admin quick action to the corresponding service. The caller must have checked that the current user is an admin of the group.
+func (app *Application) applyGroupAction(clientID string, current *model.User, group *model.Group, action model.GroupAction) model.GroupActionResult {
+	result := model.GroupActionResult{Type: action.Type}
+	if targetID := action.TargetID(); targetID != nil {
+		result.TargetID = *targetID
+	}
+
+	err := app.dispatchGroupAction(clientID, current, group, action, result.TargetID)
+	if err != nil {
+		errMsg := err.Error()
+		result.Error = &errMsg
+		return result
+	}
+
+	result.Success = true
+	return result
+}
+
+func (app *Application) dispatchGroupAction(clientID string, current *model.User, group *model.Group, action model.GroupAction, targetID string) error {
+	if targetID == "" {
+		return fmt.Errorf("missing target for action %s", action.Type)
+	}
+
+	switch action.Type {
+	case model.GroupActionMembershipApprove, model.GroupActionMembershipReject, model.GroupActionMembershipRemove, model.GroupActionMembershipMute:
+		membership, err := app.storage.FindGroupMembershipByID(clientID, targetID)
+		if err != nil || membership == nil || membership.GroupID != group.ID {
+			return fmt.Errorf("membership %s not found within the group", targetID)
+		}
+
+		switch action.Type {
+		case model.GroupActionMembershipApprove, model.GroupActionMembershipReject:
+			if membership.Status != "pending" {
+				return fmt.Errorf("membership %s is not pending", targetID)
+			}
+			reason := ""
+			if action.Reason != nil {
+				reason = *action.Reason
+			}
+			return app.applyMembershipApproval(clientID, current, targetID, action.Type == model.GroupActionMembershipApprove, "", reason)
+		case model.GroupActionMembershipMute:
+			if !membership.IsMember() {
+				return fmt.Errorf("membership %s is not a member", targetID)
+			}
+			duration := action.MuteDuration()
+			if duration == nil {
+				return fmt.Errorf("duration must be between %d and %d seconds", int64(model.MinGroupActionMuteDuration.Seconds()),
+					int64(model.MaxGroupActionMuteDuration.Seconds()))
+			}
+			_, err = app.muteGroupMember(clientID, current, group.ID, targetID, *duration)
+			return err
+		default:
+			if membership.UserID == current.ID {
+				return fmt.Errorf("admins cannot remove their own membership")
+			}
+			return app.deleteMembershipByID(clientID, current, targetID, nil)
+		}
+	case model.GroupActionPostDelete:
+		post, err := app.storage.FindPost(nil, clientID, &current.ID, group.ID, targetID, true, false)
+		if err != nil || post == nil || post.GroupID != group.ID {
+			return fmt.Errorf("post %s not found within the group", targetID)
+		}
+		return app.deletePost(clientID, current, group.ID, targetID, true)
+	case model.GroupActionPostPin:
+		post, err := app.pinPost(clientID, current, group.ID, targetID, true)
+		if err != nil {
+			return err
+		}
+		if post == nil {
+			return fmt.Errorf("top level post %s not found within the group", targetID)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unsupported action type %s", action.Type)
+}
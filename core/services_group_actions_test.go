@@ -0,0 +1,227 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"groups/core/model"
+	"groups/driven/storage"
+	"testing"
+	"time"
+)
+
+const testClientID = "edu.illinois.rokwire"
+
+// fakeActionsStorage is the storage of the group action tests. It keeps the fixture memberships and posts in memory.
+// The storage functions which are not overridden panic as the embedded interface is nil.
+type fakeActionsStorage struct {
+	Storage
+
+	memberships  []model.GroupMembership
+	posts        []model.Post
+	pinnedCount  int64
+	mutedUntil   map[string]*time.Time // membership id -> posting muted until
+	auditActions []string
+}
+
+func newFakeActionsStorage() *fakeActionsStorage {
+	parentID := "post-1"
+	return &fakeActionsStorage{
+		memberships: []model.GroupMembership{
+			{ID: "membership-admin", ClientID: testClientID, GroupID: "group-1", UserID: "user-admin", Status: "admin"},
+			{ID: "membership-member", ClientID: testClientID, GroupID: "group-1", UserID: "user-member", Status: "member"},
+			{ID: "membership-pending", ClientID: testClientID, GroupID: "group-1", UserID: "user-pending", Status: "pending"},
+			{ID: "membership-other", ClientID: testClientID, GroupID: "group-2", UserID: "user-member", Status: "member"},
+		},
+		posts: []model.Post{
+			{ID: "post-1", ClientID: testClientID, GroupID: "group-1"},
+			{ID: "post-2", ClientID: testClientID, GroupID: "group-1", ParentID: &parentID},
+			{ID: "post-3", ClientID: testClientID, GroupID: "group-2"},
+		},
+		mutedUntil: map[string]*time.Time{},
+	}
+}
+
+func (s *fakeActionsStorage) PerformTransaction(transaction func(context storage.TransactionContext) error) error {
+	return transaction(nil)
+}
+
+func (s *fakeActionsStorage) FindGroupMembershipByID(clientID string, id string) (*model.GroupMembership, error) {
+	for _, membership := range s.memberships {
+		if membership.ID == id {
+			item := membership
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fakeActionsStorage) UpdateMembershipPostingMutedUntil(context storage.TransactionContext, clientID string, membershipID string, mutedUntil *time.Time) error {
+	s.mutedUntil[membershipID] = mutedUntil
+	for i, membership := range s.memberships {
+		if membership.ID == membershipID {
+			s.memberships[i].PostingMutedUntil = mutedUntil
+		}
+	}
+	return nil
+}
+
+func (s *fakeActionsStorage) FindPost(context storage.TransactionContext, clientID string, userID *string, groupID string, postID string, skipMembershipCheck bool, filterByToMembers bool) (*model.Post, error) {
+	for _, post := range s.posts {
+		if post.ID == postID && post.GroupID == groupID {
+			item := post
+			return &item, nil
+		}
+	}
+	return nil, nil
+}
+
+func (s *fakeActionsStorage) CountPinnedPosts(context storage.TransactionContext, clientID string, groupID string) (int64, error) {
+	return s.pinnedCount, nil
+}
+
+func (s *fakeActionsStorage) UpdatePostPinned(context storage.TransactionContext, clientID string, groupID string, postID string, pinned bool) (bool, error) {
+	for i, post := range s.posts {
+		if post.ID == postID && post.GroupID == groupID {
+			s.posts[i].Pinned = pinned
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *fakeActionsStorage) InsertAuditLog(context storage.TransactionContext, item model.AuditLog) error {
+	s.auditActions = append(s.auditActions, item.Action)
+	return nil
+}
+
+func TestApplyGroupActionPostPin(t *testing.T) {
+	cases := []struct {
+		name        string
+		postID      string
+		pinnedCount int64
+		success     bool
+		pinned      bool
+	}{
+		{name: "top level post is pinned", postID: "post-1", success: true, pinned: true},
+		{name: "reply is not pinned", postID: "post-2"},
+		{name: "post of another group is not pinned", postID: "post-3"},
+		{name: "post is not pinned over the limit", postID: "post-1", pinnedCount: model.MaxPinnedPosts},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeStorage := newFakeActionsStorage()
+			fakeStorage.pinnedCount = tc.pinnedCount
+			app := &Application{storage: fakeStorage}
+
+			postID := tc.postID
+			result := app.applyGroupAction(testClientID, &model.User{ID: "user-admin"}, &model.Group{ID: "group-1"},
+				model.GroupAction{Type: model.GroupActionPostPin, PostID: &postID})
+
+			if result.Success != tc.success {
+				t.Fatalf("success = %v, want %v, error: %v", result.Success, tc.success, result.Error)
+			}
+			if result.TargetID != tc.postID {
+				t.Errorf("target id = %s, want %s", result.TargetID, tc.postID)
+			}
+			pinned := false
+			for _, post := range fakeStorage.posts {
+				pinned = pinned || post.Pinned
+			}
+			if pinned != tc.pinned {
+				t.Errorf("pinned = %v, want %v", pinned, tc.pinned)
+			}
+			if tc.pinned && (len(fakeStorage.auditActions) != 1 || fakeStorage.auditActions[0] != model.AuditActionPostPinned) {
+				t.Errorf("audit actions = %v, want [%s]", fakeStorage.auditActions, model.AuditActionPostPinned)
+			}
+		})
+	}
+}
+
+func TestApplyGroupActionMembershipMute(t *testing.T) {
+	hour := int64(3600)
+	second := int64(1)
+	twoYears := int64(2 * 365 * 24 * 3600)
+	cases := []struct {
+		name         string
+		membershipID string
+		duration     *int64
+		success      bool
+	}{
+		{name: "member is muted", membershipID: "membership-member", duration: &hour, success: true},
+		{name: "admin is not muted", membershipID: "membership-admin", duration: &hour},
+		{name: "pending member is not muted", membershipID: "membership-pending", duration: &hour},
+		{name: "member of another group is not muted", membershipID: "membership-other", duration: &hour},
+		{name: "missing duration is rejected", membershipID: "membership-member"},
+		{name: "too short duration is rejected", membershipID: "membership-member", duration: &second},
+		{name: "too long duration is rejected", membershipID: "membership-member", duration: &twoYears},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeStorage := newFakeActionsStorage()
+			app := &Application{storage: fakeStorage}
+
+			membershipID := tc.membershipID
+			before := time.Now().UTC()
+			result := app.applyGroupAction(testClientID, &model.User{ID: "user-admin"}, &model.Group{ID: "group-1"},
+				model.GroupAction{Type: model.GroupActionMembershipMute, MembershipID: &membershipID, Duration: tc.duration})
+
+			if result.Success != tc.success {
+				t.Fatalf("success = %v, want %v, error: %v", result.Success, tc.success, result.Error)
+			}
+			mutedUntil, muted := fakeStorage.mutedUntil[tc.membershipID]
+			if muted != tc.success {
+				t.Fatalf("muted = %v, want %v", muted, tc.success)
+			}
+			if muted {
+				expected := before.Add(time.Duration(*tc.duration) * time.Second)
+				if mutedUntil == nil || mutedUntil.Before(expected) || mutedUntil.After(expected.Add(time.Minute)) {
+					t.Errorf("muted until = %v, want about %v", mutedUntil, expected)
+				}
+				if len(fakeStorage.auditActions) != 1 || fakeStorage.auditActions[0] != model.AuditActionMemberMuted {
+					t.Errorf("audit actions = %v, want [%s]", fakeStorage.auditActions, model.AuditActionMemberMuted)
+				}
+			}
+		})
+	}
+}
+
+func TestMutedMemberCannotPost(t *testing.T) {
+	fakeStorage := newFakeActionsStorage()
+	app := &Application{storage: fakeStorage}
+	current := &model.User{ID: "user-member"}
+
+	membershipID := "membership-member"
+	hour := int64(3600)
+	result := app.applyGroupAction(testClientID, &model.User{ID: "user-admin"}, &model.Group{ID: "group-1"},
+		model.GroupAction{Type: model.GroupActionMembershipMute, MembershipID: &membershipID, Duration: &hour})
+	if !result.Success {
+		t.Fatalf("error muting the member: %v", result.Error)
+	}
+
+	membership, _ := fakeStorage.FindGroupMembershipByID(testClientID, membershipID)
+	group := &model.Group{ID: "group-1", CurrentMember: membership}
+	parentID := "post-1"
+	for _, post := range []*model.Post{{GroupID: "group-1"}, {GroupID: "group-1", ParentID: &parentID}} {
+		_, err := app.createPost(testClientID, current, post, group)
+		var mutedErr *model.PostingMutedError
+		if !errors.As(err, &mutedErr) {
+			t.Errorf("create post error = %v, want the posting muted error", err)
+		}
+	}
+	if len(fakeStorage.posts) != 3 {
+		t.Errorf("posts = %d, want 3", len(fakeStorage.posts))
+	}
+}
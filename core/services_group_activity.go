@@ -0,0 +1,108 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"sort"
+	"time"
+)
+
+// getGroupActivity summarizes the posts, the events, the joins and the pinned posts of the group since the moment. Only the posts and
+// the events visible to the current user are included, and the joins respect the member info settings of the group.
+func (app *Application) getGroupActivity(clientID string, current *model.User, group *model.Group, since time.Time) (*model.GroupActivity, error) {
+	activity := model.GroupActivity{Since: since, NewPosts: []model.Post{}, NewEvents: []model.Event{}, Joins: []model.GroupActivityMember{},
+		PinnedPosts: []model.Post{}}
+
+	order := "desc"
+	posts, err := app.storage.FindPosts(clientID, current, model.PostsFilter{GroupID: group.ID, Since: &since, Order: &order}, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("error finding posts for the activity of group %s: %s", group.ID, err)
+	}
+	// the pinned posts are sorted first
+	sort.SliceStable(posts, func(i, j int) bool {
+		return posts[i].DateCreated.After(posts[j].DateCreated)
+	})
+	activity.NewPostsCount = len(posts)
+	if len(posts) > model.MaxGroupActivityItems {
+		posts = posts[:model.MaxGroupActivityItems]
+	}
+	activity.NewPosts = append(activity.NewPosts, posts...)
+
+	pinnedOnly := true
+	pinnedPosts, err := app.storage.FindPosts(clientID, current, model.PostsFilter{GroupID: group.ID, PinnedOnly: &pinnedOnly, Order: &order}, nil, true)
+	if err != nil {
+		return nil, fmt.Errorf("error finding pinned posts for the activity of group %s: %s", group.ID, err)
+	}
+	for _, post := range pinnedPosts {
+		if post.DatePinned != nil && !post.DatePinned.Before(since) {
+			activity.PinnedPosts = append(activity.PinnedPosts, post)
+		}
+	}
+
+	events, err := app.storage.FindEvents(clientID, current, group.ID, true, nil)
+	if err != nil {
+		return nil, fmt.Errorf("error finding events for the activity of group %s: %s", group.ID, err)
+	}
+	for _, event := range events {
+		if !event.DateCreated.Before(since) {
+			activity.NewEvents = append(activity.NewEvents, event)
+		}
+	}
+	sort.SliceStable(activity.NewEvents, func(i, j int) bool {
+		return activity.NewEvents[i].DateCreated.After(activity.NewEvents[j].DateCreated)
+	})
+	activity.NewEventsCount = len(activity.NewEvents)
+	if len(activity.NewEvents) > model.MaxGroupActivityItems {
+		activity.NewEvents = activity.NewEvents[:model.MaxGroupActivityItems]
+	}
+
+	memberships, err := app.findGroupMemberships(nil, clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		Statuses: []string{"admin", "member"},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error finding memberships for the activity of group %s: %s", group.ID, err)
+	}
+	var joins []model.GroupMembership
+	for _, membership := range memberships.Items {
+		if !membership.DateCreated.Before(since) {
+			joins = append(joins, membership)
+		}
+	}
+	activity.JoinsCount = len(joins)
+
+	settings := group.Settings
+	if settings == nil {
+		defaultSettings := model.DefaultGroupSettings()
+		settings = &defaultSettings
+	}
+	isAdmin := group.CurrentMember != nil && group.CurrentMember.IsAdmin()
+	if isAdmin || settings.MemberInfoPreferences.AllowMemberInfo {
+		sort.SliceStable(joins, func(i, j int) bool {
+			return joins[i].DateCreated.After(joins[j].DateCreated)
+		})
+		if len(joins) > model.MaxGroupActivityItems {
+			joins = joins[:model.MaxGroupActivityItems]
+		}
+		for _, membership := range joins {
+			activity.Joins = append(activity.Joins, model.GroupActivityMember{UserID: membership.UserID, Name: membership.Name,
+				DateJoined: membership.DateCreated})
+		}
+	}
+
+	return &activity, nil
+}
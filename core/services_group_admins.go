@@ -0,0 +1,177 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+// checkGroupKeepsAdmin gives LastAdminError if the group would be left without an admin once the provided users and memberships
+// are no longer admins. The groups which have no admin already are not blocked.
+func (app *Application) checkGroupKeepsAdmin(context storage.TransactionContext, clientID string, groupID string, exceptUserIDs []string, exceptMembershipIDs []string) error {
+	remaining, err := app.storage.CountGroupAdmins(context, clientID, groupID, exceptUserIDs, exceptMembershipIDs)
+	if err != nil {
+		return fmt.Errorf("error counting the admins of group %s: %s", groupID, err)
+	}
+	if remaining > 0 {
+		return nil
+	}
+
+	total, err := app.storage.CountGroupAdmins(context, clientID, groupID, nil, nil)
+	if err != nil {
+		return fmt.Errorf("error counting the admins of group %s: %s", groupID, err)
+	}
+	if total > 0 {
+		return &model.LastAdminError{GroupID: groupID}
+	}
+	return nil
+}
+
+// transferGroupOwnership makes the member an admin of the group. The current admin becomes a member unless keepAdmin is set.
+func (app *Application) transferGroupOwnership(clientID string, current *model.User, group *model.Group, membershipID string, keepAdmin bool) (*model.GroupMembership, error) {
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		return nil, errors.New("only the group admins can transfer the ownership")
+	}
+
+	membership, err := app.storage.FindGroupMembershipByID(clientID, membershipID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading membership %s: %s", membershipID, err)
+	}
+	if membership == nil || membership.GroupID != group.ID {
+		return nil, nil
+	}
+	if membership.Status != "member" || len(membership.UserID) == 0 {
+		return nil, fmt.Errorf("membership %s is not a member linked to an account", membershipID)
+	}
+
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		if keepAdmin {
+			err = app.storage.UpdateMembershipStatus(context, clientID, group.ID, membership.ID, "admin")
+		} else {
+			err = app.storage.TransferGroupAdmin(context, clientID, group.ID, group.CurrentMember.ID, membership.ID)
+		}
+		if err != nil {
+			return err
+		}
+		err = app.storage.UpdateGroupStats(context, clientID, group.ID, false, true, false, true)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupOwnershipTransferred, "membership", membership.ID,
+			map[string]model.AuditChange{
+				"admin_user_id":    {New: membership.UserID},
+				"previous_user_id": {Old: current.ID},
+				"keep_admin":       {New: keepAdmin},
+			})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error transferring the ownership of group %s: %s", group.ID, err)
+	}
+	membership.Status = "admin"
+
+	app.notifyNewGroupAdmin(group, membership)
+
+	return membership, nil
+}
+
+// ensureGroupAdmins keeps an admin in the groups administered only by the users who are deleted. The longest-standing member
+// is promoted, the groups without such member are flagged for the org admins.
+func (app *Application) ensureGroupAdmins(context storage.TransactionContext, userIDs []string) error {
+	adminMemberships, err := app.storage.FindAdminMembershipsByUserIDs(context, userIDs)
+	if err != nil {
+		return fmt.Errorf("error loading the admin memberships: %s", err)
+	}
+
+	checked := map[string]bool{}
+	for _, adminMembership := range adminMemberships {
+		key := adminMembership.ClientID + "_" + adminMembership.GroupID
+		if checked[key] {
+			continue
+		}
+		checked[key] = true
+
+		count, err := app.storage.CountGroupAdmins(context, adminMembership.ClientID, adminMembership.GroupID, userIDs, nil)
+		if err != nil {
+			return fmt.Errorf("error counting the admins of group %s: %s", adminMembership.GroupID, err)
+		}
+		if count > 0 {
+			continue
+		}
+
+		successor, err := app.storage.FindLongestStandingMember(context, adminMembership.ClientID, adminMembership.GroupID, userIDs)
+		if err != nil {
+			return fmt.Errorf("error loading the successor admin of group %s: %s", adminMembership.GroupID, err)
+		}
+		if successor != nil {
+			log.Printf("promoting membership %s to the admin of group %s as the last admin is deleted", successor.ID, adminMembership.GroupID)
+			err = app.storage.UpdateMembershipStatus(context, adminMembership.ClientID, adminMembership.GroupID, successor.ID, "admin")
+			if err == nil {
+				err = app.storage.UpdateGroupStats(context, adminMembership.ClientID, adminMembership.GroupID, false, true, false, true)
+			}
+		} else {
+			log.Printf("group %s has no admin and no member to promote as the last admin is deleted", adminMembership.GroupID)
+			now := time.Now().UTC()
+			err = app.storage.UpdateGroupAdminNeeded(context, adminMembership.ClientID, adminMembership.GroupID, &now)
+		}
+		if err != nil {
+			return fmt.Errorf("error keeping an admin of group %s: %s", adminMembership.GroupID, err)
+		}
+	}
+	return nil
+}
+
+func (app *Application) getGroupsNeedingAdmin(clientID string) ([]model.Group, error) {
+	return app.storage.FindGroupsNeedingAdmin(nil, clientID)
+}
+
+// clearGroupAdminNeeded removes the flag of the org admins once the group has an admin again
+func (app *Application) clearGroupAdminNeeded(clientID string, groupID string) {
+	err := app.storage.UpdateGroupAdminNeeded(nil, clientID, groupID, nil)
+	if err != nil {
+		log.Printf("error clearing the admin needed flag of group %s: %s", groupID, err)
+	}
+}
+
+// notifyNewGroupAdmin lets the member know about becoming an admin of the group
+func (app *Application) notifyNewGroupAdmin(group *model.Group, membership *model.GroupMembership) {
+	topic := "group.invitations"
+	err := app.sendNotification(
+		[]notifications.Recipient{membership.ToNotificationRecipient(false)},
+		&topic,
+		fmt.Sprintf("Group - %s", group.Title),
+		fmt.Sprintf("You are now an admin of '%s' group", group.Title),
+		map[string]string{
+			"type":        "group",
+			"operation":   "ownership_transferred",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+		},
+		app.config.AppID,
+		app.config.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error notifying the new admin of group %s: %s", group.ID, err)
+	}
+}
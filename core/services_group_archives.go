@@ -0,0 +1,33 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import "groups/core/model"
+
+func (app *Application) getGroupDeletionPolicyConfig(clientID string) (*model.GroupDeletionPolicyConfig, error) {
+	return app.storage.FindGroupDeletionPolicyConfig(nil, clientID)
+}
+
+func (app *Application) updateGroupDeletionPolicyConfig(config model.GroupDeletionPolicyConfig) error {
+	return app.storage.SaveGroupDeletionPolicyConfig(nil, config)
+}
+
+func (app *Application) getArchivedGroups(clientID string) ([]model.GroupArchiveItem, error) {
+	return app.storage.FindArchivedGroups(nil, clientID)
+}
+
+func (app *Application) getGroupArchiveItems(clientID string, groupID string, entityType *string, offset *int64, limit *int64) ([]model.GroupArchiveItem, error) {
+	return app.storage.FindGroupArchiveItems(nil, clientID, groupID, entityType, offset, limit)
+}
@@ -0,0 +1,180 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// banGroupMember bans the user identified by the user id or the external id from the group. The membership of the user is deleted.
+func (app *Application) banGroupMember(clientID string, current *model.User, group *model.Group, userID string, externalID string, reason string) (*model.GroupBan, error) {
+	if len(userID) > 0 && userID == current.ID {
+		return nil, errors.New("the admins cannot ban themselves")
+	}
+
+	ban := model.GroupBan{ID: uuid.NewString(), ClientID: clientID, GroupID: group.ID, UserID: userID, ExternalID: externalID,
+		Reason: reason, BannedBy: current.ID, DateCreated: time.Now().UTC()}
+
+	filter := model.MembershipFilter{GroupIDs: []string{group.ID}}
+	if len(userID) > 0 {
+		filter.UserID = &userID
+	} else {
+		filter.ExternalID = &externalID
+	}
+	memberships, err := app.storage.FindGroupMemberships(clientID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the membership to ban in group %s: %s", group.ID, err)
+	}
+	var membership *model.GroupMembership
+	if len(memberships.Items) > 0 {
+		membership = &memberships.Items[0]
+		if membership.IsAdmin() {
+			return nil, errors.New("the admins cannot be banned, change their status first")
+		}
+		ban.UserID = membership.UserID
+		ban.ExternalID = membership.ExternalID
+		ban.Name = membership.Name
+		ban.NetID = membership.NetID
+	} else if len(userID) > 0 {
+		// the external id is needed to keep the user out of the Authman and the smart group syncs
+		accounts, err := app.corebb.GetAccountsWithIDs([]string{userID}, nil, nil, nil, nil)
+		if err != nil {
+			log.Printf("error loading the core account %s to ban in group %s: %s", userID, group.ID, err)
+		} else if len(accounts) > 0 {
+			ban.ExternalID = accounts[0].GetExternalID()
+			ban.NetID = accounts[0].GetNetID()
+			ban.Name = accounts[0].GetFullName()
+		}
+	}
+
+	var savedBan *model.GroupBan
+	saveBan := func(context storage.TransactionContext) error {
+		savedBan, err = app.storage.SaveGroupBan(context, ban)
+		if err != nil {
+			return fmt.Errorf("error saving the ban: %s", err)
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionMemberBanned, "ban", savedBan.ID,
+			map[string]model.AuditChange{
+				"user_id":     {New: savedBan.UserID},
+				"external_id": {New: savedBan.ExternalID},
+				"reason":      {New: savedBan.Reason},
+			})
+	}
+
+	// the ban is saved within the transaction of the membership deletion, so the user is never left banned with the membership
+	if membership != nil {
+		err = app.deleteMembershipByID(clientID, current, membership.ID, saveBan)
+	} else {
+		err = app.storage.PerformTransaction(saveBan)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error banning the user in group %s: %s", group.ID, err)
+	}
+
+	return savedBan, nil
+}
+
+func (app *Application) getGroupBans(clientID string, groupID string) ([]model.GroupBan, error) {
+	return app.storage.FindGroupBans(nil, clientID, groupID)
+}
+
+// unbanGroupMember deletes the ban. The user could join the group again, the membership is not restored.
+func (app *Application) unbanGroupMember(clientID string, current *model.User, groupID string, banID string) (bool, error) {
+	deleted := false
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		deleted, err = app.storage.DeleteGroupBan(context, clientID, groupID, banID)
+		if err != nil || !deleted {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionMemberUnbanned, "ban", banID, nil)
+	})
+	if err != nil {
+		return false, fmt.Errorf("error deleting ban %s in group %s: %s", banID, groupID, err)
+	}
+	return deleted, nil
+}
+
+// muteGroupMember mutes the member for the duration, so they cannot post nor reply within the group until the mute ends.
+// The notifications of the member are not changed.
+func (app *Application) muteGroupMember(clientID string, current *model.User, groupID string, membershipID string, duration time.Duration) (*time.Time, error) {
+	mutedUntil := time.Now().UTC().Add(duration)
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.UpdateMembershipPostingMutedUntil(context, clientID, membershipID, &mutedUntil)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionMemberMuted, "membership", membershipID, nil)
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error muting membership %s in group %s: %s", membershipID, groupID, err)
+	}
+	return &mutedUntil, nil
+}
+
+// checkGroupBan gives GroupBanError if the user is banned from the group
+func (app *Application) checkGroupBan(clientID string, groupID string, userID string, externalID string) error {
+	ban, err := app.storage.FindGroupBan(nil, clientID, groupID, userID, externalID)
+	if err != nil {
+		return fmt.Errorf("error checking the bans of group %s: %s", groupID, err)
+	}
+	if ban != nil {
+		return &model.GroupBanError{GroupID: groupID}
+	}
+	return nil
+}
+
+// findBannedExternalIDs gives the external ids of the users banned from the group, so the syncs do not add them back
+func (app *Application) findBannedExternalIDs(clientID string, groupID string) (map[string]bool, error) {
+	banned, err := app.storage.FindGroupBannedExternalIDs(nil, clientID, groupID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the banned external ids of group %s: %s", groupID, err)
+	}
+
+	result := make(map[string]bool, len(banned))
+	for _, externalID := range banned {
+		result[externalID] = true
+	}
+	return result, nil
+}
+
+// filterBannedExternalIDs removes the external ids of the users banned from the group
+func (app *Application) filterBannedExternalIDs(clientID string, groupID string, externalIDs []string) ([]string, error) {
+	banned, err := app.findBannedExternalIDs(clientID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if len(banned) == 0 {
+		return externalIDs, nil
+	}
+
+	result := make([]string, 0, len(externalIDs))
+	for _, externalID := range externalIDs {
+		if !banned[externalID] {
+			result = append(result, externalID)
+		}
+	}
+	return result, nil
+}
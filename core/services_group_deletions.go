@@ -0,0 +1,270 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// groupDeletionBatchSize is the number of the group items deleted at once
+	groupDeletionBatchSize int64 = 1000
+	// groupDeletionStaleAfter is the period without a progress after which an unfinished deletion is resumed by the deletion task
+	groupDeletionStaleAfter = 10 * time.Minute
+)
+
+func (app *Application) startGroupDeletionTask() {
+	_, err := app.scheduler.AddFunc("*/10 * * * *", func() {
+		app.purgeDeletedGroups()
+		app.resumeGroupDeletions()
+	})
+	if err != nil {
+		log.Printf("error on running group deletion task: %s", err)
+	}
+	log.Printf("successful running of group deletion task")
+}
+
+// markGroupDeleted excludes the group from all the queries. Its content is purged once the restore period of the deletion policy passes,
+// at once without the restore period. The deletion is recorded in the audit log of the group. It gives false if the group is already deleted.
+func (app *Application) markGroupDeleted(clientID string, current *model.User, group *model.Group) (bool, error) {
+	policy, err := app.storage.FindGroupDeletionPolicyConfig(nil, clientID)
+	if err != nil {
+		return false, err
+	}
+
+	now := time.Now().UTC()
+	datePurge := policy.DatePurge(now)
+	marked := false
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		marked, err = app.storage.MarkGroupDeleted(context, clientID, group.ID, now, datePurge)
+		if err != nil || !marked {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupDeleted, "group", group.ID, nil)
+	})
+	if err != nil {
+		return false, fmt.Errorf("error marking group %s as deleted: %s", group.ID, err)
+	}
+	if !marked {
+		return false, nil
+	}
+
+	if !datePurge.After(now) {
+		// the purge task retries on a failure
+		_, err = app.startGroupPurge(*group)
+		if err != nil {
+			log.Printf("app.markGroupDeleted() error starting the purge of group %s: %s", group.ID, err)
+		}
+	}
+	return true, nil
+}
+
+// restoreGroup restores the deleted group if its restore period has not passed yet. It gives false if there is no such group.
+func (app *Application) restoreGroup(clientID string, current *model.User, groupID string) (bool, error) {
+	restored := false
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		restored, err = app.storage.RestoreGroup(context, clientID, groupID, time.Now().UTC())
+		if err != nil || !restored {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionGroupRestored, "group", groupID, nil)
+	})
+	if err != nil {
+		return false, err
+	}
+	return restored, nil
+}
+
+func (app *Application) getDeletedGroups(clientID string) ([]model.Group, error) {
+	return app.storage.FindDeletedGroups(nil, clientID)
+}
+
+// purgeDeletedGroups starts the purge of the deleted groups whose restore period has passed
+func (app *Application) purgeDeletedGroups() {
+	groups, err := app.storage.FindGroupsToPurge(nil, time.Now().UTC())
+	if err != nil {
+		log.Printf("app.purgeDeletedGroups() error loading the deleted groups: %s", err)
+		return
+	}
+	for _, group := range groups {
+		_, err = app.startGroupPurge(group)
+		if err != nil {
+			log.Printf("app.purgeDeletedGroups() error starting the purge of group %s: %s", group.ID, err)
+		}
+	}
+}
+
+// startGroupPurge deletes the content of the deleted group in the background. It gives nil if the group has been restored or its purge
+// has already started.
+func (app *Application) startGroupPurge(group model.Group) (*model.GroupDeletionJob, error) {
+	policy, err := app.storage.FindGroupDeletionPolicyConfig(nil, group.ClientID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	var datePurge *time.Time
+	if policy.ArchivesContent() {
+		purge := now.AddDate(0, 0, policy.RetentionDays)
+		datePurge = &purge
+	}
+	job := model.NewGroupDeletionJob(uuid.NewString(), group, datePurge)
+
+	var claimed bool
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		claimed, err = app.storage.ClaimGroupPurge(context, group.ClientID, group.ID, now)
+		if err != nil || !claimed {
+			return err
+		}
+		return app.storage.InsertGroupDeletionJob(context, job)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, nil
+	}
+
+	go app.runGroupDeletion(job)
+	return &job, nil
+}
+
+// resumeGroupDeletions resumes the failed deletions and the deletions of the instances which have stopped
+func (app *Application) resumeGroupDeletions() {
+	jobs, err := app.storage.FindUnfinishedGroupDeletionJobs(nil, time.Now().UTC().Add(-groupDeletionStaleAfter))
+	if err != nil {
+		log.Printf("app.resumeGroupDeletions() error loading the unfinished group deletions: %s", err)
+		return
+	}
+	for _, job := range jobs {
+		log.Printf("app.resumeGroupDeletions() resuming the deletion of group %s from the %s step", job.GroupID, job.Step)
+		app.runGroupDeletion(job)
+	}
+}
+
+// runGroupDeletion processes the remaining steps of the deletion. Only one instance processes a deletion at a time.
+func (app *Application) runGroupDeletion(job model.GroupDeletionJob) {
+	acquired, releaseLock, err := app.acquireLock("group_deletion_"+job.ID, groupDeletionLockLease)
+	if err != nil {
+		log.Printf("app.runGroupDeletion() error acquiring the lock of the deletion of group %s: %s", job.GroupID, err)
+		return
+	}
+	if !acquired {
+		return
+	}
+	defer releaseLock()
+
+	// another instance could have progressed in the meantime
+	current, err := app.storage.FindGroupDeletionJob(nil, job.ClientID, job.ID)
+	if err != nil || current == nil {
+		log.Printf("app.runGroupDeletion() unable to load the deletion of group %s: %v", job.GroupID, err)
+		return
+	}
+	job = *current
+	if job.Status == model.GroupDeletionJobStatusSucceeded {
+		return
+	}
+	job.Status = model.GroupDeletionJobStatusRunning
+
+	err = app.processGroupDeletionSteps(&job)
+	now := time.Now().UTC()
+	job.DateUpdated = &now
+	if err != nil {
+		job.Status = model.GroupDeletionJobStatusFailed
+		job.AddError(err)
+		log.Printf("app.runGroupDeletion() the deletion of group %s failed on the %s step: %s", job.GroupID, job.Step, err)
+	} else {
+		job.Status = model.GroupDeletionJobStatusSucceeded
+		job.DateEnded = &now
+		log.Printf("app.runGroupDeletion() group %s deleted: %v", job.GroupID, job.Processed)
+	}
+
+	err = app.storage.UpdateGroupDeletionJob(nil, job)
+	if err != nil {
+		log.Printf("app.runGroupDeletion() error updating the deletion of group %s: %s", job.GroupID, err)
+	}
+}
+
+// processGroupDeletionSteps deletes the group items in batches starting from the current step of the job. The progress is stored after
+// every batch, so a failed deletion continues where it stopped.
+func (app *Application) processGroupDeletionSteps(job *model.GroupDeletionJob) error {
+	start := 0
+	for i, step := range model.GroupDeletionSteps {
+		if step == job.Step {
+			start = i
+		}
+	}
+
+	for _, step := range model.GroupDeletionSteps[start:] {
+		job.Step = step
+		for {
+			count, err := app.deleteGroupContentBatch(job, step)
+			if err != nil {
+				return err
+			}
+			job.Processed[step] += count
+
+			now := time.Now().UTC()
+			job.DateUpdated = &now
+			err = app.storage.UpdateGroupDeletionJob(nil, *job)
+			if err != nil {
+				log.Printf("app.processGroupDeletionSteps() error updating the deletion of group %s: %s", job.GroupID, err)
+			}
+
+			if count < groupDeletionBatchSize {
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// deleteGroupContentBatch deletes the next batch of the group items of the step. The archived items are moved to the archive in the
+// same transaction as their deletion.
+func (app *Application) deleteGroupContentBatch(job *model.GroupDeletionJob, step string) (int64, error) {
+	if !job.Archives() {
+		if step == model.GroupDeletionStepEvents {
+			// the event mappings are kept as archived
+			return app.storage.ArchiveGroupEventMappings(nil, job.ClientID, job.GroupID)
+		}
+		return app.storage.DeleteGroupContentBatch(nil, job.ClientID, job.GroupID, step, job.DateStarted, nil, groupDeletionBatchSize)
+	}
+
+	var count int64
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		count, err = app.storage.DeleteGroupContentBatch(context, job.ClientID, job.GroupID, step, job.DateStarted, job.DatePurge, groupDeletionBatchSize)
+		return err
+	})
+	return count, err
+}
+
+func (app *Application) getGroupDeletionJobs(clientID string, filter model.GroupDeletionJobFilter) ([]model.GroupDeletionJob, error) {
+	return app.storage.FindGroupDeletionJobs(nil, clientID, filter)
+}
+
+func (app *Application) getGroupDeletionJob(clientID string, id string) (*model.GroupDeletionJob, error) {
+	return app.storage.FindGroupDeletionJob(nil, clientID, id)
+}
@@ -0,0 +1,61 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+)
+
+// updateGroupJoinRules sets the join rules of the group. Nil rules remove them, so the membership requests wait for the admins again.
+func (app *Application) updateGroupJoinRules(clientID string, current *model.User, group *model.Group, rules *model.GroupJoinRules) error {
+	return app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.UpdateGroupJoinRules(context, clientID, group.ID, rules)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupUpdated, "group", group.ID,
+			map[string]model.AuditChange{"join_rules": {Old: group.JoinRules, New: rules}})
+	})
+}
+
+// matchesGroupJoinRules checks if the membership request of the user is approved automatically by the join rules of the group.
+// The Core BB account attributes are checked only if the email domain and the NetID do not match.
+func (app *Application) matchesGroupJoinRules(group *model.Group, current *model.User) bool {
+	rules := group.JoinRules
+	if rules == nil || !rules.Enabled {
+		return false
+	}
+	if rules.MatchesEmailOrNetID(current.Email, current.NetID) {
+		return true
+	}
+	if len(rules.AccountMatch) == 0 || len(current.ID) == 0 {
+		return false
+	}
+
+	searchParams := map[string]interface{}{"id": []string{current.ID}}
+	for attribute, value := range rules.AccountMatch {
+		searchParams[attribute] = value
+	}
+	accounts, err := app.corebb.GetAccounts(searchParams, &current.AppID, &current.OrgID, nil, nil)
+	if err != nil {
+		// the request stays pending for the admins
+		log.Printf("error app.matchesGroupJoinRules() - unable to match the account %s for group %s: %s", current.ID, group.ID, err)
+		return false
+	}
+	return len(accounts) > 0
+}
@@ -0,0 +1,126 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultGroupMessagesLimit int64 = 50
+	maxGroupMessagesLimit     int64 = 200
+	// groupMessageSubscriberBuffer the messages are dropped for the slow subscribers once the buffer is full
+	groupMessageSubscriberBuffer = 32
+)
+
+func (app *Application) getGroupMessages(clientID string, groupID string, cursor *model.GroupMessagesCursor, limit int64) (*model.GroupMessagesPage, error) {
+	if limit <= 0 {
+		limit = defaultGroupMessagesLimit
+	}
+	if limit > maxGroupMessagesLimit {
+		limit = maxGroupMessagesLimit
+	}
+
+	messages, err := app.storage.FindGroupMessages(nil, clientID, groupID, cursor, limit)
+	if err != nil {
+		return nil, err
+	}
+	if messages == nil {
+		messages = []model.GroupMessage{}
+	}
+	return &model.GroupMessagesPage{Messages: messages, NextCursor: model.NextGroupMessagesCursor(messages, limit)}, nil
+}
+
+// createGroupMessage stores the chat message. The open streams of all instances receive it through the storage change stream.
+func (app *Application) createGroupMessage(clientID string, current *model.User, groupID string, body string) (*model.GroupMessage, error) {
+	message := model.GroupMessage{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		GroupID:     groupID,
+		Sender:      model.Creator{UserID: current.ID, Name: current.Name, Email: current.Email},
+		Body:        body,
+		DateCreated: time.Now().UTC(),
+	}
+	err := app.storage.InsertGroupMessage(nil, message)
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
+
+// deleteGroupMessage deletes the chat message. The members may delete their own messages only, the group admins may delete any message.
+func (app *Application) deleteGroupMessage(clientID string, current *model.User, group *model.Group, messageID string) (bool, error) {
+	var senderID *string
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		senderID = &current.ID
+	}
+	return app.storage.DeleteGroupMessage(nil, clientID, group.ID, messageID, senderID)
+}
+
+// groupMessageSubscribers keeps the chat streams opened on this instance per group
+type groupMessageSubscribers struct {
+	lock        sync.RWMutex
+	subscribers map[string]map[chan model.GroupMessage]bool
+}
+
+func newGroupMessageSubscribers() *groupMessageSubscribers {
+	return &groupMessageSubscribers{subscribers: map[string]map[chan model.GroupMessage]bool{}}
+}
+
+// subscribe opens a stream of the new messages of the group. The returned function closes the stream.
+func (s *groupMessageSubscribers) subscribe(clientID string, groupID string) (<-chan model.GroupMessage, func()) {
+	key := clientID + "_" + groupID
+	channel := make(chan model.GroupMessage, groupMessageSubscriberBuffer)
+
+	s.lock.Lock()
+	if s.subscribers[key] == nil {
+		s.subscribers[key] = map[chan model.GroupMessage]bool{}
+	}
+	s.subscribers[key][channel] = true
+	s.lock.Unlock()
+
+	unsubscribe := func() {
+		s.lock.Lock()
+		defer s.lock.Unlock()
+		if _, ok := s.subscribers[key][channel]; !ok {
+			return
+		}
+		delete(s.subscribers[key], channel)
+		if len(s.subscribers[key]) == 0 {
+			delete(s.subscribers, key)
+		}
+		close(channel)
+	}
+	return channel, unsubscribe
+}
+
+func (s *groupMessageSubscribers) publish(message model.GroupMessage) {
+	key := message.ClientID + "_" + message.GroupID
+
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	for channel := range s.subscribers[key] {
+		select {
+		case channel <- message:
+		default:
+			log.Printf("groupMessageSubscribers.publish() - dropping message %s for a slow subscriber of group %s", message.ID, message.GroupID)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+// updateGroupRules sets the rules of the group. The version increases whenever the text changes and the members are prompted to acknowledge the new rules.
+// An empty text removes the rules.
+func (app *Application) updateGroupRules(clientID string, current *model.User, group *model.Group, text string) (*model.GroupRules, error) {
+	var oldVersion int
+	if group.Rules != nil {
+		if group.Rules.Text == text {
+			return group.Rules, nil
+		}
+		oldVersion = group.Rules.Version
+	}
+
+	var rules *model.GroupRules
+	if len(text) > 0 {
+		rules = &model.GroupRules{Text: text, Version: oldVersion + 1, DateUpdated: time.Now().UTC()}
+	}
+
+	var newVersion int
+	if rules != nil {
+		newVersion = rules.Version
+	}
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.UpdateGroupRules(context, clientID, group.ID, rules)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupUpdated, "group", group.ID,
+			map[string]model.AuditChange{"rules_version": {Old: oldVersion, New: newVersion}})
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rules != nil {
+		go app.notifyGroupRulesChanged(clientID, current, group)
+	}
+	return rules, nil
+}
+
+func (app *Application) acknowledgeGroupRules(clientID string, current *model.User, groupID string, version int) error {
+	return app.storage.UpdateMembershipRulesAcknowledgement(nil, clientID, groupID, current.ID, version, time.Now().UTC())
+}
+
+// notifyGroupRulesChanged prompts the members of the group to acknowledge the new rules
+func (app *Application) notifyGroupRulesChanged(clientID string, current *model.User, group *model.Group) {
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		Statuses: []string{"member"},
+	})
+	if err != nil {
+		log.Printf("app.notifyGroupRulesChanged() error loading the members of group %s: %s", group.ID, err)
+		return
+	}
+
+	recipients := memberships.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		return member.UserID != current.ID, member.NotificationsPreferences.OverridePreferences && member.NotificationsPreferences.AllMute
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	topic := "group.rules"
+	err = app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("Group - %s", group.Title),
+		fmt.Sprintf("The rules of '%s' have changed. Please review and accept them to keep posting.", group.Title),
+		map[string]string{
+			"type":        "group",
+			"operation":   "rules_updated",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("app.notifyGroupRulesChanged() error notifying the members of group %s: %s", group.ID, err)
+	}
+}
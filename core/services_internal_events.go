@@ -0,0 +1,69 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+)
+
+func (app *Application) getInternalEventServicesConfig(clientID string) (*model.InternalEventServicesConfig, error) {
+	return app.storage.FindInternalEventServicesConfig(nil, clientID)
+}
+
+func (app *Application) updateInternalEventServicesConfig(config model.InternalEventServicesConfig) error {
+	return app.storage.SaveInternalEventServicesConfig(nil, config)
+}
+
+// createInternalGroupEvent links the calendar event to the group on behalf of a service. The service must be allowed to link the events to
+// the group by the config of the client and the event must exist in the Calendar BB.
+func (app *Application) createInternalGroupEvent(clientID string, service string, eventID string, group *model.Group, toMemberList []model.ToMember, creator *model.Creator) (*model.Event, error) {
+	config, err := app.storage.FindInternalEventServicesConfig(nil, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !config.Allows(service, group) {
+		return nil, &model.EventServiceNotAllowedError{Service: service, GroupID: group.ID}
+	}
+
+	mapping, err := app.storage.FindEvent(nil, clientID, group.ID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if mapping != nil {
+		return nil, &model.EventMappingExistsError{Mapping: *mapping}
+	}
+
+	eventsResponse, err := app.calendar.GetGroupCalendarEvents(model.AccountIdentifiers{}, []string{eventID}, app.config.AppID, app.config.OrgID, nil, model.GroupEventFilter{})
+	if err != nil {
+		return nil, err
+	}
+	if events, _ := eventsResponse["events"].([]interface{}); len(events) == 0 {
+		return nil, &model.CalendarEventNotFoundError{EventID: eventID}
+	}
+
+	event, err := app.createEvent(clientID, nil, eventID, group, toMemberList, creator)
+	if err != nil {
+		// linked by a concurrent request after the check
+		mapping, findErr := app.storage.FindEvent(nil, clientID, group.ID, eventID)
+		if findErr == nil && mapping != nil {
+			return nil, &model.EventMappingExistsError{Mapping: *mapping}
+		}
+		return nil, err
+	}
+
+	log.Printf("app.createInternalGroupEvent() service '%s' linked event %s to group %s", service, eventID, group.ID)
+	return event, nil
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"log"
+	"time"
+)
+
+// authmanSyncLockLease is the lease of the Authman sync lock. Another instance takes over the sync once the lease of a failed instance expires.
+const authmanSyncLockLease = 2 * time.Minute
+
+// reactionMigrationLockLease is the lease of the reaction migration lock, so only one migration runs per client
+const reactionMigrationLockLease = 2 * time.Minute
+
+// membershipPruningLockLease is the lease of the membership pruning lock, so only one instance prunes the memberships of a client
+const membershipPruningLockLease = 2 * time.Minute
+
+// smartGroupSyncLockLease is the lease of the smart group sync lock, so only one instance synchronizes the smart groups of a client
+const smartGroupSyncLockLease = 2 * time.Minute
+
+// backupLockLease is the lease of the backup lock, so only one instance exports the backups
+const backupLockLease = 2 * time.Minute
+
+// membershipDedupLockLease is the lease of the membership dedup lock, so only one instance merges the duplicate memberships of a client
+const membershipDedupLockLease = 2 * time.Minute
+
+// usageMeteringLockLease is the lease of the usage metering lock, so only one instance meters the usage of a client
+const usageMeteringLockLease = 2 * time.Minute
+
+// digestLockLease is the lease of the digest lock, so only one instance sends the digests of a client
+const digestLockLease = 2 * time.Minute
+
+// pendingMembershipLockLease is the lease of the pending membership lock, so only one instance expires the requests and reminds the admins of a client
+const pendingMembershipLockLease = 2 * time.Minute
+
+// orgUnitSyncLockLease is the lease of the org unit sync lock, so only one instance synchronizes the org units of a client
+const orgUnitSyncLockLease = 2 * time.Minute
+
+// membershipTransitionLockLease is the lease of the membership transition lock, so only one instance applies the scheduled status changes of a client
+const membershipTransitionLockLease = 2 * time.Minute
+
+// outboxDispatchLockLease is the lease of the outbox dispatch lock, so only one instance publishes the domain events
+const outboxDispatchLockLease = 2 * time.Minute
+
+// groupDeletionLockLease is the lease of the group deletion lock, so only one instance deletes the content of a group
+const groupDeletionLockLease = 2 * time.Minute
+
+// acquireLock acquires the distributed lock and keeps renewing it in the background until the returned release function is called
+func (app *Application) acquireLock(name string, lease time.Duration) (bool, func(), error) {
+	acquired, err := app.storage.AcquireLock(nil, name, app.instanceID, lease)
+	if err != nil || !acquired {
+		return false, nil, err
+	}
+
+	done := make(chan struct{})
+	go func() {
+		ticker := time.NewTicker(lease / 3)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+				renewed, err := app.storage.AcquireLock(nil, name, app.instanceID, lease)
+				if err != nil {
+					log.Printf("error renewing lock %s: %s", name, err)
+				} else if !renewed {
+					log.Printf("lock %s has been taken over by another instance", name)
+					return
+				}
+			}
+		}
+	}()
+
+	release := func() {
+		close(done)
+		err := app.storage.ReleaseLock(nil, name, app.instanceID)
+		if err != nil {
+			log.Printf("error releasing lock %s: %s", name, err)
+		}
+	}
+	return true, release, nil
+}
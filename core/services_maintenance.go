@@ -0,0 +1,52 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+)
+
+// recalculateGroupStats recalculates the membership stats of the groups. All groups of the client are processed if no group ids are passed.
+// On dry run the stats are calculated but not stored.
+func (app *Application) recalculateGroupStats(clientID string, groupIDs []string, dryRun bool) ([]model.GroupStatsRecalculation, error) {
+	groups, err := app.storage.FindGroups(clientID, nil, model.GroupsFilter{GroupIDs: groupIDs})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]model.GroupStatsRecalculation, 0, len(groups))
+	for _, group := range groups {
+		stats, err := app.storage.GetGroupMembershipStats(nil, clientID, group.ID)
+		if err != nil {
+			return results, fmt.Errorf("error calculating the stats of group %s: %s", group.ID, err)
+		}
+		if stats == nil {
+			stats = &model.GroupStats{}
+		}
+
+		result := model.GroupStatsRecalculation{GroupID: group.ID, Title: group.Title, Previous: group.Stats,
+			Current: *stats, Changed: group.Stats != *stats}
+		if result.Changed && !dryRun {
+			err = app.storage.UpdateGroupStats(nil, clientID, group.ID, false, false, false, true)
+			if err != nil {
+				return results, fmt.Errorf("error updating the stats of group %s: %s", group.ID, err)
+			}
+			result.Updated = true
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
@@ -76,6 +76,10 @@ func (app *Application) findGroupMemberships(context storage.TransactionContext,
 	return collection, err
 }
 
+func (app *Application) countGroupMemberships(clientID string, filter model.MembershipFilter) (int64, error) {
+	return app.storage.CountGroupMemberships(nil, clientID, filter)
+}
+
 // Check if a slice contains a value
 func contains(slice []string, value string) bool {
 	for _, v := range slice {
@@ -119,17 +123,43 @@ func (app *Application) findUserGroupMemberships(clientID string, userID string)
 }
 
 func (app *Application) createPendingMembership(clientID string, current *model.User, group *model.Group, member *model.GroupMembership) error {
+	err := group.ValidateMemberAnswers(member.MemberAnswers)
+	if err != nil {
+		return err
+	}
+
+	err = app.checkGroupBan(clientID, group.ID, current.ID, current.ExternalID)
+	if err != nil {
+		return err
+	}
 
-	if group.CanJoinAutomatically {
+	err = app.checkContentRateLimit(clientID, current, group.ID, model.ContentActionMembershipRequest)
+	if err != nil {
+		return err
+	}
+
+	joinAutomatically := group.CanJoinAutomatically || app.matchesGroupJoinRules(group, current)
+	if joinAutomatically {
 		member.Status = "member"
 	} else {
 		member.Status = "pending"
 	}
 
-	err := app.storage.CreatePendingMembership(clientID, current, group, member)
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.CreatePendingMembership(context, clientID, current, group, member)
+		if err != nil {
+			return err
+		}
+		return app.recordMembershipChanged(context, clientID, *member, model.MembershipChangeCreated)
+	})
 	if err != nil {
 		return err
 	}
+	app.recordContentEvent(clientID, current, group.ID, model.ContentActionMembershipRequest)
+
+	if member.Status == "member" {
+		go app.publishResearchParticipantEnrolled(clientID, group, member)
+	}
 
 	adminMemberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
 		GroupIDs: []string{group.ID},
@@ -150,11 +180,11 @@ func (app *Application) createPendingMembership(clientID string, current *model.
 				}
 
 				message := fmt.Sprintf("New membership request for '%s' %s has been submitted", group.Title, strings.ToLower(groupStr))
-				if group.CanJoinAutomatically {
+				if joinAutomatically {
 					message = fmt.Sprintf("%s joined '%s' %s", member.GetDisplayName(), group.Title, strings.ToLower(groupStr))
 				}
 
-				app.notifications.SendNotification(
+				app.sendNotification(
 					recipients,
 					&topic,
 					fmt.Sprintf("%s - %s", groupStr, group.Title),
@@ -177,8 +207,8 @@ func (app *Application) createPendingMembership(clientID string, current *model.
 		// return err // No reason to fail if the main part succeeds
 	}
 
-	if group.CanJoinAutomatically && group.AuthmanEnabled {
-		err := app.authman.AddAuthmanMemberToGroup(*group.AuthmanGroup, member.ExternalID)
+	if joinAutomatically && group.AuthmanEnabled {
+		err := app.addAuthmanGroupMember(group, member.ExternalID)
 		if err != nil {
 			log.Printf("err app.createPendingMembership() - error storing member in Authman: %s", err)
 		}
@@ -205,10 +235,24 @@ func (app *Application) createMembership(clientID string, current *model.User, g
 		}
 	}
 
-	err := app.storage.CreateMembership(clientID, current, group, membership)
+	err := app.checkGroupBan(clientID, group.ID, membership.UserID, membership.ExternalID)
+	if err != nil {
+		return err
+	}
+
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.CreateMembership(context, clientID, current, group, membership)
+		if err != nil {
+			return err
+		}
+		return app.recordMembershipChanged(context, clientID, *membership, model.MembershipChangeCreated)
+	})
 	if err != nil {
 		return err
 	}
+	if membership.IsAdminOrMember() {
+		go app.welcomeMembers(clientID, group, []model.GroupMembership{*membership})
+	}
 
 	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
 		GroupIDs: []string{group.ID},
@@ -234,7 +278,7 @@ func (app *Application) createMembership(clientID string, current *model.User, g
 
 		if len(recipients) > 0 {
 			topic := "group.invitations"
-			app.notifications.SendNotification(
+			app.sendNotification(
 				recipients,
 				&topic,
 				fmt.Sprintf("%s - %s", groupStr, group.Title),
@@ -253,8 +297,8 @@ func (app *Application) createMembership(clientID string, current *model.User, g
 
 		}
 
-		if group.AuthmanEnabled && group.AuthmanGroup != nil {
-			err = app.authman.AddAuthmanMemberToGroup(*group.AuthmanGroup, membership.ExternalID)
+		if group.AuthmanEnabled {
+			err = app.addAuthmanGroupMember(group, membership.ExternalID)
 			if err != nil {
 				return err
 			}
@@ -266,7 +310,7 @@ func (app *Application) createMembership(clientID string, current *model.User, g
 	}
 	if err == nil && group != nil {
 		if group.CanJoinAutomatically && group.AuthmanEnabled {
-			err := app.authman.AddAuthmanMemberToGroup(*group.AuthmanGroup, current.ExternalID)
+			err := app.addAuthmanGroupMember(group, current.ExternalID)
 			if err != nil {
 				log.Printf("err app.createMembership() - error storing membership in Authman: %s", err)
 			}
@@ -277,7 +321,14 @@ func (app *Application) createMembership(clientID string, current *model.User, g
 }
 
 func (app *Application) deletePendingMembership(clientID string, current *model.User, groupID string) error {
-	err := app.storage.DeleteMembership(clientID, groupID, current.ID)
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.DeleteMembership(context, clientID, groupID, current.ID)
+		if err != nil {
+			return err
+		}
+		return app.recordMembershipChanged(context, clientID,
+			model.GroupMembership{GroupID: groupID, UserID: current.ID, NetID: current.NetID, Status: "pending"}, model.MembershipChangeDeleted)
+	})
 	if err != nil {
 		return err
 	}
@@ -285,7 +336,7 @@ func (app *Application) deletePendingMembership(clientID string, current *model.
 	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
 	if err == nil && group != nil {
 		if group.CanJoinAutomatically && group.AuthmanEnabled {
-			err := app.authman.RemoveAuthmanMemberFromGroup(*group.AuthmanGroup, current.ExternalID)
+			err := app.removeAuthmanGroupMember(group, current.ExternalID)
 			if err != nil {
 				log.Printf("err app.createPendingMembership() - error storing member in Authman: %s", err)
 			}
@@ -295,13 +346,32 @@ func (app *Application) deletePendingMembership(clientID string, current *model.
 	return nil
 }
 
-func (app *Application) deleteMembershipByID(clientID string, current *model.User, membershipID string) error {
+// deleteMembershipByID deletes the membership. The audit function, if provided, records the deletion within the deletion transaction.
+func (app *Application) deleteMembershipByID(clientID string, current *model.User, membershipID string, audit func(context storage.TransactionContext) error) error {
 
 	membership, _ := app.storage.FindGroupMembershipByID(clientID, membershipID)
 
 	if membership != nil {
+		if membership.IsAdmin() {
+			err := app.checkGroupKeepsAdmin(nil, clientID, membership.GroupID, nil, []string{membership.ID})
+			if err != nil {
+				return err
+			}
+		}
 
-		err := app.storage.DeleteMembershipByID(clientID, current, membership.ID)
+		err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+			err := app.storage.DeleteMembershipByID(context, clientID, current, membership.ID)
+			if err != nil {
+				return err
+			}
+			if audit != nil {
+				err = audit(context)
+				if err != nil {
+					return err
+				}
+			}
+			return app.recordMembershipChanged(context, clientID, *membership, model.MembershipChangeDeleted)
+		})
 		if err != nil {
 			return err
 		}
@@ -309,7 +379,7 @@ func (app *Application) deleteMembershipByID(clientID string, current *model.Use
 		if membership != nil {
 			group, _ := app.storage.FindGroup(nil, clientID, membership.GroupID, nil)
 			if group.CanJoinAutomatically && group.AuthmanEnabled && membership.ExternalID != "" {
-				err := app.authman.RemoveAuthmanMemberFromGroup(*group.AuthmanGroup, membership.ExternalID)
+				err := app.removeAuthmanGroupMember(group, membership.ExternalID)
 				if err != nil {
 					log.Printf("err app.deleteMembershipByID() - error storing member: %s", err)
 				}
@@ -320,7 +390,24 @@ func (app *Application) deleteMembershipByID(clientID string, current *model.Use
 }
 
 func (app *Application) deleteMembership(clientID string, current *model.User, groupID string) error {
-	err := app.storage.DeleteMembership(clientID, groupID, current.ID)
+	membership, err := app.storage.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		return err
+	}
+	if membership != nil && membership.IsAdmin() {
+		err = app.checkGroupKeepsAdmin(nil, clientID, groupID, nil, []string{membership.ID})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.DeleteMembership(context, clientID, groupID, current.ID)
+		if err != nil || membership == nil {
+			return err
+		}
+		return app.recordMembershipChanged(context, clientID, *membership, model.MembershipChangeDeleted)
+	})
 	if err != nil {
 		return err
 	}
@@ -328,7 +415,7 @@ func (app *Application) deleteMembership(clientID string, current *model.User, g
 	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
 	if err == nil && group != nil {
 		if group.CanJoinAutomatically && group.AuthmanEnabled {
-			err := app.authman.RemoveAuthmanMemberFromGroup(*group.AuthmanGroup, current.ExternalID)
+			err := app.removeAuthmanGroupMember(group, current.ExternalID)
 			if err != nil {
 				log.Printf("err app.createPendingMembership() - error storing member in Authman: %s", err)
 			}
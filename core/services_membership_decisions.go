@@ -0,0 +1,173 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"strings"
+)
+
+// applyMembershipDecisions approves or rejects the pending memberships of the group in one transaction. If any of the memberships
+// is not pending in the group none of the decisions is applied. Every decided user gets one notification.
+func (app *Application) applyMembershipDecisions(clientID string, current *model.User, group *model.Group, decisions []model.MembershipDecision) ([]model.GroupMembership, error) {
+	rejections := make([]*model.MembershipRejection, len(decisions))
+	for i, decision := range decisions {
+		if !decision.Approve {
+			rejection, err := app.resolveMembershipRejection(clientID, decision.RejectReasonCode, decision.RejectReasonNote)
+			if err != nil {
+				return nil, err
+			}
+			rejections[i] = rejection
+		}
+	}
+
+	var memberships []model.GroupMembership
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		memberships = nil
+		for i, decision := range decisions {
+			membership, err := app.storage.ApplyPendingMembershipDecision(context, clientID, group.ID, decision.MembershipID, decision.Approve, rejections[i])
+			if err != nil {
+				return fmt.Errorf("error applying the decision for membership %s: %s", decision.MembershipID, err)
+			}
+			if membership == nil {
+				return &model.PendingMembershipError{MembershipID: decision.MembershipID}
+			}
+			memberships = append(memberships, *membership)
+
+			action := model.AuditActionMembershipRejected
+			if membership.Status == "member" {
+				action = model.AuditActionMembershipApproved
+			}
+			err = app.recordAuditLog(context, clientID, current, group.ID, action, "membership", membership.ID,
+				map[string]model.AuditChange{
+					"status":             {New: membership.Status},
+					"reject_reason":      {New: membership.RejectReason},
+					"reject_reason_code": {New: membership.RejectReasonCode},
+				})
+			if err != nil {
+				return err
+			}
+		}
+		return app.storage.UpdateGroupStats(context, clientID, group.ID, false, true, false, true)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var approved []model.GroupMembership
+	rejected := map[string][]model.GroupMembership{}
+	var rejectionsOrder []string
+	for i := range memberships {
+		membership := memberships[i]
+		if membership.Status == "member" {
+			approved = append(approved, membership)
+			go app.publishWebhookEvent(clientID, model.WebhookEventMembershipApproved, group.ID, map[string]interface{}{
+				"group_id":      group.ID,
+				"membership_id": membership.ID,
+				"user_id":       membership.UserID,
+				"status":        membership.Status,
+			})
+			go app.publishResearchParticipantEnrolled(clientID, group, &membership)
+		} else {
+			if _, ok := rejected[membership.RejectReason]; !ok {
+				rejectionsOrder = append(rejectionsOrder, membership.RejectReason)
+			}
+			rejected[membership.RejectReason] = append(rejected[membership.RejectReason], membership)
+		}
+	}
+
+	if len(approved) > 0 {
+		go app.welcomeMembers(clientID, group, approved)
+	}
+	app.notifyMembershipDecisions(current, group, approved, rejected, rejectionsOrder)
+
+	if group.CanJoinAutomatically && group.AuthmanEnabled {
+		for _, membership := range approved {
+			if membership.ExternalID == "" {
+				continue
+			}
+			err := app.addAuthmanGroupMember(group, membership.ExternalID)
+			if err != nil {
+				log.Printf("err app.applyMembershipDecisions() - error storing member %s in Authman: %s", membership.ExternalID, err)
+			}
+		}
+	}
+
+	return memberships, nil
+}
+
+// notifyMembershipDecisions sends one notification to every decided user. The users with the same outcome are notified together.
+func (app *Application) notifyMembershipDecisions(current *model.User, group *model.Group, approved []model.GroupMembership,
+	rejected map[string][]model.GroupMembership, rejectionsOrder []string) {
+	topic := "group.invitations"
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	toRecipients := func(memberships []model.GroupMembership) []notifications.Recipient {
+		recipients := make([]notifications.Recipient, 0, len(memberships))
+		for _, membership := range memberships {
+			recipients = append(recipients, membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
+				(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute)))
+		}
+		return recipients
+	}
+
+	if len(approved) > 0 {
+		app.sendNotification(
+			toRecipients(approved),
+			&topic,
+			fmt.Sprintf("%s - %s", groupStr, group.Title),
+			fmt.Sprintf("Your membership in '%s' %s has been approved", group.Title, strings.ToLower(groupStr)),
+			map[string]string{
+				"type":        "group",
+				"operation":   "membership_approve",
+				"entity_type": "group",
+				"entity_id":   group.ID,
+				"entity_name": group.Title,
+			},
+			current.AppID,
+			current.OrgID,
+			nil,
+		)
+	}
+
+	for _, reason := range rejectionsOrder {
+		memberships := rejected[reason]
+		app.sendNotification(
+			toRecipients(memberships),
+			&topic,
+			fmt.Sprintf("%s - %s", groupStr, group.Title),
+			fmt.Sprintf("Your membership in '%s' %s has been rejected with a reason: %s", group.Title, strings.ToLower(groupStr), reason),
+			map[string]string{
+				"type":               "group",
+				"operation":          "membership_reject",
+				"entity_type":        "group",
+				"entity_id":          group.ID,
+				"entity_name":        group.Title,
+				"reject_reason":      reason,
+				"reject_reason_code": memberships[0].RejectReasonCode,
+				"reject_reason_note": memberships[0].RejectReasonNote,
+			},
+			current.AppID,
+			current.OrgID,
+			nil,
+		)
+	}
+}
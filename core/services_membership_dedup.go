@@ -0,0 +1,113 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+)
+
+func (app *Application) startMembershipDedupTask() {
+	_, err := app.scheduler.AddFunc("45 3 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			_, err := app.deduplicateMemberships(clientID, false)
+			if err != nil {
+				log.Printf("error deduplicating memberships for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running membership dedup task: %s", err)
+	}
+	log.Printf("successful running of membership dedup task")
+}
+
+// deduplicateMemberships merges the memberships of the same user within the same group created by concurrent requests.
+// The merged membership keeps the highest status and the earliest join date. On dry run the duplicates are only counted.
+func (app *Application) deduplicateMemberships(clientID string, dryRun bool) (*model.MembershipDedupResult, error) {
+	duplicates, err := app.storage.FindDuplicateMemberships(nil, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("error finding the duplicate memberships: %s", err)
+	}
+
+	result := model.MembershipDedupResult{ClientID: clientID, DryRun: dryRun, DuplicateSets: len(duplicates)}
+	if dryRun {
+		return &result, nil
+	}
+	if len(duplicates) == 0 {
+		app.applyUniqueMembershipIndex()
+		return &result, nil
+	}
+
+	acquired, releaseLock, err := app.acquireLock("membership_dedup_"+clientID, membershipDedupLockLease)
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring the membership dedup lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("membership dedup for clientID %s is running on another instance", clientID)
+		return &result, nil
+	}
+	defer releaseLock()
+
+	log.Printf("deduplicateMemberships: BEGIN for clientID %s - %d duplicate sets", clientID, len(duplicates))
+
+	repairedGroups := map[string]bool{}
+	for _, duplicate := range duplicates {
+		var merged int64
+		err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+			var err error
+			merged, err = app.storage.MergeDuplicateMemberships(context, clientID, duplicate.GroupID, duplicate.UserID)
+			if err != nil || merged == 0 {
+				return err
+			}
+
+			return app.recordAuditLog(context, clientID, nil, duplicate.GroupID, model.AuditActionMembershipsMerged, "membership", duplicate.UserID,
+				map[string]model.AuditChange{
+					"membership_ids": {Old: duplicate.MembershipIDs},
+					"merged_count":   {New: merged},
+				})
+		})
+		if err != nil {
+			log.Printf("deduplicateMemberships: error merging the memberships of user %s within group %s: %s", duplicate.UserID, duplicate.GroupID, err)
+			result.Failed++
+			continue
+		}
+		if merged == 0 {
+			continue
+		}
+		result.MembershipsMerged += merged
+		repairedGroups[duplicate.GroupID] = true
+	}
+	result.GroupsRepaired = len(repairedGroups)
+
+	log.Printf("deduplicateMemberships: END for clientID %s - %d memberships merged, %d groups repaired, %d failed",
+		clientID, result.MembershipsMerged, result.GroupsRepaired, result.Failed)
+
+	if result.Failed == 0 {
+		app.applyUniqueMembershipIndex()
+	}
+	return &result, nil
+}
+
+// applyUniqueMembershipIndex makes the membership index unique after the dedup pass. The index is kept as it is while the duplicate
+// memberships of any client remain.
+func (app *Application) applyUniqueMembershipIndex() {
+	err := app.storage.ApplyUniqueMembershipIndex()
+	if err != nil {
+		log.Printf("error applying the unique membership index: %s", err)
+	}
+}
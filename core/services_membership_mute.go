@@ -0,0 +1,91 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+	"time"
+)
+
+func (app *Application) muteMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error) {
+	mutedUntil := time.Now().UTC().Add(duration)
+	err := app.storage.UpdateMembershipMutedUntil(nil, clientID, membershipID, &mutedUntil)
+	if err != nil {
+		return nil, err
+	}
+	return &mutedUntil, nil
+}
+
+func (app *Application) unmuteMembership(clientID string, membershipID string) error {
+	return app.storage.UpdateMembershipMutedUntil(nil, clientID, membershipID, nil)
+}
+
+// snoozeMembership snoozes the immediate notifications of the group without changing the mute and the notification preferences
+func (app *Application) snoozeMembership(clientID string, membershipID string, duration time.Duration) (*time.Time, error) {
+	snoozedUntil := time.Now().UTC().Add(duration)
+	err := app.storage.UpdateMembershipSnoozedUntil(nil, clientID, membershipID, &snoozedUntil)
+	if err != nil {
+		return nil, err
+	}
+	return &snoozedUntil, nil
+}
+
+func (app *Application) unsnoozeMembership(clientID string, membershipID string) error {
+	return app.storage.UpdateMembershipSnoozedUntil(nil, clientID, membershipID, nil)
+}
+
+// applyMembershipMutes marks as muted the recipients who have temporarily muted or snoozed the group of the notification. The posts and the events
+// are muted for the recipients in the digest mode too as they get them within the digests. The digests themselves are not group notifications,
+// so they are delivered during the snooze.
+// The mutes expire on their own as only the memberships muted at the moment are matched. Failures are only logged.
+func (app *Application) applyMembershipMutes(recipients []notifications.Recipient, data map[string]string) []notifications.Recipient {
+	if len(recipients) == 0 || data == nil || data["entity_type"] != "group" || data["entity_id"] == "" {
+		return recipients
+	}
+
+	userIDs := make([]string, 0, len(recipients))
+	for _, recipient := range recipients {
+		if !recipient.Mute && recipient.UserID != "" {
+			userIDs = append(userIDs, recipient.UserID)
+		}
+	}
+	if len(userIDs) == 0 {
+		return recipients
+	}
+
+	mutedUserIDs, err := app.storage.FindMutedMemberUserIDs(nil, data["entity_id"], userIDs, model.IsDigestOperation(data["operation"]))
+	if err != nil {
+		log.Printf("app.applyMembershipMutes() error finding muted members of %s: %s", data["entity_id"], err)
+		return recipients
+	}
+	if len(mutedUserIDs) == 0 {
+		return recipients
+	}
+
+	muted := make(map[string]bool, len(mutedUserIDs))
+	for _, userID := range mutedUserIDs {
+		muted[userID] = true
+	}
+	result := make([]notifications.Recipient, len(recipients))
+	for i, recipient := range recipients {
+		if muted[recipient.UserID] {
+			recipient.Mute = true
+		}
+		result[i] = recipient
+	}
+	return result
+}
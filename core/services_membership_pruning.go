@@ -0,0 +1,228 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+// membershipPruningBatchSize is the number of memberships processed per Core BB last login request
+const membershipPruningBatchSize = 200
+
+func (app *Application) startMembershipPruningTask() {
+	_, err := app.scheduler.AddFunc("30 2 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.processMembershipPruning(clientID)
+			if err != nil {
+				log.Printf("error processing membership pruning for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running membership pruning task: %s", err)
+	}
+	log.Printf("successful running of membership pruning task")
+}
+
+func (app *Application) getMembershipPruningConfig(clientID string) (*model.MembershipPruningConfig, error) {
+	return app.storage.FindMembershipPruningConfig(nil, clientID)
+}
+
+func (app *Application) updateMembershipPruningConfig(config model.MembershipPruningConfig) error {
+	return app.storage.SaveMembershipPruningConfig(nil, config)
+}
+
+// processMembershipPruning flags the memberships of the users who have not logged in for the configured period, notifies them
+// and removes the flagged memberships once the grace period passes. The users who logged in again are unflagged.
+func (app *Application) processMembershipPruning(clientID string) error {
+	config, err := app.storage.FindMembershipPruningConfig(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the membership pruning config: %s", err)
+	}
+	if config == nil || !config.Enabled || config.InactivityDays <= 0 {
+		return nil
+	}
+
+	acquired, releaseLock, err := app.acquireLock("membership_pruning_"+clientID, membershipPruningLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the membership pruning lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("membership pruning for clientID %s is running on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	log.Printf("processMembershipPruning: BEGIN for clientID %s", clientID)
+
+	// the Authman and the smart group memberships are managed by their syncs
+	authmanGroups, err := app.storage.FindAuthmanGroups(clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the Authman groups: %s", err)
+	}
+	managedGroupIDs := map[string]bool{}
+	for _, group := range authmanGroups {
+		managedGroupIDs[group.ID] = true
+	}
+	smartGroups, err := app.storage.FindSmartGroups(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the smart groups: %s", err)
+	}
+	for _, group := range smartGroups {
+		managedGroupIDs[group.ID] = true
+	}
+
+	now := time.Now()
+	staleBefore := now.AddDate(0, 0, -config.InactivityDays)
+	removeFlaggedBefore := now.AddDate(0, 0, -config.GracePeriodDays)
+
+	var flaggedCount, unflaggedCount, removedCount int64
+	afterID := ""
+	for {
+		memberships, err := app.storage.FindMembershipPruningCandidates(nil, clientID, afterID, membershipPruningBatchSize)
+		if err != nil {
+			return fmt.Errorf("error loading memberships: %s", err)
+		}
+		if len(memberships) == 0 {
+			break
+		}
+		afterID = memberships[len(memberships)-1].ID
+
+		lastLogins, err := app.findLastLoginDates(memberships)
+		if err != nil {
+			log.Printf("processMembershipPruning: error loading the last login dates for clientID %s: %s", clientID, err)
+			continue
+		}
+
+		var flagIDs, unflagIDs []string
+		flaggedUserIDs := map[string]int{}
+		removeIDs := map[string][]string{}
+		for _, membership := range memberships {
+			lastLogin := lastLogins[membership.UserID]
+			if managedGroupIDs[membership.GroupID] || lastLogin == nil {
+				continue // unknown users are never pruned
+			}
+
+			if !lastLogin.Before(staleBefore) {
+				if membership.DateStaleFlagged != nil {
+					unflagIDs = append(unflagIDs, membership.ID)
+				}
+			} else if membership.DateStaleFlagged == nil {
+				flagIDs = append(flagIDs, membership.ID)
+				flaggedUserIDs[membership.UserID]++
+			} else if config.AutoRemove && membership.DateStaleFlagged.Before(removeFlaggedBefore) {
+				removeIDs[membership.GroupID] = append(removeIDs[membership.GroupID], membership.ID)
+			}
+		}
+
+		err = app.storage.UpdateMembershipsStaleFlag(nil, clientID, flagIDs, &now)
+		if err != nil {
+			log.Printf("processMembershipPruning: error flagging %d memberships: %s", len(flagIDs), err)
+		} else {
+			flaggedCount += int64(len(flagIDs))
+			app.notifyStaleMembers(config, flaggedUserIDs)
+		}
+
+		err = app.storage.UpdateMembershipsStaleFlag(nil, clientID, unflagIDs, nil)
+		if err != nil {
+			log.Printf("processMembershipPruning: error unflagging %d memberships: %s", len(unflagIDs), err)
+		} else {
+			unflaggedCount += int64(len(unflagIDs))
+		}
+
+		for groupID, ids := range removeIDs {
+			var deleted int64
+			err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+				var err error
+				deleted, err = app.storage.DeleteStaleGroupMemberships(context, clientID, groupID, ids)
+				if err != nil || deleted == 0 {
+					return err
+				}
+
+				return app.recordAuditLog(context, clientID, nil, groupID, model.AuditActionMembershipsPruned, "membership", groupID,
+					map[string]model.AuditChange{
+						"membership_ids": {Old: ids},
+						"removed_count":  {New: deleted},
+					})
+			})
+			if err != nil {
+				log.Printf("processMembershipPruning: error removing stale memberships of group %s: %s", groupID, err)
+				continue
+			}
+			removedCount += deleted
+		}
+	}
+
+	log.Printf("processMembershipPruning: END for clientID %s - %d flagged, %d unflagged, %d removed", clientID, flaggedCount, unflaggedCount, removedCount)
+	return nil
+}
+
+// findLastLoginDates gives the Core BB last login dates of the membership users mapped by user ID
+func (app *Application) findLastLoginDates(memberships []model.GroupMembership) (map[string]*time.Time, error) {
+	var userIDs []string
+	exists := map[string]bool{}
+	for _, membership := range memberships {
+		if !exists[membership.UserID] {
+			exists[membership.UserID] = true
+			userIDs = append(userIDs, membership.UserID)
+		}
+	}
+
+	limit := len(userIDs)
+	accounts, err := app.corebb.GetAccountsWithIDs(userIDs, nil, nil, &limit, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	lastLogins := map[string]*time.Time{}
+	for _, account := range accounts {
+		lastLogins[account.ID] = account.LastLoginDate
+	}
+	return lastLogins, nil
+}
+
+// notifyStaleMembers notifies the users whose memberships have been flagged as stale
+func (app *Application) notifyStaleMembers(config *model.MembershipPruningConfig, flaggedUserIDs map[string]int) {
+	message := "You have not used the app for a while. Open it to keep your group memberships."
+	if config.AutoRemove {
+		message = fmt.Sprintf("You have not used the app for a while. Open it within %d days to keep your group memberships.", config.GracePeriodDays)
+	}
+
+	topic := "group.memberships"
+	for userID, count := range flaggedUserIDs {
+		err := app.sendNotification(
+			[]notifications.Recipient{{UserID: userID}},
+			&topic,
+			"Inactive group memberships",
+			message,
+			map[string]string{
+				"type":      "group",
+				"operation": "memberships_stale",
+				"count":     fmt.Sprintf("%d", count),
+			},
+			app.config.AppID,
+			app.config.OrgID,
+			nil,
+		)
+		if err != nil {
+			log.Printf("error notifying stale member %s: %s", userID, err)
+		}
+	}
+}
@@ -0,0 +1,233 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"groups/utils"
+	"log"
+	"strings"
+	"time"
+)
+
+// membershipTransitionBatchSize is the number of the due status changes applied at once
+const membershipTransitionBatchSize = 100
+
+func (app *Application) startMembershipTransitionTask() {
+	_, err := app.scheduler.AddFunc("*/15 * * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.processMembershipTransitions(clientID)
+			if err != nil {
+				log.Printf("error processing membership transitions for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running membership transition task: %s", err)
+	}
+	log.Printf("successful running of membership transition task")
+}
+
+// scheduleMembershipTransition schedules the status change of a member or an admin, i.e. at the end of an officer term. Only the group
+// admins could schedule it. A membership has at most one scheduled change, the new one replaces the previous. Gives nil if the membership
+// does not exist.
+func (app *Application) scheduleMembershipTransition(clientID string, current *model.User, membershipID string, status string,
+	dateEffective time.Time) (*model.GroupMembership, error) {
+	if status != "admin" && status != "member" && status != model.MembershipTransitionRemoved {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("invalid status %s", status))
+	}
+	now := time.Now().UTC()
+	if !dateEffective.After(now) {
+		return nil, utils.NewBadRequestError("the effective date must be in the future")
+	}
+
+	membership, err := app.loadTransitionMembership(clientID, current, membershipID)
+	if err != nil || membership == nil {
+		return nil, err
+	}
+	if !membership.IsAdminOrMember() {
+		return nil, utils.NewBadRequestError("only the status of the members and the admins could be scheduled")
+	}
+	if membership.Status == status {
+		return nil, utils.NewBadRequestError(fmt.Sprintf("the membership status is %s already", status))
+	}
+
+	transition := model.MembershipTransition{Status: status, DateEffective: dateEffective.UTC(), ScheduledBy: current.ID, DateScheduled: now}
+	updated := false
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		updated, err = app.storage.UpdateMembershipTransition(context, clientID, membership.GroupID, membershipID, &transition)
+		if err != nil || !updated {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, membership.GroupID, model.AuditActionMembershipUpdated, "membership", membershipID,
+			map[string]model.AuditChange{"scheduled_transition": {Old: membership.ScheduledTransition, New: transition}})
+	})
+	if err != nil || !updated {
+		return nil, err
+	}
+
+	membership.ScheduledTransition = &transition
+	return membership, nil
+}
+
+// cancelMembershipTransition cancels the scheduled status change of the membership. Only the group admins could cancel it.
+func (app *Application) cancelMembershipTransition(clientID string, current *model.User, membershipID string) error {
+	membership, err := app.loadTransitionMembership(clientID, current, membershipID)
+	if err != nil || membership == nil || membership.ScheduledTransition == nil {
+		return err
+	}
+
+	return app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		_, err := app.storage.UpdateMembershipTransition(context, clientID, membership.GroupID, membershipID, nil)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, membership.GroupID, model.AuditActionMembershipUpdated, "membership", membershipID,
+			map[string]model.AuditChange{"scheduled_transition": {Old: membership.ScheduledTransition}})
+	})
+}
+
+// loadTransitionMembership loads the membership after checking the current user is an admin of its group
+func (app *Application) loadTransitionMembership(clientID string, current *model.User, membershipID string) (*model.GroupMembership, error) {
+	membership, err := app.storage.FindGroupMembershipByID(clientID, membershipID)
+	if err != nil || membership == nil {
+		return nil, err
+	}
+
+	currentMembership, err := app.storage.FindGroupMembership(clientID, membership.GroupID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if currentMembership == nil || !currentMembership.IsAdmin() {
+		return nil, utils.NewForbiddenError()
+	}
+	return membership, nil
+}
+
+// processMembershipTransitions applies the scheduled status changes which took effect and notifies the members. The change which would
+// leave the group without an admin is dropped.
+func (app *Application) processMembershipTransitions(clientID string) error {
+	acquired, releaseLock, err := app.acquireLock("membership_transitions_"+clientID, membershipTransitionLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the membership transition lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("membership transitions for clientID %s are applied on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	var appliedCount int
+	now := time.Now().UTC()
+	for {
+		memberships, err := app.storage.FindDueMembershipTransitions(nil, clientID, now, membershipTransitionBatchSize)
+		if err != nil {
+			return fmt.Errorf("error loading the due membership transitions: %s", err)
+		}
+		if len(memberships) == 0 {
+			break
+		}
+
+		for _, membership := range memberships {
+			// the claimed transitions are not found again
+			claimed, err := app.storage.ClaimMembershipTransition(nil, clientID, membership.ID, membership.ScheduledTransition.DateEffective)
+			if err != nil {
+				return fmt.Errorf("error claiming the transition of membership %s: %s", membership.ID, err)
+			}
+			if !claimed {
+				continue
+			}
+
+			if app.applyMembershipTransition(clientID, membership) {
+				appliedCount++
+			}
+		}
+	}
+
+	if appliedCount > 0 {
+		log.Printf("processMembershipTransitions: %d membership transitions applied for clientID %s", appliedCount, clientID)
+	}
+	return nil
+}
+
+func (app *Application) applyMembershipTransition(clientID string, membership model.GroupMembership) bool {
+	transition := membership.ScheduledTransition
+	var err error
+	if transition.Status == model.MembershipTransitionRemoved {
+		err = app.deleteMembershipByID(clientID, nil, membership.ID, func(context storage.TransactionContext) error {
+			return app.recordAuditLog(context, clientID, nil, membership.GroupID, model.AuditActionMembershipsDeleted, "membership", membership.ID,
+				map[string]model.AuditChange{"status": {Old: membership.Status, New: transition.Status}})
+		})
+	} else {
+		err = app.updateMembership(clientID, nil, membership.ID, &transition.Status, nil, nil, nil, nil, nil)
+	}
+	if err != nil {
+		log.Printf("applyMembershipTransition: error applying the transition of membership %s to %s: %s", membership.ID, transition.Status, err)
+		return false
+	}
+
+	group, err := app.storage.FindGroup(nil, clientID, membership.GroupID, nil)
+	if err != nil || group == nil {
+		log.Printf("applyMembershipTransition: unable to load group %s for the notification: %v", membership.GroupID, err)
+		return true
+	}
+	app.notifyMembershipTransition(group, membership, transition.Status)
+	return true
+}
+
+// notifyMembershipTransition lets the member know the scheduled status change took effect
+func (app *Application) notifyMembershipTransition(group *model.Group, membership model.GroupMembership, status string) {
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+
+	message := fmt.Sprintf("You are now a member of '%s' %s", group.Title, strings.ToLower(groupStr))
+	switch status {
+	case "admin":
+		message = fmt.Sprintf("You are now an admin of '%s' %s", group.Title, strings.ToLower(groupStr))
+	case model.MembershipTransitionRemoved:
+		message = fmt.Sprintf("Your membership of '%s' %s has ended", group.Title, strings.ToLower(groupStr))
+	}
+
+	topic := "group.invitations"
+	err := app.sendNotification(
+		[]notifications.Recipient{membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
+			(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute))},
+		&topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		message,
+		map[string]string{
+			"type":        "group",
+			"operation":   "membership_transition",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"status":      status,
+		},
+		app.config.AppID,
+		app.config.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error notifying the membership transition of group %s: %s", group.ID, err)
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+)
+
+// resolvePostMentions degrades the mentions of the users who are not members of the group or opted out of being mentioned to plain text
+func (app *Application) resolvePostMentions(clientID string, groupID string, post *model.Post) error {
+	userIDs := model.ParseMentions(post.Subject + "\n" + post.Body)
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{groupID},
+		UserIDs:  userIDs,
+		Statuses: []string{"member", "admin"},
+	})
+	if err != nil {
+		return err
+	}
+
+	mentionable := map[string]bool{}
+	for _, membership := range memberships.Items {
+		if membership.IsMentionable() {
+			mentionable[membership.UserID] = true
+		}
+	}
+	isMentionable := func(userID string) bool {
+		return mentionable[userID]
+	}
+	post.Subject = model.DegradeMentions(post.Subject, isMentionable)
+	post.Body = model.DegradeMentions(post.Body, isMentionable)
+	return nil
+}
+
+func (app *Application) getPickerMembers(clientID string, current *model.User, groupID string, name *string, limit *int64) ([]model.PickerMember, error) {
+	searchable := true
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs:   []string{groupID},
+		Name:       name,
+		Statuses:   []string{"member", "admin"},
+		Searchable: &searchable,
+		Limit:      limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	members := []model.PickerMember{}
+	for _, membership := range memberships.Items {
+		if membership.UserID == current.ID {
+			continue
+		}
+		members = append(members, membership.ToPickerMember())
+	}
+	return members, nil
+}
@@ -0,0 +1,117 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+)
+
+func (app *Application) startOrgUnitSyncTask() {
+	if app.orgHierarchy == nil {
+		log.Printf("org hierarchy is not configured, the org unit sync task is not scheduled")
+		return
+	}
+
+	_, err := app.scheduler.AddFunc("30 3 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.synchronizeOrgUnits(clientID)
+			if err != nil {
+				log.Printf("error syncing org units for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running org unit sync task: %s", err)
+	}
+	log.Printf("successful running of org unit sync task")
+}
+
+// updateGroupOrgUnit links the group to the org unit after checking the code with the hierarchy. Nil code unlinks the group.
+func (app *Application) updateGroupOrgUnit(clientID string, current *model.User, group *model.Group, code *string) (*model.GroupOrgUnit, error) {
+	var orgUnit *model.GroupOrgUnit
+	if code != nil {
+		if app.orgHierarchy == nil {
+			return nil, errors.New("org hierarchy is not configured")
+		}
+		unit, err := app.orgHierarchy.GetOrgUnit(*code)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the org unit %s: %s", *code, err)
+		}
+		if unit == nil {
+			return nil, &model.OrgUnitNotFoundError{Code: *code}
+		}
+		orgUnit = model.NewGroupOrgUnit(*unit)
+	}
+
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		err := app.storage.UpdateGroupOrgUnit(context, clientID, group.ID, orgUnit)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, group.ID, model.AuditActionGroupUpdated, "group", group.ID,
+			map[string]model.AuditChange{"org_unit": {Old: group.OrgUnit, New: orgUnit}})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return orgUnit, nil
+}
+
+// synchronizeOrgUnits refreshes the names and the paths of the org units linked to the groups of the client. The groups of the units
+// removed from the hierarchy keep the last known details, so the admins could relink them.
+func (app *Application) synchronizeOrgUnits(clientID string) error {
+	// only one instance runs the sync
+	acquired, releaseLock, err := app.acquireLock("org_unit_sync_"+clientID, orgUnitSyncLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the org unit sync lock for clientID %s: %s", clientID, err)
+	}
+	if !acquired {
+		log.Printf("org unit sync for clientID %s is running on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	codes, err := app.storage.FindGroupOrgUnitCodes(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the org unit codes: %s", err)
+	}
+
+	log.Printf("Org unit synchronization of %d units started for clientID: %s", len(codes), clientID)
+	for _, code := range codes {
+		unit, err := app.orgHierarchy.GetOrgUnit(code)
+		if err != nil {
+			log.Printf("error app.synchronizeOrgUnits() loading the org unit %s - %s", code, err)
+			continue
+		}
+		if unit == nil {
+			log.Printf("app.synchronizeOrgUnits() org unit %s is not in the hierarchy any more", code)
+			continue
+		}
+
+		updated, err := app.storage.UpdateGroupsOrgUnit(nil, clientID, *model.NewGroupOrgUnit(*unit))
+		if err != nil {
+			log.Printf("error app.synchronizeOrgUnits() updating the groups of the org unit %s - %s", code, err)
+			continue
+		}
+		log.Printf("app.synchronizeOrgUnits() updated %d groups of the org unit %s", updated, code)
+	}
+	log.Printf("Org unit synchronization finished for clientID: %s", clientID)
+	return nil
+}
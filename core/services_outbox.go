@@ -0,0 +1,158 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// outboxMaxAttempts is the number of publish attempts before the event is marked as failed
+	outboxMaxAttempts = 8
+	// outboxRetryBaseDelay is the delay before the first retry. It doubles on every next attempt.
+	outboxRetryBaseDelay = time.Minute
+	// outboxDispatchBatchSize is the max number of events published on a single dispatch tick
+	outboxDispatchBatchSize int64 = 500
+)
+
+func (app *Application) startOutboxDispatchTask() {
+	if app.messageBus == nil {
+		log.Printf("message bus is not configured, the outbox dispatch task is not scheduled")
+		return
+	}
+
+	_, err := app.scheduler.AddFunc("* * * * *", func() {
+		err := app.dispatchOutboxEvents()
+		if err != nil {
+			log.Printf("error dispatching the outbox events: %s", err)
+		}
+	})
+	if err != nil {
+		log.Printf("error on running outbox dispatch task: %s", err)
+	}
+	log.Printf("successful running of outbox dispatch task")
+}
+
+// recordDomainEvent stores the event in the outbox. Pass the transaction context of the operation which emits the event, so the event is
+// published only if the operation is committed. Nothing is stored if the message bus is not configured.
+func (app *Application) recordDomainEvent(context storage.TransactionContext, clientID string, eventType string, groupID string, data map[string]interface{}) error {
+	if app.messageBus == nil {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	id := uuid.NewString()
+	event := model.OutboxEvent{
+		ID: id,
+		Event: model.DomainEvent{
+			ID:        id,
+			Type:      eventType,
+			ClientID:  clientID,
+			GroupID:   groupID,
+			Data:      data,
+			Timestamp: now,
+		},
+		Status:      model.OutboxEventStatusPending,
+		NextAttempt: &now,
+		DateCreated: now,
+	}
+	err := app.storage.InsertOutboxEvent(context, event)
+	if err != nil {
+		return fmt.Errorf("error storing the %s outbox event: %s", eventType, err)
+	}
+	return nil
+}
+
+// recordMembershipChanged stores the membership.changed event of a single membership
+func (app *Application) recordMembershipChanged(context storage.TransactionContext, clientID string, membership model.GroupMembership, change string) error {
+	return app.recordDomainEvent(context, clientID, model.DomainEventMembershipChanged, membership.GroupID, map[string]interface{}{
+		"group_id":      membership.GroupID,
+		"membership_id": membership.ID,
+		"user_id":       membership.UserID,
+		"net_id":        membership.NetID,
+		"status":        membership.Status,
+		"change":        change,
+	})
+}
+
+// dispatchOutboxEvents publishes the due outbox events, the oldest first. Only one instance dispatches the events, so they are not
+// published twice by concurrent instances. The consumers should still expect the events to be delivered at least once.
+func (app *Application) dispatchOutboxEvents() error {
+	acquired, releaseLock, err := app.acquireLock("outbox_dispatch", outboxDispatchLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the outbox dispatch lock: %s", err)
+	}
+	if !acquired {
+		return nil
+	}
+	defer releaseLock()
+
+	events, err := app.storage.FindPendingOutboxEvents(nil, outboxDispatchBatchSize)
+	if err != nil {
+		return fmt.Errorf("error loading the pending outbox events: %s", err)
+	}
+
+	var published int
+	for _, event := range events {
+		if app.publishOutboxEvent(event) {
+			published++
+		}
+	}
+	if len(events) > 0 {
+		log.Printf("app.dispatchOutboxEvents() published %d of %d outbox events", published, len(events))
+	}
+	return nil
+}
+
+func (app *Application) publishOutboxEvent(event model.OutboxEvent) bool {
+	now := time.Now().UTC()
+	event.DateUpdated = &now
+	event.Attempts++
+
+	payload, err := json.Marshal(event.Event)
+	if err == nil {
+		err = app.messageBus.Publish(event.Event, payload)
+	}
+
+	if err == nil {
+		event.Status = model.OutboxEventStatusPublished
+		event.LastError = nil
+		event.NextAttempt = nil
+	} else {
+		errMsg := err.Error()
+		event.LastError = &errMsg
+		if event.Attempts >= outboxMaxAttempts {
+			log.Printf("app.publishOutboxEvent() giving up the %s event %s after %d attempts: %s", event.Event.Type, event.ID, event.Attempts, err)
+			event.Status = model.OutboxEventStatusFailed
+			event.NextAttempt = nil
+		} else {
+			nextAttempt := now.Add(outboxRetryBaseDelay * time.Duration(1<<(event.Attempts-1)))
+			event.NextAttempt = &nextAttempt
+		}
+	}
+
+	updateErr := app.storage.UpdateOutboxEvent(nil, event)
+	if updateErr != nil {
+		log.Printf("app.publishOutboxEvent() error updating the outbox event %s: %s", event.ID, updateErr)
+	}
+	return err == nil
+}
@@ -0,0 +1,267 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"strings"
+	"time"
+)
+
+// pendingMembershipBatchSize is the number of expired pending memberships processed at once
+const pendingMembershipBatchSize = 200
+
+func (app *Application) startPendingMembershipTask() {
+	_, err := app.scheduler.AddFunc("0 14 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.processPendingMemberships(clientID)
+			if err != nil {
+				log.Printf("error processing pending memberships for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running pending membership task: %s", err)
+	}
+	log.Printf("successful running of pending membership task")
+}
+
+func (app *Application) getPendingMembershipConfig(clientID string) (*model.PendingMembershipConfig, error) {
+	return app.storage.FindPendingMembershipConfig(nil, clientID)
+}
+
+func (app *Application) updatePendingMembershipConfig(config model.PendingMembershipConfig) error {
+	return app.storage.SavePendingMembershipConfig(nil, config)
+}
+
+// processPendingMemberships rejects the membership requests pending for longer than the expiration period and reminds the group admins
+// about the requests pending for longer than the reminder period
+func (app *Application) processPendingMemberships(clientID string) error {
+	config, err := app.storage.FindPendingMembershipConfig(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the pending membership config: %s", err)
+	}
+	if config == nil || (config.ExpirationDays <= 0 && config.ReminderDays <= 0) {
+		return nil
+	}
+
+	acquired, releaseLock, err := app.acquireLock("pending_memberships_"+clientID, pendingMembershipLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the pending membership lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("pending membership processing for clientID %s is running on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	log.Printf("processPendingMemberships: BEGIN for clientID %s", clientID)
+	now := time.Now()
+	if config.ExpirationDays > 0 {
+		app.expirePendingMemberships(clientID, now.AddDate(0, 0, -config.ExpirationDays))
+	}
+	if config.ReminderDays > 0 {
+		app.remindPendingMemberships(clientID, now, config.ReminderDays)
+	}
+	log.Printf("processPendingMemberships: END for clientID %s", clientID)
+	return nil
+}
+
+// expirePendingMemberships rejects the pending memberships created before the date and notifies the users
+func (app *Application) expirePendingMemberships(clientID string, createdBefore time.Time) {
+	rejection := &model.MembershipRejection{Reason: model.ExpiredMembershipRejectReason}
+
+	var expiredCount int
+	afterID := ""
+	for {
+		memberships, err := app.storage.FindExpiredPendingMemberships(nil, clientID, createdBefore, afterID, pendingMembershipBatchSize)
+		if err != nil {
+			log.Printf("expirePendingMemberships: error loading the expired memberships for clientID %s: %s", clientID, err)
+			return
+		}
+		if len(memberships) == 0 {
+			break
+		}
+		afterID = memberships[len(memberships)-1].ID
+
+		groupMemberships := map[string][]model.GroupMembership{}
+		for _, membership := range memberships {
+			groupMemberships[membership.GroupID] = append(groupMemberships[membership.GroupID], membership)
+		}
+
+		for groupID, pending := range groupMemberships {
+			var expired []model.GroupMembership
+			err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+				expired = nil
+				for _, membership := range pending {
+					rejected, err := app.storage.ApplyPendingMembershipDecision(context, clientID, groupID, membership.ID, false, rejection)
+					if err != nil {
+						return err
+					}
+					// decided by an admin in the meantime
+					if rejected == nil {
+						continue
+					}
+					expired = append(expired, *rejected)
+
+					err = app.recordAuditLog(context, clientID, nil, groupID, model.AuditActionMembershipExpired, "membership", rejected.ID,
+						map[string]model.AuditChange{
+							"status":        {Old: "pending", New: rejected.Status},
+							"reject_reason": {New: rejected.RejectReason},
+						})
+					if err != nil {
+						return err
+					}
+				}
+				return app.storage.UpdateGroupStats(context, clientID, groupID, false, true, false, true)
+			})
+			if err != nil {
+				log.Printf("expirePendingMemberships: error expiring the pending memberships of group %s: %s", groupID, err)
+				continue
+			}
+			if len(expired) == 0 {
+				continue
+			}
+			expiredCount += len(expired)
+
+			group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+			if err != nil || group == nil {
+				log.Printf("expirePendingMemberships: unable to load group %s for the notification: %v", groupID, err)
+				continue
+			}
+			app.notifyExpiredMemberships(group, expired)
+		}
+	}
+
+	log.Printf("expirePendingMemberships: %d expired memberships rejected for clientID %s", expiredCount, clientID)
+}
+
+// notifyExpiredMemberships lets the users know their membership requests expired
+func (app *Application) notifyExpiredMemberships(group *model.Group, memberships []model.GroupMembership) {
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+
+	recipients := make([]notifications.Recipient, 0, len(memberships))
+	for _, membership := range memberships {
+		recipients = append(recipients, membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
+			(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute)))
+	}
+
+	topic := "group.invitations"
+	err := app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		fmt.Sprintf("Your membership request for '%s' %s expired without a decision. You could request the membership again.", group.Title, strings.ToLower(groupStr)),
+		map[string]string{
+			"type":          "group",
+			"operation":     "membership_reject",
+			"entity_type":   "group",
+			"entity_id":     group.ID,
+			"entity_name":   group.Title,
+			"reject_reason": model.ExpiredMembershipRejectReason,
+		},
+		app.config.AppID,
+		app.config.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error notifying the expired members of group %s: %s", group.ID, err)
+	}
+}
+
+// remindPendingMemberships reminds the admins of the groups with requests pending for longer than the reminder period, at most once per period
+func (app *Application) remindPendingMemberships(clientID string, now time.Time, reminderDays int) {
+	remindBefore := now.AddDate(0, 0, -reminderDays)
+	summaries, err := app.storage.FindPendingMembershipsSummaries(nil, clientID, remindBefore)
+	if err != nil {
+		log.Printf("remindPendingMemberships: error loading the pending memberships for clientID %s: %s", clientID, err)
+		return
+	}
+
+	var remindedCount int
+	for _, summary := range summaries {
+		group, err := app.storage.FindGroup(nil, clientID, summary.GroupID, nil)
+		if err != nil || group == nil {
+			log.Printf("remindPendingMemberships: unable to load group %s: %v", summary.GroupID, err)
+			continue
+		}
+		if group.DatePendingReminderSent != nil && group.DatePendingReminderSent.After(remindBefore) {
+			continue
+		}
+
+		adminMemberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+			GroupIDs: []string{group.ID},
+			Statuses: []string{"admin"},
+		})
+		if err != nil {
+			log.Printf("remindPendingMemberships: unable to load the admins of group %s: %s", group.ID, err)
+			continue
+		}
+		recipients := adminMemberships.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+			return true, member.NotificationsPreferences.OverridePreferences &&
+				(member.NotificationsPreferences.InvitationsMuted || member.NotificationsPreferences.AllMute)
+		})
+		if len(recipients) == 0 {
+			continue
+		}
+
+		groupStr := "Group"
+		if group.ResearchGroup {
+			groupStr = "Research Project"
+		}
+		message := fmt.Sprintf("%d membership requests for '%s' %s are waiting for a decision", summary.Count, group.Title, strings.ToLower(groupStr))
+		if summary.Count == 1 {
+			message = fmt.Sprintf("A membership request for '%s' %s is waiting for a decision", group.Title, strings.ToLower(groupStr))
+		}
+
+		topic := "group.invitations"
+		err = app.sendNotification(
+			recipients,
+			&topic,
+			fmt.Sprintf("%s - %s", groupStr, group.Title),
+			message,
+			map[string]string{
+				"type":        "group",
+				"operation":   "pending_member_reminder",
+				"entity_type": "group",
+				"entity_id":   group.ID,
+				"entity_name": group.Title,
+				"count":       fmt.Sprintf("%d", summary.Count),
+			},
+			app.config.AppID,
+			app.config.OrgID,
+			nil,
+		)
+		if err != nil {
+			log.Printf("remindPendingMemberships: error reminding the admins of group %s: %s", group.ID, err)
+			continue
+		}
+
+		err = app.storage.UpdateGroupPendingReminderSent(nil, clientID, group.ID, now)
+		if err != nil {
+			log.Printf("remindPendingMemberships: error saving the reminder date of group %s: %s", group.ID, err)
+		}
+		remindedCount++
+	}
+
+	log.Printf("remindPendingMemberships: admins of %d groups reminded for clientID %s", remindedCount, clientID)
+}
@@ -0,0 +1,72 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/storage"
+	"groups/utils"
+)
+
+// pinPost pins or unpins a top level post of the group. It gives utils.GroupError when the group already has the maximum number of pinned posts
+// and nil if the post does not exist.
+func (app *Application) pinPost(clientID string, current *model.User, groupID string, postID string, pinned bool) (*model.Post, error) {
+	var post *model.Post
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		existing, err := app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
+		if err != nil {
+			return err
+		}
+		if existing == nil || existing.ParentID != nil {
+			return nil
+		}
+		if existing.Pinned == pinned {
+			post = existing
+			return nil
+		}
+
+		if pinned {
+			count, err := app.storage.CountPinnedPosts(context, clientID, groupID)
+			if err != nil {
+				return err
+			}
+			if count >= model.MaxPinnedPosts {
+				return utils.NewPinnedPostsLimitError(model.MaxPinnedPosts)
+			}
+		}
+
+		updated, err := app.storage.UpdatePostPinned(context, clientID, groupID, postID, pinned)
+		if err != nil || !updated {
+			return err
+		}
+
+		action := model.AuditActionPostUnpinned
+		if pinned {
+			action = model.AuditActionPostPinned
+		}
+		err = app.recordAuditLog(context, clientID, current, groupID, action, "post", postID, nil)
+		if err != nil {
+			return err
+		}
+
+		post, err = app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
+		return err
+	})
+	if err != nil || post == nil {
+		return nil, err
+	}
+
+	return post, nil
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"sync"
+	"time"
+)
+
+// pollResultsCacheTTL is how long the poll results are served from the cache before they are fetched from the Polls BB again
+const pollResultsCacheTTL = 30 * time.Second
+
+// getGroupPollResults gives the results of the group poll according to the poll preferences of the group. The group admins always
+// get the full results. Gives nil if the poll does not exist within the group.
+func (app *Application) getGroupPollResults(clientID string, current *model.User, group *model.Group, pollID string) (*model.PollResults, error) {
+	if app.polls == nil {
+		return nil, errors.New("the Polls BB is not configured")
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		return nil, utils.NewForbiddenError()
+	}
+
+	results, ok := app.pollResultsCache.get(pollID)
+	if !ok {
+		var err error
+		results, err = app.polls.GetPollResults(pollID, current.AppID, current.OrgID)
+		if err != nil {
+			return nil, fmt.Errorf("error loading the results of poll %s: %s", pollID, err)
+		}
+		app.pollResultsCache.put(pollID, results)
+	}
+	if results == nil || results.GroupID != group.ID {
+		return nil, nil
+	}
+
+	if group.CurrentMember.IsAdmin() || group.Settings == nil || group.Settings.PollPreferences == nil {
+		return results, nil
+	}
+
+	preferences := group.Settings.PollPreferences
+	if !preferences.CanMembersSeeResults(*results) {
+		return nil, utils.NewForbiddenError()
+	}
+	if preferences.HideVoters {
+		stripped := results.WithoutVoters()
+		return &stripped, nil
+	}
+	return results, nil
+}
+
+// pollResultsCache keeps the poll results of this instance for a short time, the missing polls are cached too
+type pollResultsCache struct {
+	lock  sync.Mutex
+	items map[string]cachedPollResults
+}
+
+type cachedPollResults struct {
+	results     *model.PollResults
+	dateExpires time.Time
+}
+
+func newPollResultsCache() *pollResultsCache {
+	return &pollResultsCache{items: map[string]cachedPollResults{}}
+}
+
+func (c *pollResultsCache) get(pollID string) (*model.PollResults, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	item, ok := c.items[pollID]
+	if !ok || time.Now().After(item.dateExpires) {
+		return nil, false
+	}
+	return item.results, true
+}
+
+// put caches the results and drops the expired ones
+func (c *pollResultsCache) put(pollID string, results *model.PollResults) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for key, item := range c.items {
+		if now.After(item.dateExpires) {
+			delete(c.items, key)
+		}
+	}
+	c.items[pollID] = cachedPollResults{results: results, dateExpires: now.Add(pollResultsCacheTTL)}
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+// getPendingApprovalPosts gives the posts of the group waiting for an admin approval
+func (app *Application) getPendingApprovalPosts(clientID string, groupID string) ([]model.Post, error) {
+	return app.storage.FindPendingApprovalPosts(nil, clientID, groupID)
+}
+
+// moderatePendingPost approves or rejects the post waiting for an approval and notifies the author about the outcome.
+// The approved post runs the same flow as a new post - the rewards, the notifications and the webhook event. It gives nil if there is no such pending post.
+func (app *Application) moderatePendingPost(clientID string, current *model.User, group *model.Group, postID string, approve bool, rejectionReason string) (*model.Post, error) {
+	var post *model.Post
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		pending, err := app.storage.FindPost(context, clientID, nil, group.ID, postID, true, false)
+		if err != nil {
+			return err
+		}
+		if pending == nil || !pending.IsPendingApproval() || pending.GroupID != group.ID {
+			return nil
+		}
+
+		now := time.Now()
+		pending.DateModerated = &now
+		if !approve {
+			moderated, err := app.storage.RejectPendingPost(context, clientID, group.ID, postID, rejectionReason)
+			if err != nil || !moderated {
+				return err
+			}
+			pending.Status = model.PostStatusRejected
+			pending.RejectionReason = rejectionReason
+		} else {
+			pending.Status = ""
+			scheduleAsNewPost(group, pending)
+			moderated, err := app.storage.ApprovePendingPost(context, clientID, pending)
+			if err != nil || !moderated {
+				return err
+			}
+		}
+
+		action := model.AuditActionPostRejected
+		if approve {
+			action = model.AuditActionPostApproved
+		}
+		err = app.recordAuditLog(context, clientID, current, group.ID, action, "post", pending.ID,
+			map[string]model.AuditChange{
+				"status":           {Old: model.PostStatusPendingApproval, New: pending.Status},
+				"rejection_reason": {New: pending.RejectionReason},
+			})
+		if err != nil {
+			return err
+		}
+		post = pending
+		if !approve {
+			return nil
+		}
+
+		author := &model.User{ID: post.Creator.UserID, Name: post.Creator.Name, Email: post.Creator.Email}
+		return app.recordPostCreated(context, clientID, author, group, post)
+	})
+	if err != nil || post == nil {
+		return nil, err
+	}
+
+	go app.notifyPostModerated(clientID, current, group, post)
+
+	if approve {
+		author := &model.User{ID: post.Creator.UserID, Name: post.Creator.Name, Email: post.Creator.Email}
+		app.handlePostPublished(clientID, author, group, post)
+	}
+	return post, nil
+}
+
+// notifyPostModerated notifies the author about the approval or the rejection of the post
+func (app *Application) notifyPostModerated(clientID string, current *model.User, group *model.Group, post *model.Post) {
+	mute := false
+	membership, err := app.storage.FindGroupMembership(clientID, group.ID, post.Creator.UserID)
+	if err != nil {
+		log.Printf("error app.notifyPostModerated() - unable to find the membership of %s: %s", post.Creator.UserID, err)
+	} else if membership != nil {
+		preferences := membership.NotificationsPreferences
+		mute = preferences.OverridePreferences && (preferences.PostsMuted || preferences.AllMute)
+	}
+
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	operation := "post_approve"
+	text := fmt.Sprintf("Your post in '%s' has been approved", group.Title)
+	if !post.IsPublished() {
+		operation = "post_reject"
+		text = fmt.Sprintf("Your post in '%s' has been rejected", group.Title)
+		if len(post.RejectionReason) > 0 {
+			text = fmt.Sprintf("Your post in '%s' has been rejected with a reason: %s", group.Title, post.RejectionReason)
+		}
+	}
+
+	topic := "group.posts"
+	err = app.sendNotification(
+		[]notifications.Recipient{{UserID: post.Creator.UserID, Name: post.Creator.Name, Mute: mute}},
+		&topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		text,
+		map[string]string{
+			"type":        "group",
+			"operation":   operation,
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"post_id":     post.ID,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error app.notifyPostModerated() - unable to notify %s: %s", post.Creator.UserID, err)
+	}
+}
+
+// notifyAdminsPostPending notifies the group admins about a post waiting for their approval
+func (app *Application) notifyAdminsPostPending(clientID string, current *model.User, group *model.Group, post *model.Post) {
+	result, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		Statuses: []string{"admin"},
+	})
+	if err != nil {
+		log.Printf("error app.notifyAdminsPostPending() - unable to find the admins of group %s: %s", group.ID, err)
+		return
+	}
+	recipients := result.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		return member.UserID != current.ID,
+			member.NotificationsPreferences.OverridePreferences &&
+				(member.NotificationsPreferences.PostsMuted || member.NotificationsPreferences.AllMute)
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	topic := "group.posts"
+	err = app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		fmt.Sprintf("%s submitted a post waiting for your approval", post.Creator.Name),
+		map[string]string{
+			"type":        "group",
+			"operation":   "post_pending_approval",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"post_id":     post.ID,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error app.notifyAdminsPostPending() - unable to notify the admins of group %s: %s", group.ID, err)
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// bookmarkPost bookmarks the post for the current user. Gives nil if the post is not visible to the user.
+func (app *Application) bookmarkPost(clientID string, current *model.User, groupID string, postID string) (*model.PostBookmark, error) {
+	membership, err := app.storage.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	if membership == nil || !membership.IsAdminOrMember() {
+		return nil, nil
+	}
+
+	posts, err := app.storage.FindVisiblePosts(nil, clientID, current.ID, []string{groupID}, []string{postID})
+	if err != nil {
+		return nil, err
+	}
+	if len(posts) == 0 {
+		return nil, nil
+	}
+
+	bookmark := model.PostBookmark{ID: uuid.NewString(), ClientID: clientID, UserID: current.ID, GroupID: groupID, PostID: postID,
+		DateCreated: time.Now().UTC()}
+	return app.storage.SavePostBookmark(nil, bookmark)
+}
+
+// unbookmarkPost removes the bookmark of the post for the current user
+func (app *Application) unbookmarkPost(clientID string, current *model.User, postID string) error {
+	return app.storage.DeletePostBookmark(nil, clientID, current.ID, postID)
+}
+
+// getBookmarkedPosts gives the posts bookmarked by the current user across the groups ordered by the bookmark date (newest first). The
+// visibility is checked again, so the posts which are deleted or not visible any more, i.e. the user has left the group, are skipped but
+// the bookmarks are kept.
+func (app *Application) getBookmarkedPosts(clientID string, current *model.User, offset *int64, limit *int64) ([]model.Post, error) {
+	bookmarks, err := app.storage.FindPostBookmarks(nil, clientID, current.ID, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	if len(bookmarks) == 0 {
+		return []model.Post{}, nil
+	}
+
+	memberships, err := app.storage.FindUserGroupMemberships(clientID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	groupIDs := []string{}
+	for _, membership := range memberships.Items {
+		if membership.IsAdminOrMember() {
+			groupIDs = append(groupIDs, membership.GroupID)
+		}
+	}
+
+	postIDs := make([]string, len(bookmarks))
+	for i, bookmark := range bookmarks {
+		postIDs[i] = bookmark.PostID
+	}
+	posts, err := app.storage.FindVisiblePosts(nil, clientID, current.ID, groupIDs, postIDs)
+	if err != nil {
+		return nil, err
+	}
+	postsMapping := map[string]model.Post{}
+	for _, post := range posts {
+		postsMapping[post.ID] = post
+	}
+
+	result := make([]model.Post, 0, len(posts))
+	for _, bookmark := range bookmarks {
+		if post, ok := postsMapping[bookmark.PostID]; ok {
+			result = append(result, post)
+		}
+	}
+	return result, nil
+}
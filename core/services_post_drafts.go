@@ -0,0 +1,83 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/storage"
+	"time"
+)
+
+// getDraftPosts gives the draft posts of the current user within the group
+func (app *Application) getDraftPosts(clientID string, current *model.User, groupID string) ([]model.Post, error) {
+	return app.storage.FindDraftPosts(nil, clientID, groupID, current.ID)
+}
+
+// publishDraftPost publishes the draft post of the current user and runs the same flow as for a new post - the rewards, the notifications
+// and the webhook event. The draft waits for an approval if the group requires it. It gives nil if the user has no such draft.
+func (app *Application) publishDraftPost(clientID string, current *model.User, group *model.Group, postID string) (*model.Post, error) {
+	var post *model.Post
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		draft, err := app.storage.FindPost(context, clientID, &current.ID, group.ID, postID, true, false)
+		if err != nil {
+			return err
+		}
+		if draft == nil || !draft.IsDraft() || draft.GroupID != group.ID || draft.Creator.UserID != current.ID {
+			return nil
+		}
+
+		draft.Status = ""
+		if group.RequiresPostApproval(group.CurrentMember) {
+			draft.Status = model.PostStatusPendingApproval
+		}
+		scheduleAsNewPost(group, draft)
+
+		published, err := app.storage.PublishDraftPost(context, clientID, current.ID, draft)
+		if err != nil || !published {
+			return err
+		}
+		post = draft
+		if !post.IsPublished() {
+			return nil
+		}
+		return app.recordPostCreated(context, clientID, current, group, post)
+	})
+	if err != nil || post == nil {
+		return nil, err
+	}
+	if !post.IsPublished() {
+		go app.notifyAdminsPostPending(clientID, current, group, post)
+		return post, nil
+	}
+
+	app.handlePostPublished(clientID, current, group, post)
+	return post, nil
+}
+
+// scheduleAsNewPost orders the post as a new one. The scheduled posts keep their date and are checked against the quiet hours by the scheduler.
+// The notifications of the posts waiting for an approval are not deferred as they are not sent yet.
+func scheduleAsNewPost(group *model.Group, post *model.Post) {
+	now := time.Now()
+	post.DateCreated = now
+	post.DateNotificationDeferred = nil
+	if post.DateScheduled != nil && post.DateScheduled.After(now) {
+		post.DateCreated = *post.DateScheduled
+	} else {
+		post.DateScheduled = nil
+		if post.IsPublished() {
+			post.DateNotificationDeferred = group.QuietHoursEnd(now)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+)
+
+func (app *Application) getPostLimitsConfig(clientID string) (*model.PostLimitsConfig, error) {
+	return app.storage.FindPostLimitsConfig(nil, clientID)
+}
+
+func (app *Application) updatePostLimitsConfig(config model.PostLimitsConfig) error {
+	return app.storage.SavePostLimitsConfig(nil, config)
+}
+
+// checkPostLimits gives model.PostLimitError when the post exceeds the limits of the client. The reply depth is checked only when the parent post is provided.
+func (app *Application) checkPostLimits(clientID string, current *model.User, groupID string, post *model.Post, parentPost *model.Post) error {
+	config, err := app.storage.FindPostLimitsConfig(nil, clientID)
+	if err != nil {
+		log.Printf("app.checkPostLimits() error loading the post limits config for %s: %s", clientID, err)
+		return nil
+	}
+	if config == nil {
+		return nil
+	}
+
+	err = config.CheckContent(post)
+	if err != nil {
+		return err
+	}
+
+	if config.MaxReplyDepth != nil && parentPost != nil {
+		limit := *config.MaxReplyDepth
+		depth := 1
+		for ancestor := parentPost; ancestor.ParentID != nil; {
+			depth++
+			if depth > limit {
+				break
+			}
+			ancestor, err = app.storage.FindPost(nil, clientID, &current.ID, groupID, *ancestor.ParentID, true, false)
+			if err != nil {
+				return err
+			}
+			if ancestor == nil {
+				break
+			}
+		}
+		if depth > limit {
+			return &model.PostLimitError{Field: model.PostLimitFieldReplyDepth, Limit: limit}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+)
+
+// postNotificationBurstsMaxClaims limits the bursts summarized per run, the rest are summarized by the next runs
+const postNotificationBurstsMaxClaims = 500
+
+func (app *Application) startPostNotificationBurstTask() {
+	_, err := app.scheduler.AddFunc("* * * * *", func() {
+		err := app.processPostNotificationBursts()
+		if err != nil {
+			log.Printf("error processing post notification bursts: %s", err)
+		}
+	})
+	if err != nil {
+		log.Printf("error on running post notification burst task: %s", err)
+	}
+	log.Printf("successful running of post notification burst task")
+}
+
+func (app *Application) getPostNotificationBatchingConfig(clientID string) (*model.PostNotificationBatchingConfig, error) {
+	return app.storage.FindPostNotificationBatchingConfig(nil, clientID)
+}
+
+func (app *Application) updatePostNotificationBatchingConfig(config model.PostNotificationBatchingConfig) error {
+	return app.storage.SavePostNotificationBatchingConfig(nil, config)
+}
+
+// batchPostNotification records the post within the notification burst of the group. Gives true if the notification of the post is
+// batched into the summary of the running burst. The summary of the ended burst replaced by the post is sent right away.
+func (app *Application) batchPostNotification(clientID string, group *model.Group, post *model.Post) bool {
+	config, err := app.storage.FindPostNotificationBatchingConfig(nil, clientID)
+	if err != nil {
+		log.Printf("app.batchPostNotification() error loading the batching config for clientID %s: %s", clientID, err)
+		return false
+	}
+	if config == nil || config.WindowMinutes <= 0 {
+		return false
+	}
+
+	batched, ended, err := app.storage.RecordPostNotificationBurst(nil, clientID, group.ID, post.Creator.UserID, time.Now(),
+		time.Duration(config.WindowMinutes)*time.Minute)
+	if err != nil {
+		// rather notify the post than lose the notification
+		log.Printf("app.batchPostNotification() error recording the post %s within the burst of group %s: %s", post.ID, group.ID, err)
+		return false
+	}
+	if ended != nil && ended.BatchedCount() > 0 {
+		go app.notifyPostNotificationBurst(group, *ended)
+	}
+	return batched
+}
+
+// processPostNotificationBursts sends the summaries of the ended post notification bursts
+func (app *Application) processPostNotificationBursts() error {
+	var notifiedCount int
+	for i := 0; i < postNotificationBurstsMaxClaims; i++ {
+		burst, err := app.storage.ClaimEndedPostNotificationBurst(nil, time.Now())
+		if err != nil {
+			return fmt.Errorf("error claiming an ended post notification burst: %s", err)
+		}
+		if burst == nil {
+			break
+		}
+		if burst.BatchedCount() <= 0 {
+			continue
+		}
+
+		group, err := app.storage.FindGroup(nil, burst.ClientID, burst.GroupID, nil)
+		if err != nil {
+			log.Printf("processPostNotificationBursts: error loading the group %s: %s", burst.GroupID, err)
+			continue
+		}
+		if group == nil {
+			continue
+		}
+		app.notifyPostNotificationBurst(group, *burst)
+		notifiedCount++
+	}
+	if notifiedCount > 0 {
+		log.Printf("processPostNotificationBursts: %d post notification bursts summarized", notifiedCount)
+	}
+	return nil
+}
+
+// notifyPostNotificationBurst lets the members know about the posts batched within the burst. The creator of the posts is not notified
+// if all the posts are of a single member.
+func (app *Application) notifyPostNotificationBurst(group *model.Group, burst model.PostNotificationBurst) {
+	var skipUserID string
+	if len(burst.CreatorIDs) == 1 {
+		skipUserID = burst.CreatorIDs[0]
+	}
+
+	result, err := app.storage.FindGroupMemberships(burst.ClientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		Statuses: []string{"member", "admin"},
+	})
+	if err != nil {
+		log.Printf("app.notifyPostNotificationBurst() error loading the members of group %s: %s", group.ID, err)
+		return
+	}
+	recipients := result.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		return member.IsAdminOrMember() && member.UserID != skipUserID,
+			member.NotificationsPreferences.OverridePreferences &&
+				(member.NotificationsPreferences.PostsMuted || member.NotificationsPreferences.AllMute)
+	})
+	if len(recipients) == 0 {
+		return
+	}
+
+	groupStr := "Group"
+	if group.ResearchGroup {
+		groupStr = "Research Project"
+	}
+	count := burst.BatchedCount()
+	body := fmt.Sprintf("%d new posts in %s", count, group.Title)
+	if count == 1 {
+		body = fmt.Sprintf("A new post in %s", group.Title)
+	}
+
+	topic := "group.posts"
+	err = app.sendUnsubscribableNotification(burst.ClientID, group.ID, model.UnsubscribeScopePosts, recipients, &topic,
+		fmt.Sprintf("%s - %s", groupStr, group.Title),
+		body,
+		map[string]string{
+			"type":        "group",
+			"operation":   "posts_created",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"count":       fmt.Sprintf("%d", count),
+		},
+		app.config.AppID,
+		app.config.OrgID,
+	)
+	if err != nil {
+		log.Printf("app.notifyPostNotificationBurst() error notifying the members of group %s: %s", group.ID, err)
+	}
+}
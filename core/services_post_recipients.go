@@ -0,0 +1,136 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"groups/utils"
+)
+
+// addPostRecipients adds the group members to the recipients of the post, only the newly added members are notified. Gives nil if the
+// post does not exist.
+func (app *Application) addPostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error) {
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{group.ID},
+		UserIDs:  userIDs,
+		Statuses: []string{"member", "admin"},
+	})
+	if err != nil {
+		return nil, err
+	}
+	members := map[string]model.ToMember{}
+	for _, membership := range memberships.Items {
+		members[membership.UserID] = model.ToMember{UserID: membership.UserID, ExternalID: membership.ExternalID, Name: membership.Name,
+			Email: membership.Email}
+	}
+	for _, userID := range userIDs {
+		if _, ok := members[userID]; !ok {
+			return nil, utils.NewBadRequestError(fmt.Sprintf("%s is not a member of the group", userID))
+		}
+	}
+
+	var added []model.ToMember
+	post, err := app.updatePostRecipients(clientID, current, group.ID, postID, func(toMembers []model.ToMember) ([]model.ToMember, error) {
+		existing := map[string]bool{}
+		for _, toMember := range toMembers {
+			existing[toMember.UserID] = true
+		}
+
+		added = nil
+		for _, userID := range userIDs {
+			if existing[userID] {
+				continue
+			}
+			existing[userID] = true
+			added = append(added, members[userID])
+		}
+		return append(toMembers, added...), nil
+	})
+	if err != nil || post == nil {
+		return nil, err
+	}
+
+	// the recipients of a scheduled post which is not sent yet are notified with the post
+	if len(added) > 0 && post.IsPublished() {
+		delta := *post
+		delta.ToMembersList = added
+		go app.sendGroupNotificationForNewPost(clientID, &current.ID, &current.Name, group, &delta)
+	}
+	return post, nil
+}
+
+// removePostRecipients removes the members from the recipients of the post. At least one recipient must remain, otherwise the post would
+// become visible to the whole group. Gives nil if the post does not exist.
+func (app *Application) removePostRecipients(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error) {
+	removed := map[string]bool{}
+	for _, userID := range userIDs {
+		removed[userID] = true
+	}
+
+	return app.updatePostRecipients(clientID, current, group.ID, postID, func(toMembers []model.ToMember) ([]model.ToMember, error) {
+		remaining := []model.ToMember{}
+		for _, toMember := range toMembers {
+			if !removed[toMember.UserID] {
+				remaining = append(remaining, toMember)
+			}
+		}
+		if len(remaining) == 0 {
+			return nil, utils.NewBadRequestError("at least one recipient must remain, update the post to make it visible to the whole group")
+		}
+		return remaining, nil
+	})
+}
+
+// updatePostRecipients changes the recipients of the post of the current user within a transaction. Only the posts to the chosen
+// members could be changed, the recipients of the posts to the whole group are chosen by the post update.
+func (app *Application) updatePostRecipients(clientID string, current *model.User, groupID string, postID string,
+	change func(toMembers []model.ToMember) ([]model.ToMember, error)) (*model.Post, error) {
+	var post *model.Post
+	err := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		post = nil
+		existing, err := app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
+		if err != nil || existing == nil {
+			return err
+		}
+		if existing.Creator.UserID != current.ID {
+			return utils.NewForbiddenError()
+		}
+		if len(existing.ToMembersList) == 0 {
+			return utils.NewBadRequestError("the post is visible to the whole group, update the post to choose the recipients")
+		}
+
+		toMembers, err := change(existing.ToMembersList)
+		if err != nil {
+			return err
+		}
+		if len(toMembers) == len(existing.ToMembersList) {
+			post = existing
+			return nil
+		}
+
+		updated, err := app.storage.UpdatePostToMembers(context, clientID, groupID, postID, toMembers)
+		if err != nil || !updated {
+			return err
+		}
+		post, err = app.storage.FindPost(context, clientID, &current.ID, groupID, postID, true, false)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return post, nil
+}
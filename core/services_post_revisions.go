@@ -0,0 +1,40 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+)
+
+// getPostRevisions gives the previous revisions of a group post. It gives nil if the post does not belong to the group.
+func (app *Application) getPostRevisions(clientID string, current *model.User, groupID string, postID string) ([]model.PostRevision, error) {
+	post, err := app.storage.FindPost(nil, clientID, &current.ID, groupID, postID, true, false)
+	if err != nil {
+		return nil, fmt.Errorf("error finding post: %v", err)
+	}
+	if post == nil || post.GroupID != groupID {
+		return nil, nil
+	}
+
+	revisions, err := app.storage.FindPostRevisions(nil, clientID, postID)
+	if err != nil {
+		return nil, err
+	}
+	if revisions == nil {
+		revisions = []model.PostRevision{}
+	}
+	return revisions, nil
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"sync"
+	"time"
+)
+
+const (
+	// publicFeedCacheTTL is how long the public feed is served from the cache, so the changes and the disabling of the feed could take
+	// this long to be visible
+	publicFeedCacheTTL = 5 * time.Minute
+	// publicFeedSize is the maximum number of the posts in the public feed
+	publicFeedSize = 50
+)
+
+// getGroupPublicFeed gives the latest public posts of the group for the websites. It gives nil if the group does not exist, it is not public
+// or the admins disabled the feed.
+func (app *Application) getGroupPublicFeed(clientID string, groupID string) (*model.GroupPublicFeed, error) {
+	cacheKey := clientID + "/" + groupID
+	if feed, ok := app.publicFeedCache.get(cacheKey); ok {
+		return feed, nil
+	}
+
+	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil || !group.IsPublicFeedEnabled() {
+		app.publicFeedCache.put(cacheKey, nil)
+		return nil, nil
+	}
+
+	posts, err := app.storage.FindPublicFeedPosts(nil, clientID, groupID, publicFeedSize)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := model.GroupPublicFeed{GroupID: group.ID, Title: group.Title, Updated: group.DateCreated, Entries: make([]model.GroupPublicFeedEntry, len(posts))}
+	if group.Description != nil {
+		feed.Description = *group.Description
+	}
+	for i, post := range posts {
+		feed.Entries[i] = model.NewGroupPublicFeedEntry(post)
+		if feed.Entries[i].Updated.After(feed.Updated) {
+			feed.Updated = feed.Entries[i].Updated
+		}
+	}
+
+	app.publicFeedCache.put(cacheKey, &feed)
+	return &feed, nil
+}
+
+// publicFeedCache keeps the public feeds of this instance for a short time, the groups without a feed are cached too
+type publicFeedCache struct {
+	lock  sync.Mutex
+	items map[string]cachedPublicFeed
+}
+
+type cachedPublicFeed struct {
+	feed        *model.GroupPublicFeed
+	dateExpires time.Time
+}
+
+func newPublicFeedCache() *publicFeedCache {
+	return &publicFeedCache{items: map[string]cachedPublicFeed{}}
+}
+
+func (c *publicFeedCache) get(key string) (*model.GroupPublicFeed, bool) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	item, ok := c.items[key]
+	if !ok || time.Now().After(item.dateExpires) {
+		return nil, false
+	}
+	return item.feed, true
+}
+
+// put caches the feed and drops the expired ones
+func (c *publicFeedCache) put(key string, feed *model.GroupPublicFeed) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := time.Now()
+	for itemKey, item := range c.items {
+		if now.After(item.dateExpires) {
+			delete(c.items, itemKey)
+		}
+	}
+	c.items[key] = cachedPublicFeed{feed: feed, dateExpires: now.Add(publicFeedCacheTTL)}
+}
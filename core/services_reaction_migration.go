@@ -0,0 +1,180 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// reactionMigrationPageSize the number of posts pushed to the Social BB in a single batch
+const reactionMigrationPageSize = 100
+
+// startReactionMigration starts a background job which moves the post reactions of the groups to the Social BB. All groups with reactions are migrated if no group IDs are provided.
+func (app *Application) startReactionMigration(clientID string, groupIDs []string) (*model.ReactionMigration, error) {
+	if app.social == nil {
+		return nil, errors.New("the Social BB is not configured")
+	}
+
+	acquired, releaseLock, err := app.acquireLock("reaction_migration_"+clientID, reactionMigrationLockLease)
+	if err != nil {
+		return nil, fmt.Errorf("error acquiring the reaction migration lock for clientID %s: %s", clientID, err)
+	}
+	if !acquired {
+		return nil, fmt.Errorf("another reaction migration is running for clientID %s", clientID)
+	}
+
+	if len(groupIDs) == 0 {
+		groupIDs, err = app.storage.FindGroupIDsWithPostReactions(nil, clientID)
+		if err != nil {
+			releaseLock()
+			return nil, fmt.Errorf("error finding the groups with reactions for clientID %s: %s", clientID, err)
+		}
+	}
+
+	migration := model.ReactionMigration{ID: uuid.NewString(), ClientID: clientID, Status: model.ReactionMigrationStatusRunning,
+		Groups: make([]model.ReactionMigrationGroup, len(groupIDs)), DateStarted: time.Now()}
+	for i, groupID := range groupIDs {
+		migration.Groups[i] = model.ReactionMigrationGroup{GroupID: groupID, Status: model.ReactionMigrationStatusRunning}
+	}
+
+	err = app.storage.InsertReactionMigration(nil, migration)
+	if err != nil {
+		releaseLock()
+		return nil, fmt.Errorf("error storing the reaction migration for clientID %s: %s", clientID, err)
+	}
+
+	go func() {
+		defer releaseLock()
+		app.runReactionMigration(&migration)
+	}()
+
+	return &migration, nil
+}
+
+func (app *Application) runReactionMigration(migration *model.ReactionMigration) {
+	log.Printf("Reaction migration %s started for %d groups of clientID %s", migration.ID, len(migration.Groups), migration.ClientID)
+
+	for i := range migration.Groups {
+		groupResult := &migration.Groups[i]
+		err := app.migrateGroupReactions(migration.ClientID, groupResult)
+		if err != nil {
+			log.Printf("error migrating the reactions of group %s - %s", groupResult.GroupID, err)
+			errMsg := err.Error()
+			groupResult.Error = &errMsg
+			groupResult.Status = model.ReactionMigrationStatusFailed
+		} else {
+			groupResult.Status = model.ReactionMigrationStatusCompleted
+		}
+
+		err = app.storage.UpdateReactionMigration(nil, *migration)
+		if err != nil {
+			log.Printf("error storing the progress of reaction migration %s - %s", migration.ID, err)
+		}
+	}
+
+	migration.Finish()
+	err := app.storage.UpdateReactionMigration(nil, *migration)
+	if err != nil {
+		log.Printf("error storing the result of reaction migration %s - %s", migration.ID, err)
+	}
+
+	log.Printf("Reaction migration %s finished with status %s", migration.ID, migration.Status)
+}
+
+// migrateGroupReactions pushes the reactions of the group posts to the Social BB and verifies the counts.
+// The local reaction writes are disabled during the migration and are enabled back if it fails, so no reaction is lost.
+func (app *Application) migrateGroupReactions(clientID string, result *model.ReactionMigrationGroup) error {
+	group, err := app.storage.FindGroup(nil, clientID, result.GroupID, nil)
+	if err != nil {
+		return fmt.Errorf("error finding the group: %s", err)
+	}
+	if group == nil {
+		return errors.New("group not found")
+	}
+
+	err = app.storage.UpdateGroupReactionsMigrated(nil, clientID, group.ID, true)
+	if err != nil {
+		return fmt.Errorf("error disabling the local reaction writes: %s", err)
+	}
+
+	err = app.pushGroupReactions(clientID, group.ID, result)
+	if err == nil {
+		result.SocialCount, err = app.social.CountGroupReactions(group.ID, app.config.AppID, app.config.OrgID)
+		if err != nil {
+			err = fmt.Errorf("error counting the Social BB reactions: %s", err)
+		} else if result.PushedCount != result.LocalCount || result.SocialCount < result.LocalCount {
+			err = fmt.Errorf("reaction counts mismatch - local: %d, pushed: %d, social: %d", result.LocalCount, result.PushedCount, result.SocialCount)
+		}
+	}
+
+	if err != nil {
+		revertErr := app.storage.UpdateGroupReactionsMigrated(nil, clientID, group.ID, false)
+		if revertErr != nil {
+			log.Printf("error enabling back the local reaction writes of group %s - %s", group.ID, revertErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (app *Application) pushGroupReactions(clientID string, groupID string, result *model.ReactionMigrationGroup) error {
+	var afterID *string
+	for {
+		posts, err := app.storage.FindPostsWithReactions(nil, clientID, groupID, afterID, reactionMigrationPageSize)
+		if err != nil {
+			return fmt.Errorf("error finding the posts with reactions: %s", err)
+		}
+		if len(posts) == 0 {
+			return nil
+		}
+
+		reactions := []model.SocialReaction{}
+		for _, post := range posts {
+			for reaction, userIDs := range post.Reactions {
+				for _, userID := range userIDs {
+					reactions = append(reactions, model.NewSocialReaction(clientID, post, userID, reaction))
+				}
+			}
+		}
+
+		if len(reactions) > 0 {
+			pushed, err := app.social.ImportReactions(reactions, app.config.AppID, app.config.OrgID)
+			if err != nil {
+				return fmt.Errorf("error pushing the reactions to the Social BB: %s", err)
+			}
+			result.PushedCount += pushed
+		}
+		result.Posts += int64(len(posts))
+		result.LocalCount += int64(len(reactions))
+
+		lastID := posts[len(posts)-1].ID
+		afterID = &lastID
+	}
+}
+
+func (app *Application) getReactionMigrations(clientID string, limit int64) ([]model.ReactionMigration, error) {
+	return app.storage.FindReactionMigrations(nil, clientID, limit)
+}
+
+func (app *Application) getReactionMigration(clientID string, id string) (*model.ReactionMigration, error) {
+	return app.storage.FindReactionMigration(nil, clientID, id)
+}
@@ -0,0 +1,192 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// reactionRateLimit is the max number of reactions a user can make within reactionRateWindow
+	reactionRateLimit  = 30
+	reactionRateWindow = time.Minute
+	// reactionToggleSpamLimit is the max number of toggles of the same reaction on the same post within reactionToggleSpamWindow
+	reactionToggleSpamLimit  = 10
+	reactionToggleSpamWindow = 5 * time.Minute
+	// reactionSuspensionPeriod is how long the reactions of a toggle spammer are suspended
+	reactionSuspensionPeriod = time.Hour
+)
+
+// checkReactionLimits gives model.ReactionLimitError when the user is suspended, exceeds the rate limit or is detected as toggle spammer.
+// The storage errors are only logged as the limiting must never prevent the legitimate reactions.
+func (app *Application) checkReactionLimits(clientID string, current *model.User, groupID string, postID string, reaction string) error {
+	now := time.Now().UTC()
+
+	suspension, err := app.storage.FindActiveReactionSuspension(nil, clientID, current.ID)
+	if err != nil {
+		log.Printf("app.checkReactionLimits() error finding reaction suspension for %s: %s", current.ID, err)
+	} else if suspension != nil {
+		return &model.ReactionLimitError{Reason: "reactions are temporarily suspended", RetryAfter: suspension.DateExpires.Sub(now)}
+	}
+
+	count, err := app.storage.CountReactionEvents(nil, clientID, current.ID, nil, nil, now.Add(-reactionRateWindow))
+	if err != nil {
+		log.Printf("app.checkReactionLimits() error counting reactions for %s: %s", current.ID, err)
+	} else if count >= reactionRateLimit {
+		return &model.ReactionLimitError{Reason: "too many reactions", RetryAfter: reactionRateWindow}
+	}
+
+	toggles, err := app.storage.CountReactionEvents(nil, clientID, current.ID, &postID, &reaction, now.Add(-reactionToggleSpamWindow))
+	if err != nil {
+		log.Printf("app.checkReactionLimits() error counting toggles for %s: %s", current.ID, err)
+	} else if toggles >= reactionToggleSpamLimit {
+		app.suspendReactions(clientID, current, groupID, postID, reaction, toggles)
+		return &model.ReactionLimitError{Reason: "reactions are temporarily suspended", RetryAfter: reactionSuspensionPeriod}
+	}
+
+	return nil
+}
+
+// recordReactionEvent stores the reaction for the rate limiting. Failures are only logged.
+func (app *Application) recordReactionEvent(clientID string, current *model.User, groupID string, postID string, reaction string, on bool) {
+	event := model.ReactionEvent{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		UserID:      current.ID,
+		GroupID:     groupID,
+		PostID:      postID,
+		Reaction:    reaction,
+		On:          on,
+		DateCreated: time.Now().UTC(),
+	}
+
+	err := app.storage.InsertReactionEvent(nil, event)
+	if err != nil {
+		log.Printf("app.recordReactionEvent() error storing reaction event for %s: %s", current.ID, err)
+	}
+}
+
+// suspendReactions suspends the reactions of a toggle spammer and notifies the group admins
+func (app *Application) suspendReactions(clientID string, current *model.User, groupID string, postID string, reaction string, toggles int64) {
+	now := time.Now().UTC()
+	suspension := model.ReactionSuspension{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		UserID:      current.ID,
+		GroupID:     groupID,
+		PostID:      postID,
+		Reason:      fmt.Sprintf("toggled '%s' %d times within %s", reaction, toggles, reactionToggleSpamWindow),
+		DateExpires: now.Add(reactionSuspensionPeriod),
+		DateCreated: now,
+	}
+
+	err := app.storage.InsertReactionSuspension(nil, suspension)
+	if err != nil {
+		log.Printf("app.suspendReactions() error storing reaction suspension for %s: %s", current.ID, err)
+		return
+	}
+
+	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+	if err != nil || group == nil {
+		log.Printf("app.suspendReactions() error finding group %s: %v", groupID, err)
+		return
+	}
+
+	result, _ := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{
+		GroupIDs: []string{groupID},
+		Statuses: []string{"admin"},
+	})
+	toMembers := result.GetMembersAsRecipients(func(membership model.GroupMembership) (bool, bool) {
+		return membership.UserID != current.ID, false
+	})
+	if len(toMembers) == 0 {
+		return
+	}
+
+	err = app.sendNotification(toMembers, nil, fmt.Sprintf("Group - %s", group.Title),
+		fmt.Sprintf("The reactions of %s have been suspended for %s due to reaction spam", current.Name, reactionSuspensionPeriod),
+		map[string]string{
+			"type":        "group",
+			"operation":   "reactions_suspended",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+			"post_id":     postID,
+			"user_id":     current.ID,
+		},
+		current.AppID,
+		current.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("app.suspendReactions() error notifying the admins of group %s: %s", groupID, err)
+	}
+}
+
+// getPostReactors gives the users who reacted to the post, optionally only with the provided reaction. The names are hidden from the members
+// if the group does not allow viewing them. It gives nil if the post is not found.
+func (app *Application) getPostReactors(clientID string, current *model.User, group *model.Group, postID string, reaction *string) ([]model.PostReactor, error) {
+	post, err := app.storage.FindPost(nil, clientID, &current.ID, group.ID, postID, true, true)
+	if err != nil || post == nil {
+		return nil, err
+	}
+
+	var userIDs []string
+	reactors := []model.PostReactor{}
+	for postReaction, reactionUserIDs := range post.Reactions {
+		if reaction != nil && *reaction != postReaction {
+			continue
+		}
+		for _, userID := range reactionUserIDs {
+			reactors = append(reactors, model.PostReactor{UserID: userID, Reaction: postReaction})
+			userIDs = append(userIDs, userID)
+		}
+	}
+	if len(reactors) == 0 {
+		return reactors, nil
+	}
+
+	showNames := group.CurrentMember != nil && group.CurrentMember.IsAdmin()
+	if group.Settings == nil || (group.Settings.MemberInfoPreferences.AllowMemberInfo && group.Settings.MemberInfoPreferences.CanViewMemberName) {
+		showNames = true
+	}
+	if showNames {
+		memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{GroupIDs: []string{group.ID}, UserIDs: userIDs})
+		if err != nil {
+			return nil, err
+		}
+		names := map[string]string{}
+		for _, membership := range memberships.Items {
+			names[membership.UserID] = membership.Name
+		}
+		for i := range reactors {
+			reactors[i].Name = names[reactors[i].UserID]
+		}
+	}
+
+	sort.Slice(reactors, func(i, j int) bool {
+		if reactors[i].Reaction != reactors[j].Reaction {
+			return reactors[i].Reaction < reactors[j].Reaction
+		}
+		return reactors[i].Name < reactors[j].Name
+	})
+	return reactors, nil
+}
@@ -0,0 +1,73 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"time"
+)
+
+// markGroupPostsRead moves the read cursor of the current user within the group to the current time
+func (app *Application) markGroupPostsRead(clientID string, current *model.User, groupID string) error {
+	return app.storage.SaveGroupReadCursor(nil, clientID, groupID, current.ID, time.Now())
+}
+
+// getUnreadCounts gives the number of the unread posts within every group the current user is a member of. The posts created before
+// the user joined the group are not counted unless the user has marked the group as read.
+func (app *Application) getUnreadCounts(clientID string, current *model.User) ([]model.GroupUnreadCount, error) {
+	memberships, err := app.storage.FindUserGroupMemberships(clientID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	cursors, err := app.storage.FindGroupReadCursors(nil, clientID, current.ID)
+	if err != nil {
+		return nil, err
+	}
+	cursorsMapping := map[string]time.Time{}
+	for _, cursor := range cursors {
+		cursorsMapping[cursor.GroupID] = cursor.DateRead
+	}
+
+	result := []model.GroupUnreadCount{}
+	since := map[string]time.Time{}
+	for _, membership := range memberships.Items {
+		if !membership.IsAdminOrMember() {
+			continue
+		}
+
+		count := model.GroupUnreadCount{GroupID: membership.GroupID}
+		if dateRead, ok := cursorsMapping[membership.GroupID]; ok {
+			count.DateRead = &dateRead
+			since[membership.GroupID] = dateRead
+		} else {
+			since[membership.GroupID] = membership.DateCreated
+		}
+		result = append(result, count)
+	}
+
+	counts, err := app.storage.CountUnreadPosts(nil, clientID, current.ID, since)
+	if err != nil {
+		return nil, err
+	}
+	countsMapping := map[string]int64{}
+	for _, count := range counts {
+		countsMapping[count.GroupID] = count.UnreadCount
+	}
+	for i := range result {
+		result[i].UnreadCount = countsMapping[result[i].GroupID]
+	}
+	return result, nil
+}
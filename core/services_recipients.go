@@ -0,0 +1,71 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/notifications"
+)
+
+// resolveGroupRecipients resolves the audience of the group into the de-duplicated notification recipients. The recipients who muted the
+// category in their preferences or muted the group are flagged as muted, or omitted if requested. Gives nil if the group does not exist.
+func (app *Application) resolveGroupRecipients(clientID string, groupID string, audience model.RecipientAudience) ([]notifications.Recipient, error) {
+	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+	if err != nil || group == nil {
+		return nil, err
+	}
+
+	filter := model.MembershipFilter{GroupIDs: []string{groupID}, Statuses: []string{"member", "admin"}}
+	switch audience.Audience {
+	case model.RecipientAudienceAdmins:
+		filter.Statuses = []string{"admin"}
+	case model.RecipientAudienceToMembers:
+		filter.UserIDs = audience.ToMembers
+	}
+	memberships, err := app.storage.FindGroupMemberships(clientID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	skipped := map[string]bool{}
+	for _, userID := range audience.SkipUserIDs {
+		skipped[userID] = true
+	}
+
+	added := map[string]bool{}
+	recipients := memberships.GetMembersAsNotificationRecipients(func(member model.GroupMembership) (bool, bool) {
+		if len(member.UserID) == 0 || skipped[member.UserID] || added[member.UserID] {
+			return false, false
+		}
+		if audience.Audience == model.RecipientAudienceAttended &&
+			(member.DateAttended == nil || member.DateAttended.Before(*audience.AttendedSince)) {
+			return false, false
+		}
+		added[member.UserID] = true
+		return true, member.NotificationsPreferences.IsMuted(audience.Category)
+	})
+
+	recipients = app.applyMembershipMutes(recipients, map[string]string{"entity_type": "group", "entity_id": groupID, "operation": audience.Operation})
+	if audience.ExcludeMuted {
+		unmuted := []notifications.Recipient{}
+		for _, recipient := range recipients {
+			if !recipient.Mute {
+				unmuted = append(unmuted, recipient)
+			}
+		}
+		recipients = unmuted
+	}
+	return recipients, nil
+}
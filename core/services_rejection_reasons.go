@@ -0,0 +1,57 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"strings"
+)
+
+func (app *Application) getRejectionReasonsConfig(clientID string) (*model.RejectionReasonsConfig, error) {
+	return app.storage.FindRejectionReasonsConfig(nil, clientID)
+}
+
+func (app *Application) updateRejectionReasonsConfig(config model.RejectionReasonsConfig) error {
+	return app.storage.SaveRejectionReasonsConfig(nil, config)
+}
+
+// resolveMembershipRejection gives model.RejectReasonError when the client has a rejection reasons catalog and the code is missing or
+// unknown. The clients without a catalog keep rejecting with the free text note only.
+func (app *Application) resolveMembershipRejection(clientID string, code string, note string) (*model.MembershipRejection, error) {
+	note = strings.TrimSpace(note)
+
+	config, err := app.storage.FindRejectionReasonsConfig(nil, clientID)
+	if err != nil {
+		return nil, fmt.Errorf("error loading the rejection reasons config for %s: %s", clientID, err)
+	}
+	if config == nil || len(config.Reasons) == 0 {
+		if len(code) > 0 {
+			return nil, &model.RejectReasonError{Code: code}
+		}
+		return &model.MembershipRejection{Note: note, Reason: note}, nil
+	}
+
+	reason := config.FindReason(code)
+	if reason == nil {
+		return nil, &model.RejectReasonError{Code: code}
+	}
+
+	text := reason.Message
+	if len(note) > 0 {
+		text = fmt.Sprintf("%s: %s", text, note)
+	}
+	return &model.MembershipRejection{Code: code, Note: note, Reason: text}, nil
+}
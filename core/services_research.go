@@ -0,0 +1,66 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+	"sort"
+)
+
+// publishResearchParticipantEnrolled publishes the enrollment of a research group participant so the downstream recruitment systems can record it.
+// The consent is given with the membership request, so the consent date is the date of the membership creation.
+func (app *Application) publishResearchParticipantEnrolled(clientID string, group *model.Group, membership *model.GroupMembership) {
+	if group == nil || membership == nil || !group.ResearchGroup {
+		return
+	}
+
+	app.publishWebhookEvent(clientID, model.WebhookEventResearchParticipantEnrolled, group.ID, map[string]interface{}{
+		"group_id":        group.ID,
+		"membership_id":   membership.ID,
+		"user_id":         membership.UserID,
+		"status":          membership.Status,
+		"consent_version": group.ResearchConsentVersion(),
+		"consent_date":    membership.DateCreated,
+		"profile_match":   app.getResearchProfileMatch(group, membership.UserID),
+	})
+}
+
+// getResearchProfileMatch checks if the user matches the research profile of the group and gives the profile criteria which have been checked
+func (app *Application) getResearchProfileMatch(group *model.Group, userID string) map[string]interface{} {
+	criteria := []string{}
+	for k1, v1 := range group.ResearchProfile {
+		for k2 := range v1 {
+			criteria = append(criteria, k1+"."+k2)
+		}
+	}
+	sort.Strings(criteria)
+
+	result := map[string]interface{}{"criteria": criteria}
+
+	searchParams := app.formatCoreAccountSearchParams(group.ResearchProfile)
+	searchParams["id"] = []string{userID}
+	limit := 1
+	accounts, err := app.corebb.GetAccounts(searchParams, nil, nil, &limit, nil)
+	if err != nil {
+		// the match is unknown, but the enrollment must still be published
+		log.Printf("app.getResearchProfileMatch() error matching the research profile of group %s: %s", group.ID, err)
+		result["matched"] = nil
+		return result
+	}
+
+	result["matched"] = len(accounts) > 0
+	return result
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+)
+
+const scheduleConflictWindow = 15 * time.Minute
+
+// getGroupSchedule merges the scheduled posts, the upcoming calendar events with the occurrences of the recurring series and the scheduled event reminders
+// of the group. The reminders are given when the reminder task sends them, for the events which have recipients by the event_reminders group setting.
+func (app *Application) getGroupSchedule(clientID string, current *model.User, groupID string) (model.GroupSchedule, error) {
+	schedule := model.GroupSchedule{}
+
+	group, err := app.storage.FindGroup(nil, clientID, groupID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("app.getGroupSchedule() error finding group %s: %s", groupID, err)
+	}
+
+	scheduledOnly := true
+	posts, err := app.storage.FindPosts(clientID, current, model.PostsFilter{GroupID: groupID, ScheduledOnly: &scheduledOnly}, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("app.getGroupSchedule() error finding scheduled posts for group %s: %s", groupID, err)
+	}
+	for i := range posts {
+		post := posts[i]
+		if post.DateScheduled == nil {
+			continue
+		}
+		post.Replies = nil
+		schedule = append(schedule, model.GroupScheduleItem{
+			Type:  model.ScheduleItemTypePost,
+			ID:    post.ID,
+			Title: post.Subject,
+			Date:  *post.DateScheduled,
+			Post:  &post,
+		})
+	}
+
+	startTimeAfter := time.Now().Unix()
+	published := true
+	eventsResponse, err := app.getGroupCalendarEvents(clientID, current, groupID, &published, model.GroupEventFilter{StartTimeAfter: &startTimeAfter})
+	if err != nil {
+		// The calendar events are not crucial for the preview. Log and proceed with the posts only.
+		log.Printf("app.getGroupSchedule() error loading calendar events for group %s: %s", groupID, err)
+	} else {
+		schedule = append(schedule, app.buildCalendarEventScheduleItems(eventsResponse)...)
+	}
+
+	mappings, err := app.storage.FindEvents(clientID, current, groupID, true, nil)
+	if err != nil {
+		// The series and the reminders are not crucial for the preview either.
+		log.Printf("app.getGroupSchedule() error loading the events of group %s: %s", groupID, err)
+	} else {
+		schedule = buildEventMappingScheduleItems(schedule, mappings, group.Settings != nil && group.Settings.EventReminders, time.Now())
+	}
+
+	schedule.SortByDate()
+	schedule.MarkConflicts(scheduleConflictWindow)
+
+	return schedule, nil
+}
+
+func (app *Application) buildCalendarEventScheduleItems(eventsResponse map[string]interface{}) []model.GroupScheduleItem {
+	var items []model.GroupScheduleItem
+	if eventsResponse == nil {
+		return items
+	}
+
+	events, ok := eventsResponse["events"].([]interface{})
+	if !ok {
+		return items
+	}
+
+	for _, entry := range events {
+		event, ok := entry.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		startDate := calendarEventStartDate(event)
+		if startDate == nil {
+			continue
+		}
+
+		item := model.GroupScheduleItem{
+			Type:  model.ScheduleItemTypeEvent,
+			Date:  *startDate,
+			Event: event,
+		}
+		if id, ok := event["id"].(string); ok {
+			item.ID = id
+		}
+		if name, ok := event["name"].(string); ok {
+			item.Title = name
+		}
+		items = append(items, item)
+	}
+
+	return items
+}
+
+// buildEventMappingScheduleItems links the listed calendar events to their series and adds the upcoming occurrences of the series the Calendar BB has
+// not given. It adds the scheduled reminders of the upcoming events which have not been sent yet with the reminder recipients too.
+func buildEventMappingScheduleItems(schedule model.GroupSchedule, mappings []model.Event, remindersDefault bool, now time.Time) model.GroupSchedule {
+	listed := map[string]int{}
+	for i, item := range schedule {
+		if item.Type == model.ScheduleItemTypeEvent {
+			listed[item.ID] = i
+		}
+	}
+
+	for _, mapping := range mappings {
+		start := mapping.OccurrenceStart
+		if start == nil {
+			start = mapping.DateStart
+		}
+		title := ""
+		if i, ok := listed[mapping.EventID]; ok {
+			schedule[i].SeriesID = mapping.SeriesID
+			start = &schedule[i].Date
+			title = schedule[i].Title
+		} else if mapping.SeriesID != nil && start != nil && start.After(now) {
+			schedule = append(schedule, model.GroupScheduleItem{
+				Type:     model.ScheduleItemTypeEvent,
+				ID:       mapping.EventID,
+				Date:     *start,
+				SeriesID: mapping.SeriesID,
+			})
+		}
+		if start == nil || !start.After(now) || mapping.DateReminderSent != nil {
+			continue
+		}
+
+		userIDs, _ := mapping.GetScheduledReminderUserIDs(remindersDefault)
+		if len(userIDs) == 0 {
+			continue
+		}
+		// the reminder task sends the reminders of the events starting within the lead on its next run
+		reminderDate := start.Add(-eventReminderLead)
+		if reminderDate.Before(now) {
+			reminderDate = now
+		}
+		schedule = append(schedule, model.GroupScheduleItem{
+			Type:               model.ScheduleItemTypeEventReminder,
+			ID:                 mapping.EventID,
+			Title:              title,
+			Date:               reminderDate,
+			SeriesID:           mapping.SeriesID,
+			ReminderRecipients: len(userIDs),
+		})
+	}
+	return schedule
+}
+
+// calendarEventStartDate extracts the start date from a Calendar BB event. Supports RFC3339 string and unix timestamp values.
+func calendarEventStartDate(event map[string]interface{}) *time.Time {
+	for _, key := range []string{"start_date", "start_time"} {
+		switch value := event[key].(type) {
+		case string:
+			date, err := time.Parse(time.RFC3339, value)
+			if err == nil {
+				return &date
+			}
+		case float64:
+			date := time.Unix(int64(value), 0).UTC()
+			return &date
+		}
+	}
+	return nil
+}
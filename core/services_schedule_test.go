@@ -0,0 +1,79 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"testing"
+	"time"
+)
+
+func TestBuildEventMappingScheduleItemsReminders(t *testing.T) {
+	now := time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC)
+	soon := now.Add(2 * time.Hour)
+	later := now.Add(3 * 24 * time.Hour)
+	optIn := true
+	going := []model.EventRSVP{{UserID: "user-going", Status: model.EventRSVPStatusGoing}}
+	optedIn := []model.EventRSVP{{UserID: "user-opted-in", Reminder: &optIn}}
+
+	cases := []struct {
+		name             string
+		event            model.Event
+		remindersDefault bool
+		date             *time.Time // the date of the reminder, nil if there is no reminder
+		recipients       int
+	}{
+		{name: "reminder is due a day before the event", event: model.Event{EventID: "event-1", DateStart: &later, RSVPs: going},
+			remindersDefault: true, date: timePtr(later.Add(-eventReminderLead)), recipients: 1},
+		{name: "reminder of an event within a day is due on the next run", event: model.Event{EventID: "event-1", DateStart: &soon, RSVPs: going},
+			remindersDefault: true, date: &now, recipients: 1},
+		{name: "going members get no reminder when the group default is off", event: model.Event{EventID: "event-1", DateStart: &later, RSVPs: going}},
+		{name: "opted in members get the reminder when the group default is off", event: model.Event{EventID: "event-1", DateStart: &later, RSVPs: optedIn},
+			date: timePtr(later.Add(-eventReminderLead)), recipients: 1},
+		{name: "sent reminder is not given", event: model.Event{EventID: "event-1", DateStart: &soon, RSVPs: going, DateReminderSent: &now},
+			remindersDefault: true},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			schedule := buildEventMappingScheduleItems(model.GroupSchedule{}, []model.Event{tc.event}, tc.remindersDefault, now)
+
+			var reminders []model.GroupScheduleItem
+			for _, item := range schedule {
+				if item.Type == model.ScheduleItemTypeEventReminder {
+					reminders = append(reminders, item)
+				}
+			}
+			if tc.date == nil {
+				if len(reminders) > 0 {
+					t.Fatalf("reminders = %v, want none", reminders)
+				}
+				return
+			}
+			if len(reminders) != 1 {
+				t.Fatalf("reminders = %d, want 1", len(reminders))
+			}
+			if !reminders[0].Date.Equal(*tc.date) {
+				t.Errorf("reminder date = %v, want %v", reminders[0].Date, *tc.date)
+			}
+			if reminders[0].ReminderRecipients != tc.recipients {
+				t.Errorf("reminder recipients = %d, want %d", reminders[0].ReminderRecipients, tc.recipients)
+			}
+		})
+	}
+}
+
+func timePtr(value time.Time) *time.Time {
+	return &value
+}
@@ -24,7 +24,7 @@ import (
 	"time"
 )
 
-func (app Application) processCoreAccountsCleanup() {
+func (app *Application) processCoreAccountsCleanup() {
 	app.logger.Infof("processCoreAccountsCleanup:BEGIN")
 	defer app.logger.Infof("processCoreAccountsCleanup:END")
 
@@ -68,7 +68,7 @@ func (app Application) processCoreAccountsCleanup() {
 	}
 }
 
-func (app Application) deleteAppOrgUsersData(accountsIDs []string) {
+func (app *Application) deleteAppOrgUsersData(accountsIDs []string) {
 
 	//in transaction
 	errTr := app.storage.PerformTransaction(func(context storage.TransactionContext) error {
@@ -78,12 +78,37 @@ func (app Application) deleteAppOrgUsersData(accountsIDs []string) {
 			return err
 		}
 
+		err = app.storage.PullRSVPsFromEventsByUserIDs(nil, accountsIDs)
+		if err != nil {
+			app.logger.Errorf("error deleting event rsvps by account ID - %s", err)
+			return err
+		}
+
+		err = app.storage.DeleteEventAttendancesByUserIDs(nil, accountsIDs)
+		if err != nil {
+			app.logger.Errorf("error deleting event attendances by account ID - %s", err)
+			return err
+		}
+
+		err = app.storage.DeleteGroupMessagesByUserIDs(nil, accountsIDs)
+		if err != nil {
+			app.logger.Errorf("error deleting group messages by account ID - %s", err)
+			return err
+		}
+
 		err = app.storage.PullMembersFromPostsByUserIDs(nil, nil, accountsIDs)
 		if err != nil {
 			app.logger.Errorf("error deleting  members from event by account ID - %s", err)
 			return err
 		}
 
+		// keep an admin in the groups administered only by the deleted accounts
+		err = app.ensureGroupAdmins(context, accountsIDs)
+		if err != nil {
+			app.logger.Errorf("error keeping the group admins of the deleted accounts - %s", err)
+			return err
+		}
+
 		// delete the group memberships
 		err = app.storage.DeleteGroupMembershipsByAccountsIDs(nil, nil, accountsIDs)
 		if err != nil {
@@ -115,7 +140,7 @@ func (app Application) deleteAppOrgUsersData(accountsIDs []string) {
 	return
 }
 
-func (app Application) getAccountsIDs(memberships []model.DeletedMembership) []string {
+func (app *Application) getAccountsIDs(memberships []model.DeletedMembership) []string {
 	res := make([]string, len(memberships))
 	for i, item := range memberships {
 		res[i] = item.AccountID
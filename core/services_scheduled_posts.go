@@ -20,73 +20,100 @@ import (
 	"groups/driven/storage"
 	"log"
 	"time"
+
+	"github.com/google/uuid"
 )
 
-func (app Application) processScheduledPosts() error {
+// scheduledPostClaimLease is the time the instance has for sending the notification of a claimed post before another instance could take it over
+const scheduledPostClaimLease = 5 * time.Minute
+
+// scheduledPostsMaxClaims limits the posts notified per run, the rest are notified by the next runs
+const scheduledPostsMaxClaims = 500
+
+// scheduledPostMaxNotificationAttempts is the number of the claims after which a notification which still fails is given up
+const scheduledPostMaxNotificationAttempts = 5
+
+// processScheduledPosts sends the notifications of the due scheduled posts. Every post is claimed atomically with a token of the run
+// before its notification is sent, so only one instance sends it. The claims of the crashed runs are taken over once their lease expires,
+// so a notification could be repeated only if the instance crashes between sending it and marking the post as notified.
+// The notification is marked as failed once it fails on the last of the allowed attempts, so it is not claimed forever.
+func (app *Application) processScheduledPosts() error {
 
 	log.Printf("processScheduledPosts:BEGIN")
 	defer log.Printf("processScheduledPosts:END")
 
-	startTime := time.Now()
-	syncKey := "scheduled_posts"
-	transaction := func(context storage.TransactionContext) error {
-		err := app.checkForConcurentRun(context, startTime, syncKey)
+	claimToken := fmt.Sprintf("%s-%s", app.instanceID, uuid.NewString())
+	var notifiedCount, deferredCount, failedCount int
+	for i := 0; i < scheduledPostsMaxClaims; i++ {
+		post, err := app.storage.ClaimScheduledPost(nil, claimToken, time.Now().Add(scheduledPostClaimLease))
 		if err != nil {
-			return err
+			return fmt.Errorf("error claiming a scheduled post: %s", err)
+		}
+		if post == nil {
+			break
 		}
 
-		posts, err := app.storage.FindScheduledPosts(context)
+		group, err := app.storage.FindGroup(nil, post.ClientID, post.GroupID, nil)
 		if err != nil {
-			return err
+			// the claim expires and the post is retried by a later run
+			log.Printf("processScheduledPosts: error loading the group %s of post %s: %s", post.GroupID, post.ID, err)
+			failedCount += app.failScheduledPostNotification(post, claimToken)
+			continue
 		}
-
-		log.Printf("processScheduledPosts: Found %d scheduled posts for current the current time", len(posts))
-		var postIds []string
-		if len(posts) > 0 {
-			for _, post := range posts {
-				group, err := app.storage.FindGroup(context, post.ClientID, post.GroupID, nil)
+		if group != nil {
+			if deferUntil := group.QuietHoursEnd(time.Now()); deferUntil != nil {
+				err = app.storage.UpdatePostNotificationDeferred(nil, post.ID, claimToken, *deferUntil)
 				if err != nil {
-					return err
-				}
-				if group != nil {
-					err = app.sendGroupNotificationForNewPost(post.ClientID, &post.Creator.UserID, &post.Creator.Name, group, &post)
-					if err != nil {
-						return nil
-					}
-
-					postIds = append(postIds, post.ID)
+					log.Printf("processScheduledPosts: error deferring the notification of post %s: %s", post.ID, err)
+					continue
 				}
+				deferredCount++
+				log.Printf("processScheduledPosts: Notification for post %s deferred to %s by the group quiet hours", post.ID, deferUntil)
+				continue
 			}
-		}
-		log.Printf("processScheduledPosts: Successful send of %d notifications for scheduled posts", len(posts))
 
-		if len(postIds) > 0 {
-			err = app.storage.UpdateDateNotifiedForPostIDs(context, postIds, time.Now())
+			err = app.sendGroupNotificationForNewPost(post.ClientID, &post.Creator.UserID, &post.Creator.Name, group, post)
 			if err != nil {
-				return nil
+				log.Printf("processScheduledPosts: error sending the notification of post %s: %s", post.ID, err)
+				failedCount += app.failScheduledPostNotification(post, claimToken)
+				continue
 			}
 		}
 
-		// Finish task
-		endTime := time.Now()
-		err = app.storage.SaveSyncTimes(context, model.SyncTimes{StartTime: &startTime, EndTime: &endTime, Key: syncKey})
+		// the posts of the deleted groups are marked as notified as there is nobody to notify
+		updated, err := app.storage.UpdateClaimedPostNotified(nil, post.ID, claimToken, time.Now())
 		if err != nil {
-			return err
+			log.Printf("processScheduledPosts: error marking post %s as notified: %s", post.ID, err)
+			continue
 		}
+		if !updated {
+			log.Printf("processScheduledPosts: the claim of post %s expired before the notification was marked as sent", post.ID)
+			continue
+		}
+		notifiedCount++
+	}
+	log.Printf("processScheduledPosts: Successful send of %d notifications for scheduled posts, %d deferred, %d failed", notifiedCount, deferredCount, failedCount)
+
+	return nil
+}
 
-		return nil
+// failScheduledPostNotification marks the notification of the claimed post as failed once it has used all the attempts. Otherwise the claim
+// expires and the post is retried by a later run. It gives 1 if the notification is marked as failed.
+func (app *Application) failScheduledPostNotification(post *model.Post, claimToken string) int {
+	if post.NotificationAttempts < scheduledPostMaxNotificationAttempts {
+		return 0
 	}
 
-	err := app.storage.PerformTransaction(transaction)
+	err := app.storage.UpdateClaimedPostNotificationFailed(nil, post.ID, claimToken, time.Now())
 	if err != nil {
-		log.Printf("processScheduledPosts task running on another instance. error: %s", err)
-		return err
+		log.Printf("processScheduledPosts: error marking the notification of post %s as failed: %s", post.ID, err)
+		return 0
 	}
-
-	return nil
+	log.Printf("processScheduledPosts: the notification of post %s in group %s failed %d times, it is not retried anymore", post.ID, post.GroupID, post.NotificationAttempts)
+	return 1
 }
 
-func (app Application) checkForConcurentRun(context storage.TransactionContext, startTime time.Time, syncKey string) error {
+func (app *Application) checkForConcurentRun(context storage.TransactionContext, startTime time.Time, syncKey string) error {
 	times, err := app.storage.FindSyncTimes(context, "", "scheduled_posts", false)
 	if err != nil {
 		return err
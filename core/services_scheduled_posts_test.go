@@ -0,0 +1,76 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"groups/core/model"
+	"groups/driven/storage"
+	"testing"
+	"time"
+)
+
+// fakeScheduledPostsStorage is the storage of the scheduled post tests. The group of the post could not be loaded, so its notification
+// always fails. The claims do not expire, so every run claims the post again.
+type fakeScheduledPostsStorage struct {
+	Storage
+
+	post   model.Post
+	claims int
+}
+
+func (s *fakeScheduledPostsStorage) ClaimScheduledPost(context storage.TransactionContext, claimToken string, leaseExpiry time.Time) (*model.Post, error) {
+	if s.post.DateNotified != nil || s.post.DateNotificationFailed != nil || s.post.NotificationClaim == claimToken {
+		return nil, nil
+	}
+	s.claims++
+	s.post.NotificationClaim = claimToken
+	s.post.NotificationAttempts++
+	post := s.post
+	return &post, nil
+}
+
+func (s *fakeScheduledPostsStorage) FindGroup(context storage.TransactionContext, clientID string, groupID string, userID *string) (*model.Group, error) {
+	return nil, errors.New("database unavailable")
+}
+
+func (s *fakeScheduledPostsStorage) UpdateClaimedPostNotificationFailed(context storage.TransactionContext, id string, claimToken string, dateFailed time.Time) error {
+	if s.post.ID == id && s.post.NotificationClaim == claimToken {
+		s.post.DateNotificationFailed = &dateFailed
+		s.post.NotificationClaim = ""
+	}
+	return nil
+}
+
+func TestProcessScheduledPostsGivesUpFailingNotification(t *testing.T) {
+	scheduled := time.Now().Add(-time.Hour)
+	fakeStorage := &fakeScheduledPostsStorage{post: model.Post{ID: "post-1", ClientID: testClientID, GroupID: "group-1", DateScheduled: &scheduled}}
+	app := &Application{storage: fakeStorage, instanceID: "instance-1"}
+
+	for run := 0; run < scheduledPostMaxNotificationAttempts+3; run++ {
+		err := app.processScheduledPosts()
+		if err != nil {
+			t.Fatalf("error processing the scheduled posts - %s", err)
+		}
+		failed := fakeStorage.post.DateNotificationFailed != nil
+		if failed != (run+1 >= scheduledPostMaxNotificationAttempts) {
+			t.Fatalf("run %d: notification failed = %v with %d attempts", run+1, failed, fakeStorage.post.NotificationAttempts)
+		}
+	}
+
+	if fakeStorage.claims != scheduledPostMaxNotificationAttempts {
+		t.Errorf("claims = %d, want %d", fakeStorage.claims, scheduledPostMaxNotificationAttempts)
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// smartGroupAccountsPageSize is the number of accounts loaded per Core BB accounts request
+const smartGroupAccountsPageSize = 100
+
+// smartGroupBatchSize is the number of memberships saved per bulk write
+const smartGroupBatchSize = 1000
+
+func (app *Application) startSmartGroupSyncTask() {
+	_, err := app.scheduler.AddFunc("15 * * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.synchronizeSmartGroups(clientID)
+			if err != nil {
+				log.Printf("error syncing smart groups for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running smart group sync task: %s", err)
+	}
+	log.Printf("successful running of smart group sync task")
+}
+
+// updateSmartGroupRules sets the smart group rules of the group. Nil rules turn the group back to a regular group and keep the current members.
+func (app *Application) updateSmartGroupRules(clientID string, current *model.User, groupID string, rules *model.SmartGroupRules) error {
+	return app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		group, err := app.storage.FindGroup(context, clientID, groupID, nil)
+		if err != nil {
+			return err
+		}
+		if group == nil {
+			return fmt.Errorf("missing group for ID %s", groupID)
+		}
+		if group.AuthmanEnabled && rules != nil {
+			return errors.New("the Authman groups could not be smart groups")
+		}
+		err = app.storage.UpdateGroupSmartRules(context, clientID, groupID, rules)
+		if err != nil {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, groupID, model.AuditActionSmartGroupRulesUpdated, "group", groupID,
+			map[string]model.AuditChange{"smart_group_rules": {Old: group.SmartGroupRules, New: rules}})
+	})
+}
+
+// synchronizeSmartGroups synchronizes the memberships of all smart groups of the client
+func (app *Application) synchronizeSmartGroups(clientID string) error {
+	// only one instance runs the sync
+	acquired, releaseLock, err := app.acquireLock("smart_group_sync_"+clientID, smartGroupSyncLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the smart group sync lock for clientID %s: %s", clientID, err)
+	}
+	if !acquired {
+		log.Printf("smart group sync for clientID %s is running on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	groups, err := app.storage.FindSmartGroups(nil, clientID)
+	if err != nil {
+		return fmt.Errorf("error loading the smart groups: %s", err)
+	}
+
+	log.Printf("Smart group synchronization of %d groups started for clientID: %s", len(groups), clientID)
+	for _, group := range groups {
+		err := app.synchronizeSmartGroup(clientID, group.ID)
+		if err != nil {
+			log.Printf("error app.synchronizeSmartGroup() '%s' - %s", group.Title, err)
+		}
+	}
+	log.Printf("Smart group synchronization finished for clientID: %s", clientID)
+	return nil
+}
+
+// synchronizeSmartGroup reconciles the group memberships with the Core BB accounts matching the smart group rules the same way
+// as the full Authman sync - the matching accounts are added or updated with a new sync ID and the non admin members
+// without it are removed. The accounts without an external ID are skipped as the synchronized memberships are keyed by it.
+func (app *Application) synchronizeSmartGroup(clientID string, groupID string) error {
+	group, err := app.checkGroupSyncTimes(clientID, groupID)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		endTime := time.Now()
+		group.SyncEndTime = &endTime
+		err := app.storage.UpdateGroupSyncTimes(nil, clientID, group)
+		if err != nil {
+			log.Printf("Error saving group to end smart group sync for %s: %s\n", group.ID, err)
+		}
+	}()
+
+	if !group.IsSmartGroupSyncEligible() {
+		return fmt.Errorf("smart group synchronization failed for group '%s' due to bad settings", group.Title)
+	}
+
+	// nothing is removed if the candidate accounts could not be loaded
+	accounts, err := app.retrieveSmartGroupAccounts(group.SmartGroupRules)
+	if err != nil {
+		return fmt.Errorf("error loading the accounts for smart group '%s': %s", group.Title, err)
+	}
+
+	adminExternalIDsMap, err := app.findAuthmanGroupAdminExternalIDs(clientID, group)
+	if err != nil {
+		return err
+	}
+
+	bannedExternalIDsMap, err := app.findBannedExternalIDs(clientID, group.ID)
+	if err != nil {
+		return err
+	}
+
+	syncID := uuid.NewString()
+	var added, failed, skipped int64
+	operations := []storage.SingleMembershipOperation{}
+	saveBatch := func() {
+		addedIDs, err := app.storage.BulkUpdateGroupMembershipsByExternalID(clientID, group.ID, operations, false)
+		if err != nil {
+			log.Printf("Error on bulk saving %d memberships of smart group %s: %s\n", len(operations), group.ID, err)
+			failed += int64(len(operations))
+		} else {
+			added += int64(len(addedIDs))
+			app.welcomeMembershipIDs(clientID, group, addedIDs)
+		}
+		operations = []storage.SingleMembershipOperation{}
+	}
+
+	exists := map[string]bool{}
+	for _, account := range accounts {
+		externalID := account.GetExternalID()
+		if externalID == "" {
+			skipped++
+			continue
+		}
+		if exists[externalID] || bannedExternalIDsMap[externalID] {
+			continue
+		}
+		exists[externalID] = true
+
+		status := "member"
+		if adminExternalIDsMap[externalID] {
+			status = "admin"
+		}
+		userID := account.ID
+		operation := storage.SingleMembershipOperation{
+			ClientID:   clientID,
+			GroupID:    group.ID,
+			ExternalID: externalID,
+			UserID:     &userID,
+			Status:     &status,
+			SyncID:     &syncID,
+			Answers:    group.CreateMembershipEmptyAnswers(),
+		}
+		if name := account.GetFullName(); name != "" {
+			operation.Name = &name
+		}
+		if email := account.Profile.Email; email != "" {
+			operation.Email = &email
+		}
+		operations = append(operations, operation)
+
+		if len(operations) >= smartGroupBatchSize {
+			saveBatch()
+		}
+	}
+	if len(operations) > 0 {
+		saveBatch()
+	}
+
+	// the members could be removed only if all matching accounts are stored, otherwise they would be removed by mistake.
+	// The sync is audited within the same transaction.
+	var deleteCount int64
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		if failed == 0 {
+			deleteCount, err = app.storage.DeleteUnsyncedGroupMemberships(context, clientID, group.ID, syncID)
+			if err != nil {
+				return fmt.Errorf("error deleting removed memberships of smart group '%s': %s", group.Title, err)
+			}
+		}
+
+		err = app.storage.UpdateGroupStats(context, clientID, group.ID, false, false, true, true)
+		if err != nil {
+			return fmt.Errorf("error updating group stats for smart group '%s': %s", group.Title, err)
+		}
+
+		return app.recordAuditLog(context, clientID, nil, group.ID, model.AuditActionSmartGroupSync, "group", group.ID,
+			map[string]model.AuditChange{
+				"sync_id":       {New: syncID},
+				"matched_count": {New: len(accounts)},
+				"added_count":   {New: added},
+				"removed_count": {New: deleteCount},
+				"failed_count":  {New: failed},
+				"skipped_count": {New: skipped},
+			})
+	})
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to save %d memberships of smart group '%s'", failed, group.Title)
+	}
+	return nil
+}
+
+// retrieveSmartGroupAccounts gives all Core BB accounts matching the smart group rules
+func (app *Application) retrieveSmartGroupAccounts(rules *model.SmartGroupRules) ([]model.CoreAccount, error) {
+	var list []model.CoreAccount
+	limit := smartGroupAccountsPageSize
+	offset := 0
+	for {
+		buffer, err := app.corebb.GetAccounts(rules.Match, rules.AppID, rules.OrgID, &limit, &offset)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, buffer...)
+		if len(buffer) < limit {
+			break
+		}
+		offset += limit
+	}
+	return list, nil
+}
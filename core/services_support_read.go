@@ -0,0 +1,44 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"log"
+)
+
+// getGroupForSupportRead gives the group for the read-only access of the support staff. It gives nil if the user does not have the
+// support read permission or the group does not exist. Every granted access is recorded in the audit log of the viewed group.
+func (app *Application) getGroupForSupportRead(clientID string, current *model.User, groupID string, resource string) (*model.Group, error) {
+	if current == nil || current.IsAnonymous || !current.HasPermission(model.PermissionGroupsSupportRead) {
+		return nil, nil
+	}
+
+	group, err := app.getGroup(clientID, current, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if group == nil {
+		return nil, nil
+	}
+
+	log.Printf("app.getGroupForSupportRead() - %s reads the %s of group %s", current.ID, resource, groupID)
+	// the read is not a mutation, so there is no transaction to join
+	err = app.recordAuditLog(nil, clientID, current, groupID, model.AuditActionSupportRead, resource, groupID, nil)
+	if err != nil {
+		return nil, err
+	}
+	return group, nil
+}
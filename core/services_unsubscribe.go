@@ -0,0 +1,115 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/notifications"
+	"groups/driven/storage"
+	"log"
+	"time"
+)
+
+// unsubscribeTokenTTL the unsubscribe links stay valid for 60 days after the notification
+const unsubscribeTokenTTL = 60 * 24 * time.Hour
+
+// createUnsubscribeToken signs an unsubscribe token for the user. It gives nil when the unsubscribe links are not configured.
+func (app *Application) createUnsubscribeToken(clientID string, userID string, groupID string, scope string) *string {
+	if len(app.config.UnsubscribeTokenSecret) == 0 {
+		return nil
+	}
+	token := model.UnsubscribeToken{
+		ClientID:    clientID,
+		UserID:      userID,
+		GroupID:     groupID,
+		Scope:       scope,
+		DateExpires: time.Now().Add(unsubscribeTokenTTL).Unix(),
+	}.Sign(app.config.UnsubscribeTokenSecret)
+	return &token
+}
+
+// sendUnsubscribableNotification sends the notification with a personal unsubscribe token for every recipient.
+// The tokens are given as recipient data, so the notification is sent to all recipients with a single request and a failed
+// send can be retried without notifying anyone twice.
+func (app *Application) sendUnsubscribableNotification(clientID string, groupID string, scope string, recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string) error {
+	if len(app.config.UnsubscribeTokenSecret) == 0 {
+		return app.sendNotification(recipients, topic, title, text, data, appID, orgID, nil)
+	}
+
+	tokenRecipients := make([]notifications.Recipient, len(recipients))
+	for i, recipient := range recipients {
+		if token := app.createUnsubscribeToken(clientID, recipient.UserID, groupID, scope); token != nil {
+			recipientData := make(map[string]string, len(recipient.Data)+1)
+			for key, value := range recipient.Data {
+				recipientData[key] = value
+			}
+			recipientData["unsubscribe_token"] = *token
+			recipient.Data = recipientData
+		}
+		tokenRecipients[i] = recipient
+	}
+	return app.sendNotification(tokenRecipients, topic, title, text, data, appID, orgID, nil)
+}
+
+// unsubscribe mutes the notifications of the scope of a signed unsubscribe token. It gives nil if the token is not valid.
+func (app *Application) unsubscribe(token string) (*model.UnsubscribeResult, error) {
+	if len(app.config.UnsubscribeTokenSecret) == 0 {
+		log.Println("app.unsubscribe() - the unsubscribe links are not configured")
+		return nil, nil
+	}
+	payload, err := model.ParseUnsubscribeToken(token, app.config.UnsubscribeTokenSecret)
+	if err != nil {
+		log.Printf("app.unsubscribe() - %s", err)
+		return nil, nil
+	}
+
+	filter := model.MembershipFilter{
+		UserID:   &payload.UserID,
+		Statuses: []string{"member", "admin"},
+	}
+	if len(payload.GroupID) > 0 {
+		filter.GroupIDs = []string{payload.GroupID}
+	}
+	memberships, err := app.storage.FindGroupMemberships(payload.ClientID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	// the link is used without login, so the actor is identified by the token only
+	actor := &model.User{ID: payload.UserID}
+	result := model.UnsubscribeResult{Scope: payload.Scope, GroupIDs: []string{}}
+	for _, membership := range memberships.Items {
+		before := membership.NotificationsPreferences
+		preferences := membership.NotificationsPreferences
+		if !preferences.Mute(payload.Scope) {
+			continue
+		}
+
+		err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+			err := app.storage.UpdateMembershipNotificationsPreferences(context, payload.ClientID, membership.ID, preferences)
+			if err != nil {
+				return err
+			}
+
+			return app.recordAuditLog(context, payload.ClientID, actor, membership.GroupID, model.AuditActionNotificationsUnsubscribed, "membership", membership.ID,
+				model.NewAuditDiff(before, preferences))
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.GroupIDs = append(result.GroupIDs, membership.GroupID)
+	}
+	return &result, nil
+}
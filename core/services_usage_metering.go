@@ -0,0 +1,105 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"log"
+	"time"
+)
+
+func (app *Application) startUsageMeteringTask() {
+	_, err := app.scheduler.AddFunc("15 4 * * *", func() {
+		for _, clientID := range app.config.SupportedClientIDs {
+			err := app.meterUsage(clientID, time.Now())
+			if err != nil {
+				log.Printf("error metering the usage for clientID %s: %s", clientID, err)
+			}
+		}
+	})
+	if err != nil {
+		log.Printf("error on running usage metering task: %s", err)
+	}
+	log.Printf("successful running of usage metering task")
+}
+
+// meterUsage updates the usage record of the current month. The record of the previous month is finalized once, on the first run within the new month.
+func (app *Application) meterUsage(clientID string, now time.Time) error {
+	acquired, releaseLock, err := app.acquireLock("usage_metering_"+clientID, usageMeteringLockLease)
+	if err != nil {
+		return fmt.Errorf("error acquiring the usage metering lock: %s", err)
+	}
+	if !acquired {
+		log.Printf("usage metering for clientID %s is running on another instance", clientID)
+		return nil
+	}
+	defer releaseLock()
+
+	period := model.UsagePeriod(now)
+	periodStart, _, err := model.UsagePeriodRange(period)
+	if err != nil {
+		return err
+	}
+	err = app.meterUsagePeriod(clientID, model.UsagePeriod(periodStart.AddDate(0, -1, 0)), true)
+	if err != nil {
+		return err
+	}
+	return app.meterUsagePeriod(clientID, period, false)
+}
+
+// meterUsagePeriod counts and saves the usage of the client within the period. The final records are kept as they are.
+func (app *Application) meterUsagePeriod(clientID string, period string, final bool) error {
+	existing, err := app.storage.FindUsageRecord(nil, clientID, period)
+	if err != nil {
+		return fmt.Errorf("error finding the usage record of %s: %s", period, err)
+	}
+	if existing != nil && existing.Final {
+		return nil
+	}
+	if existing == nil && final {
+		// the service was not metering yet, the counts of the past period could not be reconstructed
+		return nil
+	}
+
+	from, to, err := model.UsagePeriodRange(period)
+	if err != nil {
+		return err
+	}
+	record, err := app.storage.CountUsage(nil, clientID, from, to)
+	if err != nil {
+		return fmt.Errorf("error counting the usage of %s: %s", period, err)
+	}
+	record.Period = period
+	record.Final = final
+
+	err = app.storage.SaveUsageRecord(nil, *record)
+	if err != nil {
+		return fmt.Errorf("error saving the usage record of %s: %s", period, err)
+	}
+	return nil
+}
+
+func (app *Application) getUsageRecords(clientID string, fromPeriod *string, toPeriod *string) ([]model.UsageRecord, error) {
+	for _, period := range []*string{fromPeriod, toPeriod} {
+		if period == nil {
+			continue
+		}
+		if _, _, err := model.UsagePeriodRange(*period); err != nil {
+			return nil, err
+		}
+	}
+	return app.storage.FindUsageRecords(nil, clientID, fromPeriod, toPeriod)
+}
@@ -0,0 +1,99 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// mirrorNotificationToInbox stores a summary of the sent notification for every recipient. Failures are only logged as the inbox is a secondary channel.
+func (app *Application) mirrorNotificationToInbox(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string, dateScheduled *time.Time) {
+	if len(recipients) == 0 {
+		return
+	}
+
+	dateCreated := time.Now()
+	if dateScheduled != nil {
+		dateCreated = *dateScheduled
+	}
+
+	var groupID *string
+	if data != nil && data["entity_type"] == "group" && data["entity_id"] != "" {
+		val := data["entity_id"]
+		groupID = &val
+	}
+
+	items := make([]model.UserNotification, 0, len(recipients))
+	for _, recipient := range recipients {
+		if recipient.UserID == "" {
+			continue
+		}
+		items = append(items, model.UserNotification{
+			ID:          uuid.NewString(),
+			AppID:       appID,
+			OrgID:       orgID,
+			UserID:      recipient.UserID,
+			GroupID:     groupID,
+			Topic:       topic,
+			Subject:     title,
+			Body:        text,
+			Data:        recipientNotificationData(data, recipient),
+			DateCreated: dateCreated,
+		})
+	}
+
+	err := app.storage.InsertUserNotifications(nil, items)
+	if err != nil {
+		log.Printf("app.mirrorNotificationToInbox() error storing %d inbox entries: %s", len(items), err)
+	}
+}
+
+func (app *Application) getUserNotifications(current *model.User, filter model.UserNotificationsFilter) ([]model.UserNotification, int64, error) {
+	list, err := app.storage.FindUserNotifications(nil, current.AppID, current.OrgID, current.ID, filter)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	unreadCount, err := app.storage.CountUnreadUserNotifications(nil, current.AppID, current.OrgID, current.ID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return list, unreadCount, nil
+}
+
+func (app *Application) markUserNotificationsRead(current *model.User, ids []string, read bool) (int64, error) {
+	return app.storage.UpdateUserNotificationsRead(nil, current.AppID, current.OrgID, current.ID, ids, read)
+}
+
+// recipientNotificationData gives the notification data with the personal data of the recipient merged over it
+func recipientNotificationData(data map[string]string, recipient notifications.Recipient) map[string]string {
+	if len(recipient.Data) == 0 {
+		return data
+	}
+	result := make(map[string]string, len(data)+len(recipient.Data))
+	for key, value := range data {
+		result[key] = value
+	}
+	for key, value := range recipient.Data {
+		result[key] = value
+	}
+	return result
+}
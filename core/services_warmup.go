@@ -0,0 +1,63 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"time"
+)
+
+// warmupGroupsLimit is the size of the group lists primed during the warmup
+const warmupGroupsLimit int64 = 100
+
+// warmup loads the sync configs, the managed group configs and the category visibility configs into the storage caches the handlers read
+// them from, then schedules the timers which need the sync configs. The frequently accessed group lists are primed optionally, so that the
+// first requests after deploy do not pay the cold start cost of the database. The API requests are served only after the warmup completes.
+// Failures are only logged as they must not block the service forever.
+func (app *Application) warmup() {
+	startTime := time.Now()
+	log.Println("warmup started")
+
+	err := app.storage.CacheConfigs()
+	if err != nil {
+		log.Printf("warmup error caching the configs: %s", err)
+	}
+
+	app.setupCronTimer()
+
+	if app.config.WarmupPrimeGroups {
+		for _, clientID := range app.config.SupportedClientIDs {
+			limit := warmupGroupsLimit
+			_, err := app.storage.FindGroups(clientID, nil, model.GroupsFilter{Limit: &limit})
+			if err != nil {
+				log.Printf("warmup error priming the groups list for %s: %s", clientID, err)
+			}
+		}
+	}
+
+	app.ready.Store(true)
+	log.Printf("warmup completed in %s", time.Since(startTime))
+}
+
+func (app *Application) isReady() bool {
+	return app.ready.Load()
+}
+
+// getExternalServicesMetrics gives the request metrics and the circuit breaker state of the external services
+func (app *Application) getExternalServicesMetrics() []utils.ResilientClientMetrics {
+	return utils.GetResilientClientsMetrics()
+}
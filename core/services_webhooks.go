@@ -0,0 +1,297 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/driven/storage"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// webhookMaxAttempts is the number of delivery attempts before the delivery is marked as failed
+	webhookMaxAttempts = 6
+	// webhookRetryBaseDelay is the delay before the first retry. It doubles on every next attempt.
+	webhookRetryBaseDelay = time.Minute
+	// webhookDeliveryBatchSize is the max number of deliveries processed on a single worker tick
+	webhookDeliveryBatchSize = 100
+	// webhookDeliveryClaimLease is the time the instance has for attempting a claimed delivery before another instance could take it over
+	webhookDeliveryClaimLease = 2 * time.Minute
+	// webhookSecretMaxOverlap is the max period the previous secret still signs the payloads after a rotation
+	webhookSecretMaxOverlap = 7 * 24 * time.Hour
+)
+
+func (app *Application) startWebhookDeliveryTask() {
+	_, err := app.scheduler.AddFunc("* * * * *", func() {
+		app.processWebhookDeliveries()
+	})
+	if err != nil {
+		log.Printf("error on running webhook delivery task: %s", err)
+	}
+	log.Printf("successful running of webhook delivery task")
+}
+
+// publishWebhookEvent queues the event for all active subscriptions of the client which are subscribed for the event type.
+// Failures are only logged as the webhooks must never break the main operation.
+func (app *Application) publishWebhookEvent(clientID string, eventType string, groupID string, data map[string]interface{}) {
+	subscriptions, err := app.storage.FindWebhookSubscriptions(nil, clientID, true)
+	if err != nil {
+		log.Printf("app.publishWebhookEvent() error loading webhook subscriptions for %s: %s", eventType, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	event := model.WebhookEvent{
+		ID:        uuid.NewString(),
+		Type:      eventType,
+		ClientID:  clientID,
+		GroupID:   groupID,
+		Data:      data,
+		Timestamp: now,
+	}
+
+	deliveries := []model.WebhookDelivery{}
+	for _, subscription := range subscriptions {
+		if !subscription.IsSubscribedFor(eventType) {
+			continue
+		}
+		deliveries = append(deliveries, model.WebhookDelivery{
+			ID:             uuid.NewString(),
+			ClientID:       clientID,
+			SubscriptionID: subscription.ID,
+			Event:          event,
+			Status:         model.WebhookDeliveryStatusPending,
+			NextAttempt:    &now,
+			DateCreated:    now,
+		})
+	}
+	if len(deliveries) == 0 {
+		return
+	}
+
+	err = app.storage.InsertWebhookDeliveries(nil, deliveries)
+	if err != nil {
+		log.Printf("app.publishWebhookEvent() error storing %d deliveries for %s: %s", len(deliveries), eventType, err)
+		return
+	}
+
+	go app.processWebhookDeliveries()
+}
+
+// processWebhookDeliveries attempts the due deliveries. Only one worker runs at a time within the instance. Every delivery is claimed
+// before the attempt, so the workers of the other instances do not deliver it again.
+func (app *Application) processWebhookDeliveries() {
+	if !app.webhookDeliveryLock.TryLock() {
+		return
+	}
+	defer app.webhookDeliveryLock.Unlock()
+
+	claimToken := fmt.Sprintf("%s-%s", app.instanceID, uuid.NewString())
+	subscriptions := map[string]*model.WebhookSubscription{}
+	for i := 0; i < webhookDeliveryBatchSize; i++ {
+		delivery, err := app.storage.ClaimWebhookDelivery(nil, claimToken, time.Now().UTC().Add(webhookDeliveryClaimLease))
+		if err != nil {
+			log.Printf("app.processWebhookDeliveries() error claiming a pending delivery: %s", err)
+			return
+		}
+		if delivery == nil {
+			return
+		}
+
+		subscription, ok := subscriptions[delivery.SubscriptionID]
+		if !ok {
+			subscription, err = app.storage.FindWebhookSubscription(nil, delivery.ClientID, delivery.SubscriptionID)
+			if err != nil {
+				// the claim expires, so the delivery is attempted again later
+				log.Printf("app.processWebhookDeliveries() error loading subscription %s: %s", delivery.SubscriptionID, err)
+				continue
+			}
+			subscriptions[delivery.SubscriptionID] = subscription
+		}
+
+		app.attemptWebhookDelivery(*delivery, subscription, claimToken)
+	}
+}
+
+func (app *Application) attemptWebhookDelivery(delivery model.WebhookDelivery, subscription *model.WebhookSubscription, claimToken string) {
+	now := time.Now().UTC()
+	delivery.DateUpdated = &now
+
+	if subscription == nil || !subscription.Active {
+		errMsg := "the subscription is disabled or deleted"
+		delivery.Status = model.WebhookDeliveryStatusFailed
+		delivery.LastError = &errMsg
+		delivery.NextAttempt = nil
+	} else {
+		delivery.Attempts++
+
+		payload, err := json.Marshal(delivery.Event)
+		if err == nil {
+			delivery.ResponseStatus, err = app.webhooks.Deliver(subscription.URL, subscription.SigningSecrets(now), delivery.Event.Type, payload)
+		}
+
+		if err == nil {
+			delivery.Status = model.WebhookDeliveryStatusDelivered
+			delivery.LastError = nil
+			delivery.NextAttempt = nil
+		} else {
+			errMsg := err.Error()
+			delivery.LastError = &errMsg
+			if delivery.Attempts >= webhookMaxAttempts {
+				delivery.Status = model.WebhookDeliveryStatusFailed
+				delivery.NextAttempt = nil
+			} else {
+				nextAttempt := now.Add(webhookRetryBaseDelay * time.Duration(1<<(delivery.Attempts-1)))
+				delivery.NextAttempt = &nextAttempt
+			}
+		}
+	}
+
+	updated, err := app.storage.UpdateWebhookDelivery(nil, delivery, claimToken)
+	if err != nil {
+		log.Printf("app.attemptWebhookDelivery() error updating delivery %s: %s", delivery.ID, err)
+	} else if !updated {
+		log.Printf("app.attemptWebhookDelivery() the claim of delivery %s has been taken over by another instance", delivery.ID)
+	}
+}
+
+func (app *Application) createWebhookSubscription(clientID string, url string, eventTypes []string, secret string) (*model.WebhookSubscription, string, error) {
+	err := app.webhooks.ValidateURL(url)
+	if err != nil {
+		return nil, "", err
+	}
+
+	for _, eventType := range eventTypes {
+		supported := false
+		for _, item := range model.WebhookEventTypes {
+			if item == eventType {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return nil, "", fmt.Errorf("unsupported webhook event type: %s", eventType)
+		}
+	}
+
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	subscription := model.WebhookSubscription{
+		ID:          uuid.NewString(),
+		ClientID:    clientID,
+		URL:         url,
+		Secret:      secret,
+		EventTypes:  eventTypes,
+		Active:      true,
+		DateCreated: time.Now().UTC(),
+	}
+	err = app.storage.InsertWebhookSubscription(nil, subscription)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return &subscription, secret, nil
+}
+
+func (app *Application) getWebhookSubscriptions(clientID string) ([]model.WebhookSubscription, error) {
+	return app.storage.FindWebhookSubscriptions(nil, clientID, false)
+}
+
+func (app *Application) updateWebhookSubscriptionActive(clientID string, id string, active bool) (bool, error) {
+	matched, err := app.storage.UpdateWebhookSubscriptionActive(nil, clientID, id, active)
+	if err != nil {
+		return false, err
+	}
+	return matched > 0, nil
+}
+
+func (app *Application) getWebhookDeliveries(clientID string, subscriptionID string, offset *int64, limit *int64) ([]model.WebhookDelivery, error) {
+	return app.storage.FindWebhookDeliveries(nil, clientID, subscriptionID, offset, limit)
+}
+
+// rotateWebhookSecret replaces the secret of the subscription. The payloads are signed with the previous secret too until the overlap ends,
+// so the subscriber could switch to the new secret without dropping deliveries. Zero overlap revokes the previous secret at once.
+func (app *Application) rotateWebhookSecret(clientID string, current *model.User, id string, secret string, overlap time.Duration) (*model.WebhookSubscription, string, error) {
+	if overlap < 0 || overlap > webhookSecretMaxOverlap {
+		return nil, "", fmt.Errorf("the overlap must be between 0 and %s", webhookSecretMaxOverlap)
+	}
+
+	subscription, err := app.storage.FindWebhookSubscription(nil, clientID, id)
+	if err != nil {
+		return nil, "", err
+	}
+	if subscription == nil {
+		return nil, "", nil
+	}
+
+	if secret == "" {
+		secret, err = generateWebhookSecret()
+		if err != nil {
+			return nil, "", err
+		}
+	}
+
+	now := time.Now().UTC()
+	var previousSecretExpires *time.Time
+	if overlap > 0 {
+		expires := now.Add(overlap)
+		previousSecretExpires = &expires
+	}
+
+	var matched int64
+	err = app.storage.PerformTransaction(func(context storage.TransactionContext) error {
+		var err error
+		matched, err = app.storage.UpdateWebhookSubscriptionSecret(context, clientID, id, secret, subscription.Secret, previousSecretExpires)
+		if err != nil || matched == 0 {
+			return err
+		}
+
+		return app.recordAuditLog(context, clientID, current, "", model.AuditActionWebhookSecretRotated, "webhook", id,
+			map[string]model.AuditChange{"date_previous_secret_expires": {Old: subscription.DatePreviousSecretExpires, New: previousSecretExpires}})
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	if matched == 0 {
+		return nil, "", nil
+	}
+
+	subscription.Secret = secret
+	subscription.PreviousSecret = ""
+	subscription.DatePreviousSecretExpires = previousSecretExpires
+	subscription.DateUpdated = &now
+	return subscription, secret, nil
+}
+
+func generateWebhookSecret() (string, error) {
+	randomBytes := make([]byte, 32)
+	_, err := rand.Read(randomBytes)
+	if err != nil {
+		return "", fmt.Errorf("error generating webhook secret: %s", err)
+	}
+	return hex.EncodeToString(randomBytes), nil
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package core
+
+import (
+	"fmt"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+)
+
+// welcomeMembers sends the welcome message of the group to the memberships which have just become members or admins.
+// The message is posted within the group to them too if the group asks for it. Failures are only logged.
+func (app *Application) welcomeMembers(clientID string, group *model.Group, memberships []model.GroupMembership) {
+	if group == nil || group.Settings == nil || group.Settings.WelcomeMessage == nil || !group.Settings.WelcomeMessage.Enabled {
+		return
+	}
+	welcome := group.Settings.WelcomeMessage
+
+	recipients := []notifications.Recipient{}
+	toMembers := []model.ToMember{}
+	for _, membership := range memberships {
+		if len(membership.UserID) == 0 || !membership.IsAdminOrMember() {
+			continue // the users without a Core BB account are welcomed once they are synchronized with one
+		}
+		recipients = append(recipients, membership.ToNotificationRecipient(membership.NotificationsPreferences.OverridePreferences &&
+			(membership.NotificationsPreferences.InvitationsMuted || membership.NotificationsPreferences.AllMute)))
+		toMembers = append(toMembers, model.ToMember{UserID: membership.UserID, ExternalID: membership.ExternalID, Name: membership.Name, Email: membership.Email})
+	}
+	if len(recipients) == 0 {
+		return
+	}
+
+	topic := "group.invitations"
+	err := app.sendNotification(
+		recipients,
+		&topic,
+		fmt.Sprintf("Welcome to %s", group.Title),
+		welcome.Text,
+		map[string]string{
+			"type":        "group",
+			"operation":   "membership_welcome",
+			"entity_type": "group",
+			"entity_id":   group.ID,
+			"entity_name": group.Title,
+		},
+		app.config.AppID,
+		app.config.OrgID,
+		nil,
+	)
+	if err != nil {
+		log.Printf("error sending the welcome message of group %s: %s", group.ID, err)
+	}
+
+	if welcome.AutoPost {
+		err = app.postWelcomeMessage(clientID, group, welcome.Text, toMembers)
+		if err != nil {
+			log.Printf("error posting the welcome message of group %s: %s", group.ID, err)
+		}
+	}
+}
+
+// welcomeMembershipIDs sends the welcome message of the group to the newly created memberships
+func (app *Application) welcomeMembershipIDs(clientID string, group *model.Group, membershipIDs []string) {
+	if len(membershipIDs) == 0 || group == nil || group.Settings == nil || group.Settings.WelcomeMessage == nil || !group.Settings.WelcomeMessage.Enabled {
+		return
+	}
+
+	memberships, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{GroupIDs: []string{group.ID}, IDs: membershipIDs})
+	if err != nil {
+		log.Printf("error loading the new memberships of group %s to welcome: %s", group.ID, err)
+		return
+	}
+	app.welcomeMembers(clientID, group, memberships.Items)
+}
+
+// postWelcomeMessage posts the welcome message within the group to the new members on behalf of a group admin.
+// The post is not notified as the members get the welcome notification already.
+func (app *Application) postWelcomeMessage(clientID string, group *model.Group, text string, toMembers []model.ToMember) error {
+	admins, err := app.storage.FindGroupMemberships(clientID, model.MembershipFilter{GroupIDs: []string{group.ID}, Statuses: []string{"admin"}})
+	if err != nil {
+		return fmt.Errorf("error loading the group admins: %s", err)
+	}
+	var author *model.User
+	for _, admin := range admins.Items {
+		if len(admin.UserID) > 0 {
+			author = &model.User{ID: admin.UserID, Name: admin.Name, Email: admin.Email}
+			break
+		}
+	}
+	if author == nil {
+		return fmt.Errorf("no admin to post on behalf of")
+	}
+
+	post := &model.Post{GroupID: group.ID, Subject: fmt.Sprintf("Welcome to %s", group.Title), Body: text, ToMembersList: toMembers}
+	_, err = app.storage.CreatePost(nil, clientID, author, post)
+	return err
+}
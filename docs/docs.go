@@ -37,24 +37,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/group/events/v3": {
-            "post": {
+        "/api/admin/abuse-reports": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Create a calendar event and link it to multiple group ids",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets the abuse reports of the posts and the groups ordered by the creation date (newest first)",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminCreateCalendarEventMultiGroup",
+                "operationId": "AdminGetAbuseReports",
                 "parameters": [
                     {
                         "type": "string",
@@ -64,50 +58,70 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
-                        }
+                        "type": "string",
+                        "description": "Comma separated group IDs",
+                        "name": "group_ids",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "description": "Reported post ID",
+                        "name": "post_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "post or group",
+                        "name": "target_type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses - open, reviewed, resolved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AbuseReport"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/event/{event-id}": {
-            "delete": {
+        "/api/admin/abuse-reports/{id}": {
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group event",
+                "description": "Applies a moderation action on an abuse report. The review action marks the report as reviewed. The dismiss, remove_post and warn_user actions resolve the report. The remove_post and warn_user actions are allowed only for the reports of posts. The resolved reports could not be moderated anymore.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminDeleteGroupEvent",
+                "operationId": "AdminModerateAbuseReport",
                 "parameters": [
                     {
                         "type": "string",
@@ -118,44 +132,43 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
+                        "description": "Abuse report ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "event-id",
-                        "in": "path",
-                        "required": true
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/moderateAbuseReportRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/AbuseReport"
                         }
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/events": {
-            "get": {
+        "/api/admin/account-links/repair": {
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the group events.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Finds the memberships which are not linked to a Core BB account yet and links them, together with the posts and the events addressed to the same external ID, to the accounts which exist already. The memberships of the groups the account is already part of are not claimed.",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupEvents",
+                "operationId": "AdminRepairAccountLinks",
                 "parameters": [
                     {
                         "type": "string",
@@ -163,12 +176,36 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AccountLinkRepairResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/archived-groups": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the deleted groups whose content is kept in the archive, the latest first. The data field holds the group as it was on the deletion.",
+                "tags": [
+                    "Admin"
+                ],
+                "operationId": "AdminGetArchivedGroups",
+                "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -178,31 +215,25 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "string"
+                                "$ref": "#/definitions/GroupArchiveItem"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/events/v3": {
-            "put": {
+        "/api/admin/archived-groups/{group-id}": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a calendar event and for a single group id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets the archived group, posts and event mappings of a deleted group",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminUpdateCalendarEventSingleGroup",
+                "operationId": "AdminGetGroupArchiveItems",
                 "parameters": [
                     {
                         "type": "string",
@@ -213,54 +244,58 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "group id",
-                        "name": "id",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
-                        }
+                        "type": "string",
+                        "description": "group, post or event",
+                        "name": "entity_type",
+                        "in": "query"
                     },
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupArchiveItem"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/admin/authman/groups/validate": {
             "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Create a calendar event and link it to a single group id",
+                "description": "Validates Authman group keys before mapping them to a group. Gives the member count of each key or the error of retrieving its members.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminCreateCalendarEventSingleGroup",
+                "operationId": "AdminValidateAuthmanGroups",
                 "parameters": [
                     {
                         "type": "string",
@@ -269,58 +304,41 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "group id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                            "$ref": "#/definitions/validateAuthmanGroupsRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuthmanSyncKeyResult"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/events/v3/load": {
-            "post": {
+        "/api/admin/authman/sync-runs": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gets the group calendar events",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the last Authman sync runs ordered by start date (newest first). Each run contains the start and end times, the counts of the added, removed and failed memberships and the error details.",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupCalendarEventsV3",
+                "operationId": "AdminGetAuthmanSyncRuns",
                 "parameters": [
                     {
                         "type": "string",
@@ -332,97 +350,79 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "name": "group_id",
+                        "in": "query"
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/GroupEventFilter"
-                        }
+                        "type": "string",
+                        "description": "Run type - global or group",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of runs. Default 20",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuthmanSyncRun"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/members": {
+        "/api/admin/category-visibility-config": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets the list of group members.",
-                "consumes": [
-                    "text/plain"
-                ],
+                "description": "Gets the group categories the group lists of the client may contain",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupMembers",
+                "operationId": "AdminGetCategoryVisibilityConfig",
                 "parameters": [
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/MembershipFilter"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "APP",
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/GroupMembership"
-                            }
+                            "$ref": "#/definitions/CategoryVisibilityConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/admin/group/{group-id}/members/v2": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets the list of group members.",
+                "description": "Saves the group categories the group lists of the client may contain. If allowed_categories is not empty only the groups of these categories are listed. The groups of denied_categories are never listed. The restriction is applied to the group list APIs of the client, the empty lists remove it.",
                 "consumes": [
                     "text/plain"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupMembersV2",
+                "operationId": "AdminSaveCategoryVisibilityConfig",
                 "parameters": [
                     {
                         "description": "body data",
@@ -430,7 +430,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/MembershipFilter"
+                            "$ref": "#/definitions/CategoryVisibilityConfig"
                         }
                     },
                     {
@@ -439,43 +439,27 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/GroupMembership"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/admin/group/{group-id}/stats": {
+        "/api/admin/content-filter-config": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Retrieves stats for a group by id",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the content policy applied on the posts",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupStats",
+                "operationId": "AdminGetContentFilterConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -483,40 +467,68 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ContentFilterConfig"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Saves the content policy applied on the created and the updated posts. The subject and the body of the posts are matched against the blocked words and, if external_moderation is set, checked with the external moderation API. The matching posts are rejected with error code 11 (reject), published and reported to the abuse report queue (flag) or only published with the content flags (annotate).",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "operationId": "AdminSaveContentFilterConfig",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ContentFilterConfig"
+                        }
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/GroupStats"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/admin/group/{groupID}/posts": {
+        "/api/admin/content-rate-limits-config": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "gets all posts for the desired group.",
+                "description": "Gets the anti-spam limits of the content created by a user within a group",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupPosts",
+                "operationId": "AdminGetContentRateLimitsConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -524,71 +536,41 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "groupID",
-                        "name": "groupID",
-                        "in": "query",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Values: message|post",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "asc|desc",
-                        "name": "order",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Post"
-                            }
+                            "$ref": "#/definitions/ContentRateLimitsConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/admin/group/{groupId}/posts/{postId}": {
-            "delete": {
+            },
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Updates a post within the desired group.",
+                "description": "Saves the anti-spam limits of the posts, the replies, the reactions and the membership requests created by a user within a group. Each limit allows max actions within window_seconds. The exceeding requests are rejected with 429 and Retry-After header.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminDeleteGroupPost",
+                "operationId": "AdminSaveContentRateLimitsConfig",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ContentRateLimitsConfig"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -604,24 +586,52 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/group/{id}": {
-            "delete": {
+        "/api/admin/deleted-groups": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group.",
-                "consumes": [
-                    "application/json"
+                "description": "Gets the deleted groups whose restore period has not passed yet, the latest deleted first. The content of a group is deleted or archived once its date_purge passes.",
+                "tags": [
+                    "Admin"
                 ],
-                "produces": [
-                    "application/json"
+                "operationId": "AdminGetDeletedGroups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Group"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/deleted-groups/{group-id}/restore": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
                 ],
+                "description": "Restores a deleted group with all its content within the restore period of the group deletion policy. The upcoming events cancelled on the deletion stay cancelled.",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminDeleteGroup",
+                "operationId": "AdminRestoreGroup",
                 "parameters": [
                     {
                         "type": "string",
@@ -632,8 +642,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
                     }
@@ -644,28 +654,31 @@ const docTemplate = `{
                         "schema": {
                             "type": "string"
                         }
+                    },
+                    "404": {
+                        "description": "Not found - the group is not deleted or its restore period has passed",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/api/admin/groups": {
+        "/api/admin/external-services/metrics": {
             "get": {
                 "security": [
-                    {
-                        "APIKeyAuth": []
-                    },
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the groups list. It can be filtered by category",
+                "description": "Gives the request metrics and the circuit breaker state of the external services used by the driven adapters",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetAllGroups",
+                "operationId": "AdminGetExternalServicesMetrics",
                 "parameters": [
                     {
                         "type": "string",
@@ -673,51 +686,6 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! category - filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
-                        "name": "privacy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! limit - limit the result",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
-                        "name": "include_hidden",
-                        "in": "query"
-                    },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/GroupsFilter"
-                        }
                     }
                 ],
                 "responses": {
@@ -726,28 +694,25 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Group"
+                                "$ref": "#/definitions/ResilientClientMetrics"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/admin/managed-group-configs": {
+        "/api/admin/group-deletion-jobs": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets managed group configs",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the last group deletion jobs ordered by start date (newest first). The content of a deleted group is deleted in batches in the background, each job contains the step in progress, the number of the deleted items per step and the error details. The failed jobs are resumed automatically.",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetManagedGroupConfigs",
+                "operationId": "AdminGetGroupDeletionJobs",
                 "parameters": [
                     {
                         "type": "string",
@@ -755,6 +720,24 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Job status - running, succeeded or failed",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of jobs. Default 20",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -763,36 +746,26 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ManagedGroupConfig"
+                                "$ref": "#/definitions/GroupDeletionJob"
                             }
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/admin/group-deletion-jobs/{id}": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates an existing managed group config",
-                "consumes": [
-                    "text/plain"
-                ],
+                "description": "Gets the progress of a group deletion job",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminUpdateManagedGroupConfig",
+                "operationId": "AdminGetGroupDeletionJob",
                 "parameters": [
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ManagedGroupConfig"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -802,7 +775,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "ID",
+                        "description": "Job ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -810,34 +783,27 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupDeletionJob"
+                        }
                     }
                 }
-            },
-            "post": {
+            }
+        },
+        "/api/admin/group-deletion-policy-config": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Creates a new managed group config",
-                "consumes": [
-                    "text/plain"
-                ],
+                "description": "Gets the handling of the content of the deleted groups",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminCreateManagedGroupConfig",
+                "operationId": "AdminGetGroupDeletionPolicyConfig",
                 "parameters": [
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/ManagedGroupConfig"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -850,38 +816,41 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/ManagedGroupConfig"
+                            "$ref": "#/definitions/GroupDeletionPolicyConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/admin/managed-group-configs/{id}": {
-            "delete": {
+            },
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a managed group config",
+                "description": "Saves the handling of the content of the deleted groups. The content is deleted by default. With archive_content the group, its posts and its event mappings are moved to the archive on the deletion instead. The archived content is available to the admins only and is purged once retention_days pass. With restore_days the deleted groups are excluded from all the queries and could be restored by the admins within the period before their content is deleted or archived.",
+                "consumes": [
+                    "text/plain"
+                ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminDeleteManagedGroupConfig",
+                "operationId": "AdminSaveGroupDeletionPolicyConfig",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupDeletionPolicyConfig"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -891,14 +860,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/admin/memberships/{membership-id}": {
-            "put": {
+        "/api/admin/group/events/v3": {
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a membership. Only the status can be changed.",
+                "description": "Create a calendar event and link it to multiple group ids",
                 "consumes": [
                     "application/json"
                 ],
@@ -908,7 +877,7 @@ const docTemplate = `{
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminUpdateMembership",
+                "operationId": "AdminCreateCalendarEventMultiGroup",
                 "parameters": [
                     {
                         "type": "string",
@@ -923,30 +892,35 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/updateMembershipRequest"
+                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "Membership ID",
-                        "name": "membership-id",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
+                        }
                     }
                 }
-            },
+            }
+        },
+        "/api/admin/group/{group-id}/event/{event-id}": {
             "delete": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a membership",
+                "description": "Deletes a group event",
                 "consumes": [
                     "application/json"
                 ],
@@ -956,7 +930,7 @@ const docTemplate = `{
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminDeleteMembership",
+                "operationId": "AdminDeleteGroupEvent",
                 "parameters": [
                     {
                         "type": "string",
@@ -967,34 +941,44 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Membership ID",
-                        "name": "membership-id",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/api/admin/sync-configs": {
+        "/api/admin/group/{group-id}/events": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets sync config",
+                "description": "Gives the group events.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetSyncConfigs",
+                "operationId": "AdminGetGroupEvents",
                 "parameters": [
                     {
                         "type": "string",
@@ -1002,6 +986,13 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1010,63 +1001,89 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/model.SyncConfig"
+                                "type": "string"
                             }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/admin/group/{group-id}/events/v3": {
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Saves sync config",
+                "description": "Updates a calendar event and for a single group id",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminSaveSyncConfig",
+                "operationId": "AdminUpdateCalendarEventSingleGroup",
                 "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "group id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/model.SyncConfig"
+                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                        }
                     }
                 }
-            }
-        },
-        "/api/admin/user/event/{event-id}/groups": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates the group mappings for an event with id",
+                "description": "Create a calendar event and link it to a single group id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateGroupMappingsEventID",
+                "operationId": "AdminCreateCalendarEventSingleGroup",
                 "parameters": [
                     {
                         "type": "string",
@@ -1077,8 +1094,24 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event-id",
+                        "description": "group id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
                     }
@@ -1087,24 +1120,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/getPutAdminGroupIDsForEventIDRequestAndResponse"
+                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
                         }
                     }
                 }
             }
         },
-        "/api/admin/user/event/{event_id}/groups": {
-            "get": {
+        "/api/admin/group/{group-id}/events/v3/load": {
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Get all group IDs where the current user is an admin",
+                "description": "Gets the group calendar events",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetAdminGroupIDsForEventID",
+                "operationId": "AdminGetGroupCalendarEventsV3",
                 "parameters": [
                     {
                         "type": "string",
@@ -1115,41 +1154,55 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event-id",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/GroupEventFilter"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/getPutAdminGroupIDsForEventIDRequestAndResponse"
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/admin/user/groups": {
+        "/api/admin/group/{group-id}/members": {
             "get": {
                 "security": [
-                    {
-                        "APIKeyAuth": []
-                    },
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the groups list. It can be filtered by category",
+                "description": "Gets the list of group members.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetUserGroups",
+                "operationId": "AdminGetGroupMembers",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MembershipFilter"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -1159,39 +1212,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Filtering by group's title (case-insensitive)",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "category - filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "privacy - filter by privacy",
-                        "name": "privacy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "offset - skip number of records",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "limit - limit the result",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
-                        "name": "include_hidden",
-                        "in": "query"
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1200,29 +1224,38 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Group"
+                                "$ref": "#/definitions/GroupMembership"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/admin/v2/groups": {
-            "get": {
+        "/api/admin/group/{group-id}/members/v2": {
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the groups list. It can be filtered by category, title and privacy. V2",
+                "description": "Gets the list of group members. The search filter finds the members by a case-insensitive part of the name, the email or the NetID.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroupsV2",
+                "operationId": "AdminGetGroupMembersV2",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MembershipFilter"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -1232,48 +1265,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! category - filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
-                        "name": "privacy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! limit - limit the result",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
-                        "name": "include_hidden",
-                        "in": "query"
-                    },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/GroupsFilter"
-                        }
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -1282,28 +1277,28 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Group"
+                                "$ref": "#/definitions/GroupMembership"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/admin/v2/groups/{id}": {
-            "get": {
+        "/api/admin/group/{group-id}/posts/{postID}/restore": {
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives a group. V2",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Restores a deleted post together with the replies which have been deleted with it. A reply can be restored only if its parent post is not deleted.",
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetGroup",
+                "operationId": "AdminRestoreGroupPost",
                 "parameters": [
                     {
                         "type": "string",
@@ -1314,8 +1309,15 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
                         "in": "path",
                         "required": true
                     }
@@ -1324,30 +1326,27 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Group"
+                            "$ref": "#/definitions/model.Post"
                         }
                     }
                 }
             }
         },
-        "/api/admin/v2/user/groups": {
-            "get": {
+        "/api/admin/group/{group-id}/smart-rules": {
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives the user groups. It can be filtered by category, title and privacy. V2.",
+                "description": "Sets the rules the group membership is derived from. The match attributes are passed as search params to the Core BB accounts API and the matching accounts become members on the next smart group sync. The non admin members not matching the rules are removed. Requires the managed_group_admin permission.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminGetUserGroupsV2",
+                "operationId": "AdminUpdateSmartGroupRules",
                 "parameters": [
                     {
                         "type": "string",
@@ -1358,39 +1357,10 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! category - filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
-                        "name": "privacy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! limit - limit the result",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
-                        "name": "include_hidden",
-                        "in": "query"
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     },
                     {
                         "description": "body data",
@@ -1398,178 +1368,112 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/GroupsFilter"
+                            "$ref": "#/definitions/SmartGroupRules"
                         }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Group"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/analytics/groups": {
-            "get": {
+            },
+            "delete": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Gets groups",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Removes the smart group rules, so the group becomes a regular group. The current members are kept. Requires the managed_group_admin permission.",
                 "tags": [
-                    "Analytics"
+                    "Admin"
                 ],
-                "operationId": "AnalyticsGetGroups",
+                "operationId": "AdminDeleteSmartGroupRules",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Start date string - RFC3339 encoded",
-                        "name": "start_date",
-                        "in": "query"
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date string - RFC3339 encoded",
-                        "name": "end_date",
-                        "in": "query"
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/rest.analyticsGetGroupsResponse"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/analytics/members": {
-            "get": {
+        "/api/admin/group/{group-id}/smart-rules/synchronize": {
+            "post": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Gets groups members",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Synchronizes the memberships of a smart group with the Core BB accounts matching its rules without waiting for the periodic sync. Requires the managed_group_admin permission.",
                 "tags": [
-                    "Analytics"
+                    "Admin"
                 ],
-                "operationId": "AnalyticsGetGroupsMembers",
+                "operationId": "AdminSynchronizeSmartGroup",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Start date string - RFC3339 encoded",
-                        "name": "start_date",
-                        "in": "query"
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "End date string - RFC3339 encoded",
-                        "name": "end_date",
-                        "in": "query"
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/rest.analyticsGetGroupsMembersResponse"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/analytics/posts": {
+        "/api/admin/group/{group-id}/stats": {
             "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Gets posts",
+                "description": "Retrieves stats for a group by id",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Analytics"
+                    "Admin"
                 ],
-                "operationId": "AnalyticsGetPosts",
+                "operationId": "AdminGetGroupStats",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group_id",
-                        "in": "query"
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Start date string - RFC3339 encoded",
-                        "name": "start_date",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "End date string - RFC3339 encoded",
-                        "name": "end_date",
-                        "in": "query"
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/rest.analyticsGetPostsResponse"
-                            }
-                        }
-                    }
-                }
-            }
-        },
-        "/api/bbs/groups/{user_id}/memberships": {
-            "get": {
-                "security": [
-                    {
-                        "AppUserAuth": []
-                    }
-                ],
-                "description": "Gets all related group memberships status and group title using userID",
-                "tags": [
-                    "BBS"
-                ],
-                "operationId": "GetGroupMemberships",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "User ID",
-                        "name": "user_id",
+                        "description": "Group ID",
+                        "name": "group-id",
                         "in": "path",
                         "required": true
                     }
@@ -1580,34 +1484,25 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "type": "array",
-                                "items": {
-                                    "$ref": "#/definitions/GetGroupMembershipsResponse"
-                                }
+                                "$ref": "#/definitions/GroupStats"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/group/events/v3": {
-            "post": {
+        "/api/admin/group/{groupID}/posts": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Create a calendar event and link it to multiple group ids",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "gets all posts for the desired group.",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateCalendarEventMultiGroup",
+                "operationId": "AdminGetGroupPosts",
                 "parameters": [
                     {
                         "type": "string",
@@ -1617,47 +1512,68 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
-                        }
+                        "type": "string",
+                        "description": "groupID",
+                        "name": "groupID",
+                        "in": "query",
+                        "required": true
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "description": "Values: message|post",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc|desc",
+                        "name": "order",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Post"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/group/{group-id}/authman/synchronize": {
-            "post": {
+        "/api/admin/group/{groupId}/posts/{postId}": {
+            "delete": {
                 "security": [
                     {
                         "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Synchronizes Authman group. Only admin of the group could initiate the operation",
+                "description": "Updates a post within the desired group.",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "SynchAuthmanGroup",
+                "operationId": "AdminDeleteGroupPost",
                 "parameters": [
                     {
                         "type": "string",
@@ -1665,13 +1581,6 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -1681,14 +1590,14 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/{group-id}/event/{event-id}": {
+        "/api/admin/group/{id}": {
             "delete": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group event",
+                "description": "Deletes a group. The group is excluded from all the queries at once and its content is deleted in the background once the restore period of the group deletion policy passes, the progress is given by the admin group deletion jobs API. The event mappings of the group are archived and the members who responded to the upcoming events are notified.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1696,9 +1605,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "DeleteGroupEvent",
+                "operationId": "AdminDeleteGroup",
                 "parameters": [
                     {
                         "type": "string",
@@ -1709,17 +1618,16 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
+                        "description": "ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "event-id",
-                        "in": "path",
-                        "required": true
+                        "type": "boolean",
+                        "description": "Cancels the upcoming events linked only to the group in the Calendar BB",
+                        "name": "cancel_events",
+                        "in": "query"
                     }
                 ],
                 "responses": {
@@ -1732,24 +1640,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/{group-id}/events": {
+        "/api/admin/group/{id}/analytics": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives the group events.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets weekly time-series stats of a group: posts, new members and events per week, the total events count and the count of members who posted or reacted within the last 30 days",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupEvents",
+                "operationId": "AdminGetGroupAnalytics",
                 "parameters": [
                     {
                         "type": "string",
@@ -1761,40 +1663,39 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "description": "Group ID",
-                        "name": "group-id",
+                        "name": "id",
                         "in": "path",
                         "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of weeks including the current one - 12 by default, 52 max",
+                        "name": "weeks",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
+                            "$ref": "#/definitions/GroupAnalytics"
                         }
                     }
                 }
-            },
-            "put": {
+            }
+        },
+        "/api/admin/group/{id}/attendance": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a group event",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets the per-member attendance counts across the events of an attendance group. The current members without attendance are included with zero counts, the former members who attended within the range are included with an empty status. Use format=csv to export the report.",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateGroupEvent",
+                "operationId": "AdminGetGroupAttendance",
                 "parameters": [
                     {
                         "type": "string",
@@ -1803,99 +1704,60 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/groupEventRequest"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "Group ID",
-                        "name": "group-id",
+                        "name": "id",
                         "in": "path",
                         "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
-                }
-            },
-            "post": {
-                "security": [
-                    {
-                        "AppUserAuth": []
-                    }
-                ],
-                "description": "Creates a group event",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Client"
-                ],
-                "operationId": "CreateGroupEvent",
-                "parameters": [
+                    },
                     {
                         "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
+                        "description": "Start date string - RFC3339 encoded",
+                        "name": "start_date",
+                        "in": "query"
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/groupEventRequest"
-                        }
+                        "type": "string",
+                        "description": "End date string - RFC3339 encoded",
+                        "name": "end_date",
+                        "in": "query"
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "description": "json (default) or csv",
+                        "name": "format",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/GroupAttendanceReport"
                         }
                     }
                 }
             }
         },
-        "/api/group/{group-id}/events/v2": {
+        "/api/admin/groups": {
             "get": {
                 "security": [
+                    {
+                        "APIKeyAuth": []
+                    },
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the group events.",
+                "description": "Gives the groups list. It can be filtered by category",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupEventsV2",
+                "operationId": "AdminGetAllGroups",
                 "parameters": [
                     {
                         "type": "string",
@@ -1906,10 +1768,54 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! category - filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
+                        "name": "privacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! limit - limit the result",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
+                        "name": "include_hidden",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gives the groups created by the account, the research groups included",
+                        "name": "creator_id",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupsFilter"
+                        }
                     }
                 ],
                 "responses": {
@@ -1918,31 +1824,25 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/Event"
+                                "$ref": "#/definitions/Group"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/group/{group-id}/events/v3": {
-            "put": {
+        "/api/admin/groups/admin-needed": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a calendar event and for a single group id",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gives the groups whose last admin has deleted the account while there was no member to promote. An org admin has to appoint an admin through the admin memberships API, which clears the flag.",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateCalendarEventSingleGroup",
+                "operationId": "AdminGetGroupsNeedingAdmin",
                 "parameters": [
                     {
                         "type": "string",
@@ -1950,47 +1850,29 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "group id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
-                        }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Group"
+                            }
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/admin/groups/cleanup": {
             "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Create a calendar event and link it to a single group id",
+                "description": "Archives or deletes all the groups created by the account, i.e. when the account is found to be abusive. Archive hides the groups from the search and blocks the new membership requests, delete follows the group deletion policy so the groups could be restored within the restore period. Use the dry run to review the matched groups first and exclude the groups to be kept. The managed groups are skipped unless the admin has the managed_group_admin permission. At most 500 groups are cleaned up at once.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1998,9 +1880,9 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateCalendarEventSingleGroup",
+                "operationId": "AdminCleanupCreatorGroups",
                 "parameters": [
                     {
                         "type": "string",
@@ -2009,58 +1891,44 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "type": "string",
-                        "description": "group id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                            "$ref": "#/definitions/cleanupCreatorGroupsRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                            "$ref": "#/definitions/CreatorGroupsCleanupResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - too many groups",
+                        "schema": {
+                            "type": "string"
                         }
                     }
                 }
             }
         },
-        "/api/group/{group-id}/events/v3/load": {
-            "post": {
+        "/api/admin/groups/{id}/audit": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gets the group calendar events",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the audit log of privileged mutations within a group ordered by date (newest first)",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupCalendarEventsV3",
+                "operationId": "AdminGetGroupAuditLogs",
                 "parameters": [
                     {
                         "type": "string",
@@ -2072,95 +1940,86 @@ const docTemplate = `{
                     {
                         "type": "string",
                         "description": "Group ID",
-                        "name": "group-id",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     },
                     {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "schema": {
-                            "$ref": "#/definitions/GroupEventFilter"
-                        }
+                        "type": "string",
+                        "description": "Comma separated list of action types. Example: group.updated,post.deleted",
+                        "name": "action",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuditLog"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/group/{group-id}/members": {
+        "/api/admin/internal-event-services-config": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets the list of group members. The result would be empty if the current user doesn't belong to the requested group.",
-                "consumes": [
-                    "text/plain"
-                ],
+                "description": "Gets the services allowed to link the events to the groups of the client through the internal API",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateMember",
+                "operationId": "AdminGetInternalEventServicesConfig",
                 "parameters": [
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/MembershipFilter"
-                        }
-                    },
                     {
                         "type": "string",
                         "description": "APP",
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/GroupMembership"
-                            }
+                            "$ref": "#/definitions/InternalEventServicesConfig"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Adds a member to a group. The current user is required to be an admin of the group",
+                "description": "Saves the services allowed to link the events to the groups of the client through the internal API. A service, identified by the service query param of the internal API, may link the events to the groups having any of its categories or matching all its attributes. Once the config is saved, the services missing from it are not allowed to link any events.",
                 "consumes": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateMember",
+                "operationId": "AdminSaveInternalEventServicesConfig",
                 "parameters": [
                     {
                         "description": "body data",
@@ -2168,7 +2027,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/createMemberRequest"
+                            "$ref": "#/definitions/InternalEventServicesConfig"
                         }
                     },
                     {
@@ -2177,13 +2036,6 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2191,21 +2043,23 @@ const docTemplate = `{
                         "description": "OK"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/admin/license-config": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a member membership from a group",
+                "description": "Gets the licensing metadata attached to the analytics exports and the public groups feeds",
                 "consumes": [
-                    "text/plain"
+                    "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "DeleteMember",
+                "operationId": "AdminGetLicenseConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2213,40 +2067,31 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfuly deleted",
+                        "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/LicenseConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/{group-id}/members/multi-update": {
+            },
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates multiple members in a group at once with status and other details",
+                "description": "Saves the licensing metadata attached to the analytics exports and the public groups feeds. The license and terms links are sent as Link headers, the license name and the attribution as X-Content-License and X-Content-Attribution headers.",
                 "consumes": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "MultiUpdateMembers",
+                "operationId": "AdminSaveLicenseConfig",
                 "parameters": [
                     {
                         "description": "body data",
@@ -2254,7 +2099,7 @@ const docTemplate = `{
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/MembershipMultiUpdate"
+                            "$ref": "#/definitions/LicenseConfig"
                         }
                     },
                     {
@@ -2263,13 +2108,6 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
@@ -2279,24 +2117,21 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/{group-id}/pending-members": {
-            "post": {
+        "/api/admin/managed-group-configs": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Creates a group pending member",
+                "description": "Gets managed group configs",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreatePendingMember",
+                "operationId": "AdminGetManagedGroupConfigs",
                 "parameters": [
                     {
                         "type": "string",
@@ -2304,54 +2139,44 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/createPendingMemberRequest"
-                        }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    },
-                    "423": {
-                        "description": "Locked",
-                        "schema": {
-                            "type": "string"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ManagedGroupConfig"
+                            }
                         }
                     }
                 }
             },
-            "delete": {
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group pending member",
+                "description": "Updates an existing managed group config",
                 "consumes": [
                     "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "DeletePendingMember",
+                "operationId": "AdminUpdateManagedGroupConfig",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ManagedGroupConfig"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -2361,81 +2186,72 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
+                        "description": "ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Successfuly deleted",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "OK"
                     }
                 }
-            }
-        },
-        "/api/group/{group-id}/stats": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Retrieves stats for a group by id",
+                "description": "Creates a new managed group config",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupStats",
+                "operationId": "AdminCreateManagedGroupConfig",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/ManagedGroupConfig"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/GroupStats"
-                            }
+                            "$ref": "#/definitions/ManagedGroupConfig"
                         }
                     }
                 }
             }
         },
-        "/api/group/{groupID}/posts": {
-            "get": {
+        "/api/admin/managed-group-configs/{id}": {
+            "delete": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "gets all posts for the desired group.",
+                "description": "Deletes a managed group config",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupPosts",
+                "operationId": "AdminDeleteManagedGroupConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2446,70 +2262,31 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "groupID",
-                        "name": "groupID",
-                        "in": "query",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Values: message|post",
-                        "name": "type",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "offset",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "integer",
-                        "description": "limit",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "asc|desc",
-                        "name": "order",
-                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.Post"
-                            }
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/group/{groupId}/posts": {
-            "post": {
+        "/api/admin/membership-pruning-config": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "creates a post within the desired group.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets the policy for pruning the memberships of long-inactive users",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateGroupPost",
+                "operationId": "AdminGetMembershipPruningConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2523,31 +2300,35 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/model.Post"
+                            "$ref": "#/definitions/MembershipPruningConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/{groupId}/posts/{postId}": {
-            "get": {
+            },
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gets a post within the desired group.",
+                "description": "Saves the policy for pruning the memberships of long-inactive users. Every night the members who have not logged in for inactivity_days are flagged and notified. With auto_remove the flagged memberships are removed after grace_period_days unless the user logs in again. Admins and Authman memberships are never pruned.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupPost",
+                "operationId": "AdminSaveMembershipPruningConfig",
                 "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MembershipPruningConfig"
+                        }
+                    },
                     {
                         "type": "string",
                         "description": "APP",
@@ -2558,30 +2339,29 @@ const docTemplate = `{
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/model.Post"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
+            }
+        },
+        "/api/admin/memberships/{membership-id}": {
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Updates a post within the desired group.",
+                "description": "Updates a membership. Only the status and the show_as_leader flag can be changed.",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateGroupPost",
+                "operationId": "AdminUpdateMembership",
                 "parameters": [
                     {
                         "type": "string",
@@ -2589,13 +2369,32 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateMembershipRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "OK"
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
                         "schema": {
-                            "$ref": "#/definitions/model.Post"
+                            "type": "string"
                         }
                     }
                 }
@@ -2604,19 +2403,19 @@ const docTemplate = `{
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Updates a post within the desired group.",
+                "description": "Deletes a membership",
                 "consumes": [
                     "application/json"
                 ],
+                "produces": [
+                    "application/json"
+                ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "DeleteGroupPost",
+                "operationId": "AdminDeleteMembership",
                 "parameters": [
                     {
                         "type": "string",
@@ -2624,33 +2423,40 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK"
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
+                        "schema": {
+                            "type": "string"
+                        }
                     }
                 }
             }
         },
-        "/api/group/{groupId}/posts/{postId}/reactions": {
-            "put": {
+        "/api/admin/pending-membership-config": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Reacts to a post within the desired group.",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the policy for the expiration of the pending membership requests and the reminders of the group admins",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "ReactToGroupPost",
+                "operationId": "AdminGetPendingMembershipConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2664,43 +2470,41 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/PendingMembershipConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/{groupId}/posts/{postId}/report/abuse": {
+            },
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Reports an abusive group post",
+                "description": "Saves the policy for the pending membership requests. Every day the requests pending for longer than expiration_days are rejected and the users are notified. The admins of the groups with requests pending for longer than reminder_days are reminded at most once per reminder_days. Zero disables the expiration or the reminders.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "ReportAbuseGroupPost",
+                "operationId": "AdminSavePendingMembershipConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/reportAbuseGroupPostRequestBody"
+                            "$ref": "#/definitions/PendingMembershipConfig"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2710,24 +2514,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/group/{id}": {
-            "delete": {
+        "/api/admin/post-limits-config": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets the size rules of the posts",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "DeleteGroup",
+                "operationId": "AdminGetPostLimitsConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2735,56 +2533,47 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/PostLimitsConfig"
                         }
                     }
                 }
-            }
-        },
-        "/api/group/{id}/report/abuse": {
+            },
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Reports an abusive group",
+                "description": "Saves the size rules of the posts. The missing limits are not applied. The posts exceeding the limits are rejected with error code 10 and the exceeded limit in the error details. The limits are exposed to the clients by the client config API.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "ReportAbuseGroup",
+                "operationId": "AdminSavePostLimitsConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/reportAbuseGroupRequestBody"
+                            "$ref": "#/definitions/PostLimitsConfig"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
@@ -2794,24 +2583,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/groups": {
+        "/api/admin/post-notification-batching-config": {
             "get": {
                 "security": [
-                    {
-                        "APIKeyAuth": []
-                    },
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the groups list. It can be filtered by category",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the batching of the post notifications of the groups with bursty activity",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroups",
+                "operationId": "AdminGetPostNotificationBatchingConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2819,128 +2602,71 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
-                        "name": "title",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! category - filter by category",
-                        "name": "category",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
-                        "name": "privacy",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! limit - limit the result",
-                        "name": "limit",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
-                        "name": "include_hidden",
-                        "in": "query"
-                    },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/GroupsFilter"
-                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/Group"
-                            }
+                            "$ref": "#/definitions/PostNotificationBatchingConfig"
                         }
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Creates a group. The user must be part of urn:mace:uiuc.edu:urbana:authman:app-rokwire-service-policy-rokwire groups access. Title must be a unique. Category must be one of the categories list. Privacy can be public or private",
+                "description": "Saves the batching of the post notifications. The first post of a group is notified immediately and the posts following it within window_minutes are notified within a single \"N new posts in {group}\" summary once the window ends. The replies, the messages to selected members and the posts used as notifications are not batched. Zero disables the batching.",
                 "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "CreateGroup",
+                "operationId": "AdminSavePostNotificationBatchingConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/createGroupRequest"
+                            "$ref": "#/definitions/PostNotificationBatchingConfig"
                         }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/createResponse"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/groups/{id}": {
+        "/api/admin/privacy-config": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives a group",
+                "description": "Gets the privacy config applied to the analytics and stats exports",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroup",
+                "operationId": "AdminGetPrivacyConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -2948,20 +2674,13 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/getGroupResponse"
+                            "$ref": "#/definitions/PrivacyConfig"
                         }
                     }
                 }
@@ -2972,84 +2691,62 @@ const docTemplate = `{
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a group.",
+                "description": "Saves the privacy config applied to the analytics and stats exports",
                 "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateGroup",
+                "operationId": "AdminSavePrivacyConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/updateGroupRequest"
+                            "$ref": "#/definitions/PrivacyConfig"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "OK"
                     }
                 }
             }
         },
-        "/api/int/group/title/{title}/members": {
+        "/api/admin/reactions/migrations": {
             "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Retrieves group members by  title",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the last migrations of the post reactions to the Social BB ordered by start date (newest first)",
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "IntGetGroupMembersByGroupTitle",
+                "operationId": "AdminGetReactionMigrations",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Title",
-                        "name": "identifier",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Offsetting result",
-                        "name": "offset",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "Limiting the result",
+                        "type": "integer",
+                        "description": "limit",
                         "name": "limit",
                         "in": "query"
                     }
@@ -3060,31 +2757,26 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/ShortMemberRecord"
+                                "$ref": "#/definitions/ReactionMigration"
                             }
                         }
                     }
                 }
-            }
-        },
-        "/api/int/group/{group-id}/date_updated": {
+            },
             "post": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Updates the date updated field of the desired group",
+                "description": "Starts a background job which moves the post reactions of the groups to the Social BB. All groups with reactions are migrated if no group IDs are provided. The local reaction writes of a group are disabled once its reaction counts are verified in the Social BB. Only one migration runs per client at a time.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "IntUpdateGroupDateUpdated",
+                "operationId": "AdminStartReactionMigration",
                 "parameters": [
                     {
                         "type": "string",
@@ -3094,38 +2786,36 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/startReactionMigrationRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReactionMigration"
+                        }
                     }
                 }
             }
         },
-        "/api/int/group/{group-id}/events": {
-            "post": {
+        "/api/admin/reactions/migrations/{id}": {
+            "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Creates a group event",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Gets a migration of the post reactions to the Social BB together with the per group counts and errors",
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "IntCreateGroupEvent",
+                "operationId": "AdminGetReactionMigration",
                 "parameters": [
                     {
                         "type": "string",
@@ -3134,42 +2824,36 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/intCreateGroupEventRequestBody"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
+                        "description": "Migration ID",
+                        "name": "id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/ReactionMigration"
+                        }
                     }
                 }
             }
         },
-        "/api/int/group/{group-id}/events/{event-id}": {
-            "delete": {
+        "/api/admin/rejection-reasons-config": {
+            "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a group event",
+                "description": "Gets the catalog of the membership rejection reasons",
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "IntDeleteGroupEvent",
+                "operationId": "AdminGetRejectionReasonsConfig",
                 "parameters": [
                     {
                         "type": "string",
@@ -3177,72 +2861,46 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "Event ID",
-                        "name": "event-id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK"
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/RejectionReasonsConfig"
+                        }
                     }
                 }
-            }
-        },
-        "/api/int/group/{group-id}/notification": {
-            "post": {
+            },
+            "put": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Sends a notification to members of a group",
+                "description": "Saves the catalog of the membership rejection reasons. Each reason has a code, a primary message and optional messages by locale. Once the catalog is not empty the membership rejections require a reason code of the catalog.",
                 "consumes": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "SendGroupNotification",
+                "operationId": "AdminSaveRejectionReasonsConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "The name of the service which invokes the API",
-                        "name": "service",
-                        "in": "query"
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/sendGroupNotificationRequestBody"
+                            "$ref": "#/definitions/RejectionReasonsConfig"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -3253,61 +2911,27 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/int/group/{identifier}": {
-            "get": {
-                "security": [
-                    {
-                        "IntAPIKeyAuth": []
-                    }
-                ],
-                "description": "Retrieves group details and members",
-                "consumes": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Internal"
-                ],
-                "operationId": "IntGetGroup",
-                "parameters": [
-                    {
-                        "type": "string",
-                        "description": "Identifier",
-                        "name": "identifier",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "$ref": "#/definitions/Group"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/int/user/{identifier}/groups": {
+        "/api/admin/sync-configs": {
             "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Gives the user groups memberships",
+                "description": "Gets sync config",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Internal"
+                    "Admin"
                 ],
-                "operationId": "IntGetUserGroupMemberships",
+                "operationId": "AdminGetSyncConfigs",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Identifier",
-                        "name": "identifier",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
@@ -3315,81 +2939,65 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.userGroupShortDetail"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.SyncConfig"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/api/memberships/{membership-id}": {
+            },
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a membership. Only admin can update the status and date_attended fields of a membership record. Member is allowed to update only his/her notification preferences.",
+                "description": "Saves sync config",
                 "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+                    "text/plain"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "UpdateMembership",
+                "operationId": "AdminSaveSyncConfig",
                 "parameters": [
-                    {
-                        "type": "string",
-                        "description": "APP",
-                        "name": "APP",
-                        "in": "header",
-                        "required": true
-                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/updateMembershipRequest"
+                            "$ref": "#/definitions/model.SyncConfig"
                         }
                     },
                     {
                         "type": "string",
-                        "description": "Membership ID",
-                        "name": "membership-id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
+                        "description": "OK"
                     }
                 }
-            },
-            "delete": {
+            }
+        },
+        "/api/admin/user/event/{event-id}/groups": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Deletes a membership",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Updates the group mappings for an event with id",
                 "tags": [
                     "Client"
                 ],
-                "operationId": "DeleteMembership",
+                "operationId": "UpdateGroupMappingsEventID",
                 "parameters": [
                     {
                         "type": "string",
@@ -3400,8 +3008,8 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "Membership ID",
-                        "name": "membership-id",
+                        "description": "Event ID",
+                        "name": "event-id",
                         "in": "path",
                         "required": true
                     }
@@ -3410,30 +3018,24 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/getPutAdminGroupIDsForEventIDRequestAndResponse"
                         }
                     }
                 }
             }
         },
-        "/api/memberships/{membership-id}/approval": {
-            "put": {
+        "/api/admin/user/event/{event_id}/groups": {
+            "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Аpprove/Deny a membership",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
-                ],
+                "description": "Get all group IDs where the current user is an admin",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "MembershipApproval",
+                "operationId": "AdminGetAdminGroupIDsForEventID",
                 "parameters": [
                     {
                         "type": "string",
@@ -3442,19 +3044,10 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
-                    {
-                        "description": "body data",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/membershipApprovalRequest"
-                        }
-                    },
                     {
                         "type": "string",
-                        "description": "Membership ID",
-                        "name": "membership-id",
+                        "description": "Event ID",
+                        "name": "event-id",
                         "in": "path",
                         "required": true
                     }
@@ -3463,27 +3056,30 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "string"
+                            "$ref": "#/definitions/getPutAdminGroupIDsForEventIDRequestAndResponse"
                         }
                     }
                 }
             }
         },
-        "/api/research-profile/user-count": {
-            "post": {
+        "/api/admin/user/groups": {
+            "get": {
                 "security": [
+                    {
+                        "APIKeyAuth": []
+                    },
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Retrieves the user count matching the provided research profile",
+                "description": "Gives the groups list. It can be filtered by category",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetResearchProfileUserCount",
+                "operationId": "AdminGetUserGroups",
                 "parameters": [
                     {
                         "type": "string",
@@ -3493,108 +3089,70 @@ const docTemplate = `{
                         "required": true
                     },
                     {
-                        "description": "Research profile",
-                        "name": "data",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "type": "object",
-                            "additionalProperties": {
-                                "type": "object",
-                                "additionalProperties": {
-                                    "type": "array",
-                                    "items": {
-                                        "type": "string"
-                                    }
-                                }
-                            }
-                        }
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "integer"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/user": {
-            "delete": {
-                "security": [
+                        "type": "string",
+                        "description": "Filtering by group's title (case-insensitive)",
+                        "name": "title",
+                        "in": "query"
+                    },
                     {
-                        "AppUserAuth": []
+                        "type": "string",
+                        "description": "category - filter by category",
+                        "name": "category",
+                        "in": "query"
                     },
                     {
-                        "APIKeyAuth": []
-                    }
-                ],
-                "description": "Deletes a user with all the involved information from the Notifications BB (this includes - group membership \u0026 posts (and child posts - no matter of the creator))",
-                "tags": [
-                    "Client"
-                ],
-                "operationId": "DeleteUser",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/user/group-memberships": {
-            "get": {
-                "security": [
+                        "type": "string",
+                        "description": "privacy - filter by privacy",
+                        "name": "privacy",
+                        "in": "query"
+                    },
                     {
-                        "AppUserAuth": []
-                    }
-                ],
-                "description": "Gives the user groups memberships",
-                "consumes": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Client"
-                ],
-                "operationId": "GetUserGroupMemberships",
-                "parameters": [
+                        "type": "string",
+                        "description": "offset - skip number of records",
+                        "name": "offset",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "Identifier",
-                        "name": "identifier",
-                        "in": "path",
-                        "required": true
+                        "description": "limit - limit the result",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
+                        "name": "include_hidden",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/rest.userGroupShortDetail"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Group"
+                            }
                         }
                     }
                 }
             }
         },
-        "/api/user/groups": {
+        "/api/admin/v2/groups": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives the user groups.",
+                "description": "Gives the groups list. It can be filtered by category, title and privacy. V2",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetUserGroups",
+                "operationId": "AdminGetGroupsV2",
                 "parameters": [
                     {
                         "type": "string",
@@ -3655,47 +3213,28 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/getUserGroupsResponse"
+                                "$ref": "#/definitions/Group"
                             }
                         }
                     }
                 }
             }
         },
-        "/api/user/login": {
+        "/api/admin/v2/groups/{id}": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
-                    },
-                    {
-                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Logs in the user and refactor the user record and linked data if need",
-                "tags": [
-                    "Client"
-                ],
-                "operationId": "LoginUser",
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/api/user/stats": {
-            "get": {
-                "security": [
-                    {
-                        "AppUserAuth": []
-                    }
+                "description": "Gives a group. V2",
+                "consumes": [
+                    "application/json"
                 ],
-                "description": "Gets user stat information. Responds with {\"posts_count\": xxx}",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetUserStats",
+                "operationId": "AdminGetGroup",
                 "parameters": [
                     {
                         "type": "string",
@@ -3703,33 +3242,43 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/getUserStatsResponse"
+                            "$ref": "#/definitions/Group"
                         }
                     }
                 }
             }
         },
-        "/api/v2/groups": {
+        "/api/admin/v2/user/groups": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
                     }
                 ],
-                "description": "Gives the groups list. It can be filtered by category, title and privacy. V2",
+                "description": "Gives the user groups. It can be filtered by category, title and privacy. V2.",
                 "consumes": [
                     "application/json"
                 ],
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupsV2",
+                "operationId": "AdminGetUserGroupsV2",
                 "parameters": [
                     {
                         "type": "string",
@@ -3797,21 +3346,18 @@ const docTemplate = `{
                 }
             }
         },
-        "/api/v2/groups/{id}": {
+        "/api/admin/webhooks": {
             "get": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gives a group. V2",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the webhook subscriptions of the client",
                 "tags": [
-                    "Client"
+                    "Admin"
                 ],
-                "operationId": "GetGroupV2",
+                "operationId": "AdminGetWebhookSubscriptions",
                 "parameters": [
                     {
                         "type": "string",
@@ -3819,77 +3365,77 @@ const docTemplate = `{
                         "name": "APP",
                         "in": "header",
                         "required": true
-                    },
-                    {
-                        "type": "string",
-                        "description": "ID",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/Group"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookSubscription"
+                            }
                         }
                     }
                 }
-            }
-        },
-        "/api/v2/user/groups": {
-            "get": {
+            },
+            "post": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Gets all related group users linked for the described event id",
+                "description": "Registers a callback URL for group events. The URL must use https and its host must resolve to public addresses only, the redirects of the subscriber are not followed. The payloads are signed with HMAC-SHA256 using the secret which is returned only on creation.",
+                "consumes": [
+                    "application/json"
+                ],
                 "tags": [
-                    "BBS"
+                    "Admin"
                 ],
-                "operationId": "BBSGetEventUsers",
+                "operationId": "AdminCreateWebhookSubscription",
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "Event ID",
-                        "name": "event_id",
-                        "in": "path",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
                         "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createWebhookSubscriptionRequest"
+                        }
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/getEventUserIDsResponse"
-                            }
+                            "$ref": "#/definitions/createWebhookSubscriptionResponse"
                         }
                     }
                 }
             }
         },
-        "/group/{group-id}/members": {
+        "/api/admin/webhooks/{id}": {
             "put": {
                 "security": [
                     {
                         "AppUserAuth": []
                     }
                 ],
-                "description": "Updates a membership. Only the status can be changed.",
+                "description": "Enables or disables a webhook subscription. The pending deliveries of a disabled subscription are marked as failed.",
                 "consumes": [
                     "application/json"
                 ],
-                "produces": [
-                    "application/json"
-                ],
                 "tags": [
                     "Admin"
                 ],
-                "operationId": "AdminCreateMemberships",
+                "operationId": "AdminUpdateWebhookSubscription",
                 "parameters": [
                     {
                         "type": "string",
@@ -3898,48 +3444,23 @@ const docTemplate = `{
                         "in": "header",
                         "required": true
                     },
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
                     {
                         "description": "body data",
                         "name": "data",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/model.MembershipStatus"
-                            }
+                            "$ref": "#/definitions/updateWebhookSubscriptionRequest"
                         }
-                    },
-                    {
-                        "type": "string",
-                        "description": "Group ID",
-                        "name": "group-id",
-                        "in": "path",
-                        "required": true
-                    }
-                ],
-                "responses": {
-                    "200": {
-                        "description": "OK"
-                    }
-                }
-            }
-        },
-        "/int/authman/synchronize": {
-            "post": {
-                "security": [
-                    {
-                        "IntAPIKeyAuth": []
                     }
                 ],
-                "description": "Synchronizes Authman groups membership",
-                "consumes": [
-                    "application/json"
-                ],
-                "tags": [
-                    "Internal"
-                ],
-                "operationId": "SynchronizeAuthman",
                 "responses": {
                     "200": {
                         "description": "OK"
@@ -3947,75 +3468,9397 @@ const docTemplate = `{
                 }
             }
         },
-        "/int/stats": {
+        "/api/admin/webhooks/{id}/deliveries": {
             "get": {
                 "security": [
                     {
-                        "IntAPIKeyAuth": []
+                        "AppUserAuth": []
                     }
                 ],
-                "description": "Retrieve group stats",
-                "consumes": [
-                    "application/json"
-                ],
+                "description": "Gets the delivery history of a webhook subscription ordered by date (newest first)",
                 "tags": [
-                    "Internal"
+                    "Admin"
+                ],
+                "operationId": "AdminGetWebhookDeliveries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
                 ],
-                "operationId": "IntGroupStats",
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/GroupsStats"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/WebhookDelivery"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/admin/webhooks/{id}/secret": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Replaces the secret of a webhook subscription. During the overlap the X-Groups-Signature header contains the comma separated signatures of the new and the previous secrets, so the subscriber could switch without dropping deliveries. Zero overlap revokes the previous secret at once. The new secret is returned only once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "operationId": "AdminRotateWebhookSecret",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Subscription ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rotateWebhookSecretRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/createWebhookSubscriptionResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/groups": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Gets groups. When the privacy mode is enabled for the client the groups below the k-anonymity threshold are suppressed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "operationId": "AnalyticsGetGroups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Start date string - RFC3339 encoded",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date string - RFC3339 encoded",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rest.analyticsGetGroupsResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/members": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Gets groups members. When the privacy mode is enabled for the client the identifiers are hashed and the small groups are suppressed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "operationId": "AnalyticsGetGroupsMembers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date string - RFC3339 encoded",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date string - RFC3339 encoded",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rest.analyticsGetGroupsMembersResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/analytics/posts": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Gets posts. When the privacy mode is enabled for the client the user identifiers are hashed and the small groups are suppressed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Analytics"
+                ],
+                "operationId": "AnalyticsGetPosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Start date string - RFC3339 encoded",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "End date string - RFC3339 encoded",
+                        "name": "end_date",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/rest.analyticsGetPostsResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/accounts/merge": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Moves the memberships, posts, reactions, event to_members, RSVPs and attendances, bans, read cursors, post bookmarks and notification read receipts of the old account within the client of the APP header to the surviving account. When both accounts are members of the same group, the membership with the higher status is kept. The move is done within a transaction.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSMergeAccounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/AccountMerge"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AccountMergeResult"
                         }
                     }
                 }
             }
         },
-        "/version": {
-            "get": {
-                "description": "Gives the service version.",
-                "produces": [
-                    "text/plain"
-                ],
-                "tags": [
-                    "Client"
-                ],
-                "operationId": "Version",
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "string"
-                        }
-                    }
+        "/api/bbs/groups": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets all related groups by groupIDs",
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "GetGroupsbyGroupsIDs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated group IDs",
+                        "name": "group-ids",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/Group"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Creates a group on behalf of the service account of another building block. The token must have the groups:write scope. The service account is recorded as the creator but does not become a member, so the initial admins must be provided with the members config.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSCreateGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/adminCreateGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/createResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/groups/events": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets all related eventID and groupID using eventIDs",
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "GetGroupsEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Comma separated event IDs",
+                        "name": "events-ids",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/GetGroupsEvents"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/groups/{group_id}": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a group created by the service account of the token. The token must have the groups:write scope. The groups created by other accounts could not be updated.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSUpdateGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/bbs/groups/{group_id}/group-memberships": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets all related group memberships status and group title using groupID",
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "GetGroupMembershipsByGroupID",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/groups/{group_id}/members": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Adds a member to a group created by the service account of the token. The token must have the memberships:write scope. The status is pending when not provided.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSCreateMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createMemberRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Forbidden - the user is banned from the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/groups/{user_id}/memberships": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets all related group memberships status and group title using userID",
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "GetGroupMemberships",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "User ID",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "array",
+                                "items": {
+                                    "$ref": "#/definitions/GetGroupMembershipsResponse"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/bbs/memberships": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the group memberships status, group title and admin flag of the users in one call. The result follows the order of the user IDs and contains the users without memberships too. The memberships of the deleted groups are skipped. At most 500 users could be looked up at once.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSGetUsersGroupMemberships",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/getUsersGroupMembershipsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/UserGroupMemberships"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/client-config": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the settings of the client which the apps need for validating the input before sending it. The missing post limits are not applied. The rejection reasons are the catalog of the membership rejections with the messages of the best matching locale.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetClientConfig",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated list of locales ordered by preference",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/clientConfigResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/directory/groups/{id}/feed.atom": {
+            "get": {
+                "description": "Gives the latest public posts of a public group as an Atom feed, so the departments could embed the announcements of the group on their websites. The private posts, the posts to specific members, the scheduled posts and the replies are not included, neither are the creators of the posts. The group admins could disable the feed with the public_feed_disabled setting. The feed is cached for 5 minutes and supports the conditional requests with ETag.",
+                "produces": [
+                    "application/atom+xml"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPublicFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ETag of the cached feed",
+                        "name": "If-None-Match",
+                        "in": "header"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "304": {
+                        "description": "Not modified",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - the group does not exist, it is not public or the feed is disabled",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/events/v3": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Create a calendar event and link it to multiple group ids",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateCalendarEventMultiGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventMultiGroupData"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/actions": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Applies a single admin quick action within a group. Supported types: membership.approve, membership.reject (with optional reason), membership.remove and membership.mute (with duration in seconds, from a minute to a year, the muted member cannot post nor reply until it ends) which require membership_id, and post.delete and post.pin which require post_id. Only group admins can apply actions. The outcome of the action is reported in the result.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ApplyGroupAction",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupAction"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupActionResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/activity": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the new posts, the new events, the joins and the posts pinned since the provided moment. Only the posts and the events visible to the current user are included. The joins are listed only if the group allows the member info, otherwise just counted. The lists contain up to 50 newest items. Only the group members can see the activity.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupActivity",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "since - RFC3339 date",
+                        "name": "since",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupActivity"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/authman/sync-status": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the last Authman sync runs of the group ordered by start date (newest first). Each run contains the start and end times, the counts of the added, removed and failed memberships and the error details. Only admin of the group could get them",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetAuthmanSyncStatus",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Number of runs. Default 20",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AuthmanSyncRun"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/authman/synchronize": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Synchronizes Authman group. Only admin of the group could initiate the operation",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "SynchAuthmanGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/bans": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the users banned from the group, the latest first. Only group admins can list the bans.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupBans",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupBan"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Bans a user from the group by the user id or the external id. The membership of the user is deleted. The banned users cannot request the membership or join the group automatically, the admins cannot add them and the Authman and the smart group syncs skip them. The ban is kept until it is deleted. The admins cannot be banned. Only group admins can ban users.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateGroupBan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createGroupBanRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupBan"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/bans/{ban-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Lifts the ban of a user, so the user could join the group again. The deleted membership is not restored. Only group admins can lift the bans.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupBan",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Ban ID",
+                        "name": "ban-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/event/{event-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a group event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Gives the group events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupEvents",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a group event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/groupEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Creates a group event",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/groupEventRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/ical": {
+            "get": {
+                "description": "Gives the group events visible to the member of the token as an iCalendar feed. The token is given by the feed link API. The events which started more than 90 days ago are not included.",
+                "produces": [
+                    "text/calendar"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupEventsICal",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Signed calendar feed token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - the token is not valid or the user is no longer a member of the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/ical/link": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the personal iCal feed URL of the group events for the current member. The URL can be subscribed from the calendar clients. It stops working when the user leaves the group or rotates the URL.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateCalendarFeedLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CalendarFeedLink"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/ical/link/rotate": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Issues a new personal iCal feed URL of the group events for the current member, i.e. when the previous one has leaked. During the overlap the previous URL still works, so the subscribed calendars could be switched. Zero overlap revokes the previous URL at once. The rotation is recorded in the audit log and the group admins are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "RotateCalendarFeedLink",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rotateCalendarFeedLinkRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/CalendarFeedLink"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/v2": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the group events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupEventsV2",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events starting at or after the date (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events starting at or before the date (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only events which have not ended yet",
+                        "name": "upcoming_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Event"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/v3": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a calendar event and for a single group id",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateCalendarEventSingleGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "group id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Create a calendar event and link it to a single group id. With a recurrence rule a calendar event is created for every occurrence and the events are linked to the group as one series.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateCalendarEventSingleGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "group id",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.createCalendarEventSingleGroupData"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/v3/load": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Gets the group calendar events",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupCalendarEventsV3",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/GroupEventFilter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/v3/{event-id}/series": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates an event of a recurring series. The scope \"this\" updates the selected event only, \"future\" updates the selected and all later events of the series. The later events keep their distance from the selected one.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateCalendarEventSeries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "this or future",
+                        "name": "scope",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/rest.updateCalendarEventSingleGroupData"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.updateCalendarEventSeriesData"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Unlinks events of a recurring series from the group. The scope \"this\" unlinks the selected event only, \"future\" unlinks the selected and all later events of the series.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteEventSeries",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "this or future",
+                        "name": "scope",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.deleteEventSeriesResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/checkin": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Checks in the current member to an event of an attendance group with the code or the QR token given by the group admin. The attendance date of the membership is set on the first check-in. Checking in again keeps the first check-in.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CheckInToEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/eventCheckInRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EventAttendance"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - the code is not valid or has expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/checkin-codes": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Generates a short-lived check-in code for an event of an attendance group. The code is given to the members by hand and the token is meant to be encoded in a QR code. The code expires after ttl_minutes (15 minutes by default, one day at most).",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateEventCheckInCode",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/createEventCheckInCodeRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EventCheckInCode"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/feature": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Features an event of the group until the featured_until time, so the events and the feed APIs list it first. The event could be featured for up to 30 days and at most 3 events can be featured in a group at the same time. Setting a new time for a featured event extends or shortens it. Only group admins can feature events.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "FeatureGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/featureGroupEventRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Event"
+                        }
+                    },
+                    "409": {
+                        "description": "the featured events limit is reached",
+                        "schema": {
+                            "$ref": "#/definitions/utils.GroupError"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Stops featuring an event of the group before its featured_until time. Only group admins can unfeature events.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnfeatureGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Event"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/reminder": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Sets the reminder opt-in of the current user for a group event. The members who opted in get the event reminders even if they muted the group events, the members who opted out do not get them even if they are going. The scheduled reminders are sent a day before the event to the members going to it when the event_reminders setting of the group is on, otherwise to the members who opted in only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateEventReminder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/eventReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EventRSVPSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/rsvp": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Sets the RSVP of the current user for a group event. The status is one of going, maybe or not_going. Only the group members who can see the event are allowed to respond.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateEventRSVP",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/eventRSVPRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EventRSVPSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/rsvps": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the RSVP counts of a group event and the response of the current user. The group admins get the list of the responses as well.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetEventRSVPs",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/EventRSVPSummary"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/events/{event-id}/rsvps/reminder": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Sends a reminder notification to the group members who responded going to the event or opted in for its reminders. Only group admins can send reminders. A default message is used if the message is empty.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "SendEventRSVPReminder",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/eventRSVPReminderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/eventRSVPReminderResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/feed": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the recent posts, events and membership milestones of a group in one chronologically sorted (newest first) list. Only the group members can see the feed.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit - 20 by default",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupFeedItem"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/join-rules": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Sets the join rules of the group. The membership requests of the users matching any of the email domains, the NetID pattern or the Core BB account attributes skip the pending state. The other requests wait for the admins as usual. Only group admins can set the join rules.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroupJoinRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupJoinRules"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupJoinRules"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Removes the join rules of the group, so all the membership requests wait for the admins again. Only group admins can remove the join rules.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupJoinRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/members": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the list of group members. Gives 403 if the current user is not an admin or a member of the group. The member information is given as allowed by the group settings, the member answers, the notification preferences and the rejection reasons of the others are given to the group admins only.",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateMember",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MembershipFilter"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupMembership"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Adds a member to a group. The current user is required to be an admin of the group",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateMember",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createMemberRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "403": {
+                        "description": "Forbidden - the user is banned from the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a member membership from a group",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfuly deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/members/multi-update": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates multiple members in a group at once with status and other details",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MultiUpdateMembers",
+                "parameters": [
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/MembershipMultiUpdate"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/members/picker": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the members and the admins of the group to be picked (e.g. for a mention) by the current user. The members who opted out of the member pickers (searchable = false) are not listed. The mentions of the members who opted out of being mentioned (mentionable = false) are turned into plain text when a post is saved. Gives 403 if the member names are not visible to the current user.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupMemberPicker",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filters the members by name",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "The maximum number of the members",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PickerMember"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/messages": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the chat messages of a group from the newest to the oldest one. Use the next_cursor of the response to load the older messages. The messages are kept for 30 days.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupMessages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "next_cursor of the previous page",
+                        "name": "cursor",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "page size - 50 by default, 200 max",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupMessagesPage"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Sends a chat message to a group. The body is plain text up to 2000 characters. Use the posts for structured announcements.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateGroupMessage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createGroupMessageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/messages/stream": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Streams the new chat messages of a group as server-sent events. Every event has the \"message\" type and a GroupMessage JSON data. Load the history with the messages API.",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "StreamGroupMessages",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupMessage"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/messages/{message-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a chat message of a group. The members may delete their own messages, the group admins may delete any message.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupMessage",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Message ID",
+                        "name": "message-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/org-unit": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Links the group to an org unit of the campus organizational hierarchy, i.e. a department. The code is checked with the hierarchy and the unit name is kept in sync daily. The groups under a college could be listed with the org_unit_code filter. Only group admins can link the group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroupOrgUnit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateGroupOrgUnitRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupOrgUnit"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - unknown org unit code",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Unlinks the group from its org unit, so it is not listed under the unit any more. Only group admins can unlink the group.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupOrgUnit",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/ownership/transfer": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Makes a member of the group an admin. The current admin becomes a member unless keep_admin is set. Only group admins can transfer the ownership. The last admin of a group cannot leave it or be demoted, so the ownership has to be transferred first.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "TransferGroupOwnership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/transferGroupOwnershipRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupMembership"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/pending-members": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Creates a group pending member. Gives 429 with Retry-After header when the user exceeds the membership requests rate limit of the client.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreatePendingMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createPendingMemberRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - the member answers do not match the typed membership questions of the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - the user is banned from the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "423": {
+                        "description": "Locked",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a group pending member",
+                "consumes": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeletePendingMember",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Successfuly deleted",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/pending-members/decisions": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Approves or rejects up to 100 pending memberships of the group at once. The decisions are applied in one transaction, so if any of the memberships is not pending in the group none of them is applied. The rejections follow the rules of the single membership approval API. Every decided user gets one notification. Only group admins can decide.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreatePendingMemberDecisions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/pendingMemberDecisionsRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupMembership"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/polls/{poll-id}/results": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the results of a group poll from the Polls BB according to the poll preferences of the group. The members may see the results any time, once the poll is closed or never, and the voter identities may be hidden from them. The group admins always see the full results. The results are cached for a short time.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPollResults",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Poll ID",
+                        "name": "poll-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PollResults"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/rules": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the rules (code of conduct) of the group together with the version acknowledged by the current user. The members have to acknowledge the current version before posting.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupRulesStatus"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates the rules (code of conduct) of the group. The version increases whenever the text changes and the members are notified to acknowledge the new rules. An empty text removes the rules. Only group admins can update the rules.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroupRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateGroupRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupRules"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/rules/acknowledge": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Acknowledges the current version of the group rules by the current member. Gives 409 if the rules have changed in the meantime, so the member has to review the new version.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "AcknowledgeGroupRules",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/acknowledgeGroupRulesRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupRulesStatus"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the acknowledged version is not the current one",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/schedule": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the timeline of upcoming scheduled posts and events for a group. The occurrences of the recurring series are given with their series_id. The scheduled reminders of the upcoming events are given when the reminder task sends them, a day before the event, with the number of their recipients - the members going or opted in when the event_reminders group setting is on, the opted in members only otherwise. The reminders already sent are not given. Entries scheduled too close to each other are flagged as conflicting. Only group admins can see the schedule.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupSchedule",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupScheduleItem"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{group-id}/stats": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Retrieves stats for a group by id",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupStats"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/abuse-reports": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the abuse reports of the group posts sent to the group admins ordered by the creation date (newest first). Only the group admins could see them.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupAbuseReports",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated statuses - open, reviewed, resolved",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/AbuseReport"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/abuse-reports/{id}": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Applies a moderation action on an abuse report of a group post sent to the group admins. The review action marks the report as reviewed. The dismiss, remove_post and warn_user actions resolve the report. The resolved reports could not be moderated anymore. Only the group admins could moderate them.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ModerateGroupAbuseReport",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Abuse report ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/moderateAbuseReportRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AbuseReport"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "gets all posts for the desired group. The reactions are given as counts per reaction (reaction_counts) and the reactions of the current user (user_reactions).",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "groupID",
+                        "name": "groupID",
+                        "in": "query",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Values: message|post",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc|desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Post"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/drafts": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the draft posts of the current user within the group ordered by the last change (newest first). The drafts are created with status \"draft\" by the create post API and they are visible only to their creator until published.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupDraftPosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Post"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/mark-read": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Marks all posts of the group created until now as read by the current user. The unread counts include only the posts created afterwards.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MarkGroupPostsRead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/pending": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the posts of the members waiting for an admin approval ordered by the creation date (oldest first). The posts of the members wait for an approval when the group settings require it (post_preferences.posts_require_approval). Only group admins can get the queue.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPendingPosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Post"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/approval": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Approves or rejects a post waiting for an approval. The approved post is published as a new one and the members are notified. The rejected post stays visible only to its author. The author is notified about the outcome. Only group admins can moderate the posts.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GroupPostApproval",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/postApprovalRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/bookmark": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Bookmarks a post of the group for the current user. Bookmarking the post again keeps the original bookmark. Only group members could bookmark the posts visible to them.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "BookmarkGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/PostBookmark"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - the post is not visible to the user",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Removes the bookmark of the post for the current user. The bookmark could be removed even if the post is not visible any more.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnbookmarkGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/history": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the previous revisions (subject, body and image) of a group post ordered by the edit date (newest first). The current content is the post itself. Only group admins can get the history.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPostHistory",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PostRevision"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/pin": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Pins a top level post of the group so it is returned first by the group posts API. At most 3 posts can be pinned in a group. Only group admins can pin posts.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "PinGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Unpins a post of the group. Only group admins can unpin posts.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnpinGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/publish": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Publishes a draft post of the current user. The post is ordered as a new one and the members are notified the same way as for a new post. The scheduled drafts are notified at their scheduled date.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "PublishGroupDraftPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/reactions": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the users who reacted to a post within the desired group. The names are given only if the current user is allowed to see the member names of the group.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPostReactions",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Gives only the users who reacted with this reaction",
+                        "name": "reaction",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/PostReactor"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupID}/posts/{postID}/to-members": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Adds group members to the recipients of a post sent to the chosen members, without resubmitting the whole post. Only the newly added members are notified, the recipients of a scheduled post are notified when the post is sent. Only the creator of the post could change the recipients. The recipients of a post to the whole group are chosen by the post update.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "AddGroupPostRecipients",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/postRecipientsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - not a group member or the post is sent to the whole group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Removes members from the recipients of a post sent to the chosen members, without resubmitting the whole post. At least one recipient must remain, otherwise the post would become visible to the whole group. Only the creator of the post could change the recipients.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "RemoveGroupPostRecipients",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Post ID",
+                        "name": "postID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/postRecipientsRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - no recipient would remain or the post is sent to the whole group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupId}/posts": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "creates a post within the desired group. Posts created with status \"draft\" are visible only to their creator until published. In the groups requiring approval the posts of the members get status \"pending_approval\" and are visible only to their creator until an admin approves them. Gives 429 with Retry-After header when the user exceeds the posts or the replies rate limit of the client.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupId}/posts/{postId}": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Gets a post within the desired group. The reactions are given as counts per reaction (reaction_counts) and the reactions of the current user (user_reactions).",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Updates a post within the desired group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/model.Post"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Updates a post within the desired group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/group/{groupId}/posts/{postId}/reactions": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Toggles the reaction of the current user to a post within the desired group. A user may react with several reactions. Gives 400 if the reaction is not within the allowed reactions of the group and 429 with Retry-After header when the user reacts too often or the reactions are temporarily suspended due to toggle spam.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ReactToGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the reactions of the group are managed by the Social BB",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{groupId}/posts/{postId}/report/abuse": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Reports an abusive group post",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ReportAbuseGroupPost",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reportAbuseGroupPostRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/group/{id}": {
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a group. The group is excluded from all the queries at once and its content is deleted in the background once the restore period of the group deletion policy passes, the progress is given by the admin group deletion jobs API. The event mappings of the group are archived and the members who responded to the upcoming events are notified.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Cancels the upcoming events linked only to the group in the Calendar BB",
+                        "name": "cancel_events",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/group/{id}/report/abuse": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Reports an abusive group",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ReportAbuseGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/reportAbuseGroupRequestBody"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/groups": {
+            "get": {
+                "security": [
+                    {
+                        "APIKeyAuth": []
+                    },
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the groups list. It can be filtered by category",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! category - filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
+                        "name": "privacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! limit - limit the result",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
+                        "name": "include_hidden",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupsFilter"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones.",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Group"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Creates a group. The user must be part of urn:mace:uiuc.edu:urbana:authman:app-rokwire-service-policy-rokwire groups access. Title must be a unique. Category must be one of the categories list. Privacy can be public or private",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CreateGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/createGroupRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/createResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/groups/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Gives a group",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones.",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/getGroupResponse"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateGroupRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/int/group/title/{title}/members": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves group members by  title",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntGetGroupMembersByGroupTitle",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Title",
+                        "name": "identifier",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Offsetting result",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Limiting the result",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/ShortMemberRecord"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/int/group/{group-id}/date_updated": {
+            "post": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Updates the date updated field of the desired group",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntUpdateGroupDateUpdated",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/int/group/{group-id}/events": {
+            "post": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Links an existing calendar event to the group. If the internal event services config of the client is set, the service must be allowed to link the events to the group by its categories or attributes. The event must exist in the Calendar BB. Linking an already linked event gives 409 with the existing mapping in the error details.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntCreateGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The name of the service which invokes the API",
+                        "name": "service",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/intCreateGroupEventRequestBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Event"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden - the service is not allowed to link events to the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - the group or the calendar event does not exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the event is already linked to the group",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/int/group/{group-id}/events/{event-id}": {
+            "delete": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a group event",
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntDeleteGroupEvent",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/int/group/{group-id}/notification": {
+            "post": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Sends a notification to members of a group",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "SendGroupNotification",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "The name of the service which invokes the API",
+                        "name": "service",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/sendGroupNotificationRequestBody"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/int/group/{group-id}/recipients": {
+            "post": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Resolves the audience of a group into the de-duplicated notification recipients, so the fan-out logic is not duplicated in the other services. The audience is all the members and admins (default), the admins only, the members who attended the group since a date or the listed members. The recipients who muted the notification category in their preferences or muted the group for the operation are flagged as muted, or omitted with exclude_muted.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntResolveGroupRecipients",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/RecipientAudience"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/notifications.Recipient"
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid audience",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "404": {
+                        "description": "Not found - the group does not exist",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/int/group/{identifier}": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Retrieves group details and members",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntGetGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identifier",
+                        "name": "identifier",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Group"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/int/user/{identifier}/groups": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Gives the user groups memberships",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntGetUserGroupMemberships",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identifier",
+                        "name": "identifier",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.userGroupShortDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a membership. Only admin can update the status, date_attended and show_as_leader fields of a membership record. Member is allowed to update only his/her notification preferences and whether he/she could be mentioned (mentionable) or found in the member pickers (searchable).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateMembershipRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Deletes a membership",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict - the group must keep at least one admin",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}/approval": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Аpprove/Deny a membership. If the client has a rejection reasons catalog the rejections require reject_reason_code of the catalog and reject_reason is an optional note, otherwise reject_reason is the free text reason. Gives 400 for a missing or unknown reason code.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MembershipApproval",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/membershipApprovalRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}/digest": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Switches the current member to the daily or weekly digests of the group. The new posts and events are not notified one by one but summarized within a single digest notification, emailed too if requested. An empty frequency turns the digests off.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UpdateMembershipDigest",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/updateMembershipDigestRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}/mute": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Temporarily mutes all the notifications of the group (posts, events, polls and membership changes) for the current member. The notifications are still delivered as muted. They are unmuted automatically once the duration passes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MuteMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/muteMembershipRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/muteMembershipResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Unmutes the notifications of the group for the current member before the mute expires",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnmuteMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}/snooze": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Snoozes the immediate notifications of the group (posts, events, polls and membership changes) for the current member, i.e. for 8 hours. The notifications are still delivered as muted and the digests still accumulate. The notification preferences and the mute are not changed. The snooze ends automatically once the duration passes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "SnoozeMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/snoozeMembershipRequest"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/snoozeMembershipResponse"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Ends the snooze of the immediate notifications of the group for the current member before it expires",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnsnoozeMembership",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/memberships/{membership-id}/transition": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Schedules a future status change of a member or an admin, i.e. at the end of an officer term. The member becomes an admin or a member or is removed from the group at the effective date and is notified. A membership has at most one scheduled change, the new one replaces the previous. The scheduled change is given within the membership as scheduled_transition. The change which would leave the group without an admin is dropped. Only group admins could schedule the changes.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "ScheduleMembershipTransition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/scheduleMembershipTransitionRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupMembership"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - the effective date is not in the future or the status is the current one",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Cancels the scheduled status change of a membership. Only group admins could cancel the changes.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "CancelMembershipTransition",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Membership ID",
+                        "name": "membership-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/notifications/unsubscribe": {
+            "get": {
+                "description": "Gives the confirmation page of an unsubscribe link. The page does not change the notification preferences, they are changed once the user submits its form, so the mail scanners and the link prefetchers opening the link do not unsubscribe the user.",
+                "produces": [
+                    "text/html"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "UnsubscribeConfirmation",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signed unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "The confirmation page",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - the token is missing",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "Mutes the notifications of the scope of a signed unsubscribe token without login. The token is given in the unsubscribe_token data of the announcement notifications, as a query or a form parameter, so it supports the one-click unsubscribe of the mail clients (RFC 8058). The change is recorded in the audit log of every affected group.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "Unsubscribe",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Signed unsubscribe token",
+                        "name": "token",
+                        "in": "query",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/UnsubscribeResult"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - the token is not valid or has expired",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/research-profile/user-count": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Retrieves the user count matching the provided research profile",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetResearchProfileUserCount",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "Research profile",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "object",
+                            "additionalProperties": {
+                                "type": "object",
+                                "additionalProperties": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    }
+                                }
+                            }
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "integer"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user": {
+            "delete": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Deletes a user with all the involved information from the Notifications BB (this includes - group membership \u0026 posts (and child posts - no matter of the creator))",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "DeleteUser",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/user/admin-feed": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the things needing attention across all the groups the current user administers - the pending membership requests, the posts held for an approval, the unresolved abuse reports sent to the group admins and the groups whose last Authman sync has failed. The items are counted per group and type and sorted by the latest date (newest first). The deep link of an item is the path of the API which lists the things.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserAdminFeed",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AdminFeed"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/bookmarks": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the posts bookmarked by the current user across the groups ordered by the bookmark date (newest first). The visibility of the posts is checked again, so the deleted posts and the posts of the groups the user has left are skipped and a page could contain fewer posts than the limit.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserBookmarkedPosts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.Post"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/group-memberships": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the user groups memberships",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserGroupMemberships",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Identifier",
+                        "name": "identifier",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/rest.userGroupShortDetail"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/groups": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Gives the user groups.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserGroups",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! category - filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
+                        "name": "privacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! limit - limit the result",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
+                        "name": "include_hidden",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupsFilter"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones.",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/getUserGroupsResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/groups/unread-counts": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the number of the unread top level posts within every group the current user is a member of. The posts created before the last mark as read (or before joining the group) and the posts of the user are not counted.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserGroupsUnreadCounts",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/GroupUnreadCount"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/login": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    },
+                    {
+                        "APIKeyAuth": []
+                    }
+                ],
+                "description": "Logs in the user and refactor the user record and linked data if need. The memberships, the posts and the events added by the external ID or the NetID of the user before the first login are linked to the account.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "LoginUser",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/AccountLinkResult"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/notifications": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets the group related notifications inbox of the current user ordered by date (newest first)",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserNotifications",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Filter by read state. Values: true|false",
+                        "name": "read",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/getUserNotificationsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/user/notifications/read": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates the read state of multiple notifications of the current user. Empty or missing ids list marks all notifications.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MarkUserNotificationsRead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/markUserNotificationsReadRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/user/notifications/{id}/read": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Marks a single notification of the current user as read",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "MarkUserNotificationRead",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Notification ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/api/user/stats": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets user stat information. Responds with {\"posts_count\": xxx}",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetUserStats",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/getUserStatsResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/groups": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the groups list. It can be filtered by category, title and privacy. V2",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupsV2",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! Filtering by group's title (case-insensitive)",
+                        "name": "title",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! category - filter by category",
+                        "name": "category",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! privacy - filter by privacy",
+                        "name": "privacy",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! offset - skip number of records",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! limit - limit the result",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false.",
+                        "name": "include_hidden",
+                        "in": "query"
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupsFilter"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones.",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/Group"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/groups/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives a group. V2",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupV2",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones.",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Group"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v2/user/groups": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gets all related group users linked for the described event id",
+                "tags": [
+                    "BBS"
+                ],
+                "operationId": "BBSGetEventUsers",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "Event ID",
+                        "name": "event_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/getEventUserIDsResponse"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v3/groups/{group-id}": {
+            "patch": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates only the fields provided in the body, the missing fields are left unchanged. Unlike the full update, the unset fields are not cleared, i.e. the image URL or the settings. The Authman and the membership question fields are updated by the full update only. Only group admins can update the group.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "PatchGroup",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/GroupPatch"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/Group"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad request - invalid fields or duplicated title",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v4/group/{group-id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the group events with the total count and the paging of the response. The featured events are first.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupEventsV4",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events starting at or after the date (RFC3339)",
+                        "name": "start_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "Only events starting at or before the date (RFC3339)",
+                        "name": "end_date",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Only events which have not ended yet",
+                        "name": "upcoming_only",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Limit",
+                        "name": "limit",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/listResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/Event"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v4/group/{group-id}/members": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the group members matching the filter with the total count and the paging of the response. The members could be sorted by name, join date (date_created) or status. The light filter omits the member answers and the notification preferences for the member lists. The search filter finds the members by a case-insensitive part of the name. Gives 403 if the current user is not an admin or a member of the group. The member information is given as allowed by the group settings, the member answers, the notification preferences and the rejection reasons of the others are given to the group admins only.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupMembersV4",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/MembershipFilter"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/listResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/GroupMembership"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v4/group/{groupID}/posts": {
+            "get": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the group posts with the total count of the top level posts and the paging of the response. The replies are given within their top level posts and are not counted.",
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupPostsV4",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "groupID",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "Values: message|post",
+                        "name": "type",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "offset",
+                        "name": "offset",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "limit",
+                        "name": "limit",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "asc|desc",
+                        "name": "order",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/listResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/model.Post"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/api/v4/groups": {
+            "post": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Gives the groups matching the filter with the total count and the paging of the response. The filter is the same as of the v2 API. Pass an empty cursor with the limit to page by the creation date with the cursor instead of the offset, the next pages are loaded with the next_cursor of the response and stay stable while groups are being created or renamed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "GetGroupsV4",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/GroupsFilter"
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Comma separated locales ordered by preference, e.g. es-MX,es",
+                        "name": "locale",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "allOf": [
+                                {
+                                    "$ref": "#/definitions/listResponse"
+                                },
+                                {
+                                    "type": "object",
+                                    "properties": {
+                                        "items": {
+                                            "type": "array",
+                                            "items": {
+                                                "$ref": "#/definitions/Group"
+                                            }
+                                        }
+                                    }
+                                }
+                            ]
+                        }
+                    }
+                }
+            }
+        },
+        "/group/{group-id}/members": {
+            "put": {
+                "security": [
+                    {
+                        "AppUserAuth": []
+                    }
+                ],
+                "description": "Updates a membership. Only the status can be changed.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Admin"
+                ],
+                "operationId": "AdminCreateMemberships",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "APP",
+                        "name": "APP",
+                        "in": "header",
+                        "required": true
+                    },
+                    {
+                        "description": "body data",
+                        "name": "data",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/model.MembershipStatus"
+                            }
+                        }
+                    },
+                    {
+                        "type": "string",
+                        "description": "Group ID",
+                        "name": "group-id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/int/authman/synchronize": {
+            "post": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Synchronizes Authman groups membership",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "SynchronizeAuthman",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    }
+                }
+            }
+        },
+        "/int/stats": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Retrieve group stats",
+                "consumes": [
+                    "application/json"
+                ],
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntGroupStats",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/GroupsStats"
+                        }
+                    }
+                }
+            }
+        },
+        "/int/usage": {
+            "get": {
+                "security": [
+                    {
+                        "IntAPIKeyAuth": []
+                    }
+                ],
+                "description": "Gets the monthly usage records of the client for the billing and the reporting services ordered by period. The record of the current month is updated daily and the record of a past month is final. The counts of the groups, the members and the posts and the storage footprint are snapshots, the created posts and the notification sends are counted within the month.",
+                "tags": [
+                    "Internal"
+                ],
+                "operationId": "IntGetUsageRecords",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "The first period (inclusive) in the format 2006-01",
+                        "name": "from",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "The last period (inclusive) in the format 2006-01",
+                        "name": "to",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/UsageRecord"
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/ready": {
+            "get": {
+                "description": "Gives the service readiness. The service is ready once the startup warmup completes.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "Ready",
+                "responses": {
+                    "200": {
+                        "description": "OK"
+                    },
+                    "503": {
+                        "description": "Service Unavailable"
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "Gives the service version.",
+                "produces": [
+                    "text/plain"
+                ],
+                "tags": [
+                    "Client"
+                ],
+                "operationId": "Version",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "AbuseReport": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "the last moderation action",
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "comment": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_resolved": {
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "group_title": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "moderator_comment": {
+                    "type": "string"
+                },
+                "moderator_id": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "reported_user_id": {
+                    "description": "the author of the reported post",
+                    "type": "string"
+                },
+                "reported_user_name": {
+                    "type": "string"
+                },
+                "reporter_id": {
+                    "type": "string"
+                },
+                "reporter_name": {
+                    "type": "string"
+                },
+                "send_to_dean": {
+                    "type": "boolean"
+                },
+                "send_to_group_admins": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "description": "post or group",
+                    "type": "string"
+                }
+            }
+        },
+        "AccountIdentifiers": {
+            "type": "object",
+            "properties": {
+                "account_id": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "AccountLinkRepairResult": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "the events addressed to the account",
+                    "type": "integer"
+                },
+                "external_ids": {
+                    "description": "the unlinked external IDs checked",
+                    "type": "integer"
+                },
+                "failed": {
+                    "description": "the accounts whose linking failed",
+                    "type": "integer"
+                },
+                "linked_users": {
+                    "description": "the Core BB accounts which got linked data",
+                    "type": "integer"
+                },
+                "memberships": {
+                    "description": "the memberships claimed by the account",
+                    "type": "integer"
+                },
+                "missing_users": {
+                    "description": "the external IDs without a Core BB account yet",
+                    "type": "integer"
+                },
+                "posts": {
+                    "description": "the posts addressed to the account",
+                    "type": "integer"
+                }
+            }
+        },
+        "AccountLinkResult": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "description": "the events addressed to the account",
+                    "type": "integer"
+                },
+                "memberships": {
+                    "description": "the memberships claimed by the account",
+                    "type": "integer"
+                },
+                "posts": {
+                    "description": "the posts addressed to the account",
+                    "type": "integer"
+                }
+            }
+        },
+        "AccountMerge": {
+            "type": "object",
+            "required": [
+                "new_user_id",
+                "old_user_id"
+            ],
+            "properties": {
+                "new_user_id": {
+                    "type": "string"
+                },
+                "old_user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "AccountMergeResult": {
+            "type": "object",
+            "properties": {
+                "bans": {
+                    "description": "the bans of the old account apply to the surviving account",
+                    "type": "integer"
+                },
+                "event_attendances": {
+                    "description": "the attendance of the surviving account is kept",
+                    "type": "integer"
+                },
+                "event_rsvps": {
+                    "description": "the response of the surviving account is kept",
+                    "type": "integer"
+                },
+                "events": {
+                    "type": "integer"
+                },
+                "events_to_members": {
+                    "type": "integer"
+                },
+                "groups_created": {
+                    "type": "integer"
+                },
+                "memberships_merged": {
+                    "description": "the surviving account was already a member, the highest status is kept",
+                    "type": "integer"
+                },
+                "memberships_moved": {
+                    "type": "integer"
+                },
+                "post_bookmarks": {
+                    "description": "the bookmarks of the surviving account are kept",
+                    "type": "integer"
+                },
+                "posts": {
+                    "type": "integer"
+                },
+                "posts_reactions": {
+                    "type": "integer"
+                },
+                "posts_to_members": {
+                    "type": "integer"
+                },
+                "read_cursors": {
+                    "description": "the later read date is kept when both accounts have read the group",
+                    "type": "integer"
+                },
+                "user_notifications": {
+                    "type": "integer"
+                }
+            }
+        },
+        "AdminFeed": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "$ref": "#/definitions/AdminFeedCounts"
+                },
+                "items": {
+                    "description": "newest first",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AdminFeedItem"
+                    }
+                }
+            }
+        },
+        "AdminFeedCounts": {
+            "type": "object",
+            "properties": {
+                "abuse_reports": {
+                    "type": "integer"
+                },
+                "failed_syncs": {
+                    "type": "integer"
+                },
+                "pending_memberships": {
+                    "type": "integer"
+                },
+                "pending_posts": {
+                    "type": "integer"
+                }
+            }
+        },
+        "AdminFeedItem": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "date_latest": {
+                    "type": "string"
+                },
+                "deep_link": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "group_title": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "AuditActor": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "user or system",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "AuditChange": {
+            "type": "object",
+            "properties": {
+                "new": {},
+                "old": {}
+            }
+        },
+        "AuditLog": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "actor": {
+                    "$ref": "#/definitions/AuditActor"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "diff": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "$ref": "#/definitions/AuditChange"
+                    }
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "target_type": {
+                    "description": "group, membership or post",
+                    "type": "string"
+                }
+            }
+        },
+        "AuthmanSyncKeyResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "key": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "integer"
+                }
+            }
+        },
+        "AuthmanSyncRun": {
+            "type": "object",
+            "properties": {
+                "added": {
+                    "type": "integer"
+                },
+                "authman_group": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_ended": {
+                    "type": "string"
+                },
+                "date_started": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "failed": {
+                    "type": "integer"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "keys": {
+                    "description": "per Authman group key results of the full group runs",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/AuthmanSyncKeyResult"
+                    }
+                },
+                "mode": {
+                    "description": "full or delta, for the group runs",
+                    "type": "string"
+                },
+                "removed": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "running, succeeded or failed",
+                    "type": "string"
+                },
+                "type": {
+                    "description": "global or group",
+                    "type": "string"
+                }
+            }
+        },
+        "CalendarFeedLink": {
+            "type": "object",
+            "properties": {
+                "path": {
+                    "description": "relative to the service host",
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "CategoryVisibilityConfig": {
+            "type": "object",
+            "properties": {
+                "allowed_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "denied_categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "ContentFilterConfig": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "description": "reject, flag or annotate",
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "external_moderation": {
+                    "description": "Checks the content with the external moderation API too, if it is configured",
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "words": {
+                    "description": "Blocked words and phrases, matched case insensitively as whole words",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "ContentRateLimitsConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "membership_requests": {
+                    "$ref": "#/definitions/RateLimit"
+                },
+                "posts": {
+                    "$ref": "#/definitions/RateLimit"
+                },
+                "reactions": {
+                    "$ref": "#/definitions/RateLimit"
+                },
+                "replies": {
+                    "$ref": "#/definitions/RateLimit"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "Creator": {
+            "type": "object",
+            "required": [
+                "email",
+                "name",
+                "user_id"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreatorGroupsCleanupItem": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "matched, archived, deleted, skipped or failed",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "CreatorGroupsCleanupResult": {
+            "type": "object",
+            "properties": {
+                "action": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "string"
+                },
+                "dry_run": {
+                    "type": "boolean"
+                },
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/CreatorGroupsCleanupItem"
+                    }
+                }
+            }
+        },
+        "Event": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "creator": {
+                    "$ref": "#/definitions/Creator"
+                },
+                "date_archived": {
+                    "description": "set when the group is deleted, the mapping is kept instead of being dropped",
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_end": {
+                    "description": "the end of the calendar event when known",
+                    "type": "string"
+                },
+                "date_start": {
+                    "description": "the start of the calendar event when known",
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "featured_until": {
+                    "description": "the event is listed first until the time, set by the group admins",
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "occurrence_start": {
+                    "description": "the start of the event within its series",
+                    "type": "string"
+                },
+                "series_id": {
+                    "description": "the recurring series the event belongs to",
+                    "type": "string"
+                },
+                "to_members": {
+                    "description": "nil or empty means everyone; non-empty means visible to those user ids and admins",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ToMember"
+                    }
+                }
+            }
+        },
+        "EventAttendance": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "date_checked_in": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "membership_id": {
+                    "type": "string"
+                },
+                "method": {
+                    "description": "code or qr",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "EventCheckInCode": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "code": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_expires": {
+                    "type": "string"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "EventRSVP": {
+            "type": "object",
+            "properties": {
+                "date_updated": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "reminder": {
+                    "description": "the reminder opt-in of the member. The group notifications preferences apply if not set",
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "going, maybe or not_going. Empty if the member has set the reminder preference only",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "EventRSVPSummary": {
+            "type": "object",
+            "properties": {
+                "counts": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "rsvps": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/EventRSVP"
+                    }
+                },
+                "user_reminder": {
+                    "description": "the reminder opt-in of the current user",
+                    "type": "boolean"
+                },
+                "user_status": {
+                    "description": "the response of the current user",
+                    "type": "string"
+                }
+            }
+        },
+        "EventRecurrence": {
+            "type": "object",
+            "properties": {
+                "count": {
+                    "type": "integer"
+                },
+                "frequency": {
+                    "description": "daily, weekly or monthly",
+                    "type": "string"
+                },
+                "interval": {
+                    "description": "1 by default",
+                    "type": "integer"
+                },
+                "until": {
+                    "type": "string"
+                }
+            }
+        },
+        "GeoNearFilter": {
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number"
+                },
+                "longitude": {
+                    "type": "number"
+                },
+                "radius": {
+                    "description": "in meters",
+                    "type": "number"
+                }
+            }
+        },
+        "GeoPoint": {
+            "type": "object",
+            "properties": {
+                "coordinates": {
+                    "type": "array",
+                    "items": {
+                        "type": "number"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "GetGroupMembershipsResponse": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "string"
+                },
+                "group_title": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "GetGroupsEvents": {
+            "type": "object",
+            "properties": {
+                "event_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "Group": {
+            "type": "object",
+            "properties": {
+                "attendance_group": {
+                    "type": "boolean"
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "authman_enabled": {
+                    "type": "boolean"
+                },
+                "authman_group": {
+                    "type": "string"
+                },
+                "authman_groups": {
+                    "description": "additional Authman group keys, the membership is the union of all keys",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "authman_sync_watermark": {
+                    "description": "the time the memberships are synchronized up to, used by the incremental sync",
+                    "type": "string"
+                },
+                "block_new_membership_requests": {
+                    "type": "boolean"
+                },
+                "can_join_automatically": {
+                    "type": "boolean"
+                },
+                "categories": {
+                    "description": "all the categories of the group, the first one is kept in the legacy category field",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "description": "one of the enums categories list",
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "creator_id": {
+                    "description": "the account which created the group, not tracked for the groups created before",
+                    "type": "string"
+                },
+                "current_member": {
+                    "description": "this is indicative and it's not required for update APIs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GroupMembership"
+                        }
+                    ]
+                },
+                "date_admin_needed": {
+                    "description": "the last admin has left and there has been no member to promote, the org admins have to appoint one",
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_deleted": {
+                    "description": "the group is excluded from all the queries, it could be restored until the purge date",
+                    "type": "string"
+                },
+                "date_managed_membership_updated": {
+                    "type": "string"
+                },
+                "date_membership_updated": {
+                    "type": "string"
+                },
+                "date_purge": {
+                    "description": "the content of the deleted group is deleted then, unset once the purge starts",
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hidden_for_search": {
+                    "type": "boolean"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "is_abuse": {
+                    "type": "boolean"
+                },
+                "join_rules": {
+                    "description": "the matching membership requests are approved automatically",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GroupJoinRules"
+                        }
+                    ]
+                },
+                "leaders": {
+                    "description": "public regardless of the member list visibility",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/GroupLeader"
+                    }
+                },
+                "locale": {
+                    "description": "the locale applied to the title and the description, empty for the primary ones",
+                    "type": "string"
+                },
+                "localizations": {
+                    "description": "per locale title and description, the primary title stays unique",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/model.GroupLocalizations"
+                        }
+                    ]
+                },
+                "location": {
+                    "description": "optional, for the location based groups",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GeoPoint"
+                        }
+                    ]
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Member"
+                    }
+                },
+                "membership_questions": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "membership_questions_schema": {
+                    "description": "the typed membership questions, MembershipQuestions keeps their texts for the legacy clients",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MembershipQuestion"
+                    }
+                },
+                "only_admins_can_create_polls": {
+                    "type": "boolean"
+                },
+                "org_unit": {
+                    "description": "the campus org unit of the group, the name is synchronized from the hierarchy",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GroupOrgUnit"
+                        }
+                    ]
+                },
+                "privacy": {
+                    "description": "public or private",
+                    "type": "string"
+                },
+                "reactions_migrated": {
+                    "description": "the post reactions are managed by the Social BB, the local reaction writes are disabled",
+                    "type": "boolean"
+                },
+                "research_consent_details": {
+                    "type": "string"
+                },
+                "research_consent_statement": {
+                    "type": "string"
+                },
+                "research_description": {
+                    "type": "string"
+                },
+                "research_group": {
+                    "type": "boolean"
+                },
+                "research_open": {
+                    "type": "boolean"
+                },
+                "research_profile": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "rules": {
+                    "description": "managed through the rules APIs",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GroupRules"
+                        }
+                    ]
+                },
+                "settings": {
+                    "description": "TODO: Remove the pointer once the backward support is not needed any more!",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GroupSettings"
+                        }
+                    ]
+                },
+                "smart_group_rules": {
+                    "description": "the membership is derived from the Core BB account attributes",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/SmartGroupRules"
+                        }
+                    ]
+                },
+                "stats": {
+                    "$ref": "#/definitions/GroupStats"
+                },
+                "sync_end_time": {
+                    "type": "string"
+                },
+                "sync_start_time": {
+                    "type": "string"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "web_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupAction": {
+            "type": "object",
+            "required": [
+                "type"
+            ],
+            "properties": {
+                "duration": {
+                    "description": "mute duration in seconds",
+                    "type": "integer"
+                },
+                "membership_id": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "reject reason",
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupActionResult": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "type": "string"
+                },
+                "success": {
+                    "type": "boolean"
+                },
+                "target_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupActivity": {
+            "type": "object",
+            "properties": {
+                "joins": {
+                    "description": "empty when the members cannot see the member info of the group",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/GroupActivityMember"
+                    }
+                },
+                "joins_count": {
+                    "type": "integer"
+                },
+                "new_events": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/Event"
+                    }
+                },
+                "new_events_count": {
+                    "type": "integer"
+                },
+                "new_posts": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Post"
+                    }
+                },
+                "new_posts_count": {
+                    "type": "integer"
+                },
+                "pinned_posts": {
+                    "description": "posts pinned since the moment",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Post"
+                    }
+                },
+                "since": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupActivityMember": {
+            "type": "object",
+            "properties": {
+                "date_joined": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupAnalytics": {
+            "type": "object",
+            "properties": {
+                "active_members_count": {
+                    "description": "posted or reacted within the last 30 days",
+                    "type": "integer"
+                },
+                "date_generated": {
+                    "type": "string"
+                },
+                "events_count": {
+                    "type": "integer"
+                },
+                "events_per_week": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WeeklyCount"
+                    }
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "new_members_per_week": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WeeklyCount"
+                    }
+                },
+                "posts_per_week": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/WeeklyCount"
+                    }
+                },
+                "rejections_by_reason": {
+                    "description": "rejected membership requests by reason code, \"other\" for the free text reasons",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "weeks": {
+                    "type": "integer"
+                }
+            }
+        },
+        "GroupArchiveItem": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "data": {
+                    "description": "the stored document as it was on the deletion",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "date_archived": {
+                    "type": "string"
+                },
+                "date_purge": {
+                    "type": "string"
+                },
+                "entity_id": {
+                    "type": "string"
+                },
+                "entity_type": {
+                    "description": "group, post or event",
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupAttendanceReport": {
+            "type": "object",
+            "properties": {
+                "date_generated": {
+                    "type": "string"
+                },
+                "end_date": {
+                    "type": "string"
+                },
+                "events_count": {
+                    "description": "events with at least one check-in",
+                    "type": "integer"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MemberAttendance"
+                    }
+                },
+                "start_date": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupBan": {
+            "type": "object",
+            "properties": {
+                "banned_by": {
+                    "description": "user id of the admin",
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net_id": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupDeletionJob": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "date_ended": {
+                    "type": "string"
+                },
+                "date_purge": {
+                    "description": "the content is archived until then by the deletion policy",
+                    "type": "string"
+                },
+                "date_started": {
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "group_title": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "processed": {
+                    "description": "the number of the deleted or archived items per step",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "status": {
+                    "description": "running, succeeded or failed",
+                    "type": "string"
+                },
+                "step": {
+                    "description": "the step in progress",
+                    "type": "string"
+                }
+            }
+        },
+        "GroupDeletionPolicyConfig": {
+            "type": "object",
+            "properties": {
+                "archive_content": {
+                    "type": "boolean"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "restore_days": {
+                    "type": "integer"
+                },
+                "retention_days": {
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupEventFilter": {
+            "type": "object",
+            "properties": {
+                "end_time_after": {
+                    "type": "integer"
+                },
+                "end_time_before": {
+                    "type": "integer"
+                },
+                "limit": {
+                    "type": "integer"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "start_time_after": {
+                    "type": "integer"
+                },
+                "start_time_after_null_end_time": {
+                    "type": "integer"
+                },
+                "start_time_before": {
+                    "type": "integer"
+                },
+                "start_time_before_null_end_time": {
+                    "type": "integer"
+                }
+            }
+        },
+        "GroupFeedItem": {
+            "type": "object",
+            "properties": {
+                "date": {
+                    "type": "string"
+                },
+                "event": {
+                    "$ref": "#/definitions/Event"
+                },
+                "milestone": {
+                    "$ref": "#/definitions/MembershipMilestone"
+                },
+                "post": {
+                    "$ref": "#/definitions/model.Post"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupJoinRules": {
+            "type": "object",
+            "properties": {
+                "account_match": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "email_domains": {
+                    "description": "i.e. illinois.edu",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "net_id_pattern": {
+                    "description": "regular expression matched against the whole NetID",
+                    "type": "string"
+                }
+            }
+        },
+        "GroupLeader": {
+            "type": "object",
+            "properties": {
+                "id": {
+                    "description": "membership id",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "photo_url": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupLocalization": {
+            "type": "object",
+            "properties": {
+                "description": {
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupMembership": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "date_attended": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_last_digest": {
+                    "type": "string"
+                },
+                "date_rules_acknowledged": {
+                    "type": "string"
+                },
+                "date_stale_flagged": {
+                    "description": "set when the member is flagged as inactive by the pruning policy",
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "digest_email": {
+                    "description": "the digests are emailed too",
+                    "type": "boolean"
+                },
+                "digest_frequency": {
+                    "description": "daily or weekly, the new posts and events are notified within the digests only",
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MemberAnswer"
+                    }
+                },
+                "mentionable": {
+                    "description": "nil means the member could be mentioned",
+                    "type": "boolean"
+                },
+                "muted_until": {
+                    "description": "all the notifications of the group are muted until then",
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net_id": {
+                    "type": "string"
+                },
+                "notifications_preferences": {
+                    "$ref": "#/definitions/NotificationsPreferences"
+                },
+                "photo_url": {
+                    "type": "string"
+                },
+                "posting_muted_until": {
+                    "description": "muted by an admin, the member cannot post nor reply until then",
+                    "type": "string"
+                },
+                "reject_reason": {
+                    "type": "string"
+                },
+                "reject_reason_code": {
+                    "description": "code of the rejection reasons catalog",
+                    "type": "string"
+                },
+                "reject_reason_note": {
+                    "type": "string"
+                },
+                "rules_acknowledged_version": {
+                    "type": "integer"
+                },
+                "scheduled_transition": {
+                    "description": "the status change scheduled by an admin, i.e. at the end of an officer term",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/MembershipTransition"
+                        }
+                    ]
+                },
+                "searchable": {
+                    "description": "nil means the member is listed in the member pickers",
+                    "type": "boolean"
+                },
+                "show_as_leader": {
+                    "description": "listed publicly within the group leaders regardless of the member list visibility",
+                    "type": "boolean"
+                },
+                "snoozed_until": {
+                    "description": "the immediate notifications of the group are muted until then, the digests still accumulate",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "admin, pending, member, rejected",
+                    "type": "string"
+                },
+                "sync_id": {
+                    "description": "ID of sync that last updated this membership",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupMessage": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "sender": {
+                    "$ref": "#/definitions/Creator"
+                }
+            }
+        },
+        "GroupMessagesPage": {
+            "type": "object",
+            "properties": {
+                "messages": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/GroupMessage"
+                    }
+                },
+                "next_cursor": {
+                    "description": "nil when there are no older messages",
+                    "type": "string"
+                }
+            }
+        },
+        "GroupOrgUnit": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "description": "the canonical org unit code",
+                    "type": "string"
+                },
+                "date_synced": {
+                    "type": "string"
+                },
+                "name": {
+                    "description": "kept in sync with the hierarchy",
+                    "type": "string"
+                },
+                "path": {
+                    "description": "the codes of the parent units and the unit itself, the root first",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "GroupPatch": {
+            "type": "object",
+            "properties": {
+                "attendance_group": {
+                    "type": "boolean"
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "block_new_membership_requests": {
+                    "type": "boolean"
+                },
+                "can_join_automatically": {
+                    "type": "boolean"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hidden_for_search": {
+                    "type": "boolean"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "localizations": {
+                    "$ref": "#/definitions/model.GroupLocalizations"
+                },
+                "location": {
+                    "$ref": "#/definitions/GeoPoint"
+                },
+                "only_admins_can_create_polls": {
+                    "type": "boolean"
+                },
+                "privacy": {
+                    "type": "string"
+                },
+                "research_consent_details": {
+                    "type": "string"
+                },
+                "research_consent_statement": {
+                    "type": "string"
+                },
+                "research_description": {
+                    "type": "string"
+                },
+                "research_open": {
+                    "type": "boolean"
+                },
+                "research_profile": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "settings": {
+                    "$ref": "#/definitions/GroupSettings"
+                },
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "type": "string"
+                },
+                "web_url": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupRules": {
+            "type": "object",
+            "properties": {
+                "date_updated": {
+                    "type": "string"
+                },
+                "text": {
+                    "type": "string"
+                },
+                "version": {
+                    "description": "increases whenever the text changes",
+                    "type": "integer"
+                }
+            }
+        },
+        "GroupRulesStatus": {
+            "type": "object",
+            "properties": {
+                "acknowledged_version": {
+                    "type": "integer"
+                },
+                "acknowledgement_required": {
+                    "type": "boolean"
+                },
+                "date_acknowledged": {
+                    "type": "string"
+                },
+                "rules": {
+                    "$ref": "#/definitions/GroupRules"
+                }
+            }
+        },
+        "GroupScheduleItem": {
+            "type": "object",
+            "properties": {
+                "conflicting": {
+                    "description": "true if another entry is scheduled too close to this one",
+                    "type": "boolean"
+                },
+                "date": {
+                    "type": "string"
+                },
+                "event": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "id": {
+                    "type": "string"
+                },
+                "post": {
+                    "$ref": "#/definitions/model.Post"
+                },
+                "reminder_recipients": {
+                    "description": "the recipients of the scheduled reminder by the event_reminders group setting",
+                    "type": "integer"
+                },
+                "series_id": {
+                    "description": "the recurring series of the event",
+                    "type": "string"
+                },
+                "title": {
+                    "type": "string"
+                },
+                "type": {
+                    "description": "post, event or event_reminder",
+                    "type": "string"
+                }
+            }
+        },
+        "GroupSettings": {
+            "type": "object",
+            "properties": {
+                "event_reminders": {
+                    "description": "the members going to the events get the scheduled reminders unless they opted out, otherwise only the opted in members get them",
+                    "type": "boolean"
+                },
+                "member_info_preferences": {
+                    "$ref": "#/definitions/MemberInfoPreferences"
+                },
+                "poll_preferences": {
+                    "$ref": "#/definitions/PollPreferences"
+                },
+                "post_preferences": {
+                    "$ref": "#/definitions/PostPreferences"
+                },
+                "public_feed_disabled": {
+                    "description": "the public posts of a public group are not given in the Atom feed for the websites",
+                    "type": "boolean"
+                },
+                "quiet_hours": {
+                    "$ref": "#/definitions/QuietHours"
+                },
+                "welcome_message": {
+                    "$ref": "#/definitions/WelcomeMessage"
+                }
+            }
+        },
+        "GroupStat": {
+            "type": "object",
+            "properties": {
+                "authman_enabled": {
+                    "type": "boolean"
+                },
+                "privacy": {
+                    "type": "string"
+                },
+                "stats": {
+                    "$ref": "#/definitions/GroupStats"
+                },
+                "title": {
+                    "type": "string"
+                }
+            }
+        },
+        "GroupStats": {
+            "type": "object",
+            "properties": {
+                "admins_count": {
+                    "type": "integer"
+                },
+                "attendance_count": {
+                    "type": "integer"
+                },
+                "member_count": {
+                    "type": "integer"
+                },
+                "pending_count": {
+                    "type": "integer"
+                },
+                "rejected_count": {
+                    "type": "integer"
+                },
+                "total_count": {
+                    "description": "pending and rejected are excluded",
+                    "type": "integer"
+                }
+            }
+        },
+        "GroupUnreadCount": {
+            "type": "object",
+            "properties": {
+                "date_read": {
+                    "description": "nil if the user has never marked the group as read, the join date is used instead",
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "GroupsFilter": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "authman_enabled": {
+                    "type": "boolean"
+                },
+                "categories": {
+                    "description": "groups having any of the categories",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
+                    "description": "group category",
+                    "type": "string"
+                },
+                "creator_id": {
+                    "description": "groups created by the account",
+                    "type": "string"
+                },
+                "cursor": {
+                    "description": "opaque cursor (next_cursor) of the previous page, empty for the first page. Orders by the creation date and takes precedence over the offset.",
+                    "type": "string"
+                },
+                "exclude_my_groups": {
+                    "description": "Exclude My groups",
+                    "type": "boolean"
+                },
+                "hidden": {
+                    "description": "Filter by hidden flag. Values: true (show only hidden), false (show only not hidden), missing - don't do any filtering on this field.",
+                    "type": "boolean"
+                },
+                "ids": {
+                    "description": "membership id",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "include_hidden": {
+                    "description": "Include hidden groups",
+                    "type": "boolean"
+                },
+                "limit": {
+                    "description": "result limit",
+                    "type": "integer"
+                },
+                "member_external_id": {
+                    "description": "member user external id",
+                    "type": "string"
+                },
+                "member_id": {
+                    "description": "member id",
+                    "type": "string"
+                },
+                "member_user_id": {
+                    "description": "member user id",
+                    "type": "string"
+                },
+                "near": {
+                    "description": "groups located within the radius around the center",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/GeoNearFilter"
+                        }
+                    ]
+                },
+                "offset": {
+                    "description": "result offset",
+                    "type": "integer"
+                },
+                "order": {
+                    "description": "order by category \u0026 name (asc desc)",
+                    "type": "string"
+                },
+                "org_unit_code": {
+                    "description": "groups of the org unit and of all the units under it",
+                    "type": "string"
+                },
+                "privacy": {
+                    "description": "group privacy",
+                    "type": "string"
+                },
+                "research_answers": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
+                },
+                "research_group": {
+                    "type": "boolean"
+                },
+                "research_open": {
+                    "type": "boolean"
+                },
+                "tags": {
+                    "description": "group tags",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "title": {
+                    "description": "group title",
+                    "type": "string"
+                }
+            }
+        },
+        "GroupsStats": {
+            "type": "object",
+            "properties": {
+                "groups_count": {
+                    "type": "integer"
+                },
+                "groups_list": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/GroupStat"
+                    }
+                }
+            }
+        },
+        "InternalEventService": {
+            "type": "object",
+            "properties": {
+                "attributes": {
+                    "description": "i.e. {\"college\": \"Engineering\"}, a list attribute matches if it contains the value",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "name": {
+                    "description": "the service query param of the internal API",
+                    "type": "string"
+                }
+            }
+        },
+        "InternalEventServicesConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "services": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/InternalEventService"
+                    }
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "LicenseConfig": {
+            "type": "object",
+            "properties": {
+                "attribution": {
+                    "description": "Attribution statement, e.g. the name of the organization",
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "license": {
+                    "description": "License name or SPDX identifier, e.g. CC-BY-4.0",
+                    "type": "string"
+                },
+                "license_url": {
+                    "description": "Link to the license text",
+                    "type": "string"
+                },
+                "terms_url": {
+                    "description": "Link to the terms of use",
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "ManagedGroupConfig": {
+            "type": "object",
+            "properties": {
+                "admin_uins": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "authman_stems": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "Member": {
+            "type": "object",
+            "properties": {
+                "date_attended": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "external_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "member_answers": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MemberAnswer"
+                    }
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net_id": {
+                    "type": "string"
+                },
+                "photo_url": {
+                    "type": "string"
+                },
+                "reject_reason": {
+                    "type": "string"
+                },
+                "show_as_leader": {
+                    "type": "boolean"
+                },
+                "status": {
+                    "description": "pending, member, admin, rejected",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "MemberAnswer": {
+            "type": "object",
+            "properties": {
+                "answer": {
+                    "type": "string"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "values": {
+                    "description": "the selected options of a multi_select question",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "MemberAttendance": {
+            "type": "object",
+            "properties": {
+                "attended_count": {
+                    "type": "integer"
+                },
+                "date_last_attended": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "net_id": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "empty if the user is not a member any more",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "MemberInfoPreferences": {
+            "type": "object",
+            "properties": {
+                "allow_member_info": {
+                    "type": "boolean"
+                },
+                "can_view_member_email": {
+                    "type": "boolean"
+                },
+                "can_view_member_name": {
+                    "type": "boolean"
+                },
+                "can_view_member_net_id": {
+                    "type": "boolean"
+                },
+                "can_view_member_phone": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "MembershipFilter": {
+            "type": "object",
+            "properties": {
+                "external_id": {
+                    "description": "core user external id",
+                    "type": "string"
+                },
+                "group_ids": {
+                    "description": "list of group ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
+                    "description": "membership id",
+                    "type": "string"
+                },
+                "ids": {
+                    "description": "membership ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "leaders": {
+                    "description": "only the memberships shown as group leaders",
+                    "type": "boolean"
+                },
+                "light": {
+                    "description": "omit the member answers and the notification preferences, i.e. for the member lists",
+                    "type": "boolean"
+                },
+                "limit": {
+                    "description": "result limit",
+                    "type": "integer"
+                },
+                "name": {
+                    "description": "member's name",
+                    "type": "string"
+                },
+                "net_id": {
+                    "description": "core user net id",
+                    "type": "string"
+                },
+                "net_ids": {
+                    "description": "core user net ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "offset": {
+                    "description": "result offset",
+                    "type": "integer"
+                },
+                "order": {
+                    "description": "asc (default) or desc",
+                    "type": "string"
+                },
+                "search": {
+                    "description": "case-insensitive partial match of the member's name, email or net id",
+                    "type": "string"
+                },
+                "searchable": {
+                    "description": "only the memberships listed in the member pickers",
+                    "type": "boolean"
+                },
+                "sort_by": {
+                    "description": "name, date_created (join date) or status. Default: status \u0026 name",
+                    "type": "string"
+                },
+                "statuses": {
+                    "description": "lest of membership statuses",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "user_id": {
+                    "description": "core user id",
+                    "type": "string"
+                },
+                "user_ids": {
+                    "description": "core user ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "MembershipMilestone": {
+            "type": "object",
+            "properties": {
+                "members_count": {
+                    "type": "integer"
+                }
+            }
+        },
+        "MembershipMultiUpdate": {
+            "type": "object",
+            "properties": {
+                "date_attended": {
+                    "description": "date attended",
+                    "type": "string"
+                },
+                "reason": {
+                    "description": "reason",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "new status",
+                    "type": "string"
+                },
+                "user_ids": {
+                    "description": "list of user ids",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "MembershipPruningConfig": {
+            "type": "object",
+            "properties": {
+                "auto_remove": {
+                    "description": "Removes the stale memberships once the grace period passes",
+                    "type": "boolean"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "enabled": {
+                    "type": "boolean"
+                },
+                "grace_period_days": {
+                    "description": "Days between flagging and removing a stale membership",
+                    "type": "integer"
+                },
+                "inactivity_days": {
+                    "description": "Members who have not logged in for longer are flagged as stale and notified",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "MembershipQuestion": {
+            "type": "object",
+            "properties": {
+                "options": {
+                    "description": "for the select questions only",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "question": {
+                    "type": "string"
+                },
+                "required": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "description": "text, single_select, multi_select or boolean",
+                    "type": "string"
+                }
+            }
+        },
+        "MembershipTransition": {
+            "type": "object",
+            "properties": {
+                "date_effective": {
+                    "type": "string"
+                },
+                "date_scheduled": {
+                    "type": "string"
+                },
+                "scheduled_by": {
+                    "description": "user id of the admin",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "admin, member or removed",
+                    "type": "string"
+                }
+            }
+        },
+        "NotificationsPreferences": {
+            "type": "object",
+            "properties": {
+                "all_mute": {
+                    "type": "boolean"
+                },
+                "events_mute": {
+                    "type": "boolean"
+                },
+                "invitations_mute": {
+                    "type": "boolean"
+                },
+                "override_preferences": {
+                    "type": "boolean"
+                },
+                "polls_mute": {
+                    "type": "boolean"
+                },
+                "posts_mute": {
+                    "type": "boolean"
                 }
             }
-        }
-    },
-    "definitions": {
-        "AccountIdentifiers": {
+        },
+        "PendingMembershipConfig": {
             "type": "object",
             "properties": {
-                "account_id": {
+                "client_id": {
                     "type": "string"
                 },
-                "external_id": {
+                "expiration_days": {
+                    "description": "Requests pending for longer are rejected automatically, 0 keeps them pending",
+                    "type": "integer"
+                },
+                "reminder_days": {
+                    "description": "Admins are reminded about the requests pending for longer at most once per period, 0 disables the reminders",
+                    "type": "integer"
+                },
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "Creator": {
+        "PickerMember": {
             "type": "object",
-            "required": [
-                "email",
-                "name",
-                "user_id"
-            ],
             "properties": {
-                "email": {
+                "mentionable": {
+                    "type": "boolean"
+                },
+                "name": {
                     "type": "string"
                 },
+                "photo_url": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "PollOptionResult": {
+            "type": "object",
+            "properties": {
+                "option": {
+                    "type": "string"
+                },
+                "voters": {
+                    "description": "empty for the anonymous polls",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PollVoter"
+                    }
+                },
+                "votes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "PollPreferences": {
+            "type": "object",
+            "properties": {
+                "hide_voters": {
+                    "description": "the members see the vote counts only",
+                    "type": "boolean"
+                },
+                "results_visibility": {
+                    "description": "all (default), after_close or admins",
+                    "type": "string"
+                }
+            }
+        },
+        "PollResults": {
+            "type": "object",
+            "properties": {
+                "group_id": {
+                    "type": "string"
+                },
+                "options": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/PollOptionResult"
+                    }
+                },
+                "poll_id": {
+                    "type": "string"
+                },
+                "question": {
+                    "type": "string"
+                },
+                "status": {
+                    "description": "created, started or terminated",
+                    "type": "string"
+                },
+                "total_votes": {
+                    "type": "integer"
+                }
+            }
+        },
+        "PollVoter": {
+            "type": "object",
+            "properties": {
                 "name": {
                     "type": "string"
                 },
@@ -4024,268 +12867,402 @@ const docTemplate = `{
                 }
             }
         },
-        "Event": {
+        "PostBookmark": {
             "type": "object",
             "properties": {
                 "client_id": {
                     "type": "string"
                 },
-                "creator": {
-                    "$ref": "#/definitions/Creator"
-                },
                 "date_created": {
                     "type": "string"
                 },
-                "event_id": {
+                "group_id": {
                     "type": "string"
                 },
-                "group_id": {
+                "id": {
                     "type": "string"
                 },
-                "to_members": {
-                    "description": "nil or empty means everyone; non-empty means visible to those user ids and admins",
+                "post_id": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "PostLimitsConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "max_attachments": {
+                    "description": "Max number of the attached media, currently the image",
+                    "type": "integer"
+                },
+                "max_body_length": {
+                    "description": "Max number of characters of the post body",
+                    "type": "integer"
+                },
+                "max_reply_depth": {
+                    "description": "Max nesting level of the replies, 1 allows replies only to the top level posts",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "PostNotificationBatchingConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "type": {
+                    "type": "string"
+                },
+                "window_minutes": {
+                    "description": "The posts following a notified post of the group within the window are notified together once the window ends, 0 disables the batching",
+                    "type": "integer"
+                }
+            }
+        },
+        "PostPreferences": {
+            "type": "object",
+            "properties": {
+                "allow_send_post": {
+                    "type": "boolean"
+                },
+                "allowed_reactions": {
+                    "description": "the emoji the members may react with, any if empty",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/ToMember"
+                        "type": "string"
                     }
+                },
+                "can_send_post_reactions": {
+                    "type": "boolean"
+                },
+                "can_send_post_replies": {
+                    "type": "boolean"
+                },
+                "can_send_post_to_admins": {
+                    "type": "boolean"
+                },
+                "can_send_post_to_all": {
+                    "type": "boolean"
+                },
+                "can_send_post_to_specific_members": {
+                    "type": "boolean"
+                },
+                "posts_by_admins_only": {
+                    "description": "announcement mode - only the admins create top level posts, the members may still reply",
+                    "type": "boolean"
+                },
+                "posts_require_approval": {
+                    "description": "pre-moderation - the posts of the members are visible once an admin approves them",
+                    "type": "boolean"
                 }
             }
         },
-        "GetGroupMembershipsResponse": {
+        "PostReactor": {
             "type": "object",
             "properties": {
-                "status": {
+                "name": {
+                    "description": "hidden if the group does not allow viewing the member names",
                     "type": "string"
                 },
-                "title": {
+                "reaction": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "PostRevision": {
+            "type": "object",
+            "properties": {
+                "body": {
+                    "type": "string"
+                },
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "description": "the time of the edit which replaced the content",
+                    "type": "string"
+                },
+                "group_id": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "string"
+                },
+                "image_url": {
+                    "type": "string"
+                },
+                "post_id": {
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "user_id": {
+                    "description": "the user who made the edit",
                     "type": "string"
                 }
             }
         },
-        "Group": {
+        "PrivacyConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "k_anonymity_threshold": {
+                    "description": "Cells (groups) with fewer individuals are suppressed",
+                    "type": "integer"
+                },
+                "privacy_mode": {
+                    "type": "boolean"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "QuietHours": {
+            "type": "object",
+            "properties": {
+                "end": {
+                    "description": "HH:MM, before the start for a range over midnight",
+                    "type": "string"
+                },
+                "start": {
+                    "description": "HH:MM",
+                    "type": "string"
+                },
+                "timezone": {
+                    "description": "IANA time zone, e.g. America/Chicago",
+                    "type": "string"
+                }
+            }
+        },
+        "RateLimit": {
+            "type": "object",
+            "properties": {
+                "max": {
+                    "type": "integer"
+                },
+                "window_seconds": {
+                    "type": "integer"
+                }
+            }
+        },
+        "ReactionMigration": {
             "type": "object",
             "properties": {
-                "attendance_group": {
-                    "type": "boolean"
+                "client_id": {
+                    "type": "string"
                 },
-                "attributes": {
-                    "type": "object",
-                    "additionalProperties": true
+                "date_ended": {
+                    "type": "string"
                 },
-                "authman_enabled": {
-                    "type": "boolean"
+                "date_started": {
+                    "type": "string"
                 },
-                "authman_group": {
+                "error": {
                     "type": "string"
                 },
-                "block_new_membership_requests": {
-                    "type": "boolean"
+                "groups": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ReactionMigrationGroup"
+                    }
                 },
-                "can_join_automatically": {
-                    "type": "boolean"
+                "id": {
+                    "type": "string"
                 },
-                "category": {
-                    "description": "one of the enums categories list",
+                "status": {
+                    "description": "running, completed or failed",
+                    "type": "string"
+                }
+            }
+        },
+        "ReactionMigrationGroup": {
+            "type": "object",
+            "properties": {
+                "error": {
+                    "description": "the reason the group has not been migrated",
                     "type": "string"
                 },
-                "client_id": {
+                "group_id": {
                     "type": "string"
                 },
-                "current_member": {
-                    "description": "this is indicative and it's not required for update APIs",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/GroupMembership"
-                        }
-                    ]
+                "local_count": {
+                    "description": "reactions found in the group posts",
+                    "type": "integer"
                 },
-                "date_created": {
-                    "type": "string"
+                "posts": {
+                    "type": "integer"
                 },
-                "date_managed_membership_updated": {
-                    "type": "string"
+                "pushed_count": {
+                    "description": "reactions accepted by the Social BB",
+                    "type": "integer"
                 },
-                "date_membership_updated": {
-                    "type": "string"
+                "social_count": {
+                    "description": "reactions of the group reported by the Social BB after the push",
+                    "type": "integer"
                 },
-                "date_updated": {
+                "status": {
+                    "description": "running, completed or failed",
                     "type": "string"
-                },
-                "description": {
+                }
+            }
+        },
+        "RecipientAudience": {
+            "type": "object",
+            "properties": {
+                "attended_since": {
+                    "description": "required by the attended audience",
                     "type": "string"
                 },
-                "hidden_for_search": {
-                    "type": "boolean"
-                },
-                "id": {
+                "audience": {
+                    "description": "all (default), admins, attended or to_members",
                     "type": "string"
                 },
-                "image_url": {
+                "category": {
+                    "description": "posts, events, invitations or polls - the muted category of the preferences. Only all_mute applies if empty",
                     "type": "string"
                 },
-                "is_abuse": {
+                "exclude_muted": {
+                    "description": "the muted recipients are omitted instead of being flagged",
                     "type": "boolean"
                 },
-                "members": {
+                "operation": {
+                    "description": "the notification operation, the mutes of the memberships apply to it",
+                    "type": "string"
+                },
+                "skip_user_ids": {
+                    "description": "i.e. the sender",
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/Member"
+                        "type": "string"
                     }
                 },
-                "membership_questions": {
+                "to_members": {
+                    "description": "user ids, required by the to_members audience",
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
-                },
-                "only_admins_can_create_polls": {
-                    "type": "boolean"
-                },
-                "privacy": {
-                    "description": "public or private",
-                    "type": "string"
-                },
-                "research_consent_details": {
-                    "type": "string"
-                },
-                "research_consent_statement": {
-                    "type": "string"
-                },
-                "research_description": {
+                }
+            }
+        },
+        "RejectionReason": {
+            "type": "object",
+            "properties": {
+                "code": {
                     "type": "string"
                 },
-                "research_group": {
-                    "type": "boolean"
-                },
-                "research_open": {
-                    "type": "boolean"
-                },
-                "research_profile": {
+                "localizations": {
+                    "description": "message by locale",
                     "type": "object",
                     "additionalProperties": {
-                        "type": "object",
-                        "additionalProperties": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
+                        "type": "string"
                     }
                 },
-                "settings": {
-                    "description": "TODO: Remove the pointer once the backward support is not needed any more!",
-                    "allOf": [
-                        {
-                            "$ref": "#/definitions/GroupSettings"
-                        }
-                    ]
-                },
-                "stats": {
-                    "$ref": "#/definitions/GroupStats"
-                },
-                "sync_end_time": {
+                "message": {
                     "type": "string"
-                },
-                "sync_start_time": {
+                }
+            }
+        },
+        "RejectionReasonsConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
                     "type": "string"
                 },
-                "tags": {
+                "reasons": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/RejectionReason"
                     }
                 },
-                "title": {
-                    "type": "string"
-                },
-                "web_url": {
+                "type": {
                     "type": "string"
                 }
             }
         },
-        "GroupEventFilter": {
+        "ResilientClientMetrics": {
             "type": "object",
             "properties": {
-                "end_time_after": {
+                "avg_latency_ms": {
                     "type": "integer"
                 },
-                "end_time_before": {
-                    "type": "integer"
+                "date_opened": {
+                    "type": "string"
                 },
-                "limit": {
+                "failures": {
                     "type": "integer"
                 },
-                "offset": {
-                    "type": "integer"
+                "last_failure": {
+                    "type": "string"
                 },
-                "start_time_after": {
+                "name": {
+                    "type": "string"
+                },
+                "opened": {
                     "type": "integer"
                 },
-                "start_time_after_null_end_time": {
+                "rejected": {
                     "type": "integer"
                 },
-                "start_time_before": {
+                "requests": {
                     "type": "integer"
                 },
-                "start_time_before_null_end_time": {
+                "retries": {
                     "type": "integer"
+                },
+                "state": {
+                    "type": "string"
                 }
             }
         },
-        "GroupMembership": {
+        "Sender": {
             "type": "object",
             "properties": {
-                "client_id": {
-                    "type": "string"
-                },
-                "date_attended": {
-                    "type": "string"
-                },
-                "date_created": {
-                    "type": "string"
-                },
-                "date_updated": {
+                "type": {
+                    "description": "user or system",
                     "type": "string"
                 },
+                "user": {
+                    "$ref": "#/definitions/UserRef"
+                }
+            }
+        },
+        "ShortMemberRecord": {
+            "type": "object",
+            "properties": {
                 "email": {
                     "type": "string"
                 },
                 "external_id": {
                     "type": "string"
                 },
-                "group_id": {
-                    "type": "string"
-                },
                 "id": {
                     "type": "string"
                 },
-                "member_answers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/MemberAnswer"
-                    }
-                },
                 "name": {
                     "type": "string"
                 },
                 "net_id": {
                     "type": "string"
                 },
-                "notifications_preferences": {
-                    "$ref": "#/definitions/NotificationsPreferences"
-                },
-                "photo_url": {
-                    "type": "string"
-                },
-                "reject_reason": {
-                    "type": "string"
-                },
                 "status": {
-                    "description": "admin, pending, member, rejected",
-                    "type": "string"
-                },
-                "sync_id": {
-                    "description": "ID of sync that last updated this membership",
+                    "description": "pending, member, admin, rejected",
                     "type": "string"
                 },
                 "user_id": {
@@ -4293,178 +13270,193 @@ const docTemplate = `{
                 }
             }
         },
-        "GroupSettings": {
+        "SmartGroupRules": {
             "type": "object",
             "properties": {
-                "member_info_preferences": {
-                    "$ref": "#/definitions/MemberInfoPreferences"
+                "app_id": {
+                    "type": "string"
                 },
-                "post_preferences": {
-                    "$ref": "#/definitions/PostPreferences"
+                "enabled": {
+                    "type": "boolean"
+                },
+                "match": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "org_id": {
+                    "type": "string"
                 }
             }
         },
-        "GroupStat": {
+        "ToMember": {
             "type": "object",
             "properties": {
-                "authman_enabled": {
-                    "type": "boolean"
+                "email": {
+                    "type": "string"
                 },
-                "privacy": {
+                "external_id": {
                     "type": "string"
                 },
-                "stats": {
-                    "$ref": "#/definitions/GroupStats"
+                "name": {
+                    "type": "string"
                 },
-                "title": {
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "GroupStats": {
+        "UnsubscribeResult": {
             "type": "object",
             "properties": {
-                "admins_count": {
+                "group_ids": {
+                    "description": "the groups whose notifications have been muted by the link",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "scope": {
+                    "type": "string"
+                }
+            }
+        },
+        "UsageRecord": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "date_created": {
+                    "type": "string"
+                },
+                "date_updated": {
+                    "type": "string"
+                },
+                "final": {
+                    "description": "the period is over and the record is not updated anymore",
+                    "type": "boolean"
+                },
+                "groups_count": {
                     "type": "integer"
                 },
-                "attendance_count": {
+                "id": {
+                    "type": "string"
+                },
+                "members_count": {
+                    "description": "members and admins",
                     "type": "integer"
                 },
-                "member_count": {
+                "notifications_sent": {
+                    "description": "one per recipient of the group notifications",
                     "type": "integer"
                 },
-                "pending_count": {
+                "period": {
+                    "description": "2006-01",
+                    "type": "string"
+                },
+                "posts_count": {
                     "type": "integer"
                 },
-                "rejected_count": {
+                "posts_created": {
                     "type": "integer"
                 },
-                "total_count": {
-                    "description": "pending and rejected are excluded",
+                "storage_bytes": {
+                    "description": "the BSON size of the groups, the memberships and the posts",
                     "type": "integer"
                 }
             }
         },
-        "GroupsFilter": {
+        "UserGroupMembership": {
             "type": "object",
             "properties": {
-                "attributes": {
-                    "type": "object",
-                    "additionalProperties": true
-                },
-                "authman_enabled": {
-                    "type": "boolean"
-                },
-                "category": {
-                    "description": "group category",
+                "group_id": {
                     "type": "string"
                 },
-                "exclude_my_groups": {
-                    "description": "Exclude My groups",
-                    "type": "boolean"
+                "group_title": {
+                    "type": "string"
                 },
-                "hidden": {
-                    "description": "Filter by hidden flag. Values: true (show only hidden), false (show only not hidden), missing - don't do any filtering on this field.",
+                "is_admin": {
                     "type": "boolean"
                 },
-                "ids": {
-                    "description": "membership id",
+                "status": {
+                    "type": "string"
+                }
+            }
+        },
+        "UserGroupMemberships": {
+            "type": "object",
+            "properties": {
+                "memberships": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/UserGroupMembership"
                     }
                 },
-                "include_hidden": {
-                    "description": "Include hidden groups",
-                    "type": "boolean"
-                },
-                "limit": {
-                    "description": "result limit",
-                    "type": "integer"
-                },
-                "member_external_id": {
-                    "description": "member user external id",
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "UserNotification": {
+            "type": "object",
+            "properties": {
+                "app_id": {
                     "type": "string"
                 },
-                "member_id": {
-                    "description": "member id",
+                "body": {
                     "type": "string"
                 },
-                "member_user_id": {
-                    "description": "member user id",
+                "data": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "date_created": {
                     "type": "string"
                 },
-                "offset": {
-                    "description": "result offset",
-                    "type": "integer"
+                "date_read": {
+                    "type": "string"
                 },
-                "order": {
-                    "description": "order by category \u0026 name (asc desc)",
+                "group_id": {
                     "type": "string"
                 },
-                "privacy": {
-                    "description": "group privacy",
+                "id": {
                     "type": "string"
                 },
-                "research_answers": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "object",
-                        "additionalProperties": {
-                            "type": "array",
-                            "items": {
-                                "type": "string"
-                            }
-                        }
-                    }
+                "org_id": {
+                    "type": "string"
                 },
-                "research_group": {
+                "read": {
                     "type": "boolean"
                 },
-                "research_open": {
-                    "type": "boolean"
+                "subject": {
+                    "type": "string"
                 },
-                "tags": {
-                    "description": "group tags",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "topic": {
+                    "type": "string"
                 },
-                "title": {
-                    "description": "group title",
+                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "GroupsStats": {
+        "UserRef": {
             "type": "object",
             "properties": {
-                "groups_count": {
-                    "type": "integer"
+                "name": {
+                    "type": "string"
                 },
-                "groups_list": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/GroupStat"
-                    }
+                "user_id": {
+                    "type": "string"
                 }
             }
         },
-        "ManagedGroupConfig": {
+        "WebhookDelivery": {
             "type": "object",
             "properties": {
-                "admin_uins": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                },
-                "authman_stems": {
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "attempts": {
+                    "type": "integer"
                 },
                 "client_id": {
                     "type": "string"
@@ -4475,287 +13467,324 @@ const docTemplate = `{
                 "date_updated": {
                     "type": "string"
                 },
+                "event": {
+                    "$ref": "#/definitions/WebhookEvent"
+                },
                 "id": {
                     "type": "string"
                 },
-                "type": {
+                "last_error": {
+                    "type": "string"
+                },
+                "next_attempt": {
+                    "type": "string"
+                },
+                "response_status": {
+                    "type": "integer"
+                },
+                "status": {
+                    "description": "pending, delivered or failed",
+                    "type": "string"
+                },
+                "subscription_id": {
                     "type": "string"
                 }
             }
         },
-        "Member": {
+        "WebhookEvent": {
             "type": "object",
             "properties": {
-                "date_attended": {
+                "client_id": {
                     "type": "string"
                 },
-                "date_created": {
-                    "type": "string"
+                "data": {
+                    "type": "object",
+                    "additionalProperties": true
                 },
-                "date_updated": {
+                "group_id": {
                     "type": "string"
                 },
-                "email": {
+                "id": {
                     "type": "string"
                 },
-                "external_id": {
+                "timestamp": {
                     "type": "string"
                 },
-                "id": {
+                "type": {
                     "type": "string"
+                }
+            }
+        },
+        "WebhookSubscription": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
                 },
-                "member_answers": {
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/MemberAnswer"
-                    }
-                },
-                "name": {
+                "client_id": {
                     "type": "string"
                 },
-                "net_id": {
+                "date_created": {
                     "type": "string"
                 },
-                "photo_url": {
+                "date_previous_secret_expires": {
+                    "description": "end of the overlap of the previous secret",
                     "type": "string"
                 },
-                "reject_reason": {
+                "date_updated": {
                     "type": "string"
                 },
-                "status": {
-                    "description": "pending, member, admin, rejected",
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "id": {
                     "type": "string"
                 },
-                "user_id": {
+                "url": {
                     "type": "string"
                 }
             }
         },
-        "MemberAnswer": {
+        "WeeklyCount": {
             "type": "object",
             "properties": {
-                "answer": {
-                    "type": "string"
+                "count": {
+                    "type": "integer"
                 },
-                "question": {
+                "week_start": {
                     "type": "string"
                 }
             }
         },
-        "MemberInfoPreferences": {
+        "WelcomeMessage": {
             "type": "object",
             "properties": {
-                "allow_member_info": {
-                    "type": "boolean"
-                },
-                "can_view_member_email": {
+                "auto_post": {
+                    "description": "the message is posted within the group to the new members too",
                     "type": "boolean"
                 },
-                "can_view_member_name": {
-                    "type": "boolean"
-                },
-                "can_view_member_net_id": {
+                "enabled": {
                     "type": "boolean"
                 },
-                "can_view_member_phone": {
-                    "type": "boolean"
+                "text": {
+                    "type": "string"
                 }
             }
         },
-        "MembershipFilter": {
+        "acknowledgeGroupRulesRequest": {
             "type": "object",
             "properties": {
-                "external_id": {
-                    "description": "core user external id",
+                "version": {
+                    "type": "integer"
+                }
+            }
+        },
+        "adminCreateGroupRequest": {
+            "type": "object",
+            "required": [
+                "privacy",
+                "title"
+            ],
+            "properties": {
+                "attendance_group": {
+                    "type": "boolean"
+                },
+                "attributes": {
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "authman_enabled": {
+                    "type": "boolean"
+                },
+                "authman_group": {
                     "type": "string"
                 },
-                "group_ids": {
-                    "description": "list of group ids",
+                "authman_groups": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "id": {
-                    "description": "membership id",
+                "can_join_automatically": {
+                    "type": "boolean"
+                },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "category": {
                     "type": "string"
                 },
-                "limit": {
-                    "description": "result limit",
-                    "type": "integer"
+                "creator_email": {
+                    "type": "string"
                 },
-                "name": {
-                    "description": "member's name",
+                "creator_name": {
+                    "type": "string"
+                },
+                "creator_photo_url": {
+                    "type": "string"
+                },
+                "description": {
+                    "type": "string"
+                },
+                "hidden_for_search": {
+                    "type": "boolean"
+                },
+                "image_url": {
                     "type": "string"
                 },
-                "net_id": {
-                    "description": "core user net id",
-                    "type": "string"
+                "localizations": {
+                    "$ref": "#/definitions/model.GroupLocalizations"
                 },
-                "net_ids": {
-                    "description": "core user net ids",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
+                "location": {
+                    "$ref": "#/definitions/GeoPoint"
                 },
-                "offset": {
-                    "description": "result offset",
-                    "type": "integer"
+                "members": {
+                    "$ref": "#/definitions/model.DefaultMembershipConfig"
                 },
-                "statuses": {
-                    "description": "lest of membership statuses",
+                "membership_questions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
-                "user_id": {
-                    "description": "core user id",
-                    "type": "string"
-                },
-                "user_ids": {
-                    "description": "core user ids",
+                "membership_questions_schema": {
                     "type": "array",
                     "items": {
-                        "type": "string"
+                        "$ref": "#/definitions/MembershipQuestion"
                     }
-                }
-            }
-        },
-        "MembershipMultiUpdate": {
-            "type": "object",
-            "properties": {
-                "date_attended": {
-                    "description": "date attended",
+                },
+                "only_admins_can_create_polls": {
+                    "type": "boolean"
+                },
+                "privacy": {
+                    "type": "string",
+                    "enum": [
+                        "public",
+                        "private"
+                    ]
+                },
+                "research_consent_details": {
                     "type": "string"
                 },
-                "reason": {
-                    "description": "reason",
+                "research_consent_statement": {
                     "type": "string"
                 },
-                "status": {
-                    "description": "new status",
+                "research_description": {
                     "type": "string"
                 },
-                "user_ids": {
-                    "description": "list of user ids",
-                    "type": "array",
-                    "items": {
-                        "type": "string"
-                    }
-                }
-            }
-        },
-        "NotificationsPreferences": {
-            "type": "object",
-            "properties": {
-                "all_mute": {
+                "research_group": {
                     "type": "boolean"
                 },
-                "events_mute": {
+                "research_open": {
                     "type": "boolean"
                 },
-                "invitations_mute": {
-                    "type": "boolean"
+                "research_profile": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "object",
+                        "additionalProperties": {
+                            "type": "array",
+                            "items": {
+                                "type": "string"
+                            }
+                        }
+                    }
                 },
-                "override_preferences": {
-                    "type": "boolean"
+                "settings": {
+                    "$ref": "#/definitions/GroupSettings"
                 },
-                "polls_mute": {
-                    "type": "boolean"
+                "tags": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "posts_mute": {
-                    "type": "boolean"
+                "title": {
+                    "type": "string"
+                },
+                "web_url": {
+                    "type": "string"
                 }
             }
         },
-        "PostPreferences": {
+        "cleanupCreatorGroupsRequest": {
             "type": "object",
+            "required": [
+                "action",
+                "creator_id"
+            ],
             "properties": {
-                "allow_send_post": {
-                    "type": "boolean"
-                },
-                "can_send_post_reactions": {
-                    "type": "boolean"
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "archive",
+                        "delete"
+                    ]
                 },
-                "can_send_post_replies": {
+                "cancel_events": {
                     "type": "boolean"
                 },
-                "can_send_post_to_admins": {
-                    "type": "boolean"
+                "creator_id": {
+                    "type": "string"
                 },
-                "can_send_post_to_all": {
+                "dry_run": {
                     "type": "boolean"
                 },
-                "can_send_post_to_specific_members": {
-                    "type": "boolean"
+                "exclude_group_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 }
             }
         },
-        "Sender": {
+        "clientConfigResponse": {
             "type": "object",
             "properties": {
-                "type": {
-                    "description": "user or system",
-                    "type": "string"
+                "post_limits": {
+                    "$ref": "#/definitions/PostLimitsConfig"
                 },
-                "user": {
-                    "$ref": "#/definitions/UserRef"
+                "rejection_reasons": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/RejectionReason"
+                    }
                 }
             }
         },
-        "ShortMemberRecord": {
+        "createEventCheckInCodeRequest": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
-                "external_id": {
-                    "type": "string"
-                },
-                "id": {
-                    "type": "string"
-                },
-                "name": {
-                    "type": "string"
-                },
-                "net_id": {
-                    "type": "string"
-                },
-                "status": {
-                    "description": "pending, member, admin, rejected",
-                    "type": "string"
-                },
-                "user_id": {
-                    "type": "string"
+                "ttl_minutes": {
+                    "type": "integer"
                 }
             }
         },
-        "ToMember": {
+        "createGroupBanRequest": {
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string"
-                },
                 "external_id": {
                     "type": "string"
                 },
-                "name": {
-                    "type": "string"
+                "reason": {
+                    "type": "string",
+                    "maxLength": 500
                 },
                 "user_id": {
                     "type": "string"
                 }
             }
         },
-        "UserRef": {
+        "createGroupMessageRequest": {
             "type": "object",
             "properties": {
-                "name": {
-                    "type": "string"
-                },
-                "user_id": {
+                "body": {
                     "type": "string"
                 }
             }
@@ -4780,9 +13809,21 @@ const docTemplate = `{
                 "authman_group": {
                     "type": "string"
                 },
+                "authman_groups": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "can_join_automatically": {
                     "type": "boolean"
                 },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "category": {
                     "type": "string"
                 },
@@ -4804,12 +13845,24 @@ const docTemplate = `{
                 "image_url": {
                     "type": "string"
                 },
+                "localizations": {
+                    "$ref": "#/definitions/model.GroupLocalizations"
+                },
+                "location": {
+                    "$ref": "#/definitions/GeoPoint"
+                },
                 "membership_questions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "membership_questions_schema": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MembershipQuestion"
+                    }
+                },
                 "only_admins_can_create_polls": {
                     "type": "boolean"
                 },
@@ -4898,42 +13951,137 @@ const docTemplate = `{
                 },
                 "photo_url": {
                     "type": "string"
-                },
+                },
+                "status": {
+                    "description": "pending, member, admin, rejected",
+                    "type": "string"
+                },
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "createPendingMemberRequest": {
+            "type": "object",
+            "properties": {
+                "member_answers": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "properties": {
+                            "answer": {
+                                "type": "string"
+                            },
+                            "question": {
+                                "type": "string"
+                            },
+                            "values": {
+                                "type": "array",
+                                "items": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                },
+                "notifications_preferences": {
+                    "$ref": "#/definitions/NotificationsPreferences"
+                }
+            }
+        },
+        "createResponse": {
+            "type": "object",
+            "properties": {
+                "inserted_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "createWebhookSubscriptionRequest": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "secret": {
+                    "description": "generated when missing",
+                    "type": "string"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        },
+        "createWebhookSubscriptionResponse": {
+            "type": "object",
+            "properties": {
+                "secret": {
+                    "description": "returned only once on creation",
+                    "type": "string"
+                },
+                "subscription": {
+                    "$ref": "#/definitions/WebhookSubscription"
+                }
+            }
+        },
+        "eventCheckInRequest": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "eventRSVPReminderRequest": {
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string"
+                }
+            }
+        },
+        "eventRSVPReminderResponse": {
+            "type": "object",
+            "properties": {
+                "notified": {
+                    "type": "integer"
+                }
+            }
+        },
+        "eventRSVPRequest": {
+            "type": "object",
+            "properties": {
                 "status": {
-                    "description": "pending, member, admin, rejected",
-                    "type": "string"
-                },
-                "user_id": {
                     "type": "string"
                 }
             }
         },
-        "createPendingMemberRequest": {
+        "eventReminderRequest": {
             "type": "object",
             "properties": {
-                "member_answers": {
-                    "type": "array",
-                    "items": {
-                        "type": "object",
-                        "properties": {
-                            "answer": {
-                                "type": "string"
-                            },
-                            "question": {
-                                "type": "string"
-                            }
-                        }
-                    }
-                },
-                "notifications_preferences": {
-                    "$ref": "#/definitions/NotificationsPreferences"
+                "reminder": {
+                    "type": "boolean"
                 }
             }
         },
-        "createResponse": {
+        "featureGroupEventRequest": {
             "type": "object",
+            "required": [
+                "featured_until"
+            ],
             "properties": {
-                "inserted_id": {
+                "featured_until": {
                     "type": "string"
                 }
             }
@@ -5140,6 +14288,20 @@ const docTemplate = `{
                 }
             }
         },
+        "getUserNotificationsResponse": {
+            "type": "object",
+            "properties": {
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/UserNotification"
+                    }
+                },
+                "unread_count": {
+                    "type": "integer"
+                }
+            }
+        },
         "getUserStatsResponse": {
             "type": "object",
             "properties": {
@@ -5148,6 +14310,22 @@ const docTemplate = `{
                 }
             }
         },
+        "getUsersGroupMembershipsRequest": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "maxItems": 500,
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "groupEventRequest": {
             "type": "object",
             "required": [
@@ -5187,6 +14365,41 @@ const docTemplate = `{
                 }
             }
         },
+        "listResponse": {
+            "type": "object",
+            "properties": {
+                "items": {},
+                "limit": {
+                    "type": "integer"
+                },
+                "next_cursor": {
+                    "description": "nil when the cursor pagination is not used or the page is the last one",
+                    "type": "string"
+                },
+                "offset": {
+                    "type": "integer"
+                },
+                "total": {
+                    "type": "integer"
+                }
+            }
+        },
+        "markUserNotificationsReadRequest": {
+            "type": "object",
+            "properties": {
+                "ids": {
+                    "description": "empty list means all notifications of the user",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "read": {
+                    "description": "default true",
+                    "type": "boolean"
+                }
+            }
+        },
         "membershipApprovalRequest": {
             "type": "object",
             "required": [
@@ -5197,10 +14410,44 @@ const docTemplate = `{
                     "type": "boolean"
                 },
                 "reject_reason": {
+                    "description": "optional note of the rejection",
+                    "type": "string"
+                },
+                "reject_reason_code": {
+                    "description": "code of the rejection reasons catalog",
                     "type": "string"
                 }
             }
         },
+        "model.DefaultMembershipConfig": {
+            "type": "object",
+            "required": [
+                "status"
+            ],
+            "properties": {
+                "net_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "member",
+                        "pending",
+                        "rejected"
+                    ]
+                }
+            }
+        },
+        "model.GroupLocalizations": {
+            "type": "object",
+            "additionalProperties": {
+                "$ref": "#/definitions/GroupLocalization"
+            }
+        },
         "model.MembershipStatus": {
             "type": "object",
             "properties": {
@@ -5222,18 +14469,59 @@ const docTemplate = `{
                 "client_id": {
                     "type": "string"
                 },
+                "content_flags": {
+                    "description": "the blocked words and the moderation categories matched by the content filter of the client",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "date_created": {
                     "type": "string"
                 },
+                "date_deleted": {
+                    "type": "string"
+                },
+                "date_edited": {
+                    "description": "the time of the last content change, the previous content is kept as a PostRevision",
+                    "type": "string"
+                },
+                "date_moderated": {
+                    "description": "the time of the approval or the rejection of the post by an admin",
+                    "type": "string"
+                },
+                "date_notification_deferred": {
+                    "description": "set when the notification is deferred by the group quiet hours",
+                    "type": "string"
+                },
+                "date_notification_failed": {
+                    "description": "set when the scheduled notification is given up after too many attempts",
+                    "type": "string"
+                },
                 "date_notified": {
                     "type": "string"
                 },
+                "date_pinned": {
+                    "description": "the latest pinned post is the first one",
+                    "type": "string"
+                },
                 "date_scheduled": {
                     "type": "string"
                 },
                 "date_updated": {
                     "type": "string"
                 },
+                "deleted_by": {
+                    "type": "string"
+                },
+                "deletion_id": {
+                    "description": "id of the root post of the deletion",
+                    "type": "string"
+                },
+                "edited": {
+                    "description": "the subject, the body or the image has been changed after the creation",
+                    "type": "boolean"
+                },
                 "group_id": {
                     "type": "string"
                 },
@@ -5243,78 +14531,234 @@ const docTemplate = `{
                 "image_url": {
                     "type": "string"
                 },
-                "is_abuse": {
+                "is_abuse": {
+                    "type": "boolean"
+                },
+                "member": {
+                    "$ref": "#/definitions/Creator"
+                },
+                "parent_id": {
+                    "type": "string"
+                },
+                "pinned": {
+                    "description": "pinned posts are returned first",
+                    "type": "boolean"
+                },
+                "private": {
+                    "type": "boolean"
+                },
+                "reaction_counts": {
+                    "description": "the number of the users per reaction, constructed for the client APIs instead of the reactions",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "integer"
+                    }
+                },
+                "reactions": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "array",
+                        "items": {
+                            "type": "string"
+                        }
+                    }
+                },
+                "rejection_reason": {
+                    "description": "the reason of the rejection given by the admin",
+                    "type": "string"
+                },
+                "replies": {
+                    "description": "This is constructed by the code (ParentID)",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/model.Post"
+                    }
+                },
+                "status": {
+                    "description": "the unpublished posts (drafts, pending approval, rejected) are visible only to the creator, empty for the published posts",
+                    "type": "string"
+                },
+                "subject": {
+                    "type": "string"
+                },
+                "to_members": {
+                    "description": "nil or empty means everyone; non-empty means visible to those user ids and admins",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ToMember"
+                    }
+                },
+                "top_parent_id": {
+                    "type": "string"
+                },
+                "use_as_notification": {
+                    "type": "boolean"
+                },
+                "user_reactions": {
+                    "description": "the reactions of the current user, constructed for the client APIs",
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "model.SyncConfig": {
+            "type": "object",
+            "properties": {
+                "client_id": {
+                    "type": "string"
+                },
+                "cron": {
+                    "type": "string"
+                },
+                "group_timeout": {
+                    "description": "Time from sync_start_time to be considered a failed run for a single group in minutes",
+                    "type": "integer"
+                },
+                "time_threshold": {
+                    "description": "Threshold from start_time to be considered same run in minutes",
+                    "type": "integer"
+                },
+                "timeout": {
+                    "description": "Time from start_time to be considered a failed run in minutes",
+                    "type": "integer"
+                },
+                "type": {
+                    "type": "string"
+                }
+            }
+        },
+        "moderateAbuseReportRequest": {
+            "type": "object",
+            "required": [
+                "action"
+            ],
+            "properties": {
+                "action": {
+                    "type": "string",
+                    "enum": [
+                        "review",
+                        "dismiss",
+                        "remove_post",
+                        "warn_user"
+                    ]
+                },
+                "comment": {
+                    "type": "string"
+                }
+            }
+        },
+        "muteMembershipRequest": {
+            "type": "object",
+            "required": [
+                "duration"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "maximum": 31536000,
+                    "minimum": 60
+                }
+            }
+        },
+        "muteMembershipResponse": {
+            "type": "object",
+            "properties": {
+                "muted_until": {
+                    "type": "string"
+                }
+            }
+        },
+        "notifications.Recipient": {
+            "type": "object",
+            "properties": {
+                "data": {
+                    "description": "merged over the message data for this recipient only",
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
+                },
+                "mute": {
                     "type": "boolean"
                 },
-                "member": {
-                    "$ref": "#/definitions/Creator"
-                },
-                "parent_id": {
+                "name": {
                     "type": "string"
                 },
-                "private": {
+                "user_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "pendingMemberDecision": {
+            "type": "object",
+            "required": [
+                "approve",
+                "membership_id"
+            ],
+            "properties": {
+                "approve": {
                     "type": "boolean"
                 },
-                "reactions": {
-                    "type": "object",
-                    "additionalProperties": {
-                        "type": "array",
-                        "items": {
-                            "type": "string"
-                        }
-                    }
-                },
-                "replies": {
-                    "description": "This is constructed by the code (ParentID)",
-                    "type": "array",
-                    "items": {
-                        "$ref": "#/definitions/model.Post"
-                    }
+                "membership_id": {
+                    "type": "string"
                 },
-                "subject": {
+                "reject_reason": {
+                    "description": "optional note of the rejection",
                     "type": "string"
                 },
-                "to_members": {
-                    "description": "nil or empty means everyone; non-empty means visible to those user ids and admins",
+                "reject_reason_code": {
+                    "description": "code of the rejection reasons catalog",
+                    "type": "string"
+                }
+            }
+        },
+        "pendingMemberDecisionsRequest": {
+            "type": "object",
+            "required": [
+                "decisions"
+            ],
+            "properties": {
+                "decisions": {
                     "type": "array",
+                    "maxItems": 100,
+                    "minItems": 1,
                     "items": {
-                        "$ref": "#/definitions/ToMember"
+                        "$ref": "#/definitions/pendingMemberDecision"
                     }
-                },
-                "top_parent_id": {
-                    "type": "string"
-                },
-                "use_as_notification": {
-                    "type": "boolean"
                 }
             }
         },
-        "model.SyncConfig": {
+        "postApprovalRequest": {
             "type": "object",
+            "required": [
+                "approve"
+            ],
             "properties": {
-                "client_id": {
-                    "type": "string"
-                },
-                "cron": {
-                    "type": "string"
-                },
-                "group_timeout": {
-                    "description": "Time from sync_start_time to be considered a failed run for a single group in minutes",
-                    "type": "integer"
-                },
-                "time_threshold": {
-                    "description": "Threshold from start_time to be considered same run in minutes",
-                    "type": "integer"
-                },
-                "timeout": {
-                    "description": "Time from start_time to be considered a failed run in minutes",
-                    "type": "integer"
+                "approve": {
+                    "type": "boolean"
                 },
-                "type": {
+                "rejection_reason": {
                     "type": "string"
                 }
             }
         },
+        "postRecipientsRequest": {
+            "type": "object",
+            "required": [
+                "user_ids"
+            ],
+            "properties": {
+                "user_ids": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
         "reportAbuseGroupPostRequestBody": {
             "type": "object",
             "properties": {
@@ -5452,6 +14896,48 @@ const docTemplate = `{
                     "type": "object",
                     "additionalProperties": true
                 },
+                "events": {
+                    "description": "the events of the created series",
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                },
+                "recurrence": {
+                    "description": "creates a series of events starting with the event",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/EventRecurrence"
+                        }
+                    ]
+                },
+                "to_members": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/ToMember"
+                    }
+                }
+            }
+        },
+        "rest.deleteEventSeriesResponse": {
+            "type": "object",
+            "properties": {
+                "deleted": {
+                    "type": "integer"
+                }
+            }
+        },
+        "rest.updateCalendarEventSeriesData": {
+            "type": "object",
+            "properties": {
+                "events": {
+                    "type": "array",
+                    "items": {
+                        "type": "object",
+                        "additionalProperties": true
+                    }
+                },
                 "to_members": {
                     "type": "array",
                     "items": {
@@ -5498,6 +14984,52 @@ const docTemplate = `{
                 }
             }
         },
+        "rotateCalendarFeedLinkRequest": {
+            "type": "object",
+            "properties": {
+                "overlap_seconds": {
+                    "description": "the previous feed URL still works for this period",
+                    "type": "integer",
+                    "maximum": 604800,
+                    "minimum": 0
+                }
+            }
+        },
+        "rotateWebhookSecretRequest": {
+            "type": "object",
+            "properties": {
+                "overlap_seconds": {
+                    "description": "the previous secret still signs the payloads for this period",
+                    "type": "integer",
+                    "maximum": 604800,
+                    "minimum": 0
+                },
+                "secret": {
+                    "description": "generated when missing",
+                    "type": "string"
+                }
+            }
+        },
+        "scheduleMembershipTransitionRequest": {
+            "type": "object",
+            "required": [
+                "date_effective",
+                "status"
+            ],
+            "properties": {
+                "date_effective": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string",
+                    "enum": [
+                        "admin",
+                        "member",
+                        "removed"
+                    ]
+                }
+            }
+        },
         "sendGroupNotificationRequestBody": {
             "type": "object",
             "required": [
@@ -5538,6 +15070,64 @@ const docTemplate = `{
                 }
             }
         },
+        "snoozeMembershipRequest": {
+            "type": "object",
+            "required": [
+                "duration"
+            ],
+            "properties": {
+                "duration": {
+                    "type": "integer",
+                    "maximum": 604800,
+                    "minimum": 60
+                }
+            }
+        },
+        "snoozeMembershipResponse": {
+            "type": "object",
+            "properties": {
+                "snoozed_until": {
+                    "type": "string"
+                }
+            }
+        },
+        "startReactionMigrationRequest": {
+            "type": "object",
+            "properties": {
+                "group_ids": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
+        },
+        "transferGroupOwnershipRequest": {
+            "type": "object",
+            "required": [
+                "membership_id"
+            ],
+            "properties": {
+                "keep_admin": {
+                    "description": "the current admin stays an admin too",
+                    "type": "boolean"
+                },
+                "membership_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "updateGroupOrgUnitRequest": {
+            "type": "object",
+            "required": [
+                "code"
+            ],
+            "properties": {
+                "code": {
+                    "type": "string"
+                }
+            }
+        },
         "updateGroupRequest": {
             "type": "object",
             "required": [
@@ -5558,12 +15148,24 @@ const docTemplate = `{
                 "authman_group": {
                     "type": "string"
                 },
+                "authman_groups": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "block_new_membership_requests": {
                     "type": "boolean"
                 },
                 "can_join_automatically": {
                     "type": "boolean"
                 },
+                "categories": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
                 "category": {
                     "type": "string"
                 },
@@ -5576,12 +15178,24 @@ const docTemplate = `{
                 "image_url": {
                     "type": "string"
                 },
+                "localizations": {
+                    "$ref": "#/definitions/model.GroupLocalizations"
+                },
+                "location": {
+                    "$ref": "#/definitions/GeoPoint"
+                },
                 "membership_questions": {
                     "type": "array",
                     "items": {
                         "type": "string"
                     }
                 },
+                "membership_questions_schema": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/MembershipQuestion"
+                    }
+                },
                 "only_admins_can_create_polls": {
                     "type": "boolean"
                 },
@@ -5636,6 +15250,29 @@ const docTemplate = `{
                 }
             }
         },
+        "updateGroupRulesRequest": {
+            "type": "object",
+            "properties": {
+                "text": {
+                    "type": "string"
+                }
+            }
+        },
+        "updateMembershipDigestRequest": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "boolean"
+                },
+                "frequency": {
+                    "type": "string",
+                    "enum": [
+                        "daily",
+                        "weekly"
+                    ]
+                }
+            }
+        },
         "updateMembershipRequest": {
             "type": "object",
             "required": [
@@ -5645,9 +15282,18 @@ const docTemplate = `{
                 "date_attended": {
                     "type": "string"
                 },
+                "mentionable": {
+                    "type": "boolean"
+                },
                 "notifications_preferences": {
                     "$ref": "#/definitions/NotificationsPreferences"
                 },
+                "searchable": {
+                    "type": "boolean"
+                },
+                "show_as_leader": {
+                    "type": "boolean"
+                },
                 "status": {
                     "type": "string",
                     "enum": [
@@ -5656,6 +15302,49 @@ const docTemplate = `{
                     ]
                 }
             }
+        },
+        "updateWebhookSubscriptionRequest": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean"
+                }
+            }
+        },
+        "utils.GroupError": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "integer"
+                },
+                "details": {
+                    "description": "optional machine readable details",
+                    "type": "object",
+                    "additionalProperties": true
+                },
+                "message": {
+                    "type": "string"
+                },
+                "traceID": {
+                    "description": "the id of the request, set when the response is written",
+                    "type": "string"
+                }
+            }
+        },
+        "validateAuthmanGroupsRequest": {
+            "type": "object",
+            "required": [
+                "authman_groups"
+            ],
+            "properties": {
+                "authman_groups": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                }
+            }
         }
     },
     "securityDefinitions": {
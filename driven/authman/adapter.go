@@ -18,10 +18,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"groups/core/model"
+	"groups/utils"
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strings"
+	"time"
 )
 
 // Adapter implements the Authman interface
@@ -29,21 +32,24 @@ type Adapter struct {
 	authmanBaseURL  string
 	authmanUsername string
 	authmanPassword string
+	client          *utils.ResilientClient
 }
 
 // SubjectsourceidUofinetid constant for using in authmanSubjectLookup
 const SubjectsourceidUofinetid = "uofinetid"
 
 // NewAuthmanAdapter creates a new adapter for Authman API
-func NewAuthmanAdapter(authmanURL string, authmanUsername string, authmanPassword string) *Adapter {
-	return &Adapter{authmanBaseURL: authmanURL, authmanUsername: authmanUsername, authmanPassword: authmanPassword}
+func NewAuthmanAdapter(authmanURL string, authmanUsername string, authmanPassword string, clientConfig utils.ResilientClientConfig) *Adapter {
+	// the Authman POST requests are queries, so they are safe to retry
+	clientConfig.RetryAllMethods = true
+	client := utils.NewResilientClient("authman", clientConfig)
+	return &Adapter{authmanBaseURL: authmanURL, authmanUsername: authmanUsername, authmanPassword: authmanPassword, client: client}
 }
 
 // RetrieveAuthmanGroupMembers retrieves all members for a group
 func (a *Adapter) RetrieveAuthmanGroupMembers(groupName string) ([]string, error) {
 	if len(groupName) > 0 {
 		url := fmt.Sprintf("%s/groups/%s/members", a.authmanBaseURL, groupName)
-		client := &http.Client{}
 		req, err := http.NewRequest("GET", url, nil)
 		if err != nil {
 			log.Printf("RetrieveAuthmanGroupMembers: error creating load user data request - %s", err)
@@ -52,7 +58,7 @@ func (a *Adapter) RetrieveAuthmanGroupMembers(groupName string) ([]string, error
 
 		req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("RetrieveAuthmanGroupMembers: error loading user data - %s", err)
 			return nil, err
@@ -88,11 +94,79 @@ func (a *Adapter) RetrieveAuthmanGroupMembers(groupName string) ([]string, error
 	return nil, nil
 }
 
+// RetrieveAuthmanGroupMemberChanges retrieves the members added to and removed from a group since the provided time.
+// An error is returned if Authman cannot give the changes (eg. the change log does not reach the provided time), so the caller falls back to the full members retrieval.
+func (a *Adapter) RetrieveAuthmanGroupMemberChanges(groupName string, since time.Time) (*model.AuthmanMemberChanges, error) {
+	if len(groupName) == 0 {
+		return nil, fmt.Errorf("RetrieveAuthmanGroupMemberChanges: missing group name")
+	}
+
+	requestTime := time.Now()
+	query := url.Values{}
+	query.Set("since", since.UTC().Format(time.RFC3339))
+	requestURL := fmt.Sprintf("%s/groups/%s/members/changes?%s", a.authmanBaseURL, groupName, query.Encode())
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		log.Printf("RetrieveAuthmanGroupMemberChanges: error creating member changes request - %s", err)
+		return nil, err
+	}
+
+	req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		log.Printf("RetrieveAuthmanGroupMemberChanges: error loading member changes - %s", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != 200 {
+		log.Printf("RetrieveAuthmanGroupMemberChanges: error with response code - %d", resp.StatusCode)
+		return nil, fmt.Errorf("RetrieveAuthmanGroupMemberChanges: error with response code - %d", resp.StatusCode)
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("RetrieveAuthmanGroupMemberChanges: unable to read json: %s", err)
+		return nil, fmt.Errorf("RetrieveAuthmanGroupMemberChanges: unable to parse json: %s", err)
+	}
+
+	var authmanData model.AuthmanMemberChangesResponse
+	err = json.Unmarshal(data, &authmanData)
+	if err != nil {
+		log.Printf("RetrieveAuthmanGroupMemberChanges: unable to parse json: %s", err)
+		return nil, fmt.Errorf("RetrieveAuthmanGroupMemberChanges: unable to parse json: %s", err)
+	}
+	result := authmanData.WsGetMemberChangesResult
+	if result.ResultMetadata.Success == "F" {
+		return nil, fmt.Errorf("RetrieveAuthmanGroupMemberChanges: %s - %s", result.ResultMetadata.ResultCode, result.ResultMetadata.ResultMessage)
+	}
+
+	// the changes are complete at least up to the time of the request
+	changes := model.AuthmanMemberChanges{Added: []string{}, Removed: []string{}, AsOf: requestTime}
+	if result.AsOf != "" {
+		asOf, err := time.Parse(time.RFC3339, result.AsOf)
+		if err == nil {
+			changes.AsOf = asOf
+		}
+	}
+	for _, subject := range result.AddedSubjects {
+		if subject.SourceID == SubjectsourceidUofinetid {
+			changes.Added = append(changes.Added, subject.ID)
+		}
+	}
+	for _, subject := range result.RemovedSubjects {
+		if subject.SourceID == SubjectsourceidUofinetid {
+			changes.Removed = append(changes.Removed, subject.ID)
+		}
+	}
+
+	return &changes, nil
+}
+
 // AddAuthmanMemberToGroup add a member to an Authman group
 func (a *Adapter) AddAuthmanMemberToGroup(groupName string, uin string) error {
 	if len(groupName) > 0 {
 		url := fmt.Sprintf("%s/groups/%s/members/%s", a.authmanBaseURL, groupName, uin)
-		client := &http.Client{}
 		req, err := http.NewRequest("PUT", url, nil)
 		if err != nil {
 			log.Printf("AddAuthmanMemberToGroup: error creating load user data request - %s", err)
@@ -101,7 +175,7 @@ func (a *Adapter) AddAuthmanMemberToGroup(groupName string, uin string) error {
 
 		req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("AddAuthmanMemberToGroup: error loading user data - %s", err)
 			return err
@@ -121,7 +195,6 @@ func (a *Adapter) AddAuthmanMemberToGroup(groupName string, uin string) error {
 func (a *Adapter) RemoveAuthmanMemberFromGroup(groupName string, uin string) error {
 	if len(groupName) > 0 {
 		url := fmt.Sprintf("%s/groups/%s/members/%s", a.authmanBaseURL, groupName, uin)
-		client := &http.Client{}
 		req, err := http.NewRequest("DELETE", url, nil)
 		if err != nil {
 			log.Printf("AddAuthmanMemberToGroup: error creating load user data request - %s", err)
@@ -130,7 +203,7 @@ func (a *Adapter) RemoveAuthmanMemberFromGroup(groupName string, uin string) err
 
 		req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("AddAuthmanMemberToGroup: error loading user data - %s", err)
 			return err
@@ -171,7 +244,6 @@ func (a *Adapter) RetrieveAuthmanUsers(externalIDs []string) (map[string]model.A
 		}
 
 		url := fmt.Sprintf("%s/subjects", a.authmanBaseURL)
-		client := &http.Client{}
 		req, err := http.NewRequest("GET", url, strings.NewReader(string(reqBody)))
 		if err != nil {
 			log.Printf("RetrieveAuthmanUsers: error creating load user data request - %s", err)
@@ -181,7 +253,7 @@ func (a *Adapter) RetrieveAuthmanUsers(externalIDs []string) (map[string]model.A
 		req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
 		req.Header.Add("Content-Type", "application/json")
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("RetrieveAuthmanUsers: error loading user data - %s", err)
 			return nil, err
@@ -233,7 +305,6 @@ func (a *Adapter) RetrieveAuthmanStemGroups(stemName string) (*model.АuthmanGro
 		}`, stemName)
 
 	url := fmt.Sprintf("%s/groups", a.authmanBaseURL)
-	client := &http.Client{}
 	req, err := http.NewRequest("POST", url, strings.NewReader(requestBody))
 	if err != nil {
 		log.Printf("RetrieveAuthmanStemGroups: error creating load user data request - %s", err)
@@ -243,7 +314,7 @@ func (a *Adapter) RetrieveAuthmanStemGroups(stemName string) (*model.АuthmanGro
 	req.SetBasicAuth(a.authmanUsername, a.authmanPassword)
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := client.Do(req)
+	resp, err := a.client.Do(req)
 	if err != nil {
 		log.Printf("RetrieveAuthmanStemGroups: error loading user data - %s", err)
 		return nil, err
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"groups/core/model"
+	"groups/utils"
 	"io"
 	"log"
 	"net/http"
@@ -31,16 +32,18 @@ import (
 type Adapter struct {
 	baseURL               string
 	serviceAccountManager *authservice.ServiceAccountManager
+	client                *utils.ResilientClient
 }
 
 // NewCalendarAdapter creates a new Calendar BB adapter instance
-func NewCalendarAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager) (*Adapter, error) {
+func NewCalendarAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager, clientConfig utils.ResilientClientConfig) (*Adapter, error) {
 	if serviceAccountManager == nil {
 		log.Println("service account manager is nil")
 		return nil, errors.New("service account manager is nil")
 	}
 
-	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager}, nil
+	client := utils.NewResilientClient("calendar", clientConfig)
+	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager, client: client}, nil
 }
 
 // CreateCalendarEvent creates calendar event
@@ -69,7 +72,7 @@ func (a *Adapter) CreateCalendarEvent(adminIdentifier []model.AccountIdentifiers
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := a.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("CreateCalendarEvent: error sending request - %s", err)
 		return nil, err
@@ -125,7 +128,7 @@ func (a *Adapter) UpdateCalendarEvent(currentAccountIdentifier model.AccountIden
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := a.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("UpdateCalendarEvent: error sending request - %s", err)
 		return nil, err
@@ -153,6 +156,50 @@ func (a *Adapter) UpdateCalendarEvent(currentAccountIdentifier model.AccountIden
 	return response, err
 }
 
+// DeleteCalendarEvent deletes calendar event
+func (a *Adapter) DeleteCalendarEvent(currentAccountIdentifier model.AccountIdentifiers, eventID string, orgID string, appID string) error {
+
+	type calendarRequest struct {
+		EventID                   string                   `json:"event_id"`
+		CurrentAccountIdentifiers model.AccountIdentifiers `json:"current_account_identifiers"`
+		AppID                     string                   `json:"app_id"`
+		OrgID                     string                   `json:"org_id"`
+	}
+
+	body := calendarRequest{EventID: eventID, CurrentAccountIdentifiers: currentAccountIdentifier, AppID: appID, OrgID: orgID}
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	url := fmt.Sprintf("%s/api/bbs/events", a.baseURL)
+	req, err := http.NewRequest("DELETE", url, bytes.NewReader(data))
+	if err != nil {
+		log.Printf("DeleteCalendarEvent:error creating event  request - %s", err)
+		return err
+	}
+	req.Header.Add("Content-Type", "application/json")
+
+	resp, err := a.makeRequest(req, appID, orgID)
+	if err != nil {
+		log.Printf("DeleteCalendarEvent: error sending request - %s", err)
+		return err
+	}
+	defer resp.Body.Close()
+
+	responseData, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("DeleteCalendarEvent: unable to read response json: %s", err)
+		return fmt.Errorf("DeleteCalendarEvent: unable to parse response json: %s", err)
+	}
+
+	if resp.StatusCode != 200 {
+		log.Printf("DeleteCalendarEvent: error with response code - %d, Response: %s", resp.StatusCode, responseData)
+		return fmt.Errorf("DeleteCalendarEvent:error with response code != 200")
+	}
+	return nil
+}
+
 // GetGroupCalendarEvents gets calendar events for a group
 func (a *Adapter) GetGroupCalendarEvents(currentAccountIdentifier model.AccountIdentifiers, eventIDs []string, appID string, orgID string, published *bool, filter model.GroupEventFilter) (map[string]interface{}, error) {
 	type filterType struct {
@@ -205,7 +252,7 @@ func (a *Adapter) GetGroupCalendarEvents(currentAccountIdentifier model.AccountI
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := a.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("GetGroupCalendarEvents: error sending request - %s", err)
 		return nil, err
@@ -257,7 +304,7 @@ func (a *Adapter) AddPeopleToCalendarEvent(people []string, eventID string, orgI
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := a.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("AddPeopleToCalendarEvent: error sending request - %s", err)
 		return err
@@ -301,7 +348,7 @@ func (a *Adapter) RemovePeopleFromCalendarEvent(people []string, eventID string,
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := a.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("RemovePeopleFromCalendarEvent: error sending request - %s", err)
 		return err
@@ -320,3 +367,10 @@ func (a *Adapter) RemovePeopleFromCalendarEvent(people []string, eventID string,
 	}
 	return nil
 }
+
+// makeRequest sends the request authenticated by the service account manager through the resilient client
+func (a *Adapter) makeRequest(req *http.Request, appID string, orgID string) (*http.Response, error) {
+	return a.client.DoWith(req, func(req *http.Request) (*http.Response, error) {
+		return a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	})
+}
@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 	"groups/core/model"
+	"groups/utils"
 	"io"
 	"io/ioutil"
 	"log"
@@ -34,19 +35,20 @@ import (
 type Adapter struct {
 	coreURL               string
 	serviceAccountManager *authservice.ServiceAccountManager
+	client                *utils.ResilientClient
 	logger                logs.Logger
 }
 
 // NewCoreAdapter creates a new adapter for Core API
-func NewCoreAdapter(coreURL string, serviceAccountManager *authservice.ServiceAccountManager) *Adapter {
-	return &Adapter{coreURL: coreURL, serviceAccountManager: serviceAccountManager}
+func NewCoreAdapter(coreURL string, serviceAccountManager *authservice.ServiceAccountManager, clientConfig utils.ResilientClientConfig) *Adapter {
+	client := utils.NewResilientClient("core", clientConfig)
+	return &Adapter{coreURL: coreURL, serviceAccountManager: serviceAccountManager, client: client}
 }
 
 // RetrieveCoreUserAccount retrieves Core user account
 func (a *Adapter) RetrieveCoreUserAccount(token string) (*model.CoreAccount, error) {
 	if len(token) > 0 {
 		url := fmt.Sprintf("%s/services/account", token)
-		client := &http.Client{}
 		req, err := http.NewRequest("GET", url, nil)
 		if err != nil {
 			log.Printf("RetrieveCoreUserAccount: error creating load user data request - %s", err)
@@ -54,7 +56,7 @@ func (a *Adapter) RetrieveCoreUserAccount(token string) (*model.CoreAccount, err
 		}
 		req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("RetrieveCoreUserAccount: error loading user data - %s", err)
 			return nil, err
@@ -87,14 +89,13 @@ func (a *Adapter) RetrieveCoreUserAccount(token string) (*model.CoreAccount, err
 func (a *Adapter) RetrieveCoreServices(serviceIDs []string) ([]model.CoreService, error) {
 	if len(serviceIDs) > 0 {
 		url := fmt.Sprintf("%s/bbs/service-regs?ids=%s", a.coreURL, strings.Join(serviceIDs, ","))
-		client := &http.Client{}
 		req, err := http.NewRequest("GET", url, nil)
 		if err != nil {
 			log.Printf("RetrieveCoreServices: error creating load core service regs - %s", err)
 			return nil, err
 		}
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("RetrieveCoreServices: error loading core service regs data - %s", err)
 			return nil, err
@@ -164,7 +165,7 @@ func (a *Adapter) GetAccountsCount(searchParams map[string]interface{}, appID *s
 	if orgID != nil {
 		appIDVal = *orgID
 	}
-	resp, err := a.serviceAccountManager.MakeRequest(req, appIDVal, orgIDVal)
+	resp, err := a.makeRequest(req, appIDVal, orgIDVal)
 	if err != nil {
 		log.Printf("GetAccountsCount: error sending request - %s", err)
 		return 0, err
@@ -294,7 +295,7 @@ func (a *Adapter) GetAccounts(searchParams map[string]interface{}, appID *string
 	if orgID != nil {
 		appIDVal = *orgID
 	}
-	resp, err := a.serviceAccountManager.MakeRequest(req, appIDVal, orgIDVal)
+	resp, err := a.makeRequest(req, appIDVal, orgIDVal)
 	if err != nil {
 		log.Printf("GetAccounts: error sending request - %s", err)
 		return nil, err
@@ -339,7 +340,7 @@ func (a *Adapter) LoadDeletedMemberships() ([]model.DeletedUserData, error) {
 	}
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := a.serviceAccountManager.MakeRequest(req, "all", "all")
+	resp, err := a.makeRequest(req, "all", "all")
 	if err != nil {
 		log.Printf("LoadDeletedMemberships: error sending request - %s", err)
 		return nil, err
@@ -379,7 +380,7 @@ func (a *Adapter) RetrieveFerpaAccounts(ids []string) ([]string, error) {
 		}
 		req.Header.Add("Content-Type", "application/json")
 
-		resp, err := a.serviceAccountManager.MakeRequest(req, "all", "all")
+		resp, err := a.makeRequest(req, "all", "all")
 		if err != nil {
 			log.Printf("RetrieveFerpaAccounts: error sending request - %s", err)
 			return nil, err
@@ -408,3 +409,10 @@ func (a *Adapter) RetrieveFerpaAccounts(ids []string) ([]string, error) {
 	}
 	return nil, nil
 }
+
+// makeRequest sends the request authenticated by the service account manager through the resilient client
+func (a *Adapter) makeRequest(req *http.Request, appID string, orgID string) (*http.Response, error) {
+	return a.client.DoWith(req, func(req *http.Request) (*http.Response, error) {
+		return a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	})
+}
@@ -0,0 +1,248 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package messagebus
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// TypeSNS publishes the events to an AWS SNS topic
+	TypeSNS = "sns"
+	// TypeSQS sends the events to an AWS SQS queue
+	TypeSQS = "sqs"
+	// TypeKafka produces the events to a Kafka topic through the Kafka REST proxy
+	TypeKafka = "kafka"
+)
+
+// Adapter implements the MessageBus interface. The AWS requests use the query API signed with AWS Signature Version 4, the Kafka
+// records are produced through the REST proxy (v2 API), so no client library is needed for any of the buses.
+type Adapter struct {
+	busType   string
+	endpoint  string // SNS endpoint, SQS queue URL or Kafka REST proxy URL
+	target    string // SNS topic ARN, SQS queue URL or Kafka topic
+	region    string
+	accessKey string
+	secretKey string
+
+	client *utils.ResilientClient
+}
+
+// NewMessageBusAdapter creates a new message bus adapter. The endpoint is optional for SNS and SQS, the regional AWS endpoints are
+// used by default. The Kafka REST proxy credentials could be provided within the endpoint URL.
+func NewMessageBusAdapter(busType string, endpoint string, target string, region string, accessKey string, secretKey string,
+	clientConfig utils.ResilientClientConfig) (*Adapter, error) {
+	if target == "" {
+		return nil, fmt.Errorf("missing message bus target")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+
+	switch busType {
+	case TypeSNS:
+		if endpoint == "" {
+			endpoint = fmt.Sprintf("https://sns.%s.amazonaws.com/", region)
+		}
+	case TypeSQS:
+		endpoint = target
+	case TypeKafka:
+		if endpoint == "" {
+			return nil, fmt.Errorf("missing Kafka REST proxy endpoint")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported message bus type %s", busType)
+	}
+	if (busType == TypeSNS || busType == TypeSQS) && (accessKey == "" || secretKey == "") {
+		return nil, fmt.Errorf("missing AWS credentials")
+	}
+
+	client := utils.NewResilientClient("message_bus", clientConfig)
+	return &Adapter{busType: busType, endpoint: strings.TrimSuffix(endpoint, "/"), target: target, region: region,
+		accessKey: accessKey, secretKey: secretKey, client: client}, nil
+}
+
+// Publish publishes the event payload. The group ID is the partition key of the Kafka records and the message group of the FIFO topics
+// and queues, so the events of a group are consumed in order.
+func (a *Adapter) Publish(event model.DomainEvent, payload []byte) error {
+	var req *http.Request
+	var err error
+	switch a.busType {
+	case TypeSNS:
+		req, err = a.newSNSRequest(event, payload)
+	case TypeSQS:
+		req, err = a.newSQSRequest(event, payload)
+	default:
+		req, err = a.newKafkaRequest(event, payload)
+	}
+	if err != nil {
+		return fmt.Errorf("messagebus.Publish: error creating request - %s", err)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("messagebus.Publish: error sending request - %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("messagebus.Publish: unable to read the response - %s", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("messagebus.Publish: error with response code - %d: %s", resp.StatusCode, string(body))
+	}
+	return nil
+}
+
+func (a *Adapter) newSNSRequest(event model.DomainEvent, payload []byte) (*http.Request, error) {
+	form := url.Values{}
+	form.Set("Action", "Publish")
+	form.Set("Version", "2010-03-31")
+	form.Set("TopicArn", a.target)
+	form.Set("Message", string(payload))
+	form.Set("MessageAttributes.entry.1.Name", "type")
+	form.Set("MessageAttributes.entry.1.Value.DataType", "String")
+	form.Set("MessageAttributes.entry.1.Value.StringValue", event.Type)
+	if strings.HasSuffix(a.target, ".fifo") {
+		form.Set("MessageGroupId", partitionKey(event))
+		form.Set("MessageDeduplicationId", event.ID)
+	}
+	return a.newAWSRequest("sns", form)
+}
+
+func (a *Adapter) newSQSRequest(event model.DomainEvent, payload []byte) (*http.Request, error) {
+	form := url.Values{}
+	form.Set("Action", "SendMessage")
+	form.Set("Version", "2012-11-05")
+	form.Set("MessageBody", string(payload))
+	form.Set("MessageAttribute.1.Name", "type")
+	form.Set("MessageAttribute.1.Value.DataType", "String")
+	form.Set("MessageAttribute.1.Value.StringValue", event.Type)
+	if strings.HasSuffix(a.target, ".fifo") {
+		form.Set("MessageGroupId", partitionKey(event))
+		form.Set("MessageDeduplicationId", event.ID)
+	}
+	return a.newAWSRequest("sqs", form)
+}
+
+func (a *Adapter) newKafkaRequest(event model.DomainEvent, payload []byte) (*http.Request, error) {
+	type kafkaRecord struct {
+		Key   string          `json:"key"`
+		Value json.RawMessage `json:"value"`
+	}
+	type kafkaRecords struct {
+		Records []kafkaRecord `json:"records"`
+	}
+
+	data, err := json.Marshal(kafkaRecords{Records: []kafkaRecord{{Key: partitionKey(event), Value: payload}}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", a.endpoint+"/topics/"+url.PathEscape(a.target), bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+	req.Header.Set("Accept", "application/vnd.kafka.v2+json")
+	return req, nil
+}
+
+func (a *Adapter) newAWSRequest(service string, form url.Values) (*http.Request, error) {
+	data := []byte(form.Encode())
+	req, err := http.NewRequest("POST", a.endpoint, bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	a.sign(req, service, data, time.Now().UTC())
+	return req, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization headers to the request
+func (a *Adapter) sign(req *http.Request, service string, data []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(data)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+
+	signedHeaders := []string{"content-type", "host", "x-amz-date"}
+	var canonicalHeaders strings.Builder
+	for _, header := range signedHeaders {
+		value := req.Header.Get(header)
+		if header == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(header + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + a.region + "/" + service + "/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+a.secretKey), date)
+	signingKey = hmacSHA256(signingKey, a.region)
+	signingKey = hmacSHA256(signingKey, service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.accessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+// partitionKey keeps the events of a group together, the client events without a group are keyed by the client
+func partitionKey(event model.DomainEvent) string {
+	if event.GroupID != "" {
+		return event.GroupID
+	}
+	return event.ClientID
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,96 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package moderation
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+)
+
+// Adapter implements the Moderation interface
+type Adapter struct {
+	url    string
+	apiKey string
+	client *utils.ResilientClient
+}
+
+// NewModerationAdapter creates a new external moderation API adapter instance
+func NewModerationAdapter(url string, apiKey string, clientConfig utils.ResilientClientConfig) *Adapter {
+	client := utils.NewResilientClient("moderation", clientConfig)
+	return &Adapter{url: url, apiKey: apiKey, client: client}
+}
+
+// CheckContent checks the text with the external moderation API. Gives the violated categories, empty if the text is acceptable.
+func (a *Adapter) CheckContent(text string) ([]string, error) {
+	type checkRequest struct {
+		Text string `json:"text"`
+	}
+	type checkResponse struct {
+		Flagged    bool     `json:"flagged"`
+		Categories []string `json:"categories"`
+	}
+
+	data, err := json.Marshal(checkRequest{Text: text})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", a.url, bytes.NewReader(data))
+	if err != nil {
+		log.Printf("CheckContent: error creating request - %s", err)
+		return nil, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	if a.apiKey != "" {
+		req.Header.Add("Authorization", "Bearer "+a.apiKey)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		log.Printf("CheckContent: error sending request - %s", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	dataRes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("CheckContent: unable to read json: %s", err)
+		return nil, fmt.Errorf("CheckContent: unable to parse json: %s", err)
+	}
+	if resp.StatusCode != 200 {
+		log.Printf("CheckContent: error with response code - %d body: %s", resp.StatusCode, dataRes)
+		return nil, fmt.Errorf("CheckContent: error with response code - %d body: %s", resp.StatusCode, dataRes)
+	}
+
+	var response checkResponse
+	err = json.Unmarshal(dataRes, &response)
+	if err != nil {
+		log.Printf("CheckContent: unable to parse json: %s", err)
+		return nil, fmt.Errorf("CheckContent: unable to parse json: %s", err)
+	}
+
+	if !response.Flagged {
+		return nil, nil
+	}
+	if len(response.Categories) == 0 {
+		return []string{"flagged"}, nil
+	}
+	return response.Categories, nil
+}
@@ -19,6 +19,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"groups/utils"
 	"io"
 	"log"
 	"net/http"
@@ -31,23 +32,26 @@ import (
 type Adapter struct {
 	baseURL               string
 	serviceAccountManager *authservice.ServiceAccountManager
+	client                *utils.ResilientClient
 }
 
 // Recipient struct
 type Recipient struct {
-	UserID string `json:"user_id"`
-	Name   string `json:"name"`
-	Mute   bool   `json:"mute"`
+	UserID string            `json:"user_id"`
+	Name   string            `json:"name"`
+	Mute   bool              `json:"mute"`
+	Data   map[string]string `json:"data,omitempty"` // merged over the message data for this recipient only
 }
 
 // NewNotificationsAdapter creates a new Notifications BB adapter instance
-func NewNotificationsAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager) (*Adapter, error) {
+func NewNotificationsAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager, clientConfig utils.ResilientClientConfig) (*Adapter, error) {
 	if serviceAccountManager == nil {
 		log.Println("service account manager is nil")
 		return nil, errors.New("service account manager is nil")
 	}
 
-	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager}, nil
+	client := utils.NewResilientClient("notifications", clientConfig)
+	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager, client: client}, nil
 }
 
 // SendNotification sends notification to a user
@@ -90,7 +94,7 @@ func (na *Adapter) sendNotification(recipients []Recipient, topic *string, title
 			return err
 		}
 
-		resp, err := na.serviceAccountManager.MakeRequest(req, appID, orgID)
+		resp, err := na.makeRequest(req, appID, orgID)
 		if err != nil {
 			log.Printf("SendNotification: error sending request - %s", err)
 			return err
@@ -138,7 +142,7 @@ func (na *Adapter) sendMail(toEmail string, subject string, body string) error {
 			return err
 		}
 
-		resp, err := na.serviceAccountManager.MakeRequest(req, "all", "all")
+		resp, err := na.makeRequest(req, "all", "all")
 		if err != nil {
 			log.Printf("sendMail: error sending request - %s", err)
 			return err
@@ -175,7 +179,7 @@ func (na *Adapter) DeleteNotifications(appID string, orgID string, ids string) e
 		return err
 	}
 
-	resp, err := na.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := na.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("DeleteNotification: error sending request - %s", err)
 		return err
@@ -220,7 +224,7 @@ func (na *Adapter) AddNotificationRecipients(appID string, orgID string, notific
 		return err
 	}
 
-	resp, err := na.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := na.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("AddNotificationRecipient: error sending request - %s", err)
 		return err
@@ -265,7 +269,7 @@ func (na *Adapter) RemoveNotificationRecipients(appID string, orgID string, noti
 		return err
 	}
 
-	resp, err := na.serviceAccountManager.MakeRequest(req, appID, orgID)
+	resp, err := na.makeRequest(req, appID, orgID)
 	if err != nil {
 		log.Printf("AddNotificationRecipient: error sending request - %s", err)
 		return err
@@ -279,3 +283,10 @@ func (na *Adapter) RemoveNotificationRecipients(appID string, orgID string, noti
 	}
 	return nil
 }
+
+// makeRequest sends the request authenticated by the service account manager through the resilient client
+func (na *Adapter) makeRequest(req *http.Request, appID string, orgID string) (*http.Response, error) {
+	return na.client.DoWith(req, func(req *http.Request) (*http.Response, error) {
+		return na.serviceAccountManager.MakeRequest(req, appID, orgID)
+	})
+}
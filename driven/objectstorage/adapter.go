@@ -0,0 +1,222 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package objectstorage
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Adapter implements the ObjectStorage interface for S3-compatible object storage. The requests are signed with AWS Signature Version 4
+// and use the path style addressing (<endpoint>/<bucket>/<key>), which is supported by AWS S3 and by the S3-compatible servers.
+type Adapter struct {
+	endpoint  string
+	region    string
+	bucket    string
+	accessKey string
+	secretKey string
+
+	client *http.Client
+}
+
+// NewObjectStorageAdapter creates a new object storage adapter
+func NewObjectStorageAdapter(endpoint string, region string, bucket string, accessKey string, secretKey string, timeout time.Duration) (*Adapter, error) {
+	if endpoint == "" || bucket == "" || accessKey == "" || secretKey == "" {
+		return nil, fmt.Errorf("missing object storage endpoint, bucket or credentials")
+	}
+	if region == "" {
+		region = "us-east-1"
+	}
+	return &Adapter{endpoint: strings.TrimSuffix(endpoint, "/"), region: region, bucket: bucket,
+		accessKey: accessKey, secretKey: secretKey, client: &http.Client{Timeout: timeout}}, nil
+}
+
+// PutObject stores the object with the provided key
+func (a *Adapter) PutObject(key string, data []byte, contentType string) error {
+	req, err := a.newRequest("PUT", key, nil, data)
+	if err != nil {
+		return fmt.Errorf("objectstorage.PutObject: error creating request - %s", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	_, err = a.do(req, data)
+	if err != nil {
+		return fmt.Errorf("objectstorage.PutObject: %s", err)
+	}
+	return nil
+}
+
+// GetObject gives the object with the provided key
+func (a *Adapter) GetObject(key string) ([]byte, error) {
+	req, err := a.newRequest("GET", key, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("objectstorage.GetObject: error creating request - %s", err)
+	}
+
+	data, err := a.do(req, nil)
+	if err != nil {
+		return nil, fmt.Errorf("objectstorage.GetObject: %s", err)
+	}
+	return data, nil
+}
+
+type listBucketResult struct {
+	Contents []struct {
+		Key string `xml:"Key"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+// ListObjects gives the keys of all objects with the provided prefix ordered by key
+func (a *Adapter) ListObjects(prefix string) ([]string, error) {
+	var keys []string
+	continuationToken := ""
+	for {
+		query := url.Values{}
+		query.Set("list-type", "2")
+		query.Set("prefix", prefix)
+		if continuationToken != "" {
+			query.Set("continuation-token", continuationToken)
+		}
+
+		req, err := a.newRequest("GET", "", query, nil)
+		if err != nil {
+			return nil, fmt.Errorf("objectstorage.ListObjects: error creating request - %s", err)
+		}
+		data, err := a.do(req, nil)
+		if err != nil {
+			return nil, fmt.Errorf("objectstorage.ListObjects: %s", err)
+		}
+
+		var result listBucketResult
+		err = xml.Unmarshal(data, &result)
+		if err != nil {
+			return nil, fmt.Errorf("objectstorage.ListObjects: unable to parse the response - %s", err)
+		}
+		for _, content := range result.Contents {
+			keys = append(keys, content.Key)
+		}
+
+		if !result.IsTruncated || result.NextContinuationToken == "" {
+			break
+		}
+		continuationToken = result.NextContinuationToken
+	}
+
+	sort.Strings(keys)
+	return keys, nil
+}
+
+func (a *Adapter) newRequest(method string, key string, query url.Values, data []byte) (*http.Request, error) {
+	path := "/" + a.bucket
+	if key != "" {
+		path += "/" + key
+	}
+	reqURL, err := url.Parse(a.endpoint)
+	if err != nil {
+		return nil, err
+	}
+	reqURL.Path = path
+	if query != nil {
+		reqURL.RawQuery = strings.ReplaceAll(query.Encode(), "+", "%20")
+	}
+	return http.NewRequest(method, reqURL.String(), bytes.NewReader(data))
+}
+
+func (a *Adapter) do(req *http.Request, data []byte) ([]byte, error) {
+	a.sign(req, data, time.Now().UTC())
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending request - %s", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read the response - %s", err)
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("error with response code - %d: %s", resp.StatusCode, string(body))
+	}
+	return body, nil
+}
+
+// sign adds the AWS Signature Version 4 authorization headers to the request
+func (a *Adapter) sign(req *http.Request, data []byte, now time.Time) {
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(data)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := []string{"host", "x-amz-content-sha256", "x-amz-date"}
+	if req.Header.Get("Content-Type") != "" {
+		signedHeaders = []string{"content-type", "host", "x-amz-content-sha256", "x-amz-date"}
+	}
+	var canonicalHeaders strings.Builder
+	for _, header := range signedHeaders {
+		value := req.Header.Get(header)
+		if header == "host" {
+			value = req.URL.Host
+		}
+		canonicalHeaders.WriteString(header + ":" + strings.TrimSpace(value) + "\n")
+	}
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		strings.Join(signedHeaders, ";"),
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + a.region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{"AWS4-HMAC-SHA256", amzDate, scope, sha256Hex([]byte(canonicalRequest))}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+a.secretKey), date)
+	signingKey = hmacSHA256(signingKey, a.region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		a.accessKey, scope, strings.Join(signedHeaders, ";"), signature))
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
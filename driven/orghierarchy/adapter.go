@@ -0,0 +1,81 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package orghierarchy
+
+import (
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Adapter implements the OrgHierarchy interface
+type Adapter struct {
+	baseURL string
+	apiKey  string
+	client  *utils.ResilientClient
+}
+
+// NewOrgHierarchyAdapter creates a new campus organizational hierarchy service adapter instance
+func NewOrgHierarchyAdapter(baseURL string, apiKey string, clientConfig utils.ResilientClientConfig) *Adapter {
+	client := utils.NewResilientClient("org_hierarchy", clientConfig)
+	return &Adapter{baseURL: strings.TrimSuffix(baseURL, "/"), apiKey: apiKey, client: client}
+}
+
+// GetOrgUnit loads the org unit with its ancestors. Gives nil if the code is not known to the hierarchy.
+func (a *Adapter) GetOrgUnit(code string) (*model.OrgUnit, error) {
+	req, err := http.NewRequest("GET", fmt.Sprintf("%s/org-units/%s", a.baseURL, url.PathEscape(code)), nil)
+	if err != nil {
+		log.Printf("GetOrgUnit: error creating request - %s", err)
+		return nil, err
+	}
+	if a.apiKey != "" {
+		req.Header.Add("Authorization", "Bearer "+a.apiKey)
+	}
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		log.Printf("GetOrgUnit: error sending request - %s", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("GetOrgUnit: unable to read json: %s", err)
+		return nil, fmt.Errorf("GetOrgUnit: unable to parse json: %s", err)
+	}
+	if resp.StatusCode != 200 {
+		log.Printf("GetOrgUnit: error with response code - %d body: %s", resp.StatusCode, data)
+		return nil, fmt.Errorf("GetOrgUnit: error with response code - %d body: %s", resp.StatusCode, data)
+	}
+
+	var unit model.OrgUnit
+	err = json.Unmarshal(data, &unit)
+	if err != nil {
+		log.Printf("GetOrgUnit: unable to parse json: %s", err)
+		return nil, fmt.Errorf("GetOrgUnit: unable to parse json: %s", err)
+	}
+	return &unit, nil
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package polls
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/rokwire/core-auth-library-go/v2/authservice"
+)
+
+// Adapter implements the Polls interface
+type Adapter struct {
+	baseURL               string
+	serviceAccountManager *authservice.ServiceAccountManager
+	client                *utils.ResilientClient
+}
+
+// NewPollsAdapter creates a new Polls BB adapter instance
+func NewPollsAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager, clientConfig utils.ResilientClientConfig) (*Adapter, error) {
+	if serviceAccountManager == nil {
+		log.Println("service account manager is nil")
+		return nil, errors.New("service account manager is nil")
+	}
+
+	client := utils.NewResilientClient("polls", clientConfig)
+	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager, client: client}, nil
+}
+
+// GetPollResults gives the results of the poll together with the voters. Gives nil if the poll does not exist.
+func (a *Adapter) GetPollResults(pollID string, appID string, orgID string) (*model.PollResults, error) {
+	requestURL := fmt.Sprintf("%s/api/bbs/polls/%s/results", a.baseURL, url.PathEscape(pollID))
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		log.Printf("GetPollResults: error creating request - %s", err)
+		return nil, err
+	}
+
+	resp, err := a.makeRequest(req, appID, orgID)
+	if err != nil {
+		log.Printf("GetPollResults: error sending request - %s", err)
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	dataRes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("GetPollResults: unable to read json: %s", err)
+		return nil, fmt.Errorf("GetPollResults: unable to parse json: %s", err)
+	}
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != 200 {
+		log.Printf("GetPollResults: error with response code - %d body: %s", resp.StatusCode, dataRes)
+		return nil, fmt.Errorf("GetPollResults: error with response code - %d body: %s", resp.StatusCode, dataRes)
+	}
+
+	var response model.PollResults
+	err = json.Unmarshal(dataRes, &response)
+	if err != nil {
+		log.Printf("GetPollResults: unable to parse json: %s", err)
+		return nil, fmt.Errorf("GetPollResults: unable to parse json: %s", err)
+	}
+
+	return &response, nil
+}
+
+func (a *Adapter) makeRequest(req *http.Request, appID string, orgID string) (*http.Response, error) {
+	return a.client.DoWith(req, func(req *http.Request) (*http.Response, error) {
+		return a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	})
+}
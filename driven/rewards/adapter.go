@@ -17,6 +17,7 @@ package rewards
 import (
 	"encoding/json"
 	"fmt"
+	"groups/utils"
 	"io"
 	"log"
 	"net/http"
@@ -27,6 +28,7 @@ import (
 type Adapter struct {
 	internalAPIKey string
 	rewardsHost    string
+	client         *utils.ResilientClient
 }
 
 const (
@@ -41,9 +43,9 @@ const (
 )
 
 // NewRewardsAdapter creates a new rewards adapter
-func NewRewardsAdapter(host string, internalAPIKey string) *Adapter {
+func NewRewardsAdapter(host string, internalAPIKey string, clientConfig utils.ResilientClientConfig) *Adapter {
 	if host != "" {
-		return &Adapter{rewardsHost: host, internalAPIKey: internalAPIKey}
+		return &Adapter{rewardsHost: host, internalAPIKey: internalAPIKey, client: utils.NewResilientClient("rewards", clientConfig)}
 	}
 	log.Fatal("Error: NewRewardsAdapter - not initialized core")
 	return nil
@@ -72,7 +74,6 @@ func (a *Adapter) CreateUserReward(userID string, rewardType string, description
 		}
 
 		url := fmt.Sprintf("%s/api/int/reward_history", a.rewardsHost)
-		client := &http.Client{}
 		req, err := http.NewRequest("POST", url, strings.NewReader(string(reqBody)))
 		req.Header.Add("INTERNAL-API-KEY", a.internalAPIKey)
 		if err != nil {
@@ -80,7 +81,7 @@ func (a *Adapter) CreateUserReward(userID string, rewardType string, description
 			return err
 		}
 
-		resp, err := client.Do(req)
+		resp, err := a.client.Do(req)
 		if err != nil {
 			log.Printf("CreateUserReward: error creating create reward request - %s", err)
 			return err
@@ -0,0 +1,163 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package social
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+
+	"github.com/rokwire/core-auth-library-go/v2/authservice"
+)
+
+// Adapter implements the Social interface
+type Adapter struct {
+	baseURL               string
+	serviceAccountManager *authservice.ServiceAccountManager
+	client                *utils.ResilientClient
+}
+
+// NewSocialAdapter creates a new Social BB adapter instance
+func NewSocialAdapter(baseURL string, serviceAccountManager *authservice.ServiceAccountManager, clientConfig utils.ResilientClientConfig) (*Adapter, error) {
+	if serviceAccountManager == nil {
+		log.Println("service account manager is nil")
+		return nil, errors.New("service account manager is nil")
+	}
+
+	client := utils.NewResilientClient("social", clientConfig)
+	return &Adapter{baseURL: baseURL, serviceAccountManager: serviceAccountManager, client: client}, nil
+}
+
+// ImportReactions pushes a batch of post reactions. The Social BB skips the reactions with already imported idempotency keys. Gives the count of the accepted reactions.
+func (a *Adapter) ImportReactions(reactions []model.SocialReaction, appID string, orgID string) (int64, error) {
+	type importRequest struct {
+		AppID     string                 `json:"app_id"`
+		OrgID     string                 `json:"org_id"`
+		Source    string                 `json:"source"`
+		Reactions []model.SocialReaction `json:"reactions"`
+	}
+	type importResponse struct {
+		Imported int64 `json:"imported"`
+		Skipped  int64 `json:"skipped"`
+	}
+
+	data, err := json.Marshal(importRequest{AppID: appID, OrgID: orgID, Source: "groups", Reactions: reactions})
+	if err != nil {
+		return 0, err
+	}
+
+	url := fmt.Sprintf("%s/api/bbs/reactions/import", a.baseURL)
+	req, err := http.NewRequest("POST", url, bytes.NewReader(data))
+	if err != nil {
+		log.Printf("ImportReactions: error creating request - %s", err)
+		return 0, err
+	}
+	req.Header.Add("Content-Type", "application/json")
+	// the batch key makes the retried batches safe on top of the per reaction keys
+	req.Header.Add("Idempotency-Key", batchIdempotencyKey(reactions))
+
+	resp, err := a.makeRequest(req, appID, orgID)
+	if err != nil {
+		log.Printf("ImportReactions: error sending request - %s", err)
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	dataRes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("ImportReactions: unable to read json: %s", err)
+		return 0, fmt.Errorf("ImportReactions: unable to parse json: %s", err)
+	}
+	if resp.StatusCode != 200 {
+		log.Printf("ImportReactions: error with response code - %d body: %s", resp.StatusCode, dataRes)
+		return 0, fmt.Errorf("ImportReactions: error with response code - %d body: %s", resp.StatusCode, dataRes)
+	}
+
+	var response importResponse
+	err = json.Unmarshal(dataRes, &response)
+	if err != nil {
+		log.Printf("ImportReactions: unable to parse json: %s", err)
+		return 0, fmt.Errorf("ImportReactions: unable to parse json: %s", err)
+	}
+
+	return response.Imported + response.Skipped, nil
+}
+
+// CountGroupReactions gives the count of the post reactions of the group stored in the Social BB
+func (a *Adapter) CountGroupReactions(groupID string, appID string, orgID string) (int64, error) {
+	type countResponse struct {
+		Count int64 `json:"count"`
+	}
+
+	query := url.Values{}
+	query.Set("source", "groups")
+	query.Set("group_id", groupID)
+	requestURL := fmt.Sprintf("%s/api/bbs/reactions/count?%s", a.baseURL, query.Encode())
+	req, err := http.NewRequest("GET", requestURL, nil)
+	if err != nil {
+		log.Printf("CountGroupReactions: error creating request - %s", err)
+		return 0, err
+	}
+
+	resp, err := a.makeRequest(req, appID, orgID)
+	if err != nil {
+		log.Printf("CountGroupReactions: error sending request - %s", err)
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	dataRes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.Printf("CountGroupReactions: unable to read json: %s", err)
+		return 0, fmt.Errorf("CountGroupReactions: unable to parse json: %s", err)
+	}
+	if resp.StatusCode != 200 {
+		log.Printf("CountGroupReactions: error with response code - %d body: %s", resp.StatusCode, dataRes)
+		return 0, fmt.Errorf("CountGroupReactions: error with response code - %d body: %s", resp.StatusCode, dataRes)
+	}
+
+	var response countResponse
+	err = json.Unmarshal(dataRes, &response)
+	if err != nil {
+		log.Printf("CountGroupReactions: unable to parse json: %s", err)
+		return 0, fmt.Errorf("CountGroupReactions: unable to parse json: %s", err)
+	}
+
+	return response.Count, nil
+}
+
+func (a *Adapter) makeRequest(req *http.Request, appID string, orgID string) (*http.Response, error) {
+	return a.client.DoWith(req, func(req *http.Request) (*http.Response, error) {
+		return a.serviceAccountManager.MakeRequest(req, appID, orgID)
+	})
+}
+
+func batchIdempotencyKey(reactions []model.SocialReaction) string {
+	hash := sha256.New()
+	for _, reaction := range reactions {
+		hash.Write([]byte(reaction.IdempotencyKey))
+		hash.Write([]byte{0})
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
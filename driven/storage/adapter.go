@@ -47,6 +47,9 @@ type Adapter struct {
 
 	cachedManagedGroupConfigs *syncmap.Map
 	managedGroupConfigsLock   *sync.RWMutex
+
+	cachedCategoryVisibilityConfigs *syncmap.Map
+	categoryVisibilityConfigsLock   *sync.RWMutex
 }
 
 // Start starts the storage
@@ -60,7 +63,12 @@ func (sa *Adapter) Start() error {
 	sl := storageListener{adapter: sa}
 	sa.RegisterStorageListener(&sl)
 
-	err = sa.cacheSyncConfigs()
+	return nil
+}
+
+// CacheConfigs loads the sync configs, the managed group configs and the category visibility configs into the caches
+func (sa *Adapter) CacheConfigs() error {
+	err := sa.cacheSyncConfigs()
 	if err != nil {
 		return errors.New("error caching sync configs")
 	}
@@ -70,7 +78,12 @@ func (sa *Adapter) Start() error {
 		return errors.New("error caching managed group configs")
 	}
 
-	return err
+	err = sa.cacheCategoryVisibilityConfigs()
+	if err != nil {
+		return errors.New("error caching category visibility configs")
+	}
+
+	return nil
 }
 
 // RegisterStorageListener registers a data change listener with the storage adapter
@@ -179,6 +192,390 @@ func (sa *Adapter) SaveSyncConfig(context TransactionContext, config model.SyncC
 	return nil
 }
 
+// FindPrivacyConfig finds the privacy config for the specified clientID
+func (sa *Adapter) FindPrivacyConfig(context TransactionContext, clientID string) (*model.PrivacyConfig, error) {
+	filter := bson.M{"type": "privacy", "client_id": clientID}
+
+	var configs []model.PrivacyConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SavePrivacyConfig saves the provided privacy config fields
+func (sa *Adapter) SavePrivacyConfig(context TransactionContext, config model.PrivacyConfig) error {
+	filter := bson.M{"type": "privacy", "client_id": config.ClientID}
+
+	config.Type = "privacy"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindLicenseConfig finds the license config for the specified clientID
+func (sa *Adapter) FindLicenseConfig(context TransactionContext, clientID string) (*model.LicenseConfig, error) {
+	filter := bson.M{"type": "license", "client_id": clientID}
+
+	var configs []model.LicenseConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveLicenseConfig saves the provided license config fields
+func (sa *Adapter) SaveLicenseConfig(context TransactionContext, config model.LicenseConfig) error {
+	filter := bson.M{"type": "license", "client_id": config.ClientID}
+
+	config.Type = "license"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindMembershipPruningConfig finds the membership pruning config for the specified clientID
+func (sa *Adapter) FindMembershipPruningConfig(context TransactionContext, clientID string) (*model.MembershipPruningConfig, error) {
+	filter := bson.M{"type": "membership_pruning", "client_id": clientID}
+
+	var configs []model.MembershipPruningConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveMembershipPruningConfig saves the provided membership pruning config fields
+func (sa *Adapter) SaveMembershipPruningConfig(context TransactionContext, config model.MembershipPruningConfig) error {
+	filter := bson.M{"type": "membership_pruning", "client_id": config.ClientID}
+
+	config.Type = "membership_pruning"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindPendingMembershipConfig finds the pending membership config for the specified clientID
+func (sa *Adapter) FindPendingMembershipConfig(context TransactionContext, clientID string) (*model.PendingMembershipConfig, error) {
+	filter := bson.M{"type": "pending_membership", "client_id": clientID}
+
+	var configs []model.PendingMembershipConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SavePendingMembershipConfig saves the provided pending membership config fields
+func (sa *Adapter) SavePendingMembershipConfig(context TransactionContext, config model.PendingMembershipConfig) error {
+	filter := bson.M{"type": "pending_membership", "client_id": config.ClientID}
+
+	config.Type = "pending_membership"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindPostLimitsConfig finds the post limits config for the specified clientID
+func (sa *Adapter) FindPostLimitsConfig(context TransactionContext, clientID string) (*model.PostLimitsConfig, error) {
+	filter := bson.M{"type": "post_limits", "client_id": clientID}
+
+	var configs []model.PostLimitsConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SavePostLimitsConfig saves the provided post limits config fields
+func (sa *Adapter) SavePostLimitsConfig(context TransactionContext, config model.PostLimitsConfig) error {
+	filter := bson.M{"type": "post_limits", "client_id": config.ClientID}
+
+	config.Type = "post_limits"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// cacheCategoryVisibilityConfigs caches the category visibility configs from the DB
+func (sa *Adapter) cacheCategoryVisibilityConfigs() error {
+	log.Println("cacheCategoryVisibilityConfigs..")
+
+	filter := bson.M{"type": "category_visibility"}
+
+	var configs []model.CategoryVisibilityConfig
+	err := sa.db.configs.Find(filter, &configs, nil)
+	if err != nil {
+		return err
+	}
+
+	sa.setCachedCategoryVisibilityConfigs(&configs)
+
+	return nil
+}
+
+func (sa *Adapter) setCachedCategoryVisibilityConfigs(configs *[]model.CategoryVisibilityConfig) {
+	sa.categoryVisibilityConfigsLock.Lock()
+	defer sa.categoryVisibilityConfigsLock.Unlock()
+
+	sa.cachedCategoryVisibilityConfigs = &syncmap.Map{}
+	for _, config := range *configs {
+		sa.cachedCategoryVisibilityConfigs.Store(config.ClientID, config)
+	}
+}
+
+func (sa *Adapter) getCachedCategoryVisibilityConfig(clientID string) (*model.CategoryVisibilityConfig, error) {
+	sa.categoryVisibilityConfigsLock.RLock()
+	defer sa.categoryVisibilityConfigsLock.RUnlock()
+
+	item, _ := sa.cachedCategoryVisibilityConfigs.Load(clientID)
+	if item != nil {
+		config, ok := item.(model.CategoryVisibilityConfig)
+		if !ok {
+			return nil, fmt.Errorf("error casting category visibility config for clientID: %s", clientID)
+		}
+		return &config, nil
+	}
+	return nil, nil
+}
+
+// FindCategoryVisibilityConfig finds the category visibility config for the specified clientID
+func (sa *Adapter) FindCategoryVisibilityConfig(context TransactionContext, clientID string) (*model.CategoryVisibilityConfig, error) {
+	return sa.getCachedCategoryVisibilityConfig(clientID)
+}
+
+// SaveCategoryVisibilityConfig saves the provided category visibility config fields
+func (sa *Adapter) SaveCategoryVisibilityConfig(context TransactionContext, config model.CategoryVisibilityConfig) error {
+	filter := bson.M{"type": "category_visibility", "client_id": config.ClientID}
+
+	config.Type = "category_visibility"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	// the change stream refreshes the caches of the other instances
+	return sa.cacheCategoryVisibilityConfigs()
+}
+
+// FindInternalEventServicesConfig finds the internal event services config for the specified clientID
+func (sa *Adapter) FindInternalEventServicesConfig(context TransactionContext, clientID string) (*model.InternalEventServicesConfig, error) {
+	filter := bson.M{"type": "internal_event_services", "client_id": clientID}
+
+	var configs []model.InternalEventServicesConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveInternalEventServicesConfig saves the provided internal event services config fields
+func (sa *Adapter) SaveInternalEventServicesConfig(context TransactionContext, config model.InternalEventServicesConfig) error {
+	filter := bson.M{"type": "internal_event_services", "client_id": config.ClientID}
+
+	config.Type = "internal_event_services"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindGroupDeletionPolicyConfig finds the group deletion policy config for the specified clientID
+func (sa *Adapter) FindGroupDeletionPolicyConfig(context TransactionContext, clientID string) (*model.GroupDeletionPolicyConfig, error) {
+	filter := bson.M{"type": "group_deletion_policy", "client_id": clientID}
+
+	var configs []model.GroupDeletionPolicyConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveGroupDeletionPolicyConfig saves the provided group deletion policy config fields
+func (sa *Adapter) SaveGroupDeletionPolicyConfig(context TransactionContext, config model.GroupDeletionPolicyConfig) error {
+	filter := bson.M{"type": "group_deletion_policy", "client_id": config.ClientID}
+
+	config.Type = "group_deletion_policy"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindContentFilterConfig finds the content filter config for the specified clientID
+func (sa *Adapter) FindContentFilterConfig(context TransactionContext, clientID string) (*model.ContentFilterConfig, error) {
+	filter := bson.M{"type": "content_filter", "client_id": clientID}
+
+	var configs []model.ContentFilterConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveContentFilterConfig saves the provided content filter config fields
+func (sa *Adapter) SaveContentFilterConfig(context TransactionContext, config model.ContentFilterConfig) error {
+	filter := bson.M{"type": "content_filter", "client_id": config.ClientID}
+
+	config.Type = "content_filter"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindContentRateLimitsConfig finds the content rate limits config for the specified clientID
+func (sa *Adapter) FindContentRateLimitsConfig(context TransactionContext, clientID string) (*model.ContentRateLimitsConfig, error) {
+	filter := bson.M{"type": "content_rate_limits", "client_id": clientID}
+
+	var configs []model.ContentRateLimitsConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveContentRateLimitsConfig saves the provided content rate limits config fields
+func (sa *Adapter) SaveContentRateLimitsConfig(context TransactionContext, config model.ContentRateLimitsConfig) error {
+	filter := bson.M{"type": "content_rate_limits", "client_id": config.ClientID}
+
+	config.Type = "content_rate_limits"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// FindRejectionReasonsConfig finds the rejection reasons config for the specified clientID
+func (sa *Adapter) FindRejectionReasonsConfig(context TransactionContext, clientID string) (*model.RejectionReasonsConfig, error) {
+	filter := bson.M{"type": "rejection_reasons", "client_id": clientID}
+
+	var configs []model.RejectionReasonsConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SaveRejectionReasonsConfig saves the provided rejection reasons config fields
+func (sa *Adapter) SaveRejectionReasonsConfig(context TransactionContext, config model.RejectionReasonsConfig) error {
+	filter := bson.M{"type": "rejection_reasons", "client_id": config.ClientID}
+
+	config.Type = "rejection_reasons"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // FindSyncTimes finds the sync times for the specified clientID
 func (sa *Adapter) FindSyncTimes(context TransactionContext, clientID string, key string, legacy bool) (*model.SyncTimes, error) {
 
@@ -227,6 +624,8 @@ func (sa *Adapter) GetUserPostCount(clientID string, userID string) (*int64, err
 		primitive.M{"$match": primitive.M{
 			"client_id":      clientID,
 			"member.user_id": userID,
+			"date_deleted":   nil,
+			"status":         primitive.M{"$nin": model.UnpublishedPostStatuses},
 		}},
 		primitive.M{"$count": "posts_count"},
 	}
@@ -252,7 +651,7 @@ func (sa *Adapter) DeleteUser(clientID string, userID string) error {
 		}
 		if len(posts) > 0 {
 			for _, post := range posts {
-				err = sa.DeletePost(sessionContext, clientID, userID, post.GroupID, post.ID, true)
+				err = sa.deletePost(sessionContext, clientID, userID, post.GroupID, post.ID, true, true)
 				if err != nil {
 					log.Printf("error on delete all posts for user (%s) - %s", userID, err.Error())
 					return err
@@ -275,28 +674,16 @@ func (sa *Adapter) DeleteUser(clientID string, userID string) error {
 				log.Printf("error deleting user membership - %s", err.Error())
 				return err
 			}
-			deleteMembership := false
-			if len(admins.Items) == 1 {
-				if admins.Items[0].UserID == userID {
-					log.Printf("delete group %s, because, user %s is the only admin", admins.Items[0].GroupID, userID)
-					err := sa.DeleteGroup(sessionContext, clientID, membership.GroupID)
-					if err != nil {
-						log.Printf("error deleting user membership and the whole group - %s", err.Error())
-						return err
-					}
-				} else {
-					deleteMembership = true
-				}
+			// the group of the only admin is kept, it is flagged as needing an admin by the core before the user is deleted
+			if len(admins.Items) == 1 && admins.Items[0].UserID == userID {
+				log.Printf("keep group %s without an admin, because, user %s is the only admin", membership.GroupID, userID)
+				err = sa.DeleteMembershipByID(sessionContext, clientID, nil, membership.ID)
 			} else {
-				deleteMembership = true
+				err = sa.DeleteMembership(sessionContext, clientID, membership.GroupID, membership.UserID)
 			}
-			if deleteMembership {
-				err = sa.DeleteMembershipWithContext(sessionContext, clientID, membership.GroupID, membership.UserID)
-				if err != nil {
-					log.Printf("error deleting user membership - %s", err.Error())
-					// Check the count of admins
-					return err
-				}
+			if err != nil {
+				log.Printf("error deleting user membership - %s", err.Error())
+				return err
 			}
 		}
 
@@ -323,6 +710,9 @@ func (sa *Adapter) CreateGroup(context TransactionContext, clientID string, curr
 		if group.Category != "" && group.GetNewCategory() == nil {
 			group.SetNewCategory(group.Category)
 		}
+		if group.Categories == nil {
+			group.SyncCategories(nil)
+		}
 		if len(group.Tags) > 0 && group.GetNewTags() == nil {
 			group.SetNewTags(group.Tags)
 		}
@@ -334,6 +724,10 @@ func (sa *Adapter) CreateGroup(context TransactionContext, clientID string, curr
 		group.ID = insertedID
 		group.ClientID = clientID
 		group.DateCreated = now
+		group.CreatorID = ""
+		if current != nil {
+			group.CreatorID = current.ID
+		}
 		if group.Settings == nil {
 			settings := model.DefaultGroupSettings()
 			group.Settings = &settings
@@ -358,7 +752,8 @@ func (sa *Adapter) CreateGroup(context TransactionContext, clientID string, curr
 				castedMemberships = append(castedMemberships, membership)
 			}
 		}
-		if current != nil && !contaignCurrentUser {
+		// the service accounts creating groups through the BBs APIs are not members
+		if current != nil && !current.IsBBUser && !contaignCurrentUser {
 			castedMemberships = append(castedMemberships, model.GroupMembership{
 				ID:          uuid.NewString(),
 				GroupID:     insertedID,
@@ -433,9 +828,11 @@ func (sa *Adapter) updateGroup(context TransactionContext, clientID string, curr
 			primitive.E{Key: "image_url", Value: group.ImageURL},
 			primitive.E{Key: "web_url", Value: group.WebURL},
 			primitive.E{Key: "membership_questions", Value: group.MembershipQuestions},
+			primitive.E{Key: "membership_questions_schema", Value: group.MembershipQuestionsSchema},
 			primitive.E{Key: "date_updated", Value: time.Now()},
 			primitive.E{Key: "authman_enabled", Value: group.AuthmanEnabled},
 			primitive.E{Key: "authman_group", Value: group.AuthmanGroup},
+			primitive.E{Key: "authman_groups", Value: group.AuthmanGroups},
 			primitive.E{Key: "only_admins_can_create_polls", Value: group.OnlyAdminsCanCreatePolls},
 			primitive.E{Key: "can_join_automatically", Value: group.CanJoinAutomatically},
 			primitive.E{Key: "block_new_membership_requests", Value: group.BlockNewMembershipRequests},
@@ -447,9 +844,19 @@ func (sa *Adapter) updateGroup(context TransactionContext, clientID string, curr
 			primitive.E{Key: "research_description", Value: group.ResearchDescription},
 			primitive.E{Key: "research_profile", Value: group.ResearchProfile},
 		}
+		if group.Location != nil {
+			setOperation = append(setOperation, primitive.E{Key: "location", Value: group.Location})
+		}
 		if group.Settings != nil {
 			setOperation = append(setOperation, primitive.E{Key: "settings", Value: group.Settings})
 		}
+		if group.Localizations != nil {
+			setOperation = append(setOperation, primitive.E{Key: "localizations", Value: group.Localizations})
+		}
+		if group.Categories != nil {
+			setOperation = append(setOperation, primitive.E{Key: "categories", Value: group.Categories},
+				primitive.E{Key: "category", Value: group.Category})
+		}
 
 		//
 		// Handle category and tags backward compatibility and legacy clients 355355
@@ -458,7 +865,7 @@ func (sa *Adapter) updateGroup(context TransactionContext, clientID string, curr
 			setOperation = append(setOperation, primitive.E{Key: "attributes", Value: group.Attributes})
 
 			category := group.GetNewCategory()
-			if category != nil {
+			if category != nil && group.Categories == nil {
 				setOperation = append(setOperation, primitive.E{Key: "category", Value: *category})
 			}
 
@@ -483,7 +890,9 @@ func (sa *Adapter) updateGroup(context TransactionContext, clientID string, curr
 
 			if group.Category != "" {
 				group.SetNewCategory(group.Category)
-				setOperation = append(setOperation, primitive.E{Key: "category", Value: group.Category})
+				if group.Categories == nil {
+					setOperation = append(setOperation, primitive.E{Key: "category", Value: group.Category})
+				}
 			}
 			if len(group.Tags) > 0 {
 				group.SetNewTags(group.Tags)
@@ -498,6 +907,10 @@ func (sa *Adapter) updateGroup(context TransactionContext, clientID string, curr
 		updateOperation := bson.D{
 			primitive.E{Key: "$set", Value: setOperation},
 		}
+		if group.Location == nil {
+			// the 2dsphere index does not accept null locations
+			updateOperation = append(updateOperation, primitive.E{Key: "$unset", Value: bson.M{"location": ""}})
+		}
 
 		_, err := sa.db.groups.UpdateOneWithContext(
 			context,
@@ -580,10 +993,13 @@ func (sa *Adapter) DeleteGroup(ctx TransactionContext, clientID string, id strin
 
 	wrapper := func(context TransactionContext) error {
 
-		// 1. delete mapped group events
-		_, err := sa.db.events.DeleteManyWithContext(context, bson.D{
+		// 1. archive mapped group events
+		_, err := sa.db.events.UpdateManyWithContext(context, bson.D{
 			primitive.E{Key: "group_id", Value: id},
 			primitive.E{Key: "client_id", Value: clientID},
+			primitive.E{Key: "date_archived", Value: primitive.Null{}},
+		}, bson.D{
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_archived", Value: time.Now().UTC()}}},
 		}, nil)
 		if err != nil {
 			return err
@@ -598,7 +1014,52 @@ func (sa *Adapter) DeleteGroup(ctx TransactionContext, clientID string, id strin
 			return err
 		}
 
-		// 3. delete mapped group memberships
+		// 2.1. delete the revisions of the group posts
+		_, err = sa.db.postRevisions.DeleteManyWithContext(context, bson.D{
+			primitive.E{Key: "group_id", Value: id},
+			primitive.E{Key: "client_id", Value: clientID},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// 2.2. delete the read cursors of the group
+		_, err = sa.db.groupReadCursors.DeleteManyWithContext(context, bson.D{
+			primitive.E{Key: "group_id", Value: id},
+			primitive.E{Key: "client_id", Value: clientID},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// 2.3. delete the bookmarks of the group posts
+		_, err = sa.db.postBookmarks.DeleteManyWithContext(context, bson.D{
+			primitive.E{Key: "group_id", Value: id},
+			primitive.E{Key: "client_id", Value: clientID},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// 2.4. delete the bans of the group
+		_, err = sa.db.groupBans.DeleteManyWithContext(context, bson.D{
+			primitive.E{Key: "group_id", Value: id},
+			primitive.E{Key: "client_id", Value: clientID},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// 3. delete the group chat messages
+		_, err = sa.db.groupMessages.DeleteManyWithContext(context, bson.D{
+			primitive.E{Key: "group_id", Value: id},
+			primitive.E{Key: "client_id", Value: clientID},
+		}, nil)
+		if err != nil {
+			return err
+		}
+
+		// 4. delete mapped group memberships
 		_, err = sa.db.groupMemberships.DeleteManyWithContext(context, bson.D{
 			primitive.E{Key: "group_id", Value: id},
 			primitive.E{Key: "client_id", Value: clientID},
@@ -607,7 +1068,7 @@ func (sa *Adapter) DeleteGroup(ctx TransactionContext, clientID string, id strin
 			return err
 		}
 
-		// 4. delete the group
+		// 5. delete the group
 		_, err = sa.db.groups.DeleteOneWithContext(context, bson.D{
 			primitive.E{Key: "_id", Value: id},
 			primitive.E{Key: "client_id", Value: clientID},
@@ -628,7 +1089,8 @@ func (sa *Adapter) DeleteGroup(ctx TransactionContext, clientID string, id strin
 // FindGroup finds group by id and client id
 func (sa *Adapter) FindGroup(context TransactionContext, clientID string, groupID string, userID *string) (*model.Group, error) {
 	filter := bson.D{primitive.E{Key: "_id", Value: groupID},
-		primitive.E{Key: "client_id", Value: clientID}}
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery}}
 
 	var err error
 	var membership *model.GroupMembership
@@ -653,6 +1115,7 @@ func (sa *Adapter) FindGroupByTitle(clientID string, title string) (*model.Group
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "title", Value: title},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
 	}
 	var result []model.Group
 	err := sa.db.groups.Find(filter, &result, nil)
@@ -671,6 +1134,65 @@ func (sa *Adapter) FindGroupByTitle(clientID string, title string) (*model.Group
 func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter model.GroupsFilter) ([]model.Group, error) {
 	// TODO: Merge the filter logic in a common method (FindGroups, FindGroupsV3, FindUserGroups)
 
+	filter, memberships, err := sa.findGroupsQuery(clientID, userID, groupsFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	findOptions := options.Find()
+	if groupsFilter.Cursor != nil {
+		direction := 1
+		if groupsFilter.Order != nil && "desc" == *groupsFilter.Order {
+			direction = -1
+		}
+		findOptions.SetSort(groupsCursorSort(direction))
+		cursorQuery, err := groupsCursorQuery(*groupsFilter.Cursor, direction)
+		if err != nil {
+			return nil, err
+		}
+		if cursorQuery != nil {
+			filter = append(filter, *cursorQuery)
+		}
+	} else {
+		if groupsFilter.Order != nil && "desc" == *groupsFilter.Order {
+			findOptions.SetSort(bson.D{
+				{Key: "title", Value: -1},
+			})
+		} else {
+			findOptions.SetSort(bson.D{
+				{Key: "title", Value: 1},
+			})
+		}
+		if groupsFilter.Offset != nil {
+			findOptions.SetSkip(*groupsFilter.Offset)
+		}
+	}
+	if groupsFilter.Limit != nil {
+		findOptions.SetLimit(*groupsFilter.Limit)
+	}
+
+	var list []model.Group
+	err = sa.db.groups.Find(filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	if userID != nil {
+		for index, group := range list {
+			group.CurrentMember = memberships.GetMembershipBy(func(membership model.GroupMembership) bool {
+				return membership.GroupID == group.ID
+			})
+			if group.CurrentMember != nil {
+				list[index] = group
+			}
+		}
+	}
+
+	return list, nil
+}
+
+// findGroupsQuery builds the query of the groups visible to the user. It gives the memberships of the user too.
+func (sa *Adapter) findGroupsQuery(clientID string, userID *string, groupsFilter model.GroupsFilter) (bson.D, model.MembershipCollection, error) {
 	var err error
 	groupIDs := []string{}
 	var memberships model.MembershipCollection
@@ -678,7 +1200,7 @@ func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter mode
 		// find group memberships
 		memberships, err = sa.FindUserGroupMemberships(clientID, *userID)
 		if err != nil {
-			return nil, err
+			return nil, memberships, err
 		}
 
 		for _, membership := range memberships.Items {
@@ -686,7 +1208,7 @@ func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter mode
 		}
 	}
 
-	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery}}
 	if groupsFilter.GroupIDs != nil {
 		filter = append(filter, bson.E{Key: "_id", Value: bson.M{"$in": groupsFilter.GroupIDs}})
 	}
@@ -737,8 +1259,14 @@ func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter mode
 	if groupsFilter.AuthmanEnabled != nil {
 		filter = append(filter, primitive.E{Key: "authman_enabled", Value: groupsFilter.AuthmanEnabled})
 	}
-	if groupsFilter.Category != nil {
-		filter = append(filter, primitive.E{Key: "category", Value: groupsFilter.Category})
+	if category := groupCategoryQuery(groupsFilter); category != nil {
+		filter = append(filter, primitive.E{Key: "categories", Value: category})
+	}
+	if groupsFilter.OrgUnitCode != nil {
+		filter = append(filter, primitive.E{Key: "org_unit.path", Value: *groupsFilter.OrgUnitCode})
+	}
+	if groupsFilter.CreatorID != nil {
+		filter = append(filter, primitive.E{Key: "creator_id", Value: *groupsFilter.CreatorID})
 	}
 	if len(groupsFilter.Tags) > 0 {
 		filter = append(filter, primitive.E{Key: "tags", Value: bson.M{"$in": groupsFilter.Tags}})
@@ -776,6 +1304,10 @@ func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter mode
 		}
 	}
 
+	if groupsFilter.Near.IsValid() {
+		filter = append(filter, primitive.E{Key: "location", Value: geoWithinQuery(groupsFilter.Near)})
+	}
+
 	if groupsFilter.Attributes != nil {
 		attributeFilters := []bson.M{}
 		for key, value := range groupsFilter.Attributes {
@@ -797,41 +1329,16 @@ func (sa *Adapter) FindGroups(clientID string, userID *string, groupsFilter mode
 		}
 	}
 
-	findOptions := options.Find()
-	if groupsFilter.Order != nil && "desc" == *groupsFilter.Order {
-		findOptions.SetSort(bson.D{
-			{Key: "title", Value: -1},
-		})
-	} else {
-		findOptions.SetSort(bson.D{
-			{Key: "title", Value: 1},
-		})
-	}
-	if groupsFilter.Limit != nil {
-		findOptions.SetLimit(*groupsFilter.Limit)
-	}
-	if groupsFilter.Offset != nil {
-		findOptions.SetSkip(*groupsFilter.Offset)
-	}
+	return filter, memberships, nil
+}
 
-	var list []model.Group
-	err = sa.db.groups.Find(filter, &list, findOptions)
+// CountGroups counts the groups matching the filter regardless the limit and the offset
+func (sa *Adapter) CountGroups(clientID string, userID *string, groupsFilter model.GroupsFilter) (int64, error) {
+	filter, _, err := sa.findGroupsQuery(clientID, userID, groupsFilter)
 	if err != nil {
-		return nil, err
-	}
-
-	if userID != nil {
-		for index, group := range list {
-			group.CurrentMember = memberships.GetMembershipBy(func(membership model.GroupMembership) bool {
-				return membership.GroupID == group.ID
-			})
-			if group.CurrentMember != nil {
-				list[index] = group
-			}
-		}
+		return 0, err
 	}
-
-	return list, nil
+	return sa.db.groups.CountDocuments(filter)
 }
 
 // FindGroupByID finds one groups by ID and clientID
@@ -839,6 +1346,7 @@ func (sa *Adapter) FindGroupByID(clientID string, groupID string) (*model.Group,
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "_id", Value: groupID},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
 	}
 
 	findOptions := options.FindOne()
@@ -891,12 +1399,16 @@ func (sa *Adapter) FindUserGroups(clientID string, userID string, groupsFilter m
 	}
 
 	mongoFilter := bson.M{
-		"_id":       bson.M{"$in": groupIDs},
-		"client_id": clientID,
+		"_id":          bson.M{"$in": groupIDs},
+		"client_id":    clientID,
+		"date_deleted": notDeletedGroupQuery,
 	}
 
-	if groupsFilter.Category != nil {
-		mongoFilter["category"] = *groupsFilter.Category
+	if category := groupCategoryQuery(groupsFilter); category != nil {
+		mongoFilter["categories"] = category
+	}
+	if groupsFilter.OrgUnitCode != nil {
+		mongoFilter["org_unit.path"] = *groupsFilter.OrgUnitCode
 	}
 	if groupsFilter.Title != nil {
 		mongoFilter["title"] = primitive.Regex{Pattern: *groupsFilter.Title, Options: "i"}
@@ -904,6 +1416,9 @@ func (sa *Adapter) FindUserGroups(clientID string, userID string, groupsFilter m
 	if groupsFilter.Privacy != nil {
 		mongoFilter["privacy"] = groupsFilter.Privacy
 	}
+	if groupsFilter.Near.IsValid() {
+		mongoFilter["location"] = geoWithinQuery(groupsFilter.Near)
+	}
 	if groupsFilter.ResearchOpen != nil {
 		if *groupsFilter.ResearchOpen {
 			mongoFilter["research_open"] = true
@@ -984,14 +1499,15 @@ func (sa *Adapter) FindUserGroups(clientID string, userID string, groupsFilter m
 	return list, nil
 }
 
-// FindEvents finds the events for a group
-func (sa *Adapter) FindEvents(clientID string, current *model.User, groupID string, filterByToMembers bool) ([]model.Event, error) {
+// findEventsQuery builds the query of the group events visible to the user
+func findEventsQuery(clientID string, current *model.User, groupID string, filterByToMembers bool, eventsFilter *model.EventsFilter) bson.D {
 	filter := bson.D{
 		primitive.E{Key: "group_id", Value: groupID},
 		primitive.E{Key: "client_id", Value: clientID},
 	}
+	var conditions []primitive.M
 	if filterByToMembers && current != nil {
-		filter = append(filter, primitive.E{Key: "$or", Value: []primitive.M{
+		conditions = append(conditions, primitive.M{"$or": []primitive.M{
 			{"to_members": primitive.Null{}},
 			{"to_members": primitive.M{"$exists": true, "$size": 0}},
 			{"to_members.user_id": current.ID},
@@ -999,9 +1515,78 @@ func (sa *Adapter) FindEvents(clientID string, current *model.User, groupID stri
 		}})
 	}
 
+	if eventsFilter != nil {
+		dateRange := primitive.M{}
+		if eventsFilter.StartDate != nil {
+			dateRange["$gte"] = *eventsFilter.StartDate
+		}
+		if eventsFilter.EndDate != nil {
+			dateRange["$lte"] = *eventsFilter.EndDate
+		}
+		if len(dateRange) > 0 {
+			// the mappings created before the start dates were stored fall back to their creation date
+			conditions = append(conditions, primitive.M{"$or": []primitive.M{
+				{"date_start": dateRange},
+				{"date_start": primitive.Null{}, "date_created": dateRange},
+			}})
+		}
+		if eventsFilter.UpcomingOnly {
+			now := time.Now().UTC()
+			conditions = append(conditions, primitive.M{"$or": []primitive.M{
+				{"date_end": primitive.M{"$gte": now}},
+				{"date_end": primitive.Null{}, "date_start": primitive.M{"$gte": now}},
+			}})
+		}
+	}
+	if len(conditions) > 0 {
+		filter = append(filter, primitive.E{Key: "$and", Value: conditions})
+	}
+
+	return filter
+}
+
+// CountEvents counts the group events matching the filter regardless the limit and the offset
+func (sa *Adapter) CountEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, eventsFilter *model.EventsFilter) (int64, error) {
+	filter := findEventsQuery(clientID, current, groupID, filterByToMembers, eventsFilter)
+	return sa.db.events.CountDocuments(filter)
+}
+
+// FindEvents finds the events for a group
+func (sa *Adapter) FindEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, eventsFilter *model.EventsFilter) ([]model.Event, error) {
+	filter := findEventsQuery(clientID, current, groupID, filterByToMembers, eventsFilter)
+
+	if eventsFilter != nil {
+		return sa.findEventsPageFeaturedFirst(filter, eventsFilter)
+	}
+
 	var result []model.Event
 	err := sa.db.events.Find(filter, &result, nil)
-	return result, err
+	if err != nil {
+		return nil, err
+	}
+	model.SortEventsFeaturedFirst(result, time.Now().UTC())
+	return result, nil
+}
+
+// UpdateEventDates Updates the start and the end of the calendar event stored with its group mapping. The scheduled reminder is sent again
+// if the event is moved to another start.
+func (sa *Adapter) UpdateEventDates(context TransactionContext, clientID string, groupID string, eventID string, dateStart *time.Time, dateEnd *time.Time) error {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: eventID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	err := sa.resetEventReminder(context, filter, "date_start", dateStart)
+	if err != nil {
+		return err
+	}
+
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "date_start", Value: dateStart},
+		primitive.E{Key: "date_end", Value: dateEnd},
+	}}}
+	_, err = sa.db.events.UpdateOneWithContext(context, filter, update, nil)
+	return err
 }
 
 // CreateEvent creates a group event
@@ -1079,6 +1664,87 @@ func (sa *Adapter) DeleteEvent(clientID string, eventID string, groupID string)
 	})
 }
 
+// findPostsQuery builds the query of the group posts visible to the user
+func findPostsQuery(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) bson.D {
+	var userID *string
+	if current != nil {
+		userID = &current.ID
+	}
+
+	mongoFilter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: filter.GroupID},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+
+	if filter.PostType != nil {
+		if *filter.PostType == "message" {
+			mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
+				{"$and": []bson.M{
+					{"to_members": bson.M{"$ne": primitive.Null{}}},
+					{"parent_id": primitive.Null{}},
+				}},
+				{"parent_id": bson.M{"$ne": primitive.Null{}}},
+			}})
+		} else if *filter.PostType == "post" {
+			mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
+				{"$and": []bson.M{
+					{"to_members": primitive.Null{}},
+					{"parent_id": primitive.Null{}},
+				}},
+				{"parent_id": bson.M{"$ne": primitive.Null{}}},
+			}})
+		}
+	}
+
+	if filter.ScheduledOnly != nil && *filter.ScheduledOnly {
+		mongoFilter = append(mongoFilter, bson.E{Key: "date_scheduled", Value: bson.M{"$gt": time.Now()}})
+	} else {
+		mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
+			{"date_scheduled": nil},
+			{"date_scheduled": bson.M{"$lt": time.Now()}},
+		}})
+	}
+
+	if filter.PinnedOnly != nil && *filter.PinnedOnly {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "pinned", Value: true})
+	}
+	if filter.Since != nil {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "date_created", Value: bson.M{"$gte": *filter.Since}})
+	}
+
+	if filterByToMembers {
+		innerFilter := []primitive.M{
+			{"to_members": primitive.Null{}},
+			{"to_members": primitive.M{"$exists": true, "$size": 0}},
+		}
+		if current != nil {
+			innerFilter = append(innerFilter, []primitive.M{
+				{"to_members.user_id": current.ID},
+				{"member.user_id": current.ID},
+			}...)
+		}
+		mongoFilter = append(mongoFilter, primitive.E{Key: "$or", Value: innerFilter})
+	}
+
+	if filterPrivatePostsValue != nil {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "private", Value: *filterPrivatePostsValue})
+	}
+
+	// the unpublished posts are visible only to their creators
+	mongoFilter = append(mongoFilter, primitive.E{Key: "$and", Value: []bson.M{unpublishedPostsVisibilityFilter(userID)}})
+
+	return mongoFilter
+}
+
+// CountPosts counts the top level posts matching the filter regardless the limit and the offset. The replies are not counted as they
+// are given within their top level posts.
+func (sa *Adapter) CountPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) (int64, error) {
+	mongoFilter := findPostsQuery(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
+	mongoFilter = append(mongoFilter, primitive.E{Key: "parent_id", Value: nil})
+	return sa.db.posts.CountDocuments(mongoFilter)
+}
+
 // FindPosts Retrieves posts for a group
 func (sa *Adapter) FindPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) ([]model.Post, error) {
 
@@ -1101,65 +1767,20 @@ func (sa *Adapter) FindPosts(clientID string, current *model.User, filter model.
 			return errGr
 		}
 
-		mongoFilter := bson.D{
-			primitive.E{Key: "client_id", Value: clientID},
-			primitive.E{Key: "group_id", Value: filter.GroupID},
-		}
-
-		if filter.PostType != nil {
-			if *filter.PostType == "message" {
-				mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
-					{"$and": []bson.M{
-						{"to_members": bson.M{"$ne": primitive.Null{}}},
-						{"parent_id": primitive.Null{}},
-					}},
-					{"parent_id": bson.M{"$ne": primitive.Null{}}},
-				}})
-			} else if *filter.PostType == "post" {
-				mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
-					{"$and": []bson.M{
-						{"to_members": primitive.Null{}},
-						{"parent_id": primitive.Null{}},
-					}},
-					{"parent_id": bson.M{"$ne": primitive.Null{}}},
-				}})
-			}
-		}
-
-		if filter.ScheduledOnly != nil && *filter.ScheduledOnly {
-			mongoFilter = append(mongoFilter, bson.E{Key: "date_scheduled", Value: bson.M{"$gt": time.Now()}})
-		} else {
-			mongoFilter = append(mongoFilter, bson.E{Key: "$or", Value: []bson.M{
-				{"date_scheduled": nil},
-				{"date_scheduled": bson.M{"$lt": time.Now()}},
-			}})
-		}
-
-		if filterByToMembers {
-			innerFilter := []primitive.M{
-				{"to_members": primitive.Null{}},
-				{"to_members": primitive.M{"$exists": true, "$size": 0}},
-			}
-			if current != nil {
-				innerFilter = append(innerFilter, []primitive.M{
-					{"to_members.user_id": current.ID},
-					{"member.user_id": current.ID},
-				}...)
-			}
-			mongoFilter = append(mongoFilter, primitive.E{Key: "$or", Value: innerFilter})
-		}
-
-		if filterPrivatePostsValue != nil {
-			mongoFilter = append(mongoFilter, primitive.E{Key: "private", Value: *filterPrivatePostsValue})
-		}
+		mongoFilter := findPostsQuery(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
 
 		paging := false
 		findOptions := options.Find()
+		// the pinned posts are first regardless the order, so they are on the first page
+		dateCreatedOrder := 1
 		if filter.Order != nil && "desc" == *filter.Order {
-			findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
-		} else {
-			findOptions.SetSort(bson.D{{Key: "date_created", Value: 1}})
+			dateCreatedOrder = -1
 		}
+		findOptions.SetSort(bson.D{
+			{Key: "pinned", Value: -1},
+			{Key: "date_pinned", Value: -1},
+			{Key: "date_created", Value: dateCreatedOrder},
+		})
 		if filter.Limit != nil {
 			findOptions.SetLimit(*filter.Limit)
 			paging = true
@@ -1282,6 +1903,7 @@ func (sa *Adapter) findPostWithContext(context TransactionContext, clientID stri
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "_id", Value: postID},
+		primitive.E{Key: "date_deleted", Value: nil},
 	}
 
 	if filterByToMembers {
@@ -1298,6 +1920,11 @@ func (sa *Adapter) findPostWithContext(context TransactionContext, clientID stri
 		filter = append(filter, primitive.E{Key: "$or", Value: innerFilter})
 	}
 
+	// the unpublished posts are visible only to their creators, the system (nil user) sees all posts
+	if userID != nil {
+		filter = append(filter, primitive.E{Key: "$and", Value: []bson.M{unpublishedPostsVisibilityFilter(userID)}})
+	}
+
 	if !skipMembershipCheck && userID != nil {
 		membership, err := sa.FindGroupMembership(clientID, groupID, *userID)
 		if membership == nil || err != nil || !membership.IsAdminOrMember() {
@@ -1316,7 +1943,7 @@ func (sa *Adapter) findPostWithContext(context TransactionContext, clientID stri
 
 // FindTopPostByParentID Finds the top post by parent id
 func (sa *Adapter) FindTopPostByParentID(clientID string, current *model.User, groupID string, parentID string, skipMembershipCheck bool) (*model.Post, error) {
-	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "_id", Value: parentID}}
+	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "_id", Value: parentID}, primitive.E{Key: "date_deleted", Value: nil}}
 
 	if !skipMembershipCheck {
 		membership, err := sa.FindGroupMembership(clientID, groupID, current.ID)
@@ -1345,6 +1972,7 @@ func (sa *Adapter) FindPostsByParentID(ctx TransactionContext, clientID string,
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "parent_id", Value: parentID},
+		primitive.E{Key: "date_deleted", Value: nil},
 	}
 
 	if !skipMembershipCheck && userID != nil {
@@ -1388,7 +2016,7 @@ func (sa *Adapter) FindPostsByParentID(ctx TransactionContext, clientID string,
 func (sa *Adapter) FindPostsByTopParentID(context TransactionContext, clientID string, current *model.User, groupID string, topParentID string, skipMembershipCheck bool, order *string) ([]model.Post, error) {
 	var posts []model.Post
 	wrapper := func(ctx TransactionContext) error {
-		filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "top_parent_id", Value: topParentID}}
+		filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "top_parent_id", Value: topParentID}, primitive.E{Key: "date_deleted", Value: nil}}
 
 		if !skipMembershipCheck {
 			membership, err := sa.FindGroupMembershipWithContext(ctx, clientID, groupID, current.ID)
@@ -1425,8 +2053,8 @@ func (sa *Adapter) FindPostsByTopParentID(context TransactionContext, clientID s
 	return posts, nil
 }
 
-// CreatePost Created a post
-func (sa *Adapter) CreatePost(clientID string, current *model.User, post *model.Post) (*model.Post, error) {
+// CreatePost Created a post. A new transaction is started if no context is provided.
+func (sa *Adapter) CreatePost(context TransactionContext, clientID string, current *model.User, post *model.Post) (*model.Post, error) {
 
 	if current != nil && post != nil {
 		membership, err := sa.FindGroupMembership(clientID, post.GroupID, current.ID)
@@ -1466,19 +2094,29 @@ func (sa *Adapter) CreatePost(clientID string, current *model.User, post *model.
 			Name:   current.Name,
 		}
 
-		err = sa.PerformTransaction(func(context TransactionContext) error {
+		wrapperFunc := func(context TransactionContext) error {
 			_, err := sa.db.posts.InsertOneWithContext(context, post)
 			if err != nil {
 				return err
 			}
 
+			// the unpublished posts are not visible to the members, so the group is not updated
+			if !post.IsPublished() {
+				return nil
+			}
+
 			err = sa.UpdateGroupStats(context, clientID, post.GroupID, true, false, false, false)
 			if err != nil {
 				return err
 			}
 
 			return nil
-		})
+		}
+		if context != nil {
+			err = wrapperFunc(context)
+		} else {
+			err = sa.PerformTransaction(wrapperFunc)
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -1516,19 +2154,31 @@ func (sa *Adapter) UpdatePost(clientID string, userID string, post *model.Post)
 
 		filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "_id", Value: post.ID}}
 
+		setFields := bson.D{
+			primitive.E{Key: "subject", Value: post.Subject},
+			primitive.E{Key: "body", Value: post.Body},
+			primitive.E{Key: "private", Value: post.Private},
+			primitive.E{Key: "use_as_notification", Value: post.UseAsNotification},
+			primitive.E{Key: "is_abuse", Value: post.IsAbuse},
+			primitive.E{Key: "image_url", Value: post.ImageURL},
+			primitive.E{Key: "date_updated", Value: post.DateUpdated},
+			primitive.E{Key: "date_scheduled", Value: post.DateScheduled},
+			primitive.E{Key: "to_members", Value: post.ToMembersList},
+			primitive.E{Key: "content_flags", Value: post.ContentFlags},
+		}
+
+		// the previous content is kept as a revision whenever the subject, the body or the image of a published post changes
+		var revision *model.PostRevision
+		if originalPost.IsPublished() && post.ContentDiffers(originalPost) {
+			revision = &model.PostRevision{ID: uuid.NewString(), ClientID: clientID, GroupID: originalPost.GroupID, PostID: post.ID, UserID: userID,
+				Subject: originalPost.Subject, Body: originalPost.Body, ImageURL: originalPost.ImageURL, DateCreated: now}
+			post.Edited = true
+			post.DateEdited = &now
+			setFields = append(setFields, primitive.E{Key: "edited", Value: true}, primitive.E{Key: "date_edited", Value: now})
+		}
+
 		update := bson.D{
-			primitive.E{Key: "$set", Value: bson.D{
-				primitive.E{Key: "subject", Value: post.Subject},
-				primitive.E{Key: "body", Value: post.Body},
-				primitive.E{Key: "private", Value: post.Private},
-				primitive.E{Key: "use_as_notification", Value: post.UseAsNotification},
-				primitive.E{Key: "is_abuse", Value: post.IsAbuse},
-				primitive.E{Key: "image_url", Value: post.ImageURL},
-				primitive.E{Key: "date_updated", Value: post.DateUpdated},
-				primitive.E{Key: "date_scheduled", Value: post.DateScheduled},
-				primitive.E{Key: "to_members", Value: post.ToMembersList},
-			},
-			},
+			primitive.E{Key: "$set", Value: setFields},
 		}
 
 		err := sa.PerformTransaction(func(context TransactionContext) error {
@@ -1537,6 +2187,13 @@ func (sa *Adapter) UpdatePost(clientID string, userID string, post *model.Post)
 				return err
 			}
 
+			if revision != nil {
+				_, err = sa.db.postRevisions.InsertOneWithContext(context, revision)
+				if err != nil {
+					return err
+				}
+			}
+
 			return sa.UpdateGroupStats(context, clientID, post.GroupID, true, false, false, false)
 		})
 		if err != nil {
@@ -1614,8 +2271,12 @@ func (sa *Adapter) ReactToPost(context TransactionContext, userID string, postID
 	return nil
 }
 
-// DeletePost Deletes a post
+// DeletePost Soft deletes a post together with its replies. The deleted posts can be restored by the admins.
 func (sa *Adapter) DeletePost(ctx TransactionContext, clientID string, userID string, groupID string, postID string, force bool) error {
+	return sa.deletePost(ctx, clientID, userID, groupID, postID, force, false)
+}
+
+func (sa *Adapter) deletePost(ctx TransactionContext, clientID string, userID string, groupID string, postID string, force bool, hard bool) error {
 
 	deleteWrapper := func(transactionContext TransactionContext) error {
 		membership, _ := sa.FindGroupMembershipWithContext(transactionContext, clientID, groupID, userID)
@@ -1635,67 +2296,203 @@ func (sa *Adapter) DeletePost(ctx TransactionContext, clientID string, userID st
 			}
 		}
 
-		var recursiveDelete func(post model.Post) error
-		recursiveDelete = func(post model.Post) error {
-			if post.Replies != nil && len(post.Replies) > 0 {
-				for _, innerPost := range post.Replies {
-					err := recursiveDelete(innerPost)
-					if err != nil {
-						return err
-					}
+		// FindPost loads the whole reply tree
+		postIDs := []string{postID}
+		var collectReplies func(replies []model.Post)
+		collectReplies = func(replies []model.Post) {
+			for _, reply := range replies {
+				postIDs = append(postIDs, reply.ID)
+				collectReplies(reply.Replies)
+			}
+		}
+		collectReplies(originalPost.Replies)
 
-					err = sa.DeletePost(transactionContext, clientID, userID, groupID, post.ID, true)
-					if err != nil {
-						return err
-					}
-				}
+		filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "_id", Value: bson.M{"$in": postIDs}}}
+
+		if hard {
+			_, err := sa.db.posts.DeleteManyWithContext(transactionContext, filter, nil)
+			if err != nil {
+				return err
 			}
-			return nil
+			_, err = sa.db.postRevisions.DeleteManyWithContext(transactionContext,
+				bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "post_id", Value: bson.M{"$in": postIDs}}}, nil)
+			if err != nil {
+				return err
+			}
+		} else {
+			// the deletion id is the id of the deleted root post, so the restore brings back only the posts removed together
+			update := bson.D{
+				primitive.E{Key: "$set", Value: bson.D{
+					primitive.E{Key: "date_deleted", Value: time.Now().UTC()},
+					primitive.E{Key: "deleted_by", Value: userID},
+					primitive.E{Key: "deletion_id", Value: postID},
+				}},
+			}
+			_, err := sa.db.posts.UpdateManyWithContext(transactionContext, filter, update, nil)
+			if err != nil {
+				return err
+			}
+		}
+
+		return sa.UpdateGroupStats(transactionContext, clientID, groupID, true, false, false, false)
+	}
+
+	if ctx != nil {
+		return deleteWrapper(ctx)
+	}
+	return sa.PerformTransaction(func(transactionContext TransactionContext) error {
+		return deleteWrapper(transactionContext)
+	})
+}
+
+// RestorePost Restores a soft deleted post together with the replies which have been deleted with it. Gives the number of restored posts.
+func (sa *Adapter) RestorePost(ctx TransactionContext, clientID string, groupID string, postID string) (int64, error) {
+	var restored int64
+	restoreWrapper := func(transactionContext TransactionContext) error {
+		filter := bson.D{
+			primitive.E{Key: "client_id", Value: clientID},
+			primitive.E{Key: "group_id", Value: groupID},
+			primitive.E{Key: "_id", Value: postID},
+			primitive.E{Key: "date_deleted", Value: bson.M{"$ne": nil}},
+		}
+
+		var post *model.Post
+		err := sa.db.posts.FindOneWithContext(transactionContext, filter, &post, nil)
+		if err == mongo.ErrNoDocuments || (err == nil && post == nil) {
+			return fmt.Errorf("unable to find deleted post with id (%s)", postID)
 		}
-		childPosts, err := sa.FindPostsByParentID(transactionContext, clientID, &userID, groupID, postID, true, false, false, nil)
 		if err != nil {
 			return err
 		}
-		for _, childchildPost := range childPosts {
-			recursiveDelete(childchildPost)
-		}
 
-		filter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "_id", Value: postID}}
+		// the parent must be restored first, otherwise the restored post would be orphaned
+		if post.ParentID != nil {
+			parent, err := sa.findPostWithContext(transactionContext, clientID, nil, groupID, *post.ParentID, true, false)
+			if err != nil || parent == nil {
+				return fmt.Errorf("the parent post (%s) is deleted, restore it first", *post.ParentID)
+			}
+		}
 
-		_, err = sa.db.posts.DeleteOneWithContext(transactionContext, filter, nil)
+		deletionID := postID
+		if post.DeletionID != nil {
+			deletionID = *post.DeletionID
+		}
+		restoreFilter := bson.D{
+			primitive.E{Key: "client_id", Value: clientID},
+			primitive.E{Key: "group_id", Value: groupID},
+			primitive.E{Key: "$or", Value: []bson.M{
+				{"_id": postID},
+				{"deletion_id": deletionID, "date_deleted": post.DateDeleted},
+			}},
+		}
+		update := bson.D{
+			primitive.E{Key: "$set", Value: bson.D{
+				primitive.E{Key: "date_deleted", Value: nil},
+				primitive.E{Key: "deleted_by", Value: nil},
+				primitive.E{Key: "deletion_id", Value: nil},
+			}},
+		}
+		res, err := sa.db.posts.UpdateManyWithContext(transactionContext, restoreFilter, update, nil)
 		if err != nil {
 			return err
 		}
+		restored = res.ModifiedCount
 
 		return sa.UpdateGroupStats(transactionContext, clientID, groupID, true, false, false, false)
 	}
 
+	var err error
 	if ctx != nil {
-		return deleteWrapper(ctx)
+		err = restoreWrapper(ctx)
+	} else {
+		err = sa.PerformTransaction(restoreWrapper)
 	}
-	return sa.PerformTransaction(func(transactionContext TransactionContext) error {
-		return deleteWrapper(transactionContext)
-	})
+	if err != nil {
+		return 0, err
+	}
+
+	return restored, nil
 }
 
-// FindScheduledPosts Finds scheduled posts whithout sent notifications
-func (sa *Adapter) FindScheduledPosts(context TransactionContext) ([]model.Post, error) {
-	var posts []model.Post
-	err := sa.db.posts.FindWithContext(context, bson.D{
-		{Key: "date_scheduled", Value: bson.M{"$lt": time.Now()}},
+// ClaimScheduledPost Claims one due scheduled post without a sent notification for the claim token until the lease expiry.
+// The posts claimed by another token are skipped until their lease expires, the failed notifications are not claimed anymore.
+// Every claim increases the notification attempts of the post. Gives nil if there is no post to claim.
+func (sa *Adapter) ClaimScheduledPost(context TransactionContext, claimToken string, leaseExpiry time.Time) (*model.Post, error) {
+	now := time.Now()
+	filter := bson.D{
+		{Key: "$and", Value: bson.A{
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "date_notification_deferred", Value: nil}, {Key: "date_scheduled", Value: bson.M{"$lt": now}}},
+				bson.D{{Key: "date_notification_deferred", Value: bson.M{"$lt": now}}},
+			}}},
+			bson.D{{Key: "$or", Value: bson.A{
+				bson.D{{Key: "notification_claim", Value: nil}},
+				bson.D{{Key: "date_notification_claim_expires", Value: bson.M{"$lt": now}}},
+			}}},
+		}},
 		{Key: "date_notified", Value: nil},
-	}, &posts, nil)
+		{Key: "date_notification_failed", Value: nil},
+		{Key: "date_deleted", Value: nil},
+		{Key: "status", Value: bson.M{"$nin": model.UnpublishedPostStatuses}},
+	}
+	update := bson.D{
+		{Key: "$set", Value: bson.D{
+			{Key: "notification_claim", Value: claimToken},
+			{Key: "date_notification_claim_expires", Value: leaseExpiry},
+		}},
+		{Key: "$inc", Value: bson.D{{Key: "notification_attempts", Value: 1}}},
+	}
+
+	after := options.After
+	opts := options.FindOneAndUpdateOptions{ReturnDocument: &after, Sort: bson.D{{Key: "date_scheduled", Value: 1}}}
+	var post model.Post
+	err := sa.db.posts.FindOneAndUpdateWithContext(context, filter, update, &post, &opts)
 	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
 		return nil, err
 	}
-	return posts, nil
+	return &post, nil
+}
+
+// UpdatePostNotificationDeferred defers the notification of the claimed post until the provided date and releases the claim
+func (sa *Adapter) UpdatePostNotificationDeferred(context TransactionContext, id string, claimToken string, deferUntil time.Time) error {
+	_, err := sa.db.posts.UpdateOneWithContext(context,
+		bson.D{{Key: "_id", Value: id}, {Key: "notification_claim", Value: claimToken}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "date_notification_deferred", Value: deferUntil}}},
+			{Key: "$unset", Value: bson.D{{Key: "notification_claim", Value: ""}, {Key: "date_notification_claim_expires", Value: ""}}},
+		},
+		nil)
+
+	return err
 }
 
-// UpdateDateNotifiedForPostIDs Updates the notification time for the desired posts
-func (sa *Adapter) UpdateDateNotifiedForPostIDs(context TransactionContext, ids []string, dateNotified time.Time) error {
-	_, err := sa.db.posts.UpdateManyWithContext(context,
-		bson.D{{Key: "_id", Value: bson.M{"$in": ids}}},
-		bson.D{{Key: "$set", Value: bson.D{{Key: "date_notified", Value: dateNotified}}}},
+// UpdateClaimedPostNotified Sets the notification time of the claimed post and releases the claim. Gives false if the claim has been taken over by another token.
+func (sa *Adapter) UpdateClaimedPostNotified(context TransactionContext, id string, claimToken string, dateNotified time.Time) (bool, error) {
+	result, err := sa.db.posts.UpdateOneWithContext(context,
+		bson.D{{Key: "_id", Value: id}, {Key: "notification_claim", Value: claimToken}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "date_notified", Value: dateNotified}}},
+			{Key: "$unset", Value: bson.D{{Key: "notification_claim", Value: ""}, {Key: "date_notification_claim_expires", Value: ""}}},
+		},
+		nil)
+	if err != nil {
+		return false, err
+	}
+
+	return result.MatchedCount > 0, nil
+}
+
+// UpdateClaimedPostNotificationFailed Marks the notification of the claimed post as failed and releases the claim, so it is not claimed anymore
+func (sa *Adapter) UpdateClaimedPostNotificationFailed(context TransactionContext, id string, claimToken string, dateFailed time.Time) error {
+	_, err := sa.db.posts.UpdateOneWithContext(context,
+		bson.D{{Key: "_id", Value: id}, {Key: "notification_claim", Value: claimToken}},
+		bson.D{
+			{Key: "$set", Value: bson.D{{Key: "date_notification_failed", Value: dateFailed}}},
+			{Key: "$unset", Value: bson.D{{Key: "notification_claim", Value: ""}, {Key: "date_notification_claim_expires", Value: ""}}},
+		},
 		nil)
 
 	return err
@@ -1810,6 +2607,7 @@ func (sa *Adapter) FindAuthmanGroups(clientID string) ([]model.Group, error) {
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "authman_enabled", Value: true},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
 	}
 
 	findOptions := options.Find()
@@ -1828,6 +2626,7 @@ func (sa *Adapter) FindAuthmanGroupByKey(clientID string, authmanGroupKey string
 	filter := bson.D{
 		primitive.E{Key: "client_id", Value: clientID},
 		primitive.E{Key: "authman_group", Value: authmanGroupKey},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
 	}
 
 	findOptions := options.Find()
@@ -2026,6 +2825,16 @@ func (sa *Adapter) DeleteGroupMembershipsByAccountsIDs(log *logs.Logger, context
 		primitive.E{Key: "user_id", Value: primitive.M{"$in": accountsIDs}},
 	}
 	_, err := sa.db.groupMemberships.DeleteManyWithContext(context, filter, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = sa.db.groupReadCursors.DeleteManyWithContext(context, filter, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = sa.db.postBookmarks.DeleteManyWithContext(context, filter, nil)
 	return err
 }
 
@@ -2044,6 +2853,13 @@ func (sa *Adapter) DeletePostsByAccountsIDs(log *logs.Logger, context Transactio
 		primitive.E{Key: "member.user_id", Value: primitive.M{"$in": accountsIDs}},
 	}
 	_, err := sa.db.posts.DeleteManyWithContext(context, filter, nil)
+	if err != nil {
+		return err
+	}
+
+	_, err = sa.db.postRevisions.DeleteManyWithContext(context, bson.D{
+		primitive.E{Key: "user_id", Value: primitive.M{"$in": accountsIDs}},
+	}, nil)
 	return err
 }
 
@@ -2096,7 +2912,7 @@ func (sa *Adapter) PullMembersFromPostsByUserIDs(log *logs.Logger, context Trans
 }
 
 // NewStorageAdapter creates a new storage adapter instance
-func NewStorageAdapter(mongoDBAuth string, mongoDBName string, mongoTimeout string) *Adapter {
+func NewStorageAdapter(mongoDBAuth string, mongoDBName string, mongoTimeout string, slowQueryThreshold string, answersEncryptionKeys string, answersEncryptionKeyID string) *Adapter {
 	timeout, err := strconv.Atoi(mongoTimeout)
 	if err != nil {
 		log.Println("Set default timeout - 500")
@@ -2104,15 +2920,27 @@ func NewStorageAdapter(mongoDBAuth string, mongoDBName string, mongoTimeout stri
 	}
 	timeoutMS := time.Millisecond * time.Duration(timeout)
 
-	db := &database{mongoDBAuth: mongoDBAuth, mongoDBName: mongoDBName, mongoTimeout: timeoutMS}
+	threshold, err := strconv.Atoi(slowQueryThreshold)
+	if err != nil {
+		log.Println("Set default slow query threshold - 1000")
+		threshold = 1000
+	}
+	thresholdMS := time.Millisecond * time.Duration(threshold)
+
+	db := &database{mongoDBAuth: mongoDBAuth, mongoDBName: mongoDBName, mongoTimeout: timeoutMS, slowQueryThreshold: thresholdMS,
+		answersEncryptionKeys: answersEncryptionKeys, answersEncryptionKeyID: answersEncryptionKeyID}
 
 	cachedSyncConfigs := &syncmap.Map{}
 	syncConfigsLock := &sync.RWMutex{}
 
 	cachedManagedGroupConfigs := &syncmap.Map{}
 	managedGroupConfigsLock := &sync.RWMutex{}
+
+	cachedCategoryVisibilityConfigs := &syncmap.Map{}
+	categoryVisibilityConfigsLock := &sync.RWMutex{}
 	return &Adapter{db: db, cachedSyncConfigs: cachedSyncConfigs, syncConfigsLock: syncConfigsLock,
-		cachedManagedGroupConfigs: cachedManagedGroupConfigs, managedGroupConfigsLock: managedGroupConfigsLock}
+		cachedManagedGroupConfigs: cachedManagedGroupConfigs, managedGroupConfigsLock: managedGroupConfigsLock,
+		cachedCategoryVisibilityConfigs: cachedCategoryVisibilityConfigs, categoryVisibilityConfigsLock: categoryVisibilityConfigsLock}
 }
 
 func abortTransaction(sessionContext mongo.SessionContext) {
@@ -2129,6 +2957,7 @@ type storageListener struct {
 
 func (sl *storageListener) OnConfigsChanged() {
 	sl.adapter.cacheSyncConfigs()
+	sl.adapter.cacheCategoryVisibilityConfigs()
 }
 
 func (sl *storageListener) OnManagedGroupConfigsChanged() {
@@ -2139,6 +2968,7 @@ func (sl *storageListener) OnManagedGroupConfigsChanged() {
 type Listener interface {
 	OnConfigsChanged()
 	OnManagedGroupConfigsChanged()
+	OnGroupMessageCreated(message model.GroupMessage)
 }
 
 // DefaultListenerImpl default listener implementation
@@ -2150,6 +2980,9 @@ func (d *DefaultListenerImpl) OnConfigsChanged() {}
 // OnManagedGroupConfigsChanged notifies managed group configs have been updated
 func (d *DefaultListenerImpl) OnManagedGroupConfigsChanged() {}
 
+// OnGroupMessageCreated notifies a group chat message has been created on any of the service instances
+func (d *DefaultListenerImpl) OnGroupMessageCreated(message model.GroupMessage) {}
+
 // TransactionContext wraps mongo.SessionContext for use by external packages
 type TransactionContext interface {
 	mongo.SessionContext
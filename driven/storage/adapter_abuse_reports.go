@@ -0,0 +1,95 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CreateAbuseReport Creates an abuse report
+func (sa *Adapter) CreateAbuseReport(context TransactionContext, report model.AbuseReport) error {
+	_, err := sa.db.abuseReports.InsertOneWithContext(context, report)
+	return err
+}
+
+// FindAbuseReports Finds the abuse reports ordered by the creation date (newest first)
+func (sa *Adapter) FindAbuseReports(context TransactionContext, clientID string, filter model.AbuseReportsFilter) ([]model.AbuseReport, error) {
+	matchFilter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+	if len(filter.GroupIDs) > 0 {
+		matchFilter = append(matchFilter, primitive.E{Key: "group_id", Value: bson.M{"$in": filter.GroupIDs}})
+	}
+	if filter.PostID != nil {
+		matchFilter = append(matchFilter, primitive.E{Key: "post_id", Value: *filter.PostID})
+	}
+	if filter.TargetType != nil {
+		matchFilter = append(matchFilter, primitive.E{Key: "target_type", Value: *filter.TargetType})
+	}
+	if len(filter.Statuses) > 0 {
+		matchFilter = append(matchFilter, primitive.E{Key: "status", Value: bson.M{"$in": filter.Statuses}})
+	}
+	if filter.SendToGroupAdmins != nil {
+		matchFilter = append(matchFilter, primitive.E{Key: "send_to_group_admins", Value: *filter.SendToGroupAdmins})
+	}
+
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "date_created", Value: -1}})
+	if filter.Offset != nil {
+		findOptions.SetSkip(*filter.Offset)
+	}
+	if filter.Limit != nil {
+		findOptions.SetLimit(*filter.Limit)
+	}
+
+	list := []model.AbuseReport{}
+	err := sa.db.abuseReports.FindWithContext(context, matchFilter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// FindAbuseReport Finds an abuse report
+func (sa *Adapter) FindAbuseReport(context TransactionContext, clientID string, id string) (*model.AbuseReport, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	var list []model.AbuseReport
+	err := sa.db.abuseReports.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return &list[0], nil
+}
+
+// UpdateAbuseReportStatus Sets the status and the moderation action of an open or reviewed abuse report. It gives false if the report is already resolved.
+func (sa *Adapter) UpdateAbuseReportStatus(context TransactionContext, clientID string, id string, status string, action string, moderatorID string, moderatorComment string) (bool, error) {
+	now := time.Now()
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "status", Value: bson.M{"$ne": model.AbuseReportStatusResolved}},
+	}
+	set := bson.D{
+		primitive.E{Key: "status", Value: status},
+		primitive.E{Key: "action", Value: action},
+		primitive.E{Key: "moderator_id", Value: moderatorID},
+		primitive.E{Key: "moderator_comment", Value: moderatorComment},
+		primitive.E{Key: "date_updated", Value: now},
+	}
+	if status == model.AbuseReportStatusResolved {
+		set = append(set, primitive.E{Key: "date_resolved", Value: now})
+	}
+
+	result, err := sa.db.abuseReports.UpdateOneWithContext(context, filter, bson.D{primitive.E{Key: "$set", Value: set}}, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// unlinkedUserID matches the user ids of the data added by the external ID before the user has logged in
+var unlinkedUserID = bson.M{"$in": []interface{}{nil, ""}}
+
+// LinkAccountData links the memberships, the posts and the events added by the external ID or the NetID of the user to the Core BB account.
+// The memberships of the groups which the account is already part of are not claimed, so the user does not get duplicate memberships.
+func (sa *Adapter) LinkAccountData(context TransactionContext, clientID string, userID string, externalID string, netID string) (*model.AccountLinkResult, error) {
+	result := model.AccountLinkResult{}
+
+	identifiers := []bson.M{}
+	if len(externalID) > 0 {
+		identifiers = append(identifiers, bson.M{"external_id": externalID})
+	}
+	if len(netID) > 0 {
+		identifiers = append(identifiers, bson.M{"net_id": netID})
+	}
+	if len(identifiers) == 0 {
+		return &result, nil
+	}
+
+	var linkedMemberships []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, bson.M{"client_id": clientID, "user_id": userID}, &linkedMemberships,
+		options.Find().SetProjection(bson.M{"group_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	linkedGroupIDs := make([]string, len(linkedMemberships))
+	for i, membership := range linkedMemberships {
+		linkedGroupIDs[i] = membership.GroupID
+	}
+
+	now := time.Now().UTC()
+	membershipsFilter := bson.M{
+		"client_id": clientID,
+		"user_id":   unlinkedUserID,
+		"group_id":  bson.M{"$nin": linkedGroupIDs},
+		"$or":       identifiers,
+	}
+	membershipsUpdate := bson.M{"$set": bson.M{"user_id": userID, "date_updated": now}}
+	membershipsResult, err := sa.db.groupMemberships.UpdateManyWithContext(context, membershipsFilter, membershipsUpdate, nil)
+	if err != nil {
+		return nil, err
+	}
+	result.Memberships = membershipsResult.ModifiedCount
+
+	if len(externalID) > 0 {
+		toMemberFilter := bson.M{
+			"client_id":  clientID,
+			"to_members": bson.M{"$elemMatch": bson.M{"external_id": externalID, "user_id": unlinkedUserID}},
+		}
+		toMemberUpdate := bson.M{"$set": bson.M{"to_members.$[member].user_id": userID}}
+		toMemberOptions := options.Update().SetArrayFilters(options.ArrayFilters{Filters: []interface{}{
+			bson.M{"member.external_id": externalID, "member.user_id": unlinkedUserID},
+		}})
+
+		postsResult, err := sa.db.posts.UpdateManyWithContext(context, toMemberFilter, toMemberUpdate, toMemberOptions)
+		if err != nil {
+			return nil, err
+		}
+		result.Posts = postsResult.ModifiedCount
+
+		eventsResult, err := sa.db.events.UpdateManyWithContext(context, toMemberFilter, toMemberUpdate, toMemberOptions)
+		if err != nil {
+			return nil, err
+		}
+		result.Events = eventsResult.ModifiedCount
+	}
+
+	return &result, nil
+}
+
+// FindUnlinkedMembershipExternalIDs finds the external IDs of the memberships which are not linked to a Core BB account.
+// The external IDs are sorted so the next batch starts after the last external ID of the previous one.
+func (sa *Adapter) FindUnlinkedMembershipExternalIDs(context TransactionContext, clientID string, afterExternalID string, limit int64) ([]string, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id":   clientID,
+			"user_id":     unlinkedUserID,
+			"external_id": bson.M{"$gt": afterExternalID},
+		}},
+		{"$group": bson.M{"_id": "$external_id"}},
+		{"$sort": bson.M{"_id": 1}},
+		{"$limit": limit},
+	}
+
+	var result []struct {
+		ExternalID string `bson:"_id"`
+	}
+	err := sa.db.groupMemberships.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	externalIDs := make([]string, len(result))
+	for i, item := range result {
+		externalIDs[i] = item.ExternalID
+	}
+	return externalIDs, nil
+}
@@ -0,0 +1,269 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// MergeUserAccounts moves the memberships, posts, reactions, event to_members, RSVPs and attendances, bans, read cursors, bookmarks and user notifications
+// of the old user to the surviving user within a transaction. When both users are members of the same group, the membership with the higher status is kept.
+// Only the data of the client is moved, the user notifications are matched by the app and the org.
+func (sa *Adapter) MergeUserAccounts(clientID string, appID string, orgID string, oldUserID string, newUserID string) (*model.AccountMergeResult, error) {
+	result := model.AccountMergeResult{}
+
+	transaction := func(context TransactionContext) error {
+		result = model.AccountMergeResult{}
+		now := time.Now()
+
+		// memberships
+		var memberships []model.GroupMembership
+		err := sa.db.groupMemberships.FindWithContext(context, bson.M{"client_id": clientID, "user_id": oldUserID}, &memberships, nil)
+		if err != nil {
+			return err
+		}
+		for _, membership := range memberships {
+			var surviving []model.GroupMembership
+			filter := bson.M{"client_id": membership.ClientID, "group_id": membership.GroupID, "user_id": newUserID}
+			err = sa.db.groupMemberships.FindWithContext(context, filter, &surviving, nil)
+			if err != nil {
+				return err
+			}
+
+			if len(surviving) == 0 {
+				update := bson.M{"$set": bson.M{"user_id": newUserID, "date_updated": now}}
+				_, err = sa.db.groupMemberships.UpdateOneWithContext(context, bson.M{"_id": membership.ID}, update, nil)
+				if err != nil {
+					return err
+				}
+				result.MembershipsMoved++
+			} else {
+				set := bson.M{"date_updated": now}
+				if model.MembershipStatusRank(membership.Status) > model.MembershipStatusRank(surviving[0].Status) {
+					set["status"] = membership.Status
+				}
+				if membership.ShowAsLeader {
+					set["show_as_leader"] = true
+				}
+				_, err = sa.db.groupMemberships.UpdateOneWithContext(context, bson.M{"_id": surviving[0].ID}, bson.M{"$set": set}, nil)
+				if err != nil {
+					return err
+				}
+				_, err = sa.db.groupMemberships.DeleteOneWithContext(context, bson.M{"_id": membership.ID}, nil)
+				if err != nil {
+					return err
+				}
+				result.MembershipsMerged++
+			}
+
+			err = sa.UpdateGroupStats(context, membership.ClientID, membership.GroupID, false, true, false, true)
+			if err != nil {
+				return err
+			}
+		}
+
+		// posts
+		updateResult, err := sa.db.posts.UpdateManyWithContext(context, bson.M{"client_id": clientID, "member.user_id": oldUserID}, bson.M{"$set": bson.M{"member.user_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		result.Posts = updateResult.ModifiedCount
+
+		updateResult, err = sa.db.posts.UpdateManyWithContext(context, bson.M{"client_id": clientID, "to_members.user_id": oldUserID}, reKeyToMembersPipeline(oldUserID, newUserID), nil)
+		if err != nil {
+			return err
+		}
+		result.PostsToMembers = updateResult.ModifiedCount
+
+		updateResult, err = sa.db.posts.UpdateManyWithContext(context, postsReactedByFilter(clientID, oldUserID), reKeyReactionsPipeline(oldUserID, newUserID), nil)
+		if err != nil {
+			return err
+		}
+		result.PostsReactions = updateResult.ModifiedCount
+
+		// events
+		updateResult, err = sa.db.events.UpdateManyWithContext(context, bson.M{"client_id": clientID, "creator.user_id": oldUserID}, bson.M{"$set": bson.M{"creator.user_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		result.Events = updateResult.ModifiedCount
+
+		updateResult, err = sa.db.events.UpdateManyWithContext(context, bson.M{"client_id": clientID, "to_members.user_id": oldUserID}, reKeyToMembersPipeline(oldUserID, newUserID), nil)
+		if err != nil {
+			return err
+		}
+		result.EventsToMembers = updateResult.ModifiedCount
+
+		updateResult, err = sa.db.events.UpdateManyWithContext(context, bson.M{"client_id": clientID, "rsvps.user_id": oldUserID}, reKeyUserListPipeline("rsvps", oldUserID, newUserID), nil)
+		if err != nil {
+			return err
+		}
+		result.EventRSVPs = updateResult.ModifiedCount
+
+		result.EventAttendances, err = sa.reKeyUserDocuments(context, sa.db.eventAttendances, clientID, oldUserID, newUserID, []string{"client_id", "group_id", "event_id"}, nil)
+		if err != nil {
+			return err
+		}
+
+		// groups
+		updateResult, err = sa.db.groups.UpdateManyWithContext(context, bson.M{"client_id": clientID, "creator_id": oldUserID}, bson.M{"$set": bson.M{"creator_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		result.GroupsCreated = updateResult.ModifiedCount
+
+		// read receipts
+		updateResult, err = sa.db.userNotifications.UpdateManyWithContext(context, bson.M{"app_id": appID, "org_id": orgID, "user_id": oldUserID}, bson.M{"$set": bson.M{"user_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		result.UserNotifications = updateResult.ModifiedCount
+
+		// the surviving account keeps a single ban per group with the earliest ban date
+		result.Bans, err = sa.reKeyUserDocuments(context, sa.db.groupBans, clientID, oldUserID, newUserID, []string{"client_id", "group_id"},
+			func(doc bson.M) bson.M {
+				return bson.M{"$min": bson.M{"date_created": doc["date_created"]}}
+			})
+		if err != nil {
+			return err
+		}
+
+		result.ReadCursors, err = sa.reKeyUserDocuments(context, sa.db.groupReadCursors, clientID, oldUserID, newUserID, []string{"client_id", "group_id"},
+			func(doc bson.M) bson.M {
+				return bson.M{"$max": bson.M{"date_read": doc["date_read"]}}
+			})
+		if err != nil {
+			return err
+		}
+
+		result.PostBookmarks, err = sa.reKeyUserDocuments(context, sa.db.postBookmarks, clientID, oldUserID, newUserID, []string{"client_id", "post_id"}, nil)
+		if err != nil {
+			return err
+		}
+
+		// the reaction history is used by the rate limiting and the analytics
+		_, err = sa.db.reactionEvents.UpdateManyWithContext(context, bson.M{"client_id": clientID, "user_id": oldUserID}, bson.M{"$set": bson.M{"user_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		_, err = sa.db.reactionSuspensions.UpdateManyWithContext(context, bson.M{"client_id": clientID, "user_id": oldUserID}, bson.M{"$set": bson.M{"user_id": newUserID}}, nil)
+		if err != nil {
+			return err
+		}
+		_, err = sa.db.contentEvents.UpdateManyWithContext(context, bson.M{"client_id": clientID, "user_id": oldUserID}, bson.M{"$set": bson.M{"user_id": newUserID}}, nil)
+		return err
+	}
+
+	err := sa.PerformTransaction(transaction)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// reKeyUserDocuments moves the documents of the old user within the client to the new user. The keys identify the document of a user, the document of the old user is
+// deleted when the new user already has one. The merge function, if provided, gives the update of the kept document by the deleted one.
+// It gives the number of the moved and the merged documents.
+func (sa *Adapter) reKeyUserDocuments(context TransactionContext, collection *collectionWrapper, clientID string, oldUserID string, newUserID string, keys []string,
+	merge func(doc bson.M) bson.M) (int64, error) {
+	var docs []bson.M
+	err := collection.FindWithContext(context, bson.M{"client_id": clientID, "user_id": oldUserID}, &docs, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, doc := range docs {
+		surviving := bson.M{"user_id": newUserID}
+		for _, key := range keys {
+			surviving[key] = doc[key]
+		}
+		count, err := collection.CountDocumentsWithContext(context, surviving)
+		if err != nil {
+			return 0, err
+		}
+
+		if count == 0 {
+			_, err = collection.UpdateOneWithContext(context, bson.M{"_id": doc["_id"]}, bson.M{"$set": bson.M{"user_id": newUserID}}, nil)
+			if err != nil {
+				return 0, err
+			}
+			continue
+		}
+		if merge != nil {
+			_, err = collection.UpdateOneWithContext(context, surviving, merge(doc), nil)
+			if err != nil {
+				return 0, err
+			}
+		}
+		_, err = collection.DeleteOneWithContext(context, bson.M{"_id": doc["_id"]}, nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+	return int64(len(docs)), nil
+}
+
+// reKeyToMembersPipeline replaces the old user within the to_members list. The old user entry is dropped if the new user is already listed.
+func reKeyToMembersPipeline(oldUserID string, newUserID string) mongo.Pipeline {
+	return reKeyUserListPipeline("to_members", oldUserID, newUserID)
+}
+
+// reKeyUserListPipeline replaces the old user within the list of the field. The old user entry is dropped if the new user is already listed.
+func reKeyUserListPipeline(field string, oldUserID string, newUserID string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			field: bson.M{"$cond": bson.A{
+				bson.M{"$in": bson.A{newUserID, "$" + field + ".user_id"}},
+				bson.M{"$filter": bson.M{
+					"input": "$" + field,
+					"as":    "m",
+					"cond":  bson.M{"$ne": bson.A{"$$m.user_id", oldUserID}},
+				}},
+				bson.M{"$map": bson.M{
+					"input": "$" + field,
+					"as":    "m",
+					"in": bson.M{"$cond": bson.A{
+						bson.M{"$eq": bson.A{"$$m.user_id", oldUserID}},
+						bson.M{"$mergeObjects": bson.A{"$$m", bson.M{"user_id": newUserID}}},
+						"$$m",
+					}},
+				}},
+			}},
+		}}},
+	}
+}
+
+// postsReactedByFilter matches the posts of the client the user has reacted to. The reactions are keyed by the reaction, so all reaction lists are checked.
+func postsReactedByFilter(clientID string, userID string) bson.M {
+	return bson.M{
+		"client_id": clientID,
+		"reactions": bson.M{"$type": "object"},
+		"$expr": bson.M{"$in": bson.A{userID, bson.M{"$reduce": bson.M{
+			"input":        bson.M{"$objectToArray": "$reactions"},
+			"initialValue": bson.A{},
+			"in":           bson.M{"$concatArrays": bson.A{"$$value", "$$this.v"}},
+		}}}},
+	}
+}
+
+// reKeyReactionsPipeline replaces the old user within all reaction lists without duplicating the new user
+func reKeyReactionsPipeline(oldUserID string, newUserID string) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{Key: "$set", Value: bson.M{
+			"reactions": bson.M{"$arrayToObject": bson.M{"$map": bson.M{
+				"input": bson.M{"$objectToArray": "$reactions"},
+				"as":    "r",
+				"in": bson.M{
+					"k": "$$r.k",
+					"v": bson.M{"$setUnion": bson.A{bson.M{"$map": bson.M{
+						"input": "$$r.v",
+						"as":    "u",
+						"in":    bson.M{"$cond": bson.A{bson.M{"$eq": bson.A{"$$u", oldUserID}}, newUserID, "$$u"}},
+					}}}},
+				},
+			}}},
+		}}},
+	}
+}
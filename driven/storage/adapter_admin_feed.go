@@ -0,0 +1,84 @@
+package storage
+
+import (
+	"groups/core/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// CountPendingMembershipsByGroup counts the membership requests waiting for an approval within the groups
+func (sa *Adapter) CountPendingMembershipsByGroup(context TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error) {
+	match := bson.M{
+		"client_id": clientID,
+		"group_id":  bson.M{"$in": groupIDs},
+		"status":    "pending",
+	}
+	return sa.aggregateAdminFeedCounts(context, sa.db.groupMemberships, match, "$date_created")
+}
+
+// CountPendingPostsByGroup counts the posts held for an approval within the groups
+func (sa *Adapter) CountPendingPostsByGroup(context TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error) {
+	match := bson.M{
+		"client_id":    clientID,
+		"group_id":     bson.M{"$in": groupIDs},
+		"status":       model.PostStatusPendingApproval,
+		"date_deleted": nil,
+	}
+	return sa.aggregateAdminFeedCounts(context, sa.db.posts, match, "$date_created")
+}
+
+// CountOpenAbuseReportsByGroup counts the unresolved abuse reports sent to the admins of the groups
+func (sa *Adapter) CountOpenAbuseReportsByGroup(context TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error) {
+	match := bson.M{
+		"client_id":            clientID,
+		"group_id":             bson.M{"$in": groupIDs},
+		"send_to_group_admins": true,
+		"status":               bson.M{"$in": []string{model.AbuseReportStatusOpen, model.AbuseReportStatusReviewed}},
+	}
+	return sa.aggregateAdminFeedCounts(context, sa.db.abuseReports, match, "$date_created")
+}
+
+// FindFailedGroupSyncs finds the groups whose last Authman sync run has failed
+func (sa *Adapter) FindFailedGroupSyncs(context TransactionContext, clientID string, groupIDs []string) ([]model.AdminFeedGroupCount, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id": clientID,
+			"type":      model.AuthmanSyncRunTypeGroup,
+			"group_id":  bson.M{"$in": groupIDs},
+			"status":    bson.M{"$ne": model.AuthmanSyncRunStatusRunning},
+		}},
+		{"$sort": bson.M{"date_started": -1}},
+		{"$group": bson.M{
+			"_id":         "$group_id",
+			"status":      bson.M{"$first": "$status"},
+			"date_latest": bson.M{"$first": "$date_started"},
+		}},
+		{"$match": bson.M{"status": model.AuthmanSyncRunStatusFailed}},
+		{"$project": bson.M{"count": bson.M{"$literal": 1}, "date_latest": 1}},
+	}
+
+	var result []model.AdminFeedGroupCount
+	err := sa.db.authmanSyncRuns.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func (sa *Adapter) aggregateAdminFeedCounts(context TransactionContext, collection *collectionWrapper, match bson.M, dateField string) ([]model.AdminFeedGroupCount, error) {
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id":         "$group_id",
+			"count":       bson.M{"$sum": 1},
+			"date_latest": bson.M{"$max": dateField},
+		}},
+	}
+
+	var result []model.AdminFeedGroupCount
+	err := collection.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -7,6 +7,9 @@ import (
 	"time"
 )
 
+// analyticsMaxTime is the max time of the analytics queries which scan whole collections and are allowed to run longer than the interactive ones
+var analyticsMaxTime = 60 * time.Second
+
 // AnalyticsFindGroups Retrieves analytics groups
 func (sa *Adapter) AnalyticsFindGroups(startDate *time.Time, endDate *time.Time) ([]model.Group, error) {
 	filter := bson.D{}
@@ -19,7 +22,8 @@ func (sa *Adapter) AnalyticsFindGroups(startDate *time.Time, endDate *time.Time)
 	}
 
 	opts := &options.FindOptions{
-		Sort: bson.D{{Key: "date_created", Value: 1}},
+		Sort:    bson.D{{Key: "date_created", Value: 1}},
+		MaxTime: &analyticsMaxTime,
 	}
 
 	var list []model.Group
@@ -33,7 +37,7 @@ func (sa *Adapter) AnalyticsFindGroups(startDate *time.Time, endDate *time.Time)
 
 // AnalyticsFindPosts Retrieves analytics posts
 func (sa *Adapter) AnalyticsFindPosts(groupID *string, startDate *time.Time, endDate *time.Time) ([]model.Post, error) {
-	filter := bson.D{}
+	filter := bson.D{bson.E{Key: "date_deleted", Value: nil}, bson.E{Key: "status", Value: bson.M{"$nin": model.UnpublishedPostStatuses}}}
 
 	if groupID != nil {
 		filter = append(filter, bson.E{Key: "group_id", Value: *groupID})
@@ -46,7 +50,8 @@ func (sa *Adapter) AnalyticsFindPosts(groupID *string, startDate *time.Time, end
 	}
 
 	opts := &options.FindOptions{
-		Sort: bson.D{{Key: "date_created", Value: 1}},
+		Sort:    bson.D{{Key: "date_created", Value: 1}},
+		MaxTime: &analyticsMaxTime,
 	}
 
 	var list []model.Post
@@ -73,7 +78,8 @@ func (sa *Adapter) AnalyticsFindMembers(groupID *string, startDate *time.Time, e
 	}
 
 	opts := &options.FindOptions{
-		Sort: bson.D{{Key: "date_created", Value: 1}},
+		Sort:    bson.D{{Key: "date_created", Value: 1}},
+		MaxTime: &analyticsMaxTime,
 	}
 
 	var list []model.GroupMembership
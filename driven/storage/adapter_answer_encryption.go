@@ -0,0 +1,58 @@
+package storage
+
+import (
+	"errors"
+	"groups/core/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reencryptMemberAnswersBatchSize is the number of memberships re-encrypted at once
+const reencryptMemberAnswersBatchSize = 200
+
+// ReencryptMemberAnswers Encrypts the member answers stored in plaintext or with a previous master key with the current master key.
+// It is used after the master key rotation, so the previous key could be retired. Gives the number of the re-encrypted memberships.
+func (sa *Adapter) ReencryptMemberAnswers(context TransactionContext) (int64, error) {
+	encryption := sa.db.answersEncryption
+	if encryption == nil {
+		return 0, errors.New("the member answers encryption keys are not configured")
+	}
+
+	var count int64
+	afterID := ""
+	for {
+		filter := bson.M{
+			"member_answers": bson.M{"$elemMatch": bson.M{"$or": bson.A{
+				bson.M{"encrypted": bson.M{"$exists": false}},
+				bson.M{"encrypted.key_id": bson.M{"$ne": encryption.keyID}},
+			}}},
+		}
+		if afterID != "" {
+			filter["_id"] = bson.M{"$gt": afterID}
+		}
+		findOptions := options.Find()
+		findOptions.SetSort(bson.D{{Key: "_id", Value: 1}})
+		findOptions.SetLimit(reencryptMemberAnswersBatchSize)
+
+		var memberships []model.GroupMembership
+		err := sa.db.groupMemberships.FindWithContext(context, filter, &memberships, findOptions)
+		if err != nil {
+			return count, err
+		}
+		if len(memberships) == 0 {
+			return count, nil
+		}
+		afterID = memberships[len(memberships)-1].ID
+
+		for _, membership := range memberships {
+			// the answers are decrypted on loading and encrypted with the current key on saving
+			update := bson.M{"$set": bson.M{"member_answers": membership.MemberAnswers}}
+			_, err = sa.db.groupMemberships.UpdateOneWithContext(context, bson.M{"_id": membership.ID}, update, nil)
+			if err != nil {
+				return count, err
+			}
+			count++
+		}
+	}
+}
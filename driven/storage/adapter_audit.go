@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"groups/core/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// InsertAuditLog Stores an audit log record
+func (sa *Adapter) InsertAuditLog(context TransactionContext, item model.AuditLog) error {
+	_, err := sa.db.auditLogs.InsertOneWithContext(context, item)
+	return err
+}
+
+// FindAuditLogs Finds the audit log records of a group ordered by date (newest first)
+func (sa *Adapter) FindAuditLogs(context TransactionContext, clientID string, groupID string, filter model.AuditLogFilter) ([]model.AuditLog, error) {
+	mongoFilter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+	}
+	if len(filter.Actions) > 0 {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "action", Value: bson.M{"$in": filter.Actions}})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
+	if filter.Limit != nil {
+		findOptions.SetLimit(*filter.Limit)
+	}
+	if filter.Offset != nil {
+		findOptions.SetSkip(*filter.Offset)
+	}
+
+	list := []model.AuditLog{}
+	err := sa.db.auditLogs.FindWithContext(context, mongoFilter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
@@ -0,0 +1,69 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// authmanSyncRunsTTL the sync run records are kept for 90 days
+const authmanSyncRunsTTL = 90 * 24 * time.Hour
+
+// defaultAuthmanSyncRunsLimit the number of runs given when no limit is requested
+const defaultAuthmanSyncRunsLimit = 20
+
+// InsertAuthmanSyncRun Stores an Authman sync run record
+func (sa *Adapter) InsertAuthmanSyncRun(context TransactionContext, run model.AuthmanSyncRun) error {
+	_, err := sa.db.authmanSyncRuns.InsertOneWithContext(context, run)
+	return err
+}
+
+// UpdateAuthmanSyncRun Updates the counts, the errors and the status of an Authman sync run record
+func (sa *Adapter) UpdateAuthmanSyncRun(context TransactionContext, run model.AuthmanSyncRun) error {
+	filter := bson.M{"_id": run.ID, "client_id": run.ClientID}
+	update := bson.M{"$set": bson.M{
+		"status":     run.Status,
+		"mode":       run.Mode,
+		"keys":       run.Keys,
+		"added":      run.Added,
+		"removed":    run.Removed,
+		"failed":     run.Failed,
+		"errors":     run.Errors,
+		"date_ended": run.DateEnded,
+	}}
+
+	_, err := sa.db.authmanSyncRuns.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindAuthmanSyncRuns Finds the last Authman sync runs ordered by start date (newest first)
+func (sa *Adapter) FindAuthmanSyncRuns(context TransactionContext, clientID string, filter model.AuthmanSyncRunFilter) ([]model.AuthmanSyncRun, error) {
+	mongoFilter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	if filter.GroupID != nil {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "group_id", Value: *filter.GroupID})
+	}
+	if filter.Type != nil {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "type", Value: *filter.Type})
+	}
+
+	limit := int64(defaultAuthmanSyncRunsLimit)
+	if filter.Limit != nil && *filter.Limit > 0 {
+		limit = *filter.Limit
+	}
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_started", Value: -1}})
+	findOptions.SetLimit(limit)
+
+	list := []model.AuthmanSyncRun{}
+	err := sa.db.authmanSyncRuns.FindWithContext(context, mongoFilter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
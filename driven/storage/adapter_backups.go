@@ -0,0 +1,104 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+func (sa *Adapter) backupCollection(name string) (*collectionWrapper, error) {
+	switch name {
+	case "groups":
+		return sa.db.groups, nil
+	case "group_memberships":
+		return sa.db.groupMemberships, nil
+	case "posts":
+		return sa.db.posts, nil
+	case "events":
+		return sa.db.events, nil
+	}
+	return nil, fmt.Errorf("collection %s could not be backed up", name)
+}
+
+// ExportDocuments gives the documents of the collection as canonical extended JSON ordered by id, so the types are kept on restore.
+// The documents created or updated since updatedSince and before updatedBefore are given. All documents are given if updatedSince is nil.
+// The next page starts after the last returned id.
+func (sa *Adapter) ExportDocuments(collection string, updatedSince *time.Time, updatedBefore time.Time, afterID string, limit int64) ([][]byte, string, error) {
+	coll, err := sa.backupCollection(collection)
+	if err != nil {
+		return nil, "", err
+	}
+
+	filter := bson.D{}
+	if updatedSince != nil {
+		period := bson.M{"$gte": *updatedSince, "$lt": updatedBefore}
+		filter = append(filter, primitive.E{Key: "$or", Value: []bson.M{
+			{"date_updated": period},
+			{"date_updated": nil, "date_created": period},
+		}})
+	}
+	if afterID != "" {
+		filter = append(filter, primitive.E{Key: "_id", Value: bson.M{"$gt": afterID}})
+	}
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "_id", Value: 1}}).SetLimit(limit)
+
+	var list []bson.Raw
+	err = coll.Find(filter, &list, findOptions)
+	if err != nil {
+		return nil, "", err
+	}
+
+	documents := make([][]byte, len(list))
+	lastID := afterID
+	for i, raw := range list {
+		documents[i], err = bson.MarshalExtJSON(raw, true, false)
+		if err != nil {
+			return nil, "", fmt.Errorf("error encoding document of %s: %s", collection, err)
+		}
+		if id, ok := raw.Lookup("_id").StringValueOK(); ok {
+			lastID = id
+		}
+	}
+	return documents, lastID, nil
+}
+
+// ImportDocuments creates or replaces the canonical extended JSON documents in the collection by id. Gives the count of the stored documents.
+func (sa *Adapter) ImportDocuments(collection string, documents [][]byte) (int64, error) {
+	coll, err := sa.backupCollection(collection)
+	if err != nil {
+		return 0, err
+	}
+	if len(documents) == 0 {
+		return 0, nil
+	}
+
+	models := make([]mongo.WriteModel, 0, len(documents))
+	for _, document := range documents {
+		var doc bson.D
+		err = bson.UnmarshalExtJSON(document, true, &doc)
+		if err != nil {
+			return 0, fmt.Errorf("error decoding document of %s: %s", collection, err)
+		}
+		var id interface{}
+		for _, field := range doc {
+			if field.Key == "_id" {
+				id = field.Value
+				break
+			}
+		}
+		if id == nil {
+			return 0, fmt.Errorf("missing _id in document of %s", collection)
+		}
+		models = append(models, mongo.NewReplaceOneModel().SetFilter(bson.M{"_id": id}).SetReplacement(doc).SetUpsert(true))
+	}
+
+	result, err := coll.BulkWrite(models, nil)
+	if err != nil {
+		return 0, err
+	}
+	return result.UpsertedCount + result.MatchedCount, nil
+}
@@ -0,0 +1,51 @@
+package storage
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateMembershipCalendarFeedVersion Replaces the calendar feed version of the membership if it is still the provided one. The previous
+// version stays valid until the overlap end, it is revoked at once if no end is provided. It gives false if the membership or its version
+// has changed.
+func (sa *Adapter) UpdateMembershipCalendarFeedVersion(context TransactionContext, clientID string, membershipID string, version int, newVersion int,
+	previousVersionEnds *time.Time) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	if version == 0 {
+		filter = append(filter, primitive.E{Key: "calendar_feed_version", Value: bson.M{"$in": []interface{}{nil, 0}}})
+	} else {
+		filter = append(filter, primitive.E{Key: "calendar_feed_version", Value: version})
+	}
+
+	var update bson.D
+	if previousVersionEnds != nil {
+		update = bson.D{
+			primitive.E{Key: "$set", Value: bson.D{
+				primitive.E{Key: "calendar_feed_version", Value: newVersion},
+				primitive.E{Key: "date_previous_calendar_feed_version_ends", Value: previousVersionEnds},
+				primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+			}},
+		}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$set", Value: bson.D{
+				primitive.E{Key: "calendar_feed_version", Value: newVersion},
+				primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+			}},
+			primitive.E{Key: "$unset", Value: bson.D{
+				primitive.E{Key: "date_previous_calendar_feed_version_ends", Value: ""},
+			}},
+		}
+	}
+
+	result, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
@@ -0,0 +1,53 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// contentEventsTTL defines how long the content events are kept before being expired by the TTL index.
+// It must be longer than the longest window of the content rate limits.
+const contentEventsTTL = 31 * 24 * time.Hour
+
+// InsertContentEvent stores a content event
+func (sa *Adapter) InsertContentEvent(context TransactionContext, event model.ContentEvent) error {
+	_, err := sa.db.contentEvents.InsertOneWithContext(context, event)
+	return err
+}
+
+// CountContentEvents counts the content events of the user action within the group since the provided time
+func (sa *Adapter) CountContentEvents(context TransactionContext, clientID string, userID string, groupID string, action string, since time.Time) (int64, error) {
+	return sa.db.contentEvents.CountDocumentsWithContext(context, contentEventsFilter(clientID, userID, groupID, action, since))
+}
+
+// FindOldestContentEvent finds the oldest content event of the user action within the group since the provided time
+func (sa *Adapter) FindOldestContentEvent(context TransactionContext, clientID string, userID string, groupID string, action string, since time.Time) (*model.ContentEvent, error) {
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: 1}})
+	findOptions.SetLimit(1)
+
+	var list []model.ContentEvent
+	err := sa.db.contentEvents.FindWithContext(context, contentEventsFilter(clientID, userID, groupID, action, since), &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return &list[0], nil
+}
+
+func contentEventsFilter(clientID string, userID string, groupID string, action string, since time.Time) bson.D {
+	return bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "user_id", Value: userID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "action", Value: action},
+		primitive.E{Key: "date_created", Value: bson.M{"$gte": since}},
+	}
+}
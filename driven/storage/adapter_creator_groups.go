@@ -0,0 +1,32 @@
+package storage
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// ArchiveGroup Hides the group from the search and blocks the new membership requests. It gives false if the group is deleted or
+// archived already.
+func (sa *Adapter) ArchiveGroup(context TransactionContext, clientID string, groupID string) (bool, error) {
+	filter := bson.M{
+		"_id":          groupID,
+		"client_id":    clientID,
+		"date_deleted": notDeletedGroupQuery,
+		"$or": []bson.M{
+			{"hidden_for_search": bson.M{"$ne": true}},
+			{"block_new_membership_requests": bson.M{"$ne": true}},
+		},
+	}
+	update := bson.M{"$set": bson.M{
+		"hidden_for_search":             true,
+		"block_new_membership_requests": true,
+		"date_updated":                  time.Now(),
+	}}
+
+	res, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
@@ -0,0 +1,116 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UpdateMembershipDigest sets the digest frequency of the membership. Passing an empty frequency turns the digests off.
+// The digest period starts when the digests are turned on.
+func (sa *Adapter) UpdateMembershipDigest(context TransactionContext, clientID string, membershipID string, frequency string, email bool) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	now := time.Now().UTC()
+	var update bson.D
+	if len(frequency) > 0 {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "digest_frequency", Value: frequency},
+			primitive.E{Key: "digest_email", Value: email},
+			primitive.E{Key: "date_last_digest", Value: now},
+			primitive.E{Key: "date_updated", Value: now},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{
+				primitive.E{Key: "digest_frequency", Value: ""},
+				primitive.E{Key: "digest_email", Value: ""},
+				primitive.E{Key: "date_last_digest", Value: ""},
+			}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: now}}},
+		}
+	}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindDigestMemberships finds the admin and member memberships in the digest mode
+func (sa *Adapter) FindDigestMemberships(context TransactionContext, clientID string) ([]model.GroupMembership, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "digest_frequency", Value: bson.M{"$in": []string{model.DigestFrequencyDaily, model.DigestFrequencyWeekly}}},
+		primitive.E{Key: "status", Value: bson.M{"$in": []string{"admin", "member"}}},
+	}
+
+	var result []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateMembershipsLastDigest moves the last digest date of the memberships
+func (sa *Adapter) UpdateMembershipsLastDigest(context TransactionContext, membershipIDs []string, date time.Time) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: bson.M{"$in": membershipIDs}}}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_last_digest", Value: date}}}}
+	_, err := sa.db.groupMemberships.UpdateManyWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindDigestPosts finds the published top level posts visible to the user which were created within the group in the provided period.
+// The posts of the user are not included.
+func (sa *Adapter) FindDigestPosts(context TransactionContext, clientID string, groupID string, userID string, since time.Time, until time.Time) ([]model.Post, error) {
+	filter := bson.M{
+		"client_id":      clientID,
+		"group_id":       groupID,
+		"parent_id":      nil,
+		"date_deleted":   nil,
+		"status":         bson.M{"$nin": model.UnpublishedPostStatuses},
+		"member.user_id": bson.M{"$ne": userID},
+		"date_created":   bson.M{"$gt": since, "$lte": until},
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"date_scheduled": nil},
+				{"date_scheduled": bson.M{"$lt": until}},
+			}},
+			{"$or": []bson.M{
+				{"to_members": nil},
+				{"to_members": bson.M{"$size": 0}},
+				{"to_members.user_id": userID},
+			}},
+		},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
+	findOptions.SetProjection(bson.M{"subject": 1, "date_created": 1})
+
+	var result []model.Post
+	err := sa.db.posts.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CountDigestEvents counts the events visible to the user which were linked to the group in the provided period
+func (sa *Adapter) CountDigestEvents(context TransactionContext, clientID string, groupID string, userID string, since time.Time, until time.Time) (int64, error) {
+	filter := bson.M{
+		"client_id":     clientID,
+		"group_id":      groupID,
+		"date_archived": nil,
+		"date_created":  bson.M{"$gt": since, "$lte": until},
+		"$or": []bson.M{
+			{"to_members": nil},
+			{"to_members": bson.M{"$size": 0}},
+			{"to_members.user_id": userID},
+		},
+	}
+	return sa.db.events.CountDocumentsWithContext(context, filter)
+}
@@ -0,0 +1,175 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type memberAttendanceResult struct {
+	UserID           string    `bson:"_id"`
+	Name             string    `bson:"name"`
+	Email            string    `bson:"email"`
+	AttendedCount    int       `bson:"attended_count"`
+	DateLastAttended time.Time `bson:"date_last_attended"`
+}
+
+type eventsCountResult struct {
+	Count int `bson:"count"`
+}
+
+// InsertEventCheckInCode Inserts a check-in code of a group event
+func (sa *Adapter) InsertEventCheckInCode(context TransactionContext, code model.EventCheckInCode) error {
+	_, err := sa.db.eventCheckInCodes.InsertOneWithContext(context, code)
+	return err
+}
+
+// FindEventCheckInCode Finds a check-in code of a group event by its short code or its QR token
+func (sa *Adapter) FindEventCheckInCode(context TransactionContext, clientID string, groupID string, eventID string, code *string, token *string) (*model.EventCheckInCode, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "event_id", Value: eventID},
+	}
+	if code != nil {
+		filter = append(filter, primitive.E{Key: "code", Value: *code})
+	} else if token != nil {
+		filter = append(filter, primitive.E{Key: "token", Value: *token})
+	} else {
+		return nil, nil
+	}
+
+	var result []model.EventCheckInCode
+	err := sa.db.eventCheckInCodes.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+// InsertEventAttendance Records the check-in of a member to a group event. It gives false if the member has already checked in.
+// The attendance date of the membership is set on the first attendance.
+func (sa *Adapter) InsertEventAttendance(attendance model.EventAttendance) (bool, error) {
+	inserted := false
+	err := sa.PerformTransaction(func(context TransactionContext) error {
+		_, err := sa.db.eventAttendances.InsertOneWithContext(context, attendance)
+		if err != nil {
+			if mongo.IsDuplicateKeyError(err) {
+				return nil
+			}
+			return err
+		}
+		inserted = true
+
+		filter := bson.D{
+			primitive.E{Key: "_id", Value: attendance.MembershipID},
+			primitive.E{Key: "client_id", Value: attendance.ClientID},
+			primitive.E{Key: "date_attended", Value: nil},
+		}
+		update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "date_attended", Value: attendance.DateCheckedIn},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+		_, err = sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+		return err
+	})
+	return inserted, err
+}
+
+// FindEventAttendances Finds the check-ins to a group event
+func (sa *Adapter) FindEventAttendances(context TransactionContext, clientID string, groupID string, eventID string) ([]model.EventAttendance, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "event_id", Value: eventID},
+	}
+
+	var result []model.EventAttendance
+	err := sa.db.eventAttendances.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteEventAttendancesByUserIDs Deletes the check-ins of the given users
+func (sa *Adapter) DeleteEventAttendancesByUserIDs(context TransactionContext, accountIDs []string) error {
+	filter := bson.D{
+		primitive.E{Key: "user_id", Value: bson.M{"$in": accountIDs}},
+	}
+	_, err := sa.db.eventAttendances.DeleteManyWithContext(context, filter, nil)
+	return err
+}
+
+// FindGroupMemberAttendances counts the check-ins of every user to the events of the group within the date range
+func (sa *Adapter) FindGroupMemberAttendances(context TransactionContext, clientID string, groupID string, startDate *time.Time, endDate *time.Time) ([]model.MemberAttendance, error) {
+	pipeline := []bson.M{
+		{"$match": groupAttendancesMatch(clientID, groupID, startDate, endDate)},
+		{"$sort": bson.M{"date_checked_in": 1}},
+		{"$group": bson.M{
+			"_id":                "$user_id",
+			"name":               bson.M{"$last": "$name"},
+			"email":              bson.M{"$last": "$email"},
+			"attended_count":     bson.M{"$sum": 1},
+			"date_last_attended": bson.M{"$max": "$date_checked_in"},
+		}},
+	}
+
+	var result []memberAttendanceResult
+	err := sa.db.eventAttendances.AggregateWithContext(context, pipeline, &result, &options.AggregateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]model.MemberAttendance, len(result))
+	for i, item := range result {
+		dateLastAttended := item.DateLastAttended
+		list[i] = model.MemberAttendance{UserID: item.UserID, Name: item.Name, Email: item.Email,
+			AttendedCount: item.AttendedCount, DateLastAttended: &dateLastAttended}
+	}
+	return list, nil
+}
+
+// CountGroupAttendedEvents counts the events of the group with at least one check-in within the date range
+func (sa *Adapter) CountGroupAttendedEvents(context TransactionContext, clientID string, groupID string, startDate *time.Time, endDate *time.Time) (int, error) {
+	pipeline := []bson.M{
+		{"$match": groupAttendancesMatch(clientID, groupID, startDate, endDate)},
+		{"$group": bson.M{"_id": "$event_id"}},
+		{"$count": "count"},
+	}
+
+	var result []eventsCountResult
+	err := sa.db.eventAttendances.AggregateWithContext(context, pipeline, &result, &options.AggregateOptions{})
+	if err != nil {
+		return 0, err
+	}
+	if len(result) == 0 {
+		return 0, nil
+	}
+	return result[0].Count, nil
+}
+
+func groupAttendancesMatch(clientID string, groupID string, startDate *time.Time, endDate *time.Time) bson.M {
+	match := bson.M{
+		"client_id": clientID,
+		"group_id":  groupID,
+	}
+	dateFilter := bson.M{}
+	if startDate != nil {
+		dateFilter["$gte"] = *startDate
+	}
+	if endDate != nil {
+		dateFilter["$lte"] = *endDate
+	}
+	if len(dateFilter) > 0 {
+		match["date_checked_in"] = dateFilter
+	}
+	return match
+}
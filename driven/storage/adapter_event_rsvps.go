@@ -0,0 +1,156 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FindEvent Finds a single group event including its RSVPs
+func (sa *Adapter) FindEvent(context TransactionContext, clientID string, groupID string, eventID string) (*model.Event, error) {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: eventID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	var result []model.Event
+	err := sa.db.events.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+// SaveEventRSVP Sets the RSVP of the user for a group event. The previous response of the user is replaced, the reminder preference is kept.
+func (sa *Adapter) SaveEventRSVP(clientID string, groupID string, eventID string, rsvp model.EventRSVP) error {
+	return sa.PerformTransaction(func(context TransactionContext) error {
+		event, err := sa.FindEvent(context, clientID, groupID, eventID)
+		if err != nil {
+			return err
+		}
+		if event != nil {
+			if existing := event.GetRSVP(rsvp.UserID); existing != nil && rsvp.Reminder == nil {
+				rsvp.Reminder = existing.Reminder
+			}
+		}
+		return sa.replaceEventRSVP(context, clientID, groupID, eventID, rsvp)
+	})
+}
+
+// SaveEventRSVPReminder Sets the reminder preference of the user for a group event. The record is created without a status if the user has not responded yet.
+func (sa *Adapter) SaveEventRSVPReminder(clientID string, groupID string, eventID string, rsvp model.EventRSVP) error {
+	return sa.PerformTransaction(func(context TransactionContext) error {
+		event, err := sa.FindEvent(context, clientID, groupID, eventID)
+		if err != nil {
+			return err
+		}
+		if event != nil {
+			if existing := event.GetRSVP(rsvp.UserID); existing != nil {
+				rsvp.Status = existing.Status
+			}
+		}
+		return sa.replaceEventRSVP(context, clientID, groupID, eventID, rsvp)
+	})
+}
+
+func (sa *Adapter) replaceEventRSVP(context TransactionContext, clientID string, groupID string, eventID string, rsvp model.EventRSVP) error {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: eventID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	pull := bson.D{
+		primitive.E{Key: "$pull", Value: bson.D{
+			primitive.E{Key: "rsvps", Value: bson.M{"user_id": rsvp.UserID}},
+		}},
+	}
+	_, err := sa.db.events.UpdateOneWithContext(context, filter, pull, nil)
+	if err != nil {
+		return err
+	}
+
+	push := bson.D{
+		primitive.E{Key: "$push", Value: bson.D{
+			primitive.E{Key: "rsvps", Value: rsvp},
+		}},
+	}
+	_, err = sa.db.events.UpdateOneWithContext(context, filter, push, nil)
+	return err
+}
+
+// PullRSVPsFromEventsByUserIDs deletes the event RSVPs of the accounts
+func (sa *Adapter) PullRSVPsFromEventsByUserIDs(context TransactionContext, accountIDs []string) error {
+	filter := bson.D{
+		{Key: "rsvps.user_id", Value: bson.D{{Key: "$in", Value: accountIDs}}},
+	}
+	update := bson.D{
+		{Key: "$pull", Value: bson.D{
+			{Key: "rsvps", Value: bson.M{"user_id": bson.D{{Key: "$in", Value: accountIDs}}}},
+		}},
+	}
+
+	_, err := sa.db.events.UpdateManyWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindEventsDueReminder Finds the events starting within the period whose scheduled reminder has not been sent. The start within the series is used
+// for the occurrences of the recurring series.
+func (sa *Adapter) FindEventsDueReminder(context TransactionContext, clientID string, startAfter time.Time, startBefore time.Time) ([]model.Event, error) {
+	startRange := bson.M{"$gt": startAfter, "$lte": startBefore}
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "date_reminder_sent", Value: nil},
+		primitive.E{Key: "date_archived", Value: nil},
+		primitive.E{Key: "$or", Value: bson.A{
+			bson.M{"occurrence_start": startRange},
+			bson.M{"occurrence_start": nil, "date_start": startRange},
+		}},
+	}
+
+	var result []model.Event
+	err := sa.db.events.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClaimEventReminder Marks the scheduled reminder of the event as sent. It gives false if it has already been sent, e.g. by another instance.
+func (sa *Adapter) ClaimEventReminder(context TransactionContext, clientID string, groupID string, eventID string, date time.Time) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: eventID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "date_reminder_sent", Value: nil},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "date_reminder_sent", Value: date},
+	}}}
+
+	res, err := sa.db.events.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return res.ModifiedCount > 0, nil
+}
+
+// resetEventReminder unsets the sent scheduled reminder of the event if the start field changes
+func (sa *Adapter) resetEventReminder(context TransactionContext, filter bson.D, startField string, start *time.Time) error {
+	resetFilter := append(bson.D{}, filter...)
+	resetFilter = append(resetFilter,
+		primitive.E{Key: "date_reminder_sent", Value: bson.M{"$exists": true}},
+		primitive.E{Key: startField, Value: bson.M{"$ne": start}})
+	update := bson.D{primitive.E{Key: "$unset", Value: bson.D{
+		primitive.E{Key: "date_reminder_sent", Value: ""},
+	}}}
+
+	_, err := sa.db.events.UpdateOneWithContext(context, resetFilter, update, nil)
+	return err
+}
@@ -0,0 +1,97 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// InsertSeriesEvent Inserts the group mapping of an event which belongs to a recurring series
+func (sa *Adapter) InsertSeriesEvent(context TransactionContext, event model.Event) error {
+	wrapper := func(context TransactionContext) error {
+		_, err := sa.db.events.InsertOneWithContext(context, event)
+		if err != nil {
+			return err
+		}
+
+		return sa.UpdateGroupStats(context, event.ClientID, event.GroupID, true, false, false, false)
+	}
+
+	if context != nil {
+		return wrapper(context)
+	}
+	return sa.PerformTransaction(wrapper)
+}
+
+// FindEventSeries Finds the events of a series ordered by their start. The from time skips the earlier events.
+func (sa *Adapter) FindEventSeries(context TransactionContext, clientID string, groupID string, seriesID string, from *time.Time) ([]model.Event, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "series_id", Value: seriesID},
+	}
+	if from != nil {
+		filter = append(filter, primitive.E{Key: "occurrence_start", Value: bson.M{"$gte": *from}})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{primitive.E{Key: "occurrence_start", Value: 1}})
+
+	var result []model.Event
+	err := sa.db.events.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateSeriesEvent Updates the members and the start of an event within its series
+func (sa *Adapter) UpdateSeriesEvent(context TransactionContext, clientID string, groupID string, eventID string, toMemberList []model.ToMember, occurrenceStart *time.Time) error {
+	filter := bson.D{
+		primitive.E{Key: "event_id", Value: eventID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	err := sa.resetEventReminder(context, filter, "occurrence_start", occurrenceStart)
+	if err != nil {
+		return err
+	}
+
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "to_members", Value: toMemberList},
+		primitive.E{Key: "occurrence_start", Value: occurrenceStart},
+		primitive.E{Key: "date_updated", Value: time.Now()},
+	}}}
+	_, err = sa.db.events.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// DeleteEventsByIDs Deletes the group mappings of the events
+func (sa *Adapter) DeleteEventsByIDs(context TransactionContext, clientID string, groupID string, eventIDs []string) (int64, error) {
+	var deletedCount int64
+	wrapper := func(context TransactionContext) error {
+		filter := bson.D{
+			primitive.E{Key: "event_id", Value: bson.M{"$in": eventIDs}},
+			primitive.E{Key: "group_id", Value: groupID},
+			primitive.E{Key: "client_id", Value: clientID},
+		}
+		result, err := sa.db.events.DeleteManyWithContext(context, filter, nil)
+		if err != nil {
+			return err
+		}
+		deletedCount = result.DeletedCount
+
+		return sa.UpdateGroupStats(context, clientID, groupID, true, false, false, false)
+	}
+
+	var err error
+	if context != nil {
+		err = wrapper(context)
+	} else {
+		err = sa.PerformTransaction(wrapper)
+	}
+	return deletedCount, err
+}
@@ -266,6 +266,35 @@ func (sa *Adapter) FindGroupMembershipStatusAndGroupTitle(context TransactionCon
 	return results, nil
 }
 
+// FindUsersGroupMemberships Finds the group memberships status and group title of the users. The memberships of the deleted groups are skipped.
+func (sa *Adapter) FindUsersGroupMemberships(context TransactionContext, userIDs []string) ([]model.UserGroupMembership, error) {
+	pipeline := bson.A{
+		bson.D{{Key: "$match", Value: bson.D{{Key: "user_id", Value: bson.M{"$in": userIDs}}}}},
+		bson.D{{Key: "$lookup", Value: bson.D{
+			{Key: "from", Value: "groups"},
+			{Key: "localField", Value: "group_id"},
+			{Key: "foreignField", Value: "_id"},
+			{Key: "as", Value: "group_info"},
+		}}},
+		bson.D{{Key: "$unwind", Value: "$group_info"}},
+		bson.D{{Key: "$match", Value: bson.D{{Key: "group_info.date_deleted", Value: notDeletedGroupQuery}}}},
+		bson.D{{Key: "$project", Value: bson.D{
+			{Key: "user_id", Value: "$user_id"},
+			{Key: "group_id", Value: "$group_id"},
+			{Key: "title", Value: "$group_info.title"},
+			{Key: "status", Value: "$status"},
+		}}},
+	}
+
+	var results []model.UserGroupMembership
+	err := sa.db.groupMemberships.AggregateWithContext(context, pipeline, &results, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return results, nil
+}
+
 // FindGroupMembershipByGroupID Find group membership ids
 func (sa *Adapter) FindGroupMembershipByGroupID(context TransactionContext, groupID string) ([]string, error) {
 	filter := bson.D{primitive.E{Key: "group_id", Value: groupID}}
@@ -289,9 +318,9 @@ func (sa *Adapter) FindGroupMembershipByGroupID(context TransactionContext, grou
 	return userIDs, nil
 }
 
-// FindGroupsEvents Find group ID and event ID
+// FindGroupsEvents Find group ID and event ID. The mappings archived on the group deletion are skipped.
 func (sa *Adapter) FindGroupsEvents(context TransactionContext, eventIDs []string) ([]model.GetGroupsEvents, error) {
-	filter := bson.D{}
+	filter := bson.D{bson.E{Key: "date_archived", Value: nil}}
 
 	if len(eventIDs) > 0 {
 		filter = append(filter, bson.E{Key: "event_id", Value: bson.M{"$in": eventIDs}})
@@ -308,7 +337,7 @@ func (sa *Adapter) FindGroupsEvents(context TransactionContext, eventIDs []strin
 
 // FindGroupsByGroupIDs Find group by group ID
 func (sa *Adapter) FindGroupsByGroupIDs(groupIDs []string) ([]model.Group, error) {
-	filter := bson.D{}
+	filter := bson.D{bson.E{Key: "date_deleted", Value: notDeletedGroupQuery}}
 
 	if len(groupIDs) > 0 {
 		filter = append(filter, bson.E{Key: "_id", Value: bson.M{"$in": groupIDs}})
@@ -0,0 +1,113 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// findEventsPageFeaturedFirst finds a page of the events matching the filter sorted by the start date. The events featured at the moment
+// are placed at the beginning of the first page, the paging of the other events is shifted by their count.
+func (sa *Adapter) findEventsPageFeaturedFirst(filter bson.D, eventsFilter *model.EventsFilter) ([]model.Event, error) {
+	now := time.Now().UTC()
+	sort := bson.D{
+		primitive.E{Key: "date_start", Value: 1},
+		primitive.E{Key: "date_created", Value: 1},
+		primitive.E{Key: "event_id", Value: 1},
+	}
+
+	featuredFilter := append(bson.D{}, filter...)
+	featuredFilter = append(featuredFilter, primitive.E{Key: "featured_until", Value: primitive.M{"$gt": now}})
+	var featured []model.Event
+	err := sa.db.events.Find(featuredFilter, &featured, options.Find().SetSort(sort))
+	if err != nil {
+		return nil, err
+	}
+
+	var offset int64
+	if eventsFilter.Offset != nil && *eventsFilter.Offset > 0 {
+		offset = *eventsFilter.Offset
+	}
+	var limit int64
+	if eventsFilter.Limit != nil && *eventsFilter.Limit > 0 {
+		limit = *eventsFilter.Limit
+	}
+
+	result := []model.Event{}
+	featuredCount := int64(len(featured))
+	if offset < featuredCount {
+		result = append(result, featured[offset:]...)
+		if limit > 0 && int64(len(result)) >= limit {
+			return result[:limit], nil
+		}
+		offset = 0
+	} else {
+		offset -= featuredCount
+	}
+
+	findOptions := options.Find().SetSort(sort)
+	if offset > 0 {
+		findOptions.SetSkip(offset)
+	}
+	if limit > 0 {
+		findOptions.SetLimit(limit - int64(len(result)))
+	}
+
+	otherFilter := append(bson.D{}, filter...)
+	otherFilter = append(otherFilter, primitive.E{Key: "$nor", Value: []primitive.M{{"featured_until": primitive.M{"$gt": now}}}})
+	var others []model.Event
+	err = sa.db.events.Find(otherFilter, &others, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return append(result, others...), nil
+}
+
+// CountFeaturedEvents Counts the events of the group featured at the moment
+func (sa *Adapter) CountFeaturedEvents(context TransactionContext, clientID string, groupID string, now time.Time) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "featured_until", Value: primitive.M{"$gt": now}},
+	}
+	return sa.db.events.CountDocumentsWithContext(context, filter)
+}
+
+// UpdateEventFeaturedUntil Features the event of the group until the time. Passing nil stops featuring it. It gives false if the event mapping does not exist.
+func (sa *Adapter) UpdateEventFeaturedUntil(context TransactionContext, clientID string, groupID string, eventID string, featuredUntil *time.Time) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "event_id", Value: eventID},
+	}
+
+	var update bson.D
+	if featuredUntil != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "featured_until", Value: featuredUntil}}}}
+	} else {
+		update = bson.D{primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "featured_until", Value: ""}}}}
+	}
+
+	result, err := sa.db.events.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
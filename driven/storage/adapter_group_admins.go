@@ -0,0 +1,107 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// CountGroupAdmins counts the admins of the group except the provided users and memberships
+func (sa *Adapter) CountGroupAdmins(context TransactionContext, clientID string, groupID string, exceptUserIDs []string, exceptMembershipIDs []string) (int64, error) {
+	filter := bson.M{
+		"client_id": clientID,
+		"group_id":  groupID,
+		"status":    "admin",
+	}
+	if len(exceptUserIDs) > 0 {
+		filter["user_id"] = bson.M{"$nin": exceptUserIDs}
+	}
+	if len(exceptMembershipIDs) > 0 {
+		filter["_id"] = bson.M{"$nin": exceptMembershipIDs}
+	}
+	return sa.db.groupMemberships.CountDocumentsWithContext(context, filter)
+}
+
+// FindAdminMembershipsByUserIDs finds the admin memberships of the users within all the clients
+func (sa *Adapter) FindAdminMembershipsByUserIDs(context TransactionContext, userIDs []string) ([]model.GroupMembership, error) {
+	filter := bson.M{
+		"user_id": bson.M{"$in": userIDs},
+		"status":  "admin",
+	}
+
+	var result []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindLongestStandingMember finds the oldest member of the group linked to a Core BB account, except the provided users
+func (sa *Adapter) FindLongestStandingMember(context TransactionContext, clientID string, groupID string, exceptUserIDs []string) (*model.GroupMembership, error) {
+	filter := bson.M{
+		"client_id": clientID,
+		"group_id":  groupID,
+		"status":    "member",
+		"$and": []bson.M{
+			{"user_id": bson.M{"$nin": append([]string{""}, exceptUserIDs...)}},
+			{"user_id": bson.M{"$ne": nil}},
+		},
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_created", Value: 1}}).SetLimit(1)
+
+	var result []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+// TransferGroupAdmin makes the target membership an admin and the source membership a member of the group
+func (sa *Adapter) TransferGroupAdmin(context TransactionContext, clientID string, groupID string, fromMembershipID string, toMembershipID string) error {
+	err := sa.UpdateMembershipStatus(context, clientID, groupID, toMembershipID, "admin")
+	if err != nil {
+		return err
+	}
+	return sa.UpdateMembershipStatus(context, clientID, groupID, fromMembershipID, "member")
+}
+
+// UpdateMembershipStatus updates the status of the membership
+func (sa *Adapter) UpdateMembershipStatus(context TransactionContext, clientID string, groupID string, membershipID string, status string) error {
+	filter := bson.M{"_id": membershipID, "client_id": clientID, "group_id": groupID}
+	update := bson.M{"$set": bson.M{"status": status, "date_updated": time.Now().UTC()}}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// UpdateGroupAdminNeeded flags the group which has no admin for the org admins. Passing nil clears the flag.
+func (sa *Adapter) UpdateGroupAdminNeeded(context TransactionContext, clientID string, groupID string, date *time.Time) error {
+	filter := bson.M{"_id": groupID, "client_id": clientID}
+	var update bson.M
+	if date != nil {
+		update = bson.M{"$set": bson.M{"date_admin_needed": date}}
+	} else {
+		update = bson.M{"$unset": bson.M{"date_admin_needed": ""}}
+	}
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindGroupsNeedingAdmin finds the groups flagged as having no admin
+func (sa *Adapter) FindGroupsNeedingAdmin(context TransactionContext, clientID string) ([]model.Group, error) {
+	filter := bson.M{"client_id": clientID, "date_admin_needed": bson.M{"$ne": nil}, "date_deleted": notDeletedGroupQuery}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_admin_needed", Value: 1}})
+
+	var result []model.Group
+	err := sa.db.groups.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
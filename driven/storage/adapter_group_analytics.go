@@ -0,0 +1,166 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type weeklyCountResult struct {
+	ID struct {
+		Year int `bson:"year"`
+		Week int `bson:"week"`
+	} `bson:"_id"`
+	Count int64 `bson:"count"`
+}
+
+type distinctUserResult struct {
+	ID string `bson:"_id"`
+}
+
+// FindGroupWeeklyPostCounts counts the posts of the group per ISO week since the provided time
+func (sa *Adapter) FindGroupWeeklyPostCounts(context TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error) {
+	match := bson.M{
+		"client_id":    clientID,
+		"group_id":     groupID,
+		"date_deleted": nil,
+		"date_created": bson.M{"$gte": since},
+	}
+	return sa.aggregateWeeklyCounts(context, sa.db.posts, match)
+}
+
+// FindGroupWeeklyNewMemberCounts counts the new admins and members of the group per ISO week since the provided time
+func (sa *Adapter) FindGroupWeeklyNewMemberCounts(context TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error) {
+	match := bson.M{
+		"client_id":    clientID,
+		"group_id":     groupID,
+		"status":       bson.M{"$in": []string{"admin", "member"}},
+		"date_created": bson.M{"$gte": since},
+	}
+	return sa.aggregateWeeklyCounts(context, sa.db.groupMemberships, match)
+}
+
+// FindGroupWeeklyEventCounts counts the events linked to the group per ISO week since the provided time
+func (sa *Adapter) FindGroupWeeklyEventCounts(context TransactionContext, clientID string, groupID string, since time.Time) ([]model.WeeklyCount, error) {
+	match := bson.M{
+		"client_id":    clientID,
+		"group_id":     groupID,
+		"date_created": bson.M{"$gte": since},
+	}
+	return sa.aggregateWeeklyCounts(context, sa.db.events, match)
+}
+
+// CountGroupEvents counts all events linked to the group
+func (sa *Adapter) CountGroupEvents(context TransactionContext, clientID string, groupID string) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+	}
+	return sa.db.events.CountDocumentsWithContext(context, filter)
+}
+
+// CountGroupActiveMembers counts the distinct users who have posted or reacted within the group since the provided time
+func (sa *Adapter) CountGroupActiveMembers(context TransactionContext, clientID string, groupID string, since time.Time) (int64, error) {
+	userIDs := map[string]bool{}
+
+	var posters []distinctUserResult
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id":    clientID,
+			"group_id":     groupID,
+			"date_deleted": nil,
+			"date_created": bson.M{"$gte": since},
+			"status":       bson.M{"$nin": model.UnpublishedPostStatuses},
+		}},
+		{"$group": bson.M{"_id": "$member.user_id"}},
+	}
+	err := sa.db.posts.AggregateWithContext(context, pipeline, &posters, &options.AggregateOptions{})
+	if err != nil {
+		return 0, err
+	}
+	for _, item := range posters {
+		userIDs[item.ID] = true
+	}
+
+	var reactors []distinctUserResult
+	pipeline = []bson.M{
+		{"$match": bson.M{
+			"client_id":    clientID,
+			"group_id":     groupID,
+			"date_created": bson.M{"$gte": since},
+		}},
+		{"$group": bson.M{"_id": "$user_id"}},
+	}
+	err = sa.db.reactionEvents.AggregateWithContext(context, pipeline, &reactors, &options.AggregateOptions{})
+	if err != nil {
+		return 0, err
+	}
+	for _, item := range reactors {
+		userIDs[item.ID] = true
+	}
+
+	return int64(len(userIDs)), nil
+}
+
+// CountGroupRejectionsByReason counts the rejected membership requests of the group by the reason code. The requests rejected
+// with a free text reason are counted as "other".
+func (sa *Adapter) CountGroupRejectionsByReason(context TransactionContext, clientID string, groupID string) (map[string]int64, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id": clientID,
+			"group_id":  groupID,
+			"status":    "rejected",
+		}},
+		{"$group": bson.M{
+			"_id":   bson.M{"$ifNull": bson.A{"$reject_reason_code", ""}},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+
+	var result []struct {
+		ID    string `bson:"_id"`
+		Count int64  `bson:"count"`
+	}
+	err := sa.db.groupMemberships.AggregateWithContext(context, pipeline, &result, &options.AggregateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	counts := map[string]int64{}
+	for _, item := range result {
+		code := item.ID
+		if len(code) == 0 {
+			code = "other"
+		}
+		counts[code] += item.Count
+	}
+	return counts, nil
+}
+
+func (sa *Adapter) aggregateWeeklyCounts(context TransactionContext, collection *collectionWrapper, match bson.M) ([]model.WeeklyCount, error) {
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id": bson.M{
+				"year": bson.M{"$isoWeekYear": "$date_created"},
+				"week": bson.M{"$isoWeek": "$date_created"},
+			},
+			"count": bson.M{"$sum": 1},
+		}},
+	}
+
+	var result []weeklyCountResult
+	err := collection.AggregateWithContext(context, pipeline, &result, &options.AggregateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]model.WeeklyCount, len(result))
+	for i, item := range result {
+		list[i] = model.WeeklyCount{WeekStart: model.ISOWeekStart(item.ID.Year, item.ID.Week), Count: item.Count}
+	}
+	return list, nil
+}
@@ -0,0 +1,43 @@
+package storage
+
+import (
+	"groups/core/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindArchivedGroups finds the archived groups of the client, the latest first
+func (sa *Adapter) FindArchivedGroups(context TransactionContext, clientID string) ([]model.GroupArchiveItem, error) {
+	filter := bson.M{"client_id": clientID, "entity_type": model.GroupArchiveEntityGroup}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_archived", Value: -1}})
+
+	var result []model.GroupArchiveItem
+	err := sa.db.groupArchives.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindGroupArchiveItems finds the archived content of the group. The entity type is optional.
+func (sa *Adapter) FindGroupArchiveItems(context TransactionContext, clientID string, groupID string, entityType *string, offset *int64, limit *int64) ([]model.GroupArchiveItem, error) {
+	filter := bson.M{"client_id": clientID, "group_id": groupID}
+	if entityType != nil {
+		filter["entity_type"] = *entityType
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "entity_type", Value: 1}, {Key: "_id", Value: 1}})
+	if offset != nil {
+		findOptions.SetSkip(*offset)
+	}
+	if limit != nil {
+		findOptions.SetLimit(*limit)
+	}
+
+	var result []model.GroupArchiveItem
+	err := sa.db.groupArchives.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveGroupBan stores the ban of the user. Banning the same user again updates the reason.
+func (sa *Adapter) SaveGroupBan(context TransactionContext, ban model.GroupBan) (*model.GroupBan, error) {
+	filter := bson.M{"client_id": ban.ClientID, "group_id": ban.GroupID}
+	if len(ban.UserID) > 0 {
+		filter["user_id"] = ban.UserID
+	} else {
+		filter["external_id"] = ban.ExternalID
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"reason":    ban.Reason,
+			"banned_by": ban.BannedBy,
+		},
+		"$setOnInsert": bson.M{
+			"_id":          ban.ID,
+			"user_id":      ban.UserID,
+			"external_id":  ban.ExternalID,
+			"name":         ban.Name,
+			"net_id":       ban.NetID,
+			"date_created": ban.DateCreated,
+		},
+	}
+	findOptions := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After)
+
+	var result model.GroupBan
+	err := sa.db.groupBans.FindOneAndUpdateWithContext(context, filter, update, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FindGroupBans finds the bans of the group, the latest first
+func (sa *Adapter) FindGroupBans(context TransactionContext, clientID string, groupID string) ([]model.GroupBan, error) {
+	filter := bson.M{"client_id": clientID, "group_id": groupID}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_created", Value: -1}})
+
+	var result []model.GroupBan
+	err := sa.db.groupBans.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindGroupBan finds the ban of the user by the user id or the external id
+func (sa *Adapter) FindGroupBan(context TransactionContext, clientID string, groupID string, userID string, externalID string) (*model.GroupBan, error) {
+	identifiers := []bson.M{}
+	if len(userID) > 0 {
+		identifiers = append(identifiers, bson.M{"user_id": userID})
+	}
+	if len(externalID) > 0 {
+		identifiers = append(identifiers, bson.M{"external_id": externalID})
+	}
+	if len(identifiers) == 0 {
+		return nil, nil
+	}
+	filter := bson.M{"client_id": clientID, "group_id": groupID, "$or": identifiers}
+
+	var result []model.GroupBan
+	err := sa.db.groupBans.FindWithContext(context, filter, &result, options.Find().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return &result[0], nil
+}
+
+// FindGroupBannedExternalIDs finds the external ids of the users banned from the group
+func (sa *Adapter) FindGroupBannedExternalIDs(context TransactionContext, clientID string, groupID string) ([]string, error) {
+	filter := bson.M{"client_id": clientID, "group_id": groupID, "external_id": bson.M{"$nin": []interface{}{nil, ""}}}
+	findOptions := options.Find().SetProjection(bson.M{"external_id": 1})
+
+	var result []model.GroupBan
+	err := sa.db.groupBans.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	externalIDs := make([]string, len(result))
+	for i, ban := range result {
+		externalIDs[i] = ban.ExternalID
+	}
+	return externalIDs, nil
+}
+
+// DeleteGroupBan deletes the ban. It returns false if the ban does not exist.
+func (sa *Adapter) DeleteGroupBan(context TransactionContext, clientID string, groupID string, id string) (bool, error) {
+	filter := bson.M{"_id": id, "client_id": clientID, "group_id": groupID}
+	result, err := sa.db.groupBans.DeleteOneWithContext(context, filter, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// UpdateMembershipPostingMutedUntil sets the time until the member muted by an admin cannot post nor reply. Passing nil ends the mute.
+func (sa *Adapter) UpdateMembershipPostingMutedUntil(context TransactionContext, clientID string, membershipID string, mutedUntil *time.Time) error {
+	filter := bson.M{"_id": membershipID, "client_id": clientID}
+	var update bson.M
+	if mutedUntil != nil {
+		update = bson.M{"$set": bson.M{"posting_muted_until": mutedUntil, "date_updated": time.Now()}}
+	} else {
+		update = bson.M{"$unset": bson.M{"posting_muted_until": ""}, "$set": bson.M{"date_updated": time.Now()}}
+	}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
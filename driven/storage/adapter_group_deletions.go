@@ -0,0 +1,267 @@
+package storage
+
+import (
+	"errors"
+	"groups/core/model"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// defaultGroupDeletionJobsLimit the number of jobs given when no limit is requested
+const defaultGroupDeletionJobsLimit = 20
+
+// notDeletedGroupQuery matches the groups which are not deleted. The deleted groups are excluded from all the group queries.
+var notDeletedGroupQuery = bson.M{"$exists": false}
+
+// InsertGroupDeletionJob Stores a group deletion job
+func (sa *Adapter) InsertGroupDeletionJob(context TransactionContext, job model.GroupDeletionJob) error {
+	_, err := sa.db.groupDeletionJobs.InsertOneWithContext(context, job)
+	return err
+}
+
+// UpdateGroupDeletionJob Updates the progress and the status of a group deletion job
+func (sa *Adapter) UpdateGroupDeletionJob(context TransactionContext, job model.GroupDeletionJob) error {
+	filter := bson.M{"_id": job.ID, "client_id": job.ClientID}
+	update := bson.M{"$set": bson.M{
+		"status":       job.Status,
+		"step":         job.Step,
+		"processed":    job.Processed,
+		"errors":       job.Errors,
+		"date_updated": job.DateUpdated,
+		"date_ended":   job.DateEnded,
+	}}
+
+	_, err := sa.db.groupDeletionJobs.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindGroupDeletionJob Finds a group deletion job by id
+func (sa *Adapter) FindGroupDeletionJob(context TransactionContext, clientID string, id string) (*model.GroupDeletionJob, error) {
+	filter := bson.M{"_id": id, "client_id": clientID}
+
+	var list []model.GroupDeletionJob
+	err := sa.db.groupDeletionJobs.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return &list[0], nil
+}
+
+// FindGroupDeletionJobs Finds the last group deletion jobs ordered by start date (newest first)
+func (sa *Adapter) FindGroupDeletionJobs(context TransactionContext, clientID string, filter model.GroupDeletionJobFilter) ([]model.GroupDeletionJob, error) {
+	mongoFilter := bson.M{"client_id": clientID}
+	if filter.GroupID != nil {
+		mongoFilter["group_id"] = *filter.GroupID
+	}
+	if filter.Status != nil {
+		mongoFilter["status"] = *filter.Status
+	}
+
+	limit := int64(defaultGroupDeletionJobsLimit)
+	if filter.Limit != nil && *filter.Limit > 0 {
+		limit = *filter.Limit
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_started", Value: -1}}).SetLimit(limit)
+
+	list := []model.GroupDeletionJob{}
+	err := sa.db.groupDeletionJobs.FindWithContext(context, mongoFilter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// FindUnfinishedGroupDeletionJobs Finds the group deletion jobs of all the clients which have not succeeded yet and have not progressed
+// since the provided time
+func (sa *Adapter) FindUnfinishedGroupDeletionJobs(context TransactionContext, updatedBefore time.Time) ([]model.GroupDeletionJob, error) {
+	filter := bson.M{
+		"status": bson.M{"$ne": model.GroupDeletionJobStatusSucceeded},
+		"$or": []bson.M{
+			{"date_updated": bson.M{"$lt": updatedBefore}},
+			{"date_updated": nil, "date_started": bson.M{"$lt": updatedBefore}},
+		},
+	}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_started", Value: 1}})
+
+	list := []model.GroupDeletionJob{}
+	err := sa.db.groupDeletionJobs.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// MarkGroupDeleted Marks the group as deleted, so it is excluded from all the queries until its content is purged. It gives false if the
+// group is not found or it is already deleted.
+func (sa *Adapter) MarkGroupDeleted(context TransactionContext, clientID string, groupID string, date time.Time, datePurge time.Time) (bool, error) {
+	filter := bson.M{"_id": groupID, "client_id": clientID, "date_deleted": notDeletedGroupQuery}
+	update := bson.M{"$set": bson.M{"date_deleted": date, "date_purge": datePurge}}
+
+	res, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// RestoreGroup Restores the deleted group whose purge date has not passed yet. It gives false if there is no such group.
+func (sa *Adapter) RestoreGroup(context TransactionContext, clientID string, groupID string, now time.Time) (bool, error) {
+	filter := bson.M{"_id": groupID, "client_id": clientID, "date_deleted": bson.M{"$exists": true}, "date_purge": bson.M{"$gt": now}}
+	update := bson.M{"$unset": bson.M{"date_deleted": "", "date_purge": ""}}
+
+	res, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// FindDeletedGroups Finds the deleted groups of the client which could still be restored, the latest deleted first
+func (sa *Adapter) FindDeletedGroups(context TransactionContext, clientID string) ([]model.Group, error) {
+	filter := bson.M{"client_id": clientID, "date_deleted": bson.M{"$exists": true}, "date_purge": bson.M{"$exists": true}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_deleted", Value: -1}})
+
+	list := []model.Group{}
+	err := sa.db.groups.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// FindGroupsToPurge Finds the deleted groups of all the clients whose purge date has passed
+func (sa *Adapter) FindGroupsToPurge(context TransactionContext, now time.Time) ([]model.Group, error) {
+	filter := bson.M{"date_deleted": bson.M{"$exists": true}, "date_purge": bson.M{"$lte": now}}
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_purge", Value: 1}})
+
+	list := []model.Group{}
+	err := sa.db.groups.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ClaimGroupPurge Unsets the purge date of the deleted group once it has passed, so the group could not be restored any more and only
+// one purge starts. It gives false if the group has been restored or claimed in the meantime.
+func (sa *Adapter) ClaimGroupPurge(context TransactionContext, clientID string, groupID string, now time.Time) (bool, error) {
+	filter := bson.M{"_id": groupID, "client_id": clientID, "date_deleted": bson.M{"$exists": true}, "date_purge": bson.M{"$lte": now}}
+	update := bson.M{"$unset": bson.M{"date_purge": ""}}
+
+	res, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return res.MatchedCount > 0, nil
+}
+
+// ArchiveGroupEventMappings Marks the event mappings of the group as archived. It gives the number of the archived mappings.
+func (sa *Adapter) ArchiveGroupEventMappings(context TransactionContext, clientID string, groupID string) (int64, error) {
+	filter := bson.M{"group_id": groupID, "client_id": clientID, "date_archived": nil}
+	update := bson.M{"$set": bson.M{"date_archived": time.Now().UTC()}}
+
+	res, err := sa.db.events.UpdateManyWithContext(context, filter, update, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
+
+// DeleteGroupContentBatch Deletes up to limit items of the group for the deletion step. Passing the purge date moves the posts, the event
+// mappings and the group to the archive first, so the batch should run in a transaction then. It gives the number of the deleted items,
+// zero once the step is done.
+func (sa *Adapter) DeleteGroupContentBatch(context TransactionContext, clientID string, groupID string, step string, dateArchived time.Time,
+	datePurge *time.Time, limit int64) (int64, error) {
+	collection, entityType, filter := sa.groupDeletionSource(clientID, groupID, step)
+	if collection == nil {
+		return 0, errors.New("unsupported group deletion step: " + step)
+	}
+	archive := datePurge != nil && entityType != ""
+
+	findOptions := options.Find().SetLimit(limit)
+	if !archive {
+		findOptions.SetProjection(bson.M{"_id": 1})
+	}
+	var docs []bson.M
+	err := collection.FindWithContext(context, filter, &docs, findOptions)
+	if err != nil {
+		return 0, err
+	}
+	if len(docs) == 0 {
+		return 0, nil
+	}
+
+	ids := make([]interface{}, len(docs))
+	items := make([]interface{}, 0, len(docs))
+	for i, doc := range docs {
+		ids[i] = doc["_id"]
+		if archive {
+			entityID, _ := doc["_id"].(string)
+			items = append(items, model.GroupArchiveItem{
+				ID:           uuid.NewString(),
+				ClientID:     clientID,
+				GroupID:      groupID,
+				EntityType:   entityType,
+				EntityID:     entityID,
+				Data:         map[string]interface{}(doc),
+				DateArchived: dateArchived,
+				DatePurge:    *datePurge,
+			})
+		}
+	}
+
+	if len(items) > 0 {
+		_, err = sa.db.groupArchives.InsertManyWithContext(context, items, nil)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	res, err := collection.DeleteManyWithContext(context, bson.M{"_id": bson.M{"$in": ids}, "client_id": clientID}, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.DeletedCount, nil
+}
+
+// groupDeletionSource gives the collection, the archive entity type and the filter of the group items of the deletion step
+func (sa *Adapter) groupDeletionSource(clientID string, groupID string, step string) (*collectionWrapper, string, bson.M) {
+	filter := bson.M{"group_id": groupID, "client_id": clientID}
+	switch step {
+	case model.GroupDeletionStepMemberships:
+		return sa.db.groupMemberships, "", filter
+	case model.GroupDeletionStepPosts:
+		return sa.db.posts, model.GroupArchiveEntityPost, filter
+	case model.GroupDeletionStepPostRevisions:
+		return sa.db.postRevisions, "", filter
+	case model.GroupDeletionStepReadCursors:
+		return sa.db.groupReadCursors, "", filter
+	case model.GroupDeletionStepPostBookmarks:
+		return sa.db.postBookmarks, "", filter
+	case model.GroupDeletionStepBans:
+		return sa.db.groupBans, "", filter
+	case model.GroupDeletionStepMessages:
+		return sa.db.groupMessages, "", filter
+	case model.GroupDeletionStepEvents:
+		return sa.db.events, model.GroupArchiveEntityEvent, filter
+	case model.GroupDeletionStepEventCheckInCodes:
+		return sa.db.eventCheckInCodes, "", filter
+	case model.GroupDeletionStepEventAttendances:
+		return sa.db.eventAttendances, "", filter
+	case model.GroupDeletionStepWebhookDeliveries:
+		return sa.db.webhookDeliveries, "", bson.M{"event.group_id": groupID, "client_id": clientID}
+	case model.GroupDeletionStepNotificationBursts:
+		return sa.db.notificationBursts, "", filter
+	case model.GroupDeletionStepAbuseReports:
+		return sa.db.abuseReports, "", filter
+	case model.GroupDeletionStepGroup:
+		return sa.db.groups, model.GroupArchiveEntityGroup, bson.M{"_id": groupID, "client_id": clientID}
+	}
+	return nil, "", nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateGroupJoinRules Sets the join rules of the group. Passing nil removes the rules.
+func (sa *Adapter) UpdateGroupJoinRules(context TransactionContext, clientID string, groupID string, rules *model.GroupJoinRules) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: groupID}, primitive.E{Key: "client_id", Value: clientID}}
+
+	var update bson.D
+	if rules != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "join_rules", Value: rules},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "join_rules", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
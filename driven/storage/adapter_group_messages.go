@@ -0,0 +1,92 @@
+package storage
+
+import (
+	"errors"
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// groupMessagesTTL the group chat messages are kept for 30 days
+const groupMessagesTTL = 30 * 24 * time.Hour
+
+// InsertGroupMessage Inserts a group chat message
+func (sa *Adapter) InsertGroupMessage(context TransactionContext, message model.GroupMessage) error {
+	_, err := sa.db.groupMessages.InsertOneWithContext(context, message)
+	return err
+}
+
+// FindGroupMessages Finds a page of the group chat messages from the newest to the oldest one. The cursor points to the oldest message of the previous page.
+func (sa *Adapter) FindGroupMessages(context TransactionContext, clientID string, groupID string, cursor *model.GroupMessagesCursor, limit int64) ([]model.GroupMessage, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+	}
+	if cursor != nil {
+		filter = append(filter, primitive.E{Key: "$or", Value: []bson.M{
+			{"date_created": bson.M{"$lt": cursor.DateCreated}},
+			{"date_created": cursor.DateCreated, "_id": bson.M{"$lt": cursor.ID}},
+		}})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{
+		primitive.E{Key: "date_created", Value: -1},
+		primitive.E{Key: "_id", Value: -1},
+	})
+	findOptions.SetLimit(limit)
+
+	var result []model.GroupMessage
+	err := sa.db.groupMessages.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// DeleteGroupMessage Deletes a group chat message. The sender restricts the deletion to the own messages of the user.
+func (sa *Adapter) DeleteGroupMessage(context TransactionContext, clientID string, groupID string, messageID string, senderID *string) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: messageID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+	}
+	if senderID != nil {
+		filter = append(filter, primitive.E{Key: "sender.user_id", Value: *senderID})
+	}
+
+	result, err := sa.db.groupMessages.DeleteOneWithContext(context, filter, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.DeletedCount > 0, nil
+}
+
+// DeleteGroupMessagesByUserIDs Deletes the group chat messages of the given users
+func (sa *Adapter) DeleteGroupMessagesByUserIDs(context TransactionContext, accountIDs []string) error {
+	filter := bson.D{
+		primitive.E{Key: "sender.user_id", Value: bson.M{"$in": accountIDs}},
+	}
+	_, err := sa.db.groupMessages.DeleteManyWithContext(context, filter, nil)
+	return err
+}
+
+func decodeGroupMessage(fullDocument interface{}) (*model.GroupMessage, error) {
+	if fullDocument == nil {
+		return nil, errors.New("missing full document")
+	}
+	data, err := bson.Marshal(fullDocument)
+	if err != nil {
+		return nil, err
+	}
+
+	var message model.GroupMessage
+	err = bson.Unmarshal(data, &message)
+	if err != nil {
+		return nil, err
+	}
+	return &message, nil
+}
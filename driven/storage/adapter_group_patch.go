@@ -0,0 +1,124 @@
+package storage
+
+import (
+	"groups/core/model"
+	"groups/utils"
+	"strings"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PatchGroup Updates only the fields provided by the patch
+func (sa *Adapter) PatchGroup(context TransactionContext, clientID string, groupID string, patch model.GroupPatch) *utils.GroupError {
+	setOperation := bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}
+	if patch.Title != nil {
+		setOperation = append(setOperation, primitive.E{Key: "title", Value: *patch.Title})
+	}
+	if patch.Description != nil {
+		setOperation = append(setOperation, primitive.E{Key: "description", Value: patch.Description})
+	}
+	if patch.Categories != nil {
+		setOperation = append(setOperation, primitive.E{Key: "categories", Value: patch.Categories})
+	}
+	if patch.Category != nil {
+		setOperation = append(setOperation, primitive.E{Key: "category", Value: *patch.Category})
+		if patch.Attributes == nil {
+			setOperation = append(setOperation, primitive.E{Key: "attributes.category", Value: *patch.Category})
+		}
+	}
+	if patch.Tags != nil {
+		setOperation = append(setOperation, primitive.E{Key: "tags", Value: patch.Tags})
+		if patch.Attributes == nil {
+			setOperation = append(setOperation, primitive.E{Key: "attributes.tags", Value: patch.Tags})
+		}
+	}
+	if patch.Privacy != nil {
+		setOperation = append(setOperation, primitive.E{Key: "privacy", Value: *patch.Privacy})
+	}
+	if patch.HiddenForSearch != nil {
+		setOperation = append(setOperation, primitive.E{Key: "hidden_for_search", Value: *patch.HiddenForSearch})
+	}
+	if patch.ImageURL != nil {
+		setOperation = append(setOperation, primitive.E{Key: "image_url", Value: patch.ImageURL})
+	}
+	if patch.WebURL != nil {
+		setOperation = append(setOperation, primitive.E{Key: "web_url", Value: patch.WebURL})
+	}
+	if patch.OnlyAdminsCanCreatePolls != nil {
+		setOperation = append(setOperation, primitive.E{Key: "only_admins_can_create_polls", Value: *patch.OnlyAdminsCanCreatePolls})
+	}
+	if patch.CanJoinAutomatically != nil {
+		setOperation = append(setOperation, primitive.E{Key: "can_join_automatically", Value: *patch.CanJoinAutomatically})
+	}
+	if patch.BlockNewMembershipRequests != nil {
+		setOperation = append(setOperation, primitive.E{Key: "block_new_membership_requests", Value: *patch.BlockNewMembershipRequests})
+	}
+	if patch.AttendanceGroup != nil {
+		setOperation = append(setOperation, primitive.E{Key: "attendance_group", Value: *patch.AttendanceGroup})
+	}
+	if patch.ResearchOpen != nil {
+		setOperation = append(setOperation, primitive.E{Key: "research_open", Value: *patch.ResearchOpen})
+	}
+	if patch.ResearchConsentStatement != nil {
+		setOperation = append(setOperation, primitive.E{Key: "research_consent_statement", Value: *patch.ResearchConsentStatement})
+	}
+	if patch.ResearchConsentDetails != nil {
+		setOperation = append(setOperation, primitive.E{Key: "research_consent_details", Value: *patch.ResearchConsentDetails})
+	}
+	if patch.ResearchDescription != nil {
+		setOperation = append(setOperation, primitive.E{Key: "research_description", Value: *patch.ResearchDescription})
+	}
+	if patch.ResearchProfile != nil {
+		setOperation = append(setOperation, primitive.E{Key: "research_profile", Value: patch.ResearchProfile})
+	}
+	if patch.Settings != nil {
+		setOperation = append(setOperation, primitive.E{Key: "settings", Value: patch.Settings})
+	}
+	if patch.Attributes != nil {
+		setOperation = append(setOperation, primitive.E{Key: "attributes", Value: patch.Attributes})
+	}
+	if patch.Location != nil {
+		setOperation = append(setOperation, primitive.E{Key: "location", Value: patch.Location})
+	}
+	if patch.Localizations != nil {
+		setOperation = append(setOperation, primitive.E{Key: "localizations", Value: patch.Localizations})
+	}
+
+	wrapperFunc := func(context TransactionContext) error {
+		// Check the title is unique. Don't rely on the unique index.
+		if patch.Title != nil {
+			if err := sa.checkUniqueGroupTitleWithContext(context, clientID, &groupID, *patch.Title); err != nil {
+				return err
+			}
+		}
+
+		filter := bson.D{
+			primitive.E{Key: "_id", Value: groupID},
+			primitive.E{Key: "client_id", Value: clientID},
+			primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
+		}
+		update := bson.D{primitive.E{Key: "$set", Value: setOperation}}
+		_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+		return err
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
+	if err != nil {
+		if strings.Contains(err.Error(), "title_unique") {
+			return utils.NewGroupDuplicationError()
+		}
+		return utils.NewServerError()
+	}
+
+	if patch.Attributes != nil {
+		sa.UpdateGroupAttributeIndexes(&model.Group{Attributes: patch.Attributes})
+	}
+	return nil
+}
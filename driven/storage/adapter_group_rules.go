@@ -0,0 +1,46 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateGroupRules Sets the rules of the group. Passing nil removes the rules.
+func (sa *Adapter) UpdateGroupRules(context TransactionContext, clientID string, groupID string, rules *model.GroupRules) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: groupID}, primitive.E{Key: "client_id", Value: clientID}}
+
+	var update bson.D
+	if rules != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "rules", Value: rules},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "rules", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// UpdateMembershipRulesAcknowledgement Records the acknowledgement of the group rules version by the member
+func (sa *Adapter) UpdateMembershipRulesAcknowledgement(context TransactionContext, clientID string, groupID string, userID string, version int, dateAcknowledged time.Time) error {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "user_id", Value: userID},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "rules_acknowledged_version", Value: version},
+		primitive.E{Key: "date_rules_acknowledged", Value: dateAcknowledged},
+	}}}
+
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
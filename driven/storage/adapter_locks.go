@@ -0,0 +1,61 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// AcquireLock acquires or renews the lock for the owner. The lock is acquired only if it is free, expired or already held by the owner.
+func (sa *Adapter) AcquireLock(context TransactionContext, name string, owner string, lease time.Duration) (bool, error) {
+	now := time.Now().UTC()
+	filter := bson.M{
+		"_id": name,
+		"$or": []bson.M{
+			{"owner": owner},
+			{"date_expires": bson.M{"$lte": now}},
+		},
+	}
+	update := bson.M{
+		"$set": bson.M{
+			"owner":        owner,
+			"date_expires": now.Add(lease),
+		},
+		"$setOnInsert": bson.M{
+			"date_acquired": now,
+		},
+	}
+
+	opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+	var previous model.DistributedLock
+	err := sa.db.locks.FindOneAndUpdateWithContext(context, filter, update, &previous, opts)
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			// the lock is held by another owner
+			return false, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return false, err
+		}
+		// the lock has been created
+		return true, nil
+	}
+
+	if previous.Owner != owner {
+		// taken over from an expired owner
+		_, err = sa.db.locks.UpdateOneWithContext(context, bson.M{"_id": name, "owner": owner}, bson.M{"$set": bson.M{"date_acquired": now}}, nil)
+		if err != nil {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+// ReleaseLock releases the lock if it is held by the owner
+func (sa *Adapter) ReleaseLock(context TransactionContext, name string, owner string) error {
+	_, err := sa.db.locks.DeleteOneWithContext(context, bson.M{"_id": name, "owner": owner}, nil)
+	return err
+}
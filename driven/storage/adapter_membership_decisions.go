@@ -0,0 +1,64 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// ApplyPendingMembershipDecision approves or rejects a pending membership of the group. Gives nil if the membership is not pending in the group.
+// The group stats are not updated, the caller updates them once after all the decisions.
+func (sa *Adapter) ApplyPendingMembershipDecision(context TransactionContext, clientID string, groupID string, membershipID string, approve bool, rejection *model.MembershipRejection) (*model.GroupMembership, error) {
+	status := "rejected"
+	if approve {
+		status = "member"
+	}
+	if rejection == nil {
+		rejection = &model.MembershipRejection{}
+	}
+
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "status", Value: "pending"},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "status", Value: status},
+			primitive.E{Key: "reject_reason", Value: rejection.Reason},
+			primitive.E{Key: "reject_reason_code", Value: rejection.Code},
+			primitive.E{Key: "reject_reason_note", Value: rejection.Note},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		},
+		},
+	}
+	after := options.After
+	var membership model.GroupMembership
+	err := sa.db.groupMemberships.FindOneAndUpdateWithContext(context, filter, update, &membership, &options.FindOneAndUpdateOptions{ReturnDocument: &after})
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &membership, nil
+}
@@ -0,0 +1,113 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// membershipUserIndexName the name of the non-unique (client_id, group_id, user_id) index of the memberships
+	membershipUserIndexName = "client_id_1_group_id_1_user_id_1"
+	// membershipUserUniqueIndexName the name of the unique (client_id, group_id, user_id) index of the memberships which replaces the non-unique one
+	membershipUserUniqueIndexName = "client_id_1_group_id_1_user_id_1_unique"
+)
+
+// FindDuplicateMemberships Finds the (group, user) pairs of the client with more than one membership
+func (sa *Adapter) FindDuplicateMemberships(context TransactionContext, clientID string) ([]model.DuplicateMemberships, error) {
+	pipeline := duplicateMembershipsPipeline(&clientID, 0)
+
+	var result []model.DuplicateMemberships
+	err := sa.db.groupMemberships.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ApplyUniqueMembershipIndex Makes the (client_id, group_id, user_id) index of the memberships unique once there are no duplicate memberships
+func (sa *Adapter) ApplyUniqueMembershipIndex() error {
+	return sa.db.applyUniqueMembershipIndex(sa.db.groupMemberships)
+}
+
+// duplicateMembershipsPipeline gives the (group, user) pairs with more than one membership of the client, of all clients if not provided.
+// The number of the pairs is not limited if the limit is 0.
+func duplicateMembershipsPipeline(clientID *string, limit int64) []bson.M {
+	match := bson.M{"user_id": bson.M{"$nin": []interface{}{nil, ""}}}
+	group := bson.M{"group_id": "$group_id", "user_id": "$user_id"}
+	if clientID != nil {
+		match["client_id"] = *clientID
+	} else {
+		group["client_id"] = "$client_id"
+	}
+
+	pipeline := []bson.M{
+		{"$match": match},
+		{"$group": bson.M{
+			"_id":            group,
+			"membership_ids": bson.M{"$push": "$_id"},
+			"count":          bson.M{"$sum": 1},
+		}},
+		{"$match": bson.M{"count": bson.M{"$gt": 1}}},
+		{"$project": bson.M{"_id": 0, "group_id": "$_id.group_id", "user_id": "$_id.user_id", "membership_ids": 1}},
+		{"$sort": bson.M{"group_id": 1, "user_id": 1}},
+	}
+	if limit > 0 {
+		pipeline = append(pipeline, bson.M{"$limit": limit})
+	}
+	return pipeline
+}
+
+// MergeDuplicateMemberships Merges the memberships of the user within the group into a single one and updates the group stats.
+// It gives the number of the removed redundant memberships. A new transaction is started if no context is provided.
+func (sa *Adapter) MergeDuplicateMemberships(context TransactionContext, clientID string, groupID string, userID string) (int64, error) {
+	var deletedCount int64
+	wrapperFunc := func(context TransactionContext) error {
+		filter := bson.M{"client_id": clientID, "group_id": groupID, "user_id": userID}
+		var memberships []model.GroupMembership
+		err := sa.db.groupMemberships.FindWithContext(context, filter, &memberships, nil)
+		if err != nil {
+			return err
+		}
+		if len(memberships) < 2 {
+			return nil
+		}
+
+		merged, redundantIDs := model.MergeDuplicateMemberships(memberships)
+
+		_, err = sa.db.groupMemberships.DeleteManyWithContext(context, bson.M{"_id": bson.M{"$in": redundantIDs}}, nil)
+		if err != nil {
+			return err
+		}
+
+		update := bson.M{"$set": bson.M{
+			"status":                     merged.Status,
+			"external_id":                merged.ExternalID,
+			"member_answers":             merged.MemberAnswers,
+			"show_as_leader":             merged.ShowAsLeader,
+			"rules_acknowledged_version": merged.RulesAcknowledgedVersion,
+			"date_rules_acknowledged":    merged.DateRulesAcknowledged,
+			"date_created":               merged.DateCreated,
+			"date_updated":               time.Now(),
+		}}
+		_, err = sa.db.groupMemberships.UpdateOneWithContext(context, bson.M{"_id": merged.ID}, update, nil)
+		if err != nil {
+			return err
+		}
+		deletedCount = int64(len(redundantIDs))
+
+		return sa.UpdateGroupStats(context, clientID, groupID, false, true, false, true)
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return deletedCount, nil
+}
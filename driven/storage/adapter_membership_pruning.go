@@ -0,0 +1,89 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindMembershipPruningCandidates Finds a page of the member memberships of known users ordered by ID. The admins are never pruned.
+func (sa *Adapter) FindMembershipPruningCandidates(context TransactionContext, clientID string, afterID string, limit int64) ([]model.GroupMembership, error) {
+	filter := bson.M{
+		"client_id": clientID,
+		"status":    "member",
+		"user_id":   bson.M{"$nin": []interface{}{nil, ""}},
+	}
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "_id", Value: 1}})
+	findOptions.SetLimit(limit)
+
+	var memberships []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &memberships, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// UpdateMembershipsStaleFlag Sets the stale flag date of the memberships. Passing nil clears the flag.
+func (sa *Adapter) UpdateMembershipsStaleFlag(context TransactionContext, clientID string, ids []string, dateFlagged *time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+
+	filter := bson.M{"client_id": clientID, "_id": bson.M{"$in": ids}}
+	var update bson.M
+	if dateFlagged != nil {
+		update = bson.M{"$set": bson.M{"date_stale_flagged": dateFlagged}}
+	} else {
+		update = bson.M{"$unset": bson.M{"date_stale_flagged": ""}}
+	}
+
+	_, err := sa.db.groupMemberships.UpdateManyWithContext(context, filter, update, nil)
+	return err
+}
+
+// DeleteStaleGroupMemberships Deletes the flagged stale member memberships of the group and updates the group stats.
+// A new transaction is started if no context is provided.
+func (sa *Adapter) DeleteStaleGroupMemberships(context TransactionContext, clientID string, groupID string, ids []string) (int64, error) {
+	if len(ids) == 0 {
+		return 0, nil
+	}
+
+	var deletedCount int64 = 0
+	wrapperFunc := func(context TransactionContext) error {
+		filter := bson.M{
+			"client_id":          clientID,
+			"group_id":           groupID,
+			"_id":                bson.M{"$in": ids},
+			"status":             "member",
+			"date_stale_flagged": bson.M{"$ne": nil},
+		}
+
+		result, err := sa.db.groupMemberships.DeleteManyWithContext(context, filter, nil)
+		if err != nil {
+			return err
+		}
+
+		deletedCount = result.DeletedCount
+		if deletedCount > 0 {
+			return sa.UpdateGroupStats(context, clientID, groupID, false, true, false, true)
+		}
+
+		return nil
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
+	return deletedCount, err
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// UpdateMembershipTransition Schedules the status change of a member or an admin of the group. Passing nil cancels the scheduled change.
+// It gives false if there is no such membership.
+func (sa *Adapter) UpdateMembershipTransition(context TransactionContext, clientID string, groupID string, membershipID string, transition *model.MembershipTransition) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "status", Value: bson.M{"$in": []string{"admin", "member"}}},
+	}
+
+	var update bson.D
+	if transition != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "scheduled_transition", Value: transition}}}}
+	} else {
+		update = bson.D{primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "scheduled_transition", Value: ""}}}}
+	}
+
+	result, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// FindDueMembershipTransitions Finds the memberships with the scheduled status changes which take effect until the date
+func (sa *Adapter) FindDueMembershipTransitions(context TransactionContext, clientID string, until time.Time, limit int64) ([]model.GroupMembership, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "scheduled_transition.date_effective", Value: bson.M{"$lte": until}},
+	}
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "scheduled_transition.date_effective", Value: 1}}).SetLimit(limit)
+
+	var result []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ClaimMembershipTransition Clears the scheduled status change before it is applied, so it is applied once. It gives false if the change
+// has been cancelled or rescheduled in the meantime.
+func (sa *Adapter) ClaimMembershipTransition(context TransactionContext, clientID string, membershipID string, dateEffective time.Time) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "scheduled_transition.date_effective", Value: dateEffective},
+	}
+	update := bson.D{primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "scheduled_transition", Value: ""}}}}
+
+	result, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.ModifiedCount > 0, nil
+}
@@ -0,0 +1,94 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateMembershipNotificationsPreferences Updates the notifications preferences of a membership
+func (sa *Adapter) UpdateMembershipNotificationsPreferences(context TransactionContext, clientID string, membershipID string, preferences model.NotificationsPreferences) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "notifications_preferences", Value: preferences},
+		primitive.E{Key: "date_updated", Value: time.Now()},
+	}}}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// UpdateMembershipMutedUntil sets the time until the notifications of the membership are muted. Passing nil unmutes them.
+func (sa *Adapter) UpdateMembershipMutedUntil(context TransactionContext, clientID string, membershipID string, mutedUntil *time.Time) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	var update bson.D
+	if mutedUntil != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "muted_until", Value: mutedUntil},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "muted_until", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// UpdateMembershipSnoozedUntil sets the time until the immediate notifications of the membership are snoozed. Passing nil ends the snooze.
+func (sa *Adapter) UpdateMembershipSnoozedUntil(context TransactionContext, clientID string, membershipID string, snoozedUntil *time.Time) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: membershipID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	var update bson.D
+	if snoozedUntil != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "snoozed_until", Value: snoozedUntil},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "snoozed_until", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+	_, err := sa.db.groupMemberships.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindMutedMemberUserIDs finds which of the provided users have muted or snoozed the notifications of the group at the moment. Passing includeDigest
+// gives the users in the digest mode too.
+func (sa *Adapter) FindMutedMemberUserIDs(context TransactionContext, groupID string, userIDs []string, includeDigest bool) ([]string, error) {
+	now := time.Now().UTC()
+	mutedFilter := []bson.M{{"muted_until": bson.M{"$gt": now}}, {"snoozed_until": bson.M{"$gt": now}}}
+	if includeDigest {
+		mutedFilter = append(mutedFilter, bson.M{"digest_frequency": bson.M{"$in": []string{model.DigestFrequencyDaily, model.DigestFrequencyWeekly}}})
+	}
+	filter := bson.D{
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "user_id", Value: bson.M{"$in": userIDs}},
+		primitive.E{Key: "$or", Value: mutedFilter},
+	}
+
+	var memberships []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &memberships, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	userIDsList := make([]string, len(memberships))
+	for i, membership := range memberships {
+		userIDsList[i] = membership.UserID
+	}
+	return userIDsList, nil
+}
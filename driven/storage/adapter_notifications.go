@@ -0,0 +1,100 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// userNotificationsTTL defines how long the inbox entries are kept before being expired by the TTL index
+const userNotificationsTTL = 90 * 24 * time.Hour
+
+// InsertUserNotifications Stores the inbox entries of a sent notification
+func (sa *Adapter) InsertUserNotifications(context TransactionContext, items []model.UserNotification) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(items))
+	for i, item := range items {
+		documents[i] = item
+	}
+
+	_, err := sa.db.userNotifications.InsertManyWithContext(context, documents, nil)
+	return err
+}
+
+// FindUserNotifications Finds the inbox entries of the user within the app and the org. Entries scheduled for the future are excluded.
+func (sa *Adapter) FindUserNotifications(context TransactionContext, appID string, orgID string, userID string, filter model.UserNotificationsFilter) ([]model.UserNotification, error) {
+	mongoFilter := bson.D{
+		primitive.E{Key: "app_id", Value: appID},
+		primitive.E{Key: "org_id", Value: orgID},
+		primitive.E{Key: "user_id", Value: userID},
+		primitive.E{Key: "date_created", Value: bson.M{"$lte": time.Now()}},
+	}
+	if filter.Read != nil {
+		mongoFilter = append(mongoFilter, primitive.E{Key: "read", Value: *filter.Read})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
+	if filter.Limit != nil {
+		findOptions.SetLimit(*filter.Limit)
+	}
+	if filter.Offset != nil {
+		findOptions.SetSkip(*filter.Offset)
+	}
+
+	list := []model.UserNotification{}
+	err := sa.db.userNotifications.FindWithContext(context, mongoFilter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// CountUnreadUserNotifications Counts the unread inbox entries of the user within the app and the org
+func (sa *Adapter) CountUnreadUserNotifications(context TransactionContext, appID string, orgID string, userID string) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "app_id", Value: appID},
+		primitive.E{Key: "org_id", Value: orgID},
+		primitive.E{Key: "user_id", Value: userID},
+		primitive.E{Key: "read", Value: false},
+		primitive.E{Key: "date_created", Value: bson.M{"$lte": time.Now()}},
+	}
+	return sa.db.userNotifications.CountDocumentsWithContext(context, filter)
+}
+
+// UpdateUserNotificationsRead Updates the read state of the user inbox entries within the app and the org. Empty ids list means all entries of the user.
+func (sa *Adapter) UpdateUserNotificationsRead(context TransactionContext, appID string, orgID string, userID string, ids []string, read bool) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "app_id", Value: appID},
+		primitive.E{Key: "org_id", Value: orgID},
+		primitive.E{Key: "user_id", Value: userID},
+	}
+	if len(ids) > 0 {
+		filter = append(filter, primitive.E{Key: "_id", Value: bson.M{"$in": ids}})
+	}
+
+	var dateRead *time.Time
+	if read {
+		now := time.Now()
+		dateRead = &now
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "read", Value: read},
+			primitive.E{Key: "date_read", Value: dateRead},
+		}},
+	}
+
+	res, err := sa.db.userNotifications.UpdateManyWithContext(context, filter, update, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.ModifiedCount, nil
+}
@@ -0,0 +1,83 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdateGroupOrgUnit Sets the org unit of the group. Passing nil removes the group from the hierarchy.
+func (sa *Adapter) UpdateGroupOrgUnit(context TransactionContext, clientID string, groupID string, orgUnit *model.GroupOrgUnit) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: groupID}, primitive.E{Key: "client_id", Value: clientID}}
+
+	var update bson.D
+	if orgUnit != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "org_unit", Value: orgUnit},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "org_unit", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// FindGroupOrgUnitCodes Finds the distinct org unit codes of the groups of the client
+func (sa *Adapter) FindGroupOrgUnitCodes(context TransactionContext, clientID string) ([]string, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{"client_id": clientID, "org_unit.code": bson.M{"$exists": true}, "date_deleted": notDeletedGroupQuery}},
+		bson.M{"$group": bson.M{"_id": "$org_unit.code"}},
+	}
+
+	var result []struct {
+		Code string `bson:"_id"`
+	}
+	err := sa.db.groups.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	codes := make([]string, len(result))
+	for i, item := range result {
+		codes[i] = item.Code
+	}
+	return codes, nil
+}
+
+// UpdateGroupsOrgUnit Updates the org unit name and path of all the groups of the unit
+func (sa *Adapter) UpdateGroupsOrgUnit(context TransactionContext, clientID string, orgUnit model.GroupOrgUnit) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "org_unit.code", Value: orgUnit.Code},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "org_unit", Value: orgUnit},
+	}}}
+
+	result, err := sa.db.groups.UpdateManyWithContext(context, filter, update, nil)
+	if err != nil {
+		return 0, err
+	}
+	return result.ModifiedCount, nil
+}
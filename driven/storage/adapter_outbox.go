@@ -0,0 +1,57 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// outboxEventsTTL the outbox events are kept for 7 days, the undelivered events have exhausted the attempts long before
+const outboxEventsTTL = 7 * 24 * time.Hour
+
+// InsertOutboxEvent stores a domain event in the outbox. Pass the transaction context of the operation, so the event is stored only if the
+// operation is committed.
+func (sa *Adapter) InsertOutboxEvent(context TransactionContext, event model.OutboxEvent) error {
+	_, err := sa.db.outboxEvents.InsertOneWithContext(context, event)
+	return err
+}
+
+// FindPendingOutboxEvents finds the pending outbox events which are due for an attempt in the order they were emitted
+func (sa *Adapter) FindPendingOutboxEvents(context TransactionContext, limit int64) ([]model.OutboxEvent, error) {
+	filter := bson.D{
+		primitive.E{Key: "status", Value: model.OutboxEventStatusPending},
+		primitive.E{Key: "next_attempt", Value: bson.M{"$lte": time.Now().UTC()}},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: 1}})
+	findOptions.SetLimit(limit)
+
+	list := []model.OutboxEvent{}
+	err := sa.db.outboxEvents.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateOutboxEvent updates the state of an outbox event after a publish attempt
+func (sa *Adapter) UpdateOutboxEvent(context TransactionContext, event model.OutboxEvent) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: event.ID}}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "status", Value: event.Status},
+			primitive.E{Key: "attempts", Value: event.Attempts},
+			primitive.E{Key: "last_error", Value: event.LastError},
+			primitive.E{Key: "next_attempt", Value: event.NextAttempt},
+			primitive.E{Key: "date_updated", Value: event.DateUpdated},
+		}},
+	}
+
+	_, err := sa.db.outboxEvents.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindExpiredPendingMemberships Finds the pending memberships created before the date ordered by ID, starting after the provided ID
+func (sa *Adapter) FindExpiredPendingMemberships(context TransactionContext, clientID string, createdBefore time.Time, afterID string, limit int64) ([]model.GroupMembership, error) {
+	filter := bson.M{
+		"client_id":    clientID,
+		"status":       "pending",
+		"date_created": bson.M{"$lt": createdBefore},
+	}
+	if afterID != "" {
+		filter["_id"] = bson.M{"$gt": afterID}
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "_id", Value: 1}})
+	findOptions.SetLimit(limit)
+
+	var memberships []model.GroupMembership
+	err := sa.db.groupMemberships.FindWithContext(context, filter, &memberships, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// FindPendingMembershipsSummaries Gives the count and the oldest date of the pending memberships created before the date per group
+func (sa *Adapter) FindPendingMembershipsSummaries(context TransactionContext, clientID string, createdBefore time.Time) ([]model.PendingMembershipsSummary, error) {
+	pipeline := bson.A{
+		bson.M{"$match": bson.M{
+			"client_id":    clientID,
+			"status":       "pending",
+			"date_created": bson.M{"$lt": createdBefore},
+		}},
+		bson.M{"$group": bson.M{
+			"_id":         "$group_id",
+			"count":       bson.M{"$sum": 1},
+			"date_oldest": bson.M{"$min": "$date_created"},
+		}},
+	}
+
+	var result []model.PendingMembershipsSummary
+	err := sa.db.groupMemberships.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// UpdateGroupPendingReminderSent Sets the date of the last reminder about the outstanding membership requests of the group
+func (sa *Adapter) UpdateGroupPendingReminderSent(context TransactionContext, clientID string, groupID string, date time.Time) error {
+	filter := bson.M{"_id": groupID, "client_id": clientID}
+	update := bson.M{"$set": bson.M{"date_pending_reminder_sent": date}}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
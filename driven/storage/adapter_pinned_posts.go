@@ -0,0 +1,49 @@
+package storage
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// CountPinnedPosts Counts the pinned posts of a group
+func (sa *Adapter) CountPinnedPosts(context TransactionContext, clientID string, groupID string) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "pinned", Value: true},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	return sa.db.posts.CountDocumentsWithContext(context, filter)
+}
+
+// UpdatePostPinned Pins or unpins a top level post of a group. It gives false if the post does not exist.
+func (sa *Adapter) UpdatePostPinned(context TransactionContext, clientID string, groupID string, postID string, pinned bool) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: postID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "parent_id", Value: nil},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+
+	var update bson.D
+	if pinned {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "pinned", Value: true},
+			primitive.E{Key: "date_pinned", Value: time.Now().UTC()},
+		}}}
+	} else {
+		update = bson.D{primitive.E{Key: "$unset", Value: bson.D{
+			primitive.E{Key: "pinned", Value: ""},
+			primitive.E{Key: "date_pinned", Value: ""},
+		}}}
+	}
+
+	result, err := sa.db.posts.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindPendingApprovalPosts Finds the posts of the group waiting for an admin approval ordered by the creation date (oldest first)
+func (sa *Adapter) FindPendingApprovalPosts(context TransactionContext, clientID string, groupID string) ([]model.Post, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "status", Value: model.PostStatusPendingApproval},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "date_created", Value: 1}})
+
+	list := []model.Post{}
+	err := sa.db.posts.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// ApprovePendingPost Publishes the post waiting for an approval. The creation date is the approval date or the scheduled date, so the post
+// is ordered as a new one. It gives false if there is no such pending post.
+func (sa *Adapter) ApprovePendingPost(context TransactionContext, clientID string, post *model.Post) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: post.ID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "status", Value: model.PostStatusPendingApproval},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "date_created", Value: post.DateCreated},
+			primitive.E{Key: "date_scheduled", Value: post.DateScheduled},
+			primitive.E{Key: "date_moderated", Value: post.DateModerated},
+			primitive.E{Key: "date_notification_deferred", Value: post.DateNotificationDeferred},
+		}},
+		primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "status", Value: ""}}},
+	}
+
+	result, err := sa.db.posts.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, nil
+	}
+
+	return true, sa.UpdateGroupStats(context, clientID, post.GroupID, true, false, false, false)
+}
+
+// RejectPendingPost Rejects the post waiting for an approval. The post stays visible only to its creator. It gives false if there is no such pending post.
+func (sa *Adapter) RejectPendingPost(context TransactionContext, clientID string, groupID string, postID string, reason string) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: postID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "status", Value: model.PostStatusPendingApproval},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "status", Value: model.PostStatusRejected},
+			primitive.E{Key: "rejection_reason", Value: reason},
+			primitive.E{Key: "date_moderated", Value: time.Now()},
+		}},
+	}
+
+	result, err := sa.db.posts.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
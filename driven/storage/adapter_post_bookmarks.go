@@ -0,0 +1,86 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SavePostBookmark Saves the bookmark of the post. Bookmarking the post again keeps the original bookmark.
+func (sa *Adapter) SavePostBookmark(context TransactionContext, bookmark model.PostBookmark) (*model.PostBookmark, error) {
+	filter := bson.M{"client_id": bookmark.ClientID, "user_id": bookmark.UserID, "post_id": bookmark.PostID}
+	update := bson.M{"$setOnInsert": bookmark}
+
+	var result model.PostBookmark
+	err := sa.db.postBookmarks.FindOneAndUpdateWithContext(context, filter, update, &result,
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After))
+	if err != nil {
+		return nil, err
+	}
+	return &result, nil
+}
+
+// DeletePostBookmark Deletes the bookmark of the post
+func (sa *Adapter) DeletePostBookmark(context TransactionContext, clientID string, userID string, postID string) error {
+	filter := bson.M{"client_id": clientID, "user_id": userID, "post_id": postID}
+	_, err := sa.db.postBookmarks.DeleteOneWithContext(context, filter, nil)
+	return err
+}
+
+// FindPostBookmarks Finds the bookmarks of the user ordered by the bookmark date (newest first)
+func (sa *Adapter) FindPostBookmarks(context TransactionContext, clientID string, userID string, offset *int64, limit *int64) ([]model.PostBookmark, error) {
+	filter := bson.M{"client_id": clientID, "user_id": userID}
+
+	findOptions := options.Find().SetSort(bson.D{{Key: "date_created", Value: -1}, {Key: "_id", Value: 1}})
+	if offset != nil {
+		findOptions.SetSkip(*offset)
+	}
+	if limit != nil {
+		findOptions.SetLimit(*limit)
+	}
+
+	var result []model.PostBookmark
+	err := sa.db.postBookmarks.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// FindVisiblePosts Finds the posts within the groups which are visible to the user. The deleted, the scheduled and the unpublished posts
+// of the others and the messages to the other members are not given.
+func (sa *Adapter) FindVisiblePosts(context TransactionContext, clientID string, userID string, groupIDs []string, postIDs []string) ([]model.Post, error) {
+	if len(groupIDs) == 0 || len(postIDs) == 0 {
+		return nil, nil
+	}
+
+	filter := bson.M{
+		"client_id":    clientID,
+		"_id":          bson.M{"$in": postIDs},
+		"group_id":     bson.M{"$in": groupIDs},
+		"date_deleted": nil,
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"date_scheduled": nil},
+				{"date_scheduled": bson.M{"$lt": time.Now()}},
+				{"member.user_id": userID},
+			}},
+			{"$or": []bson.M{
+				{"to_members": nil},
+				{"to_members": bson.M{"$size": 0}},
+				{"to_members.user_id": userID},
+				{"member.user_id": userID},
+			}},
+			unpublishedPostsVisibilityFilter(&userID),
+		},
+	}
+
+	var result []model.Post
+	err := sa.db.posts.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,82 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// unpublishedPostsVisibilityFilter matches the published posts and the unpublished posts (drafts, pending approval, rejected) of the user.
+// Only the published posts are matched if the user is nil.
+func unpublishedPostsVisibilityFilter(userID *string) bson.M {
+	if userID == nil {
+		return bson.M{"status": bson.M{"$nin": model.UnpublishedPostStatuses}}
+	}
+	return bson.M{"$or": []bson.M{
+		{"status": bson.M{"$nin": model.UnpublishedPostStatuses}},
+		{"member.user_id": *userID},
+	}}
+}
+
+// FindDraftPosts Finds the draft posts of the user within the group ordered by the last change (newest first)
+func (sa *Adapter) FindDraftPosts(context TransactionContext, clientID string, groupID string, userID string) ([]model.Post, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "member.user_id", Value: userID},
+		primitive.E{Key: "status", Value: model.PostStatusDraft},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	findOptions := options.Find().SetSort(bson.D{
+		primitive.E{Key: "date_updated", Value: -1},
+		primitive.E{Key: "date_created", Value: -1},
+	})
+
+	list := []model.Post{}
+	err := sa.db.posts.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// PublishDraftPost Publishes the draft post of the user. The creation date is the publishing date or the scheduled date, so the post is ordered
+// as a new one. The post keeps its status if it is set, e.g. when the post waits for an approval. It gives false if there is no such draft.
+func (sa *Adapter) PublishDraftPost(context TransactionContext, clientID string, userID string, post *model.Post) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: post.ID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "member.user_id", Value: userID},
+		primitive.E{Key: "status", Value: model.PostStatusDraft},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	setFields := bson.D{
+		primitive.E{Key: "date_created", Value: post.DateCreated},
+		primitive.E{Key: "date_scheduled", Value: post.DateScheduled},
+		primitive.E{Key: "date_updated", Value: time.Now()},
+		primitive.E{Key: "date_notification_deferred", Value: post.DateNotificationDeferred},
+	}
+	update := bson.D{}
+	if post.IsPublished() {
+		update = append(update, primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "status", Value: ""}}})
+	} else {
+		setFields = append(setFields, primitive.E{Key: "status", Value: post.Status})
+	}
+	update = append(update, primitive.E{Key: "$set", Value: setFields})
+
+	result, err := sa.db.posts.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	if result.MatchedCount == 0 {
+		return false, nil
+	}
+	if !post.IsPublished() {
+		return true, nil
+	}
+
+	return true, sa.UpdateGroupStats(context, clientID, post.GroupID, true, false, false, false)
+}
@@ -0,0 +1,105 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// RecordPostNotificationBurst Records the post notification within the burst of the group. Gives true if the post falls within the window
+// of a running burst, so its notification is batched. Otherwise a new burst is started and the burst it replaced is given, if any.
+func (sa *Adapter) RecordPostNotificationBurst(context TransactionContext, clientID string, groupID string, creatorID string, now time.Time,
+	window time.Duration) (bool, *model.PostNotificationBurst, error) {
+	// the new burst could be started by another instance in the meantime, so the running burst is checked once more
+	for attempt := 0; attempt < 2; attempt++ {
+		runningFilter := bson.M{"client_id": clientID, "group_id": groupID, "date_ends": bson.M{"$gt": now}}
+		runningUpdate := bson.M{
+			"$inc":      bson.M{"count": 1},
+			"$addToSet": bson.M{"creator_ids": creatorID},
+		}
+		var running model.PostNotificationBurst
+		err := sa.db.notificationBursts.FindOneAndUpdateWithContext(context, runningFilter, runningUpdate, &running, nil)
+		if err == nil {
+			return true, nil, nil
+		}
+		if err != mongo.ErrNoDocuments {
+			return false, nil, err
+		}
+
+		endedFilter := bson.M{"client_id": clientID, "group_id": groupID, "date_ends": bson.M{"$lte": now}}
+		startUpdate := bson.M{
+			"$set": bson.M{
+				"count":        1,
+				"creator_ids":  []string{},
+				"date_started": now,
+				"date_ends":    now.Add(window),
+			},
+			"$setOnInsert": bson.M{"_id": uuid.NewString()},
+		}
+		opts := options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.Before)
+		var ended model.PostNotificationBurst
+		err = sa.db.notificationBursts.FindOneAndUpdateWithContext(context, endedFilter, startUpdate, &ended, opts)
+		if err == nil {
+			return false, &ended, nil
+		}
+		if err == mongo.ErrNoDocuments {
+			// the first burst of the group
+			return false, nil, nil
+		}
+		if !mongo.IsDuplicateKeyError(err) {
+			return false, nil, err
+		}
+	}
+	return false, nil, nil
+}
+
+// ClaimEndedPostNotificationBurst Removes an ended burst, so only the claiming instance sends its summary. Gives nil if there is no ended burst.
+func (sa *Adapter) ClaimEndedPostNotificationBurst(context TransactionContext, now time.Time) (*model.PostNotificationBurst, error) {
+	filter := bson.M{"date_ends": bson.M{"$lte": now}}
+
+	var result model.PostNotificationBurst
+	err := sa.db.notificationBursts.FindOneAndDeleteWithContext(context, filter, &result, nil)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &result, nil
+}
+
+// FindPostNotificationBatchingConfig finds the post notification batching config for the specified clientID
+func (sa *Adapter) FindPostNotificationBatchingConfig(context TransactionContext, clientID string) (*model.PostNotificationBatchingConfig, error) {
+	filter := bson.M{"type": "post_notification_batching", "client_id": clientID}
+
+	var configs []model.PostNotificationBatchingConfig
+	err := sa.db.configs.FindWithContext(context, filter, &configs, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(configs) == 0 {
+		return nil, nil
+	}
+
+	return &configs[0], nil
+}
+
+// SavePostNotificationBatchingConfig saves the provided post notification batching config fields
+func (sa *Adapter) SavePostNotificationBatchingConfig(context TransactionContext, config model.PostNotificationBatchingConfig) error {
+	filter := bson.M{"type": "post_notification_batching", "client_id": config.ClientID}
+
+	config.Type = "post_notification_batching"
+
+	upsert := true
+	opts := options.ReplaceOptions{Upsert: &upsert}
+	err := sa.db.configs.ReplaceOne(filter, config, &opts)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,29 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// UpdatePostToMembers Sets the recipients of a post. It gives false if the post does not exist.
+func (sa *Adapter) UpdatePostToMembers(context TransactionContext, clientID string, groupID string, postID string, toMembers []model.ToMember) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: postID},
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "date_deleted", Value: nil},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "to_members", Value: toMembers},
+		primitive.E{Key: "date_updated", Value: time.Now()},
+	}}}
+
+	result, err := sa.db.posts.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
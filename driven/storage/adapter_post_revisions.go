@@ -0,0 +1,25 @@
+package storage
+
+import (
+	"groups/core/model"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindPostRevisions Finds the previous revisions of a post ordered by the edit date (newest first)
+func (sa *Adapter) FindPostRevisions(context TransactionContext, clientID string, postID string) ([]model.PostRevision, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "post_id", Value: postID},
+	}
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "date_created", Value: -1}})
+
+	var list []model.PostRevision
+	err := sa.db.postRevisions.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -0,0 +1,45 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindPublicFeedPosts finds the latest published top level posts of the group which are visible to everyone. The private, the reported
+// and the scheduled posts and the messages to specific members are not given. The creators are not loaded.
+func (sa *Adapter) FindPublicFeedPosts(context TransactionContext, clientID string, groupID string, limit int64) ([]model.Post, error) {
+	filter := bson.M{
+		"client_id":    clientID,
+		"group_id":     groupID,
+		"parent_id":    nil,
+		"date_deleted": nil,
+		"private":      false,
+		"is_abuse":     bson.M{"$ne": true},
+		"status":       bson.M{"$nin": model.UnpublishedPostStatuses},
+		"$and": []bson.M{
+			{"$or": []bson.M{
+				{"date_scheduled": nil},
+				{"date_scheduled": bson.M{"$lt": time.Now()}},
+			}},
+			{"$or": []bson.M{
+				{"to_members": nil},
+				{"to_members": bson.M{"$size": 0}},
+			}},
+		},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
+	findOptions.SetLimit(limit)
+	findOptions.SetProjection(bson.M{"subject": 1, "body": 1, "image_url": 1, "date_created": 1, "date_scheduled": 1, "date_edited": 1})
+
+	var result []model.Post
+	err := sa.db.posts.FindWithContext(context, filter, &result, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
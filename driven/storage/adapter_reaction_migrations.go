@@ -0,0 +1,136 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// FindPostsWithReactions finds a page of the group posts which have reactions, ordered by id. Passing afterID gives the page after the post with this id.
+func (sa *Adapter) FindPostsWithReactions(context TransactionContext, clientID string, groupID string, afterID *string, limit int64) ([]model.Post, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "group_id", Value: groupID},
+		primitive.E{Key: "reactions", Value: bson.M{"$exists": true, "$ne": bson.M{}}},
+	}
+	if afterID != nil {
+		filter = append(filter, primitive.E{Key: "_id", Value: bson.M{"$gt": *afterID}})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "_id", Value: 1}})
+	findOptions.SetLimit(limit)
+	findOptions.SetProjection(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "reactions", Value: 1},
+		primitive.E{Key: "date_created", Value: 1},
+	})
+
+	list := []model.Post{}
+	err := sa.db.posts.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// FindGroupIDsWithPostReactions finds the ids of the groups which have posts with reactions
+func (sa *Adapter) FindGroupIDsWithPostReactions(context TransactionContext, clientID string) ([]string, error) {
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id": clientID,
+			"reactions": bson.M{"$exists": true, "$ne": bson.M{}},
+		}},
+		{"$group": bson.M{"_id": "$group_id"}},
+		{"$sort": bson.M{"_id": 1}},
+	}
+
+	var result []struct {
+		ID string `bson:"_id"`
+	}
+	err := sa.db.posts.AggregateWithContext(context, pipeline, &result, &options.AggregateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	groupIDs := make([]string, len(result))
+	for i, item := range result {
+		groupIDs[i] = item.ID
+	}
+	return groupIDs, nil
+}
+
+// UpdateGroupReactionsMigrated sets if the post reactions of the group are managed by the Social BB
+func (sa *Adapter) UpdateGroupReactionsMigrated(context TransactionContext, clientID string, groupID string, migrated bool) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: groupID},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "reactions_migrated", Value: migrated},
+			primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+		}},
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
+// InsertReactionMigration stores a reaction migration
+func (sa *Adapter) InsertReactionMigration(context TransactionContext, migration model.ReactionMigration) error {
+	_, err := sa.db.reactionMigrations.InsertOneWithContext(context, migration)
+	return err
+}
+
+// UpdateReactionMigration stores the progress of a reaction migration
+func (sa *Adapter) UpdateReactionMigration(context TransactionContext, migration model.ReactionMigration) error {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: migration.ID},
+		primitive.E{Key: "client_id", Value: migration.ClientID},
+	}
+	return sa.db.reactionMigrations.ReplaceOneWithContext(context, filter, migration, nil)
+}
+
+// FindReactionMigrations finds the last reaction migrations of the client ordered by start date (newest first)
+func (sa *Adapter) FindReactionMigrations(context TransactionContext, clientID string, limit int64) ([]model.ReactionMigration, error) {
+	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_started", Value: -1}})
+	if limit > 0 {
+		findOptions.SetLimit(limit)
+	}
+
+	list := []model.ReactionMigration{}
+	err := sa.db.reactionMigrations.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// FindReactionMigration finds a reaction migration by id
+func (sa *Adapter) FindReactionMigration(context TransactionContext, clientID string, id string) (*model.ReactionMigration, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	var list []model.ReactionMigration
+	err := sa.db.reactionMigrations.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return &list[0], nil
+}
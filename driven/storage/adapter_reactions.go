@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// reactionEventsTTL defines how long the reaction events are kept before being expired by the TTL index.
+// They are used for the rate limiting and for the active members within the group analytics.
+const reactionEventsTTL = 31 * 24 * time.Hour
+
+// InsertReactionEvent stores a reaction event
+func (sa *Adapter) InsertReactionEvent(context TransactionContext, event model.ReactionEvent) error {
+	_, err := sa.db.reactionEvents.InsertOneWithContext(context, event)
+	return err
+}
+
+// CountReactionEvents counts the reaction events of the user since the provided time. Passing postID and reaction limits the count to them.
+func (sa *Adapter) CountReactionEvents(context TransactionContext, clientID string, userID string, postID *string, reaction *string, since time.Time) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "user_id", Value: userID},
+		primitive.E{Key: "date_created", Value: bson.M{"$gte": since}},
+	}
+	if postID != nil {
+		filter = append(filter, primitive.E{Key: "post_id", Value: *postID})
+	}
+	if reaction != nil {
+		filter = append(filter, primitive.E{Key: "reaction", Value: *reaction})
+	}
+
+	return sa.db.reactionEvents.CountDocumentsWithContext(context, filter)
+}
+
+// InsertReactionSuspension stores a reaction suspension
+func (sa *Adapter) InsertReactionSuspension(context TransactionContext, suspension model.ReactionSuspension) error {
+	_, err := sa.db.reactionSuspensions.InsertOneWithContext(context, suspension)
+	return err
+}
+
+// FindActiveReactionSuspension finds the latest expiring active reaction suspension of the user
+func (sa *Adapter) FindActiveReactionSuspension(context TransactionContext, clientID string, userID string) (*model.ReactionSuspension, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "user_id", Value: userID},
+		primitive.E{Key: "date_expires", Value: bson.M{"$gt": time.Now().UTC()}},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_expires", Value: -1}})
+	findOptions.SetLimit(1)
+
+	var list []model.ReactionSuspension
+	err := sa.db.reactionSuspensions.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return &list[0], nil
+}
@@ -0,0 +1,78 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// SaveGroupReadCursor Moves the read cursor of the user within the group to the provided date. The cursor never moves back.
+func (sa *Adapter) SaveGroupReadCursor(context TransactionContext, clientID string, groupID string, userID string, dateRead time.Time) error {
+	filter := bson.M{"client_id": clientID, "group_id": groupID, "user_id": userID}
+	update := bson.M{
+		"$max":         bson.M{"date_read": dateRead},
+		"$setOnInsert": bson.M{"_id": uuid.NewString()},
+	}
+
+	_, err := sa.db.groupReadCursors.UpdateOneWithContext(context, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindGroupReadCursors Finds the read cursors of the user
+func (sa *Adapter) FindGroupReadCursors(context TransactionContext, clientID string, userID string) ([]model.GroupReadCursor, error) {
+	filter := bson.M{"client_id": clientID, "user_id": userID}
+
+	var result []model.GroupReadCursor
+	err := sa.db.groupReadCursors.FindWithContext(context, filter, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// CountUnreadPosts Counts the published top level posts visible to the user which were created within the groups after the provided dates.
+// The posts of the user are not counted.
+func (sa *Adapter) CountUnreadPosts(context TransactionContext, clientID string, userID string, since map[string]time.Time) ([]model.GroupUnreadCount, error) {
+	if len(since) == 0 {
+		return nil, nil
+	}
+
+	groupsFilter := make([]bson.M, 0, len(since))
+	for groupID, date := range since {
+		groupsFilter = append(groupsFilter, bson.M{"group_id": groupID, "date_created": bson.M{"$gt": date}})
+	}
+
+	now := time.Now()
+	pipeline := []bson.M{
+		{"$match": bson.M{
+			"client_id":      clientID,
+			"parent_id":      nil,
+			"date_deleted":   nil,
+			"status":         bson.M{"$nin": model.UnpublishedPostStatuses},
+			"member.user_id": bson.M{"$ne": userID},
+			"$and": []bson.M{
+				{"$or": groupsFilter},
+				{"$or": []bson.M{
+					{"date_scheduled": nil},
+					{"date_scheduled": bson.M{"$lt": now}},
+				}},
+				{"$or": []bson.M{
+					{"to_members": nil},
+					{"to_members": bson.M{"$size": 0}},
+					{"to_members.user_id": userID},
+				}},
+			},
+		}},
+		{"$group": bson.M{"_id": "$group_id", "count": bson.M{"$sum": 1}}},
+	}
+
+	var result []model.GroupUnreadCount
+	err := sa.db.posts.AggregateWithContext(context, pipeline, &result, nil)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
@@ -0,0 +1,47 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// FindSmartGroups Finds the groups with enabled smart group rules
+func (sa *Adapter) FindSmartGroups(context TransactionContext, clientID string) ([]model.Group, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "smart_group_rules.enabled", Value: true},
+		primitive.E{Key: "authman_enabled", Value: bson.M{"$ne": true}},
+		primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery},
+	}
+
+	var list []model.Group
+	err := sa.db.groups.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// UpdateGroupSmartRules Sets the smart group rules of a group. Nil rules turn the group back to a regular group.
+func (sa *Adapter) UpdateGroupSmartRules(context TransactionContext, clientID string, groupID string, rules *model.SmartGroupRules) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: groupID}, primitive.E{Key: "client_id", Value: clientID}}
+
+	var update bson.D
+	if rules != nil {
+		update = bson.D{primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "smart_group_rules", Value: rules},
+			primitive.E{Key: "date_updated", Value: time.Now()},
+		}}}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$unset", Value: bson.D{primitive.E{Key: "smart_group_rules", Value: ""}}},
+			primitive.E{Key: "$set", Value: bson.D{primitive.E{Key: "date_updated", Value: time.Now()}}},
+		}
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
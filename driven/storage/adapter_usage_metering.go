@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"github.com/google/uuid"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+type usageCount struct {
+	Count int64 `bson:"count"`
+}
+
+// CountUsage Counts the usage of the client. The created posts and the notification sends are counted within [from, to).
+// The notifications without a group are not attributed to any client.
+func (sa *Adapter) CountUsage(context TransactionContext, clientID string, from time.Time, to time.Time) (*model.UsageRecord, error) {
+	record := model.UsageRecord{ClientID: clientID}
+
+	var err error
+	record.GroupsCount, err = sa.db.groups.CountDocumentsWithContext(context, bson.M{"client_id": clientID})
+	if err != nil {
+		return nil, err
+	}
+	record.MembersCount, err = sa.db.groupMemberships.CountDocumentsWithContext(context, bson.M{
+		"client_id": clientID,
+		"status":    bson.M{"$in": []string{"member", "admin"}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	record.PostsCount, err = sa.db.posts.CountDocumentsWithContext(context, bson.M{"client_id": clientID, "date_deleted": nil})
+	if err != nil {
+		return nil, err
+	}
+	record.PostsCreated, err = sa.db.posts.CountDocumentsWithContext(context, bson.M{
+		"client_id":    clientID,
+		"date_created": bson.M{"$gte": from, "$lt": to},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the notifications are counted per group first, so only the groups are looked up for the client
+	pipeline := []bson.M{
+		{"$match": bson.M{"group_id": bson.M{"$ne": nil}, "date_created": bson.M{"$gte": from, "$lt": to}}},
+		{"$group": bson.M{"_id": "$group_id", "count": bson.M{"$sum": 1}}},
+		{"$lookup": bson.M{"from": "groups", "localField": "_id", "foreignField": "_id", "as": "group"}},
+		{"$match": bson.M{"group.client_id": clientID}},
+		{"$group": bson.M{"_id": nil, "count": bson.M{"$sum": "$count"}}},
+	}
+	var notifications []usageCount
+	err = sa.db.userNotifications.AggregateWithContext(context, pipeline, &notifications, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(notifications) > 0 {
+		record.NotificationsSent = notifications[0].Count
+	}
+
+	sizePipeline := []bson.M{
+		{"$match": bson.M{"client_id": clientID}},
+		{"$group": bson.M{"_id": nil, "count": bson.M{"$sum": bson.M{"$bsonSize": "$$ROOT"}}}},
+	}
+	for _, coll := range []*collectionWrapper{sa.db.groups, sa.db.groupMemberships, sa.db.posts} {
+		var sizes []usageCount
+		err = coll.AggregateWithContext(context, sizePipeline, &sizes, nil)
+		if err != nil {
+			return nil, err
+		}
+		if len(sizes) > 0 {
+			record.StorageBytes += sizes[0].Count
+		}
+	}
+
+	return &record, nil
+}
+
+// SaveUsageRecord Creates or updates the usage record of the client for the period
+func (sa *Adapter) SaveUsageRecord(context TransactionContext, record model.UsageRecord) error {
+	now := time.Now()
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: record.ClientID},
+		primitive.E{Key: "period", Value: record.Period},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "groups_count", Value: record.GroupsCount},
+			primitive.E{Key: "members_count", Value: record.MembersCount},
+			primitive.E{Key: "posts_count", Value: record.PostsCount},
+			primitive.E{Key: "posts_created", Value: record.PostsCreated},
+			primitive.E{Key: "notifications_sent", Value: record.NotificationsSent},
+			primitive.E{Key: "storage_bytes", Value: record.StorageBytes},
+			primitive.E{Key: "final", Value: record.Final},
+			primitive.E{Key: "date_updated", Value: now},
+		}},
+		primitive.E{Key: "$setOnInsert", Value: bson.D{
+			primitive.E{Key: "_id", Value: uuid.NewString()},
+			primitive.E{Key: "date_created", Value: now},
+		}},
+	}
+
+	_, err := sa.db.usageMetering.UpdateOneWithContext(context, filter, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// FindUsageRecord Finds the usage record of the client for the period
+func (sa *Adapter) FindUsageRecord(context TransactionContext, clientID string, period string) (*model.UsageRecord, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "period", Value: period},
+	}
+
+	var list []model.UsageRecord
+	err := sa.db.usageMetering.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+	return &list[0], nil
+}
+
+// FindUsageRecords Finds the usage records of the client within the periods ordered by period. The bounds are inclusive and optional.
+func (sa *Adapter) FindUsageRecords(context TransactionContext, clientID string, fromPeriod *string, toPeriod *string) ([]model.UsageRecord, error) {
+	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+	if fromPeriod != nil || toPeriod != nil {
+		periodFilter := bson.M{}
+		if fromPeriod != nil {
+			periodFilter["$gte"] = *fromPeriod
+		}
+		if toPeriod != nil {
+			periodFilter["$lte"] = *toPeriod
+		}
+		filter = append(filter, primitive.E{Key: "period", Value: periodFilter})
+	}
+	findOptions := options.Find().SetSort(bson.D{primitive.E{Key: "period", Value: 1}})
+
+	list := []model.UsageRecord{}
+	err := sa.db.usageMetering.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
@@ -6,6 +6,8 @@ import (
 	"groups/core/model"
 	"log"
 	"reflect"
+	"regexp"
+	"strings"
 	"time"
 
 	"go.mongodb.org/mongo-driver/mongo"
@@ -24,7 +26,7 @@ func (sa *Adapter) FindGroupsV3(context TransactionContext, clientID string, fil
 	var err error
 	var memberships model.MembershipCollection
 
-	groupFilter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+	groupFilter := bson.D{primitive.E{Key: "client_id", Value: clientID}, primitive.E{Key: "date_deleted", Value: notDeletedGroupQuery}}
 	findOptions := options.Find()
 
 	groupIDMap := map[string]bool{}
@@ -61,8 +63,11 @@ func (sa *Adapter) FindGroupsV3(context TransactionContext, clientID string, fil
 	if len(filter.Tags) > 0 {
 		groupFilter = append(groupFilter, primitive.E{Key: "tags", Value: primitive.M{"$in": filter.Tags}})
 	}
-	if filter.Category != nil {
-		groupFilter = append(groupFilter, primitive.E{Key: "category", Value: *filter.Category})
+	if category := groupCategoryQuery(filter); category != nil {
+		groupFilter = append(groupFilter, primitive.E{Key: "categories", Value: category})
+	}
+	if filter.OrgUnitCode != nil {
+		groupFilter = append(groupFilter, primitive.E{Key: "org_unit.path", Value: *filter.OrgUnitCode})
 	}
 	if filter.Title != nil {
 		groupFilter = append(groupFilter, primitive.E{Key: "title", Value: primitive.Regex{Pattern: *filter.Title, Options: "i"}})
@@ -70,6 +75,9 @@ func (sa *Adapter) FindGroupsV3(context TransactionContext, clientID string, fil
 	if filter.Privacy != nil {
 		groupFilter = append(groupFilter, primitive.E{Key: "privacy", Value: *filter.Privacy})
 	}
+	if filter.Near.IsValid() {
+		groupFilter = append(groupFilter, primitive.E{Key: "location", Value: geoWithinQuery(filter.Near)})
+	}
 	if filter.ResearchOpen != nil {
 		if *filter.ResearchOpen {
 			groupFilter = append(groupFilter, primitive.E{Key: "research_open", Value: true})
@@ -126,21 +134,32 @@ func (sa *Adapter) FindGroupsV3(context TransactionContext, clientID string, fil
 		}
 	}
 
+	sortDirection := 1
 	if filter.Order != nil && "desc" == *filter.Order {
-		findOptions.SetSort(bson.D{
-			{Key: "title", Value: -1},
-		})
+		sortDirection = -1
+	}
+
+	if filter.Cursor != nil {
+		findOptions.SetSort(groupsCursorSort(sortDirection))
+		cursorQuery, err := groupsCursorQuery(*filter.Cursor, sortDirection)
+		if err != nil {
+			return nil, err
+		}
+		if cursorQuery != nil {
+			groupFilter = append(groupFilter, *cursorQuery)
+		}
 	} else {
 		findOptions.SetSort(bson.D{
-			{Key: "title", Value: 1},
+			{Key: "title", Value: sortDirection},
+			{Key: "_id", Value: sortDirection},
 		})
+		if filter.Offset != nil {
+			findOptions.SetSkip(*filter.Offset)
+		}
 	}
 	if filter.Limit != nil {
 		findOptions.SetLimit(*filter.Limit)
 	}
-	if filter.Offset != nil {
-		findOptions.SetSkip(*filter.Offset)
-	}
 
 	var list []model.Group
 	err = sa.db.groups.FindWithContext(context, groupFilter, &list, findOptions)
@@ -160,6 +179,36 @@ func (sa *Adapter) FindGroupsV3(context TransactionContext, clientID string, fil
 	return list, nil
 }
 
+// groupsCursorSort gives the sort of the groups cursor pagination. The creation date and the id never change, so the pages are stable
+// while groups are being created or renamed.
+func groupsCursorSort(direction int) bson.D {
+	return bson.D{
+		{Key: "date_created", Value: direction},
+		{Key: "_id", Value: direction},
+	}
+}
+
+// groupsCursorQuery excludes everything up to (and including) the cursor position. $nor is used as $or/$and may already be present in
+// the filter. Gives nil for the empty cursor of the first page.
+func groupsCursorQuery(cursor string, direction int) (*primitive.E, error) {
+	if len(cursor) == 0 {
+		return nil, nil
+	}
+	position, err := model.ParseGroupsCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+
+	before, beforeOrEqual := "$lt", "$lte"
+	if direction < 0 {
+		before, beforeOrEqual = "$gt", "$gte"
+	}
+	return &primitive.E{Key: "$nor", Value: []bson.M{
+		{"date_created": bson.M{before: position.DateCreated}},
+		{"date_created": position.DateCreated, "_id": bson.M{beforeOrEqual: position.ID}},
+	}}, nil
+}
+
 // FindGroupMemberships finds the group membership for a given group
 func (sa *Adapter) FindGroupMemberships(clientID string, filter model.MembershipFilter) (model.MembershipCollection, error) {
 	return sa.FindGroupMembershipsWithContext(nil, clientID, filter)
@@ -168,9 +217,54 @@ func (sa *Adapter) FindGroupMemberships(clientID string, filter model.Membership
 // FindGroupMembershipsWithContext finds the group membership for a given group
 func (sa *Adapter) FindGroupMembershipsWithContext(ctx TransactionContext, clientID string, filter model.MembershipFilter) (model.MembershipCollection, error) {
 
+	matchFilter, err := groupMembershipsQuery(clientID, filter)
+	if err != nil {
+		return model.MembershipCollection{}, err
+	}
+
+	findOptions := options.FindOptions{
+		Sort: groupMembershipsSort(filter),
+	}
+	if filter.Light != nil && *filter.Light {
+		findOptions.Projection = bson.M{"member_answers": 0, "notifications_preferences": 0}
+	}
+	if filter.Offset != nil {
+		findOptions.Skip = filter.Offset
+	}
+	if filter.Limit != nil {
+		findOptions.Limit = filter.Limit
+	}
+
+	var result []model.GroupMembership
+	err = sa.db.groupMemberships.FindWithContext(ctx, matchFilter, &result, &findOptions)
+	return model.MembershipCollection{Items: result}, err
+}
+
+// groupMembershipsSort builds the sort of the memberships filter. The id breaks the ties, so the pages are stable.
+func groupMembershipsSort(filter model.MembershipFilter) bson.D {
+	direction := 1
+	if filter.Order != nil && *filter.Order == "desc" {
+		direction = -1
+	}
+
+	if filter.SortBy == nil {
+		return bson.D{{Key: "status", Value: 1}, {Key: "name", Value: 1}}
+	}
+	switch *filter.SortBy {
+	case model.MembershipSortByName:
+		return bson.D{{Key: "name", Value: direction}, {Key: "_id", Value: 1}}
+	case model.MembershipSortByDateCreated:
+		return bson.D{{Key: "date_created", Value: direction}, {Key: "_id", Value: 1}}
+	default:
+		return bson.D{{Key: "status", Value: direction}, {Key: "name", Value: direction}, {Key: "_id", Value: 1}}
+	}
+}
+
+// groupMembershipsQuery builds the query of the memberships filter
+func groupMembershipsQuery(clientID string, filter model.MembershipFilter) (bson.D, error) {
 	if filter.ID == nil && len(filter.GroupIDs) == 0 && filter.UserID == nil && filter.ExternalID == nil && filter.Name == nil {
 		log.Print("The memberships filter requires at least one of the listed filters to be set: ID, GroupsIDs, UserID, ExternalID or Name")
-		return model.MembershipCollection{}, fmt.Errorf("the memberships filter requires at least one of the listed filters to be set: ID, GroupsIDs, UserID, ExternalID or Name")
+		return nil, fmt.Errorf("the memberships filter requires at least one of the listed filters to be set: ID, GroupsIDs, UserID, ExternalID or Name")
 	}
 
 	matchFilter := bson.D{
@@ -181,6 +275,8 @@ func (sa *Adapter) FindGroupMembershipsWithContext(ctx TransactionContext, clien
 	}
 	if filter.ID != nil {
 		matchFilter = append(matchFilter, bson.E{Key: "_id", Value: *filter.ID})
+	} else if len(filter.IDs) > 0 {
+		matchFilter = append(matchFilter, bson.E{Key: "_id", Value: bson.M{"$in": filter.IDs}})
 	}
 	if filter.UserID != nil {
 		matchFilter = append(matchFilter, bson.E{Key: "user_id", Value: *filter.UserID})
@@ -201,23 +297,44 @@ func (sa *Adapter) FindGroupMembershipsWithContext(ctx TransactionContext, clien
 	if filter.Name != nil {
 		matchFilter = append(matchFilter, bson.E{Key: "name", Value: primitive.Regex{Pattern: fmt.Sprintf(`%s`, *filter.Name), Options: "i"}})
 	}
-
-	findOptions := options.FindOptions{
-		Sort: bson.D{
-			{Key: "status", Value: 1},
-			{Key: "name", Value: 1},
-		},
+	if filter.Search != nil && len(strings.TrimSpace(*filter.Search)) > 0 {
+		// the search text is matched literally
+		search := primitive.Regex{Pattern: regexp.QuoteMeta(strings.TrimSpace(*filter.Search)), Options: "i"}
+		if filter.SearchName {
+			matchFilter = append(matchFilter, bson.E{Key: "name", Value: search})
+		} else {
+			matchFilter = append(matchFilter, bson.E{Key: "$or", Value: bson.A{
+				bson.M{"name": search},
+				bson.M{"email": search},
+				bson.M{"net_id": search},
+			}})
+		}
 	}
-	if filter.Offset != nil {
-		findOptions.Skip = filter.Offset
+	if filter.Leaders != nil {
+		if *filter.Leaders {
+			matchFilter = append(matchFilter, bson.E{Key: "show_as_leader", Value: true})
+		} else {
+			matchFilter = append(matchFilter, bson.E{Key: "show_as_leader", Value: bson.M{"$ne": true}})
+		}
 	}
-	if filter.Limit != nil {
-		findOptions.Limit = filter.Limit
+	if filter.Searchable != nil {
+		if *filter.Searchable {
+			matchFilter = append(matchFilter, bson.E{Key: "searchable", Value: bson.M{"$ne": false}})
+		} else {
+			matchFilter = append(matchFilter, bson.E{Key: "searchable", Value: false})
+		}
 	}
 
-	var result []model.GroupMembership
-	err := sa.db.groupMemberships.FindWithContext(ctx, matchFilter, &result, &findOptions)
-	return model.MembershipCollection{Items: result}, err
+	return matchFilter, nil
+}
+
+// CountGroupMemberships counts the memberships matching the filter regardless the limit and the offset
+func (sa *Adapter) CountGroupMemberships(ctx TransactionContext, clientID string, filter model.MembershipFilter) (int64, error) {
+	matchFilter, err := groupMembershipsQuery(clientID, filter)
+	if err != nil {
+		return 0, err
+	}
+	return sa.db.groupMemberships.CountDocumentsWithContext(ctx, matchFilter)
 }
 
 // FindGroupMembership finds the group membership for a given user and group
@@ -265,8 +382,8 @@ func (sa *Adapter) FindUserGroupMembershipsWithContext(ctx TransactionContext, c
 	return model.MembershipCollection{Items: result}, err
 }
 
-// CreatePendingMembership creates a pending membership for a specific group
-func (sa *Adapter) CreatePendingMembership(clientID string, user *model.User, group *model.Group, membership *model.GroupMembership) error {
+// CreatePendingMembership creates a pending membership for a specific group. A new transaction is started if no context is provided.
+func (sa *Adapter) CreatePendingMembership(context TransactionContext, clientID string, user *model.User, group *model.Group, membership *model.GroupMembership) error {
 	if membership != nil && group != nil {
 
 		//1. check if the user is already a member of this group - pending or member or admin or rejected
@@ -296,17 +413,19 @@ func (sa *Adapter) CreatePendingMembership(clientID string, user *model.User, gr
 		membership.GroupID = group.ID
 		membership.DateCreated = time.Now().UTC()
 
-		err = sa.PerformTransaction(func(context TransactionContext) error {
+		wrapperFunc := func(context TransactionContext) error {
 			_, err := sa.db.groupMemberships.InsertOneWithContext(context, membership)
 			if err != nil {
 				return err
 			}
 
 			return sa.UpdateGroupStats(context, clientID, membership.GroupID, false, true, false, true)
-		})
-		if err != nil {
-			return err
 		}
+
+		if context != nil {
+			return wrapperFunc(context)
+		}
+		return sa.PerformTransaction(wrapperFunc)
 	}
 
 	return nil
@@ -325,8 +444,8 @@ type SingleMembershipOperation struct {
 	SyncID     *string
 }
 
-// BulkUpdateGroupMembershipsByExternalID Bulk update with a list of memberships
-func (sa *Adapter) BulkUpdateGroupMembershipsByExternalID(clientID string, groupID string, saveOperations []SingleMembershipOperation, updateGroupStats bool) error {
+// BulkUpdateGroupMembershipsByExternalID Bulk update with a list of memberships. Gives the ids of the newly created memberships.
+func (sa *Adapter) BulkUpdateGroupMembershipsByExternalID(clientID string, groupID string, saveOperations []SingleMembershipOperation, updateGroupStats bool) ([]string, error) {
 	now := time.Now()
 
 	var updateModels []mongo.WriteModel
@@ -357,12 +476,19 @@ func (sa *Adapter) BulkUpdateGroupMembershipsByExternalID(clientID string, group
 		})
 	}
 
+	upserted := []string{}
 	if len(updateModels) > 0 {
-		return sa.PerformTransaction(func(context TransactionContext) error {
-			_, err := sa.db.groupMemberships.BulkWrite(updateModels, nil)
+		err := sa.PerformTransaction(func(context TransactionContext) error {
+			result, err := sa.db.groupMemberships.BulkWrite(updateModels, nil)
 			if err != nil {
 				return err
 			}
+			upserted = make([]string, 0, len(result.UpsertedIDs))
+			for _, id := range result.UpsertedIDs {
+				if membershipID, ok := id.(string); ok {
+					upserted = append(upserted, membershipID)
+				}
+			}
 
 			if updateGroupStats {
 				return sa.UpdateGroupStats(context, clientID, groupID, false, false, true, true)
@@ -370,9 +496,12 @@ func (sa *Adapter) BulkUpdateGroupMembershipsByExternalID(clientID string, group
 
 			return nil
 		})
+		if err != nil {
+			return nil, err
+		}
 	}
 
-	return nil
+	return upserted, nil
 }
 
 // SaveGroupMembershipByExternalID creates or updates a group membership for a given external ID
@@ -425,8 +554,8 @@ func (sa *Adapter) SaveGroupMembershipByExternalID(clientID string, groupID stri
 	return &result, nil
 }
 
-// CreateMembership Created a member to a group
-func (sa *Adapter) CreateMembership(clientID string, current *model.User, group *model.Group, membership *model.GroupMembership) error {
+// CreateMembership Created a member to a group. A new transaction is started if no context is provided.
+func (sa *Adapter) CreateMembership(context TransactionContext, clientID string, current *model.User, group *model.Group, membership *model.GroupMembership) error {
 	if group != nil {
 
 		if len(membership.UserID) == 0 && len(membership.ExternalID) == 0 {
@@ -458,14 +587,19 @@ func (sa *Adapter) CreateMembership(clientID string, current *model.User, group
 		membership.DateCreated = time.Now()
 		membership.MemberAnswers = group.CreateMembershipEmptyAnswers()
 
-		return sa.PerformTransaction(func(context TransactionContext) error {
-			_, err := sa.db.groupMemberships.InsertOne(membership)
+		wrapperFunc := func(context TransactionContext) error {
+			_, err := sa.db.groupMemberships.InsertOneWithContext(context, membership)
 			if err != nil {
 				return err
 			}
 
 			return sa.UpdateGroupStats(context, clientID, membership.GroupID, false, true, false, true)
-		})
+		}
+
+		if context != nil {
+			return wrapperFunc(context)
+		}
+		return sa.PerformTransaction(wrapperFunc)
 	}
 
 	return nil
@@ -493,20 +627,25 @@ func (sa *Adapter) CreateMemberships(context TransactionContext, clientID string
 	return nil
 }
 
-// ApplyMembershipApproval applies a membership approval
-func (sa *Adapter) ApplyMembershipApproval(clientID string, membershipID string, approve bool, rejectReason string) (*model.GroupMembership, error) {
+// ApplyMembershipApproval applies a membership approval. A new transaction is started if no context is provided.
+func (sa *Adapter) ApplyMembershipApproval(context TransactionContext, clientID string, membershipID string, approve bool, rejection *model.MembershipRejection) (*model.GroupMembership, error) {
 	var membership model.GroupMembership
-	err := sa.PerformTransaction(func(context TransactionContext) error {
+	wrapperFunc := func(context TransactionContext) error {
 		status := "rejected"
 		if approve {
 			status = "member"
 		}
+		if rejection == nil {
+			rejection = &model.MembershipRejection{}
+		}
 
 		filter := bson.D{primitive.E{Key: "_id", Value: membershipID}, primitive.E{Key: "client_id", Value: clientID}}
 		update := bson.D{
 			primitive.E{Key: "$set", Value: bson.D{
 				primitive.E{Key: "status", Value: status},
-				primitive.E{Key: "reject_reason", Value: rejectReason},
+				primitive.E{Key: "reject_reason", Value: rejection.Reason},
+				primitive.E{Key: "reject_reason_code", Value: rejection.Code},
+				primitive.E{Key: "reject_reason_note", Value: rejection.Note},
 				primitive.E{Key: "date_updated", Value: time.Now()},
 			},
 			},
@@ -520,13 +659,20 @@ func (sa *Adapter) ApplyMembershipApproval(clientID string, membershipID string,
 		sa.UpdateGroupStats(context, clientID, membership.GroupID, false, true, false, true)
 
 		return err
-	})
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
 	return &membership, err
 }
 
-// UpdateMembership updates a membership
-func (sa *Adapter) UpdateMembership(clientID string, _ *model.User, membershipID string, membership *model.GroupMembership) error {
-	return sa.PerformTransaction(func(context TransactionContext) error {
+// UpdateMembership updates a membership. A new transaction is started if no context is provided.
+func (sa *Adapter) UpdateMembership(context TransactionContext, clientID string, _ *model.User, membershipID string, membership *model.GroupMembership) error {
+	wrapperFunc := func(context TransactionContext) error {
 		filter := bson.D{primitive.E{Key: "_id", Value: membershipID}, primitive.E{Key: "client_id", Value: clientID}}
 		update := bson.D{
 			primitive.E{Key: "$set", Value: bson.D{
@@ -534,6 +680,9 @@ func (sa *Adapter) UpdateMembership(clientID string, _ *model.User, membershipID
 				primitive.E{Key: "reject_reason", Value: membership.RejectReason},
 				primitive.E{Key: "date_attended", Value: membership.DateAttended},
 				primitive.E{Key: "notifications_preferences", Value: membership.NotificationsPreferences},
+				primitive.E{Key: "show_as_leader", Value: membership.ShowAsLeader},
+				primitive.E{Key: "mentionable", Value: membership.Mentionable},
+				primitive.E{Key: "searchable", Value: membership.Searchable},
 				primitive.E{Key: "date_updated", Value: time.Now()},
 			},
 			},
@@ -545,13 +694,17 @@ func (sa *Adapter) UpdateMembership(clientID string, _ *model.User, membershipID
 		}
 
 		return sa.UpdateGroupStats(context, clientID, membership.GroupID, false, true, false, true)
-	})
+	}
 
+	if context != nil {
+		return wrapperFunc(context)
+	}
+	return sa.PerformTransaction(wrapperFunc)
 }
 
-// UpdateMemberships Updates multiple memberships for userids in a group
-func (sa *Adapter) UpdateMemberships(clientID string, user *model.User, groupID string, operation model.MembershipMultiUpdate) error {
-	return sa.PerformTransaction(func(context TransactionContext) error {
+// UpdateMemberships Updates multiple memberships for userids in a group. A new transaction is started if no context is provided.
+func (sa *Adapter) UpdateMemberships(context TransactionContext, clientID string, user *model.User, groupID string, operation model.MembershipMultiUpdate) error {
+	wrapperFunc := func(context TransactionContext) error {
 		filter := bson.D{
 			primitive.E{Key: "group_id", Value: groupID},
 			primitive.E{Key: "user_id", Value: bson.M{"$in": operation.UserIDs}},
@@ -579,16 +732,16 @@ func (sa *Adapter) UpdateMemberships(clientID string, user *model.User, groupID
 			return sa.UpdateGroupStats(context, clientID, groupID, false, true, false, true)
 		}
 		return nil
-	})
-}
+	}
 
-// DeleteMembership deletes a member membership from a specific group
-func (sa *Adapter) DeleteMembership(clientID string, groupID string, userID string) error {
-	return sa.DeleteMembershipWithContext(nil, clientID, groupID, userID)
+	if context != nil {
+		return wrapperFunc(context)
+	}
+	return sa.PerformTransaction(wrapperFunc)
 }
 
-// DeleteMembershipWithContext deletes a member membership from a specific group with context
-func (sa *Adapter) DeleteMembershipWithContext(ctx TransactionContext, clientID string, groupID string, userID string) error {
+// DeleteMembership deletes a member membership from a specific group. A new transaction is started if no context is provided.
+func (sa *Adapter) DeleteMembership(ctx TransactionContext, clientID string, groupID string, userID string) error {
 
 	deleteWrapper := func(context TransactionContext) error {
 		currentMembership, _ := sa.FindGroupMembershipWithContext(context, clientID, groupID, userID)
@@ -628,9 +781,9 @@ func (sa *Adapter) DeleteMembershipWithContext(ctx TransactionContext, clientID
 	})
 }
 
-// DeleteMembershipByID deletes a membership by ID
-func (sa *Adapter) DeleteMembershipByID(clientID string, current *model.User, membershipID string) error {
-	return sa.PerformTransaction(func(context TransactionContext) error {
+// DeleteMembershipByID deletes a membership by ID. A new transaction is started if no context is provided.
+func (sa *Adapter) DeleteMembershipByID(context TransactionContext, clientID string, current *model.User, membershipID string) error {
+	wrapperFunc := func(context TransactionContext) error {
 		membership, err := sa.FindGroupMembershipByID(clientID, membershipID)
 		if err != nil || membership == nil {
 			return fmt.Errorf("membership %s not found", membershipID)
@@ -643,13 +796,18 @@ func (sa *Adapter) DeleteMembershipByID(clientID string, current *model.User, me
 		}
 
 		return sa.UpdateGroupStats(context, clientID, membership.GroupID, false, true, false, true)
-	})
+	}
+
+	if context != nil {
+		return wrapperFunc(context)
+	}
+	return sa.PerformTransaction(wrapperFunc)
 }
 
-// DeleteUnsyncedGroupMemberships deletes group memberships that do not exist in the latest sync
-func (sa *Adapter) DeleteUnsyncedGroupMemberships(clientID string, groupID string, syncID string) (int64, error) {
+// DeleteUnsyncedGroupMemberships deletes group memberships that do not exist in the latest sync. A new transaction is started if no context is provided.
+func (sa *Adapter) DeleteUnsyncedGroupMemberships(context TransactionContext, clientID string, groupID string, syncID string) (int64, error) {
 	var deletedCount int64 = 0
-	err := sa.PerformTransaction(func(context TransactionContext) error {
+	wrapperFunc := func(context TransactionContext) error {
 		filter := bson.M{
 			"client_id": clientID,
 			"group_id":  groupID,
@@ -657,7 +815,7 @@ func (sa *Adapter) DeleteUnsyncedGroupMemberships(clientID string, groupID strin
 			"status":    bson.M{"$ne": "admin"},
 		}
 
-		result, err := sa.db.groupMemberships.DeleteMany(filter, nil)
+		result, err := sa.db.groupMemberships.DeleteManyWithContext(context, filter, nil)
 		if err != nil {
 			return err
 		}
@@ -668,10 +826,67 @@ func (sa *Adapter) DeleteUnsyncedGroupMemberships(clientID string, groupID strin
 		}
 
 		return nil
-	})
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
+	return deletedCount, err
+}
+
+// DeleteGroupMembershipsByExternalIDs deletes the non admin group memberships of the provided external IDs. A new transaction is started if no context is provided.
+func (sa *Adapter) DeleteGroupMembershipsByExternalIDs(context TransactionContext, clientID string, groupID string, externalIDs []string) (int64, error) {
+	if len(externalIDs) == 0 {
+		return 0, nil
+	}
+
+	var deletedCount int64 = 0
+	wrapperFunc := func(context TransactionContext) error {
+		filter := bson.M{
+			"client_id":   clientID,
+			"group_id":    groupID,
+			"external_id": bson.M{"$in": externalIDs},
+			"status":      bson.M{"$ne": "admin"},
+		}
+
+		result, err := sa.db.groupMemberships.DeleteManyWithContext(context, filter, nil)
+		if err != nil {
+			return err
+		}
+
+		deletedCount = result.DeletedCount
+		if deletedCount > 0 {
+			return sa.UpdateGroupStats(context, clientID, groupID, false, false, true, true)
+		}
+
+		return nil
+	}
+
+	var err error
+	if context != nil {
+		err = wrapperFunc(context)
+	} else {
+		err = sa.PerformTransaction(wrapperFunc)
+	}
 	return deletedCount, err
 }
 
+// UpdateGroupAuthmanSyncWatermark sets the time the Authman memberships of the group are synchronized up to. Passing nil forces the next sync to be a full one.
+func (sa *Adapter) UpdateGroupAuthmanSyncWatermark(context TransactionContext, clientID string, groupID string, watermark *time.Time) error {
+	filter := bson.D{primitive.E{Key: "_id", Value: groupID}, primitive.E{Key: "client_id", Value: clientID}}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "authman_sync_watermark", Value: watermark},
+		}},
+	}
+
+	_, err := sa.db.groups.UpdateOneWithContext(context, filter, update, nil)
+	return err
+}
+
 // UpdateGroupSyncTimes updates a group uses group membership
 func (sa *Adapter) UpdateGroupSyncTimes(context TransactionContext, clientID string, group *model.Group) error {
 
@@ -847,3 +1062,43 @@ func (sa Adapter) GetGroupMembershipStats(context TransactionContext, clientID s
 	}
 	return nil, nil
 }
+
+// groupCategoryQuery builds the condition of the group categories from the requested categories and the category visibility of the client.
+// The groups match if any of their categories matches. Gives nil if there is no condition.
+func groupCategoryQuery(filter model.GroupsFilter) bson.M {
+	query := bson.M{}
+	if filter.Category != nil {
+		query["$eq"] = *filter.Category
+	}
+	if len(filter.Categories) > 0 && len(filter.AllowedCategories) > 0 {
+		allowed := map[string]bool{}
+		for _, category := range filter.AllowedCategories {
+			allowed[category] = true
+		}
+		categories := []string{}
+		for _, category := range filter.Categories {
+			if allowed[category] {
+				categories = append(categories, category)
+			}
+		}
+		query["$in"] = categories
+	} else if len(filter.Categories) > 0 {
+		query["$in"] = filter.Categories
+	} else if len(filter.AllowedCategories) > 0 {
+		query["$in"] = filter.AllowedCategories
+	}
+	if len(filter.DeniedCategories) > 0 {
+		query["$nin"] = filter.DeniedCategories
+	}
+	if len(query) == 0 {
+		return nil
+	}
+	return query
+}
+
+// geoWithinQuery matches the locations within the radius around the center of the filter
+func geoWithinQuery(near *model.GeoNearFilter) bson.M {
+	return bson.M{"$geoWithin": bson.M{
+		"$centerSphere": bson.A{bson.A{near.Longitude, near.Latitude}, near.Radius / model.EarthRadiusMeters},
+	}}
+}
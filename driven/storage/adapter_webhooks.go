@@ -0,0 +1,211 @@
+package storage
+
+import (
+	"groups/core/model"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// InsertWebhookSubscription stores a webhook subscription
+func (sa *Adapter) InsertWebhookSubscription(context TransactionContext, subscription model.WebhookSubscription) error {
+	_, err := sa.db.webhookSubscriptions.InsertOneWithContext(context, subscription)
+	return err
+}
+
+// FindWebhookSubscriptions finds the webhook subscriptions of the client. Passing activeOnly limits the result to the active subscriptions.
+func (sa *Adapter) FindWebhookSubscriptions(context TransactionContext, clientID string, activeOnly bool) ([]model.WebhookSubscription, error) {
+	filter := bson.D{primitive.E{Key: "client_id", Value: clientID}}
+	if activeOnly {
+		filter = append(filter, primitive.E{Key: "active", Value: true})
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: 1}})
+
+	list := []model.WebhookSubscription{}
+	err := sa.db.webhookSubscriptions.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// FindWebhookSubscription finds a webhook subscription by id
+func (sa *Adapter) FindWebhookSubscription(context TransactionContext, clientID string, id string) (*model.WebhookSubscription, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	var list []model.WebhookSubscription
+	err := sa.db.webhookSubscriptions.FindWithContext(context, filter, &list, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, nil
+	}
+
+	return &list[0], nil
+}
+
+// UpdateWebhookSubscriptionActive enables or disables a webhook subscription
+func (sa *Adapter) UpdateWebhookSubscriptionActive(context TransactionContext, clientID string, id string, active bool) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "active", Value: active},
+			primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+		}},
+	}
+
+	res, err := sa.db.webhookSubscriptions.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.MatchedCount, nil
+}
+
+// UpdateWebhookSubscriptionSecret sets the new secret of a webhook subscription. The previous secret is kept until it expires, nil expiry drops it.
+func (sa *Adapter) UpdateWebhookSubscriptionSecret(context TransactionContext, clientID string, id string, secret string, previousSecret string, previousSecretExpires *time.Time) (int64, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: id},
+		primitive.E{Key: "client_id", Value: clientID},
+	}
+
+	var update bson.D
+	if previousSecretExpires != nil {
+		update = bson.D{
+			primitive.E{Key: "$set", Value: bson.D{
+				primitive.E{Key: "secret", Value: secret},
+				primitive.E{Key: "previous_secret", Value: previousSecret},
+				primitive.E{Key: "date_previous_secret_expires", Value: previousSecretExpires},
+				primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+			}},
+		}
+	} else {
+		update = bson.D{
+			primitive.E{Key: "$set", Value: bson.D{
+				primitive.E{Key: "secret", Value: secret},
+				primitive.E{Key: "date_updated", Value: time.Now().UTC()},
+			}},
+			primitive.E{Key: "$unset", Value: bson.D{
+				primitive.E{Key: "previous_secret", Value: ""},
+				primitive.E{Key: "date_previous_secret_expires", Value: ""},
+			}},
+		}
+	}
+
+	res, err := sa.db.webhookSubscriptions.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return 0, err
+	}
+	return res.MatchedCount, nil
+}
+
+// InsertWebhookDeliveries stores webhook deliveries
+func (sa *Adapter) InsertWebhookDeliveries(context TransactionContext, deliveries []model.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	documents := make([]interface{}, len(deliveries))
+	for i, item := range deliveries {
+		documents[i] = item
+	}
+
+	_, err := sa.db.webhookDeliveries.InsertManyWithContext(context, documents, nil)
+	return err
+}
+
+// ClaimWebhookDelivery Claims one pending delivery which is due for an attempt for the claim token until the lease expiry. The deliveries
+// claimed by another token are skipped until their lease expires, so every instance could run the worker. Gives nil if there is no delivery to claim.
+func (sa *Adapter) ClaimWebhookDelivery(context TransactionContext, claimToken string, leaseExpiry time.Time) (*model.WebhookDelivery, error) {
+	now := time.Now().UTC()
+	filter := bson.D{
+		primitive.E{Key: "status", Value: model.WebhookDeliveryStatusPending},
+		primitive.E{Key: "next_attempt", Value: bson.M{"$lte": now}},
+		primitive.E{Key: "$or", Value: bson.A{
+			bson.D{primitive.E{Key: "delivery_claim", Value: nil}},
+			bson.D{primitive.E{Key: "date_delivery_claim_expires", Value: bson.M{"$lt": now}}},
+		}},
+	}
+	update := bson.D{primitive.E{Key: "$set", Value: bson.D{
+		primitive.E{Key: "delivery_claim", Value: claimToken},
+		primitive.E{Key: "date_delivery_claim_expires", Value: leaseExpiry},
+	}}}
+
+	after := options.After
+	opts := options.FindOneAndUpdateOptions{ReturnDocument: &after, Sort: bson.D{primitive.E{Key: "next_attempt", Value: 1}}}
+	var delivery model.WebhookDelivery
+	err := sa.db.webhookDeliveries.FindOneAndUpdateWithContext(context, filter, update, &delivery, &opts)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &delivery, nil
+}
+
+// FindWebhookDeliveries finds the delivery history of a subscription ordered by date (newest first)
+func (sa *Adapter) FindWebhookDeliveries(context TransactionContext, clientID string, subscriptionID string, offset *int64, limit *int64) ([]model.WebhookDelivery, error) {
+	filter := bson.D{
+		primitive.E{Key: "client_id", Value: clientID},
+		primitive.E{Key: "subscription_id", Value: subscriptionID},
+	}
+
+	findOptions := options.Find()
+	findOptions.SetSort(bson.D{{Key: "date_created", Value: -1}})
+	if limit != nil {
+		findOptions.SetLimit(*limit)
+	}
+	if offset != nil {
+		findOptions.SetSkip(*offset)
+	}
+
+	list := []model.WebhookDelivery{}
+	err := sa.db.webhookDeliveries.FindWithContext(context, filter, &list, findOptions)
+	if err != nil {
+		return nil, err
+	}
+
+	return list, nil
+}
+
+// UpdateWebhookDelivery updates the state of the claimed webhook delivery after an attempt and releases the claim. Gives false if the claim
+// has been taken over by another token.
+func (sa *Adapter) UpdateWebhookDelivery(context TransactionContext, delivery model.WebhookDelivery, claimToken string) (bool, error) {
+	filter := bson.D{
+		primitive.E{Key: "_id", Value: delivery.ID},
+		primitive.E{Key: "delivery_claim", Value: claimToken},
+	}
+	update := bson.D{
+		primitive.E{Key: "$set", Value: bson.D{
+			primitive.E{Key: "status", Value: delivery.Status},
+			primitive.E{Key: "attempts", Value: delivery.Attempts},
+			primitive.E{Key: "response_status", Value: delivery.ResponseStatus},
+			primitive.E{Key: "last_error", Value: delivery.LastError},
+			primitive.E{Key: "next_attempt", Value: delivery.NextAttempt},
+			primitive.E{Key: "date_updated", Value: delivery.DateUpdated},
+		}},
+		primitive.E{Key: "$unset", Value: bson.D{
+			primitive.E{Key: "delivery_claim", Value: ""},
+			primitive.E{Key: "date_delivery_claim_expires", Value: ""},
+		}},
+	}
+
+	result, err := sa.db.webhookDeliveries.UpdateOneWithContext(context, filter, update, nil)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
@@ -16,6 +16,7 @@ package storage
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"log"
 	"time"
@@ -25,6 +26,9 @@ import (
 	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
+// aggregateTimeout is the max time of the aggregations when the caller does not provide one
+const aggregateTimeout = time.Millisecond * 15000
+
 type collectionWrapper struct {
 	database *database
 	coll     *mongo.Collection
@@ -39,7 +43,18 @@ func (collWrapper *collectionWrapper) FindWithContext(ctx context.Context, filte
 		ctx = context.Background()
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, collWrapper.database.mongoTimeout)
+	// the server aborts the query once the max time is exceeded, so it does not keep running after the client gives up
+	if findOptions == nil {
+		findOptions = options.Find()
+	}
+	timeout := collWrapper.database.mongoTimeout
+	if findOptions.MaxTime == nil {
+		findOptions.SetMaxTime(timeout)
+	} else if *findOptions.MaxTime > timeout {
+		timeout = *findOptions.MaxTime
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
 	if filter == nil {
@@ -47,6 +62,8 @@ func (collWrapper *collectionWrapper) FindWithContext(ctx context.Context, filte
 		filter = bson.D{}
 	}
 
+	defer collWrapper.logSlowQuery("find", filter, time.Now())
+
 	cur, err := collWrapper.coll.Find(ctx, filter, findOptions)
 
 	if err == nil {
@@ -65,12 +82,20 @@ func (collWrapper *collectionWrapper) FindOneWithContext(ctx context.Context, fi
 		ctx = context.Background()
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, collWrapper.database.mongoTimeout)
-	defer cancel()
-
 	if findOptions == nil {
 		findOptions = options.FindOne() // crash if not added!
 	}
+	timeout := collWrapper.database.mongoTimeout
+	if findOptions.MaxTime == nil {
+		findOptions.SetMaxTime(timeout)
+	} else if *findOptions.MaxTime > timeout {
+		timeout = *findOptions.MaxTime
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	defer collWrapper.logSlowQuery("findOne", filter, time.Now())
 
 	singleResult := collWrapper.coll.FindOne(ctx, filter, findOptions)
 	if singleResult.Err() != nil {
@@ -229,6 +254,8 @@ func (collWrapper *collectionWrapper) UpdateOneWithContext(ctx context.Context,
 	ctx, cancel := context.WithTimeout(ctx, collWrapper.database.mongoTimeout)
 	defer cancel()
 
+	defer collWrapper.logSlowQuery("updateOne", filter, time.Now())
+
 	updateResult, err := collWrapper.coll.UpdateOne(ctx, filter, update, opts)
 	if err != nil {
 		return nil, err
@@ -269,6 +296,8 @@ func (collWrapper *collectionWrapper) UpdateManyWithContext(ctx context.Context,
 	ctx, cancel := context.WithTimeout(ctx, collWrapper.database.mongoTimeout)
 	defer cancel()
 
+	defer collWrapper.logSlowQuery("updateMany", filter, time.Now())
+
 	updateResult, err := collWrapper.coll.UpdateMany(ctx, filter, update, opts)
 	if err != nil {
 		return nil, err
@@ -299,6 +328,24 @@ func (collWrapper *collectionWrapper) FindOneAndUpdateWithContext(ctx context.Co
 	return nil
 }
 
+func (collWrapper *collectionWrapper) FindOneAndDeleteWithContext(ctx context.Context, filter interface{}, result interface{}, opts *options.FindOneAndDeleteOptions) error {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(ctx, collWrapper.database.mongoTimeout)
+	defer cancel()
+
+	singleResult := collWrapper.coll.FindOneAndDelete(ctx, filter, opts)
+	if singleResult.Err() != nil {
+		return singleResult.Err()
+	}
+	err := singleResult.Decode(result)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
 func (collWrapper *collectionWrapper) CountDocuments(filter interface{}) (int64, error) {
 	return collWrapper.CountDocumentsWithContext(context.Background(), filter)
 }
@@ -315,7 +362,9 @@ func (collWrapper *collectionWrapper) CountDocumentsWithContext(ctx context.Cont
 		filter = bson.D{}
 	}
 
-	count, err := collWrapper.coll.CountDocuments(ctx, filter)
+	defer collWrapper.logSlowQuery("count", filter, time.Now())
+
+	count, err := collWrapper.coll.CountDocuments(ctx, filter, options.Count().SetMaxTime(collWrapper.database.mongoTimeout))
 
 	if err != nil {
 		return -1, err
@@ -438,9 +487,21 @@ func (collWrapper *collectionWrapper) AggregateWithContext(ctx context.Context,
 		ctx = context.Background()
 	}
 
-	ctx, cancel := context.WithTimeout(ctx, time.Millisecond*15000)
+	if ops == nil {
+		ops = options.Aggregate()
+	}
+	timeout := aggregateTimeout
+	if ops.MaxTime == nil {
+		ops.SetMaxTime(timeout)
+	} else {
+		timeout = *ops.MaxTime
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
 
+	defer collWrapper.logSlowQuery("aggregate", bson.M{"pipeline": pipeline}, time.Now())
+
 	cursor, err := collWrapper.coll.Aggregate(ctx, pipeline, ops)
 
 	if err == nil {
@@ -449,3 +510,62 @@ func (collWrapper *collectionWrapper) AggregateWithContext(ctx context.Context,
 
 	return err
 }
+
+// logSlowQuery logs the operations which exceed the slow query threshold. Only the query shape is logged as the values could contain personal data.
+func (collWrapper *collectionWrapper) logSlowQuery(operation string, filter interface{}, startTime time.Time) {
+	threshold := collWrapper.database.slowQueryThreshold
+	duration := time.Since(startTime)
+	if threshold <= 0 || duration < threshold {
+		return
+	}
+
+	log.Printf("slow query: %s.%s took %s - shape: %s", collWrapper.coll.Name(), operation, duration, queryShape(filter))
+}
+
+// queryShape gives the filter with all values replaced by "?"
+func queryShape(filter interface{}) string {
+	data, err := bson.Marshal(filter)
+	if err != nil {
+		return "unknown"
+	}
+	var document bson.M
+	err = bson.Unmarshal(data, &document)
+	if err != nil {
+		return "unknown"
+	}
+
+	shape, err := json.Marshal(shapeOf(document))
+	if err != nil {
+		return "unknown"
+	}
+	return string(shape)
+}
+
+func shapeOf(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.M:
+		result := map[string]interface{}{}
+		for key, item := range v {
+			result[key] = shapeOf(item)
+		}
+		return result
+	case bson.D:
+		result := map[string]interface{}{}
+		for _, item := range v {
+			result[item.Key] = shapeOf(item.Value)
+		}
+		return result
+	case bson.A:
+		result := []interface{}{}
+		for _, item := range v {
+			if shape := shapeOf(item); shape != "?" {
+				result = append(result, shape)
+			}
+		}
+		if len(result) == 0 {
+			return "?"
+		}
+		return result
+	}
+	return "?"
+}
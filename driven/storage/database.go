@@ -28,22 +28,50 @@ import (
 )
 
 type database struct {
-	mongoDBAuth  string
-	mongoDBName  string
-	mongoTimeout time.Duration
+	mongoDBAuth        string
+	mongoDBName        string
+	mongoTimeout       time.Duration
+	slowQueryThreshold time.Duration
+
+	answersEncryptionKeys  string // comma separated "id:base64 key" master keys of the member answers encryption
+	answersEncryptionKeyID string // the master key used for the encryption of the new answers
+	answersEncryption      *fieldEncryption
 
 	db       *mongo.Database
 	dbClient *mongo.Client
 
-	configs             *collectionWrapper
-	syncTimes           *collectionWrapper
-	enums               *collectionWrapper
-	groups              *collectionWrapper
-	groupMemberships    *collectionWrapper
-	events              *collectionWrapper
-	posts               *collectionWrapper
-	managedGroupConfigs *collectionWrapper
-	users               *collectionWrapper
+	configs              *collectionWrapper
+	syncTimes            *collectionWrapper
+	enums                *collectionWrapper
+	groups               *collectionWrapper
+	groupMemberships     *collectionWrapper
+	events               *collectionWrapper
+	posts                *collectionWrapper
+	managedGroupConfigs  *collectionWrapper
+	users                *collectionWrapper
+	userNotifications    *collectionWrapper
+	auditLogs            *collectionWrapper
+	webhookSubscriptions *collectionWrapper
+	webhookDeliveries    *collectionWrapper
+	reactionEvents       *collectionWrapper
+	contentEvents        *collectionWrapper
+	reactionSuspensions  *collectionWrapper
+	locks                *collectionWrapper
+	authmanSyncRuns      *collectionWrapper
+	reactionMigrations   *collectionWrapper
+	eventCheckInCodes    *collectionWrapper
+	eventAttendances     *collectionWrapper
+	groupMessages        *collectionWrapper
+	postRevisions        *collectionWrapper
+	groupReadCursors     *collectionWrapper
+	postBookmarks        *collectionWrapper
+	notificationBursts   *collectionWrapper
+	abuseReports         *collectionWrapper
+	usageMetering        *collectionWrapper
+	groupBans            *collectionWrapper
+	groupArchives        *collectionWrapper
+	groupDeletionJobs    *collectionWrapper
+	outboxEvents         *collectionWrapper
 
 	listeners []Listener
 }
@@ -51,8 +79,16 @@ type database struct {
 func (m *database) start() error {
 	log.Println("database -> start")
 
+	answersEncryption, err := newFieldEncryption(m.answersEncryptionKeys, m.answersEncryptionKeyID)
+	if err != nil {
+		return err
+	}
+	if answersEncryption == nil {
+		log.Println("member answers encryption keys are not configured, the answers are stored in plaintext")
+	}
+
 	//connect to the database
-	clientOptions := options.Client().ApplyURI(m.mongoDBAuth)
+	clientOptions := options.Client().ApplyURI(m.mongoDBAuth).SetRegistry(newRegistry(answersEncryption))
 	connectContext, cancel := context.WithTimeout(context.Background(), m.mongoTimeout)
 	client, err := mongo.Connect(connectContext, clientOptions)
 	cancel()
@@ -125,6 +161,141 @@ func (m *database) start() error {
 		return err
 	}
 
+	userNotifications := &collectionWrapper{database: m, coll: db.Collection("user_notifications")}
+	err = m.applyUserNotificationsChecks(userNotifications)
+	if err != nil {
+		return err
+	}
+
+	auditLogs := &collectionWrapper{database: m, coll: db.Collection("audit_logs")}
+	err = m.applyAuditLogsChecks(auditLogs)
+	if err != nil {
+		return err
+	}
+
+	webhookSubscriptions := &collectionWrapper{database: m, coll: db.Collection("webhook_subscriptions")}
+	err = m.applyWebhookSubscriptionsChecks(webhookSubscriptions)
+	if err != nil {
+		return err
+	}
+
+	webhookDeliveries := &collectionWrapper{database: m, coll: db.Collection("webhook_deliveries")}
+	err = m.applyWebhookDeliveriesChecks(webhookDeliveries)
+	if err != nil {
+		return err
+	}
+
+	reactionEvents := &collectionWrapper{database: m, coll: db.Collection("reaction_events")}
+	err = m.applyReactionEventsChecks(reactionEvents)
+	if err != nil {
+		return err
+	}
+
+	contentEvents := &collectionWrapper{database: m, coll: db.Collection("content_events")}
+	err = m.applyContentEventsChecks(contentEvents)
+	if err != nil {
+		return err
+	}
+
+	reactionSuspensions := &collectionWrapper{database: m, coll: db.Collection("reaction_suspensions")}
+	err = m.applyReactionSuspensionsChecks(reactionSuspensions)
+	if err != nil {
+		return err
+	}
+
+	// the locks are found by _id only, so no additional indexes are needed
+	locks := &collectionWrapper{database: m, coll: db.Collection("locks")}
+
+	authmanSyncRuns := &collectionWrapper{database: m, coll: db.Collection("authman_sync_runs")}
+	err = m.applyAuthmanSyncRunsChecks(authmanSyncRuns)
+	if err != nil {
+		return err
+	}
+
+	reactionMigrations := &collectionWrapper{database: m, coll: db.Collection("reaction_migrations")}
+	err = m.applyReactionMigrationsChecks(reactionMigrations)
+	if err != nil {
+		return err
+	}
+
+	eventCheckInCodes := &collectionWrapper{database: m, coll: db.Collection("event_checkin_codes")}
+	err = m.applyEventCheckInCodesChecks(eventCheckInCodes)
+	if err != nil {
+		return err
+	}
+
+	eventAttendances := &collectionWrapper{database: m, coll: db.Collection("event_attendances")}
+	err = m.applyEventAttendancesChecks(eventAttendances)
+	if err != nil {
+		return err
+	}
+
+	groupMessages := &collectionWrapper{database: m, coll: db.Collection("group_messages")}
+	err = m.applyGroupMessagesChecks(groupMessages)
+	if err != nil {
+		return err
+	}
+
+	postRevisions := &collectionWrapper{database: m, coll: db.Collection("post_revisions")}
+	err = m.applyPostRevisionsChecks(postRevisions)
+	if err != nil {
+		return err
+	}
+
+	groupReadCursors := &collectionWrapper{database: m, coll: db.Collection("group_read_cursors")}
+	err = m.applyGroupReadCursorsChecks(groupReadCursors)
+	if err != nil {
+		return err
+	}
+
+	postBookmarks := &collectionWrapper{database: m, coll: db.Collection("post_bookmarks")}
+	err = m.applyPostBookmarksChecks(postBookmarks)
+	if err != nil {
+		return err
+	}
+
+	notificationBursts := &collectionWrapper{database: m, coll: db.Collection("post_notification_bursts")}
+	err = m.applyPostNotificationBurstsChecks(notificationBursts)
+	if err != nil {
+		return err
+	}
+
+	abuseReports := &collectionWrapper{database: m, coll: db.Collection("abuse_reports")}
+	err = m.applyAbuseReportsChecks(abuseReports)
+	if err != nil {
+		return err
+	}
+
+	usageMetering := &collectionWrapper{database: m, coll: db.Collection("usage_metering")}
+	err = m.applyUsageMeteringChecks(usageMetering)
+	if err != nil {
+		return err
+	}
+
+	groupBans := &collectionWrapper{database: m, coll: db.Collection("group_bans")}
+	err = m.applyGroupBansChecks(groupBans)
+	if err != nil {
+		return err
+	}
+
+	groupArchives := &collectionWrapper{database: m, coll: db.Collection("group_archives")}
+	err = m.applyGroupArchivesChecks(groupArchives)
+	if err != nil {
+		return err
+	}
+
+	groupDeletionJobs := &collectionWrapper{database: m, coll: db.Collection("group_deletion_jobs")}
+	err = m.applyGroupDeletionJobsChecks(groupDeletionJobs)
+	if err != nil {
+		return err
+	}
+
+	outboxEvents := &collectionWrapper{database: m, coll: db.Collection("outbox_events")}
+	err = m.applyOutboxEventsChecks(outboxEvents)
+	if err != nil {
+		return err
+	}
+
 	//apply multi-tenant
 	err = m.applyMultiTenantChecks(client, users, groups, events)
 	if err != nil {
@@ -148,9 +319,15 @@ func (m *database) start() error {
 		return err
 	}
 
+	err = m.ApplyGroupsCategoriesTransition(groups)
+	if err != nil {
+		return err
+	}
+
 	//asign the db, db client and the collections
 	m.db = db
 	m.dbClient = client
+	m.answersEncryption = answersEncryption
 
 	m.configs = configs
 	m.syncTimes = syncTimes
@@ -161,9 +338,33 @@ func (m *database) start() error {
 	m.posts = posts
 	m.managedGroupConfigs = managedGroupConfigs
 	m.users = users
+	m.userNotifications = userNotifications
+	m.auditLogs = auditLogs
+	m.webhookSubscriptions = webhookSubscriptions
+	m.webhookDeliveries = webhookDeliveries
+	m.reactionEvents = reactionEvents
+	m.contentEvents = contentEvents
+	m.reactionSuspensions = reactionSuspensions
+	m.locks = locks
+	m.authmanSyncRuns = authmanSyncRuns
+	m.reactionMigrations = reactionMigrations
+	m.eventCheckInCodes = eventCheckInCodes
+	m.eventAttendances = eventAttendances
+	m.groupMessages = groupMessages
+	m.postRevisions = postRevisions
+	m.groupReadCursors = groupReadCursors
+	m.postBookmarks = postBookmarks
+	m.notificationBursts = notificationBursts
+	m.abuseReports = abuseReports
+	m.usageMetering = usageMetering
+	m.groupBans = groupBans
+	m.groupArchives = groupArchives
+	m.groupDeletionJobs = groupDeletionJobs
+	m.outboxEvents = outboxEvents
 
 	go m.configs.Watch(nil)
 	go m.managedGroupConfigs.Watch(nil)
+	go m.groupMessages.Watch([]bson.M{{"$match": bson.M{"operationType": "insert"}}})
 
 	m.listeners = []Listener{}
 
@@ -287,6 +488,27 @@ func (m *database) applyGroupsChecks(groups *collectionWrapper) error {
 		}
 	}
 
+	if indexMapping["client_id_1_creator_id_1"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "creator_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["location_2dsphere"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "location", Value: "2dsphere"},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	if indexMapping["category_1"] == nil {
 		err := groups.AddIndex(
 			bson.D{
@@ -297,6 +519,16 @@ func (m *database) applyGroupsChecks(groups *collectionWrapper) error {
 		}
 	}
 
+	if indexMapping["categories_1"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "categories", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	if indexMapping["privacy_1"] == nil {
 		err := groups.AddIndex(
 			bson.D{
@@ -337,6 +569,39 @@ func (m *database) applyGroupsChecks(groups *collectionWrapper) error {
 		}
 	}
 
+	if indexMapping["client_id_1_smart_group_rules.enabled_1"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "smart_group_rules.enabled", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_org_unit.path_1"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "org_unit.path", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the deleted groups are purged once the restore period passes
+	if indexMapping["date_purge_1"] == nil {
+		err := groups.AddIndex(
+			bson.D{
+				primitive.E{Key: "date_purge", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
 	if indexMapping["members.id_1"] != nil {
 		err := groups.DropIndex("members.id_1")
 		if err != nil {
@@ -430,9 +695,10 @@ func (m *database) applyGroupsChecks(groups *collectionWrapper) error {
 func (m *database) applyGroupMembershipsChecks(groupMemberships *collectionWrapper) error {
 	log.Println("apply group memberships checks.....")
 
-	err := groupMemberships.AddIndex(bson.D{primitive.E{Key: "client_id", Value: 1}, primitive.E{Key: "group_id", Value: 1}, primitive.E{Key: "user_id", Value: 1}}, false)
+	// the unique index is retried after the next dedup pass, so its failure does not stop the startup
+	err := m.applyUniqueMembershipIndex(groupMemberships)
 	if err != nil {
-		return err
+		log.Printf("error applying the unique membership index: %s", err)
 	}
 
 	err = groupMemberships.AddIndex(bson.D{primitive.E{Key: "client_id", Value: 1}, primitive.E{Key: "user_id", Value: 1}}, false)
@@ -488,10 +754,132 @@ func (m *database) applyGroupMembershipsChecks(groupMemberships *collectionWrapp
 		return err
 	}
 
+	// the sorted member lists of the groups
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "status", Value: 1},
+		primitive.E{Key: "name", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "name", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "date_created", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	// the scheduled status changes, the field is missing on most of the memberships
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "scheduled_transition.date_effective", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	// the member search within the groups, the name is covered by the sorted lists index
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "email", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
+	err = groupMemberships.AddIndex(bson.D{
+		primitive.E{Key: "client_id", Value: 1},
+		primitive.E{Key: "group_id", Value: 1},
+		primitive.E{Key: "net_id", Value: 1},
+	}, false)
+	if err != nil {
+		return err
+	}
+
 	log.Println("group memberships checks passed")
 	return nil
 }
 
+// applyUniqueMembershipIndex creates the unique (client_id, group_id, user_id) index of the memberships. The existing non-unique index is
+// replaced only once there are no duplicate memberships, they are merged by the membership dedup task first. The unique index is created
+// under its own name before the non-unique one is dropped, so the memberships stay indexed if a concurrent join inserts a duplicate in the
+// meantime and the creation fails. The memberships without a user (i.e. the Authman members who have not logged in yet) are not covered.
+func (m *database) applyUniqueMembershipIndex(groupMemberships *collectionWrapper) error {
+	indexes, _ := groupMemberships.ListIndexes()
+	var existing bson.M
+	uniqueExists := false
+	for _, index := range indexes {
+		switch index["name"] {
+		case membershipUserIndexName:
+			existing = index
+		case membershipUserUniqueIndexName:
+			uniqueExists = true
+		}
+	}
+	if existing != nil {
+		if unique, _ := existing["unique"].(bool); unique {
+			return nil
+		}
+	}
+
+	if !uniqueExists {
+		if existing != nil {
+			var duplicates []bson.M
+			err := groupMemberships.Aggregate(duplicateMembershipsPipeline(nil, 1), &duplicates, nil)
+			if err != nil {
+				return err
+			}
+			if len(duplicates) > 0 {
+				log.Printf("the %s index of the group memberships is kept non-unique until the duplicate memberships are merged", membershipUserIndexName)
+				return nil
+			}
+		}
+
+		err := groupMemberships.AddIndexWithOptions(bson.D{
+			primitive.E{Key: "client_id", Value: 1},
+			primitive.E{Key: "group_id", Value: 1},
+			primitive.E{Key: "user_id", Value: 1},
+		}, options.Index().SetName(membershipUserUniqueIndexName).SetUnique(true).SetPartialFilterExpression(bson.M{"user_id": bson.M{"$gt": ""}}))
+		if err != nil {
+			if existing == nil {
+				// keep the memberships indexed until the unique index could be created
+				indexErr := groupMemberships.AddIndex(bson.D{
+					primitive.E{Key: "client_id", Value: 1},
+					primitive.E{Key: "group_id", Value: 1},
+					primitive.E{Key: "user_id", Value: 1},
+				}, false)
+				if indexErr != nil {
+					log.Printf("error creating the %s index of the group memberships: %s", membershipUserIndexName, indexErr)
+				}
+			}
+			return fmt.Errorf("error creating the %s index of the group memberships: %s", membershipUserUniqueIndexName, err)
+		}
+	}
+
+	if existing != nil {
+		err := groupMemberships.DropIndex(membershipUserIndexName)
+		if err != nil {
+			return fmt.Errorf("error dropping the %s index of the group memberships replaced by %s: %s", membershipUserIndexName, membershipUserUniqueIndexName, err)
+		}
+	}
+	return nil
+}
+
 func (m *database) applyEventsChecks(events *collectionWrapper) error {
 	log.Println("apply events checks.....")
 
@@ -573,6 +961,56 @@ func (m *database) applyEventsChecks(events *collectionWrapper) error {
 		}
 	}
 
+	if indexMapping["client_id_1_group_id_1_date_start_1"] == nil {
+		err := events.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_start", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1_series_id_1_occurrence_start_1"] == nil {
+		err := events.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "series_id", Value: 1},
+				primitive.E{Key: "occurrence_start", Value: 1},
+			},
+			options.Index().SetPartialFilterExpression(bson.M{"series_id": bson.M{"$exists": true}}))
+		if err != nil {
+			return err
+		}
+	}
+
+	// the events due the scheduled reminders
+	if indexMapping["client_id_1_date_start_1"] == nil {
+		err := events.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "date_start", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_occurrence_start_1"] == nil {
+		err := events.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "occurrence_start", Value: 1},
+			},
+			options.Index().SetPartialFilterExpression(bson.M{"occurrence_start": bson.M{"$exists": true}}))
+		if err != nil {
+			return err
+		}
+	}
+
 	if indexMapping["to_members.external_id_1"] == nil {
 		err := events.AddIndex(
 			bson.D{
@@ -716,16 +1154,929 @@ func (m *database) applyPostsChecks(posts *collectionWrapper) error {
 		}
 	}
 
-	log.Println("posts checks passed")
-	return nil
-}
-
+	if indexMapping["client_id_1_group_id_1_pinned_1"] == nil {
+		err := posts.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "pinned", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+	if indexMapping["client_id_1_group_id_1_status_1"] == nil {
+		err := posts.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "status", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("posts checks passed")
+	return nil
+}
+
 func (m *database) applyManagedGroupConfigsChecks(managedGroupConfigs *collectionWrapper) error {
 	log.Println("apply managed group configs checks.....")
 
-	//TODO: Set up indexes
+	//TODO: Set up indexes
+
+	log.Println("managed group configs checks passed")
+	return nil
+}
+
+func (m *database) applyUserNotificationsChecks(userNotifications *collectionWrapper) error {
+	log.Println("apply user notifications checks.....")
+
+	indexes, _ := userNotifications.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["app_id_1_org_id_1_user_id_1_read_1_date_created_-1"] == nil {
+		err := userNotifications.AddIndex(
+			bson.D{
+				primitive.E{Key: "app_id", Value: 1},
+				primitive.E{Key: "org_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "read", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_created_1"] == nil {
+		expireAfter := int32(userNotificationsTTL.Seconds())
+		err := userNotifications.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_created", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("user notifications checks passed")
+	return nil
+}
+
+func (m *database) applyAuditLogsChecks(auditLogs *collectionWrapper) error {
+	log.Println("apply audit logs checks.....")
+
+	indexes, _ := auditLogs.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_date_created_-1"] == nil {
+		err := auditLogs.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["action_1"] == nil {
+		err := auditLogs.AddIndex(
+			bson.D{
+				primitive.E{Key: "action", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("audit logs checks passed")
+	return nil
+}
+
+func (m *database) applyWebhookSubscriptionsChecks(webhookSubscriptions *collectionWrapper) error {
+	log.Println("apply webhook subscriptions checks.....")
+
+	indexes, _ := webhookSubscriptions.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_active_1"] == nil {
+		err := webhookSubscriptions.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "active", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("webhook subscriptions checks passed")
+	return nil
+}
+
+func (m *database) applyWebhookDeliveriesChecks(webhookDeliveries *collectionWrapper) error {
+	log.Println("apply webhook deliveries checks.....")
+
+	indexes, _ := webhookDeliveries.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["status_1_next_attempt_1"] == nil {
+		err := webhookDeliveries.AddIndex(
+			bson.D{
+				primitive.E{Key: "status", Value: 1},
+				primitive.E{Key: "next_attempt", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_subscription_id_1_date_created_-1"] == nil {
+		err := webhookDeliveries.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "subscription_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("webhook deliveries checks passed")
+	return nil
+}
+
+func (m *database) applyReactionEventsChecks(reactionEvents *collectionWrapper) error {
+	log.Println("apply reaction events checks.....")
+
+	indexes, _ := reactionEvents.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_user_id_1_post_id_1_date_created_1"] == nil {
+		err := reactionEvents.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "post_id", Value: 1},
+				primitive.E{Key: "date_created", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1_date_created_1"] == nil {
+		err := reactionEvents.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_created", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_created_1"] == nil {
+		expireAfter := int32(reactionEventsTTL.Seconds())
+		err := reactionEvents.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_created", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("reaction events checks passed")
+	return nil
+}
+
+func (m *database) applyContentEventsChecks(contentEvents *collectionWrapper) error {
+	log.Println("apply content events checks.....")
+
+	indexes, _ := contentEvents.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_user_id_1_group_id_1_action_1_date_created_1"] == nil {
+		err := contentEvents.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "action", Value: 1},
+				primitive.E{Key: "date_created", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_created_1"] == nil {
+		expireAfter := int32(contentEventsTTL.Seconds())
+		err := contentEvents.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_created", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("content events checks passed")
+	return nil
+}
+
+func (m *database) applyReactionSuspensionsChecks(reactionSuspensions *collectionWrapper) error {
+	log.Println("apply reaction suspensions checks.....")
+
+	indexes, _ := reactionSuspensions.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_user_id_1"] == nil {
+		err := reactionSuspensions.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the suspensions are removed as soon as they expire
+	if indexMapping["date_expires_1"] == nil {
+		expireAfter := int32(0)
+		err := reactionSuspensions.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_expires", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("reaction suspensions checks passed")
+	return nil
+}
+
+func (m *database) applyAuthmanSyncRunsChecks(authmanSyncRuns *collectionWrapper) error {
+	log.Println("apply authman sync runs checks.....")
+
+	indexes, _ := authmanSyncRuns.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_date_started_-1"] == nil {
+		err := authmanSyncRuns.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_started", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_started_1"] == nil {
+		expireAfter := int32(authmanSyncRunsTTL.Seconds())
+		err := authmanSyncRuns.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_started", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("authman sync runs checks passed")
+	return nil
+}
+
+func (m *database) applyReactionMigrationsChecks(reactionMigrations *collectionWrapper) error {
+	log.Println("apply reaction migrations checks.....")
+
+	indexes, _ := reactionMigrations.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_date_started_-1"] == nil {
+		err := reactionMigrations.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "date_started", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("reaction migrations checks passed")
+	return nil
+}
+
+func (m *database) applyEventCheckInCodesChecks(eventCheckInCodes *collectionWrapper) error {
+	log.Println("apply event check-in codes checks.....")
+
+	indexes, _ := eventCheckInCodes.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_event_id_1_code_1"] == nil {
+		err := eventCheckInCodes.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "event_id", Value: 1},
+				primitive.E{Key: "code", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["token_1"] == nil {
+		err := eventCheckInCodes.AddIndex(
+			bson.D{
+				primitive.E{Key: "token", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the codes are removed as soon as they expire
+	if indexMapping["date_expires_1"] == nil {
+		expireAfter := int32(0)
+		err := eventCheckInCodes.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_expires", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("event check-in codes checks passed")
+	return nil
+}
+
+func (m *database) applyEventAttendancesChecks(eventAttendances *collectionWrapper) error {
+	log.Println("apply event attendances checks.....")
+
+	indexes, _ := eventAttendances.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_event_id_1_user_id_1"] == nil {
+		err := eventAttendances.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "event_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+			}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_user_id_1"] == nil {
+		err := eventAttendances.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("event attendances checks passed")
+	return nil
+}
+
+func (m *database) applyGroupMessagesChecks(groupMessages *collectionWrapper) error {
+	log.Println("apply group messages checks.....")
+
+	indexes, _ := groupMessages.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_date_created_-1__id_-1"] == nil {
+		err := groupMessages.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+				primitive.E{Key: "_id", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["sender.user_id_1"] == nil {
+		err := groupMessages.AddIndex(
+			bson.D{
+				primitive.E{Key: "sender.user_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the messages are kept for the retention period only
+	if indexMapping["date_created_1"] == nil {
+		expireAfter := int32(groupMessagesTTL.Seconds())
+		err := groupMessages.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_created", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("group messages checks passed")
+	return nil
+}
+
+func (m *database) applyPostRevisionsChecks(postRevisions *collectionWrapper) error {
+	log.Println("apply post revisions checks.....")
+
+	indexes, _ := postRevisions.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_post_id_1_date_created_-1"] == nil {
+		err := postRevisions.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "post_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1"] == nil {
+		err := postRevisions.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["user_id_1"] == nil {
+		err := postRevisions.AddIndex(
+			bson.D{
+				primitive.E{Key: "user_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("post revisions checks passed")
+	return nil
+}
+
+func (m *database) applyGroupReadCursorsChecks(groupReadCursors *collectionWrapper) error {
+	log.Println("apply group read cursors checks.....")
+
+	indexes, _ := groupReadCursors.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_user_id_1_group_id_1"] == nil {
+		err := groupReadCursors.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+			}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1"] == nil {
+		err := groupReadCursors.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
 
-	log.Println("managed group configs checks passed")
+	log.Println("group read cursors checks passed")
+	return nil
+}
+
+func (m *database) applyPostBookmarksChecks(postBookmarks *collectionWrapper) error {
+	log.Println("apply post bookmarks checks.....")
+
+	indexes, _ := postBookmarks.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_user_id_1_post_id_1"] == nil {
+		err := postBookmarks.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "post_id", Value: 1},
+			}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_user_id_1_date_created_-1"] == nil {
+		err := postBookmarks.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1"] == nil {
+		err := postBookmarks.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("post bookmarks checks passed")
+	return nil
+}
+
+func (m *database) applyPostNotificationBurstsChecks(notificationBursts *collectionWrapper) error {
+	log.Println("apply post notification bursts checks.....")
+
+	indexes, _ := notificationBursts.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	// one burst per group
+	if indexMapping["client_id_1_group_id_1"] == nil {
+		err := notificationBursts.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+			}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_ends_1"] == nil {
+		err := notificationBursts.AddIndex(
+			bson.D{
+				primitive.E{Key: "date_ends", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("post notification bursts checks passed")
+	return nil
+}
+
+func (m *database) applyAbuseReportsChecks(abuseReports *collectionWrapper) error {
+	log.Println("apply abuse reports checks.....")
+
+	indexes, _ := abuseReports.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_status_1_date_created_-1"] == nil {
+		err := abuseReports.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "status", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1_date_created_-1"] == nil {
+		err := abuseReports.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "date_created", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["post_id_1"] == nil {
+		err := abuseReports.AddIndex(
+			bson.D{
+				primitive.E{Key: "post_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("abuse reports checks passed")
+	return nil
+}
+
+func (m *database) applyUsageMeteringChecks(usageMetering *collectionWrapper) error {
+	log.Println("apply usage metering checks.....")
+
+	indexes, _ := usageMetering.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_period_1"] == nil {
+		err := usageMetering.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "period", Value: 1},
+			}, true)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("usage metering checks passed")
+	return nil
+}
+
+func (m *database) applyGroupBansChecks(groupBans *collectionWrapper) error {
+	log.Println("apply group bans checks.....")
+
+	indexes, _ := groupBans.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_user_id_1"] == nil {
+		err := groupBans.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "user_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_group_id_1_external_id_1"] == nil {
+		err := groupBans.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "external_id", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("group bans checks passed")
+	return nil
+}
+
+func (m *database) applyGroupArchivesChecks(groupArchives *collectionWrapper) error {
+	log.Println("apply group archives checks.....")
+
+	indexes, _ := groupArchives.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_group_id_1_entity_type_1"] == nil {
+		err := groupArchives.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "group_id", Value: 1},
+				primitive.E{Key: "entity_type", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["client_id_1_entity_type_1_date_archived_-1"] == nil {
+		err := groupArchives.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "entity_type", Value: 1},
+				primitive.E{Key: "date_archived", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	// the archived content is purged once the retention period passes
+	if indexMapping["date_purge_1"] == nil {
+		expireAfter := int32(0)
+		err := groupArchives.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_purge", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("group archives checks passed")
+	return nil
+}
+
+func (m *database) applyGroupDeletionJobsChecks(groupDeletionJobs *collectionWrapper) error {
+	log.Println("apply group deletion jobs checks.....")
+
+	indexes, _ := groupDeletionJobs.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["client_id_1_date_started_-1"] == nil {
+		err := groupDeletionJobs.AddIndex(
+			bson.D{
+				primitive.E{Key: "client_id", Value: 1},
+				primitive.E{Key: "date_started", Value: -1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["status_1"] == nil {
+		err := groupDeletionJobs.AddIndex(
+			bson.D{
+				primitive.E{Key: "status", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("group deletion jobs checks passed")
+	return nil
+}
+
+func (m *database) applyOutboxEventsChecks(outboxEvents *collectionWrapper) error {
+	log.Println("apply outbox events checks.....")
+
+	indexes, _ := outboxEvents.ListIndexes()
+	indexMapping := map[string]interface{}{}
+	for _, index := range indexes {
+		name := index["name"].(string)
+		indexMapping[name] = index
+	}
+
+	if indexMapping["status_1_next_attempt_1_date_created_1"] == nil {
+		err := outboxEvents.AddIndex(
+			bson.D{
+				primitive.E{Key: "status", Value: 1},
+				primitive.E{Key: "next_attempt", Value: 1},
+				primitive.E{Key: "date_created", Value: 1},
+			}, false)
+		if err != nil {
+			return err
+		}
+	}
+
+	if indexMapping["date_created_1"] == nil {
+		expireAfter := int32(outboxEventsTTL.Seconds())
+		err := outboxEvents.AddIndexWithOptions(
+			bson.D{
+				primitive.E{Key: "date_created", Value: 1},
+			},
+			&options.IndexOptions{
+				ExpireAfterSeconds: &expireAfter,
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	log.Println("outbox events checks passed")
 	return nil
 }
 
@@ -1026,17 +2377,40 @@ func (m *database) ApplyGroupsAttributesTransition(client *mongo.Client, groups
 	return nil
 }
 
+// ApplyGroupsCategoriesTransition moves the single category of the groups to the categories list
+func (m *database) ApplyGroupsCategoriesTransition(groups *collectionWrapper) error {
+	log.Println("apply group categories migration.....")
+
+	filter := bson.D{
+		primitive.E{Key: "categories", Value: bson.M{"$exists": false}},
+		primitive.E{Key: "category", Value: bson.M{"$nin": bson.A{"", nil}}},
+	}
+	update := bson.A{
+		bson.M{"$set": bson.M{"categories": bson.A{"$category"}}},
+	}
+	result, err := groups.UpdateManyWithContext(nil, filter, update, nil)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("group categories migration passed, %d groups migrated", result.ModifiedCount)
+	return nil
+}
+
 func (m *database) onDataChanged(changeDoc map[string]interface{}) {
 	if changeDoc == nil {
 		return
 	}
-	log.Printf("onDataChanged: %+v\n", changeDoc)
 	ns := changeDoc["ns"]
 	if ns == nil {
 		return
 	}
 	nsMap := ns.(map[string]interface{})
 	coll := nsMap["coll"]
+	// the chat messages are high volume and contain user content, so they are not logged
+	if coll != "group_messages" {
+		log.Printf("onDataChanged: %+v\n", changeDoc)
+	}
 
 	switch coll {
 	case "configs":
@@ -1052,5 +2426,15 @@ func (m *database) onDataChanged(changeDoc map[string]interface{}) {
 		for _, listener := range m.listeners {
 			go listener.OnManagedGroupConfigsChanged()
 		}
+	case "group_messages":
+		message, err := decodeGroupMessage(changeDoc["fullDocument"])
+		if err != nil {
+			log.Printf("error decoding the created group message: %s", err)
+			return
+		}
+
+		for _, listener := range m.listeners {
+			go listener.OnGroupMessageCreated(*message)
+		}
 	}
 }
@@ -0,0 +1,219 @@
+package storage
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"reflect"
+	"strings"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/bsoncodec"
+	"go.mongodb.org/mongo-driver/bson/bsonrw"
+)
+
+var (
+	memberAnswerType       = reflect.TypeOf(model.MemberAnswer{})
+	storedMemberAnswerType = reflect.TypeOf(storedMemberAnswer{})
+)
+
+// fieldEncryption encrypts the sensitive fields with the envelope encryption. Every value is encrypted with its own random data key,
+// which is stored encrypted with the master key. The master keys are provided by the KMS through the config and identified by their IDs,
+// so the values encrypted with the previous keys could be read after the rotation.
+type fieldEncryption struct {
+	keyID string            // the master key used for the encryption
+	keys  map[string][]byte // all the master keys by ID
+}
+
+// newFieldEncryption parses the comma separated "id:base64 key" master keys. The keys must be 32 bytes long. Gives nil if no keys are
+// configured.
+func newFieldEncryption(keysConfig string, keyID string) (*fieldEncryption, error) {
+	if len(keysConfig) == 0 {
+		return nil, nil
+	}
+
+	keys := map[string][]byte{}
+	for _, item := range strings.Split(keysConfig, ",") {
+		id, value, found := strings.Cut(strings.TrimSpace(item), ":")
+		if !found || len(id) == 0 {
+			return nil, errors.New("the encryption keys must be in the id:base64 key format")
+		}
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("error decoding the encryption key %s: %s", id, err)
+		}
+		if len(key) != 32 {
+			return nil, fmt.Errorf("the encryption key %s must be 32 bytes long", id)
+		}
+		keys[id] = key
+	}
+	if _, ok := keys[keyID]; !ok {
+		return nil, fmt.Errorf("the current encryption key %s is not configured", keyID)
+	}
+
+	return &fieldEncryption{keyID: keyID, keys: keys}, nil
+}
+
+// encryptedValue is the stored form of an encrypted value
+type encryptedValue struct {
+	KeyID string `bson:"key_id"` // the master key the data key is encrypted with
+	Key   []byte `bson:"key"`    // the encrypted data key
+	Data  []byte `bson:"data"`   // the value encrypted with the data key
+}
+
+func (e *fieldEncryption) encrypt(plaintext []byte) (*encryptedValue, error) {
+	dataKey := make([]byte, 32)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	data, err := sealAESGCM(dataKey, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	encryptedKey, err := sealAESGCM(e.keys[e.keyID], dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return &encryptedValue{KeyID: e.keyID, Key: encryptedKey, Data: data}, nil
+}
+
+func (e *fieldEncryption) decrypt(value *encryptedValue) ([]byte, error) {
+	masterKey, ok := e.keys[value.KeyID]
+	if !ok {
+		return nil, fmt.Errorf("unknown encryption key %s", value.KeyID)
+	}
+	dataKey, err := openAESGCM(masterKey, value.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error decrypting the data key: %s", err)
+	}
+	return openAESGCM(dataKey, value.Data)
+}
+
+// sealAESGCM encrypts the plaintext and prefixes it with the random nonce
+func sealAESGCM(key []byte, plaintext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func openAESGCM(key []byte, ciphertext []byte) ([]byte, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("the encrypted value is too short")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+}
+
+// storedMemberAnswer is the stored form of the member answer. The question stays in plaintext, the answer and the values are encrypted
+// once the encryption is configured. The answers stored before are read in plaintext.
+type storedMemberAnswer struct {
+	Question  string          `bson:"question"`
+	Answer    string          `bson:"answer,omitempty"`
+	Values    []string        `bson:"values,omitempty"`
+	Encrypted *encryptedValue `bson:"encrypted,omitempty"`
+}
+
+type memberAnswerContent struct {
+	Answer string   `bson:"answer"`
+	Values []string `bson:"values,omitempty"`
+}
+
+// memberAnswerCodec encrypts the member answers wherever they are stored, so the encryption is transparent to the rest of the adapter
+type memberAnswerCodec struct {
+	encryption *fieldEncryption
+}
+
+func (c memberAnswerCodec) EncodeValue(ectx bsoncodec.EncodeContext, vw bsonrw.ValueWriter, val reflect.Value) error {
+	if !val.IsValid() || val.Type() != memberAnswerType {
+		return bsoncodec.ValueEncoderError{Name: "memberAnswerCodec.EncodeValue", Types: []reflect.Type{memberAnswerType}, Received: val}
+	}
+	answer := val.Interface().(model.MemberAnswer)
+
+	stored := storedMemberAnswer{Question: answer.Question}
+	if c.encryption != nil {
+		content, err := bson.Marshal(memberAnswerContent{Answer: answer.Answer, Values: answer.Values})
+		if err != nil {
+			return err
+		}
+		stored.Encrypted, err = c.encryption.encrypt(content)
+		if err != nil {
+			return fmt.Errorf("error encrypting the member answer: %s", err)
+		}
+	} else {
+		stored.Answer = answer.Answer
+		stored.Values = answer.Values
+	}
+
+	encoder, err := ectx.LookupEncoder(storedMemberAnswerType)
+	if err != nil {
+		return err
+	}
+	return encoder.EncodeValue(ectx, vw, reflect.ValueOf(stored))
+}
+
+func (c memberAnswerCodec) DecodeValue(dctx bsoncodec.DecodeContext, vr bsonrw.ValueReader, val reflect.Value) error {
+	if !val.CanSet() || val.Type() != memberAnswerType {
+		return bsoncodec.ValueDecoderError{Name: "memberAnswerCodec.DecodeValue", Types: []reflect.Type{memberAnswerType}, Received: val}
+	}
+
+	decoder, err := dctx.LookupDecoder(storedMemberAnswerType)
+	if err != nil {
+		return err
+	}
+	var stored storedMemberAnswer
+	err = decoder.DecodeValue(dctx, vr, reflect.ValueOf(&stored).Elem())
+	if err != nil {
+		return err
+	}
+
+	answer := model.MemberAnswer{Question: stored.Question, Answer: stored.Answer, Values: stored.Values}
+	if stored.Encrypted != nil {
+		if c.encryption == nil {
+			return errors.New("the member answer is encrypted but the encryption keys are not configured")
+		}
+		content, err := c.encryption.decrypt(stored.Encrypted)
+		if err != nil {
+			return fmt.Errorf("error decrypting the member answer: %s", err)
+		}
+		var decrypted memberAnswerContent
+		err = bson.Unmarshal(content, &decrypted)
+		if err != nil {
+			return err
+		}
+		answer.Answer = decrypted.Answer
+		answer.Values = decrypted.Values
+	}
+
+	val.Set(reflect.ValueOf(answer))
+	return nil
+}
+
+// newRegistry creates the BSON registry of the database client with the codecs of the encrypted fields
+func newRegistry(encryption *fieldEncryption) *bsoncodec.Registry {
+	registry := bson.NewRegistry()
+	codec := memberAnswerCodec{encryption: encryption}
+	registry.RegisterTypeEncoder(memberAnswerType, codec)
+	registry.RegisterTypeDecoder(memberAnswerType, codec)
+	return registry
+}
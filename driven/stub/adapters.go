@@ -0,0 +1,221 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stub
+
+import (
+	"errors"
+	"groups/core/model"
+	"groups/driven/notifications"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// NotificationsAdapter logs the notifications and the emails instead of sending them
+type NotificationsAdapter struct{}
+
+// SendNotification logs the notification
+func (a *NotificationsAdapter) SendNotification(recipients []notifications.Recipient, topic *string, title string, text string, data map[string]string, appID string, orgID string, dateScheduled *time.Time) error {
+	log.Printf("stub: notification '%s' - '%s' to %d recipients, data: %v", title, text, len(recipients), data)
+	return nil
+}
+
+// SendMail logs the email
+func (a *NotificationsAdapter) SendMail(toEmail string, subject string, body string) error {
+	log.Printf("stub: email '%s' to %s", subject, toEmail)
+	return nil
+}
+
+// DeleteNotifications does nothing
+func (a *NotificationsAdapter) DeleteNotifications(appID string, orgID string, ids string) error {
+	return nil
+}
+
+// AddNotificationRecipients does nothing
+func (a *NotificationsAdapter) AddNotificationRecipients(appID string, orgID string, notificationID string, userIDs []string) error {
+	return nil
+}
+
+// RemoveNotificationRecipients does nothing
+func (a *NotificationsAdapter) RemoveNotificationRecipients(appID string, orgID string, notificationID string, userIDs []string) error {
+	return nil
+}
+
+// AuthmanAdapter serves empty Authman groups
+type AuthmanAdapter struct{}
+
+// RetrieveAuthmanGroupMembers gives no members
+func (a *AuthmanAdapter) RetrieveAuthmanGroupMembers(groupName string) ([]string, error) {
+	return []string{}, nil
+}
+
+// RetrieveAuthmanGroupMemberChanges gives no changes
+func (a *AuthmanAdapter) RetrieveAuthmanGroupMemberChanges(groupName string, since time.Time) (*model.AuthmanMemberChanges, error) {
+	return &model.AuthmanMemberChanges{}, nil
+}
+
+// RetrieveAuthmanUsers gives no users
+func (a *AuthmanAdapter) RetrieveAuthmanUsers(externalIDs []string) (map[string]model.AuthmanSubject, error) {
+	return map[string]model.AuthmanSubject{}, nil
+}
+
+// RetrieveAuthmanStemGroups gives no groups
+func (a *AuthmanAdapter) RetrieveAuthmanStemGroups(stemName string) (*model.АuthmanGroupsResponse, error) {
+	return &model.АuthmanGroupsResponse{}, nil
+}
+
+// AddAuthmanMemberToGroup does nothing
+func (a *AuthmanAdapter) AddAuthmanMemberToGroup(groupName string, uin string) error {
+	return nil
+}
+
+// RemoveAuthmanMemberFromGroup does nothing
+func (a *AuthmanAdapter) RemoveAuthmanMemberFromGroup(groupName string, uin string) error {
+	return nil
+}
+
+// CoreAdapter serves no Core BB accounts
+type CoreAdapter struct{}
+
+// RetrieveCoreUserAccount is not supported in the dev mode
+func (a *CoreAdapter) RetrieveCoreUserAccount(token string) (*model.CoreAccount, error) {
+	return nil, errors.New("core accounts are not available in the dev mode")
+}
+
+// RetrieveCoreServices gives no services
+func (a *CoreAdapter) RetrieveCoreServices(serviceIDs []string) ([]model.CoreService, error) {
+	return []model.CoreService{}, nil
+}
+
+// GetAccounts gives no accounts
+func (a *CoreAdapter) GetAccounts(searchParams map[string]interface{}, appID *string, orgID *string, limit *int, offset *int) ([]model.CoreAccount, error) {
+	return []model.CoreAccount{}, nil
+}
+
+// GetAccountsWithIDs gives no accounts
+func (a *CoreAdapter) GetAccountsWithIDs(ids []string, appID *string, orgID *string, limit *int, offset *int) ([]model.CoreAccount, error) {
+	return []model.CoreAccount{}, nil
+}
+
+// GetAllCoreAccountsWithNetIDs gives no accounts
+func (a *CoreAdapter) GetAllCoreAccountsWithNetIDs(netIDs []string, appID *string, orgID *string) ([]model.CoreAccount, error) {
+	return []model.CoreAccount{}, nil
+}
+
+// GetAllCoreAccountsWithExternalIDs gives no accounts
+func (a *CoreAdapter) GetAllCoreAccountsWithExternalIDs(externalIDs []string, appID *string, orgID *string) ([]model.CoreAccount, error) {
+	return []model.CoreAccount{}, nil
+}
+
+// GetAccountsCount gives zero
+func (a *CoreAdapter) GetAccountsCount(searchParams map[string]interface{}, appID *string, orgID *string) (int64, error) {
+	return 0, nil
+}
+
+// LoadDeletedMemberships gives no deleted accounts
+func (a *CoreAdapter) LoadDeletedMemberships() ([]model.DeletedUserData, error) {
+	return []model.DeletedUserData{}, nil
+}
+
+// RetrieveFerpaAccounts gives no FERPA accounts
+func (a *CoreAdapter) RetrieveFerpaAccounts(ids []string) ([]string, error) {
+	return []string{}, nil
+}
+
+// RewardsAdapter logs the rewards instead of giving them
+type RewardsAdapter struct{}
+
+// CreateUserReward logs the reward
+func (a *RewardsAdapter) CreateUserReward(userID string, rewardType string, description string) error {
+	log.Printf("stub: reward %s for %s", rewardType, userID)
+	return nil
+}
+
+// CalendarAdapter keeps the calendar events in memory
+type CalendarAdapter struct {
+	events map[string]map[string]interface{}
+	lock   sync.RWMutex
+}
+
+// CreateCalendarEvent stores the event
+func (a *CalendarAdapter) CreateCalendarEvent(adminIdentifier []model.AccountIdentifiers, currentAccountIdentifier model.AccountIdentifiers, event map[string]interface{}, orgID string, appID string, groupIDs []string) (map[string]interface{}, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	created := map[string]interface{}{}
+	for key, value := range event {
+		created[key] = value
+	}
+	created["id"] = uuid.NewString()
+	a.events[created["id"].(string)] = created
+	return created, nil
+}
+
+// UpdateCalendarEvent replaces the stored event
+func (a *CalendarAdapter) UpdateCalendarEvent(currentAccountIdentifier model.AccountIdentifiers, eventID string, event map[string]interface{}, orgID string, appID string) (map[string]interface{}, error) {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	if _, ok := a.events[eventID]; !ok {
+		return nil, errors.New("event not found")
+	}
+	updated := map[string]interface{}{}
+	for key, value := range event {
+		updated[key] = value
+	}
+	updated["id"] = eventID
+	a.events[eventID] = updated
+	return updated, nil
+}
+
+// DeleteCalendarEvent removes the stored event
+func (a *CalendarAdapter) DeleteCalendarEvent(currentAccountIdentifier model.AccountIdentifiers, eventID string, orgID string, appID string) error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+
+	delete(a.events, eventID)
+	return nil
+}
+
+// GetGroupCalendarEvents gives the stored events with the provided IDs. The filter is not applied.
+func (a *CalendarAdapter) GetGroupCalendarEvents(currentAccountIdentifier model.AccountIdentifiers, eventIDs []string, appID string, orgID string, published *bool, filter model.GroupEventFilter) (map[string]interface{}, error) {
+	a.lock.RLock()
+	defer a.lock.RUnlock()
+
+	events := []interface{}{}
+	for _, eventID := range eventIDs {
+		if event, ok := a.events[eventID]; ok {
+			events = append(events, event)
+		}
+	}
+	return map[string]interface{}{"events": events, "total_count": len(events)}, nil
+}
+
+// AddPeopleToCalendarEvent does nothing
+func (a *CalendarAdapter) AddPeopleToCalendarEvent(people []string, eventID string, orgID string, appID string) error {
+	return nil
+}
+
+// RemovePeopleFromCalendarEvent does nothing
+func (a *CalendarAdapter) RemovePeopleFromCalendarEvent(people []string, eventID string, orgID string, appID string) error {
+	return nil
+}
+
+// NewCalendarAdapter creates a new in-memory calendar adapter
+func NewCalendarAdapter() *CalendarAdapter {
+	return &CalendarAdapter{events: map[string]map[string]interface{}{}}
+}
@@ -0,0 +1,111 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package stub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/rokwire/core-auth-library-go/v2/authservice"
+	"github.com/rokwire/core-auth-library-go/v2/authutils"
+	"github.com/rokwire/core-auth-library-go/v2/tokenauth"
+)
+
+const (
+	devIssuer        = "groups-dev"
+	devTokenLifetime = 24 * time.Hour
+)
+
+// TokenIssuer replaces the Core BB auth service in the dev mode. It serves the service registrations with its own public key and
+// issues the access tokens accepted by the token auth of the service.
+type TokenIssuer struct {
+	*authservice.ServiceRegSubscriptions
+
+	serviceHost string
+	appID       string
+	orgID       string
+
+	privKey   *rsa.PrivateKey
+	pubKeyPem string
+	keyID     string
+}
+
+// LoadServices gives the registration of the dev auth service and the registrations of the subscribed services hosted by this instance
+func (t *TokenIssuer) LoadServices() ([]authservice.ServiceReg, error) {
+	services := []authservice.ServiceReg{
+		{ServiceID: "auth", Host: devIssuer, PubKey: &authservice.PubKey{KeyPem: t.pubKeyPem, Alg: "RS256"}},
+	}
+	for _, serviceID := range t.GetSubscribedServices() {
+		if serviceID == "auth" {
+			continue
+		}
+		services = append(services, authservice.ServiceReg{ServiceID: serviceID, Host: t.serviceHost})
+	}
+	return services, nil
+}
+
+// IssueToken signs an access token with the provided claims. The missing standard claims, the app and the org are set to the dev defaults.
+func (t *TokenIssuer) IssueToken(claims tokenauth.Claims) (string, error) {
+	now := time.Now()
+	claims.Issuer = devIssuer
+	claims.IssuedAt = now.Unix()
+	claims.ExpiresAt = now.Add(devTokenLifetime).Unix()
+	claims.Purpose = "access"
+	if len(claims.Audience) == 0 {
+		claims.Audience = tokenauth.AudRokwire
+	}
+	if len(claims.AuthType) == 0 {
+		claims.AuthType = "dev"
+	}
+	if len(claims.Scope) == 0 {
+		claims.Scope = "all:all:all"
+	}
+	if len(claims.AppID) == 0 {
+		claims.AppID = t.appID
+	}
+	if len(claims.OrgID) == 0 {
+		claims.OrgID = t.orgID
+	}
+	claims.Permissions = strings.TrimSpace(claims.Permissions)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = t.keyID
+	return token.SignedString(t.privKey)
+}
+
+// NewTokenIssuer creates a new dev token issuer with a freshly generated key. The tokens become invalid once the service restarts.
+func NewTokenIssuer(serviceHost string, appID string, orgID string) (*TokenIssuer, error) {
+	privKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyDer, err := x509.MarshalPKIXPublicKey(&privKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyPem := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubKeyDer})
+	keyID, err := authutils.GetKeyFingerprint(&privKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &TokenIssuer{ServiceRegSubscriptions: authservice.NewServiceRegSubscriptions([]string{"auth"}), serviceHost: serviceHost,
+		appID: appID, orgID: orgID, privKey: privKey, pubKeyPem: string(pubKeyPem), keyID: keyID}, nil
+}
@@ -0,0 +1,175 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package webhooks
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+const (
+	// SignatureHeader contains the HMAC-SHA256 signature of the timestamp and the body. During the overlap after a secret rotation
+	// it contains the comma separated signatures of the new and the previous secrets.
+	SignatureHeader = "X-Groups-Signature"
+	// TimestampHeader contains the unix timestamp used for the signature
+	TimestampHeader = "X-Groups-Timestamp"
+	// EventHeader contains the event type
+	EventHeader = "X-Groups-Event"
+)
+
+// the address blocks which are neither private nor loopback for the net package but are not reachable on the internet
+var nonPublicNetworks = []*net.IPNet{
+	mustParseCIDR("0.0.0.0/8"),     // this network
+	mustParseCIDR("100.64.0.0/10"), // carrier-grade NAT, also used for the metadata services of some clouds
+	mustParseCIDR("198.18.0.0/15"), // benchmarking
+}
+
+// Adapter implements the Webhooks interface
+type Adapter struct {
+	client *http.Client
+}
+
+// NewWebhooksAdapter creates a new webhooks adapter. The deliveries connect only to public addresses, so a subscription cannot reach
+// the internal services even if its host resolves to another address after it is created. The redirects are not followed.
+// The adapter neither retries nor uses a circuit breaker: the failed deliveries are retried by the delivery queue and the
+// subscribers are independent, so a failing subscriber must not affect the deliveries to the others.
+func NewWebhooksAdapter(timeout time.Duration) *Adapter {
+	dialer := &net.Dialer{Timeout: timeout, Control: publicAddressControl}
+	client := &http.Client{
+		Timeout:   timeout,
+		Transport: &http.Transport{DialContext: dialer.DialContext, TLSHandshakeTimeout: timeout},
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	return &Adapter{client: client}
+}
+
+// ValidateURL checks if the URL can be used for a subscription. It must be an https URL whose host resolves only to public addresses.
+func (a *Adapter) ValidateURL(rawURL string) error {
+	parsedURL, err := url.Parse(rawURL)
+	if err != nil {
+		return fmt.Errorf("invalid webhook url - %s", err)
+	}
+	if parsedURL.Scheme != "https" {
+		return errors.New("the webhook url must use https")
+	}
+	host := parsedURL.Hostname()
+	if host == "" {
+		return errors.New("the webhook url has no host")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	addresses, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return fmt.Errorf("unable to resolve the webhook host %s - %s", host, err)
+	}
+	for _, address := range addresses {
+		if !IsPublicIP(address.IP) {
+			return fmt.Errorf("the webhook host %s resolves to the non-public address %s", host, address.IP)
+		}
+	}
+	return nil
+}
+
+// Deliver sends the payload signed with every provided secret to the subscriber. It returns the response status code when a response is received.
+func (a *Adapter) Deliver(url string, secrets []string, eventType string, payload []byte) (*int, error) {
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+
+	req, err := http.NewRequest("POST", url, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("webhooks.Deliver: error creating request - %s", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(TimestampHeader, timestamp)
+	signatures := make([]string, len(secrets))
+	for i, secret := range secrets {
+		signatures[i] = "sha256=" + Sign(secret, timestamp, payload)
+	}
+	req.Header.Set(SignatureHeader, strings.Join(signatures, ","))
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("webhooks.Deliver: error sending request - %s", err)
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	statusCode := resp.StatusCode
+	if statusCode < 200 || statusCode >= 300 {
+		return &statusCode, fmt.Errorf("webhooks.Deliver: error with response code - %d", statusCode)
+	}
+	return &statusCode, nil
+}
+
+// IsPublicIP checks if the address is reachable on the internet, i.e. it is not a loopback, private, link-local, multicast or
+// unspecified address
+func IsPublicIP(ip net.IP) bool {
+	if ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || ip.IsUnspecified() {
+		return false
+	}
+	for _, network := range nonPublicNetworks {
+		if network.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// publicAddressControl rejects the connections to non-public addresses after the host is resolved
+func publicAddressControl(network string, address string, conn syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || !IsPublicIP(ip) {
+		return fmt.Errorf("connection to the non-public address %s is not allowed", host)
+	}
+	return nil
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
+
+// Sign computes the hex encoded HMAC-SHA256 signature of "<timestamp>.<payload>"
+func Sign(secret string, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -22,6 +22,7 @@ import (
 	"log"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/casbin/casbin"
 	"github.com/rokwire/core-auth-library-go/v2/authorization"
@@ -49,6 +50,13 @@ type Adapter struct {
 	analyticsApisHandler *rest.AnalyticsApisHandler
 	bbsAPIHandler        *rest.BBSApisHandler
 
+	requestTimeout     time.Duration
+	longRequestTimeout time.Duration
+
+	devTokenIssuer DevTokenIssuer // set only in the dev mode
+
+	isReady func() bool // the API requests are served once the startup warmup completes
+
 	logger *logs.Logger
 }
 
@@ -79,11 +87,23 @@ func (we *Adapter) Start() {
 	subrouter.PathPrefix("/doc/ui").Handler(we.serveDocUI())
 	subrouter.HandleFunc("/doc", we.serveDoc)
 	subrouter.HandleFunc("/version", we.wrapFunc(we.apisHandler.Version, nil)).Methods("GET")
+	subrouter.HandleFunc("/ready", we.apisHandler.Ready).Methods("GET")
+	if we.devTokenIssuer != nil {
+		subrouter.HandleFunc("/dev/token", we.issueDevToken).Methods("POST")
+	}
 
 	//handle rest apis
 	restSubrouter := router.PathPrefix("/gr/api").Subrouter()
 	adminSubrouter := restSubrouter.PathPrefix("/admin").Subrouter()
 
+	// Public APIs authorized by the signed token
+	restSubrouter.HandleFunc("/notifications/unsubscribe", we.apisHandler.UnsubscribeConfirmation).Methods("GET")
+	restSubrouter.HandleFunc("/notifications/unsubscribe", we.apisHandler.Unsubscribe).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/events/ical", we.apisHandler.GetGroupEventsICal).Methods("GET")
+
+	// Public APIs without credentials
+	restSubrouter.HandleFunc("/directory/groups/{id}/feed.atom", we.clientIDWrapFunc(we.apisHandler.GetGroupPublicFeed)).Methods("GET")
+
 	// Admin V2 APIs
 	adminSubrouter.HandleFunc("/v2/groups", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupsV2)).Methods("GET", "POST")
 	adminSubrouter.HandleFunc("/v2/user/groups", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetUserGroupsV2)).Methods("GET", "POST")
@@ -91,6 +111,8 @@ func (we *Adapter) Start() {
 
 	// Admin V1 APIs
 	adminSubrouter.HandleFunc("/authman/synchronize", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SynchronizeAuthman)).Methods("POST")
+	adminSubrouter.HandleFunc("/authman/sync-runs", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetAuthmanSyncRuns)).Methods("GET")
+	adminSubrouter.HandleFunc("/authman/groups/validate", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.ValidateAuthmanGroups)).Methods("POST")
 	adminSubrouter.HandleFunc("/user/groups", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetUserGroups)).Methods("GET")
 	adminSubrouter.HandleFunc("/user/event/{event-id}/groups", we.idTokenAuthWrapFunc(we.adminApisHandler.GetAdminGroupIDsForEventID)).Methods("GET")
 	adminSubrouter.HandleFunc("/user/event/{event-id}/groups", we.idTokenAuthWrapFunc(we.adminApisHandler.UpdateGroupMappingsEventID)).Methods("PUT")
@@ -98,11 +120,19 @@ func (we *Adapter) Start() {
 	adminSubrouter.HandleFunc("/groups", we.idTokenAuthWrapFunc(we.adminApisHandler.CreateGroup)).Methods("POST")
 	adminSubrouter.HandleFunc("/groups/{id}", we.idTokenAuthWrapFunc(we.adminApisHandler.UpdateGroup)).Methods("PUT")
 	adminSubrouter.HandleFunc("/group/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.DeleteGroup)).Methods("DELETE")
+	adminSubrouter.HandleFunc("/groups/cleanup", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.CleanupCreatorGroups)).Methods("POST")
+	adminSubrouter.HandleFunc("/groups/admin-needed", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupsNeedingAdmin)).Methods("GET")
+	adminSubrouter.HandleFunc("/groups/{id}/audit", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupAuditLogs)).Methods("GET")
+	adminSubrouter.HandleFunc("/group/{id}/analytics", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupAnalytics)).Methods("GET")
+	adminSubrouter.HandleFunc("/group/{id}/attendance", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupAttendance)).Methods("GET")
 	adminSubrouter.HandleFunc("/group/{group-id}/members", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupMembers)).Methods("GET")
 	adminSubrouter.HandleFunc("/group/{group-id}/members/v2", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupMembersV2)).Methods("POST")
 
 	adminSubrouter.HandleFunc("/group/{group-id}/members", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.CreateMemberships)).Methods("POST")
 	adminSubrouter.HandleFunc("/group/{group-id}/stats", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupStats)).Methods("GET")
+	adminSubrouter.HandleFunc("/group/{group-id}/smart-rules", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.UpdateSmartGroupRules)).Methods("PUT")
+	adminSubrouter.HandleFunc("/group/{group-id}/smart-rules", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.DeleteSmartGroupRules)).Methods("DELETE")
+	adminSubrouter.HandleFunc("/group/{group-id}/smart-rules/synchronize", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SynchronizeSmartGroup)).Methods("POST")
 	adminSubrouter.HandleFunc("/group/{group-id}/events", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupEvents)).Methods("GET")
 	adminSubrouter.HandleFunc("/group/{group-id}/event/{event-id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.DeleteGroupEvent)).Methods("DELETE")
 	adminSubrouter.HandleFunc("/group/{group-id}/posts", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupPosts)).Methods("GET")
@@ -110,6 +140,7 @@ func (we *Adapter) Start() {
 	adminSubrouter.HandleFunc("/group/{groupID}/posts/{postID}", we.idTokenAuthWrapFunc(we.adminApisHandler.GetGroupPost)).Methods("GET")
 	adminSubrouter.HandleFunc("/group/{groupID}/posts/{postID}", we.idTokenAuthWrapFunc(we.adminApisHandler.UpdateGroupPost)).Methods("PUT")
 	adminSubrouter.HandleFunc("/group/{group-id}/posts/{postID}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.DeleteGroupPost)).Methods("DELETE")
+	adminSubrouter.HandleFunc("/group/{group-id}/posts/{postID}/restore", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.RestoreGroupPost)).Methods("POST")
 	adminSubrouter.HandleFunc("/group/{group-id}/events/v3/load", we.mixedAuthWrapFunc(we.adminApisHandler.GetGroupCalendarEventsV3)).Methods("GET", "POST")
 	adminSubrouter.HandleFunc("/group/events/v3", we.mixedAuthWrapFunc(we.adminApisHandler.CreateCalendarEventMultiGroup)).Methods("POST")
 	adminSubrouter.HandleFunc("/group/{group-id}/events/v3", we.mixedAuthWrapFunc(we.adminApisHandler.CreateCalendarEventSingleGroup)).Methods("POST")
@@ -122,6 +153,48 @@ func (we *Adapter) Start() {
 	adminSubrouter.HandleFunc("/managed-group-configs/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.DeleteManagedGroupConfig)).Methods("DELETE")
 	adminSubrouter.HandleFunc("/sync-configs", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetSyncConfig)).Methods("GET")
 	adminSubrouter.HandleFunc("/sync-configs", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveSyncConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/privacy-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetPrivacyConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/privacy-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SavePrivacyConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/license-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetLicenseConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/license-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveLicenseConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/membership-pruning-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetMembershipPruningConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/membership-pruning-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveMembershipPruningConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/pending-membership-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetPendingMembershipConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/pending-membership-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SavePendingMembershipConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/post-limits-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetPostLimitsConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/post-limits-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SavePostLimitsConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/group-deletion-policy-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupDeletionPolicyConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/group-deletion-policy-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveGroupDeletionPolicyConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/category-visibility-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetCategoryVisibilityConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/category-visibility-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveCategoryVisibilityConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/internal-event-services-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetInternalEventServicesConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/internal-event-services-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveInternalEventServicesConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/archived-groups", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetArchivedGroups)).Methods("GET")
+	adminSubrouter.HandleFunc("/archived-groups/{group-id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupArchiveItems)).Methods("GET")
+	adminSubrouter.HandleFunc("/group-deletion-jobs", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupDeletionJobs)).Methods("GET")
+	adminSubrouter.HandleFunc("/group-deletion-jobs/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetGroupDeletionJob)).Methods("GET")
+	adminSubrouter.HandleFunc("/deleted-groups", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetDeletedGroups)).Methods("GET")
+	adminSubrouter.HandleFunc("/deleted-groups/{group-id}/restore", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.RestoreGroup)).Methods("POST")
+	adminSubrouter.HandleFunc("/content-filter-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetContentFilterConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/content-filter-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveContentFilterConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/content-rate-limits-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetContentRateLimitsConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/content-rate-limits-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveContentRateLimitsConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/rejection-reasons-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetRejectionReasonsConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/rejection-reasons-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SaveRejectionReasonsConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/post-notification-batching-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetPostNotificationBatchingConfig)).Methods("GET")
+	adminSubrouter.HandleFunc("/post-notification-batching-config", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.SavePostNotificationBatchingConfig)).Methods("PUT")
+	adminSubrouter.HandleFunc("/external-services/metrics", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetExternalServicesMetrics)).Methods("GET")
+	adminSubrouter.HandleFunc("/webhooks", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetWebhookSubscriptions)).Methods("GET")
+	adminSubrouter.HandleFunc("/webhooks", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.CreateWebhookSubscription)).Methods("POST")
+	adminSubrouter.HandleFunc("/webhooks/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.UpdateWebhookSubscription)).Methods("PUT")
+	adminSubrouter.HandleFunc("/webhooks/{id}/deliveries", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetWebhookDeliveries)).Methods("GET")
+	adminSubrouter.HandleFunc("/webhooks/{id}/secret", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.RotateWebhookSecret)).Methods("POST")
+	adminSubrouter.HandleFunc("/reactions/migrations", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetReactionMigrations)).Methods("GET")
+	adminSubrouter.HandleFunc("/reactions/migrations", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.StartReactionMigration)).Methods("POST")
+	adminSubrouter.HandleFunc("/reactions/migrations/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetReactionMigration)).Methods("GET")
+	adminSubrouter.HandleFunc("/abuse-reports", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.GetAbuseReports)).Methods("GET")
+	adminSubrouter.HandleFunc("/abuse-reports/{id}", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.ModerateAbuseReport)).Methods("PUT")
+	adminSubrouter.HandleFunc("/account-links/repair", we.adminIDTokenAuthWrapFunc(we.adminApisHandler.RepairAccountLinks)).Methods("POST")
 
 	// Internal key protection
 	restSubrouter.HandleFunc("/int/user/{identifier}/groups", we.internalKeyAuthFunc(we.internalApisHandler.IntGetUserGroupMemberships)).Methods("GET")
@@ -129,10 +202,12 @@ func (we *Adapter) Start() {
 	restSubrouter.HandleFunc("/int/group/title/{title}/members", we.internalKeyAuthFunc(we.internalApisHandler.IntGetGroupMembersByGroupTitle)).Methods("GET")
 	restSubrouter.HandleFunc("/int/authman/synchronize", we.internalKeyAuthFunc(we.internalApisHandler.SynchronizeAuthman)).Methods("POST")
 	restSubrouter.HandleFunc("/int/stats", we.internalKeyAuthFunc(we.internalApisHandler.GroupStats)).Methods("GET")
+	restSubrouter.HandleFunc("/int/usage", we.internalKeyAuthFunc(we.internalApisHandler.GetUsageRecords)).Methods("GET")
 	restSubrouter.HandleFunc("/int/group/{group-id}/date_updated", we.internalKeyAuthFunc(we.internalApisHandler.UpdateGroupDateUpdated)).Methods("POST")
 	restSubrouter.HandleFunc("/int/group/{group-id}/events", we.internalKeyAuthFunc(we.internalApisHandler.CreateGroupEvent)).Methods("POST")
 	restSubrouter.HandleFunc("/int/group/{group-id}/events/{event-id}", we.internalKeyAuthFunc(we.internalApisHandler.DeleteGroupEvent)).Methods("DELETE")
 	restSubrouter.HandleFunc("/int/group/{group-id}/notification", we.internalKeyAuthFunc(we.internalApisHandler.SendGroupNotification)).Methods("POST")
+	restSubrouter.HandleFunc("/int/group/{group-id}/recipients", we.internalKeyAuthFunc(we.internalApisHandler.ResolveGroupRecipients)).Methods("POST")
 
 	// V2 Client APIs
 	restSubrouter.HandleFunc("/v2/groups", we.anonymousAuthWrapFunc(we.apisHandler.GetGroupsV2)).Methods("GET", "POST")
@@ -142,12 +217,24 @@ func (we *Adapter) Start() {
 	//V1 Client APIs
 	restSubrouter.HandleFunc("/groups", we.idTokenAuthWrapFunc(we.apisHandler.CreateGroup)).Methods("POST")
 	restSubrouter.HandleFunc("/groups/{id}", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroup)).Methods("PUT")
+	restSubrouter.HandleFunc("/v3/groups/{group-id}", we.idTokenAuthWrapFunc(we.apisHandler.PatchGroup)).Methods("PATCH")
+	restSubrouter.HandleFunc("/v4/groups", we.anonymousAuthWrapFunc(we.apisHandler.GetGroupsV4)).Methods("GET", "POST")
+	restSubrouter.HandleFunc("/v4/group/{group-id}/members", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupMembersV4)).Methods("GET", "POST")
+	restSubrouter.HandleFunc("/v4/group/{groupID}/posts", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPostsV4)).Methods("GET")
+	restSubrouter.HandleFunc("/v4/group/{group-id}/events", we.mixedAuthWrapFunc(we.apisHandler.GetGroupEventsV4)).Methods("GET")
 	restSubrouter.HandleFunc("/user", we.idTokenAuthWrapFunc(we.apisHandler.DeleteUser)).Methods("DELETE")
 	restSubrouter.HandleFunc("/user/groups", we.idTokenAuthWrapFunc(we.apisHandler.GetUserGroups)).Methods("GET")
+	restSubrouter.HandleFunc("/user/groups/unread-counts", we.idTokenAuthWrapFunc(we.apisHandler.GetUserGroupsUnreadCounts)).Methods("GET")
+	restSubrouter.HandleFunc("/user/bookmarks", we.idTokenAuthWrapFunc(we.apisHandler.GetUserBookmarkedPosts)).Methods("GET")
+	restSubrouter.HandleFunc("/user/admin-feed", we.idTokenAuthWrapFunc(we.apisHandler.GetUserAdminFeed)).Methods("GET")
 	restSubrouter.HandleFunc("/user/login", we.idTokenAuthWrapFunc(we.apisHandler.LoginUser)).Methods("GET")
 	restSubrouter.HandleFunc("/user/stats", we.idTokenAuthWrapFunc(we.apisHandler.GetUserStats)).Methods("GET")
+	restSubrouter.HandleFunc("/user/notifications", we.idTokenAuthWrapFunc(we.apisHandler.GetUserNotifications)).Methods("GET")
+	restSubrouter.HandleFunc("/user/notifications/read", we.idTokenAuthWrapFunc(we.apisHandler.MarkUserNotificationsRead)).Methods("PUT")
+	restSubrouter.HandleFunc("/user/notifications/{id}/read", we.idTokenAuthWrapFunc(we.apisHandler.MarkUserNotificationRead)).Methods("PUT")
 	restSubrouter.HandleFunc("/user/event/{event-id}/groups", we.idTokenAuthWrapFunc(we.apisHandler.GetAdminGroupIDsForEventID)).Methods("GET")
 	restSubrouter.HandleFunc("/user/event/{event-id}/groups", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupMappingsEventID)).Methods("PUT")
+	restSubrouter.HandleFunc("/client-config", we.anonymousAuthWrapFunc(we.apisHandler.GetClientConfig)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{id}/stats", we.anonymousAuthWrapFunc(we.apisHandler.GetGroupStats)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{id}/report/abuse", we.idTokenAuthWrapFunc(we.apisHandler.ReportAbuseGroup)).Methods("PUT")
 	restSubrouter.HandleFunc("/group/{id}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroup)).Methods("DELETE")
@@ -157,15 +244,49 @@ func (we *Adapter) Start() {
 	restSubrouter.HandleFunc("/group/{group-id}/members", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupMembers)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{group-id}/members", we.idTokenAuthWrapFunc(we.apisHandler.CreateMember)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/members", we.idTokenAuthWrapFunc(we.apisHandler.DeleteMember)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/members/picker", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupMemberPicker)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{group-id}/members/multi-update", we.idTokenAuthWrapFunc(we.apisHandler.MultiUpdateMembers)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/bans", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupBans)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/bans", we.idTokenAuthWrapFunc(we.apisHandler.CreateGroupBan)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/bans/{ban-id}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupBan)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/pending-members/decisions", we.idTokenAuthWrapFunc(we.apisHandler.CreatePendingMemberDecisions)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/ownership/transfer", we.idTokenAuthWrapFunc(we.apisHandler.TransferGroupOwnership)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/authman/synchronize", we.idTokenAuthWrapFunc(we.apisHandler.SynchAuthmanGroup)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/authman/sync-status", we.idTokenAuthWrapFunc(we.apisHandler.GetAuthmanSyncStatus)).Methods("GET")
 	restSubrouter.HandleFunc("/memberships/{membership-id}/approval", we.idTokenAuthWrapFunc(we.apisHandler.MembershipApproval)).Methods("PUT")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/mute", we.idTokenAuthWrapFunc(we.apisHandler.MuteMembership)).Methods("PUT")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/mute", we.idTokenAuthWrapFunc(we.apisHandler.UnmuteMembership)).Methods("DELETE")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/snooze", we.idTokenAuthWrapFunc(we.apisHandler.SnoozeMembership)).Methods("PUT")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/snooze", we.idTokenAuthWrapFunc(we.apisHandler.UnsnoozeMembership)).Methods("DELETE")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/transition", we.idTokenAuthWrapFunc(we.apisHandler.ScheduleMembershipTransition)).Methods("PUT")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/transition", we.idTokenAuthWrapFunc(we.apisHandler.CancelMembershipTransition)).Methods("DELETE")
+	restSubrouter.HandleFunc("/memberships/{membership-id}/digest", we.idTokenAuthWrapFunc(we.apisHandler.UpdateMembershipDigest)).Methods("PUT")
 	restSubrouter.HandleFunc("/memberships/{membership-id}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteMembership)).Methods("DELETE")
 	restSubrouter.HandleFunc("/memberships/{membership-id}", we.idTokenAuthWrapFunc(we.apisHandler.UpdateMembership)).Methods("PUT")
 
 	restSubrouter.HandleFunc("/group/{group-id}/events", we.idTokenAuthWrapFunc(we.apisHandler.CreateGroupEvent)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/events", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupEvent)).Methods("PUT")
 	restSubrouter.HandleFunc("/group/{group-id}/event/{event-id}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupEvent)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/feature", we.idTokenAuthWrapFunc(we.apisHandler.FeatureGroupEvent)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/feature", we.idTokenAuthWrapFunc(we.apisHandler.UnfeatureGroupEvent)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/rsvp", we.idTokenAuthWrapFunc(we.apisHandler.UpdateEventRSVP)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/rsvps", we.idTokenAuthWrapFunc(we.apisHandler.GetEventRSVPs)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/reminder", we.idTokenAuthWrapFunc(we.apisHandler.UpdateEventReminder)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/rsvps/reminder", we.idTokenAuthWrapFunc(we.apisHandler.SendEventRSVPReminder)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/checkin-codes", we.idTokenAuthWrapFunc(we.apisHandler.CreateEventCheckInCode)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/events/{event-id}/checkin", we.idTokenAuthWrapFunc(we.apisHandler.CheckInToEvent)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/messages", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupMessages)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/messages", we.idTokenAuthWrapFunc(we.apisHandler.CreateGroupMessage)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/messages/stream", we.idTokenAuthWrapFunc(we.apisHandler.StreamGroupMessages)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/messages/{message-id}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupMessage)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/rules", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupRules)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/rules", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupRules)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/join-rules", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupJoinRules)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/join-rules", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupJoinRules)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/org-unit", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupOrgUnit)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/org-unit", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupOrgUnit)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{group-id}/polls/{poll-id}/results", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPollResults)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/rules/acknowledge", we.idTokenAuthWrapFunc(we.apisHandler.AcknowledgeGroupRules)).Methods("POST")
 
 	//extended client id token protection (eg. allow event managers)
 	restSubrouter.HandleFunc("/user/group-memberships", we.idTokenExtendedClientAuthWrapFunc(we.apisHandler.GetUserGroupMemberships)).Methods("GET")
@@ -173,11 +294,30 @@ func (we *Adapter) Start() {
 	// Client Post APIs
 	restSubrouter.HandleFunc("/group/{groupID}/posts", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPosts)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{groupID}/posts", we.idTokenAuthWrapFunc(we.apisHandler.CreateGroupPost)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/drafts", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupDraftPosts)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/pending", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPendingPosts)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/mark-read", we.idTokenAuthWrapFunc(we.apisHandler.MarkGroupPostsRead)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPost)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}", we.idTokenAuthWrapFunc(we.apisHandler.UpdateGroupPost)).Methods("PUT")
 	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/reactions", we.idTokenAuthWrapFunc(we.apisHandler.ReactToGroupPost)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/reactions", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPostReactions)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/report/abuse", we.idTokenAuthWrapFunc(we.apisHandler.ReportAbuseGroupPost)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{groupID}/abuse-reports", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupAbuseReports)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{groupID}/abuse-reports/{id}", we.idTokenAuthWrapFunc(we.apisHandler.ModerateGroupAbuseReport)).Methods("PUT")
 	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}", we.idTokenAuthWrapFunc(we.apisHandler.DeleteGroupPost)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/pin", we.idTokenAuthWrapFunc(we.apisHandler.PinGroupPost)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/pin", we.idTokenAuthWrapFunc(we.apisHandler.UnpinGroupPost)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/bookmark", we.idTokenAuthWrapFunc(we.apisHandler.BookmarkGroupPost)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/bookmark", we.idTokenAuthWrapFunc(we.apisHandler.UnbookmarkGroupPost)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/to-members", we.idTokenAuthWrapFunc(we.apisHandler.AddGroupPostRecipients)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/to-members", we.idTokenAuthWrapFunc(we.apisHandler.RemoveGroupPostRecipients)).Methods("DELETE")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/history", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupPostHistory)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/publish", we.idTokenAuthWrapFunc(we.apisHandler.PublishGroupDraftPost)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{groupID}/posts/{postID}/approval", we.idTokenAuthWrapFunc(we.apisHandler.GroupPostApproval)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/schedule", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupSchedule)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/feed", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupFeed)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/activity", we.idTokenAuthWrapFunc(we.apisHandler.GetGroupActivity)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/actions", we.idTokenAuthWrapFunc(we.apisHandler.ApplyGroupAction)).Methods("POST")
 
 	restSubrouter.HandleFunc("/research-profile/user-count", we.adminIDTokenAuthWrapFunc(we.apisHandler.GetResearchProfileUserCount)).Methods("POST")
 
@@ -188,8 +328,12 @@ func (we *Adapter) Start() {
 	restSubrouter.HandleFunc("/group/events/v3", we.mixedAuthWrapFunc(we.apisHandler.CreateCalendarEventMultiGroup)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/events", we.mixedAuthWrapFunc(we.apisHandler.GetGroupEvents)).Methods("GET")
 	restSubrouter.HandleFunc("/group/{group-id}/events/v2", we.mixedAuthWrapFunc(we.apisHandler.GetGroupEventsV2)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/events/ical/link", we.idTokenAuthWrapFunc(we.apisHandler.CreateCalendarFeedLink)).Methods("POST")
+	restSubrouter.HandleFunc("/group/{group-id}/events/ical/link/rotate", we.idTokenAuthWrapFunc(we.apisHandler.RotateCalendarFeedLink)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/events/v3", we.mixedAuthWrapFunc(we.apisHandler.CreateCalendarEventSingleGroup)).Methods("POST")
 	restSubrouter.HandleFunc("/group/{group-id}/events/v3", we.mixedAuthWrapFunc(we.apisHandler.UpdateCalendarEventSingleGroup)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/events/v3/{event-id}/series", we.idTokenAuthWrapFunc(we.apisHandler.UpdateCalendarEventSeries)).Methods("PUT")
+	restSubrouter.HandleFunc("/group/{group-id}/events/v3/{event-id}/series", we.idTokenAuthWrapFunc(we.apisHandler.DeleteEventSeries)).Methods("DELETE")
 
 	// Analytics
 	analyticsSubrouter := restSubrouter.PathPrefix("/analytics").Subrouter()
@@ -201,11 +345,16 @@ func (we *Adapter) Start() {
 	bbsSubrouter := restSubrouter.PathPrefix("/bbs").Subrouter()
 	bbsSubrouter.HandleFunc("/event/{event_id}/aggregated-users", we.wrapFunc(we.bbsAPIHandler.GetEventUserIDs, we.auth2.bbs.Permissions)).Methods("GET")
 	bbsSubrouter.HandleFunc("/groups/{user_id}/memberships", we.wrapFunc(we.bbsAPIHandler.GetGroupMemberships, we.auth2.bbs.Permissions)).Methods("GET")
+	bbsSubrouter.HandleFunc("/memberships", we.wrapFunc(we.bbsAPIHandler.GetUsersGroupMemberships, we.auth2.bbs.Permissions)).Methods("POST")
 	bbsSubrouter.HandleFunc("/groups/{group_id}/group-memberships", we.wrapFunc(we.bbsAPIHandler.GetGroupMembershipsByGroupID, we.auth2.bbs.Permissions)).Methods("GET")
 	bbsSubrouter.HandleFunc("/groups/events", we.wrapFunc(we.bbsAPIHandler.GetGroupsEvents, we.auth2.bbs.Permissions)).Methods("GET")
 	bbsSubrouter.HandleFunc("/groups", we.wrapFunc(we.bbsAPIHandler.GetGroupsByGroupIDs, we.auth2.bbs.Permissions)).Methods("GET")
+	bbsSubrouter.HandleFunc("/accounts/merge", we.wrapFunc(we.bbsAPIHandler.MergeAccounts, we.auth2.bbs.Permissions)).Methods("POST")
+	bbsSubrouter.HandleFunc("/groups", we.wrapFunc(we.bbsAPIHandler.CreateServiceGroup, we.auth2.bbsScopes.Standard)).Methods("POST")
+	bbsSubrouter.HandleFunc("/groups/{group_id}", we.wrapFunc(we.bbsAPIHandler.UpdateServiceGroup, we.auth2.bbsScopes.Standard)).Methods("PUT")
+	bbsSubrouter.HandleFunc("/groups/{group_id}/members", we.wrapFunc(we.bbsAPIHandler.CreateServiceGroupMember, we.auth2.bbsScopes.Standard)).Methods("POST")
 
-	log.Fatal(http.ListenAndServe(":"+we.port, router))
+	log.Fatal(http.ListenAndServe(":"+we.port, errorEnvelopeHandler(readinessHandler(deadlineHandler(router, we.requestTimeout, we.longRequestTimeout), we.isReady))))
 }
 
 func (we Adapter) serveDoc(w http.ResponseWriter, r *http.Request) {
@@ -237,6 +386,24 @@ func (we Adapter) apiKeysAuthWrapFunc(handler apiKeyAuthFunc) http.HandlerFunc {
 	}
 }
 
+// clientIDWrapFunc serves the public APIs which are requested without any credentials, i.e. the feeds embedded in the websites
+func (we Adapter) clientIDWrapFunc(handler apiKeyAuthFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, req *http.Request) {
+		logObj := we.logger.NewRequestLog(req)
+		logObj.RequestReceived()
+
+		clientIDOK, clientID := we.auth.clientIDCheck(req)
+		if !clientIDOK {
+			log.Printf("%s %s Bad request - unsupported APP header", req.Method, req.URL.Path)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		handler(clientID, w, req)
+		logObj.RequestComplete()
+	}
+}
+
 type idTokenAuthFunc = func(string, *model.User, http.ResponseWriter, *http.Request)
 
 func (we Adapter) idTokenAuthWrapFunc(handler idTokenAuthFunc) http.HandlerFunc {
@@ -371,6 +538,8 @@ func (we Adapter) wrapFunc(handler handleFunc, authorization tokenauth.Handler)
 
 			logObj.SetContext("account_id", claims.Subject)
 
+			// the unsupported clients are left empty for the APIs working on the client data
+			_, clientID := we.auth.clientIDCheck(req)
 			user := model.User{
 				AppID:       claims.AppID,
 				OrgID:       claims.OrgID,
@@ -379,6 +548,7 @@ func (we Adapter) wrapFunc(handler handleFunc, authorization tokenauth.Handler)
 				IsBBUser:    true,
 				IsCoreUser:  true,
 				Permissions: we.getPermissions(claims),
+				ClientID:    clientID,
 			}
 			response = handler(logObj, req, &user)
 		} else {
@@ -398,22 +568,34 @@ func newBBsStandardHandler(serviceRegManager *authservice.ServiceRegManager) (*t
 		return nil, errors.WrapErrorAction(logutils.ActionCreate, "bbs token auth", nil, err)
 	}
 
-	check := func(claims *tokenauth.Claims, req *http.Request) (int, error) {
-		if !claims.Service {
-			return http.StatusUnauthorized, errors.ErrorData(logutils.StatusInvalid, "service claim", nil)
-		}
-
-		if !claims.FirstParty {
-			return http.StatusUnauthorized, errors.ErrorData(logutils.StatusInvalid, "first party claim", nil)
-		}
+	auth := tokenauth.NewStandardHandler(*bbsTokenAuth, bbsClaimsCheck)
+	return &auth, nil
+}
 
-		return http.StatusOK, nil
+// newBBsScopeHandler authorizes the service accounts managing the groups by the scopes of their tokens instead of the permissions
+func newBBsScopeHandler(serviceRegManager *authservice.ServiceRegManager) (*tokenauth.StandardHandler, error) {
+	bbsScopeAuth := authorization.NewCasbinScopeAuthorization("driver/web/authorization_bbs_scope_policy.csv", serviceRegManager.AuthService.ServiceID)
+	bbsTokenAuth, err := tokenauth.NewTokenAuth(true, serviceRegManager, nil, bbsScopeAuth)
+	if err != nil {
+		return nil, errors.WrapErrorAction(logutils.ActionCreate, "bbs scope token auth", nil, err)
 	}
 
-	auth := tokenauth.NewStandardHandler(*bbsTokenAuth, check)
+	auth := tokenauth.NewScopeHandler(*bbsTokenAuth, bbsClaimsCheck)
 	return &auth, nil
 }
 
+func bbsClaimsCheck(claims *tokenauth.Claims, req *http.Request) (int, error) {
+	if !claims.Service {
+		return http.StatusUnauthorized, errors.ErrorData(logutils.StatusInvalid, "service claim", nil)
+	}
+
+	if !claims.FirstParty {
+		return http.StatusUnauthorized, errors.ErrorData(logutils.StatusInvalid, "first party claim", nil)
+	}
+
+	return http.StatusOK, nil
+}
+
 // END BBs auth //////////
 
 func (we Adapter) getPermissions(claims *tokenauth.Claims) []string {
@@ -435,7 +617,8 @@ func (we Adapter) completeResponse(w http.ResponseWriter, response logs.HTTPResp
 // NewWebAdapter creates new WebAdapter instance
 func NewWebAdapter(app *core.Application, host string, port string, supportedClientIDs []string, appKeys []string, oidcProvider string, oidcClientID string,
 	oidcExtendedClientIDs string, oidcAdminClientID string, oidcAdminWebClientID string,
-	internalAPIKey string, serviceRegManager *authservice.ServiceRegManager, groupServiceURL string, logger *logs.Logger) *Adapter {
+	internalAPIKey string, serviceRegManager *authservice.ServiceRegManager, groupServiceURL string, requestTimeout time.Duration,
+	longRequestTimeout time.Duration, devTokenIssuer DevTokenIssuer, logger *logs.Logger) *Adapter {
 	authorization := casbin.NewEnforcer("driver/web/authorization_model.conf", "driver/web/authorization_policy.csv")
 
 	auth := NewAuth(app, host, supportedClientIDs, appKeys, internalAPIKey, oidcProvider, oidcClientID, oidcExtendedClientIDs, oidcAdminClientID,
@@ -453,5 +636,7 @@ func NewWebAdapter(app *core.Application, host string, port string, supportedCli
 	bbApisHandler := rest.NewBBApisHandler(app)
 
 	return &Adapter{host: host, port: port, auth: auth, auth2: auth2, apisHandler: apisHandler, adminApisHandler: adminApisHandler,
-		internalApisHandler: internalApisHandler, analyticsApisHandler: analyticsApisHandler, bbsAPIHandler: bbApisHandler, logger: logger}
+		internalApisHandler: internalApisHandler, analyticsApisHandler: analyticsApisHandler, bbsAPIHandler: bbApisHandler,
+		requestTimeout: requestTimeout, longRequestTimeout: longRequestTimeout, devTokenIssuer: devTokenIssuer, isReady: app.Services.IsReady,
+		logger: logger}
 }
@@ -25,7 +25,8 @@ import (
 
 // Auth2 handler
 type Auth2 struct {
-	bbs tokenauth.Handlers
+	bbs       tokenauth.Handlers
+	bbsScopes tokenauth.Handlers
 
 	logger *logs.Logger
 }
@@ -46,9 +47,16 @@ func NewAuth2(serviceRegManager *authservice.ServiceRegManager, logger *logs.Log
 	}
 	bbsHandlers := tokenauth.NewHandlers(bbsStandardHandler) //add permissions, user and authenticated
 
+	bbsScopeHandler, err := newBBsScopeHandler(serviceRegManager)
+	if err != nil {
+		return nil, errors.WrapErrorAction(logutils.ActionCreate, "bbs scope auth", nil, err)
+	}
+	bbsScopeHandlers := tokenauth.NewHandlers(bbsScopeHandler)
+
 	auth := Auth2{
-		bbs:    bbsHandlers,
-		logger: logger,
+		bbs:       bbsHandlers,
+		bbsScopes: bbsScopeHandlers,
+		logger:    logger,
 	}
 	return &auth, nil
 }
@@ -0,0 +1,135 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+)
+
+// longRequestPathPrefixes are the route classes which are allowed to run up to the long request deadline
+var longRequestPathPrefixes = []string{"/gr/api/admin", "/gr/api/analytics", "/gr/api/int", "/gr/api/bbs"}
+
+// streamingPathSuffixes are the server-sent events routes which stay open and are not buffered nor limited by a deadline
+var streamingPathSuffixes = []string{"/messages/stream"}
+
+// deadlineHandler cancels the requests which exceed the deadline of their route class and responds with 504.
+// The response of the wrapped handler is buffered so nothing is written once the deadline is exceeded.
+// Only the reads are limited. The storage writes do not get the request context, so a mutating request could still commit after
+// the 504 and be applied twice by a client retry.
+func deadlineHandler(next http.Handler, requestTimeout time.Duration, longRequestTimeout time.Duration) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		timeout := requestTimeout
+		for _, prefix := range longRequestPathPrefixes {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				timeout = longRequestTimeout
+				break
+			}
+		}
+		if timeout <= 0 || !isReadRequest(r) || isStreamingRequest(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), timeout)
+		defer cancel()
+
+		tw := &deadlineWriter{header: make(http.Header)}
+		done := make(chan struct{})
+		panicChan := make(chan interface{}, 1)
+		go func() {
+			defer func() {
+				if p := recover(); p != nil {
+					panicChan <- p
+				}
+			}()
+			next.ServeHTTP(tw, r.WithContext(ctx))
+			close(done)
+		}()
+
+		select {
+		case p := <-panicChan:
+			panic(p)
+		case <-done:
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			for key, values := range tw.header {
+				w.Header()[key] = values
+			}
+			if tw.code == 0 {
+				tw.code = http.StatusOK
+			}
+			w.WriteHeader(tw.code)
+			w.Write(tw.buffer.Bytes())
+		case <-ctx.Done():
+			tw.mu.Lock()
+			defer tw.mu.Unlock()
+			tw.timedOut = true
+			log.Printf("request %s %s exceeded the deadline of %s", r.Method, r.URL.Path, timeout)
+			http.Error(w, http.StatusText(http.StatusGatewayTimeout), http.StatusGatewayTimeout)
+		}
+	})
+}
+
+func isReadRequest(r *http.Request) bool {
+	return r.Method == http.MethodGet || r.Method == http.MethodHead
+}
+
+func isStreamingRequest(r *http.Request) bool {
+	for _, suffix := range streamingPathSuffixes {
+		if strings.HasSuffix(r.URL.Path, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+type deadlineWriter struct {
+	mu       sync.Mutex
+	header   http.Header
+	buffer   bytes.Buffer
+	code     int
+	timedOut bool
+}
+
+func (tw *deadlineWriter) Header() http.Header {
+	return tw.header
+}
+
+func (tw *deadlineWriter) Write(data []byte) (int, error) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut {
+		return 0, http.ErrHandlerTimeout
+	}
+	if tw.code == 0 {
+		tw.code = http.StatusOK
+	}
+	return tw.buffer.Write(data)
+}
+
+func (tw *deadlineWriter) WriteHeader(code int) {
+	tw.mu.Lock()
+	defer tw.mu.Unlock()
+	if tw.timedOut || tw.code != 0 {
+		return
+	}
+	tw.code = code
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"encoding/json"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/rokwire/core-auth-library-go/v2/tokenauth"
+)
+
+// DevTokenIssuer issues the access tokens of the dev mode
+type DevTokenIssuer interface {
+	IssueToken(claims tokenauth.Claims) (string, error)
+}
+
+type devTokenRequest struct {
+	UserID      string   `json:"user_id"`
+	Name        string   `json:"name"`
+	Email       string   `json:"email"`
+	NetID       string   `json:"net_id"`
+	Permissions []string `json:"permissions"`
+	Anonymous   bool     `json:"anonymous"`
+	Service     bool     `json:"service"` // issues a first party service token accepted by the BBs APIs
+}
+
+type devTokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+}
+
+// issueDevToken issues an access token for the requested user. It is available only in the dev mode.
+func (we Adapter) issueDevToken(w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error reading the dev token request - %s", err)
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var request devTokenRequest
+	err = json.Unmarshal(data, &request)
+	if err != nil {
+		log.Printf("error unmarshalling the dev token request - %s", err)
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if len(request.UserID) == 0 {
+		http.Error(w, "user_id is required", http.StatusBadRequest)
+		return
+	}
+
+	claims := tokenauth.Claims{
+		Name:        request.Name,
+		Email:       request.Email,
+		UID:         request.NetID,
+		Permissions: strings.Join(request.Permissions, ","),
+		Anonymous:   request.Anonymous,
+		Service:     request.Service,
+		FirstParty:  request.Service,
+	}
+	claims.Subject = request.UserID
+	if len(request.NetID) > 0 {
+		claims.ExternalIDs = map[string]string{"net_id": request.NetID}
+	}
+
+	token, err := we.devTokenIssuer.IssueToken(claims)
+	if err != nil {
+		log.Printf("error issuing the dev token - %s", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response, err := json.Marshal(devTokenResponse{AccessToken: token, TokenType: "Bearer"})
+	if err != nil {
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(response)
+}
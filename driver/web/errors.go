@@ -0,0 +1,97 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"bytes"
+	"groups/utils"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/google/uuid"
+)
+
+// traceIDHeader is the response header with the id of the request, it is also included in the error responses
+const traceIDHeader = "X-Trace-ID"
+
+// errorEnvelopeHandler responds with the standardized error envelope for all the APIs. The errors written by the handlers as
+// plain text or without a body are wrapped in the envelope with the code mapped from the HTTP status, the envelopes written by the
+// handlers get the trace id of the request.
+func errorEnvelopeHandler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceID := uuid.NewString()
+		w.Header().Set(traceIDHeader, traceID)
+
+		ew := &errorEnvelopeWriter{ResponseWriter: w}
+		next.ServeHTTP(ew, r)
+		if ew.status < http.StatusBadRequest {
+			return
+		}
+
+		body := ew.buffer.Bytes()
+		groupErr := utils.ParseGroupError(body)
+		if groupErr == nil {
+			groupErr = utils.NewStatusError(ew.status, strings.TrimSpace(string(body)))
+		}
+		groupErr.TraceID = traceID
+		if ew.status >= http.StatusInternalServerError {
+			log.Printf("trace %s: %s %s responded with %d - %s", traceID, r.Method, r.URL.Path, ew.status, strings.TrimSpace(string(body)))
+		}
+
+		w.Header().Del("Content-Length")
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.WriteHeader(ew.status)
+		w.Write([]byte(groupErr.JSONErrorString()))
+	})
+}
+
+// errorEnvelopeWriter passes the successful responses through and buffers the error responses, so they could be wrapped
+type errorEnvelopeWriter struct {
+	http.ResponseWriter
+	status int
+	buffer bytes.Buffer
+}
+
+func (ew *errorEnvelopeWriter) WriteHeader(code int) {
+	if ew.status != 0 {
+		return
+	}
+	ew.status = code
+	if code < http.StatusBadRequest {
+		ew.ResponseWriter.WriteHeader(code)
+	}
+}
+
+func (ew *errorEnvelopeWriter) Write(data []byte) (int, error) {
+	if ew.status == 0 {
+		ew.WriteHeader(http.StatusOK)
+	}
+	if ew.status >= http.StatusBadRequest {
+		return ew.buffer.Write(data)
+	}
+	return ew.ResponseWriter.Write(data)
+}
+
+// Flush keeps the server-sent events streaming
+func (ew *errorEnvelopeWriter) Flush() {
+	if ew.status >= http.StatusBadRequest {
+		return
+	}
+	if flusher, ok := ew.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
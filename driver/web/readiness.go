@@ -0,0 +1,37 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"groups/utils"
+	"net/http"
+	"strings"
+)
+
+// readinessRetryAfter is the number of seconds the clients are asked to wait while the service warms up
+const readinessRetryAfter = "5"
+
+// readinessHandler rejects the API requests with 503 until the startup warmup completes, so the first requests after deploy are not
+// served by a cold instance. The docs, the version and the readiness probe are always served.
+func readinessHandler(next http.Handler, isReady func() bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.HasPrefix(r.URL.Path, "/gr/api/") && !isReady() {
+			w.Header().Set("Retry-After", readinessRetryAfter)
+			http.Error(w, utils.NewServiceUnavailableError().JSONErrorString(), http.StatusServiceUnavailable)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
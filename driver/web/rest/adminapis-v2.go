@@ -258,7 +258,7 @@ func (h *AdminApisHandler) GetGroupV2(clientID string, current *model.User, w ht
 		return
 	}
 
-	group, err := h.app.Services.GetGroup(clientID, current, id)
+	group, err := h.app.Services.GetGroupDetails(clientID, current, id)
 	if err != nil {
 		log.Printf("adminapis.GetGroupV2() error on getting group %s - %s", id, err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
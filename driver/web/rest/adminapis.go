@@ -22,6 +22,7 @@ import (
 	"io"
 	"log"
 	"net/http"
+	"net/url"
 	"strconv"
 	"strings"
 
@@ -152,6 +153,7 @@ func (h *AdminApisHandler) GetUserGroups(clientID string, current *model.User, w
 // @Param offset query string false "Deprecated - instead use request body filter! offset - skip number of records"
 // @Param limit query string false "Deprecated - instead use request body filter! limit - limit the result"
 // @Param include_hidden query string false "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false."
+// @Param creator_id query string false "Gives the groups created by the account, the research groups included"
 // @Param data body model.GroupsFilter true "body data"
 // @Success 200 {array} model.Group
 // @Security APIKeyAuth
@@ -204,6 +206,11 @@ func (h *AdminApisHandler) GetAllGroups(clientID string, current *model.User, w
 		}
 	}
 
+	creatorIDs, ok := r.URL.Query()["creator_id"]
+	if ok && len(creatorIDs[0]) > 0 {
+		groupsFilter.CreatorID = &creatorIDs[0]
+	}
+
 	requestData, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.Printf("adminapis.GetAllGroups() error on marshal model.GroupsFilter request body - %s\n", err.Error())
@@ -219,7 +226,8 @@ func (h *AdminApisHandler) GetAllGroups(clientID string, current *model.User, w
 		}
 	}
 
-	if groupsFilter.ResearchGroup == nil {
+	// all the groups of the creator are listed unless the research filter is set explicitly
+	if groupsFilter.ResearchGroup == nil && groupsFilter.CreatorID == nil {
 		b := false
 		groupsFilter.ResearchGroup = &b
 	}
@@ -308,32 +316,37 @@ func (h *AdminApisHandler) GetGroupStats(clientID string, current *model.User, w
 }
 
 type adminCreateGroupRequest struct {
-	Title                    string                         `json:"title" validate:"required"`
-	Description              *string                        `json:"description"`
-	Category                 string                         `json:"category"`
-	Tags                     []string                       `json:"tags"`
-	Privacy                  string                         `json:"privacy" validate:"required,oneof=public private"`
-	Hidden                   bool                           `json:"hidden_for_search"`
-	CreatorName              string                         `json:"creator_name"`
-	CreatorEmail             string                         `json:"creator_email"`
-	CreatorPhotoURL          string                         `json:"creator_photo_url"`
-	ImageURL                 *string                        `json:"image_url"`
-	WebURL                   *string                        `json:"web_url"`
-	MembershipQuestions      []string                       `json:"membership_questions"`
-	AuthmanEnabled           bool                           `json:"authman_enabled"`
-	AuthmanGroup             *string                        `json:"authman_group"`
-	OnlyAdminsCanCreatePolls bool                           `json:"only_admins_can_create_polls" `
-	CanJoinAutomatically     bool                           `json:"can_join_automatically"`
-	AttendanceGroup          bool                           `json:"attendance_group" `
-	ResearchOpen             bool                           `json:"research_open"`
-	ResearchGroup            bool                           `json:"research_group"`
-	ResearchConsentStatement string                         `json:"research_consent_statement"`
-	ResearchConsentDetails   string                         `json:"research_consent_details"`
-	ResearchDescription      string                         `json:"research_description"`
-	ResearchProfile          map[string]map[string][]string `json:"research_profile"`
-	Settings                 *model.GroupSettings           `json:"settings"`
-	Attributes               map[string]interface{}         `json:"attributes"`
-	MembersConfig            *model.DefaultMembershipConfig `json:"members,omitempty"`
+	Title                     string                         `json:"title" validate:"required"`
+	Description               *string                        `json:"description"`
+	Category                  string                         `json:"category"`
+	Categories                []string                       `json:"categories"`
+	Tags                      []string                       `json:"tags"`
+	Privacy                   string                         `json:"privacy" validate:"required,oneof=public private"`
+	Hidden                    bool                           `json:"hidden_for_search"`
+	CreatorName               string                         `json:"creator_name"`
+	CreatorEmail              string                         `json:"creator_email"`
+	CreatorPhotoURL           string                         `json:"creator_photo_url"`
+	ImageURL                  *string                        `json:"image_url"`
+	WebURL                    *string                        `json:"web_url"`
+	MembershipQuestions       []string                       `json:"membership_questions"`
+	MembershipQuestionsSchema []model.MembershipQuestion     `json:"membership_questions_schema"`
+	AuthmanEnabled            bool                           `json:"authman_enabled"`
+	AuthmanGroup              *string                        `json:"authman_group"`
+	AuthmanGroups             []string                       `json:"authman_groups"`
+	OnlyAdminsCanCreatePolls  bool                           `json:"only_admins_can_create_polls" `
+	CanJoinAutomatically      bool                           `json:"can_join_automatically"`
+	AttendanceGroup           bool                           `json:"attendance_group" `
+	ResearchOpen              bool                           `json:"research_open"`
+	ResearchGroup             bool                           `json:"research_group"`
+	ResearchConsentStatement  string                         `json:"research_consent_statement"`
+	ResearchConsentDetails    string                         `json:"research_consent_details"`
+	ResearchDescription       string                         `json:"research_description"`
+	ResearchProfile           map[string]map[string][]string `json:"research_profile"`
+	Settings                  *model.GroupSettings           `json:"settings"`
+	Attributes                map[string]interface{}         `json:"attributes"`
+	MembersConfig             *model.DefaultMembershipConfig `json:"members,omitempty"`
+	Location                  *model.GeoPoint                `json:"location"`
+	Localizations             model.GroupLocalizations       `json:"localizations"`
 } //@name adminCreateGroupRequest
 
 // CreateGroup creates a group
@@ -372,6 +385,40 @@ func (h *AdminApisHandler) CreateGroup(clientID string, current *model.User, w h
 		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
 		return
 	}
+	if requestData.Location != nil {
+		err = requestData.Location.Validate()
+		if err != nil {
+			log.Printf("Error on validating create group location - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	if requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+		if err != nil {
+			log.Printf("Error on validating create group settings - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	err = requestData.Localizations.Validate()
+	if err != nil {
+		log.Printf("Error on validating create group localizations - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	if err != nil {
+		log.Printf("Error on validating create group Authman groups - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	if err != nil {
+		log.Printf("Error on validating create group membership questions - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
 
 	if requestData.AuthmanEnabled && !current.HasPermission("managed_group_admin") {
 		log.Printf("Only managed_group_admin could create a managed group")
@@ -386,28 +433,33 @@ func (h *AdminApisHandler) CreateGroup(clientID string, current *model.User, w h
 	}
 
 	groupData := &model.Group{
-		Title:                    requestData.Title,
-		Description:              requestData.Description,
-		Category:                 requestData.Category,
-		Tags:                     requestData.Tags,
-		Privacy:                  requestData.Privacy,
-		HiddenForSearch:          requestData.Hidden,
-		ImageURL:                 requestData.ImageURL,
-		WebURL:                   requestData.WebURL,
-		MembershipQuestions:      requestData.MembershipQuestions,
-		AuthmanGroup:             requestData.AuthmanGroup,
-		AuthmanEnabled:           requestData.AuthmanEnabled,
-		OnlyAdminsCanCreatePolls: requestData.OnlyAdminsCanCreatePolls,
-		CanJoinAutomatically:     requestData.CanJoinAutomatically,
-		AttendanceGroup:          requestData.AttendanceGroup,
-		ResearchGroup:            requestData.ResearchGroup,
-		ResearchOpen:             requestData.ResearchOpen,
-		ResearchConsentStatement: requestData.ResearchConsentStatement,
-		ResearchConsentDetails:   requestData.ResearchConsentDetails,
-		ResearchDescription:      requestData.ResearchDescription,
-		ResearchProfile:          requestData.ResearchProfile,
-		Settings:                 requestData.Settings,
-		Attributes:               requestData.Attributes,
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
+		ResearchGroup:             requestData.ResearchGroup,
+		ResearchOpen:              requestData.ResearchOpen,
+		ResearchConsentStatement:  requestData.ResearchConsentStatement,
+		ResearchConsentDetails:    requestData.ResearchConsentDetails,
+		ResearchDescription:       requestData.ResearchDescription,
+		ResearchProfile:           requestData.ResearchProfile,
+		Settings:                  requestData.Settings,
+		Attributes:                requestData.Attributes,
+		Location:                  requestData.Location,
+		Localizations:             requestData.Localizations,
 	}
 
 	insertedID, groupErr := h.app.Services.CreateGroup(clientID, current, groupData, requestData.MembersConfig)
@@ -477,6 +529,40 @@ func (h *AdminApisHandler) UpdateGroup(clientID string, current *model.User, w h
 		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
 		return
 	}
+	if requestData.Location != nil {
+		err = requestData.Location.Validate()
+		if err != nil {
+			log.Printf("Error on validating update group location - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	if requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+		if err != nil {
+			log.Printf("Error on validating update group settings - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	err = requestData.Localizations.Validate()
+	if err != nil {
+		log.Printf("Error on validating update group localizations - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	if err != nil {
+		log.Printf("Error on validating update group Authman groups - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	if err != nil {
+		log.Printf("Error on validating update group membership questions - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
 
 	//check if allowed to update
 	group, err := h.app.Services.GetGroup(clientID, current, id)
@@ -497,21 +583,24 @@ func (h *AdminApisHandler) UpdateGroup(clientID string, current *model.User, w h
 	}
 
 	groupErr := h.app.Services.UpdateGroup(clientID, current, &model.Group{
-		ID:                       id,
-		Title:                    requestData.Title,
-		Description:              requestData.Description,
-		Category:                 requestData.Category,
-		Tags:                     requestData.Tags,
-		Privacy:                  requestData.Privacy,
-		HiddenForSearch:          requestData.Hidden,
-		ImageURL:                 requestData.ImageURL,
-		WebURL:                   requestData.WebURL,
-		MembershipQuestions:      requestData.MembershipQuestions,
-		AuthmanGroup:             requestData.AuthmanGroup,
-		AuthmanEnabled:           requestData.AuthmanEnabled,
-		OnlyAdminsCanCreatePolls: requestData.OnlyAdminsCanCreatePolls,
-		CanJoinAutomatically:     requestData.CanJoinAutomatically,
-		AttendanceGroup:          requestData.AttendanceGroup,
+		ID:                        id,
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
 
 		ResearchGroup:            requestData.ResearchGroup,
 		ResearchOpen:             requestData.ResearchOpen,
@@ -521,6 +610,8 @@ func (h *AdminApisHandler) UpdateGroup(clientID string, current *model.User, w h
 		ResearchProfile:          requestData.ResearchProfile,
 		Settings:                 requestData.Settings,
 		Attributes:               requestData.Attributes,
+		Location:                 requestData.Location,
+		Localizations:            requestData.Localizations,
 	})
 	if groupErr != nil {
 		log.Printf("Error on updating group - %s\n", err)
@@ -568,6 +659,13 @@ func (h *AdminApisHandler) GetGroupMembers(clientID string, current *model.User,
 		}
 	}
 
+	err = request.Validate()
+	if err != nil {
+		log.Printf("adminapis.GetGroupMembers() error: %s", err.Error())
+		http.Error(w, utils.NewBadRequestError(err.Error()).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
 	request.GroupIDs = append(request.GroupIDs, groupID)
 
 	//check if allowed to update
@@ -595,7 +693,7 @@ func (h *AdminApisHandler) GetGroupMembers(clientID string, current *model.User,
 }
 
 // GetGroupMembersV2 Gets the list of group members.
-// @Description Gets the list of group members.
+// @Description Gets the list of group members. The search filter finds the members by a case-insensitive part of the name, the email or the NetID.
 // @ID AdminGetGroupMembersV2
 // @Tags Admin
 // @Accept plain
@@ -630,6 +728,13 @@ func (h *AdminApisHandler) GetGroupMembersV2(clientID string, current *model.Use
 		}
 	}
 
+	err = request.Validate()
+	if err != nil {
+		log.Printf("adminapis.GetGroupMembers() error: %s", err.Error())
+		http.Error(w, utils.NewBadRequestError(err.Error()).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
 	request.GroupIDs = append(request.GroupIDs, groupID)
 
 	//check if allowed to update
@@ -727,11 +832,12 @@ func (h *AdminApisHandler) CreateMemberships(clientID string, current *model.Use
 }
 
 type adminUpdateMembershipRequest struct {
-	Status *string `json:"status" validate:"required,oneof=pending member admin rejected"`
+	Status       *string `json:"status" validate:"required,oneof=pending member admin rejected"`
+	ShowAsLeader *bool   `json:"show_as_leader"`
 } // @name adminUpdateMembershipRequest
 
-// UpdateMembership updates a membership. Only the status can be changed.
-// @Description Updates a membership. Only the status can be changed.
+// UpdateMembership updates a membership. Only the status and the show_as_leader flag can be changed.
+// @Description Updates a membership. Only the status and the show_as_leader flag can be changed.
 // @ID AdminUpdateMembership
 // @Tags Admin
 // @Accept json
@@ -740,6 +846,7 @@ type adminUpdateMembershipRequest struct {
 // @Param data body updateMembershipRequest true "body data"
 // @Param membership-id path string true "Membership ID"
 // @Success 200
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/admin/memberships/{membership-id} [put]
 func (h *AdminApisHandler) UpdateMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -785,8 +892,11 @@ func (h *AdminApisHandler) UpdateMembership(clientID string, current *model.User
 	var status *string
 	status = requestData.Status
 
-	err = h.app.Services.UpdateMembership(clientID, current, membershipID, status, nil, nil)
+	err = h.app.Services.UpdateMembership(clientID, current, membershipID, status, nil, nil, requestData.ShowAsLeader, nil, nil)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Printf("adminapis.UpdateMembership() Error on updating membership - %s\n", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -805,6 +915,7 @@ func (h *AdminApisHandler) UpdateMembership(clientID string, current *model.User
 // @Param APP header string true "APP"
 // @Param membership-id path string true "Membership ID"
 // @Success 200
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/admin/memberships/{membership-id} [delete]
 func (h *AdminApisHandler) DeleteMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -829,6 +940,9 @@ func (h *AdminApisHandler) DeleteMembership(clientID string, current *model.User
 
 	err = h.app.Services.DeleteMembershipByID(clientID, current, membershipID)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Printf("adminapis.DeleteMembership() Error: %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -858,7 +972,7 @@ func (h *AdminApisHandler) GetGroupEvents(clientID string, current *model.User,
 		return
 	}
 
-	events, err := h.app.Services.GetEvents(clientID, current, groupID, false)
+	events, err := h.app.Services.GetEvents(clientID, current, groupID, false, nil)
 	if err != nil {
 		log.Printf("error getting group events - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -883,13 +997,14 @@ func (h *AdminApisHandler) GetGroupEvents(clientID string, current *model.User,
 }
 
 // DeleteGroup deletes a group
-// @Description Deletes a group.
+// @Description Deletes a group. The group is excluded from all the queries at once and its content is deleted in the background once the restore period of the group deletion policy passes, the progress is given by the admin group deletion jobs API. The event mappings of the group are archived and the members who responded to the upcoming events are notified.
 // @ID AdminDeleteGroup
 // @Tags Admin
 // @Accept json
 // @Produce json
 // @Param APP header string true "APP"
 // @Param id path string true "ID"
+// @Param cancel_events query bool false "Cancels the upcoming events linked only to the group in the Calendar BB"
 // @Success 200 {string} Successfully deleted
 // @Security AppUserAuth
 // @Router /api/admin/group/{id} [delete]
@@ -909,13 +1024,19 @@ func (h *AdminApisHandler) DeleteGroup(clientID string, current *model.User, w h
 		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
 		return
 	}
+	if group == nil {
+		log.Printf("there is no a group for the provided id - %s", id)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
 	if group.AuthmanEnabled && !current.HasPermission("managed_group_admin") {
 		log.Printf("%s is not allowed to update group settings '%s'. Only user with managed_group_admin permission could delete a managed group", current.Email, group.Title)
 		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
 		return
 	}
 
-	err = h.app.Services.DeleteGroup(clientID, current, id)
+	cancelEvents := r.URL.Query().Get("cancel_events") == "true"
+	err = h.app.Services.DeleteGroup(clientID, current, id, cancelEvents)
 	if err != nil {
 		log.Printf("Error on deleting group - %s\n", err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -1004,6 +1125,52 @@ func (h *AdminApisHandler) DeleteGroupPost(clientID string, current *model.User,
 	w.WriteHeader(http.StatusOK)
 }
 
+// RestoreGroupPost Restores a deleted post
+// @Description Restores a deleted post together with the replies which have been deleted with it. A reply can be restored only if its parent post is not deleted.
+// @ID AdminRestoreGroupPost
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {object} model.Post
+// @Security AppUserAuth
+// @Security APIKeyAuth
+// @Router /api/admin/group/{group-id}/posts/{postID}/restore [post]
+func (h *AdminApisHandler) RestoreGroupPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("groupID is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	postID := params["postID"]
+	if len(postID) <= 0 {
+		log.Println("postID is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	post, err := h.app.Services.RestorePost(clientID, current, groupID, postID)
+	if err != nil {
+		log.Printf("error restoring post (%s) - %s", postID, err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(post)
+	if err != nil {
+		log.Printf("error on marshal restored post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
 // GetManagedGroupConfigs gets managed group configs
 // @Description Gets managed group configs
 // @ID AdminGetManagedGroupConfigs
@@ -1215,6 +1382,695 @@ func (h *AdminApisHandler) SaveSyncConfig(clientID string, current *model.User,
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetPrivacyConfig gets privacy config
+// @Description Gets the privacy config applied to the analytics and stats exports
+// @ID AdminGetPrivacyConfig
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Success 200 {object} model.PrivacyConfig
+// @Security AppUserAuth
+// @Router /api/admin/privacy-config [get]
+func (h *AdminApisHandler) GetPrivacyConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetPrivacyConfig(clientID)
+	if err != nil {
+		log.Printf("error getting privacy config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal privacy config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SavePrivacyConfig saves privacy config
+// @Description Saves the privacy config applied to the analytics and stats exports
+// @ID AdminSavePrivacyConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.PrivacyConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/privacy-config [put]
+func (h *AdminApisHandler) SavePrivacyConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save privacy config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.PrivacyConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the privacy config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if config.KAnonymityThreshold < 0 {
+		log.Println("k_anonymity_threshold must not be negative")
+		http.Error(w, "k_anonymity_threshold must not be negative", http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdatePrivacyConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetLicenseConfig gets license config
+// @Description Gets the licensing metadata attached to the analytics exports and the public groups feeds
+// @ID AdminGetLicenseConfig
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Success 200 {object} model.LicenseConfig
+// @Security AppUserAuth
+// @Router /api/admin/license-config [get]
+func (h *AdminApisHandler) GetLicenseConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetLicenseConfig(clientID)
+	if err != nil {
+		log.Printf("error getting license config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal license config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveLicenseConfig saves license config
+// @Description Saves the licensing metadata attached to the analytics exports and the public groups feeds. The license and terms links are sent as Link headers, the license name and the attribution as X-Content-License and X-Content-Attribution headers.
+// @ID AdminSaveLicenseConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.LicenseConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/license-config [put]
+func (h *AdminApisHandler) SaveLicenseConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save license config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.LicenseConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the license config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	for _, link := range []string{config.LicenseURL, config.TermsURL} {
+		if len(link) == 0 {
+			continue
+		}
+		parsed, err := url.ParseRequestURI(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") {
+			log.Printf("invalid license config link - %s", link)
+			http.Error(w, "license_url and terms_url must be http(s) links", http.StatusBadRequest)
+			return
+		}
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateLicenseConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetMembershipPruningConfig gets membership pruning config
+// @Description Gets the policy for pruning the memberships of long-inactive users
+// @ID AdminGetMembershipPruningConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.MembershipPruningConfig
+// @Security AppUserAuth
+// @Router /api/admin/membership-pruning-config [get]
+func (h *AdminApisHandler) GetMembershipPruningConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetMembershipPruningConfig(clientID)
+	if err != nil {
+		log.Printf("error getting membership pruning config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal membership pruning config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveMembershipPruningConfig saves membership pruning config
+// @Description Saves the policy for pruning the memberships of long-inactive users. Every night the members who have not logged in for inactivity_days are flagged and notified. With auto_remove the flagged memberships are removed after grace_period_days unless the user logs in again. Admins and Authman memberships are never pruned.
+// @ID AdminSaveMembershipPruningConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.MembershipPruningConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/membership-pruning-config [put]
+func (h *AdminApisHandler) SaveMembershipPruningConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save membership pruning config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.MembershipPruningConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the membership pruning config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if config.Enabled && config.InactivityDays <= 0 {
+		log.Println("inactivity_days must be positive")
+		http.Error(w, "inactivity_days must be positive", http.StatusBadRequest)
+		return
+	}
+	if config.GracePeriodDays < 0 {
+		log.Println("grace_period_days must not be negative")
+		http.Error(w, "grace_period_days must not be negative", http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateMembershipPruningConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetPendingMembershipConfig gets pending membership config
+// @Description Gets the policy for the expiration of the pending membership requests and the reminders of the group admins
+// @ID AdminGetPendingMembershipConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.PendingMembershipConfig
+// @Security AppUserAuth
+// @Router /api/admin/pending-membership-config [get]
+func (h *AdminApisHandler) GetPendingMembershipConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetPendingMembershipConfig(clientID)
+	if err != nil {
+		log.Printf("error getting pending membership config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal pending membership config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SavePendingMembershipConfig saves pending membership config
+// @Description Saves the policy for the pending membership requests. Every day the requests pending for longer than expiration_days are rejected and the users are notified. The admins of the groups with requests pending for longer than reminder_days are reminded at most once per reminder_days. Zero disables the expiration or the reminders.
+// @ID AdminSavePendingMembershipConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.PendingMembershipConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/pending-membership-config [put]
+func (h *AdminApisHandler) SavePendingMembershipConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save pending membership config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.PendingMembershipConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the pending membership config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if config.ExpirationDays < 0 || config.ReminderDays < 0 {
+		log.Println("expiration_days and reminder_days must not be negative")
+		http.Error(w, "expiration_days and reminder_days must not be negative", http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdatePendingMembershipConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetPostLimitsConfig gets post limits config
+// @Description Gets the size rules of the posts
+// @ID AdminGetPostLimitsConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.PostLimitsConfig
+// @Security AppUserAuth
+// @Router /api/admin/post-limits-config [get]
+func (h *AdminApisHandler) GetPostLimitsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetPostLimitsConfig(clientID)
+	if err != nil {
+		log.Printf("error getting post limits config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal post limits config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SavePostLimitsConfig saves post limits config
+// @Description Saves the size rules of the posts. The missing limits are not applied. The posts exceeding the limits are rejected with error code 10 and the exceeded limit in the error details. The limits are exposed to the clients by the client config API.
+// @ID AdminSavePostLimitsConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.PostLimitsConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/post-limits-config [put]
+func (h *AdminApisHandler) SavePostLimitsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save post limits config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.PostLimitsConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the post limits config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdatePostLimitsConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetContentFilterConfig gets content filter config
+// @Description Gets the content policy applied on the posts
+// @ID AdminGetContentFilterConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.ContentFilterConfig
+// @Security AppUserAuth
+// @Router /api/admin/content-filter-config [get]
+func (h *AdminApisHandler) GetContentFilterConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetContentFilterConfig(clientID)
+	if err != nil {
+		log.Printf("error getting content filter config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal content filter config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveContentFilterConfig saves content filter config
+// @Description Saves the content policy applied on the created and the updated posts. The subject and the body of the posts are matched against the blocked words and, if external_moderation is set, checked with the external moderation API. The matching posts are rejected with error code 11 (reject), published and reported to the abuse report queue (flag) or only published with the content flags (annotate).
+// @ID AdminSaveContentFilterConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.ContentFilterConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/content-filter-config [put]
+func (h *AdminApisHandler) SaveContentFilterConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save content filter config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.ContentFilterConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the content filter config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateContentFilterConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetContentRateLimitsConfig gets content rate limits config
+// @Description Gets the anti-spam limits of the content created by a user within a group
+// @ID AdminGetContentRateLimitsConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.ContentRateLimitsConfig
+// @Security AppUserAuth
+// @Router /api/admin/content-rate-limits-config [get]
+func (h *AdminApisHandler) GetContentRateLimitsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetContentRateLimitsConfig(clientID)
+	if err != nil {
+		log.Printf("error getting content rate limits config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal content rate limits config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveContentRateLimitsConfig saves content rate limits config
+// @Description Saves the anti-spam limits of the posts, the replies, the reactions and the membership requests created by a user within a group. Each limit allows max actions within window_seconds. The exceeding requests are rejected with 429 and Retry-After header.
+// @ID AdminSaveContentRateLimitsConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.ContentRateLimitsConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/content-rate-limits-config [put]
+func (h *AdminApisHandler) SaveContentRateLimitsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save content rate limits config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.ContentRateLimitsConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the content rate limits config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateContentRateLimitsConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetRejectionReasonsConfig gets rejection reasons config
+// @Description Gets the catalog of the membership rejection reasons
+// @ID AdminGetRejectionReasonsConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.RejectionReasonsConfig
+// @Security AppUserAuth
+// @Router /api/admin/rejection-reasons-config [get]
+func (h *AdminApisHandler) GetRejectionReasonsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetRejectionReasonsConfig(clientID)
+	if err != nil {
+		log.Printf("error getting rejection reasons config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal rejection reasons config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveRejectionReasonsConfig saves rejection reasons config
+// @Description Saves the catalog of the membership rejection reasons. Each reason has a code, a primary message and optional messages by locale. Once the catalog is not empty the membership rejections require a reason code of the catalog.
+// @ID AdminSaveRejectionReasonsConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.RejectionReasonsConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/rejection-reasons-config [put]
+func (h *AdminApisHandler) SaveRejectionReasonsConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save rejection reasons config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.RejectionReasonsConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the rejection reasons config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateRejectionReasonsConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetPostNotificationBatchingConfig gets post notification batching config
+// @Description Gets the batching of the post notifications of the groups with bursty activity
+// @ID AdminGetPostNotificationBatchingConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.PostNotificationBatchingConfig
+// @Security AppUserAuth
+// @Router /api/admin/post-notification-batching-config [get]
+func (h *AdminApisHandler) GetPostNotificationBatchingConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetPostNotificationBatchingConfig(clientID)
+	if err != nil {
+		log.Printf("error getting post notification batching config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal post notification batching config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SavePostNotificationBatchingConfig saves post notification batching config
+// @Description Saves the batching of the post notifications. The first post of a group is notified immediately and the posts following it within window_minutes are notified within a single "N new posts in {group}" summary once the window ends. The replies, the messages to selected members and the posts used as notifications are not batched. Zero disables the batching.
+// @ID AdminSavePostNotificationBatchingConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.PostNotificationBatchingConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/post-notification-batching-config [put]
+func (h *AdminApisHandler) SavePostNotificationBatchingConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save post notification batching config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.PostNotificationBatchingConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the post notification batching config data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if config.WindowMinutes < 0 {
+		log.Println("window_minutes must not be negative")
+		http.Error(w, "window_minutes must not be negative", http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdatePostNotificationBatchingConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetExternalServicesMetrics gets the external services metrics
+// @Description Gives the request metrics and the circuit breaker state of the external services used by the driven adapters
+// @ID AdminGetExternalServicesMetrics
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Success 200 {array} utils.ResilientClientMetrics
+// @Security AppUserAuth
+// @Router /api/admin/external-services/metrics [get]
+func (h *AdminApisHandler) GetExternalServicesMetrics(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	metrics := h.app.Services.GetExternalServicesMetrics()
+
+	data, err := json.Marshal(metrics)
+	if err != nil {
+		log.Println("Error on marshal external services metrics")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
 // SynchronizeAuthman Synchronizes Authman groups membership
 // @Description Synchronizes Authman groups membership
 // @Tags Admin
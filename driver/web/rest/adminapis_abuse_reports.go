@@ -0,0 +1,90 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// GetAbuseReports Gets the abuse reports
+// @Description Gets the abuse reports of the posts and the groups ordered by the creation date (newest first)
+// @ID AdminGetAbuseReports
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group_ids query string false "Comma separated group IDs"
+// @Param post_id query string false "Reported post ID"
+// @Param target_type query string false "post or group"
+// @Param status query string false "Comma separated statuses - open, reviewed, resolved"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.AbuseReport
+// @Security AppUserAuth
+// @Router /api/admin/abuse-reports [get]
+func (h *AdminApisHandler) GetAbuseReports(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	filter := getAbuseReportsFilter(r)
+	if groupIDs := getStringQueryParam(r, "group_ids"); groupIDs != nil {
+		filter.GroupIDs = strings.Split(*groupIDs, ",")
+	}
+	filter.PostID = getStringQueryParam(r, "post_id")
+	filter.TargetType = getStringQueryParam(r, "target_type")
+
+	reports, err := h.app.Services.GetAbuseReports(clientID, filter)
+	if err != nil {
+		log.Printf("error: adminapis.GetAbuseReports() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		log.Printf("error: adminapis.GetAbuseReports() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// ModerateAbuseReport Moderates an abuse report
+// @Description Applies a moderation action on an abuse report. The review action marks the report as reviewed. The dismiss, remove_post and warn_user actions resolve the report. The remove_post and warn_user actions are allowed only for the reports of posts. The resolved reports could not be moderated anymore.
+// @ID AdminModerateAbuseReport
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param id path string true "Abuse report ID"
+// @Param data body moderateAbuseReportRequest true "body data"
+// @Success 200 {object} model.AbuseReport
+// @Security AppUserAuth
+// @Router /api/admin/abuse-reports/{id} [put]
+func (h *AdminApisHandler) ModerateAbuseReport(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if len(id) == 0 {
+		log.Println("Abuse report id is required")
+		http.Error(w, "Abuse report id is required", http.StatusBadRequest)
+		return
+	}
+
+	writeModeratedAbuseReport(w, r, "adminapis.ModerateAbuseReport()", func(requestData moderateAbuseReportRequest) (*model.AbuseReport, error) {
+		return h.app.Services.ModerateAbuseReport(clientID, current, nil, id, requestData.Action, requestData.Comment)
+	})
+}
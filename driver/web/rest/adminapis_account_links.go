@@ -0,0 +1,50 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+)
+
+// RepairAccountLinks Links the missed data of the users added by the external ID
+// @Description Finds the memberships which are not linked to a Core BB account yet and links them, together with the posts and the events addressed to the same external ID, to the accounts which exist already. The memberships of the groups the account is already part of are not claimed.
+// @ID AdminRepairAccountLinks
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.AccountLinkRepairResult
+// @Security AppUserAuth
+// @Router /api/admin/account-links/repair [post]
+func (h *AdminApisHandler) RepairAccountLinks(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	result, err := h.app.Services.RepairAccountLinks(clientID, current)
+	if err != nil {
+		log.Printf("error: adminapis.RepairAccountLinks() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.Printf("error: adminapis.RepairAccountLinks() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
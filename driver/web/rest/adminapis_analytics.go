@@ -0,0 +1,87 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupAnalytics Gets the analytics of a group
+// @Description Gets weekly time-series stats of a group: posts, new members and events per week, the total events count and the count of members who posted or reacted within the last 30 days
+// @ID AdminGetGroupAnalytics
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Group ID"
+// @Param weeks query integer false "Number of weeks including the current one - 12 by default, 52 max"
+// @Success 200 {object} model.GroupAnalytics
+// @Security AppUserAuth
+// @Router /api/admin/group/{id}/analytics [get]
+func (h *AdminApisHandler) GetGroupAnalytics(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	weeks := 0
+	weeksQuery, ok := r.URL.Query()["weeks"]
+	if ok && len(weeksQuery[0]) > 0 {
+		val, err := strconv.Atoi(weeksQuery[0])
+		if err != nil {
+			log.Printf("error: adminapis.GetGroupAnalytics() - invalid weeks - %s", err.Error())
+			http.Error(w, "invalid weeks", http.StatusBadRequest)
+			return
+		}
+		weeks = val
+	}
+
+	group, err := h.app.Services.GetGroupEntity(clientID, groupID)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAnalytics() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: adminapis.GetGroupAnalytics() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	analytics, err := h.app.Services.GetGroupAnalytics(clientID, groupID, weeks)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAnalytics() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(analytics)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAnalytics() - unable to marshal the analytics - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
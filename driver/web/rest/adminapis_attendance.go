@@ -0,0 +1,155 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupAttendance Gets the attendance report of a group
+// @Description Gets the per-member attendance counts across the events of an attendance group. The current members without attendance are included with zero counts, the former members who attended within the range are included with an empty status. Use format=csv to export the report.
+// @ID AdminGetGroupAttendance
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Group ID"
+// @Param start_date query string false "Start date string - RFC3339 encoded"
+// @Param end_date query string false "End date string - RFC3339 encoded"
+// @Param format query string false "json (default) or csv"
+// @Success 200 {object} model.GroupAttendanceReport
+// @Security AppUserAuth
+// @Router /api/admin/group/{id}/attendance [get]
+func (h *AdminApisHandler) GetGroupAttendance(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	var startDate *time.Time
+	startDateStr, ok := r.URL.Query()["start_date"]
+	if ok && len(startDateStr) > 0 && len(startDateStr[0]) > 0 {
+		date, err := time.Parse(time.RFC3339, startDateStr[0])
+		if err != nil {
+			log.Printf("error: adminapis.GetGroupAttendance() - unable to parse start_date - %s", err.Error())
+			http.Error(w, "unable to parse start_date", http.StatusBadRequest)
+			return
+		}
+		startDate = &date
+	}
+
+	var endDate *time.Time
+	endDateStr, ok := r.URL.Query()["end_date"]
+	if ok && len(endDateStr) > 0 && len(endDateStr[0]) > 0 {
+		date, err := time.Parse(time.RFC3339, endDateStr[0])
+		if err != nil {
+			log.Printf("error: adminapis.GetGroupAttendance() - unable to parse end_date - %s", err.Error())
+			http.Error(w, "unable to parse end_date", http.StatusBadRequest)
+			return
+		}
+		endDate = &date
+	}
+
+	format := r.URL.Query().Get("format")
+	if len(format) > 0 && format != "json" && format != "csv" {
+		log.Printf("error: adminapis.GetGroupAttendance() - invalid format %s", format)
+		http.Error(w, "format must be json or csv", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroupEntity(clientID, groupID)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAttendance() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: adminapis.GetGroupAttendance() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	report, err := h.app.Services.GetGroupAttendanceReport(clientID, groupID, startDate, endDate)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAttendance() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	if format == "csv" {
+		data, err := attendanceReportToCSV(report)
+		if err != nil {
+			log.Printf("error: adminapis.GetGroupAttendance() - unable to write the csv - %s", err.Error())
+			http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=\"attendance-%s.csv\"", groupID))
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+		return
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAttendance() - unable to marshal the report - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+func attendanceReportToCSV(report *model.GroupAttendanceReport) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := csv.NewWriter(&buffer)
+	err := writer.Write([]string{"user_id", "name", "email", "net_id", "status", "attended_count", "events_count", "date_last_attended"})
+	if err != nil {
+		return nil, err
+	}
+
+	eventsCount := strconv.Itoa(report.EventsCount)
+	for _, member := range report.Members {
+		var dateLastAttended string
+		if member.DateLastAttended != nil {
+			dateLastAttended = member.DateLastAttended.UTC().Format(time.RFC3339)
+		}
+		err = writer.Write([]string{member.UserID, member.Name, member.Email, member.NetID, member.Status,
+			strconv.Itoa(member.AttendedCount), eventsCount, dateLastAttended})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	writer.Flush()
+	if err = writer.Error(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
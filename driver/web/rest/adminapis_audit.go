@@ -0,0 +1,89 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupAuditLogs Gets the audit log of a group
+// @Description Gets the audit log of privileged mutations within a group ordered by date (newest first)
+// @ID AdminGetGroupAuditLogs
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Group ID"
+// @Param action query string false "Comma separated list of action types. Example: group.updated,post.deleted"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.AuditLog
+// @Security AppUserAuth
+// @Router /api/admin/groups/{id}/audit [get]
+func (h *AdminApisHandler) GetGroupAuditLogs(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	var filter model.AuditLogFilter
+
+	actions, ok := r.URL.Query()["action"]
+	if ok && len(actions[0]) > 0 {
+		filter.Actions = strings.Split(actions[0], ",")
+	}
+
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			filter.Offset = &val
+		}
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	items, err := h.app.Services.GetGroupAuditLogs(clientID, groupID, filter)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAuditLogs() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupAuditLogs() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,136 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+)
+
+// GetAuthmanSyncRuns Gets the last Authman sync runs
+// @Description Gets the last Authman sync runs ordered by start date (newest first). Each run contains the start and end times, the counts of the added, removed and failed memberships and the error details.
+// @ID AdminGetAuthmanSyncRuns
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group_id query string false "Group ID"
+// @Param type query string false "Run type - global or group"
+// @Param limit query integer false "Number of runs. Default 20"
+// @Success 200 {array} model.AuthmanSyncRun
+// @Security AppUserAuth
+// @Router /api/admin/authman/sync-runs [get]
+func (h *AdminApisHandler) GetAuthmanSyncRuns(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	var filter model.AuthmanSyncRunFilter
+
+	groupIDs, ok := r.URL.Query()["group_id"]
+	if ok && len(groupIDs[0]) > 0 {
+		filter.GroupID = &groupIDs[0]
+	}
+
+	types, ok := r.URL.Query()["type"]
+	if ok && len(types[0]) > 0 {
+		if types[0] != model.AuthmanSyncRunTypeGlobal && types[0] != model.AuthmanSyncRunTypeGroup {
+			log.Printf("error: adminapis.GetAuthmanSyncRuns() - invalid type %s", types[0])
+			http.Error(w, "invalid type", http.StatusBadRequest)
+			return
+		}
+		filter.Type = &types[0]
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	runs, err := h.app.Services.GetAuthmanSyncRuns(clientID, filter)
+	if err != nil {
+		log.Printf("error: adminapis.GetAuthmanSyncRuns() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(runs)
+	if err != nil {
+		log.Printf("error: adminapis.GetAuthmanSyncRuns() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type validateAuthmanGroupsRequest struct {
+	AuthmanGroups []string `json:"authman_groups" validate:"required,min=1"`
+} // @name validateAuthmanGroupsRequest
+
+// ValidateAuthmanGroups Validates Authman group keys before mapping them to a group
+// @Description Validates Authman group keys before mapping them to a group. Gives the member count of each key or the error of retrieving its members.
+// @ID AdminValidateAuthmanGroups
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body validateAuthmanGroupsRequest true "body data"
+// @Success 200 {array} model.AuthmanSyncKeyResult
+// @Security AppUserAuth
+// @Router /api/admin/authman/groups/validate [post]
+func (h *AdminApisHandler) ValidateAuthmanGroups(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.ValidateAuthmanGroups() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData validateAuthmanGroupsRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: adminapis.ValidateAuthmanGroups() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if len(requestData.AuthmanGroups) == 0 {
+		log.Printf("error: adminapis.ValidateAuthmanGroups() - missing authman_groups")
+		http.Error(w, "missing authman_groups", http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	if err != nil {
+		log.Printf("error: adminapis.ValidateAuthmanGroups() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	results := h.app.Services.ValidateAuthmanGroups(requestData.AuthmanGroups)
+
+	data, err = json.Marshal(results)
+	if err != nil {
+		log.Printf("error: adminapis.ValidateAuthmanGroups() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
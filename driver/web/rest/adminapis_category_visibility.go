@@ -0,0 +1,96 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+)
+
+// GetCategoryVisibilityConfig gets category visibility config
+// @Description Gets the group categories the group lists of the client may contain
+// @ID AdminGetCategoryVisibilityConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.CategoryVisibilityConfig
+// @Security AppUserAuth
+// @Router /api/admin/category-visibility-config [get]
+func (h *AdminApisHandler) GetCategoryVisibilityConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetCategoryVisibilityConfig(clientID)
+	if err != nil {
+		log.Printf("error getting category visibility config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal category visibility config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveCategoryVisibilityConfig saves category visibility config
+// @Description Saves the group categories the group lists of the client may contain. If allowed_categories is not empty only the groups of these categories are listed. The groups of denied_categories are never listed. The restriction is applied to the group list APIs of the client, the empty lists remove it.
+// @ID AdminSaveCategoryVisibilityConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.CategoryVisibilityConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/category-visibility-config [put]
+func (h *AdminApisHandler) SaveCategoryVisibilityConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save category visibility config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.CategoryVisibilityConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the category visibility config - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateCategoryVisibilityConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
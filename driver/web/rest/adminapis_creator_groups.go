@@ -0,0 +1,91 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type cleanupCreatorGroupsRequest struct {
+	CreatorID       string   `json:"creator_id" validate:"required"`
+	Action          string   `json:"action" validate:"required,oneof=archive delete"`
+	DryRun          bool     `json:"dry_run"`
+	ExcludeGroupIDs []string `json:"exclude_group_ids"`
+	CancelEvents    bool     `json:"cancel_events"`
+} // @name cleanupCreatorGroupsRequest
+
+// CleanupCreatorGroups Archives or deletes the groups created by an account
+// @Description Archives or deletes all the groups created by the account, i.e. when the account is found to be abusive. Archive hides the groups from the search and blocks the new membership requests, delete follows the group deletion policy so the groups could be restored within the restore period. Use the dry run to review the matched groups first and exclude the groups to be kept. The managed groups are skipped unless the admin has the managed_group_admin permission. At most 500 groups are cleaned up at once.
+// @ID AdminCleanupCreatorGroups
+// @Tags Admin
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body cleanupCreatorGroupsRequest true "body data"
+// @Success 200 {object} model.CreatorGroupsCleanupResult
+// @Failure 400 {string} string "Bad request - too many groups"
+// @Security AppUserAuth
+// @Router /api/admin/groups/cleanup [post]
+func (h *AdminApisHandler) CleanupCreatorGroups(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.CleanupCreatorGroups() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData cleanupCreatorGroupsRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: adminapis.CleanupCreatorGroups() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: adminapis.CleanupCreatorGroups() - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	result, err := h.app.Services.CleanupCreatorGroups(clientID, current, requestData.CreatorID, requestData.Action, requestData.DryRun,
+		requestData.ExcludeGroupIDs, requestData.CancelEvents)
+	if err != nil {
+		log.Printf("error: adminapis.CleanupCreatorGroups() - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+	log.Printf("adminapis.CleanupCreatorGroups() - %s cleaned up (%s, dry run %t) %d groups of %s", current.Email, requestData.Action,
+		requestData.DryRun, len(result.Groups), requestData.CreatorID)
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		log.Printf("error: adminapis.CleanupCreatorGroups() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
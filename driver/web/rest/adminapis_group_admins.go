@@ -0,0 +1,53 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+)
+
+// GetGroupsNeedingAdmin Gives the groups which have no admin
+// @Description Gives the groups whose last admin has deleted the account while there was no member to promote. An org admin has to appoint an admin through the admin memberships API, which clears the flag.
+// @ID AdminGetGroupsNeedingAdmin
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {array} model.Group
+// @Security AppUserAuth
+// @Router /api/admin/groups/admin-needed [get]
+func (h *AdminApisHandler) GetGroupsNeedingAdmin(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groups, err := h.app.Services.GetGroupsNeedingAdmin(clientID)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupsNeedingAdmin() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if groups == nil {
+		groups = []model.Group{}
+	}
+
+	data, err := json.Marshal(groups)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupsNeedingAdmin() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,199 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupDeletionPolicyConfig gets group deletion policy config
+// @Description Gets the handling of the content of the deleted groups
+// @ID AdminGetGroupDeletionPolicyConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.GroupDeletionPolicyConfig
+// @Security AppUserAuth
+// @Router /api/admin/group-deletion-policy-config [get]
+func (h *AdminApisHandler) GetGroupDeletionPolicyConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetGroupDeletionPolicyConfig(clientID)
+	if err != nil {
+		log.Printf("error getting group deletion policy config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal group deletion policy config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveGroupDeletionPolicyConfig saves group deletion policy config
+// @Description Saves the handling of the content of the deleted groups. The content is deleted by default. With archive_content the group, its posts and its event mappings are moved to the archive on the deletion instead. The archived content is available to the admins only and is purged once retention_days pass. With restore_days the deleted groups are excluded from all the queries and could be restored by the admins within the period before their content is deleted or archived.
+// @ID AdminSaveGroupDeletionPolicyConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.GroupDeletionPolicyConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/group-deletion-policy-config [put]
+func (h *AdminApisHandler) SaveGroupDeletionPolicyConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save group deletion policy config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.GroupDeletionPolicyConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the group deletion policy config - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateGroupDeletionPolicyConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetArchivedGroups Gets the archived groups
+// @Description Gets the deleted groups whose content is kept in the archive, the latest first. The data field holds the group as it was on the deletion.
+// @ID AdminGetArchivedGroups
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {array} model.GroupArchiveItem
+// @Security AppUserAuth
+// @Router /api/admin/archived-groups [get]
+func (h *AdminApisHandler) GetArchivedGroups(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	items, err := h.app.Services.GetArchivedGroups(clientID)
+	if err != nil {
+		log.Printf("error: adminapis.GetArchivedGroups() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if items == nil {
+		items = []model.GroupArchiveItem{}
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		log.Printf("error: adminapis.GetArchivedGroups() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetGroupArchiveItems Gets the archived content of a deleted group
+// @Description Gets the archived group, posts and event mappings of a deleted group
+// @ID AdminGetGroupArchiveItems
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param entity_type query string false "group, post or event"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.GroupArchiveItem
+// @Security AppUserAuth
+// @Router /api/admin/archived-groups/{group-id} [get]
+func (h *AdminApisHandler) GetGroupArchiveItems(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	var entityType *string
+	entityTypes, ok := r.URL.Query()["entity_type"]
+	if ok && len(entityTypes[0]) > 0 {
+		entityType = &entityTypes[0]
+	}
+
+	var offset *int64
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			offset = &val
+		}
+	}
+
+	var limit *int64
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			limit = &val
+		}
+	}
+
+	items, err := h.app.Services.GetGroupArchiveItems(clientID, groupID, entityType, offset, limit)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupArchiveItems() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if len(items) == 0 {
+		log.Printf("error: adminapis.GetGroupArchiveItems() - no archive of group %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(items)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupArchiveItems() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
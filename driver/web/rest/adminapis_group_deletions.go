@@ -0,0 +1,188 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupDeletionJobs Gets the last group deletion jobs
+// @Description Gets the last group deletion jobs ordered by start date (newest first). The content of a deleted group is deleted in batches in the background, each job contains the step in progress, the number of the deleted items per step and the error details. The failed jobs are resumed automatically.
+// @ID AdminGetGroupDeletionJobs
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group_id query string false "Group ID"
+// @Param status query string false "Job status - running, succeeded or failed"
+// @Param limit query integer false "Number of jobs. Default 20"
+// @Success 200 {array} model.GroupDeletionJob
+// @Security AppUserAuth
+// @Router /api/admin/group-deletion-jobs [get]
+func (h *AdminApisHandler) GetGroupDeletionJobs(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	var filter model.GroupDeletionJobFilter
+
+	groupIDs, ok := r.URL.Query()["group_id"]
+	if ok && len(groupIDs[0]) > 0 {
+		filter.GroupID = &groupIDs[0]
+	}
+
+	statuses, ok := r.URL.Query()["status"]
+	if ok && len(statuses[0]) > 0 {
+		if statuses[0] != model.GroupDeletionJobStatusRunning && statuses[0] != model.GroupDeletionJobStatusSucceeded &&
+			statuses[0] != model.GroupDeletionJobStatusFailed {
+			log.Printf("error: adminapis.GetGroupDeletionJobs() - invalid status %s", statuses[0])
+			http.Error(w, "invalid status", http.StatusBadRequest)
+			return
+		}
+		filter.Status = &statuses[0]
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	jobs, err := h.app.Services.GetGroupDeletionJobs(clientID, filter)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupDeletionJobs() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(jobs)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupDeletionJobs() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetGroupDeletionJob Gets a group deletion job
+// @Description Gets the progress of a group deletion job
+// @ID AdminGetGroupDeletionJob
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Job ID"
+// @Success 200 {object} model.GroupDeletionJob
+// @Security AppUserAuth
+// @Router /api/admin/group-deletion-jobs/{id} [get]
+func (h *AdminApisHandler) GetGroupDeletionJob(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("id is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	job, err := h.app.Services.GetGroupDeletionJob(clientID, id)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupDeletionJob() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if job == nil {
+		log.Printf("error: adminapis.GetGroupDeletionJob() - job %s not found", id)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(job)
+	if err != nil {
+		log.Printf("error: adminapis.GetGroupDeletionJob() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetDeletedGroups Gets the deleted groups which could be restored
+// @Description Gets the deleted groups whose restore period has not passed yet, the latest deleted first. The content of a group is deleted or archived once its date_purge passes.
+// @ID AdminGetDeletedGroups
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {array} model.Group
+// @Security AppUserAuth
+// @Router /api/admin/deleted-groups [get]
+func (h *AdminApisHandler) GetDeletedGroups(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groups, err := h.app.Services.GetDeletedGroups(clientID)
+	if err != nil {
+		log.Printf("error: adminapis.GetDeletedGroups() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(groups)
+	if err != nil {
+		log.Printf("error: adminapis.GetDeletedGroups() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// RestoreGroup Restores a deleted group
+// @Description Restores a deleted group with all its content within the restore period of the group deletion policy. The upcoming events cancelled on the deletion stay cancelled.
+// @ID AdminRestoreGroup
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {string} Successfully restored
+// @Failure 404 {string} string "Not found - the group is not deleted or its restore period has passed"
+// @Security AppUserAuth
+// @Router /api/admin/deleted-groups/{group-id}/restore [post]
+func (h *AdminApisHandler) RestoreGroup(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	restored, err := h.app.Services.RestoreGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: adminapis.RestoreGroup() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !restored {
+		log.Printf("error: adminapis.RestoreGroup() - group %s could not be restored", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully restored"))
+}
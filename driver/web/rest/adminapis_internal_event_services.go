@@ -0,0 +1,96 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+)
+
+// GetInternalEventServicesConfig gets internal event services config
+// @Description Gets the services allowed to link the events to the groups of the client through the internal API
+// @ID AdminGetInternalEventServicesConfig
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {object} model.InternalEventServicesConfig
+// @Security AppUserAuth
+// @Router /api/admin/internal-event-services-config [get]
+func (h *AdminApisHandler) GetInternalEventServicesConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	config, err := h.app.Services.GetInternalEventServicesConfig(clientID)
+	if err != nil {
+		log.Printf("error getting internal event services config - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		log.Println("Error on marshal internal event services config")
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SaveInternalEventServicesConfig saves internal event services config
+// @Description Saves the services allowed to link the events to the groups of the client through the internal API. A service, identified by the service query param of the internal API, may link the events to the groups having any of its categories or matching all its attributes. Once the config is saved, the services missing from it are not allowed to link any events.
+// @ID AdminSaveInternalEventServicesConfig
+// @Tags Admin
+// @Accept plain
+// @Param data body model.InternalEventServicesConfig true "body data"
+// @Param APP header string true "APP"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/internal-event-services-config [put]
+func (h *AdminApisHandler) SaveInternalEventServicesConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error reading body on save internal event services config - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var config model.InternalEventServicesConfig
+	err = json.Unmarshal(data, &config)
+	if err != nil {
+		log.Printf("Error on unmarshal the internal event services config - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = config.Validate()
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	config.ClientID = clientID
+	err = h.app.Services.UpdateInternalEventServicesConfig(config)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
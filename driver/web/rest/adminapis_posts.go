@@ -245,6 +245,12 @@ func (h *AdminApisHandler) CreateGroupPost(clientID string, current *model.User,
 		return
 	}
 
+	if group.CurrentMember.IsMember() && post.ParentID == nil && group.Settings != nil && group.Settings.PostPreferences.PostsByAdminsOnly {
+		log.Printf("only the admins may create posts for group '%s'", group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
 	if group.CurrentMember.IsMember() && post.ParentID != nil && group.Settings != nil && !group.Settings.PostPreferences.CanSendPostReplies {
 		log.Printf("replies are not allowed for group '%s'", group.Title)
 		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
@@ -255,6 +261,9 @@ func (h *AdminApisHandler) CreateGroupPost(clientID string, current *model.User,
 
 	post, err = h.app.Services.CreatePost(clientID, current, post, group)
 	if err != nil {
+		if writePostLimitError(w, err) || writeContentFilterError(w, err) || writeContentRateLimitError(w, err) || writePostingMutedError(w, err) {
+			return
+		}
 		log.Printf("error getting posts for group - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -341,6 +350,9 @@ func (h *AdminApisHandler) UpdateGroupPost(clientID string, current *model.User,
 
 	post, err = h.app.Services.UpdatePost(clientID, current, group, post)
 	if err != nil {
+		if writePostLimitError(w, err) || writeContentFilterError(w, err) {
+			return
+		}
 		log.Printf("error update post (%s) - %s", postID, err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
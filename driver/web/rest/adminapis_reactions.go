@@ -0,0 +1,157 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+type startReactionMigrationRequest struct {
+	GroupIDs []string `json:"group_ids"`
+} // @name startReactionMigrationRequest
+
+// StartReactionMigration Starts a migration of the post reactions to the Social BB
+// @Description Starts a background job which moves the post reactions of the groups to the Social BB. All groups with reactions are migrated if no group IDs are provided. The local reaction writes of a group are disabled once its reaction counts are verified in the Social BB. Only one migration runs per client at a time.
+// @ID AdminStartReactionMigration
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body startReactionMigrationRequest false "body data"
+// @Success 200 {object} model.ReactionMigration
+// @Security AppUserAuth
+// @Router /api/admin/reactions/migrations [post]
+func (h *AdminApisHandler) StartReactionMigration(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.StartReactionMigration() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData startReactionMigrationRequest
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &requestData)
+		if err != nil {
+			log.Printf("error: adminapis.StartReactionMigration() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	migration, err := h.app.Services.StartReactionMigration(clientID, requestData.GroupIDs)
+	if err != nil {
+		log.Printf("error: adminapis.StartReactionMigration() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(migration)
+	if err != nil {
+		log.Printf("error: adminapis.StartReactionMigration() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetReactionMigrations Gets the last migrations of the post reactions to the Social BB
+// @Description Gets the last migrations of the post reactions to the Social BB ordered by start date (newest first)
+// @ID AdminGetReactionMigrations
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.ReactionMigration
+// @Security AppUserAuth
+// @Router /api/admin/reactions/migrations [get]
+func (h *AdminApisHandler) GetReactionMigrations(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	var limit int64
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			limit = val
+		}
+	}
+
+	migrations, err := h.app.Services.GetReactionMigrations(clientID, limit)
+	if err != nil {
+		log.Printf("error: adminapis.GetReactionMigrations() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(migrations)
+	if err != nil {
+		log.Printf("error: adminapis.GetReactionMigrations() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetReactionMigration Gets a migration of the post reactions to the Social BB
+// @Description Gets a migration of the post reactions to the Social BB together with the per group counts and errors
+// @ID AdminGetReactionMigration
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Migration ID"
+// @Success 200 {object} model.ReactionMigration
+// @Security AppUserAuth
+// @Router /api/admin/reactions/migrations/{id} [get]
+func (h *AdminApisHandler) GetReactionMigration(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("Migration id is required")
+		http.Error(w, "Migration id is required", http.StatusBadRequest)
+		return
+	}
+
+	migration, err := h.app.Services.GetReactionMigration(clientID, id)
+	if err != nil {
+		log.Printf("error: adminapis.GetReactionMigration() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if migration == nil {
+		log.Printf("error: adminapis.GetReactionMigration() - there is no a migration for the provided id - %s", id)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(migration)
+	if err != nil {
+		log.Printf("error: adminapis.GetReactionMigration() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,132 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// UpdateSmartGroupRules Sets the smart group rules of a group
+// @Description Sets the rules the group membership is derived from. The match attributes are passed as search params to the Core BB accounts API and the matching accounts become members on the next smart group sync. The non admin members not matching the rules are removed. Requires the managed_group_admin permission.
+// @ID AdminUpdateSmartGroupRules
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.SmartGroupRules true "body data"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/group/{group-id}/smart-rules [put]
+func (h *AdminApisHandler) UpdateSmartGroupRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	if !current.HasPermission("managed_group_admin") {
+		log.Printf("error: adminapis.UpdateSmartGroupRules() - %s is not allowed to manage smart groups", current.Email)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	groupID := mux.Vars(r)["group-id"]
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateSmartGroupRules() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var rules model.SmartGroupRules
+	err = json.Unmarshal(data, &rules)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateSmartGroupRules() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	err = rules.Validate()
+	if err != nil {
+		log.Printf("error: adminapis.UpdateSmartGroupRules() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.app.Services.UpdateSmartGroupRules(clientID, current, groupID, &rules)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateSmartGroupRules() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// DeleteSmartGroupRules Removes the smart group rules of a group
+// @Description Removes the smart group rules, so the group becomes a regular group. The current members are kept. Requires the managed_group_admin permission.
+// @ID AdminDeleteSmartGroupRules
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/group/{group-id}/smart-rules [delete]
+func (h *AdminApisHandler) DeleteSmartGroupRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	if !current.HasPermission("managed_group_admin") {
+		log.Printf("error: adminapis.DeleteSmartGroupRules() - %s is not allowed to manage smart groups", current.Email)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	groupID := mux.Vars(r)["group-id"]
+	err := h.app.Services.UpdateSmartGroupRules(clientID, current, groupID, nil)
+	if err != nil {
+		log.Printf("error: adminapis.DeleteSmartGroupRules() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// SynchronizeSmartGroup Synchronizes the memberships of a smart group
+// @Description Synchronizes the memberships of a smart group with the Core BB accounts matching its rules without waiting for the periodic sync. Requires the managed_group_admin permission.
+// @ID AdminSynchronizeSmartGroup
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/group/{group-id}/smart-rules/synchronize [post]
+func (h *AdminApisHandler) SynchronizeSmartGroup(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	if !current.HasPermission("managed_group_admin") {
+		log.Printf("error: adminapis.SynchronizeSmartGroup() - %s is not allowed to manage smart groups", current.Email)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	groupID := mux.Vars(r)["group-id"]
+	err := h.app.Services.SynchronizeSmartGroup(clientID, groupID)
+	if err != nil {
+		log.Printf("error: adminapis.SynchronizeSmartGroup() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
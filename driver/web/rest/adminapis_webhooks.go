@@ -0,0 +1,304 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type createWebhookSubscriptionRequest struct {
+	URL        string   `json:"url" validate:"required,url"`
+	EventTypes []string `json:"event_types" validate:"required,min=1"`
+	Secret     string   `json:"secret"` // generated when missing
+} // @name createWebhookSubscriptionRequest
+
+type createWebhookSubscriptionResponse struct {
+	Subscription model.WebhookSubscription `json:"subscription"`
+	Secret       string                    `json:"secret"` // returned only once on creation
+} // @name createWebhookSubscriptionResponse
+
+// CreateWebhookSubscription Registers a webhook subscription
+// @Description Registers a callback URL for group events. The URL must use https and its host must resolve to public addresses only, the redirects of the subscriber are not followed. The payloads are signed with HMAC-SHA256 using the secret which is returned only on creation.
+// @ID AdminCreateWebhookSubscription
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body createWebhookSubscriptionRequest true "body data"
+// @Success 200 {object} createWebhookSubscriptionResponse
+// @Security AppUserAuth
+// @Router /api/admin/webhooks [post]
+func (h *AdminApisHandler) CreateWebhookSubscription(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.CreateWebhookSubscription() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData createWebhookSubscriptionRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: adminapis.CreateWebhookSubscription() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: adminapis.CreateWebhookSubscription() - validation error - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscription, secret, err := h.app.Services.CreateWebhookSubscription(clientID, requestData.URL, requestData.EventTypes, requestData.Secret)
+	if err != nil {
+		log.Printf("error: adminapis.CreateWebhookSubscription() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err = json.Marshal(createWebhookSubscriptionResponse{Subscription: *subscription, Secret: secret})
+	if err != nil {
+		log.Printf("error: adminapis.CreateWebhookSubscription() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetWebhookSubscriptions Gets the webhook subscriptions
+// @Description Gets the webhook subscriptions of the client
+// @ID AdminGetWebhookSubscriptions
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Success 200 {array} model.WebhookSubscription
+// @Security AppUserAuth
+// @Router /api/admin/webhooks [get]
+func (h *AdminApisHandler) GetWebhookSubscriptions(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	subscriptions, err := h.app.Services.GetWebhookSubscriptions(clientID)
+	if err != nil {
+		log.Printf("error: adminapis.GetWebhookSubscriptions() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(subscriptions)
+	if err != nil {
+		log.Printf("error: adminapis.GetWebhookSubscriptions() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type updateWebhookSubscriptionRequest struct {
+	Active bool `json:"active"`
+} // @name updateWebhookSubscriptionRequest
+
+// UpdateWebhookSubscription Enables or disables a webhook subscription
+// @Description Enables or disables a webhook subscription. The pending deliveries of a disabled subscription are marked as failed.
+// @ID AdminUpdateWebhookSubscription
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param id path string true "Subscription ID"
+// @Param data body updateWebhookSubscriptionRequest true "body data"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/admin/webhooks/{id} [put]
+func (h *AdminApisHandler) UpdateWebhookSubscription(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("id is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateWebhookSubscription() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData updateWebhookSubscriptionRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateWebhookSubscription() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	found, err := h.app.Services.UpdateWebhookSubscriptionActive(clientID, id, requestData.Active)
+	if err != nil {
+		log.Printf("error: adminapis.UpdateWebhookSubscription() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !found {
+		log.Printf("error: adminapis.UpdateWebhookSubscription() - subscription %s not found", id)
+		http.Error(w, "subscription not found", http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetWebhookDeliveries Gets the delivery history of a webhook subscription
+// @Description Gets the delivery history of a webhook subscription ordered by date (newest first)
+// @ID AdminGetWebhookDeliveries
+// @Tags Admin
+// @Param APP header string true "APP"
+// @Param id path string true "Subscription ID"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.WebhookDelivery
+// @Security AppUserAuth
+// @Router /api/admin/webhooks/{id}/deliveries [get]
+func (h *AdminApisHandler) GetWebhookDeliveries(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("id is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	var offset *int64
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			offset = &val
+		}
+	}
+
+	var limit *int64
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			limit = &val
+		}
+	}
+
+	deliveries, err := h.app.Services.GetWebhookDeliveries(clientID, id, offset, limit)
+	if err != nil {
+		log.Printf("error: adminapis.GetWebhookDeliveries() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(deliveries)
+	if err != nil {
+		log.Printf("error: adminapis.GetWebhookDeliveries() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type rotateWebhookSecretRequest struct {
+	Secret         string `json:"secret"`                                      // generated when missing
+	OverlapSeconds int64  `json:"overlap_seconds" validate:"min=0,max=604800"` // the previous secret still signs the payloads for this period
+} // @name rotateWebhookSecretRequest
+
+// RotateWebhookSecret Rotates the secret of a webhook subscription
+// @Description Replaces the secret of a webhook subscription. During the overlap the X-Groups-Signature header contains the comma separated signatures of the new and the previous secrets, so the subscriber could switch without dropping deliveries. Zero overlap revokes the previous secret at once. The new secret is returned only once.
+// @ID AdminRotateWebhookSecret
+// @Tags Admin
+// @Accept json
+// @Param APP header string true "APP"
+// @Param id path string true "Subscription ID"
+// @Param data body rotateWebhookSecretRequest true "body data"
+// @Success 200 {object} createWebhookSubscriptionResponse
+// @Security AppUserAuth
+// @Router /api/admin/webhooks/{id}/secret [post]
+func (h *AdminApisHandler) RotateWebhookSecret(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("id is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData rotateWebhookSecretRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - validation error - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	subscription, secret, err := h.app.Services.RotateWebhookSecret(clientID, current, id, requestData.Secret, time.Duration(requestData.OverlapSeconds)*time.Second)
+	if err != nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if subscription == nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - subscription %s not found", id)
+		http.Error(w, "subscription not found", http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(createWebhookSubscriptionResponse{Subscription: *subscription, Secret: secret})
+	if err != nil {
+		log.Printf("error: adminapis.RotateWebhookSecret() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
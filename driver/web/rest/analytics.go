@@ -45,7 +45,7 @@ type analyticsGetGroupsResponse struct {
 }
 
 // AnalyticsGetGroups Gets groups
-// @Description Gets groups
+// @Description Gets groups. When the privacy mode is enabled for the client the groups below the k-anonymity threshold are suppressed.
 // @ID AnalyticsGetGroups
 // @Tags Analytics
 // @Accept json
@@ -79,7 +79,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetGroups(clientID string, w http.Respon
 		endDate = &date
 	}
 
-	groups, err := h.app.Services.AnalyticsFindGroups(startDate, endDate)
+	groups, err := h.app.Services.AnalyticsFindGroups(clientID, startDate, endDate)
 	if err != nil {
 		log.Printf("unable to retrieve posts: %s", err)
 		http.Error(w, "unable to retrieve posts", http.StatusInternalServerError)
@@ -122,6 +122,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetGroups(clientID string, w http.Respon
 		return
 	}
 
+	setLicenseHeaders(h.app, clientID, w)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
@@ -137,7 +138,7 @@ type analyticsGetPostsResponse struct {
 }
 
 // AnalyticsGetPosts Gets posts
-// @Description Gets posts
+// @Description Gets posts. When the privacy mode is enabled for the client the user identifiers are hashed and the small groups are suppressed.
 // @ID AnalyticsGetPosts
 // @Tags Analytics
 // @Accept json
@@ -179,7 +180,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetPosts(clientID string, w http.Respons
 		endDate = &date
 	}
 
-	posts, err := h.app.Services.AnalyticsFindPosts(groupID, startDate, endDate)
+	posts, err := h.app.Services.AnalyticsFindPosts(clientID, groupID, startDate, endDate)
 	if err != nil {
 		log.Printf("unable to retrieve posts: %s", err)
 		http.Error(w, "unable to retrieve posts", http.StatusInternalServerError)
@@ -210,6 +211,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetPosts(clientID string, w http.Respons
 		return
 	}
 
+	setLicenseHeaders(h.app, clientID, w)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
@@ -224,7 +226,7 @@ type analyticsGetGroupsMembersResponse struct {
 }
 
 // AnalyticsGetGroupsMembers Gets groups members
-// @Description Gets groups members
+// @Description Gets groups members. When the privacy mode is enabled for the client the identifiers are hashed and the small groups are suppressed.
 // @ID AnalyticsGetGroupsMembers
 // @Tags Analytics
 // @Accept json
@@ -266,7 +268,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetGroupsMembers(clientID string, w http
 		endDate = &date
 	}
 
-	members, err := h.app.Services.AnalyticsFindMembers(groupID, startDate, endDate)
+	members, err := h.app.Services.AnalyticsFindMembers(clientID, groupID, startDate, endDate)
 	if err != nil {
 		log.Printf("unable to retrieve posts: %s", err)
 		http.Error(w, "unable to retrieve posts", http.StatusInternalServerError)
@@ -296,6 +298,7 @@ func (h *AnalyticsApisHandler) AnalyticsGetGroupsMembers(clientID string, w http
 		return
 	}
 
+	setLicenseHeaders(h.app, clientID, w)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
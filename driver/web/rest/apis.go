@@ -48,32 +48,56 @@ func (h ApisHandler) Version(log *logs.Log, req *http.Request, user *model.User)
 	return log.HTTPResponseSuccessMessage(h.app.Services.GetVersion())
 }
 
+// Ready Gives the service readiness. The service is ready once the startup warmup completes.
+// @Description Gives the service readiness. The service is ready once the startup warmup completes.
+// @ID Ready
+// @Tags Client
+// @Produce plain
+// @Success 200
+// @Failure 503
+// @Router /ready [get]
+func (h ApisHandler) Ready(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain")
+	if !h.app.Services.IsReady() {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte("warming up"))
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("ready"))
+}
+
 type createGroupRequest struct {
-	Title                    string                         `json:"title" validate:"required"`
-	Description              *string                        `json:"description"`
-	Category                 string                         `json:"category"`
-	Tags                     []string                       `json:"tags"`
-	Privacy                  string                         `json:"privacy" validate:"required,oneof=public private"`
-	Hidden                   bool                           `json:"hidden_for_search"`
-	CreatorName              string                         `json:"creator_name"`
-	CreatorEmail             string                         `json:"creator_email"`
-	CreatorPhotoURL          string                         `json:"creator_photo_url"`
-	ImageURL                 *string                        `json:"image_url"`
-	WebURL                   *string                        `json:"web_url"`
-	MembershipQuestions      []string                       `json:"membership_questions"`
-	AuthmanEnabled           bool                           `json:"authman_enabled"`
-	AuthmanGroup             *string                        `json:"authman_group"`
-	OnlyAdminsCanCreatePolls bool                           `json:"only_admins_can_create_polls" `
-	CanJoinAutomatically     bool                           `json:"can_join_automatically"`
-	AttendanceGroup          bool                           `json:"attendance_group" `
-	ResearchOpen             bool                           `json:"research_open"`
-	ResearchGroup            bool                           `json:"research_group"`
-	ResearchConsentStatement string                         `json:"research_consent_statement"`
-	ResearchConsentDetails   string                         `json:"research_consent_details"`
-	ResearchDescription      string                         `json:"research_description"`
-	ResearchProfile          map[string]map[string][]string `json:"research_profile"`
-	Settings                 *model.GroupSettings           `json:"settings"`
-	Attributes               map[string]interface{}         `json:"attributes"`
+	Title                     string                         `json:"title" validate:"required"`
+	Description               *string                        `json:"description"`
+	Category                  string                         `json:"category"`
+	Categories                []string                       `json:"categories"`
+	Tags                      []string                       `json:"tags"`
+	Privacy                   string                         `json:"privacy" validate:"required,oneof=public private"`
+	Hidden                    bool                           `json:"hidden_for_search"`
+	CreatorName               string                         `json:"creator_name"`
+	CreatorEmail              string                         `json:"creator_email"`
+	CreatorPhotoURL           string                         `json:"creator_photo_url"`
+	ImageURL                  *string                        `json:"image_url"`
+	WebURL                    *string                        `json:"web_url"`
+	MembershipQuestions       []string                       `json:"membership_questions"`
+	MembershipQuestionsSchema []model.MembershipQuestion     `json:"membership_questions_schema"`
+	AuthmanEnabled            bool                           `json:"authman_enabled"`
+	AuthmanGroup              *string                        `json:"authman_group"`
+	AuthmanGroups             []string                       `json:"authman_groups"`
+	OnlyAdminsCanCreatePolls  bool                           `json:"only_admins_can_create_polls" `
+	CanJoinAutomatically      bool                           `json:"can_join_automatically"`
+	AttendanceGroup           bool                           `json:"attendance_group" `
+	ResearchOpen              bool                           `json:"research_open"`
+	ResearchGroup             bool                           `json:"research_group"`
+	ResearchConsentStatement  string                         `json:"research_consent_statement"`
+	ResearchConsentDetails    string                         `json:"research_consent_details"`
+	ResearchDescription       string                         `json:"research_description"`
+	ResearchProfile           map[string]map[string][]string `json:"research_profile"`
+	Settings                  *model.GroupSettings           `json:"settings"`
+	Attributes                map[string]interface{}         `json:"attributes"`
+	Location                  *model.GeoPoint                `json:"location"`
+	Localizations             model.GroupLocalizations       `json:"localizations"`
 } //@name createGroupRequest
 
 type userGroupShortDetail struct {
@@ -121,6 +145,40 @@ func (h *ApisHandler) CreateGroup(clientID string, current *model.User, w http.R
 		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
 		return
 	}
+	if requestData.Location != nil {
+		err = requestData.Location.Validate()
+		if err != nil {
+			log.Printf("Error on validating create group location - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	if requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+		if err != nil {
+			log.Printf("Error on validating create group settings - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	err = requestData.Localizations.Validate()
+	if err != nil {
+		log.Printf("Error on validating create group localizations - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	if err != nil {
+		log.Printf("Error on validating create group Authman groups - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	if err != nil {
+		log.Printf("Error on validating create group membership questions - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
 
 	if requestData.AuthmanEnabled && !current.HasPermission("managed_group_admin") {
 		log.Printf("Only managed_group_admin could create a managed group")
@@ -135,28 +193,33 @@ func (h *ApisHandler) CreateGroup(clientID string, current *model.User, w http.R
 	}
 
 	insertedID, groupErr := h.app.Services.CreateGroup(clientID, current, &model.Group{
-		Title:                    requestData.Title,
-		Description:              requestData.Description,
-		Category:                 requestData.Category,
-		Tags:                     requestData.Tags,
-		Privacy:                  requestData.Privacy,
-		HiddenForSearch:          requestData.Hidden,
-		ImageURL:                 requestData.ImageURL,
-		WebURL:                   requestData.WebURL,
-		MembershipQuestions:      requestData.MembershipQuestions,
-		AuthmanGroup:             requestData.AuthmanGroup,
-		AuthmanEnabled:           requestData.AuthmanEnabled,
-		OnlyAdminsCanCreatePolls: requestData.OnlyAdminsCanCreatePolls,
-		CanJoinAutomatically:     requestData.CanJoinAutomatically,
-		AttendanceGroup:          requestData.AttendanceGroup,
-		ResearchGroup:            requestData.ResearchGroup,
-		ResearchOpen:             requestData.ResearchOpen,
-		ResearchConsentStatement: requestData.ResearchConsentStatement,
-		ResearchConsentDetails:   requestData.ResearchConsentDetails,
-		ResearchDescription:      requestData.ResearchDescription,
-		ResearchProfile:          requestData.ResearchProfile,
-		Settings:                 requestData.Settings,
-		Attributes:               requestData.Attributes,
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
+		ResearchGroup:             requestData.ResearchGroup,
+		ResearchOpen:              requestData.ResearchOpen,
+		ResearchConsentStatement:  requestData.ResearchConsentStatement,
+		ResearchConsentDetails:    requestData.ResearchConsentDetails,
+		ResearchDescription:       requestData.ResearchDescription,
+		ResearchProfile:           requestData.ResearchProfile,
+		Settings:                  requestData.Settings,
+		Attributes:                requestData.Attributes,
+		Location:                  requestData.Location,
+		Localizations:             requestData.Localizations,
 	}, nil)
 	if groupErr != nil {
 		log.Println(groupErr.Error())
@@ -184,14 +247,17 @@ type updateGroupRequest struct {
 	Title                      string                         `json:"title" validate:"required"`
 	Description                *string                        `json:"description"`
 	Category                   string                         `json:"category"`
+	Categories                 []string                       `json:"categories"`
 	Tags                       []string                       `json:"tags"`
 	Privacy                    string                         `json:"privacy" validate:"required,oneof=public private"`
 	Hidden                     bool                           `json:"hidden_for_search"`
 	ImageURL                   *string                        `json:"image_url"`
 	WebURL                     *string                        `json:"web_url"`
 	MembershipQuestions        []string                       `json:"membership_questions"`
+	MembershipQuestionsSchema  []model.MembershipQuestion     `json:"membership_questions_schema"`
 	AuthmanEnabled             bool                           `json:"authman_enabled"`
 	AuthmanGroup               *string                        `json:"authman_group"`
+	AuthmanGroups              []string                       `json:"authman_groups"`
 	OnlyAdminsCanCreatePolls   bool                           `json:"only_admins_can_create_polls"`
 	CanJoinAutomatically       bool                           `json:"can_join_automatically"`
 	BlockNewMembershipRequests bool                           `json:"block_new_membership_requests"`
@@ -204,6 +270,8 @@ type updateGroupRequest struct {
 	ResearchProfile            map[string]map[string][]string `json:"research_profile"`
 	Settings                   *model.GroupSettings           `json:"settings"`
 	Attributes                 map[string]interface{}         `json:"attributes"`
+	Location                   *model.GeoPoint                `json:"location"`
+	Localizations              model.GroupLocalizations       `json:"localizations"`
 } //@name updateGroupRequest
 
 // UpdateGroup updates a group
@@ -250,6 +318,40 @@ func (h *ApisHandler) UpdateGroup(clientID string, current *model.User, w http.R
 		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
 		return
 	}
+	if requestData.Location != nil {
+		err = requestData.Location.Validate()
+		if err != nil {
+			log.Printf("Error on validating update group location - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	if requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+		if err != nil {
+			log.Printf("Error on validating update group settings - %s\n", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	err = requestData.Localizations.Validate()
+	if err != nil {
+		log.Printf("Error on validating update group localizations - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	if err != nil {
+		log.Printf("Error on validating update group Authman groups - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	if err != nil {
+		log.Printf("Error on validating update group membership questions - %s\n", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
 
 	//check if allowed to update
 	group, err := h.app.Services.GetGroup(clientID, current, id)
@@ -270,21 +372,24 @@ func (h *ApisHandler) UpdateGroup(clientID string, current *model.User, w http.R
 	}
 
 	groupErr := h.app.Services.UpdateGroup(clientID, current, &model.Group{
-		ID:                       id,
-		Title:                    requestData.Title,
-		Description:              requestData.Description,
-		Category:                 requestData.Category,
-		Tags:                     requestData.Tags,
-		Privacy:                  requestData.Privacy,
-		HiddenForSearch:          requestData.Hidden,
-		ImageURL:                 requestData.ImageURL,
-		WebURL:                   requestData.WebURL,
-		MembershipQuestions:      requestData.MembershipQuestions,
-		AuthmanGroup:             requestData.AuthmanGroup,
-		AuthmanEnabled:           requestData.AuthmanEnabled,
-		OnlyAdminsCanCreatePolls: requestData.OnlyAdminsCanCreatePolls,
-		CanJoinAutomatically:     requestData.CanJoinAutomatically,
-		AttendanceGroup:          requestData.AttendanceGroup,
+		ID:                        id,
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
 
 		ResearchGroup:            requestData.ResearchGroup,
 		ResearchOpen:             requestData.ResearchOpen,
@@ -294,6 +399,8 @@ func (h *ApisHandler) UpdateGroup(clientID string, current *model.User, w http.R
 		ResearchProfile:          requestData.ResearchProfile,
 		Settings:                 requestData.Settings,
 		Attributes:               requestData.Attributes,
+		Location:                 requestData.Location,
+		Localizations:            requestData.Localizations,
 	})
 	if groupErr != nil {
 		log.Printf("Error on updating group - %s\n", err)
@@ -352,13 +459,14 @@ func (h *ApisHandler) GetGroupStats(clientID string, current *model.User, w http
 }
 
 // DeleteGroup deletes a group
-// @Description Deletes a group.
+// @Description Deletes a group. The group is excluded from all the queries at once and its content is deleted in the background once the restore period of the group deletion policy passes, the progress is given by the admin group deletion jobs API. The event mappings of the group are archived and the members who responded to the upcoming events are notified.
 // @ID DeleteGroup
 // @Tags Client
 // @Accept json
 // @Produce json
 // @Param APP header string true "APP"
 // @Param id path string true "ID"
+// @Param cancel_events query bool false "Cancels the upcoming events linked only to the group in the Calendar BB"
 // @Success 200 {string} Successfully deleted
 // @Security AppUserAuth
 // @Router /api/group/{id} [delete]
@@ -379,6 +487,11 @@ func (h *ApisHandler) DeleteGroup(clientID string, current *model.User, w http.R
 		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
 		return
 	}
+	if group == nil {
+		log.Printf("there is no a group for the provided id - %s", id)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
 	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
 		log.Printf("%s is not allowed to update group settings '%s'. Only group admin could delete group", current.Email, group.Title)
 		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
@@ -390,7 +503,8 @@ func (h *ApisHandler) DeleteGroup(clientID string, current *model.User, w http.R
 		return
 	}
 
-	err = h.app.Services.DeleteGroup(clientID, current, id)
+	cancelEvents := r.URL.Query().Get("cancel_events") == "true"
+	err = h.app.Services.DeleteGroup(clientID, current, id, cancelEvents)
 	if err != nil {
 		log.Printf("Error on deleting group - %s\n", err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -415,6 +529,7 @@ func (h *ApisHandler) DeleteGroup(clientID string, current *model.User, w http.R
 // @Param limit query string false "Deprecated - instead use request body filter! limit - limit the result"
 // @Param include_hidden query string false "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false."
 // @Param data body model.GroupsFilter true "body data"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {array} model.Group
 // @Security APIKeyAuth
 // @Security AppUserAuth
@@ -497,6 +612,11 @@ func (h *ApisHandler) GetGroups(clientID string, current *model.User, w http.Res
 		groups[index] = group
 	}
 
+	locales := getLocalesQueryParam(r)
+	for i := range groups {
+		groups[i].Localize(locales)
+	}
+
 	data, err := json.Marshal(groups)
 	if err != nil {
 		log.Println("apis.GetGroups() error on marshal the groups items")
@@ -554,6 +674,7 @@ type getUserGroupsResponse struct {
 // @Param limit query string false "Deprecated - instead use request body filter! limit - limit the result"
 // @Param include_hidden query string false "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false."
 // @Param data body model.GroupsFilter true "body data"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {array} getUserGroupsResponse
 // @Security AppUserAuth
 // @Security APIKeyAuth
@@ -644,6 +765,11 @@ func (h *ApisHandler) GetUserGroups(clientID string, current *model.User, w http
 		groups[index] = group
 	}
 
+	locales := getLocalesQueryParam(r)
+	for i := range groups {
+		groups[i].Localize(locales)
+	}
+
 	data, err := json.Marshal(groups)
 	if err != nil {
 		log.Println("apis.GetUserGroups() error on marshal the user groups items")
@@ -657,15 +783,31 @@ func (h *ApisHandler) GetUserGroups(clientID string, current *model.User, w http
 }
 
 // LoginUser Logs in the user and refactor the user record and linked data if need
-// @Description Logs in the user and refactor the user record and linked data if need
+// @Description Logs in the user and refactor the user record and linked data if need. The memberships, the posts and the events added by the external ID or the NetID of the user before the first login are linked to the account.
 // @ID LoginUser
 // @Tags Client
-// @Success 200
+// @Success 200 {object} model.AccountLinkResult
 // @Security AppUserAuth
 // @Security APIKeyAuth
 // @Router /api/user/login [get]
 func (h *ApisHandler) LoginUser(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	result, err := h.app.Services.LoginUser(clientID, current)
+	if err != nil {
+		log.Printf("error on login user %s - %s", current.ID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.Printf("error on marshal the login result of user %s - %s", current.ID, err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
+	w.Write(data)
 }
 
 type getUserStatsResponse struct {
@@ -806,6 +948,7 @@ type getGroupResponse struct {
 // @Accept json
 // @Param APP header string true "APP"
 // @Param id path string true "ID"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {object} getGroupResponse
 // @Security AppUserAuth
 // @Security APIKeyAuth
@@ -819,7 +962,7 @@ func (h *ApisHandler) GetGroup(clientID string, current *model.User, w http.Resp
 		return
 	}
 
-	group, err := h.app.Services.GetGroup(clientID, current, id)
+	group, err := h.app.Services.GetGroupDetails(clientID, current, id)
 	if err != nil {
 		log.Printf("adminapis.GetGroupV2() error on getting group %s", err)
 		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
@@ -837,6 +980,8 @@ func (h *ApisHandler) GetGroup(clientID string, current *model.User, w http.Resp
 
 	group.ApplyLegacyMembership(membershipCollection)
 
+	group.Localize(getLocalesQueryParam(r))
+
 	data, err := json.Marshal(group)
 	if err != nil {
 		log.Println("Error on marshal the group")
@@ -851,14 +996,15 @@ func (h *ApisHandler) GetGroup(clientID string, current *model.User, w http.Resp
 
 type createPendingMemberRequest struct {
 	MemberAnswers []struct {
-		Question string `json:"question"`
-		Answer   string `json:"answer"`
+		Question string   `json:"question"`
+		Answer   string   `json:"answer"`
+		Values   []string `json:"values"`
 	} `json:"member_answers"`
 	NotificationsPreferences *model.NotificationsPreferences `json:"notifications_preferences"`
 } // @name createPendingMemberRequest
 
 // CreatePendingMember creates a group pending member
-// @Description Creates a group pending member
+// @Description Creates a group pending member. Gives 429 with Retry-After header when the user exceeds the membership requests rate limit of the client.
 // @ID CreatePendingMember
 // @Tags Client
 // @Accept json
@@ -867,6 +1013,8 @@ type createPendingMemberRequest struct {
 // @Param data body createPendingMemberRequest true "body data"
 // @Param group-id path string true "Group ID"
 // @Success 200 {string} Successfully created
+// @Failure 400 {string} string "Bad request - the member answers do not match the typed membership questions of the group"
+// @Failure 403 {string} string "Forbidden - the user is banned from the group"
 // @Failure 423 {string} block_new_membership_requests flag is true
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/pending-members [post]
@@ -920,7 +1068,7 @@ func (h *ApisHandler) CreatePendingMember(clientID string, current *model.User,
 	mAnswers := make([]model.MemberAnswer, len(memberAnswers))
 	if memberAnswers != nil {
 		for i, current := range memberAnswers {
-			mAnswers[i] = model.MemberAnswer{Question: current.Question, Answer: current.Answer}
+			mAnswers[i] = model.MemberAnswer{Question: current.Question, Answer: current.Answer, Values: current.Values}
 		}
 	}
 
@@ -940,7 +1088,7 @@ func (h *ApisHandler) CreatePendingMember(clientID string, current *model.User,
 	err = h.app.Services.CreatePendingMembership(clientID, current, group, member)
 	if err != nil {
 		log.Printf("Error on creating a pending member - %s\n", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -980,8 +1128,8 @@ func (h *ApisHandler) DeletePendingMember(clientID string, current *model.User,
 	w.Write([]byte("Successfully deleted"))
 }
 
-// GetGroupMembers Gets the list of group members. The result would be empty if the current user doesn't belong to the requested group.
-// @Description Gets the list of group members. The result would be empty if the current user doesn't belong to the requested group.
+// GetGroupMembers Gets the list of group members. Gives 403 if the current user is not an admin or a member of the group.
+// @Description Gets the list of group members. Gives 403 if the current user is not an admin or a member of the group. The member information is given as allowed by the group settings, the member answers, the notification preferences and the rejection reasons of the others are given to the group admins only.
 // @ID CreateMember
 // @Tags Client
 // @Accept plain
@@ -992,19 +1140,57 @@ func (h *ApisHandler) DeletePendingMember(clientID string, current *model.User,
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/members [get]
 func (h *ApisHandler) GetGroupMembers(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	request, ok := readGroupMembersFilter(w, r)
+	if !ok {
+		return
+	}
+
+	group, fullView, ok := h.loadGroupMembersAccess(clientID, current, request.GroupIDs[0], w)
+	if !ok {
+		return
+	}
+
+	members, err := h.app.Services.FindGroupMemberships(clientID, *request)
+	if err != nil {
+		log.Printf("api.GetGroupMembers error: %s", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !fullView {
+		members.ApplyMemberView(group.Settings, current.ID)
+	}
+
+	if members.Items == nil {
+		members.Items = []model.GroupMembership{}
+	}
+
+	data, err := json.Marshal(members.Items)
+	if err != nil {
+		log.Printf("api.GetGroupMembers error: %s", err)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// readGroupMembersFilter reads the memberships filter of the group members list APIs. It responds with the error if the request is invalid.
+func readGroupMembersFilter(w http.ResponseWriter, r *http.Request) (*model.MembershipFilter, bool) {
 	params := mux.Vars(r)
 	groupID := params["group-id"]
 	if len(groupID) <= 0 {
 		log.Println("group-id is required")
 		http.Error(w, "group-id is required", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	requestData, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.Printf("Error on marshal model.MembershipFilter request body - %s\n", err.Error())
 		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
-		return
+		return nil, false
 	}
 
 	var request model.MembershipFilter
@@ -1016,30 +1202,39 @@ func (h *ApisHandler) GetGroupMembers(clientID string, current *model.User, w ht
 		}
 	}
 
-	request.GroupIDs = append(request.GroupIDs, groupID)
-
-	//check if allowed to update
-	members, err := h.app.Services.FindGroupMemberships(clientID, request)
+	err = request.Validate()
 	if err != nil {
-		log.Printf("api.GetGroupMembers error: %s", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
+		log.Printf("error: api.readGroupMembersFilter() - %s", err.Error())
+		http.Error(w, utils.NewBadRequestError(err.Error()).JSONErrorString(), http.StatusBadRequest)
+		return nil, false
 	}
 
-	if members.Items == nil {
-		members.Items = []model.GroupMembership{}
-	}
+	// the members of the group of the path only, the search by the email and the NetID is given by the admin APIs only
+	request.GroupIDs = []string{groupID}
+	request.SearchName = true
+	return &request, true
+}
 
-	data, err := json.Marshal(members.Items)
-	if err != nil {
-		log.Printf("api.GetGroupMembers error: %s", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
+// loadGroupMembersAccess gives the group if the current user could list its members, i.e. is an admin or a member of the group or has
+// the support read permission. It responds with the error if not. The full view flag says if the admin only fields of the members are given.
+func (h *ApisHandler) loadGroupMembersAccess(clientID string, current *model.User, groupID string, w http.ResponseWriter) (*model.Group, bool, bool) {
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil || group == nil {
+		log.Printf("error: api.loadGroupMembersAccess() - unable to find group %s - %v", groupID, err)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return nil, false, false
 	}
 
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	w.Write(data)
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		_, supportRead := h.loadSupportReadGroup(clientID, current, groupID, "members")
+		if !supportRead {
+			log.Printf("error: api.loadGroupMembersAccess() - %s is not allowed to list the members of group %s", current.ID, groupID)
+			http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+			return nil, false, false
+		}
+		return group, true, true
+	}
+	return group, group.CurrentMember.IsAdmin(), true
 }
 
 // createMemberRequest
@@ -1067,6 +1262,7 @@ type createMemberRequest struct {
 // @Param APP header string true "APP"
 // @Param group-id path string true "Group ID"
 // @Success 200
+// @Failure 403 {string} string "Forbidden - the user is banned from the group"
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/members [post]
 func (h *ApisHandler) CreateMember(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -1146,6 +1342,9 @@ func (h *ApisHandler) CreateMember(clientID string, current *model.User, w http.
 
 	err = h.app.Services.CreateMembership(clientID, current, group, &member)
 	if err != nil {
+		if writeGroupBanError(w, err) {
+			return
+		}
 		log.Println(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1164,6 +1363,7 @@ func (h *ApisHandler) CreateMember(clientID string, current *model.User, w http.
 // @Param APP header string true "APP"
 // @Param group-id path string true "Group ID"
 // @Success 200
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/members/multi-update [put]
 func (h *ApisHandler) MultiUpdateMembers(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -1224,6 +1424,9 @@ func (h *ApisHandler) MultiUpdateMembers(clientID string, current *model.User, w
 
 	err = h.app.Services.UpdateMemberships(clientID, current, group, operation)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Printf("error: api.MultiUpdateMembers() - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1241,6 +1444,7 @@ func (h *ApisHandler) MultiUpdateMembers(clientID string, current *model.User, w
 // @Param APP header string true "APP"
 // @Param group-id path string true "Group ID"
 // @Success 200 {string} string "Successfuly deleted"
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/members [delete]
 func (h *ApisHandler) DeleteMember(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -1254,6 +1458,9 @@ func (h *ApisHandler) DeleteMember(clientID string, current *model.User, w http.
 
 	err := h.app.Services.DeleteMembership(clientID, current, groupID)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Println(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1265,12 +1472,13 @@ func (h *ApisHandler) DeleteMember(clientID string, current *model.User, w http.
 }
 
 type membershipApprovalRequest struct {
-	Approve        *bool  `json:"approve" validate:"required"`
-	RejectedReason string `json:"reject_reason"`
+	Approve          *bool  `json:"approve" validate:"required"`
+	RejectedReason   string `json:"reject_reason"`      // optional note of the rejection
+	RejectReasonCode string `json:"reject_reason_code"` // code of the rejection reasons catalog
 } // @name membershipApprovalRequest
 
 // MembershipApproval approve/deny a membership
-// @Description Аpprove/Deny a membership
+// @Description Аpprove/Deny a membership. If the client has a rejection reasons catalog the rejections require reject_reason_code of the catalog and reject_reason is an optional note, otherwise reject_reason is the free text reason. Gives 400 for a missing or unknown reason code.
 // @ID MembershipApproval
 // @Tags Client
 // @Accept json
@@ -1346,10 +1554,10 @@ func (h *ApisHandler) MembershipApproval(clientID string, current *model.User, w
 	approve := *requestData.Approve
 	rejectedReason := requestData.RejectedReason
 
-	err = h.app.Services.ApplyMembershipApproval(clientID, current, membershipID, approve, rejectedReason)
+	err = h.app.Services.ApplyMembershipApproval(clientID, current, membershipID, approve, requestData.RejectReasonCode, rejectedReason)
 	if err != nil {
 		log.Printf("Error on applying membership approval - %s\n", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
@@ -1367,6 +1575,7 @@ func (h *ApisHandler) MembershipApproval(clientID string, current *model.User, w
 // @Param APP header string true "APP"
 // @Param membership-id path string true "Membership ID"
 // @Success 200 {string} Successfully deleted
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/memberships/{membership-id} [delete]
 func (h *ApisHandler) DeleteMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -1412,6 +1621,9 @@ func (h *ApisHandler) DeleteMembership(clientID string, current *model.User, w h
 
 	err = h.app.Services.DeleteMembershipByID(clientID, current, membershipID)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Println(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1426,10 +1638,13 @@ type updateMembershipRequest struct {
 	Status                   *string                         `json:"status" validate:"required,oneof=member admin"`
 	DateAttended             *time.Time                      `json:"date_attended"`
 	NotificationsPreferences *model.NotificationsPreferences `json:"notifications_preferences"`
+	ShowAsLeader             *bool                           `json:"show_as_leader"`
+	Mentionable              *bool                           `json:"mentionable"`
+	Searchable               *bool                           `json:"searchable"`
 } // @name updateMembershipRequest
 
-// UpdateMembership updates a membership. Only admin can update the status and date_attended fields of a membership record. Member is allowed to update only his/her notification preferences.
-// @Description Updates a membership. Only admin can update the status and date_attended fields of a membership record. Member is allowed to update only his/her notification preferences.
+// UpdateMembership updates a membership. Only admin can update the status, date_attended and show_as_leader fields of a membership record. Member is allowed to update only his/her notification preferences and whether he/she could be mentioned or found in the member pickers.
+// @Description Updates a membership. Only admin can update the status, date_attended and show_as_leader fields of a membership record. Member is allowed to update only his/her notification preferences and whether he/she could be mentioned (mentionable) or found in the member pickers (searchable).
 // @ID UpdateMembership
 // @Tags Client
 // @Accept json
@@ -1438,6 +1653,7 @@ type updateMembershipRequest struct {
 // @Param data body updateMembershipRequest true "body data"
 // @Param membership-id path string true "Membership ID"
 // @Success 200 {string} Successfully updated
+// @Failure 409 {string} string "Conflict - the group must keep at least one admin"
 // @Security AppUserAuth
 // @Router /api/memberships/{membership-id} [put]
 func (h *ApisHandler) UpdateMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
@@ -1504,16 +1720,25 @@ func (h *ApisHandler) UpdateMembership(clientID string, current *model.User, w h
 	var status *string
 	var dateAttended *time.Time
 	var notificationsPreferences *model.NotificationsPreferences
+	var showAsLeader *bool
+	var mentionable *bool
+	var searchable *bool
 	if group.CurrentMember.IsAdmin() {
 		status = requestData.Status
 		dateAttended = requestData.DateAttended
+		showAsLeader = requestData.ShowAsLeader
 	}
 	if group.CurrentMember.UserID == membership.UserID {
 		notificationsPreferences = requestData.NotificationsPreferences
+		mentionable = requestData.Mentionable
+		searchable = requestData.Searchable
 	}
 
-	err = h.app.Services.UpdateMembership(clientID, current, membershipID, status, dateAttended, notificationsPreferences)
+	err = h.app.Services.UpdateMembership(clientID, current, membershipID, status, dateAttended, notificationsPreferences, showAsLeader, mentionable, searchable)
 	if err != nil {
+		if writeLastAdminError(w, err) {
+			return
+		}
 		log.Printf("Error on updating membership - %s\n", err)
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -1569,6 +1794,67 @@ func (h *ApisHandler) SynchAuthmanGroup(clientID string, current *model.User, w
 	w.WriteHeader(http.StatusOK)
 }
 
+// GetAuthmanSyncStatus Gives the last Authman sync runs of the group. Only admin of the group could get them
+// @Description Gives the last Authman sync runs of the group ordered by start date (newest first). Each run contains the start and end times, the counts of the added, removed and failed memberships and the error details. Only admin of the group could get them
+// @ID GetAuthmanSyncStatus
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param limit query integer false "Number of runs. Default 20"
+// @Success 200 {array} model.AuthmanSyncRun
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/authman/sync-status [get]
+func (h *ApisHandler) GetAuthmanSyncStatus(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	isAdmin, err := h.app.Services.IsGroupAdmin(clientID, groupID, current.ID)
+	if err != nil {
+		log.Println(err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if !isAdmin {
+		log.Printf("%s is not allowed to get the Authman sync status for group '%s'", current.Email, groupID)
+
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte("Forbidden"))
+		return
+	}
+
+	filter := model.AuthmanSyncRunFilter{GroupID: &groupID}
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	runs, err := h.app.Services.GetAuthmanSyncRuns(clientID, filter)
+	if err != nil {
+		log.Printf("error: api.GetAuthmanSyncStatus() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(runs)
+	if err != nil {
+		log.Printf("error: api.GetAuthmanSyncStatus() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
 // GetGroupEvents gives the group events
 // @Description Gives the group events.
 // @ID GetGroupEvents
@@ -1592,12 +1878,16 @@ func (h *ApisHandler) GetGroupEvents(clientID string, current *model.User, w htt
 
 	//check if allowed to see the events for this group
 	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
+	supportRead := false
 	if group == nil || group.CurrentMember == nil || !hasPermission {
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
-		return
+		group, supportRead = h.loadSupportReadGroup(clientID, current, groupID, "events")
+		if !supportRead {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
 	}
 
-	events, err := h.app.Services.GetEvents(clientID, current, groupID, group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember())
+	events, err := h.app.Services.GetEvents(clientID, current, groupID, !supportRead && !group.CurrentMember.IsAdminOrMember(), nil)
 	if err != nil {
 		log.Printf("error getting group events - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
@@ -1628,40 +1918,27 @@ func (h *ApisHandler) GetGroupEvents(clientID string, current *model.User, w htt
 // @Accept json
 // @Param APP header string true "APP"
 // @Param group-id path string true "Group ID"
+// @Param start_date query string false "Only events starting at or after the date (RFC3339)"
+// @Param end_date query string false "Only events starting at or before the date (RFC3339)"
+// @Param upcoming_only query bool false "Only events which have not ended yet"
+// @Param offset query int false "Offset"
+// @Param limit query int false "Limit"
 // @Success 200 {array} model.Event
 // @Security AppUserAuth
 // @Router /api/group/{group-id}/events/v2 [get]
 func (h *ApisHandler) GetGroupEventsV2(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
-	//validate input
-	params := mux.Vars(r)
-	groupID := params["group-id"]
-	if len(groupID) <= 0 {
-		log.Println("Group id is required")
-		http.Error(w, "Group id is required", http.StatusBadRequest)
+	request, ok := h.loadGroupEventsRequest(clientID, current, w, r)
+	if !ok {
 		return
 	}
 
-	//check if allowed to see the events for this group
-	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
-	if group == nil || group.CurrentMember == nil || !hasPermission {
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
-		return
-	}
-
-	events, err := h.app.Services.GetEvents(clientID, current, groupID, group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember())
+	events, err := h.app.Services.GetEvents(clientID, current, request.groupID, request.filterByToMembers, request.filter)
 	if err != nil {
 		log.Printf("error getting group events - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
-
-	// Remove  ToMembersList for non-admins
-	if len(events) > 0 && !group.CurrentMember.IsAdmin() {
-		for i, event := range events {
-			event.ToMembersList = nil
-			events[i] = event
-		}
-	}
+	request.hideToMembers(events)
 
 	data, err := json.Marshal(events)
 	if err != nil {
@@ -1675,6 +1952,105 @@ func (h *ApisHandler) GetGroupEventsV2(clientID string, current *model.User, w h
 	w.Write(data)
 }
 
+// groupEventsRequest is the validated request of the group events list APIs
+type groupEventsRequest struct {
+	groupID           string
+	filter            *model.EventsFilter
+	filterByToMembers bool
+	showToMembers     bool
+}
+
+// hideToMembers removes the recipients of the events for non-admins
+func (request groupEventsRequest) hideToMembers(events []model.Event) {
+	if request.showToMembers {
+		return
+	}
+	for i := range events {
+		events[i].ToMembersList = nil
+	}
+}
+
+// loadGroupEventsRequest checks the user is allowed to see the group events and reads the filter. It responds with the error otherwise.
+func (h *ApisHandler) loadGroupEventsRequest(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) (*groupEventsRequest, bool) {
+	//validate input
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return nil, false
+	}
+
+	//check if allowed to see the events for this group
+	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
+	supportRead := false
+	if group == nil || group.CurrentMember == nil || !hasPermission {
+		group, supportRead = h.loadSupportReadGroup(clientID, current, groupID, "events")
+		if !supportRead {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return nil, false
+		}
+	}
+
+	eventsFilter, err := getEventsFilterParams(r)
+	if err != nil {
+		log.Printf("error: api.loadGroupEventsRequest() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	return &groupEventsRequest{
+		groupID:           groupID,
+		filter:            eventsFilter,
+		filterByToMembers: !supportRead && !group.CurrentMember.IsAdminOrMember(),
+		showToMembers:     supportRead || group.CurrentMember.IsAdmin(),
+	}, true
+}
+
+func getEventsFilterParams(r *http.Request) (*model.EventsFilter, error) {
+	query := r.URL.Query()
+	var filter model.EventsFilter
+	if value := query.Get("start_date"); len(value) > 0 {
+		startDate, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start_date - %s", err.Error())
+		}
+		filter.StartDate = &startDate
+	}
+	if value := query.Get("end_date"); len(value) > 0 {
+		endDate, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end_date - %s", err.Error())
+		}
+		filter.EndDate = &endDate
+	}
+	if filter.StartDate != nil && filter.EndDate != nil && filter.EndDate.Before(*filter.StartDate) {
+		return nil, fmt.Errorf("end_date is before start_date")
+	}
+	if value := query.Get("upcoming_only"); len(value) > 0 {
+		upcomingOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("invalid upcoming_only - %s", err.Error())
+		}
+		filter.UpcomingOnly = upcomingOnly
+	}
+	if value := query.Get("offset"); len(value) > 0 {
+		offset, err := strconv.ParseInt(value, 0, 64)
+		if err != nil || offset < 0 {
+			return nil, fmt.Errorf("invalid offset")
+		}
+		filter.Offset = &offset
+	}
+	if value := query.Get("limit"); len(value) > 0 {
+		limit, err := strconv.ParseInt(value, 0, 64)
+		if err != nil || limit < 0 {
+			return nil, fmt.Errorf("invalid limit")
+		}
+		filter.Limit = &limit
+	}
+	return &filter, nil
+}
+
 type groupEventRequest struct {
 	EventID       string           `json:"event_id" validate:"required"`
 	ToMembersList []model.ToMember `json:"to_members" bson:"to_members"` // nil or empty means everyone; non-empty means visible to those user ids and admins
@@ -1903,28 +2279,22 @@ func (h *ApisHandler) DeleteGroupEvent(clientID string, current *model.User, w h
 	w.Write([]byte("Successfully deleted"))
 }
 
-// GetGroupPosts gets all posts for the desired group.
-// @Description gets all posts for the desired group.
-// @ID GetGroupPosts
-// @Tags Client
-// @Param APP header string true "APP"
-// @Param groupID query string true "groupID"
-// @Param type query string false "Values: message|post"
-// @Param offset query string false "offset"
-// @Param limit query integer false "limit"
-// @Param order query string false "asc|desc"
-// @Success 200 {array} model.Post
-// @Security AppUserAuth
-// @Security APIKeyAuth
-// @Router /api/group/{groupID}/posts [get]
-func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+// groupPostsRequest is the validated request of the group posts list APIs
+type groupPostsRequest struct {
+	filter                  model.PostsFilter
+	filterPrivatePostsValue *bool
+	filterByToMembers       bool
+}
+
+// loadGroupPostsRequest checks the user is allowed to see the group posts and reads the filter. It responds with the error otherwise.
+func (h *ApisHandler) loadGroupPostsRequest(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) (*groupPostsRequest, bool) {
 	var filter model.PostsFilter
 	params := mux.Vars(r)
 	id := params["groupID"]
 	if len(id) <= 0 {
 		log.Println("groupID is required")
 		http.Error(w, "groupID is required", http.StatusBadRequest)
-		return
+		return nil, false
 	}
 	filter.GroupID = id
 
@@ -1933,7 +2303,7 @@ func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http
 		if postTypesQuery[0] != "message" && postTypesQuery[0] != "post" {
 			log.Println("the 'type' query param can be 'message' or 'post'")
 			http.Error(w, "the 'type' query param can be 'message' or 'post'", http.StatusBadRequest)
-			return
+			return nil, false
 		}
 		filter.PostType = &postTypesQuery[0]
 	}
@@ -1943,7 +2313,7 @@ func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http
 		if scheduleOnlyQuery[0] != "true" && scheduleOnlyQuery[0] != "false" {
 			log.Println("the 'scheduled_only' query param can be 'true', 'false', or missing")
 			http.Error(w, "the 'scheduled_only' query param can be 'true', 'false', or missing", http.StatusBadRequest)
-			return
+			return nil, false
 		}
 		if scheduleOnlyQuery[0] == "true" {
 			val := true
@@ -1981,38 +2351,68 @@ func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http
 	if err != nil {
 		log.Println(err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+		return nil, false
 	}
 	if group == nil {
 		log.Printf("there is no a group for the provided group id - %s", id)
 		//do not say to much to the user as we do not know if he/she is an admin for the group yet
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
-		return
+		return nil, false
 	}
 
 	membership, _ := h.app.Services.FindGroupMembership(clientID, group.ID, current.ID)
 
+	supportRead := false
 	if membership == nil || !membership.IsAdminOrMember() {
-		log.Printf("%s is not allowed to get posts for group %s", current.Email, group.Title)
+		_, supportRead = h.loadSupportReadGroup(clientID, current, group.ID, "posts")
+		if !supportRead {
+			log.Printf("%s is not allowed to get posts for group %s", current.Email, group.Title)
 
-		w.WriteHeader(http.StatusForbidden)
-		w.Write([]byte("Forbidden"))
-		return
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("Forbidden"))
+			return nil, false
+		}
 	}
 
 	var filterPrivatePostsValue *bool
-	if group == nil || err != nil || membership == nil || !membership.IsAdminOrMember() {
+	if !supportRead && (group == nil || err != nil || membership == nil || !membership.IsAdminOrMember()) {
 		filter := false
 		filterPrivatePostsValue = &filter
 	}
 
-	filterByToMembers := true
-	posts, err := h.app.Services.GetPosts(clientID, current, filter, filterPrivatePostsValue, filterByToMembers)
+	return &groupPostsRequest{filter: filter, filterPrivatePostsValue: filterPrivatePostsValue, filterByToMembers: !supportRead}, true
+}
+
+// GetGroupPosts gets all posts for the desired group.
+// @Description gets all posts for the desired group. The reactions are given as counts per reaction (reaction_counts) and the reactions of the current user (user_reactions).
+// @ID GetGroupPosts
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID query string true "groupID"
+// @Param type query string false "Values: message|post"
+// @Param offset query string false "offset"
+// @Param limit query integer false "limit"
+// @Param order query string false "asc|desc"
+// @Success 200 {array} model.Post
+// @Security AppUserAuth
+// @Security APIKeyAuth
+// @Router /api/group/{groupID}/posts [get]
+func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	request, ok := h.loadGroupPostsRequest(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	id := request.filter.GroupID
+
+	posts, err := h.app.Services.GetPosts(clientID, current, request.filter, request.filterPrivatePostsValue, request.filterByToMembers)
 	if err != nil {
 		log.Printf("error getting posts for group (%s) - %s", id, err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	for i := range posts {
+		posts[i].SummarizeReactions(current.ID)
+	}
 
 	data, err := json.Marshal(posts)
 	if err != nil {
@@ -2027,7 +2427,7 @@ func (h *ApisHandler) GetGroupPosts(clientID string, current *model.User, w http
 }
 
 // CreateGroupPost creates a post within the desired group.
-// @Description creates a post within the desired group.
+// @Description creates a post within the desired group. Posts created with status "draft" are visible only to their creator until published. In the groups requiring approval the posts of the members get status "pending_approval" and are visible only to their creator until an admin approves them. Gives 429 with Retry-After header when the user exceeds the posts or the replies rate limit of the client.
 // @ID CreateGroupPost
 // @Tags Client
 // @Accept json
@@ -2088,16 +2488,31 @@ func (h *ApisHandler) CreateGroupPost(clientID string, current *model.User, w ht
 		return
 	}
 
+	if group.CurrentMember.IsMember() && post.ParentID == nil && group.Settings != nil && group.Settings.PostPreferences.PostsByAdminsOnly {
+		log.Printf("only the admins may create posts for group '%s'", group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
 	if group.CurrentMember.IsMember() && post.ParentID != nil && group.Settings != nil && !group.Settings.PostPreferences.CanSendPostReplies {
 		log.Printf("replies are not allowed for group '%s'", group.Title)
 		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 		return
 	}
 
+	if group.RequiresRulesAcknowledgement(group.CurrentMember) {
+		log.Printf("%s has not acknowledged the current rules of group '%s'", current.Email, group.Title)
+		http.Error(w, utils.NewRulesNotAcknowledgedError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
 	post.GroupID = id // Set group id from the query param
 
 	post, err = h.app.Services.CreatePost(clientID, current, post, group)
 	if err != nil {
+		if writePostLimitError(w, err) || writeContentFilterError(w, err) || writeContentRateLimitError(w, err) || writePostingMutedError(w, err) {
+			return
+		}
 		log.Printf("error getting posts for group - %s", err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -2116,7 +2531,7 @@ func (h *ApisHandler) CreateGroupPost(clientID string, current *model.User, w ht
 }
 
 // GetGroupPost Gets a post within the desired group.
-// @Description Gets a post within the desired group.
+// @Description Gets a post within the desired group. The reactions are given as counts per reaction (reaction_counts) and the reactions of the current user (user_reactions).
 // @ID GetGroupPost
 // @Tags Client
 // @Accept  json
@@ -2154,20 +2569,27 @@ func (h *ApisHandler) GetGroupPost(clientID string, current *model.User, w http.
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
 		return
 	}
+	supportRead := false
 	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
-		log.Printf("%s is not allowed to delete event for %s", current.Email, group.Title)
+		_, supportRead = h.loadSupportReadGroup(clientID, current, group.ID, "posts")
+		if !supportRead {
+			log.Printf("%s is not allowed to delete event for %s", current.Email, group.Title)
 
-		w.WriteHeader(http.StatusForbidden)
-		w.Write([]byte("Forbidden"))
-		return
+			w.WriteHeader(http.StatusForbidden)
+			w.Write([]byte("Forbidden"))
+			return
+		}
 	}
 
-	post, err := h.app.Services.GetPost(clientID, &current.ID, groupID, postID, true, true)
+	post, err := h.app.Services.GetPost(clientID, &current.ID, groupID, postID, true, !supportRead)
 	if err != nil {
 		log.Printf("error getting post (%s) - %s", postID, err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	if post != nil {
+		post.SummarizeReactions(current.ID)
+	}
 
 	data, err := json.Marshal(post)
 	if err != nil {
@@ -2250,6 +2672,9 @@ func (h *ApisHandler) UpdateGroupPost(clientID string, current *model.User, w ht
 
 	post, err = h.app.Services.UpdatePost(clientID, current, group, post)
 	if err != nil {
+		if writePostLimitError(w, err) || writeContentFilterError(w, err) {
+			return
+		}
 		log.Printf("error update post (%s) - %s", postID, err.Error())
 		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
@@ -2273,12 +2698,14 @@ type reactToGroupPostRequestBody struct {
 } // @name reactToGroupPostRequestBody
 
 // ReactToGroupPost Reacts to a post within the desired group.
-// @Description Reacts to a post within the desired group.
+// @Description Toggles the reaction of the current user to a post within the desired group. A user may react with several reactions. Gives 400 if the reaction is not within the allowed reactions of the group and 429 with Retry-After header when the user reacts too often or the reactions are temporarily suspended due to toggle spam.
 // @ID ReactToGroupPost
 // @Tags Client
 // @Accept  json
 // @Param APP header string true "APP"
 // @Success 200 {string} Success
+// @Failure 409 {string} string "Conflict - the reactions of the group are managed by the Social BB"
+// @Failure 429 {string} string "Too Many Requests"
 // @Security AppUserAuth
 // @Security APIKeyAuth
 // @Router /api/group/{groupId}/posts/{postId}/reactions [put]
@@ -2337,11 +2764,21 @@ func (h *ApisHandler) ReactToGroupPost(clientID string, current *model.User, w h
 		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
 		return
 	}
+	if group.Settings != nil && !group.Settings.PostPreferences.IsReactionAllowed(body.Reaction) {
+		log.Printf("reaction %s is not allowed for group '%s'", body.Reaction, group.Title)
+		http.Error(w, "the reaction is not allowed for the group", http.StatusBadRequest)
+		return
+	}
+	if group.ReactionsMigrated {
+		log.Printf("reactions of group '%s' are managed by the Social BB", group.Title)
+		http.Error(w, "reactions of the group are managed by the Social BB", http.StatusConflict)
+		return
+	}
 
 	err = h.app.Services.ReactToPost(clientID, current, groupID, postID, body.Reaction)
 	if err != nil {
 		log.Printf("error reacting to post (%s) - %s", postID, err.Error())
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
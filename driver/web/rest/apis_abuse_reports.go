@@ -0,0 +1,191 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type moderateAbuseReportRequest struct {
+	Action  string `json:"action" validate:"required,oneof=review dismiss remove_post warn_user"`
+	Comment string `json:"comment"`
+} // @name moderateAbuseReportRequest
+
+// getAbuseReportsFilter reads the status, offset and limit query params shared by the abuse report APIs
+func getAbuseReportsFilter(r *http.Request) model.AbuseReportsFilter {
+	filter := model.AbuseReportsFilter{
+		Offset: getInt64QueryParam(r, "offset"),
+		Limit:  getInt64QueryParam(r, "limit"),
+	}
+	if statuses := getStringQueryParam(r, "status"); statuses != nil {
+		filter.Statuses = strings.Split(*statuses, ",")
+	}
+	return filter
+}
+
+// GetGroupAbuseReports Gets the abuse reports of the group posts
+// @Description Gets the abuse reports of the group posts sent to the group admins ordered by the creation date (newest first). Only the group admins could see them.
+// @ID GetGroupAbuseReports
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param status query string false "Comma separated statuses - open, reviewed, resolved"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.AbuseReport
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/abuse-reports [get]
+func (h *ApisHandler) GetGroupAbuseReports(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID := mux.Vars(r)["groupID"]
+	if len(groupID) == 0 {
+		log.Printf("error: api.GetGroupAbuseReports() - groupID is required")
+		http.Error(w, "groupID is required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.GetGroupAbuseReports() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.GetGroupAbuseReports() - %s is not an admin of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	sendToGroupAdmins := true
+	targetType := model.AbuseReportTargetPost
+	filter := getAbuseReportsFilter(r)
+	filter.GroupIDs = []string{groupID}
+	filter.TargetType = &targetType
+	filter.SendToGroupAdmins = &sendToGroupAdmins
+
+	reports, err := h.app.Services.GetAbuseReports(clientID, filter)
+	if err != nil {
+		log.Printf("error: api.GetGroupAbuseReports() - unable to get the reports - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(reports)
+	if err != nil {
+		log.Printf("error: api.GetGroupAbuseReports() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// ModerateGroupAbuseReport Moderates an abuse report of a group post
+// @Description Applies a moderation action on an abuse report of a group post sent to the group admins. The review action marks the report as reviewed. The dismiss, remove_post and warn_user actions resolve the report. The resolved reports could not be moderated anymore. Only the group admins could moderate them.
+// @ID ModerateGroupAbuseReport
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param id path string true "Abuse report ID"
+// @Param data body moderateAbuseReportRequest true "body data"
+// @Success 200 {object} model.AbuseReport
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/abuse-reports/{id} [put]
+func (h *ApisHandler) ModerateGroupAbuseReport(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	id := params["id"]
+	if len(groupID) == 0 || len(id) == 0 {
+		log.Printf("error: api.ModerateGroupAbuseReport() - groupID and id are required")
+		http.Error(w, "groupID and id are required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.ModerateGroupAbuseReport() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.ModerateGroupAbuseReport() - %s is not an admin of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	writeModeratedAbuseReport(w, r, "api.ModerateGroupAbuseReport()", func(requestData moderateAbuseReportRequest) (*model.AbuseReport, error) {
+		return h.app.Services.ModerateAbuseReport(clientID, current, &groupID, id, requestData.Action, requestData.Comment)
+	})
+}
+
+// writeModeratedAbuseReport reads the moderation request, applies it through the moderate function and writes the moderated report
+func writeModeratedAbuseReport(w http.ResponseWriter, r *http.Request, caller string, moderate func(requestData moderateAbuseReportRequest) (*model.AbuseReport, error)) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: %s - unable to read the body - %s", caller, err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData moderateAbuseReportRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: %s - unable to unmarshal the body - %s", caller, err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: %s - invalid body - %s", caller, err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	report, err := moderate(requestData)
+	if err != nil {
+		log.Printf("error: %s - unable to moderate the report - %s", caller, err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if report == nil {
+		log.Printf("error: %s - abuse report not found", caller)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(report)
+	if err != nil {
+		log.Printf("error: %s - unable to marshal the response - %s", caller, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
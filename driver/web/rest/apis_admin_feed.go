@@ -0,0 +1,50 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+)
+
+// GetUserAdminFeed Gets the admin feed of the user
+// @Description Gets the things needing attention across all the groups the current user administers - the pending membership requests, the posts held for an approval, the unresolved abuse reports sent to the group admins and the groups whose last Authman sync has failed. The items are counted per group and type and sorted by the latest date (newest first). The deep link of an item is the path of the API which lists the things.
+// @ID GetUserAdminFeed
+// @Tags Client
+// @Param APP header string true "APP"
+// @Success 200 {object} model.AdminFeed
+// @Security AppUserAuth
+// @Router /api/user/admin-feed [get]
+func (h *ApisHandler) GetUserAdminFeed(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	feed, err := h.app.Services.GetAdminFeed(clientID, current)
+	if err != nil {
+		log.Printf("error: api.GetUserAdminFeed() - unable to get the admin feed - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(feed)
+	if err != nil {
+		log.Printf("error: api.GetUserAdminFeed() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
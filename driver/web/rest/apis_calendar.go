@@ -66,8 +66,10 @@ func (h *ApisHandler) GetGroupCalendarEventsV3(clientID string, current *model.U
 	//check if allowed to see the events for this group
 	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
 	if group == nil || group.CurrentMember == nil || !hasPermission {
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
-		return
+		if _, supportRead := h.loadSupportReadGroup(clientID, current, groupID, "events"); !supportRead {
+			http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+			return
+		}
 	}
 
 	published := true
@@ -156,12 +158,14 @@ func (h *ApisHandler) CreateCalendarEventMultiGroup(clientID string, current *mo
 }
 
 type createCalendarEventSingleGroupData struct {
-	Event     map[string]interface{} `json:"event"`
-	ToMembers []model.ToMember       `json:"to_members"`
+	Event      map[string]interface{}   `json:"event"`
+	ToMembers  []model.ToMember         `json:"to_members"`
+	Recurrence *model.EventRecurrence   `json:"recurrence,omitempty"` // creates a series of events starting with the event
+	Events     []map[string]interface{} `json:"events,omitempty"`     // the events of the created series
 }
 
 // CreateCalendarEventSingleGroup Create a calendar event and link it to a single group id
-// @Description Create a calendar event and link it to a single group id
+// @Description Create a calendar event and link it to a single group id. With a recurrence rule a calendar event is created for every occurrence and the events are linked to the group as one series.
 // @ID CreateCalendarEventSingleGroup
 // @Tags Client
 // @Accept json
@@ -218,6 +222,43 @@ func (h *ApisHandler) CreateCalendarEventSingleGroup(clientID string, current *m
 		return
 	}
 
+	if requestData.Recurrence != nil {
+		err = requestData.Recurrence.Validate()
+		if err != nil {
+			log.Printf("api.CreateCalendarEventSingleGroup() Error on validating the recurrence - %s\n", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		events, err := h.app.Services.CreateCalendarEventSeriesSingleGroup(clientID, current, requestData.Event, groupID, requestData.ToMembers, *requestData.Recurrence)
+		if err != nil {
+			log.Printf("api.CreateCalendarEventSingleGroup() Error on creating the event series - %s\n", err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		var firstEvent map[string]interface{}
+		if len(events) > 0 {
+			firstEvent = events[0]
+		}
+		data, err = json.Marshal(createCalendarEventSingleGroupData{
+			Event:      firstEvent,
+			ToMembers:  requestData.ToMembers,
+			Recurrence: requestData.Recurrence,
+			Events:     events,
+		})
+		if err != nil {
+			log.Printf("api.CreateCalendarEventSingleGroup() Error on marshaling response data - %s\n", err.Error())
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/plain")
+		w.WriteHeader(http.StatusOK)
+		w.Write(data)
+		return
+	}
+
 	event, member, err := h.app.Services.CreateCalendarEventSingleGroup(clientID, current, requestData.Event, groupID, requestData.ToMembers)
 	if err != nil {
 		log.Printf("api.CreateCalendarEventSingleGroup() Error on validating create event data - %s\n", err.Error())
@@ -325,6 +366,136 @@ func (h *ApisHandler) UpdateCalendarEventSingleGroup(clientID string, current *m
 	w.Write(data)
 }
 
+type updateCalendarEventSeriesData struct {
+	Events    []map[string]interface{} `json:"events"`
+	ToMembers []model.ToMember         `json:"to_members"`
+}
+
+type deleteEventSeriesResponse struct {
+	Deleted int64 `json:"deleted"`
+}
+
+// UpdateCalendarEventSeries Updates an event of a recurring series
+// @Description Updates an event of a recurring series. The scope "this" updates the selected event only, "future" updates the selected and all later events of the series. The later events keep their distance from the selected one.
+// @ID UpdateCalendarEventSeries
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param scope query string true "this or future"
+// @Param data body updateCalendarEventSingleGroupData true "body data"
+// @Success 200 {object} updateCalendarEventSeriesData
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/v3/{event-id}/series [put]
+func (h *ApisHandler) UpdateCalendarEventSeries(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID, eventID, scope, ok := h.getEventSeriesParams(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("api.UpdateCalendarEventSeries() Error on reading the request body - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData updateCalendarEventSingleGroupData
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("api.UpdateCalendarEventSeries() Error on unmarshal the update event request data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	events, err := h.app.Services.UpdateCalendarEventSeries(clientID, current, groupID, eventID, scope, requestData.Event, requestData.ToMembers)
+	if err != nil {
+		log.Printf("api.UpdateCalendarEventSeries() Error on updating the event series - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(updateCalendarEventSeriesData{
+		Events:    events,
+		ToMembers: requestData.ToMembers,
+	})
+	if err != nil {
+		log.Printf("api.UpdateCalendarEventSeries() Error on marshaling response data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// DeleteEventSeries Unlinks events of a recurring series from the group
+// @Description Unlinks events of a recurring series from the group. The scope "this" unlinks the selected event only, "future" unlinks the selected and all later events of the series.
+// @ID DeleteEventSeries
+// @Tags Client
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param scope query string true "this or future"
+// @Success 200 {object} deleteEventSeriesResponse
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/v3/{event-id}/series [delete]
+func (h *ApisHandler) DeleteEventSeries(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID, eventID, scope, ok := h.getEventSeriesParams(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	deleted, err := h.app.Services.DeleteEventSeries(clientID, groupID, eventID, scope)
+	if err != nil {
+		log.Printf("api.DeleteEventSeries() Error on deleting the event series - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(deleteEventSeriesResponse{Deleted: deleted})
+	if err != nil {
+		log.Printf("api.DeleteEventSeries() Error on marshaling response data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// getEventSeriesParams gives the group id, the event id and the scope of a series request. The current user must be a group admin.
+func (h *ApisHandler) getEventSeriesParams(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) (string, string, string, bool) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	eventID := params["event-id"]
+	if len(groupID) <= 0 || len(eventID) <= 0 {
+		log.Println("api.getEventSeriesParams() group id and event id are required")
+		http.Error(w, "group id and event id are required", http.StatusBadRequest)
+		return "", "", "", false
+	}
+
+	scope := r.URL.Query().Get("scope")
+	if scope != model.EventSeriesScopeThis && scope != model.EventSeriesScopeFuture {
+		log.Printf("api.getEventSeriesParams() invalid scope %s\n", scope)
+		http.Error(w, "scope must be this or future", http.StatusBadRequest)
+		return "", "", "", false
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil || membership == nil || !membership.IsAdmin() {
+		log.Printf("api.getEventSeriesParams() - User %s is not admin of the group - %s\n", current.ID, groupID)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return "", "", "", false
+	}
+	return groupID, eventID, scope, true
+}
+
 // getPutAdminGroupIDsForEventIDRequestAndResponse
 type getPutAdminGroupIDsForEventIDRequestAndResponse struct {
 	GroupIDs []string `json:"group_ids"`
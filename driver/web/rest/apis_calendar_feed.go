@@ -0,0 +1,183 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// CreateCalendarFeedLink Gives the iCal feed URL of the current member of a group
+// @Description Gives the personal iCal feed URL of the group events for the current member. The URL can be subscribed from the calendar clients. It stops working when the user leaves the group or rotates the URL.
+// @ID CreateCalendarFeedLink
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {object} model.CalendarFeedLink
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/ical/link [post]
+func (h *ApisHandler) CreateCalendarFeedLink(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
+	if group == nil || group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() || !hasPermission {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	link, err := h.app.Services.CreateCalendarFeedLink(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.CreateCalendarFeedLink() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(link)
+	if err != nil {
+		log.Printf("error: api.CreateCalendarFeedLink() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type rotateCalendarFeedLinkRequest struct {
+	OverlapSeconds int64 `json:"overlap_seconds" validate:"min=0,max=604800"` // the previous feed URL still works for this period
+} // @name rotateCalendarFeedLinkRequest
+
+// RotateCalendarFeedLink Rotates the iCal feed URL of the current member of a group
+// @Description Issues a new personal iCal feed URL of the group events for the current member, i.e. when the previous one has leaked. During the overlap the previous URL still works, so the subscribed calendars could be switched. Zero overlap revokes the previous URL at once. The rotation is recorded in the audit log and the group admins are notified.
+// @ID RotateCalendarFeedLink
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body rotateCalendarFeedLinkRequest true "body data"
+// @Success 200 {object} model.CalendarFeedLink
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/ical/link/rotate [post]
+func (h *ApisHandler) RotateCalendarFeedLink(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.RotateCalendarFeedLink() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData rotateCalendarFeedLinkRequest
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &requestData)
+		if err != nil {
+			log.Printf("error: api.RotateCalendarFeedLink() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.RotateCalendarFeedLink() - validation error - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	group, hasPermission := h.app.Services.CheckUserGroupMembershipPermission(clientID, current, groupID)
+	if group == nil || group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() || !hasPermission {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	link, err := h.app.Services.RotateCalendarFeedLink(clientID, current, group, time.Duration(requestData.OverlapSeconds)*time.Second)
+	if err != nil {
+		log.Printf("error: api.RotateCalendarFeedLink() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(link)
+	if err != nil {
+		log.Printf("error: api.RotateCalendarFeedLink() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetGroupEventsICal Gives the group events as an iCal feed
+// @Description Gives the group events visible to the member of the token as an iCalendar feed. The token is given by the feed link API. The events which started more than 90 days ago are not included.
+// @ID GetGroupEventsICal
+// @Tags Client
+// @Produce text/calendar
+// @Param group-id path string true "Group ID"
+// @Param token query string true "Signed calendar feed token"
+// @Success 200 {string} string
+// @Failure 404 {string} string "Not found - the token is not valid or the user is no longer a member of the group"
+// @Router /api/group/{group-id}/events/ical [get]
+func (h ApisHandler) GetGroupEventsICal(w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	token := r.URL.Query().Get("token")
+	if len(token) == 0 {
+		log.Println("error: api.GetGroupEventsICal() - missing token")
+		http.Error(w, utils.NewMissingParamError("token is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	feed, err := h.app.Services.GetGroupCalendarFeed(groupID, token)
+	if err != nil {
+		log.Printf("error: api.GetGroupEventsICal() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if feed == nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/calendar; charset=utf-8")
+	w.Header().Set("Content-Disposition", fmt.Sprintf("inline; filename=\"group-%s.ics\"", groupID))
+	w.WriteHeader(http.StatusOK)
+	w.Write(marshalICalendar(*feed, r.Host))
+}
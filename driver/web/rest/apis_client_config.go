@@ -0,0 +1,70 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+)
+
+// clientConfigResponse the settings of the client which the apps need for validating the input before sending it
+type clientConfigResponse struct {
+	PostLimits       model.PostLimitsConfig  `json:"post_limits"`
+	RejectionReasons []model.RejectionReason `json:"rejection_reasons"`
+} // @name clientConfigResponse
+
+// GetClientConfig Gets the client config
+// @Description Gets the settings of the client which the apps need for validating the input before sending it. The missing post limits are not applied. The rejection reasons are the catalog of the membership rejections with the messages of the best matching locale.
+// @ID GetClientConfig
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param locale query string false "Comma separated list of locales ordered by preference"
+// @Success 200 {object} clientConfigResponse
+// @Security AppUserAuth
+// @Router /api/client-config [get]
+func (h *ApisHandler) GetClientConfig(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	postLimits, err := h.app.Services.GetPostLimitsConfig(clientID)
+	if err != nil {
+		log.Printf("error: api.GetClientConfig() - unable to get the post limits config - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	rejectionReasons, err := h.app.Services.GetRejectionReasonsConfig(clientID)
+	if err != nil {
+		log.Printf("error: api.GetClientConfig() - unable to get the rejection reasons config - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	response := clientConfigResponse{PostLimits: model.PostLimitsConfig{ClientID: clientID}}
+	if postLimits != nil {
+		response.PostLimits = *postLimits
+	}
+	response.RejectionReasons = rejectionReasons.Localize(getLocalesQueryParam(r))
+
+	data, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("error: api.GetClientConfig() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,164 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type createEventCheckInCodeRequest struct {
+	TTLMinutes int `json:"ttl_minutes"`
+} // @name createEventCheckInCodeRequest
+
+type eventCheckInRequest struct {
+	Code  *string `json:"code"`
+	Token *string `json:"token"`
+} // @name eventCheckInRequest
+
+// CreateEventCheckInCode Generates a check-in code for a group event
+// @Description Generates a short-lived check-in code for an event of an attendance group. The code is given to the members by hand and the token is meant to be encoded in a QR code. The code expires after ttl_minutes (15 minutes by default, one day at most).
+// @ID CreateEventCheckInCode
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body createEventCheckInCodeRequest false "body data"
+// @Success 200 {object} model.EventCheckInCode
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/checkin-codes [post]
+func (h *ApisHandler) CreateEventCheckInCode(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, _, event, ok := h.loadRSVPEvent(clientID, current, w, r, true)
+	if !ok {
+		return
+	}
+	if !group.AttendanceGroup {
+		log.Printf("error: api.CreateEventCheckInCode() - %s is not an attendance group", group.Title)
+		http.Error(w, "check-in is available for attendance groups only", http.StatusBadRequest)
+		return
+	}
+
+	var requestData createEventCheckInCodeRequest
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.CreateEventCheckInCode() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &requestData)
+		if err != nil {
+			log.Printf("error: api.CreateEventCheckInCode() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	code, err := h.app.Services.CreateEventCheckInCode(clientID, current, group.ID, event.EventID, time.Duration(requestData.TTLMinutes)*time.Minute)
+	if err != nil {
+		log.Printf("error: api.CreateEventCheckInCode() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(code)
+	if err != nil {
+		log.Printf("error: api.CreateEventCheckInCode() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// CheckInToEvent Checks in the current member to a group event
+// @Description Checks in the current member to an event of an attendance group with the code or the QR token given by the group admin. The attendance date of the membership is set on the first check-in. Checking in again keeps the first check-in.
+// @ID CheckInToEvent
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body eventCheckInRequest true "body data"
+// @Success 200 {object} model.EventAttendance
+// @Failure 403 {string} string "Forbidden - the code is not valid or has expired"
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/checkin [post]
+func (h *ApisHandler) CheckInToEvent(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, membership, event, ok := h.loadRSVPEvent(clientID, current, w, r, false)
+	if !ok {
+		return
+	}
+	if !group.AttendanceGroup {
+		log.Printf("error: api.CheckInToEvent() - %s is not an attendance group", group.Title)
+		http.Error(w, "check-in is available for attendance groups only", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.CheckInToEvent() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData eventCheckInRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.CheckInToEvent() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if requestData.Code != nil {
+		code := strings.ToUpper(strings.TrimSpace(*requestData.Code))
+		requestData.Code = &code
+	}
+	if (requestData.Code == nil || len(*requestData.Code) == 0) && (requestData.Token == nil || len(*requestData.Token) == 0) {
+		log.Println("error: api.CheckInToEvent() - code or token is required")
+		http.Error(w, "code or token is required", http.StatusBadRequest)
+		return
+	}
+
+	attendance, err := h.app.Services.CheckInToEvent(clientID, current, membership, event.EventID, requestData.Code, requestData.Token)
+	if err != nil {
+		log.Printf("error: api.CheckInToEvent() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if attendance == nil {
+		log.Printf("error: api.CheckInToEvent() - invalid or expired check-in code for event %s by %s", event.EventID, current.Email)
+		http.Error(w, "the check-in code is not valid or has expired", http.StatusForbidden)
+		return
+	}
+
+	data, err = json.Marshal(attendance)
+	if err != nil {
+		log.Printf("error: api.CheckInToEvent() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,289 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type eventRSVPRequest struct {
+	Status string `json:"status"`
+} // @name eventRSVPRequest
+
+type eventReminderRequest struct {
+	Reminder bool `json:"reminder"`
+} // @name eventReminderRequest
+
+type eventRSVPReminderRequest struct {
+	Message string `json:"message"`
+} // @name eventRSVPReminderRequest
+
+type eventRSVPReminderResponse struct {
+	Notified int `json:"notified"`
+} // @name eventRSVPReminderResponse
+
+// UpdateEventRSVP Sets the RSVP of the current user for a group event
+// @Description Sets the RSVP of the current user for a group event. The status is one of going, maybe or not_going. Only the group members who can see the event are allowed to respond.
+// @ID UpdateEventRSVP
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body eventRSVPRequest true "body data"
+// @Success 200 {object} model.EventRSVPSummary
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/rsvp [put]
+func (h *ApisHandler) UpdateEventRSVP(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	_, _, event, ok := h.loadRSVPEvent(clientID, current, w, r, false)
+	if !ok {
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.UpdateEventRSVP() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData eventRSVPRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.UpdateEventRSVP() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !model.IsValidEventRSVPStatus(requestData.Status) {
+		log.Printf("error: api.UpdateEventRSVP() - invalid status %s", requestData.Status)
+		http.Error(w, "status must be one of going, maybe or not_going", http.StatusBadRequest)
+		return
+	}
+
+	summary, err := h.app.Services.UpdateEventRSVP(clientID, current, event.GroupID, event.EventID, requestData.Status)
+	if err != nil {
+		log.Printf("error: api.UpdateEventRSVP() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(summary)
+	if err != nil {
+		log.Printf("error: api.UpdateEventRSVP() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// UpdateEventReminder Sets the reminder opt-in of the current user for a group event
+// @Description Sets the reminder opt-in of the current user for a group event. The members who opted in get the event reminders even if they muted the group events, the members who opted out do not get them even if they are going. The scheduled reminders are sent a day before the event to the members going to it when the event_reminders setting of the group is on, otherwise to the members who opted in only.
+// @ID UpdateEventReminder
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body eventReminderRequest true "body data"
+// @Success 200 {object} model.EventRSVPSummary
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/reminder [put]
+func (h *ApisHandler) UpdateEventReminder(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	_, _, event, ok := h.loadRSVPEvent(clientID, current, w, r, false)
+	if !ok {
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.UpdateEventReminder() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData eventReminderRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.UpdateEventReminder() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	summary, err := h.app.Services.UpdateEventReminder(clientID, current, event.GroupID, event.EventID, requestData.Reminder)
+	if err != nil {
+		log.Printf("error: api.UpdateEventReminder() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(summary)
+	if err != nil {
+		log.Printf("error: api.UpdateEventReminder() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetEventRSVPs Gets the RSVP summary of a group event
+// @Description Gets the RSVP counts of a group event and the response of the current user. The group admins get the list of the responses as well.
+// @ID GetEventRSVPs
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Success 200 {object} model.EventRSVPSummary
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/rsvps [get]
+func (h *ApisHandler) GetEventRSVPs(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	_, membership, event, ok := h.loadRSVPEvent(clientID, current, w, r, false)
+	if !ok {
+		return
+	}
+
+	summary := event.GetRSVPSummary(current.ID, membership.IsAdmin())
+
+	data, err := json.Marshal(summary)
+	if err != nil {
+		log.Printf("error: api.GetEventRSVPs() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// SendEventRSVPReminder Sends a reminder to the members who are going to a group event
+// @Description Sends a reminder notification to the group members who responded going to the event or opted in for its reminders. Only group admins can send reminders. A default message is used if the message is empty.
+// @ID SendEventRSVPReminder
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body eventRSVPReminderRequest false "body data"
+// @Success 200 {object} eventRSVPReminderResponse
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/rsvps/reminder [post]
+func (h *ApisHandler) SendEventRSVPReminder(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, _, event, ok := h.loadRSVPEvent(clientID, current, w, r, true)
+	if !ok {
+		return
+	}
+
+	var requestData eventRSVPReminderRequest
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.SendEventRSVPReminder() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &requestData)
+		if err != nil {
+			log.Printf("error: api.SendEventRSVPReminder() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	notified, err := h.app.Services.SendEventRSVPReminder(clientID, current, group, event, requestData.Message)
+	if err != nil {
+		log.Printf("error: api.SendEventRSVPReminder() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(eventRSVPReminderResponse{Notified: notified})
+	if err != nil {
+		log.Printf("error: api.SendEventRSVPReminder() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// loadRSVPEvent loads the group event of the request and checks that the current user is allowed to access its RSVPs.
+// The error response is written if the check fails.
+func (h *ApisHandler) loadRSVPEvent(clientID string, current *model.User, w http.ResponseWriter, r *http.Request, adminOnly bool) (*model.Group, *model.GroupMembership, *model.Event, bool) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return nil, nil, nil, false
+	}
+	eventID := params["event-id"]
+	if len(eventID) <= 0 {
+		log.Println("Event id is required")
+		http.Error(w, "Event id is required", http.StatusBadRequest)
+		return nil, nil, nil, false
+	}
+
+	group, err := h.app.Services.GetGroupEntity(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.loadRSVPEvent() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, nil, nil, false
+	}
+	if group == nil {
+		log.Printf("error: api.loadRSVPEvent() - missing group %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, nil, nil, false
+	}
+
+	membership, _ := h.app.Services.FindGroupMembership(clientID, group.ID, current.ID)
+	if membership == nil || !membership.IsAdminOrMember() || (adminOnly && !membership.IsAdmin()) {
+		log.Printf("error: api.loadRSVPEvent() - %s is not allowed to access the RSVPs of event %s", current.Email, eventID)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return nil, nil, nil, false
+	}
+
+	event, err := h.app.Services.GetGroupEvent(clientID, group.ID, eventID)
+	if err != nil {
+		log.Printf("error: api.loadRSVPEvent() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return nil, nil, nil, false
+	}
+	if event == nil {
+		log.Printf("error: api.loadRSVPEvent() - missing event %s for group %s", eventID, groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return nil, nil, nil, false
+	}
+	if !membership.IsAdmin() && event.HasToMembersList() && !event.HasToMemberUser(&current.ID, &current.ExternalID) {
+		log.Printf("error: api.loadRSVPEvent() - event %s is not visible for %s", eventID, current.Email)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return nil, nil, nil, false
+	}
+
+	return group, membership, event, true
+}
@@ -0,0 +1,143 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type featureGroupEventRequest struct {
+	FeaturedUntil *time.Time `json:"featured_until" validate:"required"`
+} // @name featureGroupEventRequest
+
+// FeatureGroupEvent Features an event of the group
+// @Description Features an event of the group until the featured_until time, so the events and the feed APIs list it first. The event could be featured for up to 30 days and at most 3 events can be featured in a group at the same time. Setting a new time for a featured event extends or shortens it. Only group admins can feature events.
+// @ID FeatureGroupEvent
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Param data body featureGroupEventRequest true "body data"
+// @Success 200 {object} model.Event
+// @Failure 409 {object} utils.GroupError "the featured events limit is reached"
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/feature [put]
+func (h *ApisHandler) FeatureGroupEvent(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.FeatureGroupEvent() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData featureGroupEventRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.FeatureGroupEvent() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.FeatureGroupEvent() - validation error - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	now := time.Now().UTC()
+	featuredUntil := requestData.FeaturedUntil.UTC()
+	if !featuredUntil.After(now) || featuredUntil.Sub(now) > model.MaxEventFeatureDuration {
+		log.Printf("error: api.FeatureGroupEvent() - invalid featured_until %s", featuredUntil)
+		http.Error(w, utils.NewValidationError(fmt.Errorf("featured_until must be in the future and within %d days", int(model.MaxEventFeatureDuration.Hours()/24))).JSONErrorString(),
+			http.StatusBadRequest)
+		return
+	}
+
+	h.featureGroupEvent("FeatureGroupEvent", clientID, current, w, r, &featuredUntil)
+}
+
+// UnfeatureGroupEvent Stops featuring an event of the group
+// @Description Stops featuring an event of the group before its featured_until time. Only group admins can unfeature events.
+// @ID UnfeatureGroupEvent
+// @Tags Client
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param event-id path string true "Event ID"
+// @Success 200 {object} model.Event
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/events/{event-id}/feature [delete]
+func (h *ApisHandler) UnfeatureGroupEvent(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	h.featureGroupEvent("UnfeatureGroupEvent", clientID, current, w, r, nil)
+}
+
+func (h *ApisHandler) featureGroupEvent(api string, clientID string, current *model.User, w http.ResponseWriter, r *http.Request, featuredUntil *time.Time) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	eventID := params["event-id"]
+	if len(groupID) == 0 || len(eventID) == 0 {
+		log.Printf("error: api.%s() - group-id and event-id are required", api)
+		http.Error(w, "group-id and event-id are required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkGroupAdmin(api, clientID, current, groupID, w) {
+		return
+	}
+
+	event, err := h.app.Services.FeatureEvent(clientID, current, groupID, eventID, featuredUntil)
+	if err != nil {
+		var groupErr *utils.GroupError
+		if errors.As(err, &groupErr) {
+			log.Printf("error: api.%s() - %s", api, err.Error())
+			http.Error(w, groupErr.JSONErrorString(), http.StatusConflict)
+			return
+		}
+		log.Printf("error: api.%s() - unable to update event (%s) - %s", api, eventID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if event == nil {
+		log.Printf("error: api.%s() - event (%s) not found in group %s", api, eventID, groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(event)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to marshal the response - %s", api, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupFeed Gets the activity feed of a group
+// @Description Gets the recent posts, events and membership milestones of a group in one chronologically sorted (newest first) list. Only the group members can see the feed.
+// @ID GetGroupFeed
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit - 20 by default"
+// @Success 200 {array} model.GroupFeedItem
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/feed [get]
+func (h *ApisHandler) GetGroupFeed(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	var filter model.GroupFeedFilter
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			filter.Offset = &val
+		}
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupFeed() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupFeed() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.GetGroupFeed() - %s is not allowed to see the feed of group %s", current.Email, group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	feed, err := h.app.Services.GetGroupFeed(clientID, current, groupID, filter)
+	if err != nil {
+		log.Printf("error: api.GetGroupFeed() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(feed)
+	if err != nil {
+		log.Printf("error: api.GetGroupFeed() - unable to marshal the feed - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,105 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// ApplyGroupAction Applies a group admin quick action
+// @Description Applies a single admin quick action within a group. Supported types: membership.approve, membership.reject (with optional reason), membership.remove and membership.mute (with duration in seconds, from a minute to a year, the muted member cannot post nor reply until it ends) which require membership_id, and post.delete and post.pin which require post_id. Only group admins can apply actions. The outcome of the action is reported in the result.
+// @ID ApplyGroupAction
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.GroupAction true "body data"
+// @Success 200 {object} model.GroupActionResult
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/actions [post]
+func (h *ApisHandler) ApplyGroupAction(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.ApplyGroupAction() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var action model.GroupAction
+	err = json.Unmarshal(data, &action)
+	if err != nil {
+		log.Printf("error: api.ApplyGroupAction() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(action)
+	if err != nil {
+		log.Printf("error: api.ApplyGroupAction() - validation error - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !action.IsSupported() {
+		log.Printf("error: api.ApplyGroupAction() - unsupported action type %s", action.Type)
+		http.Error(w, "unsupported action type", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.ApplyGroupAction() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.ApplyGroupAction() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.ApplyGroupAction() - %s is not allowed to apply actions within group %s", current.Email, group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	result := h.app.Services.ApplyGroupAction(clientID, current, group, action)
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		log.Printf("error: api.ApplyGroupAction() - unable to marshal the result - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
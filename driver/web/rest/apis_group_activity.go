@@ -0,0 +1,93 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupActivity Gets what happened in a group since a moment
+// @Description Gets the new posts, the new events, the joins and the posts pinned since the provided moment. Only the posts and the events visible to the current user are included. The joins are listed only if the group allows the member info, otherwise just counted. The lists contain up to 50 newest items. Only the group members can see the activity.
+// @ID GetGroupActivity
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param since query string true "since - RFC3339 date"
+// @Success 200 {object} model.GroupActivity
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/activity [get]
+func (h *ApisHandler) GetGroupActivity(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	sinceStr := r.URL.Query().Get("since")
+	if len(sinceStr) == 0 {
+		log.Println("error: api.GetGroupActivity() - since is required")
+		http.Error(w, "since is required", http.StatusBadRequest)
+		return
+	}
+	since, err := time.Parse(time.RFC3339, sinceStr)
+	if err != nil {
+		log.Printf("error: api.GetGroupActivity() - invalid since %s - %s", sinceStr, err.Error())
+		http.Error(w, "since must be a RFC3339 date", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupActivity() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupActivity() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.GetGroupActivity() - %s is not allowed to see the activity of group %s", current.Email, group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	activity, err := h.app.Services.GetGroupActivity(clientID, current, group, since)
+	if err != nil {
+		log.Printf("error: api.GetGroupActivity() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(activity)
+	if err != nil {
+		log.Printf("error: api.GetGroupActivity() - unable to marshal the activity - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
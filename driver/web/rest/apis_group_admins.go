@@ -0,0 +1,118 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// transferGroupOwnershipRequest the member who becomes an admin of the group
+type transferGroupOwnershipRequest struct {
+	MembershipID string `json:"membership_id" validate:"required"`
+	KeepAdmin    bool   `json:"keep_admin"` // the current admin stays an admin too
+} // @name transferGroupOwnershipRequest
+
+// TransferGroupOwnership Transfers the group ownership to a member
+// @Description Makes a member of the group an admin. The current admin becomes a member unless keep_admin is set. Only group admins can transfer the ownership. The last admin of a group cannot leave it or be demoted, so the ownership has to be transferred first.
+// @ID TransferGroupOwnership
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body transferGroupOwnershipRequest true "body data"
+// @Param group-id path string true "Group ID"
+// @Success 200 {object} model.GroupMembership
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/ownership/transfer [post]
+func (h *ApisHandler) TransferGroupOwnership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) == 0 {
+		log.Println("error: api.TransferGroupOwnership() - group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData transferGroupOwnershipRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - validation error - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - unable to find the group - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.TransferGroupOwnership() - group (%s) not found", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.TransferGroupOwnership() - %s is not allowed to transfer the ownership of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	membership, err := h.app.Services.TransferGroupOwnership(clientID, current, group, requestData.MembershipID, requestData.KeepAdmin)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if membership == nil {
+		log.Printf("error: api.TransferGroupOwnership() - membership (%s) not found in group %s", requestData.MembershipID, groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(membership)
+	if err != nil {
+		log.Printf("error: api.TransferGroupOwnership() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,213 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// createGroupBanRequest the user to ban by the user id or the external id
+type createGroupBanRequest struct {
+	UserID     string `json:"user_id" validate:"required_without=ExternalID"`
+	ExternalID string `json:"external_id" validate:"required_without=UserID"`
+	Reason     string `json:"reason" validate:"max=500"`
+} // @name createGroupBanRequest
+
+// CreateGroupBan Bans a user from the group
+// @Description Bans a user from the group by the user id or the external id. The membership of the user is deleted. The banned users cannot request the membership or join the group automatically, the admins cannot add them and the Authman and the smart group syncs skip them. The ban is kept until it is deleted. The admins cannot be banned. Only group admins can ban users.
+// @ID CreateGroupBan
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body createGroupBanRequest true "body data"
+// @Param group-id path string true "Group ID"
+// @Success 200 {object} model.GroupBan
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/bans [post]
+func (h *ApisHandler) CreateGroupBan(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) == 0 {
+		log.Println("error: api.CreateGroupBan() - group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData createGroupBanRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - validation error - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroupEntity(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - unable to find the group - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.CreateGroupBan() - group (%s) not found", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+	if !h.checkGroupAdmin("CreateGroupBan", clientID, current, groupID, w) {
+		return
+	}
+
+	ban, err := h.app.Services.BanGroupMember(clientID, current, group, requestData.UserID, requestData.ExternalID, requestData.Reason)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	data, err = json.Marshal(ban)
+	if err != nil {
+		log.Printf("error: api.CreateGroupBan() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetGroupBans Gives the bans of the group
+// @Description Gives the users banned from the group, the latest first. Only group admins can list the bans.
+// @ID GetGroupBans
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {array} model.GroupBan
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/bans [get]
+func (h *ApisHandler) GetGroupBans(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) == 0 {
+		log.Println("error: api.GetGroupBans() - group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkGroupAdmin("GetGroupBans", clientID, current, groupID, w) {
+		return
+	}
+
+	bans, err := h.app.Services.GetGroupBans(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupBans() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if bans == nil {
+		bans = []model.GroupBan{}
+	}
+
+	data, err := json.Marshal(bans)
+	if err != nil {
+		log.Printf("error: api.GetGroupBans() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// DeleteGroupBan Lifts the ban of a user
+// @Description Lifts the ban of a user, so the user could join the group again. The deleted membership is not restored. Only group admins can lift the bans.
+// @ID DeleteGroupBan
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param ban-id path string true "Ban ID"
+// @Success 200 {string} Successfully deleted
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/bans/{ban-id} [delete]
+func (h *ApisHandler) DeleteGroupBan(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	banID := params["ban-id"]
+	if len(groupID) == 0 || len(banID) == 0 {
+		log.Println("error: api.DeleteGroupBan() - group-id and ban-id are required")
+		http.Error(w, "group-id and ban-id are required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkGroupAdmin("DeleteGroupBan", clientID, current, groupID, w) {
+		return
+	}
+
+	deleted, err := h.app.Services.UnbanGroupMember(clientID, current, groupID, banID)
+	if err != nil {
+		log.Printf("error: api.DeleteGroupBan() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		log.Printf("error: api.DeleteGroupBan() - ban (%s) not found in group %s", banID, groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully deleted"))
+}
+
+// checkGroupAdmin responds with an error and gives false if the current user is not an admin of the group
+func (h *ApisHandler) checkGroupAdmin(api string, clientID string, current *model.User, groupID string, w http.ResponseWriter) bool {
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to find the membership - %s", api, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return false
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.%s() - %s is not an admin of group %s", api, current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return false
+	}
+	return true
+}
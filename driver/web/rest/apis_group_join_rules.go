@@ -0,0 +1,119 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+)
+
+// UpdateGroupJoinRules Sets the join rules of the group
+// @Description Sets the join rules of the group. The membership requests of the users matching any of the email domains, the NetID pattern or the Core BB account attributes skip the pending state. The other requests wait for the admins as usual. Only group admins can set the join rules.
+// @ID UpdateGroupJoinRules
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.GroupJoinRules true "body data"
+// @Success 200 {object} model.GroupJoinRules
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/join-rules [put]
+func (h *ApisHandler) UpdateGroupJoinRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.UpdateGroupJoinRules() - %s is not allowed to update the join rules of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupJoinRules() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var rules model.GroupJoinRules
+	err = json.Unmarshal(data, &rules)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupJoinRules() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = rules.Validate()
+	if err != nil {
+		log.Printf("error: api.UpdateGroupJoinRules() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	err = h.app.Services.UpdateGroupJoinRules(clientID, current, group, &rules)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupJoinRules() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(rules)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupJoinRules() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// DeleteGroupJoinRules Removes the join rules of the group
+// @Description Removes the join rules of the group, so all the membership requests wait for the admins again. Only group admins can remove the join rules.
+// @ID DeleteGroupJoinRules
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {string} Successfully deleted
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/join-rules [delete]
+func (h *ApisHandler) DeleteGroupJoinRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.DeleteGroupJoinRules() - %s is not allowed to remove the join rules of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	err := h.app.Services.UpdateGroupJoinRules(clientID, current, group, nil)
+	if err != nil {
+		log.Printf("error: api.DeleteGroupJoinRules() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully deleted"))
+}
@@ -0,0 +1,289 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorilla/mux"
+)
+
+// groupMessagesKeepAlivePeriod the stream sends a comment periodically so the proxies do not close the idle connections
+const groupMessagesKeepAlivePeriod = 25 * time.Second
+
+type createGroupMessageRequest struct {
+	Body string `json:"body"`
+} // @name createGroupMessageRequest
+
+// GetGroupMessages Gets the chat messages of a group
+// @Description Gets the chat messages of a group from the newest to the oldest one. Use the next_cursor of the response to load the older messages. The messages are kept for 30 days.
+// @ID GetGroupMessages
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param cursor query string false "next_cursor of the previous page"
+// @Param limit query integer false "page size - 50 by default, 200 max"
+// @Success 200 {object} model.GroupMessagesPage
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/messages [get]
+func (h *ApisHandler) GetGroupMessages(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadMessagesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	var cursor *model.GroupMessagesCursor
+	if cursorParam := r.URL.Query().Get("cursor"); len(cursorParam) > 0 {
+		parsed, err := model.ParseGroupMessagesCursor(cursorParam)
+		if err != nil {
+			log.Printf("error: api.GetGroupMessages() - %s", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+		cursor = parsed
+	}
+
+	var limit int64
+	if limitParam := r.URL.Query().Get("limit"); len(limitParam) > 0 {
+		val, err := strconv.ParseInt(limitParam, 10, 64)
+		if err != nil {
+			log.Printf("error: api.GetGroupMessages() - invalid limit - %s", err.Error())
+			http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+		limit = val
+	}
+
+	page, err := h.app.Services.GetGroupMessages(clientID, group.ID, cursor, limit)
+	if err != nil {
+		log.Printf("error: api.GetGroupMessages() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(page)
+	if err != nil {
+		log.Printf("error: api.GetGroupMessages() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// CreateGroupMessage Sends a chat message to a group
+// @Description Sends a chat message to a group. The body is plain text up to 2000 characters. Use the posts for structured announcements.
+// @ID CreateGroupMessage
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body createGroupMessageRequest true "body data"
+// @Success 200 {object} model.GroupMessage
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/messages [post]
+func (h *ApisHandler) CreateGroupMessage(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadMessagesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.RequiresRulesAcknowledgement(group.CurrentMember) {
+		log.Printf("error: api.CreateGroupMessage() - %s has not acknowledged the current rules of group '%s'", current.Email, group.Title)
+		http.Error(w, utils.NewRulesNotAcknowledgedError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.CreateGroupMessage() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData createGroupMessageRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.CreateGroupMessage() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	body := strings.TrimSpace(requestData.Body)
+	if len(body) == 0 || utf8.RuneCountInString(body) > model.GroupMessageMaxLength {
+		err = fmt.Errorf("body must be between 1 and %d characters", model.GroupMessageMaxLength)
+		log.Printf("error: api.CreateGroupMessage() - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	message, err := h.app.Services.CreateGroupMessage(clientID, current, group.ID, body)
+	if err != nil {
+		log.Printf("error: api.CreateGroupMessage() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(message)
+	if err != nil {
+		log.Printf("error: api.CreateGroupMessage() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// DeleteGroupMessage Deletes a chat message of a group
+// @Description Deletes a chat message of a group. The members may delete their own messages, the group admins may delete any message.
+// @ID DeleteGroupMessage
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param message-id path string true "Message ID"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/messages/{message-id} [delete]
+func (h *ApisHandler) DeleteGroupMessage(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadMessagesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	messageID := mux.Vars(r)["message-id"]
+	if len(messageID) <= 0 {
+		log.Println("Message id is required")
+		http.Error(w, utils.NewMissingParamError("Message id is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	deleted, err := h.app.Services.DeleteGroupMessage(clientID, current, group, messageID)
+	if err != nil {
+		log.Printf("error: api.DeleteGroupMessage() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if !deleted {
+		log.Printf("error: api.DeleteGroupMessage() - message %s of group %s is missing or not owned by %s", messageID, group.ID, current.Email)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// StreamGroupMessages Streams the new chat messages of a group
+// @Description Streams the new chat messages of a group as server-sent events. Every event has the "message" type and a GroupMessage JSON data. Load the history with the messages API.
+// @ID StreamGroupMessages
+// @Tags Client
+// @Produce text/event-stream
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {object} model.GroupMessage
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/messages/stream [get]
+func (h *ApisHandler) StreamGroupMessages(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadMessagesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		log.Println("error: api.StreamGroupMessages() - streaming is not supported")
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	messages, unsubscribe := h.app.Services.SubscribeGroupMessages(clientID, group.ID)
+	defer unsubscribe()
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	keepAlive := time.NewTicker(groupMessagesKeepAlivePeriod)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-keepAlive.C:
+			fmt.Fprint(w, ": keep-alive\n\n")
+			flusher.Flush()
+		case message, ok := <-messages:
+			if !ok {
+				return
+			}
+			data, err := json.Marshal(message)
+			if err != nil {
+				log.Printf("error: api.StreamGroupMessages() - unable to marshal message %s - %s", message.ID, err.Error())
+				continue
+			}
+			fmt.Fprintf(w, "id: %s\nevent: message\ndata: %s\n\n", message.ID, data)
+			flusher.Flush()
+		}
+	}
+}
+
+// loadMessagesGroup loads the group of the request and checks that the current user is a member or has the support read access for the GET requests.
+// The error response is written if the group cannot be loaded.
+func (h *ApisHandler) loadMessagesGroup(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) (*model.Group, bool) {
+	groupID := mux.Vars(r)["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, utils.NewMissingParamError("Group id is required").JSONErrorString(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.loadMessagesGroup() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if group == nil {
+		log.Printf("error: api.loadMessagesGroup() - missing group %s", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return nil, false
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		// the support staff may read the messages only
+		if r.Method == http.MethodGet {
+			if _, supportRead := h.loadSupportReadGroup(clientID, current, group.ID, "messages"); supportRead {
+				return group, true
+			}
+		}
+		log.Printf("error: api.loadMessagesGroup() - %s is not a member of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return nil, false
+	}
+	return group, true
+}
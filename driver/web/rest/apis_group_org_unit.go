@@ -0,0 +1,127 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type updateGroupOrgUnitRequest struct {
+	Code string `json:"code" validate:"required"`
+} // @name updateGroupOrgUnitRequest
+
+// UpdateGroupOrgUnit Links the group to an org unit
+// @Description Links the group to an org unit of the campus organizational hierarchy, i.e. a department. The code is checked with the hierarchy and the unit name is kept in sync daily. The groups under a college could be listed with the org_unit_code filter. Only group admins can link the group.
+// @ID UpdateGroupOrgUnit
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body updateGroupOrgUnitRequest true "body data"
+// @Success 200 {object} model.GroupOrgUnit
+// @Failure 400 {string} string "Bad request - unknown org unit code"
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/org-unit [put]
+func (h *ApisHandler) UpdateGroupOrgUnit(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.UpdateGroupOrgUnit() - %s is not allowed to update the org unit of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupOrgUnit() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData updateGroupOrgUnitRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupOrgUnit() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupOrgUnit() - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	orgUnit, err := h.app.Services.UpdateGroupOrgUnit(clientID, current, group, &requestData.Code)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupOrgUnit() - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+
+	data, err = json.Marshal(orgUnit)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupOrgUnit() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// DeleteGroupOrgUnit Unlinks the group from its org unit
+// @Description Unlinks the group from its org unit, so it is not listed under the unit any more. Only group admins can unlink the group.
+// @ID DeleteGroupOrgUnit
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {string} Successfully deleted
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/org-unit [delete]
+func (h *ApisHandler) DeleteGroupOrgUnit(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.DeleteGroupOrgUnit() - %s is not allowed to remove the org unit of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	_, err := h.app.Services.UpdateGroupOrgUnit(clientID, current, group, nil)
+	if err != nil {
+		log.Printf("error: api.DeleteGroupOrgUnit() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully deleted"))
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+)
+
+// PatchGroup Updates only the provided fields of a group
+// @Description Updates only the fields provided in the body, the missing fields are left unchanged. Unlike the full update, the unset fields are not cleared, i.e. the image URL or the settings. The Authman and the membership question fields are updated by the full update only. Only group admins can update the group.
+// @ID PatchGroup
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.GroupPatch true "body data"
+// @Success 200 {object} model.Group
+// @Failure 400 {string} string "Bad request - invalid fields or duplicated title"
+// @Security AppUserAuth
+// @Router /api/v3/groups/{group-id} [patch]
+func (h *ApisHandler) PatchGroup(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.PatchGroup() - %s is not allowed to update group settings '%s'. Only group admin could update a group", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+	if group.AuthmanEnabled && !current.HasPermission("managed_group_admin") {
+		log.Printf("error: api.PatchGroup() - %s is not allowed to update the managed group '%s'", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.PatchGroup() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var patch model.GroupPatch
+	err = json.Unmarshal(data, &patch)
+	if err != nil {
+		log.Printf("error: api.PatchGroup() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	if patch.IsEmpty() {
+		log.Printf("error: api.PatchGroup() - no fields to update")
+		http.Error(w, utils.NewMissingParamError("no fields to update").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	err = patch.Validate()
+	if err != nil {
+		log.Printf("error: api.PatchGroup() - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	if (group.ResearchGroup || patch.ModifiesResearch()) && !current.HasPermission("research_group_admin") {
+		log.Printf("error: api.PatchGroup() - %s is not allowed to update the research group '%s'", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	patched, groupErr := h.app.Services.PatchGroup(clientID, current, group.ID, patch)
+	if groupErr != nil {
+		log.Printf("error: api.PatchGroup() - %s", groupErr.Error())
+		http.Error(w, groupErr.JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	data, err = json.Marshal(patched)
+	if err != nil {
+		log.Printf("error: api.PatchGroup() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,205 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+type updateGroupRulesRequest struct {
+	Text string `json:"text"`
+} // @name updateGroupRulesRequest
+
+type acknowledgeGroupRulesRequest struct {
+	Version int `json:"version"`
+} // @name acknowledgeGroupRulesRequest
+
+// GetGroupRules Gets the rules of the group
+// @Description Gets the rules (code of conduct) of the group together with the version acknowledged by the current user. The members have to acknowledge the current version before posting.
+// @ID GetGroupRules
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {object} model.GroupRulesStatus
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/rules [get]
+func (h *ApisHandler) GetGroupRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	data, err := json.Marshal(group.GetRulesStatus(group.CurrentMember))
+	if err != nil {
+		log.Printf("error: api.GetGroupRules() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// UpdateGroupRules Updates the rules of the group
+// @Description Updates the rules (code of conduct) of the group. The version increases whenever the text changes and the members are notified to acknowledge the new rules. An empty text removes the rules. Only group admins can update the rules.
+// @ID UpdateGroupRules
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body updateGroupRulesRequest true "body data"
+// @Success 200 {object} model.GroupRules
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/rules [put]
+func (h *ApisHandler) UpdateGroupRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.UpdateGroupRules() - %s is not allowed to update the rules of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupRules() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData updateGroupRulesRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupRules() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	rules, err := h.app.Services.UpdateGroupRules(clientID, current, group, requestData.Text)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupRules() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(rules)
+	if err != nil {
+		log.Printf("error: api.UpdateGroupRules() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// AcknowledgeGroupRules Acknowledges the rules of the group
+// @Description Acknowledges the current version of the group rules by the current member. Gives 409 if the rules have changed in the meantime, so the member has to review the new version.
+// @ID AcknowledgeGroupRules
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body acknowledgeGroupRulesRequest true "body data"
+// @Success 200 {object} model.GroupRulesStatus
+// @Failure 409 {string} string "Conflict - the acknowledged version is not the current one"
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/rules/acknowledge [post]
+func (h *ApisHandler) AcknowledgeGroupRules(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	group, ok := h.loadRulesGroup(clientID, current, w, r)
+	if !ok {
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.AcknowledgeGroupRules() - %s is not a member of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.AcknowledgeGroupRules() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData acknowledgeGroupRulesRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.AcknowledgeGroupRules() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	if group.Rules == nil || group.Rules.Version != requestData.Version {
+		log.Printf("error: api.AcknowledgeGroupRules() - version %d is not the current rules version of %s", requestData.Version, group.Title)
+		http.Error(w, "the rules have changed, please review the current version", http.StatusConflict)
+		return
+	}
+
+	err = h.app.Services.AcknowledgeGroupRules(clientID, current, group.ID, requestData.Version)
+	if err != nil {
+		log.Printf("error: api.AcknowledgeGroupRules() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	group.CurrentMember.RulesAcknowledgedVersion = requestData.Version
+	data, err = json.Marshal(group.GetRulesStatus(group.CurrentMember))
+	if err != nil {
+		log.Printf("error: api.AcknowledgeGroupRules() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// loadRulesGroup loads the group of the request for the current user. The error response is written if the group cannot be loaded.
+func (h *ApisHandler) loadRulesGroup(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) (*model.Group, bool) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, utils.NewMissingParamError("Group id is required").JSONErrorString(), http.StatusBadRequest)
+		return nil, false
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.loadRulesGroup() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return nil, false
+	}
+	if group == nil {
+		log.Printf("error: api.loadRulesGroup() - missing group %s", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return nil, false
+	}
+	return group, true
+}
@@ -0,0 +1,86 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupMemberPicker Gets the members listed in the member picker of the group
+// @Description Gets the members and the admins of the group to be picked (e.g. for a mention) by the current user. The members who opted out of the member pickers (searchable = false) are not listed. The mentions of the members who opted out of being mentioned (mentionable = false) are turned into plain text when a post is saved. Gives 403 if the member names are not visible to the current user.
+// @ID GetGroupMemberPicker
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param name query string false "Filters the members by name"
+// @Param limit query integer false "The maximum number of the members"
+// @Success 200 {array} model.PickerMember
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/members/picker [get]
+func (h *ApisHandler) GetGroupMemberPicker(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID := mux.Vars(r)["group-id"]
+	if len(groupID) == 0 {
+		log.Printf("error: api.GetGroupMemberPicker() - group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupMemberPicker() - unable to find group %s - %s", groupID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupMemberPicker() - group %s not found", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.GetGroupMemberPicker() - %s is not a member of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+	if !group.CurrentMember.IsAdmin() && group.Settings != nil &&
+		!(group.Settings.MemberInfoPreferences.AllowMemberInfo && group.Settings.MemberInfoPreferences.CanViewMemberName) {
+		log.Printf("error: api.GetGroupMemberPicker() - the member names of group %s are hidden", groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	members, err := h.app.Services.GetPickerMembers(clientID, current, groupID, getStringQueryParam(r, "name"), getInt64QueryParam(r, "limit"))
+	if err != nil {
+		log.Printf("error: api.GetGroupMemberPicker() - unable to get the members - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(members)
+	if err != nil {
+		log.Printf("error: api.GetGroupMemberPicker() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
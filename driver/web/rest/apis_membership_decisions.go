@@ -0,0 +1,130 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type pendingMemberDecision struct {
+	MembershipID     string `json:"membership_id" validate:"required"`
+	Approve          *bool  `json:"approve" validate:"required"`
+	RejectedReason   string `json:"reject_reason"`      // optional note of the rejection
+	RejectReasonCode string `json:"reject_reason_code"` // code of the rejection reasons catalog
+} // @name pendingMemberDecision
+
+type pendingMemberDecisionsRequest struct {
+	Decisions []pendingMemberDecision `json:"decisions" validate:"required,min=1,max=100,dive"`
+} // @name pendingMemberDecisionsRequest
+
+// CreatePendingMemberDecisions Approves or rejects multiple pending memberships of the group
+// @Description Approves or rejects up to 100 pending memberships of the group at once. The decisions are applied in one transaction, so if any of the memberships is not pending in the group none of them is applied. The rejections follow the rules of the single membership approval API. Every decided user gets one notification. Only group admins can decide.
+// @ID CreatePendingMemberDecisions
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body pendingMemberDecisionsRequest true "body data"
+// @Param group-id path string true "Group ID"
+// @Success 200 {array} model.GroupMembership
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/pending-members/decisions [post]
+func (h *ApisHandler) CreatePendingMemberDecisions(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) == 0 {
+		log.Println("error: api.CreatePendingMemberDecisions() - group-id is required")
+		http.Error(w, "group-id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var requestData pendingMemberDecisionsRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - validation error - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	decisions := make([]model.MembershipDecision, 0, len(requestData.Decisions))
+	membershipIDs := map[string]bool{}
+	for _, item := range requestData.Decisions {
+		if membershipIDs[item.MembershipID] {
+			log.Printf("error: api.CreatePendingMemberDecisions() - duplicate decision for membership %s", item.MembershipID)
+			http.Error(w, fmt.Sprintf("duplicate decision for membership %s", item.MembershipID), http.StatusBadRequest)
+			return
+		}
+		membershipIDs[item.MembershipID] = true
+		decisions = append(decisions, model.MembershipDecision{MembershipID: item.MembershipID, Approve: *item.Approve,
+			RejectReasonCode: item.RejectReasonCode, RejectReasonNote: item.RejectedReason})
+	}
+
+	group, err := h.app.Services.GetGroupEntity(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - unable to find the group - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - group (%s) not found", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+	if !h.checkGroupAdmin("CreatePendingMemberDecisions", clientID, current, groupID, w) {
+		return
+	}
+
+	memberships, err := h.app.Services.ApplyMembershipDecisions(clientID, current, group, decisions)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - %s", err)
+		writeError(w, err)
+		return
+	}
+
+	data, err = json.Marshal(memberships)
+	if err != nil {
+		log.Printf("error: api.CreatePendingMemberDecisions() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,91 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// updateMembershipDigestRequest the digest frequency, empty for the notification per post and event
+type updateMembershipDigestRequest struct {
+	Frequency string `json:"frequency" validate:"omitempty,oneof=daily weekly"`
+	Email     bool   `json:"email"`
+} // @name updateMembershipDigestRequest
+
+// UpdateMembershipDigest updates the digest mode of the group for the current member
+// @Description Switches the current member to the daily or weekly digests of the group. The new posts and events are not notified one by one but summarized within a single digest notification, emailed too if requested. An empty frequency turns the digests off.
+// @ID UpdateMembershipDigest
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body updateMembershipDigestRequest true "body data"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {string} Successfully updated
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/digest [put]
+func (h *ApisHandler) UpdateMembershipDigest(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("Membership id is required")
+		http.Error(w, "Membership id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error on reading the membership digest data - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData updateMembershipDigestRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("Error on unmarshal the membership digest data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("Error on validating membership digest data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkOwnMembership(clientID, current, membershipID, w) {
+		return
+	}
+
+	err = h.app.Services.UpdateMembershipDigest(clientID, membershipID, requestData.Frequency, requestData.Email)
+	if err != nil {
+		log.Printf("Error on updating the digest of membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully updated"))
+}
@@ -0,0 +1,270 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// muteMembershipRequest the duration of the mute in seconds, up to a year
+type muteMembershipRequest struct {
+	Duration int64 `json:"duration" validate:"required,min=60,max=31536000"`
+} // @name muteMembershipRequest
+
+// muteMembershipResponse the time until the notifications of the group are muted
+type muteMembershipResponse struct {
+	MutedUntil time.Time `json:"muted_until"`
+} // @name muteMembershipResponse
+
+// MuteMembership mutes all the notifications of the group for the current member
+// @Description Temporarily mutes all the notifications of the group (posts, events, polls and membership changes) for the current member. The notifications are still delivered as muted. They are unmuted automatically once the duration passes.
+// @ID MuteMembership
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body muteMembershipRequest true "body data"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {object} muteMembershipResponse
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/mute [put]
+func (h *ApisHandler) MuteMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("Membership id is required")
+		http.Error(w, "Membership id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error on reading the membership mute data - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData muteMembershipRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("Error on unmarshal the membership mute data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("Error on validating membership mute data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkOwnMembership(clientID, current, membershipID, w) {
+		return
+	}
+
+	mutedUntil, err := h.app.Services.MuteMembership(clientID, membershipID, time.Duration(requestData.Duration)*time.Second)
+	if err != nil {
+		log.Printf("Error on muting membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(muteMembershipResponse{MutedUntil: *mutedUntil})
+	if err != nil {
+		log.Printf("Error on marshal the membership mute - %s\n", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// UnmuteMembership unmutes the notifications of the group for the current member
+// @Description Unmutes the notifications of the group for the current member before the mute expires
+// @ID UnmuteMembership
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {string} Successfully unmuted
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/mute [delete]
+func (h *ApisHandler) UnmuteMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("Membership id is required")
+		http.Error(w, "Membership id is required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkOwnMembership(clientID, current, membershipID, w) {
+		return
+	}
+
+	err := h.app.Services.UnmuteMembership(clientID, membershipID)
+	if err != nil {
+		log.Printf("Error on unmuting membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully unmuted"))
+}
+
+// snoozeMembershipRequest the duration of the snooze in seconds, up to a week
+type snoozeMembershipRequest struct {
+	Duration int64 `json:"duration" validate:"required,min=60,max=604800"`
+} // @name snoozeMembershipRequest
+
+// snoozeMembershipResponse the time until the immediate notifications of the group are snoozed
+type snoozeMembershipResponse struct {
+	SnoozedUntil time.Time `json:"snoozed_until"`
+} // @name snoozeMembershipResponse
+
+// SnoozeMembership snoozes the immediate notifications of the group for the current member
+// @Description Snoozes the immediate notifications of the group (posts, events, polls and membership changes) for the current member, i.e. for 8 hours. The notifications are still delivered as muted and the digests still accumulate. The notification preferences and the mute are not changed. The snooze ends automatically once the duration passes.
+// @ID SnoozeMembership
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param data body snoozeMembershipRequest true "body data"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {object} snoozeMembershipResponse
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/snooze [put]
+func (h *ApisHandler) SnoozeMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("Membership id is required")
+		http.Error(w, "Membership id is required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("Error on reading the membership snooze data - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData snoozeMembershipRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("Error on unmarshal the membership snooze data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("Error on validating membership snooze data - %s\n", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkOwnMembership(clientID, current, membershipID, w) {
+		return
+	}
+
+	snoozedUntil, err := h.app.Services.SnoozeMembership(clientID, membershipID, time.Duration(requestData.Duration)*time.Second)
+	if err != nil {
+		log.Printf("Error on snoozing membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err = json.Marshal(snoozeMembershipResponse{SnoozedUntil: *snoozedUntil})
+	if err != nil {
+		log.Printf("Error on marshal the membership snooze - %s\n", err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// UnsnoozeMembership ends the snooze of the group notifications for the current member
+// @Description Ends the snooze of the immediate notifications of the group for the current member before it expires
+// @ID UnsnoozeMembership
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {string} Successfully unsnoozed
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/snooze [delete]
+func (h *ApisHandler) UnsnoozeMembership(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("Membership id is required")
+		http.Error(w, "Membership id is required", http.StatusBadRequest)
+		return
+	}
+
+	if !h.checkOwnMembership(clientID, current, membershipID, w) {
+		return
+	}
+
+	err := h.app.Services.UnsnoozeMembership(clientID, membershipID)
+	if err != nil {
+		log.Printf("Error on unsnoozing membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully unsnoozed"))
+}
+
+// checkOwnMembership responds with an error and gives false if the membership does not belong to the current user
+func (h *ApisHandler) checkOwnMembership(clientID string, current *model.User, membershipID string, w http.ResponseWriter) bool {
+	membership, err := h.app.Services.FindGroupMembershipByID(clientID, membershipID)
+	if err != nil {
+		log.Printf("Error on finding membership %s - %s\n", membershipID, err)
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return false
+	}
+	if membership == nil {
+		log.Printf("Membership %s not found\n", membershipID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return false
+	}
+	if membership.UserID != current.ID {
+		log.Printf("%s is not allowed to update membership %s", current.Email, membershipID)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return false
+	}
+	return true
+}
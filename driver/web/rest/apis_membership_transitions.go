@@ -0,0 +1,130 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type scheduleMembershipTransitionRequest struct {
+	Status        string    `json:"status" validate:"required,oneof=admin member removed"`
+	DateEffective time.Time `json:"date_effective" validate:"required"`
+} // @name scheduleMembershipTransitionRequest
+
+// ScheduleMembershipTransition Schedules a status change of a membership
+// @Description Schedules a future status change of a member or an admin, i.e. at the end of an officer term. The member becomes an admin or a member or is removed from the group at the effective date and is notified. A membership has at most one scheduled change, the new one replaces the previous. The scheduled change is given within the membership as scheduled_transition. The change which would leave the group without an admin is dropped. Only group admins could schedule the changes.
+// @ID ScheduleMembershipTransition
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param membership-id path string true "Membership ID"
+// @Param data body scheduleMembershipTransitionRequest true "body data"
+// @Success 200 {object} model.GroupMembership
+// @Failure 400 {string} string "Bad request - the effective date is not in the future or the status is the current one"
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/transition [put]
+func (h *ApisHandler) ScheduleMembershipTransition(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("error: api.ScheduleMembershipTransition() - membership id is required")
+		http.Error(w, utils.NewMissingParamError("Membership id is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	var requestData scheduleMembershipTransitionRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.ScheduleMembershipTransition(clientID, current, membershipID, requestData.Status, requestData.DateEffective)
+	if err != nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+	if membership == nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - membership %s not found", membershipID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(membership)
+	if err != nil {
+		log.Printf("error: api.ScheduleMembershipTransition() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// CancelMembershipTransition Cancels the scheduled status change of a membership
+// @Description Cancels the scheduled status change of a membership. Only group admins could cancel the changes.
+// @ID CancelMembershipTransition
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param membership-id path string true "Membership ID"
+// @Success 200 {string} Successfully cancelled
+// @Security AppUserAuth
+// @Router /api/memberships/{membership-id}/transition [delete]
+func (h *ApisHandler) CancelMembershipTransition(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	membershipID := params["membership-id"]
+	if len(membershipID) <= 0 {
+		log.Println("error: api.CancelMembershipTransition() - membership id is required")
+		http.Error(w, utils.NewMissingParamError("Membership id is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	err := h.app.Services.CancelMembershipTransition(clientID, current, membershipID)
+	if err != nil {
+		log.Printf("error: api.CancelMembershipTransition() - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully cancelled"))
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// PinGroupPost Pins a post of the group
+// @Description Pins a top level post of the group so it is returned first by the group posts API. At most 3 posts can be pinned in a group. Only group admins can pin posts.
+// @ID PinGroupPost
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {object} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/pin [put]
+func (h *ApisHandler) PinGroupPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	h.pinGroupPost("PinGroupPost", clientID, current, w, r, true)
+}
+
+// UnpinGroupPost Unpins a post of the group
+// @Description Unpins a post of the group. Only group admins can unpin posts.
+// @ID UnpinGroupPost
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {object} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/pin [delete]
+func (h *ApisHandler) UnpinGroupPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	h.pinGroupPost("UnpinGroupPost", clientID, current, w, r, false)
+}
+
+func (h *ApisHandler) pinGroupPost(api string, clientID string, current *model.User, w http.ResponseWriter, r *http.Request, pinned bool) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.%s() - groupID and postID are required", api)
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to find the membership - %s", api, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.%s() - %s is not allowed to pin posts in group %s", api, current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	post, err := h.app.Services.PinPost(clientID, current, groupID, postID, pinned)
+	if err != nil {
+		var groupErr *utils.GroupError
+		if errors.As(err, &groupErr) {
+			log.Printf("error: api.%s() - %s", api, err.Error())
+			http.Error(w, groupErr.JSONErrorString(), http.StatusConflict)
+			return
+		}
+		log.Printf("error: api.%s() - unable to update post (%s) - %s", api, postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if post == nil {
+		log.Printf("error: api.%s() - post (%s) not found", api, postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(post)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to marshal the response - %s", api, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,88 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupPollResults Gets the results of a group poll
+// @Description Gets the results of a group poll from the Polls BB according to the poll preferences of the group. The members may see the results any time, once the poll is closed or never, and the voter identities may be hidden from them. The group admins always see the full results. The results are cached for a short time.
+// @ID GetGroupPollResults
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param poll-id path string true "Poll ID"
+// @Success 200 {object} model.PollResults
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/polls/{poll-id}/results [get]
+func (h *ApisHandler) GetGroupPollResults(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	pollID := params["poll-id"]
+	if len(groupID) == 0 || len(pollID) == 0 {
+		log.Println("error: api.GetGroupPollResults() - group id and poll id are required")
+		http.Error(w, utils.NewMissingParamError("group id and poll id are required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPollResults() - unable to load group %s - %s", groupID, err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupPollResults() - missing group %s", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	results, err := h.app.Services.GetGroupPollResults(clientID, current, group, pollID)
+	if err != nil {
+		var groupErr *utils.GroupError
+		if errors.As(err, &groupErr) {
+			log.Printf("error: api.GetGroupPollResults() - %s is not allowed to see the results of poll %s", current.Email, pollID)
+			http.Error(w, groupErr.JSONErrorString(), http.StatusForbidden)
+			return
+		}
+		log.Printf("error: api.GetGroupPollResults() - unable to get the results of poll %s - %s", pollID, err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if results == nil {
+		log.Printf("error: api.GetGroupPollResults() - missing poll %s in group %s", pollID, groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(results)
+	if err != nil {
+		log.Printf("error: api.GetGroupPollResults() - unable to marshal the response - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+// GetGroupPendingPosts Gets the posts waiting for an approval
+// @Description Gets the posts of the members waiting for an admin approval ordered by the creation date (oldest first). The posts of the members wait for an approval when the group settings require it (post_preferences.posts_require_approval). Only group admins can get the queue.
+// @ID GetGroupPendingPosts
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Success 200 {array} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/pending [get]
+func (h *ApisHandler) GetGroupPendingPosts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID := mux.Vars(r)["groupID"]
+	if len(groupID) == 0 {
+		log.Printf("error: api.GetGroupPendingPosts() - groupID is required")
+		http.Error(w, "groupID is required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPendingPosts() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.GetGroupPendingPosts() - %s is not allowed to review the posts in group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	posts, err := h.app.Services.GetPendingApprovalPosts(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPendingPosts() - unable to get the pending posts - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(posts)
+	if err != nil {
+		log.Printf("error: api.GetGroupPendingPosts() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type postApprovalRequest struct {
+	Approve         *bool  `json:"approve" validate:"required"`
+	RejectionReason string `json:"rejection_reason"`
+} // @name postApprovalRequest
+
+// GroupPostApproval Approves or rejects a post waiting for an approval
+// @Description Approves or rejects a post waiting for an approval. The approved post is published as a new one and the members are notified. The rejected post stays visible only to its author. The author is notified about the outcome. Only group admins can moderate the posts.
+// @ID GroupPostApproval
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Param data body postApprovalRequest true "body data"
+// @Success 200 {object} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/approval [put]
+func (h *ApisHandler) GroupPostApproval(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.GroupPostApproval() - groupID and postID are required")
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData postApprovalRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - unable to unmarshal the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - invalid body - %s", err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - unable to find the group - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GroupPostApproval() - group %s not found", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.GroupPostApproval() - %s is not allowed to moderate the posts in group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	post, err := h.app.Services.ModeratePendingPost(clientID, current, group, postID, *requestData.Approve, requestData.RejectionReason)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - unable to moderate post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if post == nil {
+		log.Printf("error: api.GroupPostApproval() - pending post (%s) not found", postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(post)
+	if err != nil {
+		log.Printf("error: api.GroupPostApproval() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
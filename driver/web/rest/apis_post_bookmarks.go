@@ -0,0 +1,152 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+// BookmarkGroupPost Bookmarks a post of the group
+// @Description Bookmarks a post of the group for the current user. Bookmarking the post again keeps the original bookmark. Only group members could bookmark the posts visible to them.
+// @ID BookmarkGroupPost
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {object} model.PostBookmark
+// @Failure 404 {string} string "Not found - the post is not visible to the user"
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/bookmark [put]
+func (h *ApisHandler) BookmarkGroupPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.BookmarkGroupPost() - groupID and postID are required")
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	bookmark, err := h.app.Services.BookmarkPost(clientID, current, groupID, postID)
+	if err != nil {
+		log.Printf("error: api.BookmarkGroupPost() - unable to bookmark post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if bookmark == nil {
+		log.Printf("error: api.BookmarkGroupPost() - post (%s) not found", postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(bookmark)
+	if err != nil {
+		log.Printf("error: api.BookmarkGroupPost() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// UnbookmarkGroupPost Removes the bookmark of a post of the group
+// @Description Removes the bookmark of the post for the current user. The bookmark could be removed even if the post is not visible any more.
+// @ID UnbookmarkGroupPost
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {string} Successfully deleted
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/bookmark [delete]
+func (h *ApisHandler) UnbookmarkGroupPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	postID := params["postID"]
+	if len(postID) == 0 {
+		log.Printf("error: api.UnbookmarkGroupPost() - postID is required")
+		http.Error(w, "postID is required", http.StatusBadRequest)
+		return
+	}
+
+	err := h.app.Services.UnbookmarkPost(clientID, current, postID)
+	if err != nil {
+		log.Printf("error: api.UnbookmarkGroupPost() - unable to remove the bookmark of post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+	w.Write([]byte("Successfully deleted"))
+}
+
+// GetUserBookmarkedPosts Gets the posts bookmarked by the current user
+// @Description Gets the posts bookmarked by the current user across the groups ordered by the bookmark date (newest first). The visibility of the posts is checked again, so the deleted posts and the posts of the groups the user has left are skipped and a page could contain fewer posts than the limit.
+// @ID GetUserBookmarkedPosts
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {array} model.Post
+// @Security AppUserAuth
+// @Router /api/user/bookmarks [get]
+func (h *ApisHandler) GetUserBookmarkedPosts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	var offset, limit *int64
+
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			offset = &val
+		}
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			limit = &val
+		}
+	}
+
+	posts, err := h.app.Services.GetBookmarkedPosts(clientID, current, offset, limit)
+	if err != nil {
+		log.Printf("error: api.GetUserBookmarkedPosts() - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	for i := range posts {
+		posts[i].SummarizeReactions(current.ID)
+	}
+
+	data, err := json.Marshal(posts)
+	if err != nil {
+		log.Printf("error: api.GetUserBookmarkedPosts() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupDraftPosts Gets the draft posts of the current user
+// @Description Gets the draft posts of the current user within the group ordered by the last change (newest first). The drafts are created with status "draft" by the create post API and they are visible only to their creator until published.
+// @ID GetGroupDraftPosts
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Success 200 {array} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/drafts [get]
+func (h *ApisHandler) GetGroupDraftPosts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID := mux.Vars(r)["groupID"]
+	if len(groupID) == 0 {
+		log.Printf("error: api.GetGroupDraftPosts() - groupID is required")
+		http.Error(w, "groupID is required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.GetGroupDraftPosts() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdminOrMember() {
+		log.Printf("error: api.GetGroupDraftPosts() - %s is not a member of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	posts, err := h.app.Services.GetDraftPosts(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupDraftPosts() - unable to get the drafts - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(posts)
+	if err != nil {
+		log.Printf("error: api.GetGroupDraftPosts() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// PublishGroupDraftPost Publishes a draft post of the current user
+// @Description Publishes a draft post of the current user. The post is ordered as a new one and the members are notified the same way as for a new post. The scheduled drafts are notified at their scheduled date.
+// @ID PublishGroupDraftPost
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {object} model.Post
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/publish [post]
+func (h *ApisHandler) PublishGroupDraftPost(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.PublishGroupDraftPost() - groupID and postID are required")
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.PublishGroupDraftPost() - unable to find the group - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.PublishGroupDraftPost() - group %s not found", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	// the same checks as for creating a post apply, as the membership or the group settings could have changed since the draft was saved
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.PublishGroupDraftPost() - %s is not a member of %s", current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+	if group.CurrentMember.IsMember() && group.Settings != nil && !group.Settings.PostPreferences.AllowSendPost {
+		log.Printf("error: api.PublishGroupDraftPost() - posts are not allowed for group '%s'", group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+	if group.CurrentMember.IsMember() && group.Settings != nil && group.Settings.PostPreferences.PostsByAdminsOnly {
+		log.Printf("error: api.PublishGroupDraftPost() - only the admins may create posts for group '%s'", group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+	if group.RequiresRulesAcknowledgement(group.CurrentMember) {
+		log.Printf("error: api.PublishGroupDraftPost() - %s has not acknowledged the current rules of group '%s'", current.Email, group.Title)
+		http.Error(w, utils.NewRulesNotAcknowledgedError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	post, err := h.app.Services.PublishDraftPost(clientID, current, group, postID)
+	if err != nil {
+		log.Printf("error: api.PublishGroupDraftPost() - unable to publish post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if post == nil {
+		log.Printf("error: api.PublishGroupDraftPost() - draft (%s) not found", postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(post)
+	if err != nil {
+		log.Printf("error: api.PublishGroupDraftPost() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,87 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupPostReactions Gets the users who reacted to a post
+// @Description Gets the users who reacted to a post within the desired group. The names are given only if the current user is allowed to see the member names of the group.
+// @ID GetGroupPostReactions
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Param reaction query string false "Gives only the users who reacted with this reaction"
+// @Success 200 {array} model.PostReactor
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/reactions [get]
+func (h *ApisHandler) GetGroupPostReactions(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.GetGroupPostReactions() - groupID and postID are required")
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPostReactions() - unable to find group %s - %s", groupID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupPostReactions() - group %s not found", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.GetGroupPostReactions() - %s is not a member of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	reactors, err := h.app.Services.GetPostReactors(clientID, current, group, postID, getStringQueryParam(r, "reaction"))
+	if err != nil {
+		log.Printf("error: api.GetGroupPostReactions() - unable to get the reactions of post %s - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if reactors == nil {
+		log.Printf("error: api.GetGroupPostReactions() - post %s not found", postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(reactors)
+	if err != nil {
+		log.Printf("error: api.GetGroupPostReactions() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
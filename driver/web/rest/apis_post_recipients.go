@@ -0,0 +1,140 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+type postRecipientsRequest struct {
+	UserIDs []string `json:"user_ids" validate:"required,min=1"`
+} // @name postRecipientsRequest
+
+// AddGroupPostRecipients Adds recipients to a post
+// @Description Adds group members to the recipients of a post sent to the chosen members, without resubmitting the whole post. Only the newly added members are notified, the recipients of a scheduled post are notified when the post is sent. Only the creator of the post could change the recipients. The recipients of a post to the whole group are chosen by the post update.
+// @ID AddGroupPostRecipients
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Param data body postRecipientsRequest true "body data"
+// @Success 200 {object} model.Post
+// @Failure 400 {string} string "Bad request - not a group member or the post is sent to the whole group"
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/to-members [post]
+func (h *ApisHandler) AddGroupPostRecipients(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	h.updateGroupPostRecipients(clientID, current, w, r, "AddGroupPostRecipients", h.app.Services.AddPostRecipients)
+}
+
+// RemoveGroupPostRecipients Removes recipients from a post
+// @Description Removes members from the recipients of a post sent to the chosen members, without resubmitting the whole post. At least one recipient must remain, otherwise the post would become visible to the whole group. Only the creator of the post could change the recipients.
+// @ID RemoveGroupPostRecipients
+// @Tags Client
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Param data body postRecipientsRequest true "body data"
+// @Success 200 {object} model.Post
+// @Failure 400 {string} string "Bad request - no recipient would remain or the post is sent to the whole group"
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/to-members [delete]
+func (h *ApisHandler) RemoveGroupPostRecipients(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	h.updateGroupPostRecipients(clientID, current, w, r, "RemoveGroupPostRecipients", h.app.Services.RemovePostRecipients)
+}
+
+func (h *ApisHandler) updateGroupPostRecipients(clientID string, current *model.User, w http.ResponseWriter, r *http.Request, name string,
+	update func(clientID string, current *model.User, group *model.Group, postID string, userIDs []string) (*model.Post, error)) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.%s() - groupID and postID are required", name)
+		http.Error(w, utils.NewMissingParamError("groupID and postID are required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to read the body - %s", name, err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	var requestData postRecipientsRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to unmarshal the body - %s", name, err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+	validate := validator.New()
+	err = validate.Struct(requestData)
+	if err != nil {
+		log.Printf("error: api.%s() - %s", name, err.Error())
+		http.Error(w, utils.NewValidationError(err).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.%s() - %s", name, err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.%s() - missing group %s", name, groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdminOrMember() {
+		log.Printf("error: api.%s() - %s is not a member of %s", name, current.Email, group.Title)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	post, err := update(clientID, current, group, postID, requestData.UserIDs)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to update the recipients of post (%s) - %s", name, postID, err.Error())
+		writeError(w, err)
+		return
+	}
+	if post == nil {
+		log.Printf("error: api.%s() - post (%s) not found", name, postID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(post)
+	if err != nil {
+		log.Printf("error: api.%s() - unable to marshal the response - %s", name, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
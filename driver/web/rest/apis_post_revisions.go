@@ -0,0 +1,81 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupPostHistory Gets the edit history of a group post
+// @Description Gets the previous revisions (subject, body and image) of a group post ordered by the edit date (newest first). The current content is the post itself. Only group admins can get the history.
+// @ID GetGroupPostHistory
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param postID path string true "Post ID"
+// @Success 200 {array} model.PostRevision
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/{postID}/history [get]
+func (h *ApisHandler) GetGroupPostHistory(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["groupID"]
+	postID := params["postID"]
+	if len(groupID) == 0 || len(postID) == 0 {
+		log.Printf("error: api.GetGroupPostHistory() - groupID and postID are required")
+		http.Error(w, "groupID and postID are required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPostHistory() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdmin() {
+		log.Printf("error: api.GetGroupPostHistory() - %s is not allowed to get the post history in group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	revisions, err := h.app.Services.GetPostRevisions(clientID, current, groupID, postID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPostHistory() - unable to get the revisions of post (%s) - %s", postID, err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if revisions == nil {
+		log.Printf("error: api.GetGroupPostHistory() - post (%s) not found", postID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := json.Marshal(revisions)
+	if err != nil {
+		log.Printf("error: api.GetGroupPostHistory() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,98 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"groups/utils"
+	"log"
+	"net/http"
+	"strings"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupPublicFeed Gives the public posts of a public group as an Atom feed
+// @Description Gives the latest public posts of a public group as an Atom feed, so the departments could embed the announcements of the group on their websites. The private posts, the posts to specific members, the scheduled posts and the replies are not included, neither are the creators of the posts. The group admins could disable the feed with the public_feed_disabled setting. The feed is cached for 5 minutes and supports the conditional requests with ETag.
+// @ID GetGroupPublicFeed
+// @Tags Client
+// @Produce application/atom+xml
+// @Param APP header string false "APP"
+// @Param id path string true "Group ID"
+// @Param If-None-Match header string false "ETag of the cached feed"
+// @Success 200 {string} string
+// @Success 304 {string} string "Not modified"
+// @Failure 404 {string} string "Not found - the group does not exist, it is not public or the feed is disabled"
+// @Router /api/directory/groups/{id}/feed.atom [get]
+func (h *ApisHandler) GetGroupPublicFeed(clientID string, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["id"]
+
+	feed, err := h.app.Services.GetGroupPublicFeed(clientID, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupPublicFeed() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if feed == nil {
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+
+	data, err := marshalAtomFeed(*feed, requestURL(r))
+	if err != nil {
+		log.Printf("error: api.GetGroupPublicFeed() - unable to marshal the feed - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	hash := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(hash[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	w.Header().Set("Last-Modified", feed.Updated.UTC().Format(http.TimeFormat))
+	if etagMatches(r.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// etagMatches checks the If-None-Match header with the weak comparison (RFC 9110 13.1.2)
+func etagMatches(ifNoneMatch string, etag string) bool {
+	for _, value := range strings.Split(ifNoneMatch, ",") {
+		value = strings.TrimPrefix(strings.TrimSpace(value), "W/")
+		if value == "*" || value == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// requestURL gives the absolute URL of the request as seen by the client, the proxy headers are taken into account
+func requestURL(r *http.Request) string {
+	scheme := r.Header.Get("X-Forwarded-Proto")
+	if len(scheme) == 0 {
+		scheme = "http"
+		if r.TLS != nil {
+			scheme = "https"
+		}
+	}
+	return scheme + "://" + r.Host + r.URL.Path
+}
@@ -0,0 +1,93 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// MarkGroupPostsRead Marks the posts of the group as read
+// @Description Marks all posts of the group created until now as read by the current user. The unread counts include only the posts created afterwards.
+// @ID MarkGroupPostsRead
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/group/{groupID}/posts/mark-read [post]
+func (h *ApisHandler) MarkGroupPostsRead(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	groupID := mux.Vars(r)["groupID"]
+	if len(groupID) == 0 {
+		log.Printf("error: api.MarkGroupPostsRead() - groupID is required")
+		http.Error(w, "groupID is required", http.StatusBadRequest)
+		return
+	}
+
+	membership, err := h.app.Services.FindGroupMembership(clientID, groupID, current.ID)
+	if err != nil {
+		log.Printf("error: api.MarkGroupPostsRead() - unable to find the membership - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if membership == nil || !membership.IsAdminOrMember() {
+		log.Printf("error: api.MarkGroupPostsRead() - %s is not a member of group %s", current.Email, groupID)
+		http.Error(w, utils.NewForbiddenError().JSONErrorString(), http.StatusForbidden)
+		return
+	}
+
+	err = h.app.Services.MarkGroupPostsRead(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.MarkGroupPostsRead() - unable to mark the posts as read - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+}
+
+// GetUserGroupsUnreadCounts Gets the unread post counts of the user groups
+// @Description Gets the number of the unread top level posts within every group the current user is a member of. The posts created before the last mark as read (or before joining the group) and the posts of the user are not counted.
+// @ID GetUserGroupsUnreadCounts
+// @Tags Client
+// @Param APP header string true "APP"
+// @Success 200 {array} model.GroupUnreadCount
+// @Security AppUserAuth
+// @Router /api/user/groups/unread-counts [get]
+func (h *ApisHandler) GetUserGroupsUnreadCounts(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	counts, err := h.app.Services.GetUnreadCounts(clientID, current)
+	if err != nil {
+		log.Printf("error: api.GetUserGroupsUnreadCounts() - unable to get the unread counts - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(counts)
+	if err != nil {
+		log.Printf("error: api.GetUserGroupsUnreadCounts() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
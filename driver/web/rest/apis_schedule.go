@@ -0,0 +1,78 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// GetGroupSchedule Gets the timeline of upcoming scheduled posts and events for a group
+// @Description Gets the timeline of upcoming scheduled posts and events for a group. The occurrences of the recurring series are given with their series_id. The scheduled reminders of the upcoming events are given when the reminder task sends them, a day before the event, with the number of their recipients - the members going or opted in when the event_reminders group setting is on, the opted in members only otherwise. The reminders already sent are not given. Entries scheduled too close to each other are flagged as conflicting. Only group admins can see the schedule.
+// @ID GetGroupSchedule
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Success 200 {array} model.GroupScheduleItem
+// @Security AppUserAuth
+// @Router /api/group/{group-id}/schedule [get]
+func (h *ApisHandler) GetGroupSchedule(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("Group id is required")
+		http.Error(w, "Group id is required", http.StatusBadRequest)
+		return
+	}
+
+	group, err := h.app.Services.GetGroup(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupSchedule() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if group == nil {
+		log.Printf("error: api.GetGroupSchedule() - there is no a group for the provided id - %s", groupID)
+		http.Error(w, http.StatusText(http.StatusNotFound), http.StatusNotFound)
+		return
+	}
+	if group.CurrentMember == nil || !group.CurrentMember.IsAdmin() {
+		log.Printf("error: api.GetGroupSchedule() - %s is not allowed to see the schedule of group %s", current.Email, group.Title)
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		return
+	}
+
+	schedule, err := h.app.Services.GetGroupSchedule(clientID, current, groupID)
+	if err != nil {
+		log.Printf("error: api.GetGroupSchedule() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(schedule)
+	if err != nil {
+		log.Printf("error: api.GetGroupSchedule() - unable to marshal the schedule - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
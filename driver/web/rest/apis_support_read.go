@@ -0,0 +1,31 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"groups/core/model"
+	"log"
+)
+
+// loadSupportReadGroup gives the group if the current user has the support read permission. It is called by the read handlers
+// once the membership check fails. The access is recorded in the audit log of the group.
+func (h *ApisHandler) loadSupportReadGroup(clientID string, current *model.User, groupID string, resource string) (*model.Group, bool) {
+	group, err := h.app.Services.GetGroupForSupportRead(clientID, current, groupID, resource)
+	if err != nil {
+		log.Printf("error: api.loadSupportReadGroup() - %s", err.Error())
+		return nil, false
+	}
+	return group, group != nil
+}
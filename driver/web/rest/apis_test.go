@@ -0,0 +1,717 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"groups/core"
+	"groups/core/model"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/mux"
+)
+
+// run with -update to write the golden files from the current responses
+var updateGolden = flag.Bool("update", false, "update the golden files")
+
+const testClientID = "edu.illinois.rokwire"
+
+type handlerTestCase struct {
+	name    string
+	current *model.User
+	method  string // GET if empty
+	path    string
+	body    string
+	status  int
+	golden  string // the file within testdata, empty if the body is not checked
+	prepare func(services *fakeServices)
+	check   func(t *testing.T, services *fakeServices)
+}
+
+// newTestRouter registers the handlers under test on the routes of the web adapter. The current user of the case replaces the auth wrappers.
+func newTestRouter(services *fakeServices, current *model.User) *mux.Router {
+	app := &core.Application{Services: services}
+	apisHandler := NewApisHandler(app)
+	adminApisHandler := NewAdminApisHandler(app)
+	internalApisHandler := NewInternalApisHandler(app)
+
+	userFunc := func(handler func(string, *model.User, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			handler(testClientID, current, w, r)
+		}
+	}
+	internalFunc := func(handler func(string, http.ResponseWriter, *http.Request)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			handler(testClientID, w, r)
+		}
+	}
+
+	router := mux.NewRouter().StrictSlash(true)
+	restSubrouter := router.PathPrefix("/gr/api").Subrouter()
+	restSubrouter.HandleFunc("/int/user/{identifier}/groups", internalFunc(internalApisHandler.IntGetUserGroupMemberships)).Methods("GET")
+	restSubrouter.HandleFunc("/v4/groups", userFunc(apisHandler.GetGroupsV4)).Methods("GET", "POST")
+	restSubrouter.HandleFunc("/groups/{id}", userFunc(apisHandler.GetGroup)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/members", userFunc(apisHandler.GetGroupMembers)).Methods("GET")
+	restSubrouter.HandleFunc("/v4/group/{group-id}/members", userFunc(apisHandler.GetGroupMembersV4)).Methods("GET", "POST")
+	restSubrouter.HandleFunc("/group/{groupID}/posts", userFunc(apisHandler.GetGroupPosts)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/events", userFunc(apisHandler.GetGroupEvents)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{group-id}/schedule", userFunc(apisHandler.GetGroupSchedule)).Methods("GET")
+	restSubrouter.HandleFunc("/group/{id}", userFunc(apisHandler.DeleteGroup)).Methods("DELETE")
+	restSubrouter.HandleFunc("/notifications/unsubscribe", apisHandler.UnsubscribeConfirmation).Methods("GET")
+	restSubrouter.HandleFunc("/notifications/unsubscribe", apisHandler.Unsubscribe).Methods("POST")
+
+	adminSubrouter := restSubrouter.PathPrefix("/admin").Subrouter()
+	adminSubrouter.HandleFunc("/group/{id}", userFunc(adminApisHandler.DeleteGroup)).Methods("DELETE")
+	adminSubrouter.HandleFunc("/deleted-groups/{group-id}/restore", userFunc(adminApisHandler.RestoreGroup)).Methods("POST")
+	return router
+}
+
+func runHandlerTestCases(t *testing.T, cases []handlerTestCase) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			services := newFakeServices()
+			if tc.prepare != nil {
+				tc.prepare(services)
+			}
+
+			method := tc.method
+			if len(method) == 0 {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, tc.path, strings.NewReader(tc.body))
+			rec := httptest.NewRecorder()
+			newTestRouter(services, tc.current).ServeHTTP(rec, req)
+
+			if rec.Code != tc.status {
+				t.Fatalf("status = %d, want %d, body: %s", rec.Code, tc.status, rec.Body.String())
+			}
+			if len(tc.golden) > 0 {
+				assertGolden(t, tc.golden, rec.Body.Bytes())
+			}
+			if tc.check != nil {
+				tc.check(t, services)
+			}
+		})
+	}
+}
+
+// assertGolden compares the response body with the golden file. The JSON bodies are stored indented.
+func assertGolden(t *testing.T, name string, body []byte) {
+	t.Helper()
+
+	actual := body
+	var indented bytes.Buffer
+	if json.Indent(&indented, bytes.TrimSpace(body), "", "  ") == nil {
+		indented.WriteString("\n")
+		actual = indented.Bytes()
+	}
+
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatalf("error creating testdata - %s", err)
+		}
+		if err := os.WriteFile(path, actual, 0644); err != nil {
+			t.Fatalf("error writing %s - %s", path, err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("error reading %s, run the tests with -update to create it - %s", path, err)
+	}
+	if !bytes.Equal(expected, actual) {
+		t.Errorf("response does not match %s\ngot:\n%s\nwant:\n%s", path, actual, expected)
+	}
+}
+
+func TestIntGetUserGroupMemberships(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:   "groups of the member",
+			path:   "/gr/api/int/user/200/groups",
+			status: http.StatusOK,
+			golden: "int_user_groups.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastGroupsFilter == nil || services.lastGroupsFilter.MemberExternalID == nil || *services.lastGroupsFilter.MemberExternalID != "200" {
+					t.Errorf("groups are not filtered by the member external id - %+v", services.lastGroupsFilter)
+				}
+			},
+		},
+		{
+			name:   "no groups",
+			path:   "/gr/api/int/user/999/groups",
+			status: http.StatusOK,
+			golden: "int_user_groups_empty.golden",
+		},
+	})
+}
+
+func TestGetGroupsV4(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "offset page",
+			current: &fixtureMember,
+			method:  http.MethodPost,
+			path:    "/gr/api/v4/groups",
+			body:    `{"limit":1,"offset":1}`,
+			status:  http.StatusOK,
+			golden:  "groups_v4_offset.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastGroupsFilter == nil || services.lastGroupsFilter.ResearchGroup == nil || *services.lastGroupsFilter.ResearchGroup {
+					t.Errorf("the research groups are not excluded by default - %+v", services.lastGroupsFilter)
+				}
+			},
+		},
+		{
+			name:    "research groups",
+			current: &fixtureMember,
+			method:  http.MethodPost,
+			path:    "/gr/api/v4/groups",
+			body:    `{"research_group":true}`,
+			status:  http.StatusOK,
+			golden:  "groups_v4_research.golden",
+		},
+		{
+			name:    "invalid cursor",
+			current: &fixtureMember,
+			method:  http.MethodPost,
+			path:    "/gr/api/v4/groups",
+			body:    `{"limit":1,"cursor":"not a cursor"}`,
+			status:  http.StatusBadRequest,
+			golden:  "groups_v4_invalid_cursor.golden",
+		},
+	})
+}
+
+// TestGetGroupsV4CursorPaging follows the next_cursor of the responses until the last page
+func TestGetGroupsV4CursorPaging(t *testing.T) {
+	services := newFakeServices()
+	router := newTestRouter(services, &fixtureMember)
+
+	limit := int64(1)
+	cursor := ""
+	for page := 1; ; page++ {
+		body, _ := json.Marshal(model.GroupsFilter{Limit: &limit, Cursor: &cursor})
+		req := httptest.NewRequest(http.MethodPost, "/gr/api/v4/groups", bytes.NewReader(body))
+		rec := httptest.NewRecorder()
+		router.ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("page %d status = %d, want %d, body: %s", page, rec.Code, http.StatusOK, rec.Body.String())
+		}
+		assertGolden(t, fmt.Sprintf("groups_v4_cursor_page_%d.golden", page), rec.Body.Bytes())
+
+		var response struct {
+			NextCursor *string `json:"next_cursor"`
+		}
+		if err := json.Unmarshal(rec.Body.Bytes(), &response); err != nil {
+			t.Fatalf("error decoding page %d - %s", page, err)
+		}
+		if response.NextCursor == nil {
+			if page != 3 {
+				t.Errorf("pages = %d, want 3", page)
+			}
+			return
+		}
+		if page == 3 {
+			t.Fatalf("the last page gives the next cursor %s", *response.NextCursor)
+		}
+		cursor = *response.NextCursor
+	}
+}
+
+func TestGetGroup(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "member sees the members",
+			current: &fixtureMember,
+			path:    "/gr/api/groups/group-1",
+			status:  http.StatusOK,
+			golden:  "group_member.golden",
+		},
+		{
+			name:    "pending member sees only the own membership",
+			current: &fixturePending,
+			path:    "/gr/api/groups/group-1",
+			status:  http.StatusOK,
+			golden:  "group_pending.golden",
+		},
+		{
+			name:    "outsider does not see the members",
+			current: &fixtureOutsider,
+			path:    "/gr/api/groups/group-1",
+			status:  http.StatusOK,
+			golden:  "group_outsider.golden",
+		},
+		{
+			name:   "api key does not see the members",
+			path:   "/gr/api/groups/group-1",
+			status: http.StatusOK,
+			golden: "group_outsider.golden",
+		},
+		{
+			name:    "not found",
+			current: &fixtureMember,
+			path:    "/gr/api/groups/missing",
+			status:  http.StatusNotFound,
+			golden:  "not_found.golden",
+		},
+	})
+}
+
+func TestGetGroupMembers(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "all members",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/group-1/members",
+			status:  http.StatusOK,
+			golden:  "group_members.golden",
+		},
+		{
+			name:    "members by status",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/group-1/members",
+			body:    `{"statuses":["pending"]}`,
+			status:  http.StatusOK,
+			golden:  "group_members_pending.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastMembershipFilter == nil || len(services.lastMembershipFilter.GroupIDs) != 1 || services.lastMembershipFilter.GroupIDs[0] != "group-1" {
+					t.Errorf("members are not filtered by the group of the path - %+v", services.lastMembershipFilter)
+				}
+			},
+		},
+		{
+			name:    "unknown group",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/missing/members",
+			status:  http.StatusNotFound,
+			golden:  "group_not_found.golden",
+		},
+		{
+			name:    "invalid order",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/group-1/members",
+			body:    `{"order":"up"}`,
+			status:  http.StatusBadRequest,
+			golden:  "group_members_invalid_order.golden",
+		},
+		{
+			name:    "member does not see the admin only fields",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/members",
+			status:  http.StatusOK,
+			golden:  "group_members_member_view.golden",
+		},
+		{
+			name:    "pending member is forbidden",
+			current: &fixturePending,
+			path:    "/gr/api/group/group-1/members",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/members",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+		},
+		{
+			name:    "outsider does not get the members through the group ids",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-2/members",
+			body:    `{"group_ids":["group-1"]}`,
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+		},
+		{
+			name:    "member does not get the members of the other groups",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/members",
+			body:    `{"group_ids":["group-2"]}`,
+			status:  http.StatusOK,
+			golden:  "group_members_member_view.golden",
+		},
+		{
+			name:    "support reader sees the admin only fields",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/members",
+			status:  http.StatusOK,
+			golden:  "group_members.golden",
+			prepare: func(services *fakeServices) {
+				services.supportReaders[fixtureOutsider.ID] = true
+			},
+		},
+	})
+}
+
+func TestGetGroupMembersV4(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "admin page",
+			current: &fixtureAdmin,
+			path:    "/gr/api/v4/group/group-1/members",
+			body:    `{"limit":2,"offset":0}`,
+			status:  http.StatusOK,
+			golden:  "group_members_v4_admin.golden",
+		},
+		{
+			name:    "member searches by the name only",
+			current: &fixtureMember,
+			path:    "/gr/api/v4/group/group-1/members",
+			body:    `{"search":"example.com"}`,
+			status:  http.StatusOK,
+			golden:  "group_members_v4_member.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastMembershipFilter == nil || !services.lastMembershipFilter.SearchName {
+					t.Errorf("the member search is not limited to the name - %+v", services.lastMembershipFilter)
+				}
+			},
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			path:    "/gr/api/v4/group/group-1/members",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+		},
+		{
+			name:    "pending member is forbidden",
+			current: &fixturePending,
+			path:    "/gr/api/v4/group/group-1/members",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+		},
+		{
+			name:    "unknown group",
+			current: &fixtureMember,
+			path:    "/gr/api/v4/group/missing/members",
+			status:  http.StatusNotFound,
+			golden:  "group_not_found.golden",
+		},
+	})
+}
+
+func TestGetGroupPosts(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "member sees the private posts",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/posts",
+			status:  http.StatusOK,
+			golden:  "group_posts_member.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastPrivatePosts != nil {
+					t.Errorf("private posts filter = %t, want none", *services.lastPrivatePosts)
+				}
+				if services.lastToMembers == nil || !*services.lastToMembers {
+					t.Error("posts of a member are not filtered by the to members lists")
+				}
+			},
+		},
+		{
+			name:    "pending member is forbidden",
+			current: &fixturePending,
+			path:    "/gr/api/group/group-1/posts",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_posts.golden",
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/posts",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_posts.golden",
+		},
+		{
+			name:    "support reader sees all the posts",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/posts",
+			status:  http.StatusOK,
+			golden:  "group_posts_support.golden",
+			prepare: func(services *fakeServices) {
+				services.supportReaders[fixtureOutsider.ID] = true
+			},
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastToMembers == nil || *services.lastToMembers {
+					t.Error("posts of a support reader are filtered by the to members lists")
+				}
+			},
+		},
+		{
+			name:    "invalid type",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/posts?type=note",
+			status:  http.StatusBadRequest,
+			golden:  "group_posts_invalid_type.golden",
+		},
+		{
+			name:    "unknown group",
+			current: &fixtureMember,
+			path:    "/gr/api/group/missing/posts",
+			status:  http.StatusInternalServerError,
+			golden:  "internal_server_error.golden",
+		},
+	})
+}
+
+func TestGetGroupEvents(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "admin sees all the events",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/group-1/events",
+			status:  http.StatusOK,
+			golden:  "group_events_all.golden",
+			check: func(t *testing.T, services *fakeServices) {
+				if services.lastToMembers == nil || *services.lastToMembers {
+					t.Error("events of an admin are filtered by the to members lists")
+				}
+			},
+		},
+		{
+			name:    "member sees the events",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/events",
+			status:  http.StatusOK,
+			golden:  "group_events_all.golden",
+		},
+		{
+			name:    "support reader sees all the events",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/events",
+			status:  http.StatusOK,
+			golden:  "group_events_all.golden",
+			prepare: func(services *fakeServices) {
+				services.supportReaders[fixtureOutsider.ID] = true
+			},
+		},
+		{
+			name:    "pending member is forbidden",
+			current: &fixturePending,
+			path:    "/gr/api/group/group-1/events",
+			status:  http.StatusForbidden,
+			golden:  "forbidden.golden",
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/events",
+			status:  http.StatusForbidden,
+			golden:  "forbidden.golden",
+		},
+		{
+			name:   "api key is forbidden",
+			path:   "/gr/api/group/group-1/events",
+			status: http.StatusForbidden,
+			golden: "forbidden.golden",
+		},
+	})
+}
+
+func TestGetGroupSchedule(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "admin sees the schedule",
+			current: &fixtureAdmin,
+			path:    "/gr/api/group/group-1/schedule",
+			status:  http.StatusOK,
+			golden:  "group_schedule.golden",
+		},
+		{
+			name:    "member is forbidden",
+			current: &fixtureMember,
+			path:    "/gr/api/group/group-1/schedule",
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "pending member is forbidden",
+			current: &fixturePending,
+			path:    "/gr/api/group/group-1/schedule",
+			status:  http.StatusForbidden,
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			path:    "/gr/api/group/group-1/schedule",
+			status:  http.StatusForbidden,
+		},
+	})
+}
+
+func TestDeleteGroup(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "admin deletes the group",
+			current: &fixtureAdmin,
+			method:  http.MethodDelete,
+			path:    "/gr/api/group/group-1?cancel_events=true",
+			status:  http.StatusOK,
+			check: func(t *testing.T, services *fakeServices) {
+				if !services.deletedGroups["group-1"] {
+					t.Errorf("group-1 is not deleted")
+				}
+				if services.lastCancelEvents == nil || !*services.lastCancelEvents {
+					t.Errorf("cancel_events = %v, want true", services.lastCancelEvents)
+				}
+			},
+		},
+		{
+			name:    "member is forbidden",
+			current: &fixtureMember,
+			method:  http.MethodDelete,
+			path:    "/gr/api/group/group-1",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+			check:   assertNoGroupDeleted,
+		},
+		{
+			name:    "outsider is forbidden",
+			current: &fixtureOutsider,
+			method:  http.MethodDelete,
+			path:    "/gr/api/group/group-1",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+			check:   assertNoGroupDeleted,
+		},
+		{
+			name:    "admin without the permission is forbidden to delete a managed group",
+			current: &fixtureAdmin,
+			method:  http.MethodDelete,
+			path:    "/gr/api/group/group-1",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+			prepare: func(services *fakeServices) {
+				services.groups[0].AuthmanEnabled = true
+			},
+			check: assertNoGroupDeleted,
+		},
+		{
+			name:    "system admin without the permission is forbidden to delete a managed group",
+			current: &fixtureOutsider,
+			method:  http.MethodDelete,
+			path:    "/gr/api/admin/group/group-1",
+			status:  http.StatusForbidden,
+			golden:  "forbidden_members.golden",
+			prepare: func(services *fakeServices) {
+				services.groups[0].AuthmanEnabled = true
+			},
+			check: assertNoGroupDeleted,
+		},
+		{
+			name:    "system admin deletes the group",
+			current: &fixtureOutsider,
+			method:  http.MethodDelete,
+			path:    "/gr/api/admin/group/group-1",
+			status:  http.StatusOK,
+			check: func(t *testing.T, services *fakeServices) {
+				if !services.deletedGroups["group-1"] {
+					t.Errorf("group-1 is not deleted")
+				}
+			},
+		},
+		{
+			name:    "system admin gets not found for an unknown group",
+			current: &fixtureOutsider,
+			method:  http.MethodDelete,
+			path:    "/gr/api/admin/group/missing",
+			status:  http.StatusNotFound,
+			golden:  "group_not_found.golden",
+			check:   assertNoGroupDeleted,
+		},
+	})
+}
+
+func TestRestoreGroup(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:    "deleted group is restored",
+			current: &fixtureOutsider,
+			method:  http.MethodPost,
+			path:    "/gr/api/admin/deleted-groups/group-1/restore",
+			status:  http.StatusOK,
+			prepare: func(services *fakeServices) {
+				services.deletedGroups["group-1"] = true
+			},
+			check: assertNoGroupDeleted,
+		},
+		{
+			name:    "group which is not deleted is not found",
+			current: &fixtureOutsider,
+			method:  http.MethodPost,
+			path:    "/gr/api/admin/deleted-groups/group-1/restore",
+			status:  http.StatusNotFound,
+		},
+	})
+}
+
+func TestUnsubscribe(t *testing.T) {
+	runHandlerTestCases(t, []handlerTestCase{
+		{
+			name:   "opening the link gives the confirmation without unsubscribing",
+			path:   "/gr/api/notifications/unsubscribe?token=valid-token",
+			status: http.StatusOK,
+			golden: "unsubscribe_confirmation.golden",
+			check:  assertNotUnsubscribed,
+		},
+		{
+			name:   "opening the link without a token is a bad request",
+			path:   "/gr/api/notifications/unsubscribe",
+			status: http.StatusBadRequest,
+			check:  assertNotUnsubscribed,
+		},
+		{
+			name:   "one-click post unsubscribes",
+			method: http.MethodPost,
+			path:   "/gr/api/notifications/unsubscribe?token=valid-token",
+			status: http.StatusOK,
+			check: func(t *testing.T, services *fakeServices) {
+				if len(services.unsubscribedTokens) != 1 {
+					t.Errorf("unsubscribed tokens = %v, want [valid-token]", services.unsubscribedTokens)
+				}
+			},
+		},
+		{
+			name:   "post with an invalid token is a bad request",
+			method: http.MethodPost,
+			path:   "/gr/api/notifications/unsubscribe?token=expired-token",
+			status: http.StatusBadRequest,
+			check:  assertNotUnsubscribed,
+		},
+	})
+}
+
+func assertNotUnsubscribed(t *testing.T, services *fakeServices) {
+	if len(services.unsubscribedTokens) > 0 {
+		t.Errorf("unsubscribed tokens = %v, want none", services.unsubscribedTokens)
+	}
+}
+
+func assertNoGroupDeleted(t *testing.T, services *fakeServices) {
+	if len(services.deletedGroups) > 0 {
+		t.Errorf("deleted groups = %v, want none", services.deletedGroups)
+	}
+}
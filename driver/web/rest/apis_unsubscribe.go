@@ -0,0 +1,106 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"html/template"
+	"log"
+	"net/http"
+)
+
+var unsubscribeConfirmationTemplate = template.Must(template.New("unsubscribe").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><meta name="viewport" content="width=device-width, initial-scale=1"><title>Unsubscribe</title></head>
+<body>
+<p>Do you want to stop receiving these group notifications?</p>
+<form method="post">
+<input type="hidden" name="token" value="{{.}}">
+<button type="submit">Unsubscribe</button>
+</form>
+</body>
+</html>
+`))
+
+// UnsubscribeConfirmation Gives the unsubscribe confirmation page
+// @Description Gives the confirmation page of an unsubscribe link. The page does not change the notification preferences, they are changed once the user submits its form, so the mail scanners and the link prefetchers opening the link do not unsubscribe the user.
+// @ID UnsubscribeConfirmation
+// @Tags Client
+// @Param token query string true "Signed unsubscribe token"
+// @Produce html
+// @Success 200 {string} string "The confirmation page"
+// @Failure 400 {string} string "Bad request - the token is missing"
+// @Router /api/notifications/unsubscribe [get]
+func (h ApisHandler) UnsubscribeConfirmation(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if len(token) == 0 {
+		log.Println("error: api.UnsubscribeConfirmation() - missing token")
+		http.Error(w, utils.NewMissingParamError("token is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(http.StatusOK)
+	err := unsubscribeConfirmationTemplate.Execute(w, token)
+	if err != nil {
+		log.Printf("error: api.UnsubscribeConfirmation() - unable to render the page - %s", err.Error())
+	}
+}
+
+// Unsubscribe Mutes notifications with a signed unsubscribe token
+// @Description Mutes the notifications of the scope of a signed unsubscribe token without login. The token is given in the unsubscribe_token data of the announcement notifications, as a query or a form parameter, so it supports the one-click unsubscribe of the mail clients (RFC 8058). The change is recorded in the audit log of every affected group.
+// @ID Unsubscribe
+// @Tags Client
+// @Param token query string true "Signed unsubscribe token"
+// @Success 200 {object} model.UnsubscribeResult
+// @Failure 400 {string} string "Bad request - the token is not valid or has expired"
+// @Router /api/notifications/unsubscribe [post]
+func (h ApisHandler) Unsubscribe(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	if len(token) == 0 {
+		token = r.PostFormValue("token")
+	}
+	if len(token) == 0 {
+		log.Println("error: api.Unsubscribe() - missing token")
+		http.Error(w, utils.NewMissingParamError("token is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var result *model.UnsubscribeResult
+	result, err := h.app.Services.Unsubscribe(token)
+	if err != nil {
+		log.Printf("error: api.Unsubscribe() - %s", err.Error())
+		http.Error(w, utils.NewServerError().JSONErrorString(), http.StatusInternalServerError)
+		return
+	}
+	if result == nil {
+		http.Error(w, "the unsubscribe link is not valid or has expired", http.StatusBadRequest)
+		return
+	}
+
+	data, err := json.Marshal(result)
+	if err != nil {
+		log.Printf("error: api.Unsubscribe() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,172 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	"github.com/gorilla/mux"
+)
+
+type getUserNotificationsResponse struct {
+	Items       []model.UserNotification `json:"items"`
+	UnreadCount int64                    `json:"unread_count"`
+} // @name getUserNotificationsResponse
+
+// GetUserNotifications Gets the group related notifications inbox of the current user
+// @Description Gets the group related notifications inbox of the current user ordered by date (newest first)
+// @ID GetUserNotifications
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param read query string false "Filter by read state. Values: true|false"
+// @Param offset query integer false "offset"
+// @Param limit query integer false "limit"
+// @Success 200 {object} getUserNotificationsResponse
+// @Security AppUserAuth
+// @Router /api/user/notifications [get]
+func (h *ApisHandler) GetUserNotifications(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	var filter model.UserNotificationsFilter
+
+	readQuery, ok := r.URL.Query()["read"]
+	if ok && len(readQuery[0]) > 0 {
+		val, err := strconv.ParseBool(readQuery[0])
+		if err != nil {
+			log.Println("the 'read' query param can be 'true', 'false', or missing")
+			http.Error(w, "the 'read' query param can be 'true', 'false', or missing", http.StatusBadRequest)
+			return
+		}
+		filter.Read = &val
+	}
+
+	offsets, ok := r.URL.Query()["offset"]
+	if ok && len(offsets[0]) > 0 {
+		val, err := strconv.ParseInt(offsets[0], 0, 64)
+		if err == nil {
+			filter.Offset = &val
+		}
+	}
+
+	limits, ok := r.URL.Query()["limit"]
+	if ok && len(limits[0]) > 0 {
+		val, err := strconv.ParseInt(limits[0], 0, 64)
+		if err == nil {
+			filter.Limit = &val
+		}
+	}
+
+	items, unreadCount, err := h.app.Services.GetUserNotifications(current, filter)
+	if err != nil {
+		log.Printf("error: api.GetUserNotifications() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	data, err := json.Marshal(getUserNotificationsResponse{Items: items, UnreadCount: unreadCount})
+	if err != nil {
+		log.Printf("error: api.GetUserNotifications() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+type markUserNotificationsReadRequest struct {
+	IDs  []string `json:"ids"`  // empty list means all notifications of the user
+	Read *bool    `json:"read"` // default true
+} // @name markUserNotificationsReadRequest
+
+// MarkUserNotificationsRead Updates the read state of multiple notifications of the current user
+// @Description Updates the read state of multiple notifications of the current user. Empty or missing ids list marks all notifications.
+// @ID MarkUserNotificationsRead
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body markUserNotificationsReadRequest true "body data"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/user/notifications/read [put]
+func (h *ApisHandler) MarkUserNotificationsRead(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: api.MarkUserNotificationsRead() - unable to read the body - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var requestData markUserNotificationsReadRequest
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &requestData)
+		if err != nil {
+			log.Printf("error: api.MarkUserNotificationsRead() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	read := true
+	if requestData.Read != nil {
+		read = *requestData.Read
+	}
+
+	_, err = h.app.Services.MarkUserNotificationsRead(current, requestData.IDs, read)
+	if err != nil {
+		log.Printf("error: api.MarkUserNotificationsRead() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
+
+// MarkUserNotificationRead Marks a single notification of the current user as read
+// @Description Marks a single notification of the current user as read
+// @ID MarkUserNotificationRead
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param id path string true "Notification ID"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/user/notifications/{id}/read [put]
+func (h *ApisHandler) MarkUserNotificationRead(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	id := params["id"]
+	if len(id) <= 0 {
+		log.Println("id is required")
+		http.Error(w, "id is required", http.StatusBadRequest)
+		return
+	}
+
+	count, err := h.app.Services.MarkUserNotificationsRead(current, []string{id}, true)
+	if err != nil {
+		log.Printf("error: api.MarkUserNotificationRead() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if count == 0 {
+		log.Printf("api.MarkUserNotificationRead() - notification %s is not found or already read", id)
+	}
+
+	w.Header().Set("Content-Type", "text/plain")
+	w.WriteHeader(http.StatusOK)
+}
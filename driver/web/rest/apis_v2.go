@@ -39,6 +39,7 @@ import (
 // @Param limit query string false "Deprecated - instead use request body filter! limit - limit the result"
 // @Param include_hidden query string false "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false."
 // @Param data body model.GroupsFilter true "body data"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {array} model.Group
 // @Security AppUserAuth
 // @Router /api/v2/groups [get]
@@ -121,6 +122,11 @@ func (h *ApisHandler) GetGroupsV2(clientID string, current *model.User, w http.R
 		groups = []model.Group{}
 	}
 
+	locales := getLocalesQueryParam(r)
+	for i := range groups {
+		groups[i].Localize(locales)
+	}
+
 	data, err := json.Marshal(groups)
 	if err != nil {
 		log.Println("apis.GetGroupsV2() error on marshal the groups items")
@@ -128,6 +134,7 @@ func (h *ApisHandler) GetGroupsV2(clientID string, current *model.User, w http.R
 		return
 	}
 
+	setLicenseHeaders(h.app, clientID, w)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
@@ -146,6 +153,7 @@ func (h *ApisHandler) GetGroupsV2(clientID string, current *model.User, w http.R
 // @Param limit query string false "Deprecated - instead use request body filter! limit - limit the result"
 // @Param include_hidden query string false "Deprecated - instead use request body filter! include_hidden - Includes hidden groups if a search by title is performed. Possible value is true. Default false."
 // @Param data body model.GroupsFilter true "body data"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {array} model.Group
 // @Security AppUserAuth
 // @Security APIKeyAuth
@@ -220,6 +228,11 @@ func (h *ApisHandler) GetUserGroupsV2(clientID string, current *model.User, w ht
 		groups = []model.Group{}
 	}
 
+	locales := getLocalesQueryParam(r)
+	for i := range groups {
+		groups[i].Localize(locales)
+	}
+
 	data, err := json.Marshal(groups)
 	if err != nil {
 		log.Println("apis.GetUserGroupsV2() error on marshal the user groups items")
@@ -239,6 +252,7 @@ func (h *ApisHandler) GetUserGroupsV2(clientID string, current *model.User, w ht
 // @Accept json
 // @Param APP header string true "APP"
 // @Param id path string true "ID"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es. The title and the description of the best matching locale are given. Falls back to the language of a locale and then to the primary ones."
 // @Success 200 {object} model.Group
 // @Security AppUserAuth
 // @Router /api/v2/groups/{id} [get]
@@ -251,7 +265,7 @@ func (h *ApisHandler) GetGroupV2(clientID string, current *model.User, w http.Re
 		return
 	}
 
-	group, err := h.app.Services.GetGroup(clientID, current, id)
+	group, err := h.app.Services.GetGroupDetails(clientID, current, id)
 	if err != nil {
 		log.Printf("apis.GetGroupV2() error on getting group %s - %s", id, err)
 		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
@@ -264,6 +278,8 @@ func (h *ApisHandler) GetGroupV2(clientID string, current *model.User, w http.Re
 		return
 	}
 
+	group.Localize(getLocalesQueryParam(r))
+
 	data, err := json.Marshal(group)
 	if err != nil {
 		log.Println("apis.GetGroupV2() error on marshal the group")
@@ -271,6 +287,7 @@ func (h *ApisHandler) GetGroupV2(clientID string, current *model.User, w http.Re
 		return
 	}
 
+	setLicenseHeaders(h.app, clientID, w)
 	w.Header().Set("Content-Type", "application/json; charset=utf-8")
 	w.WriteHeader(http.StatusOK)
 	w.Write(data)
@@ -0,0 +1,240 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+)
+
+// listResponse is the envelope of the v4 list APIs. The total is the count of all the items matching the filter, so the clients
+// could page through them with the limit and the offset, or with the next cursor where the list supports it.
+type listResponse struct {
+	Items      interface{} `json:"items"`
+	Total      int64       `json:"total"`
+	Limit      *int64      `json:"limit"`
+	Offset     *int64      `json:"offset"`
+	NextCursor *string     `json:"next_cursor,omitempty"` // nil when the cursor pagination is not used or the page is the last one
+} // @name listResponse
+
+func writeListResponse(w http.ResponseWriter, response listResponse) {
+	data, err := json.Marshal(response)
+	if err != nil {
+		log.Printf("error on marshal the list response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
+
+// GetGroupsV4 gets the groups page with the total count
+// @Description Gives the groups matching the filter with the total count and the paging of the response. The filter is the same as of the v2 API. Pass an empty cursor with the limit to page by the creation date with the cursor instead of the offset, the next pages are loaded with the next_cursor of the response and stay stable while groups are being created or renamed.
+// @ID GetGroupsV4
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body model.GroupsFilter false "body data"
+// @Param locale query string false "Comma separated locales ordered by preference, e.g. es-MX,es"
+// @Success 200 {object} listResponse{items=[]model.Group}
+// @Security AppUserAuth
+// @Router /api/v4/groups [post]
+func (h *ApisHandler) GetGroupsV4(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	requestData, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("apis.GetGroupsV4() error reading the request body - %s\n", err.Error())
+		http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+		return
+	}
+
+	var groupsFilter model.GroupsFilter
+	if len(requestData) > 0 {
+		err = json.Unmarshal(requestData, &groupsFilter)
+		if err != nil {
+			log.Printf("apis.GetGroupsV4() error on unmarshal model.GroupsFilter request body - %s\n", err.Error())
+			http.Error(w, http.StatusText(http.StatusBadRequest), http.StatusBadRequest)
+			return
+		}
+	}
+	if groupsFilter.ResearchGroup == nil {
+		b := false
+		groupsFilter.ResearchGroup = &b
+	}
+	if groupsFilter.Cursor != nil && len(*groupsFilter.Cursor) > 0 {
+		_, err = model.ParseGroupsCursor(*groupsFilter.Cursor)
+		if err != nil {
+			log.Printf("apis.GetGroupsV4() - %s", err.Error())
+			http.Error(w, utils.NewBadRequestError(err.Error()).JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	groups, err := h.app.Services.GetGroups(clientID, current, groupsFilter)
+	if err != nil {
+		log.Printf("apis.GetGroupsV4() error getting groups - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+	total, err := h.app.Services.CountGroups(clientID, current, groupsFilter)
+	if err != nil {
+		log.Printf("apis.GetGroupsV4() error counting groups - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+
+	if groups == nil {
+		groups = []model.Group{}
+	}
+	locales := getLocalesQueryParam(r)
+	for i := range groups {
+		groups[i].Localize(locales)
+	}
+
+	setLicenseHeaders(h.app, clientID, w)
+	response := listResponse{Items: groups, Total: total, Limit: groupsFilter.Limit, Offset: groupsFilter.Offset}
+	if groupsFilter.Cursor != nil {
+		response.Offset = nil
+		response.NextCursor = model.NextGroupsCursor(groups, groupsFilter.Limit)
+	}
+	writeListResponse(w, response)
+}
+
+// GetGroupMembersV4 gets the group members page with the total count
+// @Description Gives the group members matching the filter with the total count and the paging of the response. The members could be sorted by name, join date (date_created) or status. The light filter omits the member answers and the notification preferences for the member lists. The search filter finds the members by a case-insensitive part of the name. Gives 403 if the current user is not an admin or a member of the group. The member information is given as allowed by the group settings, the member answers, the notification preferences and the rejection reasons of the others are given to the group admins only.
+// @ID GetGroupMembersV4
+// @Tags Client
+// @Accept json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.MembershipFilter false "body data"
+// @Success 200 {object} listResponse{items=[]model.GroupMembership}
+// @Security AppUserAuth
+// @Router /api/v4/group/{group-id}/members [post]
+func (h *ApisHandler) GetGroupMembersV4(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	filter, ok := readGroupMembersFilter(w, r)
+	if !ok {
+		return
+	}
+
+	group, fullView, ok := h.loadGroupMembersAccess(clientID, current, filter.GroupIDs[0], w)
+	if !ok {
+		return
+	}
+
+	members, err := h.app.Services.FindGroupMemberships(clientID, *filter)
+	if err != nil {
+		log.Printf("api.GetGroupMembersV4 error: %s", err)
+		writeError(w, err)
+		return
+	}
+	total, err := h.app.Services.CountGroupMemberships(clientID, *filter)
+	if err != nil {
+		log.Printf("api.GetGroupMembersV4 error counting the members: %s", err)
+		writeError(w, err)
+		return
+	}
+
+	if !fullView {
+		members.ApplyMemberView(group.Settings, current.ID)
+	}
+	if members.Items == nil {
+		members.Items = []model.GroupMembership{}
+	}
+	writeListResponse(w, listResponse{Items: members.Items, Total: total, Limit: filter.Limit, Offset: filter.Offset})
+}
+
+// GetGroupPostsV4 gets the group posts page with the total count
+// @Description Gives the group posts with the total count of the top level posts and the paging of the response. The replies are given within their top level posts and are not counted.
+// @ID GetGroupPostsV4
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param groupID path string true "Group ID"
+// @Param type query string false "Values: message|post"
+// @Param offset query string false "offset"
+// @Param limit query integer false "limit"
+// @Param order query string false "asc|desc"
+// @Success 200 {object} listResponse{items=[]model.Post}
+// @Security AppUserAuth
+// @Router /api/v4/group/{groupID}/posts [get]
+func (h *ApisHandler) GetGroupPostsV4(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	request, ok := h.loadGroupPostsRequest(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	posts, err := h.app.Services.GetPosts(clientID, current, request.filter, request.filterPrivatePostsValue, request.filterByToMembers)
+	if err != nil {
+		log.Printf("error getting posts for group (%s) - %s", request.filter.GroupID, err.Error())
+		writeError(w, err)
+		return
+	}
+	total, err := h.app.Services.CountPosts(clientID, current, request.filter, request.filterPrivatePostsValue, request.filterByToMembers)
+	if err != nil {
+		log.Printf("error counting posts for group (%s) - %s", request.filter.GroupID, err.Error())
+		writeError(w, err)
+		return
+	}
+
+	for i := range posts {
+		posts[i].SummarizeReactions(current.ID)
+	}
+	writeListResponse(w, listResponse{Items: posts, Total: total, Limit: request.filter.Limit, Offset: request.filter.Offset})
+}
+
+// GetGroupEventsV4 gets the group events page with the total count
+// @Description Gives the group events with the total count and the paging of the response. The featured events are first.
+// @ID GetGroupEventsV4
+// @Tags Client
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param start_date query string false "Only events starting at or after the date (RFC3339)"
+// @Param end_date query string false "Only events starting at or before the date (RFC3339)"
+// @Param upcoming_only query bool false "Only events which have not ended yet"
+// @Param offset query int false "Offset"
+// @Param limit query int false "Limit"
+// @Success 200 {object} listResponse{items=[]model.Event}
+// @Security AppUserAuth
+// @Router /api/v4/group/{group-id}/events [get]
+func (h *ApisHandler) GetGroupEventsV4(clientID string, current *model.User, w http.ResponseWriter, r *http.Request) {
+	request, ok := h.loadGroupEventsRequest(clientID, current, w, r)
+	if !ok {
+		return
+	}
+
+	events, err := h.app.Services.GetEvents(clientID, current, request.groupID, request.filterByToMembers, request.filter)
+	if err != nil {
+		log.Printf("error getting group events - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+	total, err := h.app.Services.CountEvents(clientID, current, request.groupID, request.filterByToMembers, request.filter)
+	if err != nil {
+		log.Printf("error counting group events - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+
+	if events == nil {
+		events = []model.Event{}
+	}
+	request.hideToMembers(events)
+	writeListResponse(w, listResponse{Items: events, Total: total, Limit: request.filter.Limit, Offset: request.filter.Offset})
+}
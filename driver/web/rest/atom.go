@@ -0,0 +1,99 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/xml"
+	"groups/core/model"
+	"time"
+)
+
+const atomNamespace = "http://www.w3.org/2005/Atom"
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"feed"`
+	Xmlns    string      `xml:"xmlns,attr"`
+	ID       string      `xml:"id"`
+	Title    atomText    `xml:"title"`
+	Subtitle *atomText   `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Author   atomAuthor  `xml:"author"`
+	Link     atomLink    `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID        string    `xml:"id"`
+	Title     atomText  `xml:"title"`
+	Published string    `xml:"published"`
+	Updated   string    `xml:"updated"`
+	Content   atomText  `xml:"content"`
+	Link      *atomLink `xml:"link,omitempty"`
+}
+
+// marshalAtomFeed renders the public feed of the group as an Atom (RFC 4287) document. The post content is given as plain text, so the
+// websites embedding the feed do not render any markup of the posts.
+func marshalAtomFeed(feed model.GroupPublicFeed, selfURL string) ([]byte, error) {
+	document := atomFeed{
+		Xmlns:   atomNamespace,
+		ID:      "urn:uuid:" + feed.GroupID,
+		Title:   atomText{Type: "text", Value: feed.Title},
+		Updated: formatAtomTime(feed.Updated),
+		// the posts are published on behalf of the group, the creators stay private
+		Author:  atomAuthor{Name: feed.Title},
+		Link:    atomLink{Rel: "self", Type: "application/atom+xml", Href: selfURL},
+		Entries: make([]atomEntry, len(feed.Entries)),
+	}
+	if len(feed.Description) > 0 {
+		document.Subtitle = &atomText{Type: "text", Value: feed.Description}
+	}
+	for i, entry := range feed.Entries {
+		document.Entries[i] = atomEntry{
+			ID:        "urn:uuid:" + entry.ID,
+			Title:     atomText{Type: "text", Value: entry.Title},
+			Published: formatAtomTime(entry.Published),
+			Updated:   formatAtomTime(entry.Updated),
+			Content:   atomText{Type: "text", Value: entry.Content},
+		}
+		if len(entry.ImageURL) > 0 {
+			document.Entries[i].Link = &atomLink{Rel: "enclosure", Href: entry.ImageURL}
+		}
+	}
+
+	data, err := xml.MarshalIndent(document, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+func formatAtomTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
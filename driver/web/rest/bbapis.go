@@ -5,12 +5,14 @@ import (
 	"errors"
 	"groups/core"
 	"groups/core/model"
+	"io"
 	"net/http"
 	"strings"
 
 	"github.com/gorilla/mux"
 	"github.com/rokwire/logging-library-go/v2/logs"
 	"github.com/rokwire/logging-library-go/v2/logutils"
+	"gopkg.in/go-playground/validator.v9"
 )
 
 // BBSApisHandler handles the rest BBS APIs implementation
@@ -112,7 +114,7 @@ func (h *BBSApisHandler) GetGroupMembershipsByGroupID(log *logs.Log, req *http.R
 // @Description  Gets all related eventID and groupID using eventIDs
 // @ID GetGroupsEvents
 // @Tags BBS
-// @Param comma separated eventIDs query
+// @Param events-ids query string false "Comma separated event IDs"
 // @Success 200 {array} []model.GetGroupsEvents
 // @Security AppUserAuth
 // @Router /api/bbs/groups/events [get]
@@ -139,7 +141,7 @@ func (h *BBSApisHandler) GetGroupsEvents(log *logs.Log, req *http.Request, user
 // @Description  Gets all related groups by groupIDs
 // @ID GetGroupsbyGroupsIDs
 // @Tags BBS
-// @Param comma separated groupIDs query
+// @Param group-ids query string true "Comma separated group IDs"
 // @Success 200 {array} []model.Group
 // @Security AppUserAuth
 // @Router /api/bbs/groups [get]
@@ -164,3 +166,91 @@ func (h *BBSApisHandler) GetGroupsByGroupIDs(log *logs.Log, req *http.Request, u
 	return log.HTTPResponseSuccessJSON(data)
 
 }
+
+// MergeAccounts Moves the data of a merged account to the surviving account
+// @Description Moves the memberships, posts, reactions, event to_members, RSVPs and attendances, bans, read cursors, post bookmarks and notification read receipts of the old account within the client of the APP header to the surviving account. When both accounts are members of the same group, the membership with the higher status is kept. The move is done within a transaction.
+// @ID BBSMergeAccounts
+// @Tags BBS
+// @Accept json
+// @Param APP header string true "APP"
+// @Param data body model.AccountMerge true "body data"
+// @Success 200 {object} model.AccountMergeResult
+// @Security AppUserAuth
+// @Router /api/bbs/accounts/merge [post]
+func (h *BBSApisHandler) MergeAccounts(log *logs.Log, req *http.Request, user *model.User) logs.HTTPResponse {
+	if len(user.ClientID) == 0 {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeHeader, nil, errors.New("unsupported APP"), http.StatusBadRequest, false)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionRead, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	var merge model.AccountMerge
+	err = json.Unmarshal(data, &merge)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUnmarshal, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	err = validator.New().Struct(merge)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	result, err := h.app.Services.MergeAccounts(user.ClientID, merge)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUpdate, logutils.TypeError, nil, err, http.StatusInternalServerError, false)
+	}
+
+	data, err = json.Marshal(result)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionMarshal, logutils.TypeError, nil, err, http.StatusInternalServerError, false)
+	}
+
+	return log.HTTPResponseSuccessJSON(data)
+}
+
+// getUsersGroupMembershipsRequest request
+type getUsersGroupMembershipsRequest struct {
+	UserIDs []string `json:"user_ids" validate:"required,min=1,max=500"`
+} // @name getUsersGroupMembershipsRequest
+
+// GetUsersGroupMemberships Gets the group memberships of multiple users
+// @Description Gets the group memberships status, group title and admin flag of the users in one call. The result follows the order of the user IDs and contains the users without memberships too. The memberships of the deleted groups are skipped. At most 500 users could be looked up at once.
+// @ID BBSGetUsersGroupMemberships
+// @Tags BBS
+// @Accept json
+// @Param data body getUsersGroupMembershipsRequest true "body data"
+// @Success 200 {array} model.UserGroupMemberships
+// @Security AppUserAuth
+// @Router /api/bbs/memberships [post]
+func (h *BBSApisHandler) GetUsersGroupMemberships(log *logs.Log, req *http.Request, user *model.User) logs.HTTPResponse {
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionRead, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	var request getUsersGroupMembershipsRequest
+	err = json.Unmarshal(data, &request)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUnmarshal, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	err = validator.New().Struct(request)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	memberships, err := h.app.Services.GetUsersGroupMemberships(request.UserIDs)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionGet, logutils.TypeError, nil, err, http.StatusInternalServerError, false)
+	}
+
+	data, err = json.Marshal(memberships)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionMarshal, logutils.TypeError, nil, err, http.StatusInternalServerError, false)
+	}
+
+	return log.HTTPResponseSuccessJSON(data)
+}
@@ -0,0 +1,315 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"errors"
+	"groups/core/model"
+	"io"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"github.com/rokwire/logging-library-go/v2/logs"
+	"github.com/rokwire/logging-library-go/v2/logutils"
+	"gopkg.in/go-playground/validator.v9"
+)
+
+const (
+	typeGroup           logutils.MessageDataType = "group"
+	typeGroupMembership logutils.MessageDataType = "group membership"
+)
+
+// CreateServiceGroup Creates a group on behalf of a service account
+// @Description Creates a group on behalf of the service account of another building block. The token must have the groups:write scope. The service account is recorded as the creator but does not become a member, so the initial admins must be provided with the members config.
+// @ID BBSCreateGroup
+// @Tags BBS
+// @Accept json
+// @Param APP header string false "APP"
+// @Param data body adminCreateGroupRequest true "body data"
+// @Success 200 {object} createResponse
+// @Security AppUserAuth
+// @Router /api/bbs/groups [post]
+func (h *BBSApisHandler) CreateServiceGroup(log *logs.Log, req *http.Request, user *model.User) logs.HTTPResponse {
+	if len(user.ClientID) == 0 {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeHeader, nil, errors.New("unsupported APP"), http.StatusBadRequest, false)
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionRead, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	var requestData adminCreateGroupRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUnmarshal, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	err = validator.New().Struct(requestData)
+	if err == nil && requestData.Location != nil {
+		err = requestData.Location.Validate()
+	}
+	if err == nil && requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+	}
+	if err == nil {
+		err = requestData.Localizations.Validate()
+	}
+	if err == nil {
+		err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	}
+	if err == nil {
+		err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	}
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	if requestData.AuthmanEnabled && !user.HasPermission("managed_group_admin") {
+		return log.HTTPResponseErrorAction(logutils.ActionCreate, typeGroup, nil, errors.New("only managed_group_admin could create a managed group"), http.StatusForbidden, false)
+	}
+	if requestData.ResearchGroup && !user.HasPermission("research_group_admin") {
+		return log.HTTPResponseErrorAction(logutils.ActionCreate, typeGroup, nil, errors.New("only research_group_admin could create a research group"), http.StatusForbidden, false)
+	}
+
+	insertedID, groupErr := h.app.Services.CreateGroup(user.ClientID, user, &model.Group{
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
+		ResearchGroup:             requestData.ResearchGroup,
+		ResearchOpen:              requestData.ResearchOpen,
+		ResearchConsentStatement:  requestData.ResearchConsentStatement,
+		ResearchConsentDetails:    requestData.ResearchConsentDetails,
+		ResearchDescription:       requestData.ResearchDescription,
+		ResearchProfile:           requestData.ResearchProfile,
+		Settings:                  requestData.Settings,
+		Attributes:                requestData.Attributes,
+		Location:                  requestData.Location,
+		Localizations:             requestData.Localizations,
+	}, requestData.MembersConfig)
+	if groupErr != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionCreate, typeGroup, nil, groupErr, groupErr.HTTPStatus(), false)
+	}
+
+	var response createResponse
+	if insertedID != nil {
+		response.InsertedID = *insertedID
+	}
+	data, err = json.Marshal(response)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionMarshal, logutils.TypeError, nil, err, http.StatusInternalServerError, false)
+	}
+
+	return log.HTTPResponseSuccessJSON(data)
+}
+
+// UpdateServiceGroup Updates a group created by the service account
+// @Description Updates a group created by the service account of the token. The token must have the groups:write scope. The groups created by other accounts could not be updated.
+// @ID BBSUpdateGroup
+// @Tags BBS
+// @Accept json
+// @Param APP header string false "APP"
+// @Param group_id path string true "Group ID"
+// @Param data body updateGroupRequest true "body data"
+// @Success 200
+// @Security AppUserAuth
+// @Router /api/bbs/groups/{group_id} [put]
+func (h *BBSApisHandler) UpdateServiceGroup(log *logs.Log, req *http.Request, user *model.User) logs.HTTPResponse {
+	group, response := h.loadServiceGroup(log, req, user)
+	if response != nil {
+		return *response
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionRead, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	var requestData updateGroupRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUnmarshal, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	err = validator.New().Struct(requestData)
+	if err == nil && requestData.Location != nil {
+		err = requestData.Location.Validate()
+	}
+	if err == nil && requestData.Settings != nil {
+		err = requestData.Settings.Validate()
+	}
+	if err == nil {
+		err = requestData.Localizations.Validate()
+	}
+	if err == nil {
+		err = model.ValidateAuthmanGroupKeys(requestData.AuthmanGroups)
+	}
+	if err == nil {
+		err = model.ValidateMembershipQuestions(requestData.MembershipQuestionsSchema)
+	}
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	if (requestData.AuthmanEnabled || group.AuthmanEnabled) && !user.HasPermission("managed_group_admin") {
+		return log.HTTPResponseErrorAction(logutils.ActionUpdate, typeGroup, nil, errors.New("only managed_group_admin could update a managed group"), http.StatusForbidden, false)
+	}
+	if (requestData.ResearchGroup || group.ResearchGroup) && !user.HasPermission("research_group_admin") {
+		return log.HTTPResponseErrorAction(logutils.ActionUpdate, typeGroup, nil, errors.New("only research_group_admin could update a research group"), http.StatusForbidden, false)
+	}
+
+	groupErr := h.app.Services.UpdateGroup(user.ClientID, user, &model.Group{
+		ID:                        group.ID,
+		Title:                     requestData.Title,
+		Description:               requestData.Description,
+		Category:                  requestData.Category,
+		Categories:                requestData.Categories,
+		Tags:                      requestData.Tags,
+		Privacy:                   requestData.Privacy,
+		HiddenForSearch:           requestData.Hidden,
+		ImageURL:                  requestData.ImageURL,
+		WebURL:                    requestData.WebURL,
+		MembershipQuestions:       requestData.MembershipQuestions,
+		MembershipQuestionsSchema: requestData.MembershipQuestionsSchema,
+		AuthmanGroup:              requestData.AuthmanGroup,
+		AuthmanGroups:             requestData.AuthmanGroups,
+		AuthmanEnabled:            requestData.AuthmanEnabled,
+		OnlyAdminsCanCreatePolls:  requestData.OnlyAdminsCanCreatePolls,
+		CanJoinAutomatically:      requestData.CanJoinAutomatically,
+		AttendanceGroup:           requestData.AttendanceGroup,
+		ResearchGroup:             requestData.ResearchGroup,
+		ResearchOpen:              requestData.ResearchOpen,
+		ResearchConsentStatement:  requestData.ResearchConsentStatement,
+		ResearchConsentDetails:    requestData.ResearchConsentDetails,
+		ResearchDescription:       requestData.ResearchDescription,
+		ResearchProfile:           requestData.ResearchProfile,
+		Settings:                  requestData.Settings,
+		Attributes:                requestData.Attributes,
+		Location:                  requestData.Location,
+		Localizations:             requestData.Localizations,
+	})
+	if groupErr != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUpdate, typeGroup, nil, groupErr, groupErr.HTTPStatus(), false)
+	}
+
+	return log.HTTPResponseSuccess()
+}
+
+// CreateServiceGroupMember Adds a member to a group created by the service account
+// @Description Adds a member to a group created by the service account of the token. The token must have the memberships:write scope. The status is pending when not provided.
+// @ID BBSCreateMember
+// @Tags BBS
+// @Accept json
+// @Param APP header string false "APP"
+// @Param group_id path string true "Group ID"
+// @Param data body createMemberRequest true "body data"
+// @Success 200
+// @Failure 403 {string} string "Forbidden - the user is banned from the group"
+// @Security AppUserAuth
+// @Router /api/bbs/groups/{group_id}/members [post]
+func (h *BBSApisHandler) CreateServiceGroupMember(log *logs.Log, req *http.Request, user *model.User) logs.HTTPResponse {
+	group, response := h.loadServiceGroup(log, req, user)
+	if response != nil {
+		return *response
+	}
+
+	data, err := io.ReadAll(req.Body)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionRead, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	var requestData createMemberRequest
+	err = json.Unmarshal(data, &requestData)
+	if err != nil {
+		return log.HTTPResponseErrorAction(logutils.ActionUnmarshal, logutils.TypeRequestBody, nil, err, http.StatusBadRequest, false)
+	}
+
+	if len(requestData.UserID) == 0 && len(requestData.ExternalID) == 0 {
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil, errors.New("expected user_id or external_id"), http.StatusBadRequest, false)
+	}
+	switch requestData.Status {
+	case "":
+		requestData.Status = "pending"
+	case "member", "admin", "rejected", "pending":
+	default:
+		return log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeRequestBody, nil,
+			errors.New("expected status with possible value (member, admin, rejected, pending)"), http.StatusBadRequest, false)
+	}
+
+	member := model.GroupMembership{
+		GroupID:      group.ID,
+		UserID:       requestData.UserID,
+		ExternalID:   requestData.ExternalID,
+		Email:        requestData.Email,
+		Name:         requestData.Name,
+		NetID:        requestData.NetID,
+		PhotoURL:     requestData.PhotoURL,
+		Status:       requestData.Status,
+		DateAttended: requestData.DateAttended,
+	}
+
+	err = h.app.Services.CreateMembership(user.ClientID, user, group, &member)
+	if err != nil {
+		groupErr := coreError(err)
+		return log.HTTPResponseErrorAction(logutils.ActionCreate, typeGroupMembership, nil, err, groupErr.HTTPStatus(), false)
+	}
+
+	return log.HTTPResponseSuccess()
+}
+
+// loadServiceGroup loads the group of the path. Only the groups created by the service account of the token could be managed.
+func (h *BBSApisHandler) loadServiceGroup(log *logs.Log, req *http.Request, user *model.User) (*model.Group, *logs.HTTPResponse) {
+	if len(user.ClientID) == 0 {
+		response := log.HTTPResponseErrorAction(logutils.ActionValidate, logutils.TypeHeader, nil, errors.New("unsupported APP"), http.StatusBadRequest, false)
+		return nil, &response
+	}
+
+	groupID := mux.Vars(req)["group_id"]
+	if len(groupID) == 0 {
+		response := log.HTTPResponseErrorAction(logutils.ActionGet, logutils.TypePathParam, nil, errors.New("missing group_id"), http.StatusBadRequest, false)
+		return nil, &response
+	}
+
+	group, err := h.app.Services.GetGroupEntity(user.ClientID, groupID)
+	if err != nil {
+		response := log.HTTPResponseErrorAction(logutils.ActionFind, typeGroup, nil, err, http.StatusInternalServerError, false)
+		return nil, &response
+	}
+	if group == nil {
+		response := log.HTTPResponseErrorAction(logutils.ActionFind, typeGroup, nil, errors.New("group not found"), http.StatusNotFound, false)
+		return nil, &response
+	}
+	if group.CreatorID != user.ID {
+		response := log.HTTPResponseErrorAction(logutils.ActionValidate, typeGroup, nil, errors.New("the group was not created by the service account"), http.StatusForbidden, false)
+		return nil, &response
+	}
+
+	return group, nil
+}
@@ -15,6 +15,11 @@
 package rest
 
 import (
+	"errors"
+	"fmt"
+	"groups/core"
+	"groups/core/model"
+	"log"
 	"net/http"
 	"strconv"
 )
@@ -38,3 +43,110 @@ func getInt64QueryParam(r *http.Request, paramName string) *int64 {
 	}
 	return nil
 }
+
+// getLocalesQueryParam reads the locale negotiation param - a comma separated list of locales ordered by preference
+func getLocalesQueryParam(r *http.Request) []string {
+	locale := getStringQueryParam(r, "locale")
+	if locale == nil {
+		return nil
+	}
+	return model.ParseLocales(*locale)
+}
+
+// setLicenseHeaders attaches the licensing metadata of the client to the exports and the public feed responses
+func setLicenseHeaders(app *core.Application, clientID string, w http.ResponseWriter) {
+	config, err := app.Services.GetLicenseConfig(clientID)
+	if err != nil {
+		// the response must still be served
+		log.Printf("error getting the license config for %s - %s", clientID, err.Error())
+		return
+	}
+	if config.IsEmpty() {
+		return
+	}
+
+	if len(config.LicenseURL) > 0 {
+		w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"license\"", config.LicenseURL))
+	}
+	if len(config.TermsURL) > 0 {
+		w.Header().Add("Link", fmt.Sprintf("<%s>; rel=\"terms-of-service\"", config.TermsURL))
+	}
+	if len(config.License) > 0 {
+		w.Header().Set("X-Content-License", config.License)
+	}
+	if len(config.Attribution) > 0 {
+		w.Header().Set("X-Content-Attribution", config.Attribution)
+	}
+}
+
+// writePostLimitError responds with the structured error when the post exceeds the limits of the client. It returns false for the other errors.
+func writePostLimitError(w http.ResponseWriter, err error) bool {
+	var limitErr *model.PostLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+
+	log.Printf("post exceeds the limits - %s", err.Error())
+	writeError(w, err)
+	return true
+}
+
+// writeContentRateLimitError responds with 429 and the Retry-After header when the user creates the content too often. It returns false for the other errors.
+func writeContentRateLimitError(w http.ResponseWriter, err error) bool {
+	var limitErr *model.ContentRateLimitError
+	if !errors.As(err, &limitErr) {
+		return false
+	}
+
+	log.Printf("content rate limit exceeded - %s", err.Error())
+	writeError(w, err)
+	return true
+}
+
+// writePostingMutedError responds with 403 when the member is muted by an admin. It returns false for the other errors.
+func writePostingMutedError(w http.ResponseWriter, err error) bool {
+	var mutedErr *model.PostingMutedError
+	if !errors.As(err, &mutedErr) {
+		return false
+	}
+
+	log.Printf("member is muted - %s", err.Error())
+	writeError(w, err)
+	return true
+}
+
+// writeContentFilterError responds with the structured error when the post is rejected by the content filter of the client. It returns false for the other errors.
+func writeContentFilterError(w http.ResponseWriter, err error) bool {
+	var filterErr *model.ContentFilterError
+	if !errors.As(err, &filterErr) {
+		return false
+	}
+
+	log.Printf("post rejected by the content filter - %s", err.Error())
+	writeError(w, err)
+	return true
+}
+
+// writeLastAdminError responds with 409 when the membership change would leave the group without an admin. It returns false for the other errors.
+func writeLastAdminError(w http.ResponseWriter, err error) bool {
+	var adminErr *model.LastAdminError
+	if !errors.As(err, &adminErr) {
+		return false
+	}
+
+	log.Printf("last group admin - %s", err.Error())
+	writeError(w, err)
+	return true
+}
+
+// writeGroupBanError responds with 403 when the user is banned from the group. It returns false for the other errors.
+func writeGroupBanError(w http.ResponseWriter, err error) bool {
+	var banErr *model.GroupBanError
+	if !errors.As(err, &banErr) {
+		return false
+	}
+
+	log.Printf("banned user - %s", err.Error())
+	writeError(w, err)
+	return true
+}
@@ -0,0 +1,100 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"groups/core/model"
+	"groups/utils"
+	"log"
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// coreError maps the errors of the core services to the error model of the APIs. The unknown errors are the server errors, their
+// details are only logged.
+func coreError(err error) *utils.GroupError {
+	var groupErr *utils.GroupError
+	var postLimitErr *model.PostLimitError
+	var contentFilterErr *model.ContentFilterError
+	var contentRateLimitErr *model.ContentRateLimitError
+	var reactionLimitErr *model.ReactionLimitError
+	var lastAdminErr *model.LastAdminError
+	var banErr *model.GroupBanError
+	var mutedErr *model.PostingMutedError
+	var answersErr *model.MemberAnswersError
+	var reasonErr *model.RejectReasonError
+	var pendingErr *model.PendingMembershipError
+	var orgUnitErr *model.OrgUnitNotFoundError
+	var notAllowedErr *model.EventServiceNotAllowedError
+	var eventNotFoundErr *model.CalendarEventNotFoundError
+	var mappingExistsErr *model.EventMappingExistsError
+
+	switch {
+	case errors.As(err, &groupErr):
+		return groupErr
+	case errors.As(err, &postLimitErr):
+		return utils.NewPostLimitError(postLimitErr.Field, postLimitErr.Limit)
+	case errors.As(err, &contentFilterErr):
+		return utils.NewContentFilterError(contentFilterErr.Flags)
+	case errors.As(err, &contentRateLimitErr), errors.As(err, &reactionLimitErr):
+		return utils.NewTooManyRequestsError(err.Error())
+	case errors.As(err, &lastAdminErr):
+		return utils.NewLastAdminError()
+	case errors.As(err, &banErr):
+		return utils.NewBannedError()
+	case errors.As(err, &mutedErr):
+		return utils.NewPostingMutedError(mutedErr.MutedUntil)
+	case errors.As(err, &answersErr), errors.As(err, &reasonErr), errors.As(err, &pendingErr), errors.As(err, &orgUnitErr):
+		return utils.NewValidationError(err)
+	case errors.As(err, &notAllowedErr):
+		return utils.NewForbiddenError()
+	case errors.As(err, &eventNotFoundErr):
+		return utils.NewEventNotFoundError(eventNotFoundErr.EventID)
+	case errors.As(err, &mappingExistsErr):
+		mapping := mappingExistsErr.Mapping
+		return utils.NewEventMappingExistsError(mapping.EventID, mapping.GroupID, mapping.DateCreated)
+	}
+	return utils.NewServerError()
+}
+
+// retryAfter gives the period the user should wait after a rate limit error
+func retryAfter(err error) (time.Duration, bool) {
+	var contentRateLimitErr *model.ContentRateLimitError
+	if errors.As(err, &contentRateLimitErr) {
+		return contentRateLimitErr.RetryAfter, true
+	}
+	var reactionLimitErr *model.ReactionLimitError
+	if errors.As(err, &reactionLimitErr) {
+		return reactionLimitErr.RetryAfter, true
+	}
+	return 0, false
+}
+
+// writeError responds with the error of the core services mapped to the error model of the APIs
+func writeError(w http.ResponseWriter, err error) {
+	groupErr := coreError(err)
+	if period, ok := retryAfter(err); ok {
+		w.Header().Set("Retry-After", strconv.FormatInt(int64(math.Ceil(period.Seconds())), 10))
+	}
+
+	status := groupErr.HTTPStatus()
+	if status >= http.StatusInternalServerError {
+		log.Printf("server error - %s", err.Error())
+	}
+	http.Error(w, groupErr.JSONErrorString(), status)
+}
@@ -0,0 +1,329 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"errors"
+	"groups/core"
+	"groups/core/model"
+	"sort"
+	"time"
+)
+
+var (
+	fixtureDate = time.Date(2024, time.January, 2, 15, 4, 5, 0, time.UTC)
+
+	fixtureAdmin    = model.User{ID: "user-admin", AppID: "app-1", OrgID: "org-1", Email: "admin@example.com", Name: "Group Admin"}
+	fixtureMember   = model.User{ID: "user-member", AppID: "app-1", OrgID: "org-1", Email: "member@example.com", Name: "Group Member"}
+	fixturePending  = model.User{ID: "user-pending", AppID: "app-1", OrgID: "org-1", Email: "pending@example.com", Name: "Pending Member"}
+	fixtureOutsider = model.User{ID: "user-outsider", AppID: "app-1", OrgID: "org-1", Email: "outsider@example.com", Name: "Outsider"}
+)
+
+// fakeServices is the core layer of the handler tests. It serves the fixture group from memory.
+// The services which are not overridden panic as the embedded interface is nil.
+type fakeServices struct {
+	core.Services
+
+	groups      []model.Group
+	memberships []model.GroupMembership
+	posts       []model.Post
+	events      []model.Event
+
+	supportReaders map[string]bool // user id -> allowed to read the groups for support
+	deletedGroups  map[string]bool // group id -> deleted and still within the restore period
+
+	// the arguments of the last calls
+	lastGroupsFilter     *model.GroupsFilter
+	lastMembershipFilter *model.MembershipFilter
+	lastPostsFilter      *model.PostsFilter
+	lastPrivatePosts     *bool
+	lastToMembers        *bool
+	lastCancelEvents     *bool
+	unsubscribedTokens   []string
+}
+
+func newFakeServices() *fakeServices {
+	description := "The fixture group"
+	return &fakeServices{
+		groups: []model.Group{
+			{ID: "group-1", ClientID: "edu.illinois.rokwire", Category: "Academic", Title: "Chess Club", Privacy: "private",
+				Description: &description, Tags: []string{"chess"}, DateCreated: fixtureDate},
+			{ID: "group-2", ClientID: "edu.illinois.rokwire", Category: "Social", Title: "Research Study", Privacy: "public",
+				ResearchGroup: true, ResearchOpen: true, DateCreated: fixtureDate},
+			{ID: "group-3", ClientID: "edu.illinois.rokwire", Category: "Social", Title: "Book Club", Privacy: "public",
+				DateCreated: fixtureDate.Add(time.Hour)},
+		},
+		memberships: []model.GroupMembership{
+			{ID: "membership-1", ClientID: "edu.illinois.rokwire", GroupID: "group-1", UserID: fixtureAdmin.ID, ExternalID: "100",
+				Name: fixtureAdmin.Name, Email: fixtureAdmin.Email, Status: "admin", DateCreated: fixtureDate,
+				NotificationsPreferences: model.NotificationsPreferences{OverridePreferences: true, AllMute: true}, MutedUntil: &fixtureDate},
+			{ID: "membership-2", ClientID: "edu.illinois.rokwire", GroupID: "group-1", UserID: fixtureMember.ID, ExternalID: "200",
+				Name: fixtureMember.Name, Email: fixtureMember.Email, Status: "member", DateCreated: fixtureDate},
+			{ID: "membership-3", ClientID: "edu.illinois.rokwire", GroupID: "group-1", UserID: fixturePending.ID, ExternalID: "300",
+				Name: fixturePending.Name, Email: fixturePending.Email, Status: "pending", DateCreated: fixtureDate},
+			{ID: "membership-4", ClientID: "edu.illinois.rokwire", GroupID: "group-2", UserID: fixtureMember.ID, ExternalID: "200",
+				Name: fixtureMember.Name, Email: fixtureMember.Email, Status: "pending", DateCreated: fixtureDate},
+		},
+		posts: []model.Post{
+			{ID: "post-1", ClientID: "edu.illinois.rokwire", GroupID: "group-1",
+				Creator: model.Creator{UserID: fixtureAdmin.ID, Name: fixtureAdmin.Name, Email: fixtureAdmin.Email},
+				Subject: "Welcome", Body: "Welcome to the club", DateCreated: fixtureDate,
+				Reactions: map[string][]string{"thumbs-up": {fixtureAdmin.ID, fixtureMember.ID}}},
+			{ID: "post-2", ClientID: "edu.illinois.rokwire", GroupID: "group-1",
+				Creator: model.Creator{UserID: fixtureMember.ID, Name: fixtureMember.Name, Email: fixtureMember.Email},
+				Subject: "Members only", Body: "The next meeting", Private: true, DateCreated: fixtureDate},
+		},
+		events: []model.Event{
+			{ClientID: "edu.illinois.rokwire", EventID: "event-1", GroupID: "group-1", DateCreated: fixtureDate},
+			{ClientID: "edu.illinois.rokwire", EventID: "event-2", GroupID: "group-1", DateCreated: fixtureDate,
+				ToMembersList: []model.ToMember{{UserID: fixtureMember.ID}}},
+		},
+		supportReaders: map[string]bool{},
+		deletedGroups:  map[string]bool{},
+	}
+}
+
+func (s *fakeServices) findGroup(id string) *model.Group {
+	for _, group := range s.groups {
+		if group.ID == id {
+			return &group
+		}
+	}
+	return nil
+}
+
+func (s *fakeServices) findMembership(groupID string, userID string) *model.GroupMembership {
+	for _, membership := range s.memberships {
+		if membership.GroupID == groupID && membership.UserID == userID {
+			return &membership
+		}
+	}
+	return nil
+}
+
+// groupFor gives the group with the current member of the user, nil if there is no such group
+func (s *fakeServices) groupFor(current *model.User, id string) *model.Group {
+	group := s.findGroup(id)
+	if group != nil && current != nil {
+		group.CurrentMember = s.findMembership(id, current.ID)
+	}
+	return group
+}
+
+func (s *fakeServices) GetGroupEntity(clientID string, id string) (*model.Group, error) {
+	return s.findGroup(id), nil
+}
+
+func (s *fakeServices) GetGroup(clientID string, current *model.User, id string) (*model.Group, error) {
+	group := s.groupFor(current, id)
+	if group == nil {
+		return nil, errors.New("group not found")
+	}
+	return group, nil
+}
+
+func (s *fakeServices) GetGroupDetails(clientID string, current *model.User, id string) (*model.Group, error) {
+	return s.GetGroup(clientID, current, id)
+}
+
+func (s *fakeServices) CheckUserGroupMembershipPermission(clientID string, current *model.User, groupID string) (*model.Group, bool) {
+	group := s.groupFor(current, groupID)
+	if group == nil || group.CurrentMember == nil {
+		return group, false
+	}
+	return group, group.CurrentMember.IsAdminOrMember()
+}
+
+func (s *fakeServices) GetGroupForSupportRead(clientID string, current *model.User, groupID string, resource string) (*model.Group, error) {
+	if current == nil || !s.supportReaders[current.ID] {
+		return nil, nil
+	}
+	return s.findGroup(groupID), nil
+}
+
+func (s *fakeServices) FindGroupsV3(clientID string, filter model.GroupsFilter) ([]model.Group, error) {
+	s.lastGroupsFilter = &filter
+
+	var list []model.Group
+	for _, membership := range s.memberships {
+		if filter.MemberExternalID != nil && membership.ExternalID != *filter.MemberExternalID {
+			continue
+		}
+		group := s.findGroup(membership.GroupID)
+		if group == nil {
+			continue
+		}
+		current := membership
+		group.CurrentMember = &current
+		list = append(list, *group)
+	}
+	return list, nil
+}
+
+// GetGroups gives the groups matching the research filter ordered by the creation date and the id, as the cursor pagination does
+func (s *fakeServices) GetGroups(clientID string, current *model.User, filter model.GroupsFilter) ([]model.Group, error) {
+	s.lastGroupsFilter = &filter
+
+	list := s.filterGroups(current, filter)
+	if filter.Cursor != nil && len(*filter.Cursor) > 0 {
+		cursor, err := model.ParseGroupsCursor(*filter.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		for len(list) > 0 && !list[0].DateCreated.After(cursor.DateCreated) && (list[0].DateCreated.Before(cursor.DateCreated) || list[0].ID <= cursor.ID) {
+			list = list[1:]
+		}
+	} else if filter.Offset != nil {
+		list = list[min(int(*filter.Offset), len(list)):]
+	}
+	if filter.Limit != nil {
+		list = list[:min(int(*filter.Limit), len(list))]
+	}
+	return list, nil
+}
+
+func (s *fakeServices) CountGroups(clientID string, current *model.User, filter model.GroupsFilter) (int64, error) {
+	return int64(len(s.filterGroups(current, filter))), nil
+}
+
+func (s *fakeServices) filterGroups(current *model.User, filter model.GroupsFilter) []model.Group {
+	var list []model.Group
+	for _, group := range s.groups {
+		if filter.ResearchGroup != nil && group.ResearchGroup != *filter.ResearchGroup {
+			continue
+		}
+		list = append(list, *s.groupFor(current, group.ID))
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if !list[i].DateCreated.Equal(list[j].DateCreated) {
+			return list[i].DateCreated.Before(list[j].DateCreated)
+		}
+		return list[i].ID < list[j].ID
+	})
+	return list
+}
+
+func (s *fakeServices) GetLicenseConfig(clientID string) (*model.LicenseConfig, error) {
+	return &model.LicenseConfig{}, nil
+}
+
+func (s *fakeServices) FindGroupMemberships(clientID string, filter model.MembershipFilter) (model.MembershipCollection, error) {
+	s.lastMembershipFilter = &filter
+
+	var items []model.GroupMembership
+	for _, membership := range s.memberships {
+		if !containsString(filter.GroupIDs, membership.GroupID) {
+			continue
+		}
+		if len(filter.Statuses) > 0 && !containsString(filter.Statuses, membership.Status) {
+			continue
+		}
+		items = append(items, membership)
+	}
+	if filter.Offset != nil {
+		items = items[min(int(*filter.Offset), len(items)):]
+	}
+	if filter.Limit != nil {
+		items = items[:min(int(*filter.Limit), len(items))]
+	}
+	return model.MembershipCollection{Items: items}, nil
+}
+
+func (s *fakeServices) CountGroupMemberships(clientID string, filter model.MembershipFilter) (int64, error) {
+	filter.Offset = nil
+	filter.Limit = nil
+	collection, err := s.FindGroupMemberships(clientID, filter)
+	return int64(len(collection.Items)), err
+}
+
+func (s *fakeServices) FindGroupMembership(clientID string, groupID string, userID string) (*model.GroupMembership, error) {
+	return s.findMembership(groupID, userID), nil
+}
+
+func (s *fakeServices) GetPosts(clientID string, current *model.User, filter model.PostsFilter, filterPrivatePostsValue *bool, filterByToMembers bool) ([]model.Post, error) {
+	s.lastPostsFilter = &filter
+	s.lastPrivatePosts = filterPrivatePostsValue
+	s.lastToMembers = &filterByToMembers
+
+	var list []model.Post
+	for _, post := range s.posts {
+		if post.GroupID != filter.GroupID {
+			continue
+		}
+		if filterPrivatePostsValue != nil && post.Private != *filterPrivatePostsValue {
+			continue
+		}
+		list = append(list, post)
+	}
+	return list, nil
+}
+
+func (s *fakeServices) GetEvents(clientID string, current *model.User, groupID string, filterByToMembers bool, filter *model.EventsFilter) ([]model.Event, error) {
+	s.lastToMembers = &filterByToMembers
+
+	var list []model.Event
+	for _, event := range s.events {
+		if event.GroupID != groupID {
+			continue
+		}
+		if filterByToMembers && event.HasToMembersList() && !event.HasToMemberUser(&current.ID, nil) {
+			continue
+		}
+		list = append(list, event)
+	}
+	return list, nil
+}
+
+func (s *fakeServices) GetGroupSchedule(clientID string, current *model.User, groupID string) (model.GroupSchedule, error) {
+	seriesID := "series-1"
+	reminderDate := fixtureDate.Add(24 * time.Hour)
+	eventDate := reminderDate.Add(24 * time.Hour)
+	return model.GroupSchedule{
+		{Type: model.ScheduleItemTypePost, ID: "post-3", Title: "Announcement", Date: fixtureDate},
+		{Type: model.ScheduleItemTypeEventReminder, ID: "event-3", Title: "Weekly game", Date: reminderDate, SeriesID: &seriesID, ReminderRecipients: 2},
+		{Type: model.ScheduleItemTypeEvent, ID: "event-3", Title: "Weekly game", Date: eventDate, SeriesID: &seriesID},
+	}, nil
+}
+
+func (s *fakeServices) DeleteGroup(clientID string, current *model.User, id string, cancelEvents bool) error {
+	s.lastCancelEvents = &cancelEvents
+	s.deletedGroups[id] = true
+	return nil
+}
+
+func (s *fakeServices) RestoreGroup(clientID string, current *model.User, groupID string) (bool, error) {
+	if !s.deletedGroups[groupID] {
+		return false, nil
+	}
+	delete(s.deletedGroups, groupID)
+	return true, nil
+}
+
+func (s *fakeServices) Unsubscribe(token string) (*model.UnsubscribeResult, error) {
+	if token != "valid-token" {
+		return nil, nil
+	}
+	s.unsubscribedTokens = append(s.unsubscribedTokens, token)
+	return &model.UnsubscribeResult{Scope: model.UnsubscribeScopePosts, GroupIDs: []string{"group-1"}}, nil
+}
+
+func containsString(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"groups/core/model"
+	"strings"
+	"time"
+)
+
+// icalLineLimit is the maximum length of a content line in octets (RFC 5545 3.1)
+const icalLineLimit = 75
+
+const (
+	icalDateTimeFormat = "20060102T150405Z"
+	icalDateFormat     = "20060102"
+)
+
+var icalTextEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`, "\r", `\n`)
+
+// marshalICalendar renders the calendar feed as an iCalendar (RFC 5545) document
+func marshalICalendar(feed model.CalendarFeed, host string) []byte {
+	var buffer bytes.Buffer
+	now := time.Now().UTC().Format(icalDateTimeFormat)
+
+	writeICalLine(&buffer, "BEGIN:VCALENDAR")
+	writeICalLine(&buffer, "VERSION:2.0")
+	writeICalLine(&buffer, "PRODID:-//Rokwire//Groups Building Block//EN")
+	writeICalLine(&buffer, "CALSCALE:GREGORIAN")
+	writeICalLine(&buffer, "METHOD:PUBLISH")
+	writeICalLine(&buffer, "X-WR-CALNAME:"+escapeICalText(feed.Title))
+	for _, event := range feed.Events {
+		writeICalLine(&buffer, "BEGIN:VEVENT")
+		writeICalLine(&buffer, fmt.Sprintf("UID:%s@%s", event.ID, host))
+		writeICalLine(&buffer, "DTSTAMP:"+now)
+		if event.AllDay {
+			writeICalLine(&buffer, "DTSTART;VALUE=DATE:"+event.Start.UTC().Format(icalDateFormat))
+			end := event.Start.UTC().AddDate(0, 0, 1)
+			if event.End != nil && event.End.After(end) {
+				end = *event.End
+			}
+			writeICalLine(&buffer, "DTEND;VALUE=DATE:"+end.UTC().Format(icalDateFormat))
+		} else {
+			writeICalLine(&buffer, "DTSTART:"+event.Start.UTC().Format(icalDateTimeFormat))
+			if event.End != nil && event.End.After(event.Start) {
+				writeICalLine(&buffer, "DTEND:"+event.End.UTC().Format(icalDateTimeFormat))
+			}
+		}
+		writeICalLine(&buffer, "SUMMARY:"+escapeICalText(event.Title))
+		if len(event.Description) > 0 {
+			writeICalLine(&buffer, "DESCRIPTION:"+escapeICalText(event.Description))
+		}
+		if len(event.Location) > 0 {
+			writeICalLine(&buffer, "LOCATION:"+escapeICalText(event.Location))
+		}
+		if len(event.URL) > 0 {
+			writeICalLine(&buffer, "URL:"+event.URL)
+		}
+		writeICalLine(&buffer, "END:VEVENT")
+	}
+	writeICalLine(&buffer, "END:VCALENDAR")
+	return buffer.Bytes()
+}
+
+func escapeICalText(value string) string {
+	return icalTextEscaper.Replace(value)
+}
+
+// writeICalLine writes the content line folded at the line limit. The continuation lines start with a space, the multi-byte characters are not split.
+func writeICalLine(buffer *bytes.Buffer, line string) {
+	limit := icalLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !isUTF8Boundary(line, cut) {
+			cut--
+		}
+		buffer.WriteString(line[:cut])
+		buffer.WriteString("\r\n ")
+		line = line[cut:]
+		// the leading space of the continuation line counts to the limit
+		limit = icalLineLimit - 1
+	}
+	buffer.WriteString(line)
+	buffer.WriteString("\r\n")
+}
+
+func isUTF8Boundary(value string, index int) bool {
+	return index >= len(value) || value[index]&0xC0 != 0x80
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"groups/core"
 	"groups/core/model"
+	"groups/utils"
 	"io"
 	"log"
 	"net/http"
@@ -333,15 +334,19 @@ func (h *InternalApisHandler) UpdateGroupDateUpdated(clientID string, w http.Res
 }
 
 // CreateGroupEvent creates a group event
-// @Description Creates a group event
+// @Description Links an existing calendar event to the group. If the internal event services config of the client is set, the service must be allowed to link the events to the group by its categories or attributes. The event must exist in the Calendar BB. Linking an already linked event gives 409 with the existing mapping in the error details.
 // @ID IntCreateGroupEvent
 // @Tags Internal
 // @Accept json
 // @Produce json
 // @Param APP header string true "APP"
+// @Param service query string false "The name of the service which invokes the API"
 // @Param data body intCreateGroupEventRequestBody true "body data"
 // @Param group-id path string true "Group ID"
-// @Success 200
+// @Success 200 {object} model.Event
+// @Failure 403 {string} string "Forbidden - the service is not allowed to link events to the group"
+// @Failure 404 {string} string "Not found - the group or the calendar event does not exist"
+// @Failure 409 {string} string "Conflict - the event is already linked to the group"
 // @Security IntAPIKeyAuth
 // @Router /api/int/group/{group-id}/events [post]
 func (h *InternalApisHandler) CreateGroupEvent(clientID string, w http.ResponseWriter, r *http.Request) {
@@ -354,6 +359,8 @@ func (h *InternalApisHandler) CreateGroupEvent(clientID string, w http.ResponseW
 		return
 	}
 
+	service := r.URL.Query().Get("service")
+
 	data, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.Printf("Error on marshal the create group event - %s\n", err.Error())
@@ -386,15 +393,14 @@ func (h *InternalApisHandler) CreateGroupEvent(clientID string, w http.ResponseW
 	}
 	if group == nil {
 		log.Printf("there is no a group for the provided group id - %s", groupID)
-		//do not say to much to the user as we do not know if he/she is an admin for the group yet
-		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
 		return
 	}
 
-	grEvent, err := h.app.Services.CreateEvent(clientID, nil, requestData.EventID, group, requestData.ToMembersList, requestData.Creator)
+	grEvent, err := h.app.Services.CreateInternalGroupEvent(clientID, service, requestData.EventID, group, requestData.ToMembersList, requestData.Creator)
 	if err != nil {
 		log.Printf("Error on creating an event - %s\n", err)
-		http.Error(w, fmt.Sprintf("Error on creating an event - %s\n", err), http.StatusInternalServerError)
+		writeError(w, err)
 		return
 	}
 
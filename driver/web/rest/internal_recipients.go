@@ -0,0 +1,96 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"groups/utils"
+	"io"
+	"log"
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// ResolveGroupRecipients Resolves the audience of a group into the notification recipients
+// @Description Resolves the audience of a group into the de-duplicated notification recipients, so the fan-out logic is not duplicated in the other services. The audience is all the members and admins (default), the admins only, the members who attended the group since a date or the listed members. The recipients who muted the notification category in their preferences or muted the group for the operation are flagged as muted, or omitted with exclude_muted.
+// @ID IntResolveGroupRecipients
+// @Tags Internal
+// @Accept json
+// @Produce json
+// @Param APP header string true "APP"
+// @Param group-id path string true "Group ID"
+// @Param data body model.RecipientAudience true "body data"
+// @Success 200 {array} notifications.Recipient
+// @Failure 400 {string} string "Bad request - invalid audience"
+// @Failure 404 {string} string "Not found - the group does not exist"
+// @Security IntAPIKeyAuth
+// @Router /api/int/group/{group-id}/recipients [post]
+func (h *InternalApisHandler) ResolveGroupRecipients(clientID string, w http.ResponseWriter, r *http.Request) {
+	params := mux.Vars(r)
+	groupID := params["group-id"]
+	if len(groupID) <= 0 {
+		log.Println("error: internal.ResolveGroupRecipients() - group-id is required")
+		http.Error(w, utils.NewMissingParamError("group-id is required").JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	data, err := io.ReadAll(r.Body)
+	if err != nil {
+		log.Printf("error: internal.ResolveGroupRecipients() - unable to read the body - %s", err.Error())
+		http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	var audience model.RecipientAudience
+	if len(data) > 0 {
+		err = json.Unmarshal(data, &audience)
+		if err != nil {
+			log.Printf("error: internal.ResolveGroupRecipients() - unable to unmarshal the body - %s", err.Error())
+			http.Error(w, utils.NewBadJSONError().JSONErrorString(), http.StatusBadRequest)
+			return
+		}
+	}
+	err = audience.Validate()
+	if err != nil {
+		log.Printf("error: internal.ResolveGroupRecipients() - %s", err.Error())
+		http.Error(w, utils.NewBadRequestError(err.Error()).JSONErrorString(), http.StatusBadRequest)
+		return
+	}
+
+	recipients, err := h.app.Services.ResolveGroupRecipients(clientID, groupID, audience)
+	if err != nil {
+		log.Printf("error: internal.ResolveGroupRecipients() - %s", err.Error())
+		writeError(w, err)
+		return
+	}
+	if recipients == nil {
+		log.Printf("error: internal.ResolveGroupRecipients() - missing group %s", groupID)
+		http.Error(w, utils.NewNotFoundError().JSONErrorString(), http.StatusNotFound)
+		return
+	}
+
+	data, err = json.Marshal(recipients)
+	if err != nil {
+		log.Printf("error: internal.ResolveGroupRecipients() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,54 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rest
+
+import (
+	"encoding/json"
+	"groups/core/model"
+	"log"
+	"net/http"
+)
+
+// GetUsageRecords Gets the metered usage of the client
+// @Description Gets the monthly usage records of the client for the billing and the reporting services ordered by period. The record of the current month is updated daily and the record of a past month is final. The counts of the groups, the members and the posts and the storage footprint are snapshots, the created posts and the notification sends are counted within the month.
+// @ID IntGetUsageRecords
+// @Tags Internal
+// @Param from query string false "The first period (inclusive) in the format 2006-01"
+// @Param to query string false "The last period (inclusive) in the format 2006-01"
+// @Success 200 {array} model.UsageRecord
+// @Security IntAPIKeyAuth
+// @Router /int/usage [get]
+func (h *InternalApisHandler) GetUsageRecords(clientID string, w http.ResponseWriter, r *http.Request) {
+	records, err := h.app.Services.GetUsageRecords(clientID, getStringQueryParam(r, "from"), getStringQueryParam(r, "to"))
+	if err != nil {
+		log.Printf("error: internal.GetUsageRecords() - %s", err.Error())
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if records == nil {
+		records = []model.UsageRecord{}
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		log.Printf("error: internal.GetUsageRecords() - unable to marshal the response - %s", err.Error())
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
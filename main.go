@@ -20,13 +20,24 @@ import (
 	"groups/driven/authman"
 	"groups/driven/calendar"
 	"groups/driven/corebb"
+	"groups/driven/messagebus"
+	"groups/driven/moderation"
 	"groups/driven/notifications"
+	"groups/driven/objectstorage"
+	"groups/driven/orghierarchy"
+	"groups/driven/polls"
 	"groups/driven/rewards"
+	"groups/driven/social"
 	storage "groups/driven/storage"
+	"groups/driven/stub"
+	"groups/driven/webhooks"
 	web "groups/driver/web"
+	"groups/utils"
 	"log"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang-jwt/jwt"
 
@@ -63,12 +74,19 @@ func main() {
 	mongoDBAuth := getEnvKey("GR_MONGO_AUTH", true)
 	mongoDBName := getEnvKey("GR_MONGO_DATABASE", true)
 	mongoTimeout := getEnvKey("GR_MONGO_TIMEOUT", false)
-	storageAdapter := storage.NewStorageAdapter(mongoDBAuth, mongoDBName, mongoTimeout)
+	mongoSlowQueryThreshold := getEnvKey("GR_MONGO_SLOW_QUERY_THRESHOLD", false)
+	answersEncryptionKeys := getEnvKey("GR_ANSWERS_ENCRYPTION_KEYS", false)
+	answersEncryptionKeyID := getEnvKey("GR_ANSWERS_ENCRYPTION_KEY_ID", false)
+	storageAdapter := storage.NewStorageAdapter(mongoDBAuth, mongoDBName, mongoTimeout, mongoSlowQueryThreshold, answersEncryptionKeys, answersEncryptionKeyID)
 	err := storageAdapter.Start()
 	if err != nil {
 		log.Fatal("Cannot start the mongoDB adapter - " + err.Error())
 	}
 
+	// Dev mode
+	// the external building blocks are replaced by the in-memory stubs and the access tokens are issued by the service itself
+	devMode := getEnvKey("GR_DEV_MODE", false) == "true"
+
 	// Auth Service
 	groupServiceURL := getEnvKey("GROUP_SERVICE_URL", false)
 
@@ -79,86 +97,205 @@ func main() {
 		AuthBaseURL: coreBBHost,
 	}
 
-	serviceRegLoader, err := authservice.NewRemoteServiceRegLoader(&authService, []string{"rewards"})
-	if err != nil {
-		log.Fatalf("Error initializing remote service registration loader: %v", err)
+	// Timeout, retry and circuit breaker policy of the external adapters
+	clientConfig := utils.ResilientClientConfig{
+		Timeout:          getDurationEnvKey("GR_EXTERNAL_TIMEOUT", 15*time.Second),
+		MaxRetries:       getIntEnvKey("GR_EXTERNAL_MAX_RETRIES", 2),
+		RetryBackoff:     500 * time.Millisecond,
+		FailureThreshold: getIntEnvKey("GR_EXTERNAL_FAILURE_THRESHOLD", 5),
+		OpenDuration:     getDurationEnvKey("GR_EXTERNAL_OPEN_DURATION", 30*time.Second),
 	}
 
-	serviceRegManager, err := authservice.NewServiceRegManager(&authService, serviceRegLoader)
-	if err != nil {
-		log.Fatalf("Error initializing service registration manager: %v", err)
-	}
+	appID := getEnvKey("GROUPS_APP_ID", true)
+	orgID := getEnvKey("GROUPS_ORG_ID", true)
+	notificationsReportAbuseEmail := getEnvKey("NOTIFICATIONS_REPORT_ABUSE_EMAIL", !devMode)
+	authmanAdminUINList := getAuthmanAdminUINList(!devMode)
+
+	var serviceRegManager *authservice.ServiceRegManager
+	var devTokenIssuer web.DevTokenIssuer
+	var notificationsAdapter core.Notifications
+	var calendarAdapter core.Calendar
+	var authmanAdapter core.Authman
+	var socialAdapter core.Social
+	var pollsAdapter core.Polls
+	var coreAdapter core.Core
+	var rewardsAdapter core.Rewards
+	if devMode {
+		if len(authService.ServiceHost) == 0 {
+			authService.ServiceHost = "http://localhost/gr"
+		}
+		tokenIssuer, err := stub.NewTokenIssuer(authService.ServiceHost, appID, orgID)
+		if err != nil {
+			log.Fatalf("Error initializing dev token issuer: %v", err)
+		}
+		serviceRegManager, err = authservice.NewTestServiceRegManager(&authService, tokenIssuer)
+		if err != nil {
+			log.Fatalf("Error initializing service registration manager: %v", err)
+		}
+		devTokenIssuer = tokenIssuer
+
+		notificationsAdapter = &stub.NotificationsAdapter{}
+		calendarAdapter = stub.NewCalendarAdapter()
+		authmanAdapter = &stub.AuthmanAdapter{}
+		coreAdapter = &stub.CoreAdapter{}
+		rewardsAdapter = &stub.RewardsAdapter{}
+	} else {
+		serviceRegLoader, err := authservice.NewRemoteServiceRegLoader(&authService, []string{"rewards"})
+		if err != nil {
+			log.Fatalf("Error initializing remote service registration loader: %v", err)
+		}
 
-	serviceAccountID := getEnvKey("GR_SERVICE_ACCOUNT_ID", false)
-	privKeyRaw := getEnvKey("GR_PRIV_KEY", true)
-	privKeyRaw = strings.ReplaceAll(privKeyRaw, "\\n", "\n")
-	privKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privKeyRaw))
-	if err != nil {
-		log.Fatalf("Error parsing priv key: %v", err)
-	}
-	signatureAuth, err := sigauth.NewSignatureAuth(privKey, serviceRegManager, false)
-	if err != nil {
-		log.Fatalf("Error initializing signature auth: %v", err)
-	}
+		serviceRegManager, err = authservice.NewServiceRegManager(&authService, serviceRegLoader)
+		if err != nil {
+			log.Fatalf("Error initializing service registration manager: %v", err)
+		}
 
-	serviceAccountLoader, err := authservice.NewRemoteServiceAccountLoader(&authService, serviceAccountID, signatureAuth)
-	if err != nil {
-		log.Fatalf("Error initializing remote service account loader: %v", err)
-	}
+		serviceAccountID := getEnvKey("GR_SERVICE_ACCOUNT_ID", false)
+		privKeyRaw := getEnvKey("GR_PRIV_KEY", true)
+		privKeyRaw = strings.ReplaceAll(privKeyRaw, "\\n", "\n")
+		privKey, err := jwt.ParseRSAPrivateKeyFromPEM([]byte(privKeyRaw))
+		if err != nil {
+			log.Fatalf("Error parsing priv key: %v", err)
+		}
+		signatureAuth, err := sigauth.NewSignatureAuth(privKey, serviceRegManager, false)
+		if err != nil {
+			log.Fatalf("Error initializing signature auth: %v", err)
+		}
 
-	serviceAccountManager, err := authservice.NewServiceAccountManager(&authService, serviceAccountLoader)
-	if err != nil {
-		log.Fatalf("Error initializing service account manager: %v", err)
-	}
+		serviceAccountLoader, err := authservice.NewRemoteServiceAccountLoader(&authService, serviceAccountID, signatureAuth)
+		if err != nil {
+			log.Fatalf("Error initializing remote service account loader: %v", err)
+		}
 
-	// Notification adapter
-	appID := getEnvKey("GROUPS_APP_ID", true)
-	orgID := getEnvKey("GROUPS_ORG_ID", true)
-	notificationsReportAbuseEmail := getEnvKey("NOTIFICATIONS_REPORT_ABUSE_EMAIL", true)
-	notificationsBaseURL := getEnvKey("NOTIFICATIONS_BASE_URL", true)
-	notificationsAdapter, err := notifications.NewNotificationsAdapter(notificationsBaseURL, serviceAccountManager)
-	if err != nil {
-		log.Fatalf("Error initializing notification adapter: %v", err)
-	}
+		serviceAccountManager, err := authservice.NewServiceAccountManager(&authService, serviceAccountLoader)
+		if err != nil {
+			log.Fatalf("Error initializing service account manager: %v", err)
+		}
 
-	// Calendar adapter
-	calendarBaseURL := getEnvKey("CALENDAR_BASE_URL", true)
-	calendarAdapter, err := calendar.NewCalendarAdapter(calendarBaseURL, serviceAccountManager)
-	if err != nil {
-		log.Fatalf("Error initializing notification adapter: %v", err)
+		// Notification adapter
+		notificationsBaseURL := getEnvKey("NOTIFICATIONS_BASE_URL", true)
+		notificationsAdapter, err = notifications.NewNotificationsAdapter(notificationsBaseURL, serviceAccountManager, clientConfig)
+		if err != nil {
+			log.Fatalf("Error initializing notification adapter: %v", err)
+		}
+
+		// Calendar adapter
+		calendarBaseURL := getEnvKey("CALENDAR_BASE_URL", true)
+		calendarAdapter, err = calendar.NewCalendarAdapter(calendarBaseURL, serviceAccountManager, clientConfig)
+		if err != nil {
+			log.Fatalf("Error initializing notification adapter: %v", err)
+		}
+
+		authmanBaseURL := getEnvKey("AUTHMAN_BASE_URL", true)
+		authmanUsername := getEnvKey("AUTHMAN_USERNAME", true)
+		authmanPassword := getEnvKey("AUTHMAN_PASSWORD", true)
+
+		// Authman adapter
+		// the members of the large Authman groups take longer to load
+		authmanClientConfig := clientConfig
+		authmanClientConfig.Timeout = getDurationEnvKey("AUTHMAN_TIMEOUT", 60*time.Second)
+		authmanAdapter = authman.NewAuthmanAdapter(authmanBaseURL, authmanUsername, authmanPassword, authmanClientConfig)
+
+		// Social adapter
+		// optional, the reactions can be migrated to the Social BB only if it is configured
+		socialBaseURL := getEnvKey("SOCIAL_BASE_URL", false)
+		if socialBaseURL != "" {
+			socialAdapter, err = social.NewSocialAdapter(socialBaseURL, serviceAccountManager, clientConfig)
+			if err != nil {
+				log.Fatalf("Error initializing social adapter: %v", err)
+			}
+		}
+
+		// Polls adapter
+		// optional, the group poll results are available only if it is configured
+		pollsBaseURL := getEnvKey("POLLS_BASE_URL", false)
+		if pollsBaseURL != "" {
+			pollsAdapter, err = polls.NewPollsAdapter(pollsBaseURL, serviceAccountManager, clientConfig)
+			if err != nil {
+				log.Fatalf("Error initializing polls adapter: %v", err)
+			}
+		}
+
+		// Core adapter
+		coreAdapter = corebb.NewCoreAdapter(coreBBHost, serviceAccountManager, clientConfig)
+
+		// Rewards adapter
+		rewardsServiceReg, err := serviceRegManager.GetServiceReg("rewards")
+		if err != nil {
+			log.Fatalf("error finding rewards service reg: %s", err)
+		}
+		rewardsAdapter = rewards.NewRewardsAdapter(rewardsServiceReg.Host, intrernalAPIKey, clientConfig)
 	}
 
-	authmanBaseURL := getEnvKey("AUTHMAN_BASE_URL", true)
-	authmanUsername := getEnvKey("AUTHMAN_USERNAME", true)
-	authmanPassword := getEnvKey("AUTHMAN_PASSWORD", true)
-	authmanAdminUINList := getAuthmanAdminUINList()
+	// Object storage adapter
+	// optional, the backups are made only if it is configured
+	var objectStorageAdapter core.ObjectStorage
+	backupEndpoint := getEnvKey("GR_BACKUP_S3_ENDPOINT", false)
+	if backupEndpoint != "" {
+		objectStorageAdapter, err = objectstorage.NewObjectStorageAdapter(backupEndpoint, getEnvKey("GR_BACKUP_S3_REGION", false),
+			getEnvKey("GR_BACKUP_S3_BUCKET", true), getEnvKey("GR_BACKUP_S3_ACCESS_KEY", true), getEnvKey("GR_BACKUP_S3_SECRET_KEY", true),
+			getDurationEnvKey("GR_BACKUP_S3_TIMEOUT", 5*time.Minute))
+		if err != nil {
+			log.Fatalf("Error initializing object storage adapter: %v", err)
+		}
+	}
 
-	// Authman adapter
-	authmanAdapter := authman.NewAuthmanAdapter(authmanBaseURL, authmanUsername, authmanPassword)
+	// Moderation adapter
+	// optional, the content filter checks the posts only against the blocked words if it is not configured
+	var moderationAdapter core.Moderation
+	moderationURL := getEnvKey("GR_MODERATION_API_URL", false)
+	if moderationURL != "" {
+		moderationAdapter = moderation.NewModerationAdapter(moderationURL, getEnvKey("GR_MODERATION_API_KEY", false), clientConfig)
+	}
 
-	// Core adapter
-	coreAdapter := corebb.NewCoreAdapter(coreBBHost, serviceAccountManager)
+	// Org hierarchy adapter
+	// optional, the groups could not be linked to the org units if it is not configured
+	var orgHierarchyAdapter core.OrgHierarchy
+	orgHierarchyURL := getEnvKey("GR_ORG_HIERARCHY_URL", false)
+	if orgHierarchyURL != "" {
+		orgHierarchyAdapter = orghierarchy.NewOrgHierarchyAdapter(orgHierarchyURL, getEnvKey("GR_ORG_HIERARCHY_API_KEY", false), clientConfig)
+	}
 
-	// Rewards adapter
-	rewardsServiceReg, err := serviceRegManager.GetServiceReg("rewards")
-	if err != nil {
-		log.Fatalf("error finding rewards service reg: %s", err)
+	// Message bus adapter
+	// optional, the domain events are published to sns, sqs or kafka only if it is configured
+	var messageBusAdapter core.MessageBus
+	messageBusType := getEnvKey("GR_MESSAGE_BUS_TYPE", false)
+	if messageBusType != "" {
+		messageBusAdapter, err = messagebus.NewMessageBusAdapter(messageBusType, getEnvKey("GR_MESSAGE_BUS_ENDPOINT", false),
+			getEnvKey("GR_MESSAGE_BUS_TARGET", true), getEnvKey("GR_MESSAGE_BUS_REGION", false), getEnvKey("GR_MESSAGE_BUS_ACCESS_KEY", false),
+			getEnvKey("GR_MESSAGE_BUS_SECRET_KEY", false), clientConfig)
+		if err != nil {
+			log.Fatalf("Error initializing message bus adapter: %v", err)
+		}
 	}
-	rewardsAdapter := rewards.NewRewardsAdapter(rewardsServiceReg.Host, intrernalAPIKey)
+
+	// Webhooks adapter
+	webhooksAdapter := webhooks.NewWebhooksAdapter(10 * time.Second)
 
 	supportedClientIDs := []string{"edu.illinois.rokwire", "edu.illinois.covid"}
 
+	analyticsHashSalt := getEnvKey("GR_ANALYTICS_HASH_SALT", false)
+	warmupPrimeGroups := getEnvKey("GR_WARMUP_PRIME_GROUPS", false) == "true"
+	unsubscribeTokenSecret := getEnvKey("GR_UNSUBSCRIBE_TOKEN_SECRET", false)
+	calendarFeedTokenSecret := getEnvKey("GR_CALENDAR_FEED_TOKEN_SECRET", false)
+	backupPrefix := getEnvKey("GR_BACKUP_PREFIX", false)
+
 	config := &model.ApplicationConfig{
 		AuthmanAdminUINList:       authmanAdminUINList,
 		ReportAbuseRecipientEmail: notificationsReportAbuseEmail,
 		SupportedClientIDs:        supportedClientIDs,
 		AppID:                     appID,
 		OrgID:                     orgID,
+		AnalyticsHashSalt:         analyticsHashSalt,
+		WarmupPrimeGroups:         warmupPrimeGroups,
+		UnsubscribeTokenSecret:    unsubscribeTokenSecret,
+		CalendarFeedTokenSecret:   calendarFeedTokenSecret,
+		BackupPrefix:              backupPrefix,
 	}
 
 	//application
 	application := core.NewApplication(Version, Build, storageAdapter, notificationsAdapter, authmanAdapter,
-		coreAdapter, rewardsAdapter, calendarAdapter, serviceID, logger, config)
+		coreAdapter, rewardsAdapter, calendarAdapter, webhooksAdapter, socialAdapter, pollsAdapter, objectStorageAdapter, moderationAdapter, orgHierarchyAdapter, messageBusAdapter, serviceID, logger, config)
 	application.Start()
 
 	//web adapter
@@ -168,15 +305,18 @@ func main() {
 	if len(port) == 0 {
 		port = "80"
 	}
-	oidcProvider := getEnvKey("GR_OIDC_PROVIDER", true)
-	oidcClientID := getEnvKey("GR_OIDC_CLIENT_ID", true)
+	oidcProvider := getEnvKey("GR_OIDC_PROVIDER", !devMode)
+	oidcClientID := getEnvKey("GR_OIDC_CLIENT_ID", !devMode)
 	oidcExtendedClientIDs := getEnvKey("GR_OIDC_EXTENDED_CLIENT_IDS", false)
-	oidcAdminClientID := getEnvKey("GR_OIDC_ADMIN_CLIENT_ID", true)
-	oidcAdminWebClientID := getEnvKey("GR_OIDC_ADMIN_WEB_CLIENT_ID", true)
+	oidcAdminClientID := getEnvKey("GR_OIDC_ADMIN_CLIENT_ID", !devMode)
+	oidcAdminWebClientID := getEnvKey("GR_OIDC_ADMIN_WEB_CLIENT_ID", !devMode)
+
+	requestTimeout := getDurationEnvKey("GR_REQUEST_TIMEOUT", 30*time.Second)
+	longRequestTimeout := getDurationEnvKey("GR_LONG_REQUEST_TIMEOUT", 120*time.Second)
 
 	webAdapter := web.NewWebAdapter(application, host, port, supportedClientIDs, apiKeys, oidcProvider,
 		oidcClientID, oidcExtendedClientIDs, oidcAdminClientID, oidcAdminWebClientID,
-		intrernalAPIKey, serviceRegManager, groupServiceURL, logger)
+		intrernalAPIKey, serviceRegManager, groupServiceURL, requestTimeout, longRequestTimeout, devTokenIssuer, logger)
 	webAdapter.Start()
 }
 
@@ -207,9 +347,37 @@ func getEnvKey(key string, required bool) string {
 	return value
 }
 
-func getAuthmanAdminUINList() []string {
+// getDurationEnvKey reads a duration in seconds from the environment and falls back to the default value
+func getDurationEnvKey(key string, defaultValue time.Duration) time.Duration {
+	value := getEnvKey(key, false)
+	if len(value) == 0 {
+		return defaultValue
+	}
+	seconds, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid value for %s: %s", key, err)
+		return defaultValue
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// getIntEnvKey reads an int from the environment and falls back to the default value
+func getIntEnvKey(key string, defaultValue int) int {
+	value := getEnvKey(key, false)
+	if len(value) == 0 {
+		return defaultValue
+	}
+	intValue, err := strconv.Atoi(value)
+	if err != nil {
+		log.Printf("Invalid value for %s: %s", key, err)
+		return defaultValue
+	}
+	return intValue
+}
+
+func getAuthmanAdminUINList(required bool) []string {
 	//get from the environment
-	authmanAdminUINs := getEnvKey("AUTHMAN_ADMIN_UIN_LIST", true)
+	authmanAdminUINs := getEnvKey("AUTHMAN_ADMIN_UIN_LIST", required)
 	if len(authmanAdminUINs) == 0 {
 		return nil
 	}
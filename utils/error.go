@@ -17,12 +17,17 @@ package utils
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"strings"
+	"time"
 )
 
-// GroupError group error
+// GroupError group error. It is the single error model of the Client, Admin and Internal APIs, the clients should branch on the code.
 type GroupError struct {
 	Code    int
 	Message string
+	Details map[string]interface{} // optional machine readable details
+	TraceID string                 // the id of the request, set when the response is written
 }
 
 // Error returns the error message
@@ -32,16 +37,47 @@ func (err *GroupError) Error() string {
 
 // JSONErrorString constructs json representation of the error
 func (err *GroupError) JSONErrorString() string {
+	errorBody := map[string]interface{}{
+		"code":    err.Code,
+		"message": err.Message,
+		"text":    err.Message, // kept for the clients reading the message before the standardized envelope
+	}
+	if len(err.Details) > 0 {
+		errorBody["details"] = err.Details
+	}
+	if len(err.TraceID) > 0 {
+		errorBody["trace_id"] = err.TraceID
+	}
 	errorData := map[string]interface{}{
-		"error": map[string]interface{}{
-			"code": err.Code,
-			"text": err.Message,
-		},
+		"error": errorBody,
 	}
 	jsonString, _ := json.Marshal(errorData)
 	return string(jsonString)
 }
 
+// ParseGroupError reads the error from its json representation. It gives nil if the data is not an error envelope.
+func ParseGroupError(data []byte) *GroupError {
+	var envelope struct {
+		Error *struct {
+			Code    int                    `json:"code"`
+			Message string                 `json:"message"`
+			Text    string                 `json:"text"`
+			Details map[string]interface{} `json:"details"`
+			TraceID string                 `json:"trace_id"`
+		} `json:"error"`
+	}
+	err := json.Unmarshal(data, &envelope)
+	if err != nil || envelope.Error == nil || envelope.Error.Code == 0 {
+		return nil
+	}
+
+	message := envelope.Error.Message
+	if len(message) == 0 {
+		message = envelope.Error.Text
+	}
+	return &GroupError{Code: envelope.Error.Code, Message: message, Details: envelope.Error.Details, TraceID: envelope.Error.TraceID}
+}
+
 // NewForbiddenError new forbidden error
 func NewForbiddenError() *GroupError {
 	return &GroupError{Code: 1, Message: "forbidden operation"}
@@ -76,3 +112,150 @@ func NewMissingParamError(message string) *GroupError {
 func NewNotFoundError() *GroupError {
 	return &GroupError{Code: 7, Message: "group not found"}
 }
+
+// NewRulesNotAcknowledgedError group rules not acknowledged error
+func NewRulesNotAcknowledgedError() *GroupError {
+	return &GroupError{Code: 8, Message: "group rules not acknowledged"}
+}
+
+// NewPinnedPostsLimitError pinned posts limit reached error
+func NewPinnedPostsLimitError(limit int) *GroupError {
+	return &GroupError{Code: 9, Message: fmt.Sprintf("at most %d posts can be pinned", limit)}
+}
+
+// NewPostLimitError post limit exceeded error
+func NewPostLimitError(field string, limit int) *GroupError {
+	return &GroupError{Code: 10, Message: fmt.Sprintf("%s exceeds the limit of %d", field, limit),
+		Details: map[string]interface{}{"field": field, "limit": limit}}
+}
+
+// NewContentFilterError post rejected by the content filter error
+func NewContentFilterError(flags []string) *GroupError {
+	return &GroupError{Code: 11, Message: "the post violates the content policy",
+		Details: map[string]interface{}{"flags": flags}}
+}
+
+// NewLastAdminError last group admin error
+func NewLastAdminError() *GroupError {
+	return &GroupError{Code: 12, Message: "the group must keep at least one admin, transfer the ownership first"}
+}
+
+// NewBannedError user banned from the group error
+func NewBannedError() *GroupError {
+	return &GroupError{Code: 13, Message: "the user is banned from the group"}
+}
+
+// NewFeaturedEventsLimitError featured events limit reached error
+func NewFeaturedEventsLimitError(limit int) *GroupError {
+	return &GroupError{Code: 14, Message: fmt.Sprintf("at most %d events can be featured", limit)}
+}
+
+// NewEventNotFoundError calendar event not found error
+func NewEventNotFoundError(eventID string) *GroupError {
+	return &GroupError{Code: 15, Message: "event not found",
+		Details: map[string]interface{}{"event_id": eventID}}
+}
+
+// NewEventMappingExistsError event already linked to the group error
+func NewEventMappingExistsError(eventID string, groupID string, dateCreated time.Time) *GroupError {
+	return &GroupError{Code: 16, Message: "the event is already linked to the group",
+		Details: map[string]interface{}{"event_id": eventID, "group_id": groupID, "date_created": dateCreated}}
+}
+
+// NewBadRequestError generic bad request error
+func NewBadRequestError(message string) *GroupError {
+	return &GroupError{Code: 17, Message: message}
+}
+
+// NewUnauthorizedError missing or invalid credentials error
+func NewUnauthorizedError() *GroupError {
+	return &GroupError{Code: 18, Message: "unauthorized"}
+}
+
+// NewResourceNotFoundError generic not found error
+func NewResourceNotFoundError(message string) *GroupError {
+	return &GroupError{Code: 19, Message: message}
+}
+
+// NewConflictError generic conflict error
+func NewConflictError(message string) *GroupError {
+	return &GroupError{Code: 20, Message: message}
+}
+
+// NewTooManyRequestsError rate limit exceeded error
+func NewTooManyRequestsError(message string) *GroupError {
+	return &GroupError{Code: 21, Message: message}
+}
+
+// NewTimeoutError request deadline exceeded error
+func NewTimeoutError() *GroupError {
+	return &GroupError{Code: 22, Message: "the request exceeded the deadline"}
+}
+
+// NewServiceUnavailableError the service is not ready to serve the requests yet
+func NewServiceUnavailableError() *GroupError {
+	return &GroupError{Code: 23, Message: "the service is warming up"}
+}
+
+// NewPostingMutedError member muted by the group admins error
+func NewPostingMutedError(mutedUntil time.Time) *GroupError {
+	return &GroupError{Code: 24, Message: "the member is muted by the group admins",
+		Details: map[string]interface{}{"muted_until": mutedUntil}}
+}
+
+// NewStatusError maps the HTTP status of the responses written without a code to the error model. The message is the
+// status text if empty.
+func NewStatusError(status int, message string) *GroupError {
+	if len(message) == 0 {
+		message = strings.ToLower(http.StatusText(status))
+	}
+
+	switch status {
+	case http.StatusUnauthorized:
+		err := NewUnauthorizedError()
+		err.Message = message
+		return err
+	case http.StatusForbidden:
+		err := NewForbiddenError()
+		err.Message = message
+		return err
+	case http.StatusNotFound:
+		return NewResourceNotFoundError(message)
+	case http.StatusConflict:
+		return NewConflictError(message)
+	case http.StatusTooManyRequests:
+		return NewTooManyRequestsError(message)
+	case http.StatusGatewayTimeout:
+		return NewTimeoutError()
+	case http.StatusServiceUnavailable:
+		return NewServiceUnavailableError()
+	}
+	if status >= http.StatusInternalServerError {
+		// the server errors details are logged, not exposed
+		return NewServerError()
+	}
+	return NewBadRequestError(message)
+}
+
+// HTTPStatus gives the HTTP status the error is responded with
+func (err *GroupError) HTTPStatus() int {
+	switch err.Code {
+	case 1, 8, 13, 24:
+		return http.StatusForbidden
+	case 4:
+		return http.StatusInternalServerError
+	case 7, 15, 19:
+		return http.StatusNotFound
+	case 12, 16, 20:
+		return http.StatusConflict
+	case 18:
+		return http.StatusUnauthorized
+	case 21:
+		return http.StatusTooManyRequests
+	case 22:
+		return http.StatusGatewayTimeout
+	case 23:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusBadRequest
+}
@@ -0,0 +1,283 @@
+// Copyright 2022 Board of Trustees of the University of Illinois.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package utils
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// CircuitClosed the requests are sent
+	CircuitClosed = "closed"
+	// CircuitOpen the requests are rejected without being sent
+	CircuitOpen = "open"
+	// CircuitHalfOpen a single probe request is sent to check if the remote service has recovered
+	CircuitHalfOpen = "half_open"
+)
+
+// ErrCircuitOpen is returned when the request is rejected because the circuit breaker of the remote service is open
+var ErrCircuitOpen = errors.New("circuit breaker is open")
+
+// ResilientClientConfig defines the timeout, retry and circuit breaker policy of a resilient client
+type ResilientClientConfig struct {
+	Timeout          time.Duration // Timeout of a single attempt
+	MaxRetries       int           // Retries after the first attempt
+	RetryBackoff     time.Duration // Backoff before the first retry, doubled on each next retry
+	RetryAllMethods  bool          // Retry the non idempotent methods too. Use only for remote services where POST requests are queries
+	FailureThreshold int           // Consecutive failures which open the circuit
+	OpenDuration     time.Duration // Time the circuit stays open before a probe request is allowed
+}
+
+// ResilientClientMetrics represents the request metrics of a resilient client
+type ResilientClientMetrics struct {
+	Name         string     `json:"name"`
+	State        string     `json:"state"`
+	Requests     int64      `json:"requests"`
+	Failures     int64      `json:"failures"`
+	Retries      int64      `json:"retries"`
+	Rejected     int64      `json:"rejected"`
+	Opened       int64      `json:"opened"`
+	DateOpened   *time.Time `json:"date_opened"`
+	LastFailure  *string    `json:"last_failure"`
+	AvgLatencyMS int64      `json:"avg_latency_ms"`
+} // @name ResilientClientMetrics
+
+// ResilientClient sends the requests of a driven adapter with timeouts, retries with exponential backoff and a circuit breaker
+type ResilientClient struct {
+	name   string
+	config ResilientClientConfig
+	client *http.Client
+
+	lock                sync.Mutex
+	state               string
+	consecutiveFailures int
+	probing             bool
+	metrics             ResilientClientMetrics
+	totalLatency        time.Duration
+}
+
+var (
+	resilientClients     = map[string]*ResilientClient{}
+	resilientClientsLock sync.Mutex
+)
+
+// NewResilientClient creates a resilient client for the remote service with the provided name
+func NewResilientClient(name string, config ResilientClientConfig) *ResilientClient {
+	client := &ResilientClient{name: name, config: config, client: &http.Client{}, state: CircuitClosed}
+
+	resilientClientsLock.Lock()
+	resilientClients[name] = client
+	resilientClientsLock.Unlock()
+
+	return client
+}
+
+// GetResilientClientsMetrics gives the metrics of all resilient clients
+func GetResilientClientsMetrics() []ResilientClientMetrics {
+	resilientClientsLock.Lock()
+	clients := make([]*ResilientClient, 0, len(resilientClients))
+	for _, client := range resilientClients {
+		clients = append(clients, client)
+	}
+	resilientClientsLock.Unlock()
+
+	result := make([]ResilientClientMetrics, len(clients))
+	for i, client := range clients {
+		result[i] = client.Metrics()
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// Metrics gives the request metrics of the client
+func (c *ResilientClient) Metrics() ResilientClientMetrics {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	metrics := c.metrics
+	metrics.Name = c.name
+	metrics.State = c.state
+	if attempts := metrics.Requests + metrics.Retries; attempts > 0 {
+		metrics.AvgLatencyMS = c.totalLatency.Milliseconds() / attempts
+	}
+	return metrics
+}
+
+// Do sends the request with the client of the resilient client
+func (c *ResilientClient) Do(req *http.Request) (*http.Response, error) {
+	return c.DoWith(req, c.client.Do)
+}
+
+// DoWith sends the request with the provided send function, e.g. the authenticated request of the service account manager
+func (c *ResilientClient) DoWith(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	c.lock.Lock()
+	c.metrics.Requests++
+	c.lock.Unlock()
+
+	retryable := c.config.RetryAllMethods || isIdempotentMethod(req.Method)
+	backoff := c.config.RetryBackoff
+
+	var resp *http.Response
+	var err error
+	for attempt := 0; ; attempt++ {
+		if !c.allowRequest() {
+			return nil, fmt.Errorf("%s: %w", c.name, ErrCircuitOpen)
+		}
+
+		resp, err = c.attempt(req, send)
+		failed := err != nil || isRemoteFailure(resp.StatusCode)
+		c.recordResult(failed, err, resp)
+		if !failed || !retryable || attempt >= c.config.MaxRetries {
+			return resp, err
+		}
+
+		// the request body was consumed by the failed attempt
+		if req.Body != nil && req.Body != http.NoBody {
+			if req.GetBody == nil {
+				return resp, err
+			}
+			body, bodyErr := req.GetBody()
+			if bodyErr != nil {
+				return resp, err
+			}
+			req.Body = body
+		}
+		if resp != nil {
+			io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		c.lock.Lock()
+		c.metrics.Retries++
+		c.lock.Unlock()
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+func (c *ResilientClient) attempt(req *http.Request, send func(*http.Request) (*http.Response, error)) (*http.Response, error) {
+	startTime := time.Now()
+	defer func() {
+		c.lock.Lock()
+		c.totalLatency += time.Since(startTime)
+		c.lock.Unlock()
+	}()
+
+	if c.config.Timeout <= 0 {
+		return send(req)
+	}
+
+	ctx, cancel := context.WithTimeout(req.Context(), c.config.Timeout)
+	resp, err := send(req.WithContext(ctx))
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	// the timeout covers reading the body too, so the context is released once the caller closes it
+	resp.Body = &cancelOnCloseBody{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (c *ResilientClient) allowRequest() bool {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	switch c.state {
+	case CircuitOpen:
+		if c.metrics.DateOpened != nil && time.Since(*c.metrics.DateOpened) >= c.config.OpenDuration {
+			c.state = CircuitHalfOpen
+			c.probing = true
+			log.Printf("%s: circuit breaker is half open, sending a probe request", c.name)
+			return true
+		}
+	case CircuitHalfOpen:
+		if !c.probing {
+			c.probing = true
+			return true
+		}
+	default:
+		return true
+	}
+
+	c.metrics.Rejected++
+	return false
+}
+
+func (c *ResilientClient) recordResult(failed bool, err error, resp *http.Response) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	c.probing = false
+	if !failed {
+		if c.state != CircuitClosed {
+			log.Printf("%s: circuit breaker is closed", c.name)
+		}
+		c.state = CircuitClosed
+		c.consecutiveFailures = 0
+		return
+	}
+
+	c.metrics.Failures++
+	c.consecutiveFailures++
+	failure := ""
+	if err != nil {
+		failure = err.Error()
+	} else {
+		failure = fmt.Sprintf("response code %d", resp.StatusCode)
+	}
+	c.metrics.LastFailure = &failure
+
+	if c.state == CircuitHalfOpen || (c.config.FailureThreshold > 0 && c.consecutiveFailures >= c.config.FailureThreshold && c.state == CircuitClosed) {
+		now := time.Now()
+		c.state = CircuitOpen
+		c.metrics.Opened++
+		c.metrics.DateOpened = &now
+		log.Printf("%s: circuit breaker is open for %s after %d consecutive failures - %s", c.name, c.config.OpenDuration, c.consecutiveFailures, failure)
+	}
+}
+
+func isIdempotentMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// isRemoteFailure checks if the response code means the remote service is unavailable
+func isRemoteFailure(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusBadGateway ||
+		statusCode == http.StatusServiceUnavailable || statusCode == http.StatusGatewayTimeout
+}
+
+type cancelOnCloseBody struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnCloseBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}